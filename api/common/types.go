@@ -3,7 +3,11 @@ package common
 import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/operator-framework/api/pkg/lib/version"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -102,6 +106,48 @@ type Status struct {
 
 	// +listType=atomic
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The operator version the resources were last rendered with.
+	// +optional
+	RenderedVersion string `json:"renderedVersion,omitempty"`
+
+	// The resources deployed and owned by the operator for this resource.
+	// +optional
+	// +listType=atomic
+	Resources []ManagedResource `json:"resources,omitempty"`
+
+	// The images of the containers deployed by the operator for this resource.
+	// +optional
+	// +listType=atomic
+	Images []ContainerImage `json:"images,omitempty"`
+}
+
+// ManagedResource identifies a resource deployed by the operator.
+// +kubebuilder:object:generate=true
+type ManagedResource struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// The hash of the rendered resource, changes whenever the desired state of the resource does.
+	Hash string `json:"hash,omitempty"`
+}
+
+// ContainerImage identifies the image run by a container deployed by the operator.
+// +kubebuilder:object:generate=true
+type ContainerImage struct {
+	// The kind of the workload, e.g. Deployment.
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Container string `json:"container"`
+	// The image as rendered, before it is pinned by digest.
+	Image string `json:"image"`
+	// The digest of the image, empty when the image is referenced by tag and its digest is not
+	// resolved.
+	// +optional
+	Digest string `json:"digest,omitempty"`
 }
 
 func (s *Status) GetConditions() []Condition {
@@ -132,6 +178,507 @@ type ComponentReleaseStatus struct {
 	Releases []ComponentRelease `yaml:"releases,omitempty" json:"releases,omitempty"`
 }
 
+// ResourcesOverride defines the compute resources of a container of a Deployment rendered
+// by a component. The values are merged over the ones set in the component manifests.
+// +kubebuilder:object:generate=true
+type ResourcesOverride struct {
+	// Name of the Deployment rendered by the component.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Deployment string `json:"deployment"`
+	// Name of the container, defaults to the first container of the Deployment.
+	// +kubebuilder:validation:MaxLength=63
+	Container string `json:"container,omitempty"`
+	// Compute resources requests of the container.
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Compute resources limits of the container.
+	Limits corev1.ResourceList `json:"limits,omitempty"`
+}
+
+// SchedulingSpec defines the scheduling constraints of the workloads deployed by the operator.
+// +kubebuilder:object:generate=true
+type SchedulingSpec struct {
+	// Node labels the pods must match to be scheduled on a node, merged over the ones set in the manifests.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations of the pods, replacing the ones set in the manifests.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity of the pods, replacing the one set in the manifests.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+// HighAvailabilitySpec defines how the Deployments rendered by a component are run in high
+// availability.
+// +kubebuilder:object:generate=true
+type HighAvailabilitySpec struct {
+	// Number of replicas of the Deployments, overriding the one set in the manifests.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Minimum number, or percentage, of the pods of each Deployment that must remain available
+	// during a voluntary disruption, enforced by a PodDisruptionBudget created for the Deployment.
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// Topology spread constraints of the pods, replacing the ones set in the manifests. The pods
+	// of the Deployment are selected when no label selector is set.
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// CABundleSource references a key of a ConfigMap or of a Secret, in the applications namespace,
+// holding PEM encoded CA certificates. Exactly one of the references must be set.
+// +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.secretKeyRef)",message="exactly one of configMapKeyRef or secretKeyRef must be set"
+type CABundleSource struct {
+	// Key of a ConfigMap holding CA certificates.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// Key of a Secret holding CA certificates.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// DataScienceProjectTemplate defines the resources the operator adds to the data science projects,
+// the namespaces labeled with opendatahub.io/dashboard=true, and keeps in sync with the template.
+// +kubebuilder:object:generate=true
+type DataScienceProjectTemplate struct {
+	// Labels added to the namespaces of the projects, e.g. to select them in cluster-wide policies.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Labels map[string]string `json:"labels,omitempty"`
+	// Hard limits of the ResourceQuota added to the projects.
+	// +optional
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+	// NetworkPolicies added to the projects.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	NetworkPolicies []ProjectNetworkPolicy `json:"networkPolicies,omitempty"`
+	// Kueue LocalQueue added to the projects, only when the Kueue CRDs are installed.
+	// +optional
+	LocalQueue *ProjectLocalQueue `json:"localQueue,omitempty"`
+	// RoleBindings added to the projects.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	RoleBindings []ProjectRoleBinding `json:"roleBindings,omitempty"`
+}
+
+// ProjectNetworkPolicy is a NetworkPolicy added to the data science projects.
+// +kubebuilder:object:generate=true
+type ProjectNetworkPolicy struct {
+	// Name of the NetworkPolicy.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$"
+	Name string `json:"name"`
+	// Specification of the NetworkPolicy.
+	Spec networkingv1.NetworkPolicySpec `json:"spec"`
+}
+
+// ProjectLocalQueue is the Kueue LocalQueue added to the data science projects.
+// +kubebuilder:object:generate=true
+type ProjectLocalQueue struct {
+	// Name of the LocalQueue. Defaults to default, the LocalQueue Kueue submits the workloads
+	// of the namespace without queue name to.
+	// +optional
+	// +kubebuilder:default=default
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+	// Name of the ClusterQueue the LocalQueue points to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ClusterQueue string `json:"clusterQueue"`
+}
+
+// ProjectRoleBinding is a RoleBinding added to the data science projects.
+// +kubebuilder:object:generate=true
+type ProjectRoleBinding struct {
+	// Name of the RoleBinding.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$"
+	Name string `json:"name"`
+	// Role or ClusterRole bound.
+	RoleRef rbacv1.RoleRef `json:"roleRef"`
+	// Users, groups and service accounts the role is bound to.
+	// +optional
+	// +listType=atomic
+	Subjects []rbacv1.Subject `json:"subjects,omitempty"`
+}
+
+// UpgradeStrategy defines how a component is upgraded when the operator version changes.
+// +kubebuilder:validation:Enum=Automatic;Manual
+type UpgradeStrategy string
+
+const (
+	// UpgradeStrategyAutomatic re-renders the component as soon as the operator version changes.
+	UpgradeStrategyAutomatic UpgradeStrategy = "Automatic"
+	// UpgradeStrategyManual waits for the upgrade to be approved before re-rendering the component.
+	UpgradeStrategyManual UpgradeStrategy = "Manual"
+)
+
+// DriftPolicy defines how changes made on the cluster to the resources deployed by the operator are handled.
+// +kubebuilder:validation:Enum=Overwrite;Report;Preserve
+type DriftPolicy string
+
+const (
+	// DriftPolicyOverwrite reverts the changes without reporting them.
+	DriftPolicyOverwrite DriftPolicy = "Overwrite"
+	// DriftPolicyReport reports the changes, then reverts them.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyPreserve reports the changes and keeps them, the resource is no longer updated.
+	DriftPolicyPreserve DriftPolicy = "Preserve"
+)
+
+// AdoptionPolicy defines how the resources a component deploys are handled when they already exist on
+// the cluster without being managed by the platform, e.g. when installed by the user.
+// +kubebuilder:validation:Enum=Adopt;Skip;Fail
+type AdoptionPolicy string
+
+const (
+	// AdoptionPolicyAdopt takes over the resources, which are then managed as the ones the operator created.
+	AdoptionPolicyAdopt AdoptionPolicy = "Adopt"
+	// AdoptionPolicySkip leaves the resources as they are, the operator neither updates nor deletes them.
+	AdoptionPolicySkip AdoptionPolicy = "Skip"
+	// AdoptionPolicyFail fails the reconciliation of the component until the resources are removed or
+	// marked as managed by the operator.
+	AdoptionPolicyFail AdoptionPolicy = "Fail"
+)
+
+// ReadinessCheck is a check of the functionality of a component, evaluated once its resources are
+// deployed: the component is only Ready while all its checks pass.
+// +kubebuilder:validation:XValidation:rule="[has(self.http), has(self.crd), has(self.job)].filter(x, x).size() == 1",message="exactly one of http, crd or job must be set"
+type ReadinessCheck struct {
+	// Name of the check, reported when it fails.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Checks that an HTTP endpoint of a Service answers with a success status code.
+	// +optional
+	HTTP *HTTPReadinessCheck `json:"http,omitempty"`
+	// Checks that a CustomResourceDefinition is established.
+	// +optional
+	CRD *CRDReadinessCheck `json:"crd,omitempty"`
+	// Checks that a Job completed.
+	// +optional
+	Job *JobReadinessCheck `json:"job,omitempty"`
+}
+
+// HTTPReadinessCheck checks that an HTTP endpoint of a Service answers with a 2xx or 3xx status
+// code. As with the probes of the kubelet, the certificate of an HTTPS endpoint is not verified.
+type HTTPReadinessCheck struct {
+	// Name of the Service.
+	Service string `json:"service"`
+	// Namespace of the Service. Defaults to the applications namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Port of the Service.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// Path of the endpoint. Defaults to /.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Scheme of the endpoint. Defaults to HTTP.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// CRDReadinessCheck checks that a CustomResourceDefinition is established.
+type CRDReadinessCheck struct {
+	// Name of the CustomResourceDefinition, e.g. inferenceservices.serving.kserve.io.
+	Name string `json:"name"`
+}
+
+// JobReadinessCheck checks that a Job completed.
+type JobReadinessCheck struct {
+	// Name of the Job.
+	Name string `json:"name"`
+	// Namespace of the Job. Defaults to the applications namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// GCPolicy defines how the resources deployed by the operator and no longer rendered are garbage collected.
+// +kubebuilder:validation:Enum=Enabled;DryRun;Disabled
+type GCPolicy string
+
+const (
+	// GCPolicyEnabled deletes the resources no longer rendered.
+	GCPolicyEnabled GCPolicy = "Enabled"
+	// GCPolicyDryRun reports the resources no longer rendered, without deleting them.
+	GCPolicyDryRun GCPolicy = "DryRun"
+	// GCPolicyDisabled keeps the resources no longer rendered.
+	GCPolicyDisabled GCPolicy = "Disabled"
+)
+
+// DeployMode defines how the resources rendered by the operator are deployed.
+// +kubebuilder:validation:Enum=Apply;GitOps
+type DeployMode string
+
+const (
+	// DeployModeApply applies the rendered resources to the cluster.
+	DeployModeApply DeployMode = "Apply"
+	// DeployModeGitOps publishes the rendered resources for a GitOps tool to apply them, only the
+	// resources of the platform API, reconciled by the operator, are applied.
+	DeployModeGitOps DeployMode = "GitOps"
+)
+
+// GitOpsSpec defines where the resources rendered in the GitOps deploy mode are published.
+type GitOpsSpec struct {
+	// OCI repository the rendered resources are pushed to, as an OCI artifact holding a kustomize
+	// bundle, e.g. quay.io/example/odh-manifests.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?(/[a-z0-9._-]+)+$`
+	Repository string `json:"repository"`
+	// Tag of the pushed artifact. Defaults to latest.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`
+	Tag string `json:"tag,omitempty"`
+	// Name of the kubernetes.io/dockerconfigjson Secret, in the operator namespace, holding the
+	// credentials of the registry. The artifact is pushed anonymously when unset.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// Push the artifact over plain HTTP, e.g. to a registry of the cluster.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// ImageDigestPolicy defines how the digests of the images referenced by tag are used.
+// +kubebuilder:validation:Enum=Record;Pin
+type ImageDigestPolicy string
+
+const (
+	// ImageDigestPolicyRecord records the digests the tags resolve to in the status of the resources.
+	ImageDigestPolicyRecord ImageDigestPolicy = "Record"
+	// ImageDigestPolicyPin also pins the images by digest in the rendered workloads, the digest a
+	// tag first resolved to being kept while the image is rendered.
+	ImageDigestPolicyPin ImageDigestPolicy = "Pin"
+)
+
+// ImageDigestsSpec defines how the digests of the images referenced by tag are resolved.
+type ImageDigestsSpec struct {
+	// Record records the digests the tags resolve to in the status of the components, Pin also
+	// pins the images by digest in the rendered workloads. Defaults to Record.
+	// +optional
+	Policy ImageDigestPolicy `json:"policy,omitempty"`
+	// Name of the kubernetes.io/dockerconfigjson Secret, in the operator namespace, holding the
+	// credentials of the registries. The digests are resolved anonymously when unset.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// PreflightPolicy defines how the failures of the upgrade preflight checks are handled.
+// +kubebuilder:validation:Enum=Warn;Block
+type PreflightPolicy string
+
+const (
+	// PreflightPolicyWarn reports the failures of the preflight checks.
+	PreflightPolicyWarn PreflightPolicy = "Warn"
+	// PreflightPolicyBlock reports the failures of the preflight checks, and holds the major version
+	// upgrades of the components while a blocking check fails.
+	PreflightPolicyBlock PreflightPolicy = "Block"
+)
+
+// AcceleratorVendor is the vendor of the accelerators detected on the cluster.
+type AcceleratorVendor string
+
+const (
+	AcceleratorVendorNVIDIA AcceleratorVendor = "NVIDIA"
+	AcceleratorVendorAMD    AcceleratorVendor = "AMD"
+	AcceleratorVendorHabana AcceleratorVendor = "Habana"
+	AcceleratorVendorIntel  AcceleratorVendor = "Intel"
+)
+
+// Accelerator reports the accelerators of a vendor detected on the cluster, through the extended
+// resources advertised by its device plugin on the nodes and the presence of its operator.
+type Accelerator struct {
+	// Vendor of the accelerators.
+	Vendor AcceleratorVendor `json:"vendor"`
+	// Extended resources advertised by the device plugin of the vendor on the nodes, e.g. nvidia.com/gpu.
+	// +optional
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// Whether the operator of the vendor managing the device plugin is installed, e.g. the NVIDIA GPU operator.
+	// +optional
+	OperatorInstalled bool `json:"operatorInstalled,omitempty"`
+	// Number of nodes advertising the accelerators.
+	// +optional
+	Nodes int32 `json:"nodes,omitempty"`
+	// Number of accelerators allocatable on the nodes.
+	// +optional
+	Count int64 `json:"count,omitempty"`
+}
+
+// DevFlagsSpec defines settings meant for developers to test changes of the manifests of a
+// component without publishing them. They are not supported in production.
+// +kubebuilder:object:generate=true
+type DevFlagsSpec struct {
+	// Overlay of the component manifests rendered instead of the default one, as a path relative
+	// to the manifests directory of the component, e.g. overlays/dev. Manifests not providing
+	// the overlay are rendered as usual.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+(/[a-zA-Z0-9_.-]+)*$`
+	Overlay string `json:"overlay,omitempty"`
+	// Kustomize patches applied, in order, on the rendered manifests of the component.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	Patches []ManifestPatch `json:"patches,omitempty"`
+}
+
+// ManifestPatch defines an inline kustomize patch, either a JSON6902 or a strategic merge one.
+// +kubebuilder:object:generate=true
+type ManifestPatch struct {
+	// Resources the patch is applied on.
+	// +required
+	// +kubebuilder:validation:Required
+	Target ManifestPatchTarget `json:"target"`
+	// Content of the patch, in YAML or JSON: a list of JSON6902 operations or a partial
+	// resource merged with the strategic merge semantic.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// ManifestPatchTarget selects the rendered resources a patch is applied on, unset fields match
+// any resource.
+// +kubebuilder:object:generate=true
+type ManifestPatchTarget struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Label selector of the resources, in the kubectl format.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// Annotation selector of the resources, in the kubectl format.
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+}
+
+// ComponentWorkloadSpec defines the settings shared by the components on how their workloads
+// are deployed and run. It is inlined in the spec of each component.
+// +kubebuilder:object:generate=true
+type ComponentWorkloadSpec struct {
+	// Compute resources overrides for the containers of the Deployments rendered by the component.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=atomic
+	Resources []ResourcesOverride `json:"resources,omitempty"`
+	// Scheduling constraints of the component workloads, taking precedence over the cluster-wide ones set in DSCInitialization.
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Upgrade strategy of the component: with Manual, a new operator version is not rolled out
+	// until approved by setting the opendatahub.io/upgrade-approved annotation on the component
+	// CR to that version.
+	// +optional
+	UpgradeStrategy UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// Drift policy of the component: how changes made on the cluster to the resources deployed
+	// for the component are handled. Overwrite reverts them, Report records a DriftDetected
+	// condition and an event before reverting them, Preserve records them and keeps them.
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+	// Adoption policy of the component: how the resources deployed for the component are handled
+	// when they already exist on the cluster without being managed by the platform, e.g. when
+	// installed by the user. Adopt takes them over, Skip leaves them as they are, Fail fails the
+	// reconciliation until they are removed or annotated with opendatahub.io/managed=true.
+	// +optional
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+	// Readiness checks of the component, evaluated once its resources are deployed: HTTP checks
+	// of its Services, CustomResourceDefinitions to be established or Jobs to complete. The
+	// component is only Ready while its Deployments are available and all its checks pass.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ReadinessChecks []ReadinessCheck `json:"readinessChecks,omitempty"`
+	// High availability of the component Deployments: their number of replicas, the minimum
+	// number of their pods kept available during disruptions and the spread of the pods.
+	// +optional
+	HighAvailability *HighAvailabilitySpec `json:"highAvailability,omitempty"`
+	// Developer settings of the component, to render an alternative overlay of its manifests
+	// or to patch them. Not supported in production.
+	// +optional
+	DevFlags *DevFlagsSpec `json:"devFlags,omitempty"`
+}
+
+// The getters below can be called on a nil ComponentWorkloadSpec, e.g. the one of a component
+// whose spec does not set it, and then return the zero values.
+
+func (w *ComponentWorkloadSpec) GetResourcesOverrides() []ResourcesOverride {
+	if w == nil {
+		return nil
+	}
+
+	return w.Resources
+}
+
+func (w *ComponentWorkloadSpec) GetScheduling() *SchedulingSpec {
+	if w == nil {
+		return nil
+	}
+
+	return w.Scheduling
+}
+
+func (w *ComponentWorkloadSpec) GetUpgradeStrategy() UpgradeStrategy {
+	if w == nil {
+		return ""
+	}
+
+	return w.UpgradeStrategy
+}
+
+func (w *ComponentWorkloadSpec) GetDriftPolicy() DriftPolicy {
+	if w == nil {
+		return ""
+	}
+
+	return w.DriftPolicy
+}
+
+func (w *ComponentWorkloadSpec) GetAdoptionPolicy() AdoptionPolicy {
+	if w == nil {
+		return ""
+	}
+
+	return w.AdoptionPolicy
+}
+
+func (w *ComponentWorkloadSpec) GetReadinessChecks() []ReadinessCheck {
+	if w == nil {
+		return nil
+	}
+
+	return w.ReadinessChecks
+}
+
+func (w *ComponentWorkloadSpec) GetHighAvailability() *HighAvailabilitySpec {
+	if w == nil {
+		return nil
+	}
+
+	return w.HighAvailability
+}
+
+func (w *ComponentWorkloadSpec) GetDevFlags() *DevFlagsSpec {
+	if w == nil {
+		return nil
+	}
+
+	return w.DevFlags
+}
+
 type WithStatus interface {
 	GetStatus() *Status
 }
@@ -146,6 +693,12 @@ type WithReleases interface {
 	SetReleaseStatus(status []ComponentRelease)
 }
 
+// WithComponentWorkload is implemented by the components, returning the workload settings of
+// their spec, or nil when the spec does not hold them.
+type WithComponentWorkload interface {
+	GetWorkloadSpec() *ComponentWorkloadSpec
+}
+
 type PlatformObject interface {
 	client.Object
 	WithStatus
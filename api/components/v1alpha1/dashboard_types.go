@@ -29,13 +29,87 @@ const (
 	DashboardKind         = "Dashboard"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*Dashboard)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*Dashboard)(nil)
+	_ common.WithComponentWorkload = (*Dashboard)(nil)
+)
 
 // DashboardCommonSpec spec defines the shared desired state of Dashboard
 type DashboardCommonSpec struct {
 	// dashboard spec exposed to DSC api
 	// dashboard spec exposed only to internal api
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Branding and features of the dashboard, rendered into the OdhDashboardConfig of the
+	// applications namespace. The other settings of the OdhDashboardConfig are left to the users.
+	// +optional
+	Customization *DashboardCustomizationSpec `json:"customization,omitempty"`
+	// Sources of OdhApplication and OdhDocument resources added to the dashboard catalog, the
+	// resources removed from the sources are pruned.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	CatalogSources []DashboardCatalogSource `json:"catalogSources,omitempty"`
+}
+
+// DashboardCatalogSource is a source of OdhApplication and OdhDocument resources
+// +kubebuilder:validation:XValidation:rule="has(self.configMap) != has(self.url)",message="exactly one of configMap or url must be set"
+type DashboardCatalogSource struct {
+	// Name of a ConfigMap in the applications namespace, each of its keys holding OdhApplication
+	// and OdhDocument resources in YAML. The changes of the ConfigMaps labeled with
+	// opendatahub.io/dashboard-catalog=true are picked up immediately.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+	// HTTPS URL of a YAML file holding OdhApplication and OdhDocument resources, e.g. the raw
+	// content of a file of a Git repository, fetched on each reconciliation of the dashboard
+	// through the cluster proxy.
+	// +kubebuilder:validation:Pattern="^https://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// DashboardFeature is a dashboard feature that can be disabled, hiding its pages and tiles.
+// +kubebuilder:validation:Enum=Home;Info;Support;ClusterManager;Tracking;BYONImageStream;ISVBadges;AppLauncher;UserManagement;Projects;ProjectSharing;ModelServing;CustomServingRuntimes;KServe;ModelMesh;Pipelines;BiasMetrics;PerformanceMetrics;DistributedWorkloads;ModelCatalog;ModelRegistry;StorageClasses
+type DashboardFeature string
+
+// DashboardCustomizationSpec configures the branding and the features of the dashboard
+type DashboardCustomizationSpec struct {
+	// Product name displayed in the dashboard masthead and page titles.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	ProductName string `json:"productName,omitempty"`
+	// URL of the logo displayed in the dashboard masthead.
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	LogoURL string `json:"logoURL,omitempty"`
+	// Links added to the help menu of the dashboard.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DocumentationLinks []DashboardLink `json:"documentationLinks,omitempty"`
+	// Features disabled in the dashboard.
+	// +listType=set
+	// +optional
+	DisabledFeatures []DashboardFeature `json:"disabledFeatures,omitempty"`
+}
+
+// DashboardLink is a link displayed in the dashboard
+type DashboardLink struct {
+	// Label of the link.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// URL of the link.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
 }
 
 // DashboardSpec defines the desired state of Dashboard
@@ -59,7 +133,7 @@ type DashboardStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-dashboard'",message="Dashboard name must be default-dashboard"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-dashboard' || self.metadata.name.startsWith('default-dashboard-')",message="Dashboard name must be default-dashboard, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 // +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`,description="URL"
@@ -85,6 +159,10 @@ func (c *Dashboard) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *Dashboard) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 // +kubebuilder:object:root=true
 
 // DashboardList contains a list of Dashboard
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,13 +32,16 @@ const (
 	AIPipelinesKind = "AIPipelines"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*DataSciencePipelines)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*DataSciencePipelines)(nil)
+	_ common.WithComponentWorkload = (*DataSciencePipelines)(nil)
+)
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-datasciencepipelines'",message="DataSciencePipelines name must be default-datasciencepipelines"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-datasciencepipelines' || self.metadata.name.startsWith('default-datasciencepipelines-')",message="DataSciencePipelines name must be default-datasciencepipelines, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -71,8 +75,84 @@ type ArgoWorkflowsControllersSpec struct {
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=MinIO;External
+type ObjectStorageType string
+
+const (
+	ObjectStorageTypeMinIO    ObjectStorageType = "MinIO"
+	ObjectStorageTypeExternal ObjectStorageType = "External"
+)
+
+// PipelinesObjectStorageSpec configures the default object storage of the pipeline servers
+// +kubebuilder:validation:XValidation:rule="self.type != 'External' || has(self.external)",message="external is required with the External object storage type"
+type PipelinesObjectStorageSpec struct {
+	// Type of the object storage: MinIO deploys an in-cluster MinIO in the applications
+	// namespace, meant for proofs of concept only, External uses an S3 compatible endpoint.
+	// +kubebuilder:validation:Required
+	Type ObjectStorageType `json:"type"`
+	// Bucket storing the pipeline artifacts.
+	// +kubebuilder:default="pipelines"
+	// +kubebuilder:validation:Pattern="^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$"
+	// +optional
+	Bucket string `json:"bucket,omitempty"`
+	// Size of the volume of the in-cluster MinIO.
+	// +kubebuilder:default="10Gi"
+	// +optional
+	StorageSize resource.Quantity `json:"storageSize,omitempty"`
+	// External S3 compatible endpoint, required with the External type.
+	// +optional
+	External *ExternalObjectStorageSpec `json:"external,omitempty"`
+}
+
+// ExternalObjectStorageSpec configures an external S3 compatible endpoint
+type ExternalObjectStorageSpec struct {
+	// Hostname of the S3 compatible endpoint.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Host string `json:"host"`
+	// Port of the endpoint, defaults to 443, or to 80 when TLS is disabled.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Whether the connections to the endpoint use TLS.
+	// +kubebuilder:default=true
+	// +optional
+	Secure *bool `json:"secure,omitempty"`
+	// Region of the bucket.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Secret in the applications namespace holding the S3 credentials.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef ObjectStorageCredentialsSecretRef `json:"credentialsSecretRef"`
+}
+
+// ObjectStorageCredentialsSecretRef references the Secret holding the S3 credentials
+type ObjectStorageCredentialsSecretRef struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+	// Key of the Secret holding the access key.
+	// +kubebuilder:default="AWS_ACCESS_KEY_ID"
+	// +optional
+	AccessKeyKey string `json:"accessKeyKey,omitempty"`
+	// Key of the Secret holding the secret key.
+	// +kubebuilder:default="AWS_SECRET_ACCESS_KEY"
+	// +optional
+	SecretKeyKey string `json:"secretKeyKey,omitempty"`
+}
+
 type DataSciencePipelinesCommonSpec struct {
 	ArgoWorkflowsControllers *ArgoWorkflowsControllersSpec `json:"argoWorkflowsControllers,omitempty"`
+	// Default object storage of the pipeline servers, left to each pipeline server when unset.
+	// +optional
+	ObjectStorage *PipelinesObjectStorageSpec `json:"objectStorage,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
 }
 
 // DataSciencePipelinesCommonStatus defines the shared observed state of DataSciencePipelines
@@ -98,6 +178,10 @@ func (c *DataSciencePipelines) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *DataSciencePipelines) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *DataSciencePipelines) GetReleaseStatus() *[]common.ComponentRelease {
 	return &c.Status.Releases
 }
@@ -34,13 +34,16 @@ const (
 	FeastOperatorKind = "FeastOperator"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*FeastOperator)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*FeastOperator)(nil)
+	_ common.WithComponentWorkload = (*FeastOperator)(nil)
+)
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-feastoperator'",message="FeastOperator name must be 'default-feastoperator'"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-feastoperator' || self.metadata.name.startsWith('default-feastoperator-')",message="FeastOperator name must be default-feastoperator, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -56,6 +59,57 @@ type FeastOperator struct {
 // FeastOperatorCommonSpec defines the common spec shared across APIs for FeastOperator
 type FeastOperatorCommonSpec struct {
 	// Spec fields exposed to the DSC API
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Central feature store provisioned by the component, none when unset.
+	// +optional
+	FeatureStore *FeastFeatureStoreSpec `json:"featureStore,omitempty"`
+}
+
+// FeastFeatureStoreSpec declares the central feature store managed by the component
+type FeastFeatureStoreSpec struct {
+	// Name of the FeatureStore resource.
+	// +kubebuilder:default="default"
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace the feature store is deployed to, defaults to the applications namespace.
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the Feast project of the feature store.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern="^[A-Za-z0-9_]+$"
+	// +kubebuilder:validation:MaxLength=63
+	Project string `json:"project"`
+	// Registry backend of the feature store, a local file registry when unset.
+	// +optional
+	Registry *FeastStoreSpec `json:"registry,omitempty"`
+	// Online store of the feature store, a local SQLite file when unset.
+	// +optional
+	OnlineStore *FeastStoreSpec `json:"onlineStore,omitempty"`
+	// Offline store of the feature store, local Dask files when unset.
+	// +optional
+	OfflineStore *FeastStoreSpec `json:"offlineStore,omitempty"`
+}
+
+// FeastStoreSpec configures a store of the feature store persisted in a database or service
+type FeastStoreSpec struct {
+	// Type of the store as known by Feast, e.g. sql, redis, postgres or snowflake.offline.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Type string `json:"type"`
+	// Name of the Secret in the feature store namespace holding the store configuration.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName"`
+	// Key of the Secret holding the store configuration, defaults to the store type.
+	// +optional
+	SecretKeyName string `json:"secretKeyName,omitempty"`
 }
 
 // FeastOperatorCommonStatus defines the shared observed state of FeastOperator
@@ -87,6 +141,10 @@ func (c *FeastOperator) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *FeastOperator) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 // +kubebuilder:object:root=true
 
 // FeastOperatorList contains a list of FeastOperator objects
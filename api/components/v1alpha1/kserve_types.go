@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -38,8 +39,39 @@ const (
 	KserveRawHeaded   RawServiceConfig = "Headed"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*Kserve)(nil)
+// +kubebuilder:validation:Enum=Serverless;RawDeployment
+type DeploymentMode string
+
+const (
+	DeploymentModeServerless    DeploymentMode = "Serverless"
+	DeploymentModeRawDeployment DeploymentMode = "RawDeployment"
+)
+
+// +kubebuilder:validation:Enum=vllm;ovms;triton;caikit
+type ServingRuntimeName string
+
+const (
+	ServingRuntimeVLLM   ServingRuntimeName = "vllm"
+	ServingRuntimeOVMS   ServingRuntimeName = "ovms"
+	ServingRuntimeTriton ServingRuntimeName = "triton"
+	ServingRuntimeCaikit ServingRuntimeName = "caikit"
+)
+
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*Kserve)(nil)
+	_ common.WithComponentWorkload = (*Kserve)(nil)
+)
+
+// NIMManagementState returns the management state of the NVIDIA NIM integration, set by the
+// nim capability when present or else by the nim field.
+func (s *KserveCommonSpec) NIMManagementState() operatorv1.ManagementState {
+	if s.Capabilities.NIM != nil {
+		return s.Capabilities.NIM.ManagementState
+	}
+
+	return s.NIM.ManagementState
+}
 
 // KserveCommonSpec spec defines the shared desired state of Kserve
 type KserveCommonSpec struct {
@@ -49,8 +81,33 @@ type KserveCommonSpec struct {
 	// Headed: to set "ServiceClusterIPNone = false" in the 'inferenceservice-config' configmap for Kserve.
 	// +kubebuilder:default=Headless
 	RawDeploymentServiceConfig RawServiceConfig `json:"rawDeploymentServiceConfig,omitempty"`
+	// Deployment mode of the InferenceServices not setting one explicitly: RawDeployment
+	// (default) or Serverless, which requires Knative Serving to be installed.
+	// +kubebuilder:default=RawDeployment
+	DefaultDeploymentMode DeploymentMode `json:"defaultDeploymentMode,omitempty"`
+	// Deployment mode overrides for the InferenceServices of the namespaces matching a
+	// selector, the first matching override applies.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	DeploymentModeOverrides []DeploymentModeOverride `json:"deploymentModeOverrides,omitempty"`
+	// Autoscaling defaults of the InferenceServices not setting their own.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
 	// Configures and enables NVIDIA NIM integration
 	NIM NimSpec `json:"nim,omitempty"`
+	// Optional integrations of the component, each reporting its own status.
+	// +optional
+	Capabilities KserveCapabilities `json:"capabilities,omitempty"`
+	// Catalog of the serving runtimes deployed as ClusterServingRuntimes from the runtime
+	// templates shipped with KServe. Runtimes not listed or Removed are not deployed.
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ServingRuntimes []ServingRuntimeSpec `json:"servingRuntimes,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
 }
 
 // nimSpec enables NVIDIA NIM integration
@@ -60,6 +117,117 @@ type NimSpec struct {
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
 }
 
+// DeploymentModeOverride sets the deployment mode of the InferenceServices of a set of namespaces
+type DeploymentModeOverride struct {
+	// Selector of the namespaces the deployment mode applies to.
+	// +kubebuilder:validation:Required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// Deployment mode of the InferenceServices of the matching namespaces.
+	// +kubebuilder:validation:Required
+	DeploymentMode DeploymentMode `json:"deploymentMode"`
+}
+
+// AutoscalingSpec configures the autoscaling defaults of the InferenceServices per deployment mode
+type AutoscalingSpec struct {
+	// Knative autoscaling defaults of the Serverless InferenceServices, set in the
+	// autoscaler configuration of the KnativeServing instance.
+	// +optional
+	Serverless *ServerlessAutoscalingSpec `json:"serverless,omitempty"`
+	// HorizontalPodAutoscaler defaults of the RawDeployment InferenceServices.
+	// +optional
+	RawDeployment *RawDeploymentAutoscalingSpec `json:"rawDeployment,omitempty"`
+}
+
+// ServerlessAutoscalingSpec defines the Knative autoscaling defaults
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.maxReplicas == 0 || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type ServerlessAutoscalingSpec struct {
+	// Target number of in-flight requests per replica.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetConcurrency *int32 `json:"targetConcurrency,omitempty"`
+	// Time the last replica is kept once traffic stops before scaling to zero.
+	// +optional
+	ScaleToZeroGracePeriod *metav1.Duration `json:"scaleToZeroGracePeriod,omitempty"`
+	// Minimum number of replicas, 0 allows scaling to zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Maximum number of replicas, 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// RawDeploymentAutoscalingSpec defines the HorizontalPodAutoscaler defaults
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type RawDeploymentAutoscalingSpec struct {
+	// Minimum number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Maximum number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// Target average CPU utilization of the replicas, in percent of the requested CPU.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
+}
+
+// ServingRuntimeSpec configures a runtime of the serving runtime catalog
+type ServingRuntimeSpec struct {
+	// Name of the runtime in the catalog.
+	// +kubebuilder:validation:Required
+	Name ServingRuntimeName `json:"name"`
+	// Managed deploys the ClusterServingRuntime, Removed prunes it.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Managed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Image of the runtime container, pinning it in place of the one of the template.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Default compute resources of the runtime container, in place of the ones of the template.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// KserveCapabilities enables the optional integrations of KServe
+type KserveCapabilities struct {
+	// NVIDIA NIM integration, taking precedence over the nim field when set.
+	// +optional
+	NIM *NimCapability `json:"nim,omitempty"`
+}
+
+// NimCapability configures the NVIDIA NIM integration
+type NimCapability struct {
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Managed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Name of the Secret in the applications namespace holding the NGC API key under the
+	// api_key key, validated before the integration is reported available.
+	// +optional
+	APIKeySecretName string `json:"apiKeySecretName,omitempty"`
+	// Selector of the namespaces the NIM image pull secret is propagated to, so that NIM
+	// models can be served from them.
+	// +optional
+	PullSecretNamespaceSelector *metav1.LabelSelector `json:"pullSecretNamespaceSelector,omitempty"`
+}
+
+// CapabilityStatus reports the state of an optional integration of the component
+type CapabilityStatus struct {
+	// Name of the capability.
+	Name string `json:"name"`
+	// Management state of the capability.
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Whether the capability is available.
+	Available metav1.ConditionStatus `json:"available,omitempty"`
+	// Reason of the capability not being available.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // KserveSpec defines the desired state of Kserve
@@ -72,6 +240,11 @@ type KserveSpec struct {
 // KserveCommonStatus defines the shared observed state of Kserve
 type KserveCommonStatus struct {
 	common.ComponentReleaseStatus `json:",inline"`
+	// Status of the optional integrations of the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Capabilities []CapabilityStatus `json:"capabilities,omitempty"`
 }
 
 // KserveStatus defines the observed state of Kserve
@@ -83,7 +256,7 @@ type KserveStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-kserve'",message="Kserve name must be default-kserve"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-kserve' || self.metadata.name.startsWith('default-kserve-')",message="Kserve name must be default-kserve, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -108,6 +281,10 @@ func (c *Kserve) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *Kserve) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *Kserve) GetReleaseStatus() *[]common.ComponentRelease {
 	return &c.Status.Releases
 }
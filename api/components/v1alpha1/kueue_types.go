@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,15 +30,18 @@ const (
 	KueueKind         = "Kueue"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*Kueue)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*Kueue)(nil)
+	_ common.WithComponentWorkload = (*Kueue)(nil)
+)
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-kueue'",message="Kueue name must be default-kueue"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-kueue' || self.metadata.name.startsWith('default-kueue-')",message="Kueue name must be default-kueue, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -57,7 +61,9 @@ type KueueSpec struct {
 	KueueDefaultQueueSpec `json:",inline"`
 }
 
-type KueueCommonSpec struct{}
+type KueueCommonSpec struct {
+	common.ComponentWorkloadSpec `json:",inline"`
+}
 
 // KueueCommonStatus defines the shared observed state of Kueue
 type KueueCommonStatus struct {
@@ -94,6 +100,10 @@ func (c *Kueue) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *Kueue) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *Kueue) GetReleaseStatus() *[]common.ComponentRelease { return &c.Status.Releases }
 
 func (c *Kueue) SetReleaseStatus(releases []common.ComponentRelease) {
@@ -122,6 +132,19 @@ type KueueDefaultQueueSpec struct {
 	// Configures the automatically created cluster queue name.
 	// +kubebuilder:default=default
 	DefaultClusterQueueName string `json:"defaultClusterQueueName,omitempty"`
+	// BootstrapDefaultQueues creates the default ResourceFlavors, the default cluster queue and the
+	// default local queue of each managed namespace. When false, the queueing setup is left to the
+	// administrator, the queues previously created are kept.
+	// +optional
+	// +kubebuilder:default=true
+	BootstrapDefaultQueues *bool `json:"bootstrapDefaultQueues,omitempty"`
+	// Quota template of the default cluster queue: the nominal quota of each resource, e.g. cpu,
+	// memory or nvidia.com/gpu. The resources not set are given the allocatable capacity of the
+	// cluster, the resources set which are not found on the nodes are added to the default flavor.
+	// The template is applied when the queue is created, the queue is then left to the administrator.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	DefaultClusterQueueQuota corev1.ResourceList `json:"defaultClusterQueueQuota,omitempty"`
 }
 
 // DSCKueue contains all the configuration exposed in DSC instance for Kueue component
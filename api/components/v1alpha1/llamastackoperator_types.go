@@ -17,14 +17,17 @@ const (
 	LlamaStackOperatorKind = "LlamaStackOperator"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*LlamaStackOperator)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*LlamaStackOperator)(nil)
+	_ common.WithComponentWorkload = (*LlamaStackOperator)(nil)
+)
 
 // default kubebuilder markers for the new component
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-llamastackoperator'",message="LlamaStackOperator name must be default-llamastackoperator"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-llamastackoperator' || self.metadata.name.startsWith('default-llamastackoperator-')",message="LlamaStackOperator name must be default-llamastackoperator, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -39,6 +42,65 @@ type LlamaStackOperator struct {
 
 type LlamaStackOperatorCommonSpec struct {
 	// new component spec exposed to DSC api
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Llama Stack distribution deployed in the applications namespace, none when unset.
+	// +optional
+	Distribution *LlamaStackDistributionSpec `json:"distribution,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=remote-vllm;ollama;together
+type LlamaStackDistributionName string
+
+const (
+	LlamaStackDistributionRemoteVLLM LlamaStackDistributionName = "remote-vllm"
+	LlamaStackDistributionOllama     LlamaStackDistributionName = "ollama"
+	LlamaStackDistributionTogether   LlamaStackDistributionName = "together"
+)
+
+// LlamaStackDistributionSpec selects the Llama Stack distribution and its model provider
+// +kubebuilder:validation:XValidation:rule="self.name == 'together' || has(self.modelProvider.url)",message="modelProvider.url is required for the remote-vllm and ollama distributions"
+// +kubebuilder:validation:XValidation:rule="self.name != 'together' || !has(self.modelProvider.url)",message="modelProvider.url is not supported by the together distribution"
+// +kubebuilder:validation:XValidation:rule="self.name != 'together' || has(self.modelProvider.apiKeySecretRef)",message="modelProvider.apiKeySecretRef is required for the together distribution"
+// +kubebuilder:validation:XValidation:rule="self.name != 'ollama' || !has(self.modelProvider.apiKeySecretRef)",message="modelProvider.apiKeySecretRef is not supported by the ollama distribution"
+type LlamaStackDistributionSpec struct {
+	// Name of the distribution, selecting the inference provider of the Llama Stack server.
+	// +kubebuilder:validation:Required
+	Name LlamaStackDistributionName `json:"name"`
+	// Model provider serving the inference requests of the distribution.
+	// +kubebuilder:validation:Required
+	ModelProvider LlamaStackModelProviderSpec `json:"modelProvider"`
+}
+
+// LlamaStackModelProviderSpec configures the model provider of the Llama Stack distribution
+type LlamaStackModelProviderSpec struct {
+	// URL of the inference endpoint of the model provider, e.g. http://vllm.models.svc:8000/v1,
+	// the together distribution uses the Together AI endpoint.
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Model served by the provider, registered as the inference model of the distribution.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Model string `json:"model"`
+	// Secret in the applications namespace holding the API key of the model provider.
+	// +optional
+	APIKeySecretRef *LlamaStackAPIKeySecretRef `json:"apiKeySecretRef,omitempty"`
+}
+
+// LlamaStackAPIKeySecretRef references the Secret holding the API key of the model provider
+type LlamaStackAPIKeySecretRef struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+	// Key of the Secret holding the API key.
+	// +kubebuilder:default="apiKey"
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // LlamaStackOperatorSpec defines the desired state of LlamaStackOperator
@@ -70,6 +132,10 @@ func (c *LlamaStackOperator) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *LlamaStackOperator) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *LlamaStackOperator) GetReleaseStatus() *[]common.ComponentRelease {
 	return &c.Status.Releases
 }
@@ -29,15 +29,18 @@ const (
 	ModelControllerKind         = "ModelController"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*ModelController)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*ModelController)(nil)
+	_ common.WithComponentWorkload = (*ModelController)(nil)
+)
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-modelcontroller'",message="ModelController name must be default-modelcontroller"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-modelcontroller' || self.metadata.name.startsWith('default-modelcontroller-')",message="ModelController name must be default-modelcontroller, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 // +kubebuilder:printcolumn:name="URI",type=string,JSONPath=`.status.URI`,description="devFlag's URI used to download"
@@ -61,6 +64,8 @@ type ModelControllerSpec struct {
 type ModelControllerKerveSpec struct {
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
 	NIM             NimSpec                    `json:"nim,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
 }
 
 // a mini version of the DSCModelMeshServing only keeps management spec
@@ -100,3 +105,11 @@ func (c *ModelController) GetConditions() []common.Condition {
 func (c *ModelController) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
+
+func (c *ModelController) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	if c.Spec.Kserve == nil {
+		return nil
+	}
+
+	return &c.Spec.Kserve.ComponentWorkloadSpec
+}
@@ -29,8 +29,11 @@ const (
 	ModelRegistryKind         = "ModelRegistry"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*ModelRegistry)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*ModelRegistry)(nil)
+	_ common.WithComponentWorkload = (*ModelRegistry)(nil)
+)
 
 // ModelRegistrySpec defines the desired state of ModelRegistry
 type ModelRegistrySpec struct {
@@ -39,9 +42,140 @@ type ModelRegistrySpec struct {
 	//  model registry spec exposed only to internal api
 }
 
+// +kubebuilder:validation:Enum=mysql;postgres
+type DatabaseType string
+
+const (
+	DatabaseTypeMySQL    DatabaseType = "mysql"
+	DatabaseTypePostgres DatabaseType = "postgres"
+)
+
+// +kubebuilder:validation:Enum=disable;require;verify-ca;verify-full
+type DatabaseSSLMode string
+
+const (
+	DatabaseSSLModeDisable    DatabaseSSLMode = "disable"
+	DatabaseSSLModeRequire    DatabaseSSLMode = "require"
+	DatabaseSSLModeVerifyCA   DatabaseSSLMode = "verify-ca"
+	DatabaseSSLModeVerifyFull DatabaseSSLMode = "verify-full"
+)
+
+// ModelRegistryDatabaseSpec configures the external database used by the model registries
+type ModelRegistryDatabaseSpec struct {
+	// Type of the database server.
+	// +kubebuilder:validation:Required
+	Type DatabaseType `json:"type"`
+	// Hostname or IP address of the database server.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Host string `json:"host"`
+	// Port of the database server, defaults to 3306 for mysql and 5432 for postgres.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Name of the database holding the model registries metadata.
+	// +kubebuilder:default="model_registry"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+	// Secret in the registries namespace holding the database credentials.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef DatabaseCredentialsSecretRef `json:"credentialsSecretRef"`
+	// TLS settings of the connections to the database server.
+	// +optional
+	TLS *DatabaseTLSSpec `json:"tls,omitempty"`
+}
+
+// DatabaseCredentialsSecretRef references the Secret holding the database credentials
+type DatabaseCredentialsSecretRef struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+	// Key of the Secret holding the database user name.
+	// +kubebuilder:default="username"
+	// +optional
+	UsernameKey string `json:"usernameKey,omitempty"`
+	// Key of the Secret holding the database password.
+	// +kubebuilder:default="password"
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
+// DatabaseTLSSpec configures the TLS connections to the database server
+// +kubebuilder:validation:XValidation:rule="!has(self.sslMode) || !(self.sslMode in ['verify-ca', 'verify-full']) || has(self.caBundleConfigMapName)",message="caBundleConfigMapName is required to verify the server certificate"
+type DatabaseTLSSpec struct {
+	// SSL mode of the connections, verify-ca and verify-full check the server certificate
+	// against the CA bundle.
+	// +kubebuilder:default=require
+	// +optional
+	SSLMode DatabaseSSLMode `json:"sslMode,omitempty"`
+	// Name of a ConfigMap in the registries namespace holding the CA bundle in its ca.crt key.
+	// +optional
+	CABundleConfigMapName string `json:"caBundleConfigMapName,omitempty"`
+}
+
+// ModelRegistryInstanceSpec declares a model registry instance managed by the component
+type ModelRegistryInstanceSpec struct {
+	// Name of the model registry.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Namespace the model registry is deployed to, defaults to the registries namespace.
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Database of the model registry, defaults to the component database, or to a bundled
+	// instance when none is set.
+	// +optional
+	Database *ModelRegistryDatabaseSpec `json:"database,omitempty"`
+	// Istio service mesh configuration of the model registry, not part of the mesh when unset.
+	// +optional
+	Istio *ModelRegistryIstioSpec `json:"istio,omitempty"`
+}
+
+// ModelRegistryIstioSpec configures the Istio service mesh integration of a model registry
+type ModelRegistryIstioSpec struct {
+	// Name of the Authorino authorization provider of the model registry endpoints.
+	// +optional
+	AuthProvider string `json:"authProvider,omitempty"`
+	// Istio ingress gateway exposing the model registry outside the cluster, only reachable
+	// from inside the mesh when unset.
+	// +optional
+	Gateway *ModelRegistryGatewaySpec `json:"gateway,omitempty"`
+}
+
+// ModelRegistryGatewaySpec configures the Istio ingress gateway of a model registry
+type ModelRegistryGatewaySpec struct {
+	// Domain the model registry is exposed on, defaults to the gateway domain of the cluster.
+	// +optional
+	Domain string `json:"domain,omitempty"`
+	// Name of the Secret in the Istio ingress namespace holding the TLS certificate of the
+	// model registry host.
+	// +optional
+	CredentialName string `json:"credentialName,omitempty"`
+}
+
+// ModelRegistryInstanceStatus reports the readiness of a model registry instance
+type ModelRegistryInstanceStatus struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Whether the model registry is available, as reported by its Available condition.
+	Ready   metav1.ConditionStatus `json:"ready"`
+	Message string                 `json:"message,omitempty"`
+}
+
 // ModelRegistryCommonStatus defines the shared observed state of ModelRegistry
 type ModelRegistryCommonStatus struct {
-	RegistriesNamespace           string `json:"registriesNamespace,omitempty"`
+	RegistriesNamespace string `json:"registriesNamespace,omitempty"`
+	// Readiness of the model registry instances managed by the component.
+	// +listType=atomic
+	Registries                    []ModelRegistryInstanceStatus `json:"registries,omitempty"`
 	common.ComponentReleaseStatus `json:",inline"`
 }
 
@@ -54,7 +188,7 @@ type ModelRegistryStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-modelregistry'",message="ModelRegistry name must be default-modelregistry"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-modelregistry' || self.metadata.name.startsWith('default-modelregistry-')",message="ModelRegistry name must be default-modelregistry, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -79,6 +213,10 @@ func (c *ModelRegistry) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *ModelRegistry) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *ModelRegistry) GetReleaseStatus() *[]common.ComponentRelease {
 	return &c.Status.Releases
 }
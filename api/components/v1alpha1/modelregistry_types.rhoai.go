@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
 // ModelRegistryCommonSpec spec defines the shared desired state of ModelRegistry
 type ModelRegistryCommonSpec struct {
 	// Namespace for model registries to be installed, configurable only once when model registry is enabled, defaults to "rhoai-model-registries"
@@ -25,4 +29,16 @@ type ModelRegistryCommonSpec struct {
 	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
 	// +kubebuilder:validation:MaxLength=63
 	RegistriesNamespace string `json:"registriesNamespace,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// External database used by the model registries instead of the bundled instance.
+	// +optional
+	Database *ModelRegistryDatabaseSpec `json:"database,omitempty"`
+	// Model registry instances managed by the component, each one reconciled independently.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Registries []ModelRegistryInstanceSpec `json:"registries,omitempty"`
 }
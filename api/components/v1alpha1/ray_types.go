@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,15 +29,18 @@ const (
 	RayKind         = "Ray"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*Ray)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*Ray)(nil)
+	_ common.WithComponentWorkload = (*Ray)(nil)
+)
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-ray'",message="Ray name must be default-ray"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-ray' || self.metadata.name.startsWith('default-ray-')",message="Ray name must be default-ray, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -54,7 +58,44 @@ type RaySpec struct {
 	RayCommonSpec `json:",inline"`
 }
 
-type RayCommonSpec struct{}
+type RayCommonSpec struct {
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Defaults and security policy of the RayClusters created in the data science projects.
+	// +optional
+	ClusterDefaults *RayClusterDefaultsSpec `json:"clusterDefaults,omitempty"`
+}
+
+// RayPodSecurity is the pod security level the pods of the RayClusters must comply with.
+// +kubebuilder:validation:Enum=Restricted;Baseline
+type RayPodSecurity string
+
+const (
+	// RayPodSecurityRestricted requires the containers to run as non root, without privilege
+	// escalation and with all the capabilities dropped.
+	RayPodSecurityRestricted RayPodSecurity = "Restricted"
+	// RayPodSecurityBaseline forbids the privileged containers.
+	RayPodSecurityBaseline RayPodSecurity = "Baseline"
+)
+
+// RayClusterDefaultsSpec defines the defaults of the RayClusters created in the data science
+// projects, published in the ray-cluster-defaults ConfigMap of the applications namespace and
+// enforced by a ValidatingAdmissionPolicy.
+type RayClusterDefaultsSpec struct {
+	// Pod security level the pods of the RayClusters must comply with. Unset, the pods are not checked.
+	// +optional
+	PodSecurity RayPodSecurity `json:"podSecurity,omitempty"`
+	// Require TLS between the nodes of the RayClusters, enabled by the RAY_USE_TLS environment
+	// variable of their containers.
+	// +optional
+	EnableTLS bool `json:"enableTLS,omitempty"`
+	// Quota template of the data science projects: the hard limits of the ResourceQuota created in
+	// each namespace labelled opendatahub.io/dashboard=true. The ResourceQuota is created once, it
+	// is then left to the administrator of the project.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	ProjectQuota corev1.ResourceList `json:"projectQuota,omitempty"`
+}
 
 // RayCommonStatus defines the shared observed state of Ray
 type RayCommonStatus struct {
@@ -91,6 +132,10 @@ func (c *Ray) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *Ray) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *Ray) GetReleaseStatus() *[]common.ComponentRelease { return &c.Status.Releases }
 
 func (c *Ray) SetReleaseStatus(releases []common.ComponentRelease) {
@@ -28,15 +28,18 @@ const (
 	TrainingOperatorKind         = "TrainingOperator"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*TrainingOperator)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*TrainingOperator)(nil)
+	_ common.WithComponentWorkload = (*TrainingOperator)(nil)
+)
 
 // NOTE: json tags are required. Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-trainingoperator'",message="TrainingOperator name must be default-trainingoperator"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-trainingoperator' || self.metadata.name.startsWith('default-trainingoperator-')",message="TrainingOperator name must be default-trainingoperator, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -54,7 +57,43 @@ type TrainingOperatorSpec struct {
 	TrainingOperatorCommonSpec `json:",inline"`
 }
 
-type TrainingOperatorCommonSpec struct{}
+type TrainingOperatorCommonSpec struct {
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Defaults of the training jobs, such as the PyTorchJobs, rendered in the configuration of the training operator.
+	// +optional
+	JobDefaults *TrainingJobDefaultsSpec `json:"jobDefaults,omitempty"`
+}
+
+// GangScheduler is the scheduler gang scheduling the pods of the training jobs.
+// +kubebuilder:validation:Enum=None;Kueue;Volcano
+type GangScheduler string
+
+const (
+	// GangSchedulerNone schedules the pods of the training jobs one by one with the default scheduler.
+	GangSchedulerNone GangScheduler = "None"
+	// GangSchedulerKueue admits all the pods of the training jobs at once through Kueue.
+	GangSchedulerKueue GangScheduler = "Kueue"
+	// GangSchedulerVolcano schedules the pods of the training jobs as Volcano PodGroups.
+	GangSchedulerVolcano GangScheduler = "Volcano"
+)
+
+// TrainingJobDefaultsSpec defines the defaults of the training jobs, applied by the training
+// operator to the jobs not setting them.
+type TrainingJobDefaultsSpec struct {
+	// Gang scheduler of the pods of the training jobs.
+	// +optional
+	// +kubebuilder:default=None
+	GangScheduler GangScheduler `json:"gangScheduler,omitempty"`
+	// Number of retries before marking a training job as failed.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// PriorityClass of the pods of the training jobs.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
 
 // TrainingOperatorCommonStatus defines the shared observed state of TrainingOperator
 type TrainingOperatorCommonStatus struct {
@@ -91,6 +130,10 @@ func (c *TrainingOperator) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *TrainingOperator) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *TrainingOperator) GetReleaseStatus() *[]common.ComponentRelease {
 	return &c.Status.Releases
 }
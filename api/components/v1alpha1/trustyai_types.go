@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,15 +29,18 @@ const (
 	TrustyAIKind         = "TrustyAI"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*TrustyAI)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*TrustyAI)(nil)
+	_ common.WithComponentWorkload = (*TrustyAI)(nil)
+)
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-trustyai'",message="TrustyAI name must be default-trustyai"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-trustyai' || self.metadata.name.startsWith('default-trustyai-')",message="TrustyAI name must be default-trustyai, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -72,9 +76,58 @@ type TrustyAILMEvalSpec struct {
 	PermitOnline string `json:"permitOnline,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=PVC;Database
+type TrustyAIStorageFormat string
+
+const (
+	TrustyAIStorageFormatPVC      TrustyAIStorageFormat = "PVC"
+	TrustyAIStorageFormatDatabase TrustyAIStorageFormat = "Database"
+)
+
+// TrustyAIStorageSpec defines the storage of the inference data, explanations and fairness
+// metrics of the TrustyAI services
+// +kubebuilder:validation:XValidation:rule="self.format != 'Database' || has(self.databaseConfigurations)",message="databaseConfigurations is required with the Database storage format"
+type TrustyAIStorageSpec struct {
+	// Format of the storage: PVC stores the data on a volume of each TrustyAI service,
+	// Database in an external database.
+	// +kubebuilder:default=PVC
+	// +optional
+	Format TrustyAIStorageFormat `json:"format,omitempty"`
+	// Size of the volume of each TrustyAI service, with the PVC format.
+	// +kubebuilder:default="1Gi"
+	// +optional
+	Size resource.Quantity `json:"size,omitempty"`
+	// Name of the Secret holding the database connection settings, in the namespace of each
+	// TrustyAI service, with the Database format.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	DatabaseConfigurations string `json:"databaseConfigurations,omitempty"`
+	// Retention policy of the stored data, kept forever when unset.
+	// +optional
+	Retention *TrustyAIRetentionSpec `json:"retention,omitempty"`
+}
+
+// TrustyAIRetentionSpec bounds the data stored by the TrustyAI services
+type TrustyAIRetentionSpec struct {
+	// Number of days the inference data and metrics are kept.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAgeDays int32 `json:"maxAgeDays,omitempty"`
+	// Maximum number of inferences kept per model, the oldest are dropped first.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInferences int64 `json:"maxInferences,omitempty"`
+}
+
 type TrustyAICommonSpec struct {
 	// Eval configuration for TrustyAI evaluations
 	Eval TrustyAIEvalSpec `json:"eval,omitempty"`
+	// Storage of the inference data, explanations and fairness metrics of the TrustyAI
+	// services, so they survive restarts.
+	// +optional
+	Storage *TrustyAIStorageSpec `json:"storage,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
 }
 
 // TrustyAICommonStatus defines the shared observed state of TrustyAI
@@ -112,6 +165,10 @@ func (c *TrustyAI) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *TrustyAI) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *TrustyAI) GetReleaseStatus() *[]common.ComponentRelease { return &c.Status.Releases }
 
 func (c *TrustyAI) SetReleaseStatus(releases []common.ComponentRelease) {
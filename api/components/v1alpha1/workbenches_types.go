@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,8 +30,11 @@ const (
 	WorkbenchesKind         = "Workbenches"
 )
 
-// Check that the component implements common.PlatformObject.
-var _ common.PlatformObject = (*Workbenches)(nil)
+// Check that the component implements common.PlatformObject and common.WithComponentWorkload.
+var (
+	_ common.PlatformObject        = (*Workbenches)(nil)
+	_ common.WithComponentWorkload = (*Workbenches)(nil)
+)
 
 // WorkbenchesSpec defines the desired state of Workbenches
 type WorkbenchesSpec struct {
@@ -39,6 +43,86 @@ type WorkbenchesSpec struct {
 	// workbenches spec exposed only to internal api
 }
 
+// NotebookImagesSpec configures the notebook images offered in the workbench image picker
+type NotebookImagesSpec struct {
+	// Overrides of the out-of-the-box notebook images, matched by ImageStream name.
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Overrides []NotebookImageOverride `json:"overrides,omitempty"`
+	// Custom notebook images registered alongside the out-of-the-box ones.
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Custom []CustomNotebookImage `json:"custom,omitempty"`
+}
+
+// NotebookImageOverride enables, disables or pins an out-of-the-box notebook image
+// +kubebuilder:validation:XValidation:rule="!has(self.digest) || has(self.tag)",message="digest requires tag to be set"
+type NotebookImageOverride struct {
+	// Name of the ImageStream of the notebook image.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+	// Set to Removed to remove the notebook image from the picker.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Managed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Tag of the notebook image offered in the picker, the other tags are removed.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+	// Digest the tag is pinned to, replacing the image reference shipped with the manifests.
+	// +kubebuilder:validation:Pattern="^sha256:[a-f0-9]{64}$"
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// CustomNotebookImage registers a custom notebook image in the workbench image picker
+type CustomNotebookImage struct {
+	// Name of the ImageStream of the notebook image.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Name of the notebook image displayed in the picker.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DisplayName string `json:"displayName"`
+	// Description of the notebook image displayed in the picker.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Reference of the container image, by tag or digest.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Tag of the ImageStream pointing to the container image.
+	// +kubebuilder:default="latest"
+	// +optional
+	Tag string `json:"tag,omitempty"`
+}
+
+// NotebookCullingSpec configures the culling of the idle notebooks
+// +kubebuilder:validation:XValidation:rule="!has(self.idleTimeout) || duration(self.idleTimeout) >= duration('1m')",message="idleTimeout must be at least 1m"
+// +kubebuilder:validation:XValidation:rule="!has(self.checkInterval) || duration(self.checkInterval) >= duration('1m')",message="checkInterval must be at least 1m"
+type NotebookCullingSpec struct {
+	// Set to Managed to stop the notebooks idle for longer than the idle timeout, Removed
+	// keeps them running.
+	// +kubebuilder:validation:Enum=Managed;Removed
+	// +kubebuilder:default=Managed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Idle time after which a notebook is stopped, rounded down to the minute.
+	// +kubebuilder:default="24h"
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// Interval between two checks of the notebooks idleness, rounded down to the minute.
+	// +kubebuilder:default="1m"
+	// +optional
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
 // WorkbenchesCommonStatus defines the shared observed state of Workbenches
 type WorkbenchesCommonStatus struct {
 	common.ComponentReleaseStatus `json:",inline"`
@@ -54,7 +138,7 @@ type WorkbenchesStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
-// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-workbenches'",message="Workbenches name must be default-workbenches"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-workbenches' || self.metadata.name.startsWith('default-workbenches-')",message="Workbenches name must be default-workbenches, suffixed with the applications namespace of a scoped DataScienceCluster"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 
@@ -79,6 +163,10 @@ func (c *Workbenches) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *Workbenches) GetWorkloadSpec() *common.ComponentWorkloadSpec {
+	return &c.Spec.ComponentWorkloadSpec
+}
+
 func (c *Workbenches) GetReleaseStatus() *[]common.ComponentRelease { return &c.Status.Releases }
 
 func (c *Workbenches) SetReleaseStatus(releases []common.ComponentRelease) {
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
 type WorkbenchesCommonSpec struct {
 	// workbenches spec exposed only to internal api
 
@@ -27,4 +31,13 @@ type WorkbenchesCommonSpec struct {
 	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
 	// +kubebuilder:validation:MaxLength=63
 	WorkbenchNamespace string `json:"workbenchNamespace,omitempty"`
+
+	common.ComponentWorkloadSpec `json:",inline"`
+
+	// Notebook images offered in the workbench image picker of the dashboard.
+	// +optional
+	NotebookImages *NotebookImagesSpec `json:"notebookImages,omitempty"`
+	// Culling of the idle notebooks, left to the settings made in the dashboard when unset.
+	// +optional
+	Culling *NotebookCullingSpec `json:"culling,omitempty"`
 }
@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -39,6 +41,46 @@ func (in *ArgoWorkflowsControllersSpec) DeepCopy() *ArgoWorkflowsControllersSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+	if in.Serverless != nil {
+		in, out := &in.Serverless, &out.Serverless
+		*out = new(ServerlessAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawDeployment != nil {
+		in, out := &in.RawDeployment, &out.RawDeployment
+		*out = new(RawDeploymentAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapabilityStatus) DeepCopyInto(out *CapabilityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapabilityStatus.
+func (in *CapabilityStatus) DeepCopy() *CapabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CapabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CodeFlareCommonStatus) DeepCopyInto(out *CodeFlareCommonStatus) {
 	*out = *in
@@ -55,6 +97,21 @@ func (in *CodeFlareCommonStatus) DeepCopy() *CodeFlareCommonStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomNotebookImage) DeepCopyInto(out *CustomNotebookImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomNotebookImage.
+func (in *CustomNotebookImage) DeepCopy() *CustomNotebookImage {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomNotebookImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DSCCodeFlare) DeepCopyInto(out *DSCCodeFlare) {
 	*out = *in
@@ -96,7 +153,7 @@ func (in *DSCCodeFlareStatus) DeepCopy() *DSCCodeFlareStatus {
 func (in *DSCDashboard) DeepCopyInto(out *DSCDashboard) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.DashboardCommonSpec = in.DashboardCommonSpec
+	in.DashboardCommonSpec.DeepCopyInto(&out.DashboardCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCDashboard.
@@ -172,7 +229,7 @@ func (in *DSCDataSciencePipelinesStatus) DeepCopy() *DSCDataSciencePipelinesStat
 func (in *DSCFeastOperator) DeepCopyInto(out *DSCFeastOperator) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.FeastOperatorCommonSpec = in.FeastOperatorCommonSpec
+	in.FeastOperatorCommonSpec.DeepCopyInto(&out.FeastOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCFeastOperator.
@@ -210,7 +267,7 @@ func (in *DSCFeastOperatorStatus) DeepCopy() *DSCFeastOperatorStatus {
 func (in *DSCKserve) DeepCopyInto(out *DSCKserve) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.KserveCommonSpec = in.KserveCommonSpec
+	in.KserveCommonSpec.DeepCopyInto(&out.KserveCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCKserve.
@@ -248,8 +305,8 @@ func (in *DSCKserveStatus) DeepCopy() *DSCKserveStatus {
 func (in *DSCKueue) DeepCopyInto(out *DSCKueue) {
 	*out = *in
 	out.KueueManagementSpec = in.KueueManagementSpec
-	out.KueueCommonSpec = in.KueueCommonSpec
-	out.KueueDefaultQueueSpec = in.KueueDefaultQueueSpec
+	in.KueueCommonSpec.DeepCopyInto(&out.KueueCommonSpec)
+	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCKueue.
@@ -287,7 +344,7 @@ func (in *DSCKueueStatus) DeepCopy() *DSCKueueStatus {
 func (in *DSCLlamaStackOperator) DeepCopyInto(out *DSCLlamaStackOperator) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.LlamaStackOperatorCommonSpec = in.LlamaStackOperatorCommonSpec
+	in.LlamaStackOperatorCommonSpec.DeepCopyInto(&out.LlamaStackOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCLlamaStackOperator.
@@ -362,7 +419,7 @@ func (in *DSCModelMeshServingStatus) DeepCopy() *DSCModelMeshServingStatus {
 func (in *DSCModelRegistry) DeepCopyInto(out *DSCModelRegistry) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.ModelRegistryCommonSpec = in.ModelRegistryCommonSpec
+	in.ModelRegistryCommonSpec.DeepCopyInto(&out.ModelRegistryCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCModelRegistry.
@@ -400,7 +457,7 @@ func (in *DSCModelRegistryStatus) DeepCopy() *DSCModelRegistryStatus {
 func (in *DSCRay) DeepCopyInto(out *DSCRay) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.RayCommonSpec = in.RayCommonSpec
+	in.RayCommonSpec.DeepCopyInto(&out.RayCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCRay.
@@ -438,7 +495,7 @@ func (in *DSCRayStatus) DeepCopy() *DSCRayStatus {
 func (in *DSCTrainingOperator) DeepCopyInto(out *DSCTrainingOperator) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.TrainingOperatorCommonSpec = in.TrainingOperatorCommonSpec
+	in.TrainingOperatorCommonSpec.DeepCopyInto(&out.TrainingOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCTrainingOperator.
@@ -476,7 +533,7 @@ func (in *DSCTrainingOperatorStatus) DeepCopy() *DSCTrainingOperatorStatus {
 func (in *DSCTrustyAI) DeepCopyInto(out *DSCTrustyAI) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.TrustyAICommonSpec = in.TrustyAICommonSpec
+	in.TrustyAICommonSpec.DeepCopyInto(&out.TrustyAICommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCTrustyAI.
@@ -514,7 +571,7 @@ func (in *DSCTrustyAIStatus) DeepCopy() *DSCTrustyAIStatus {
 func (in *DSCWorkbenches) DeepCopyInto(out *DSCWorkbenches) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.WorkbenchesCommonSpec = in.WorkbenchesCommonSpec
+	in.WorkbenchesCommonSpec.DeepCopyInto(&out.WorkbenchesCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCWorkbenches.
@@ -553,7 +610,7 @@ func (in *Dashboard) DeepCopyInto(out *Dashboard) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -575,9 +632,35 @@ func (in *Dashboard) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardCatalogSource) DeepCopyInto(out *DashboardCatalogSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCatalogSource.
+func (in *DashboardCatalogSource) DeepCopy() *DashboardCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardCommonSpec) DeepCopyInto(out *DashboardCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.Customization != nil {
+		in, out := &in.Customization, &out.Customization
+		*out = new(DashboardCustomizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogSources != nil {
+		in, out := &in.CatalogSources, &out.CatalogSources
+		*out = make([]DashboardCatalogSource, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCommonSpec.
@@ -605,6 +688,46 @@ func (in *DashboardCommonStatus) DeepCopy() *DashboardCommonStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardCustomizationSpec) DeepCopyInto(out *DashboardCustomizationSpec) {
+	*out = *in
+	if in.DocumentationLinks != nil {
+		in, out := &in.DocumentationLinks, &out.DocumentationLinks
+		*out = make([]DashboardLink, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisabledFeatures != nil {
+		in, out := &in.DisabledFeatures, &out.DisabledFeatures
+		*out = make([]DashboardFeature, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardCustomizationSpec.
+func (in *DashboardCustomizationSpec) DeepCopy() *DashboardCustomizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardCustomizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardLink) DeepCopyInto(out *DashboardLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardLink.
+func (in *DashboardLink) DeepCopy() *DashboardLink {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardList) DeepCopyInto(out *DashboardList) {
 	*out = *in
@@ -640,7 +763,7 @@ func (in *DashboardList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
 	*out = *in
-	out.DashboardCommonSpec = in.DashboardCommonSpec
+	in.DashboardCommonSpec.DeepCopyInto(&out.DashboardCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
@@ -705,6 +828,12 @@ func (in *DataSciencePipelinesCommonSpec) DeepCopyInto(out *DataSciencePipelines
 		*out = new(ArgoWorkflowsControllersSpec)
 		**out = **in
 	}
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(PipelinesObjectStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSciencePipelinesCommonSpec.
@@ -798,12 +927,109 @@ func (in *DataSciencePipelinesStatus) DeepCopy() *DataSciencePipelinesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseCredentialsSecretRef) DeepCopyInto(out *DatabaseCredentialsSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseCredentialsSecretRef.
+func (in *DatabaseCredentialsSecretRef) DeepCopy() *DatabaseCredentialsSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseCredentialsSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseTLSSpec) DeepCopyInto(out *DatabaseTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseTLSSpec.
+func (in *DatabaseTLSSpec) DeepCopy() *DatabaseTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentModeOverride) DeepCopyInto(out *DeploymentModeOverride) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentModeOverride.
+func (in *DeploymentModeOverride) DeepCopy() *DeploymentModeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentModeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalObjectStorageSpec) DeepCopyInto(out *ExternalObjectStorageSpec) {
+	*out = *in
+	if in.Secure != nil {
+		in, out := &in.Secure, &out.Secure
+		*out = new(bool)
+		**out = **in
+	}
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalObjectStorageSpec.
+func (in *ExternalObjectStorageSpec) DeepCopy() *ExternalObjectStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalObjectStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeastFeatureStoreSpec) DeepCopyInto(out *FeastFeatureStoreSpec) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(FeastStoreSpec)
+		**out = **in
+	}
+	if in.OnlineStore != nil {
+		in, out := &in.OnlineStore, &out.OnlineStore
+		*out = new(FeastStoreSpec)
+		**out = **in
+	}
+	if in.OfflineStore != nil {
+		in, out := &in.OfflineStore, &out.OfflineStore
+		*out = new(FeastStoreSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeastFeatureStoreSpec.
+func (in *FeastFeatureStoreSpec) DeepCopy() *FeastFeatureStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeastFeatureStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeastOperator) DeepCopyInto(out *FeastOperator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -828,6 +1054,12 @@ func (in *FeastOperator) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeastOperatorCommonSpec) DeepCopyInto(out *FeastOperatorCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.FeatureStore != nil {
+		in, out := &in.FeatureStore, &out.FeatureStore
+		*out = new(FeastFeatureStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeastOperatorCommonSpec.
@@ -891,7 +1123,7 @@ func (in *FeastOperatorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeastOperatorSpec) DeepCopyInto(out *FeastOperatorSpec) {
 	*out = *in
-	out.FeastOperatorCommonSpec = in.FeastOperatorCommonSpec
+	in.FeastOperatorCommonSpec.DeepCopyInto(&out.FeastOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeastOperatorSpec.
@@ -921,12 +1153,27 @@ func (in *FeastOperatorStatus) DeepCopy() *FeastOperatorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeastStoreSpec) DeepCopyInto(out *FeastStoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeastStoreSpec.
+func (in *FeastStoreSpec) DeepCopy() *FeastStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeastStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kserve) DeepCopyInto(out *Kserve) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -948,10 +1195,51 @@ func (in *Kserve) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KserveCapabilities) DeepCopyInto(out *KserveCapabilities) {
+	*out = *in
+	if in.NIM != nil {
+		in, out := &in.NIM, &out.NIM
+		*out = new(NimCapability)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveCapabilities.
+func (in *KserveCapabilities) DeepCopy() *KserveCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(KserveCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KserveCommonSpec) DeepCopyInto(out *KserveCommonSpec) {
 	*out = *in
+	if in.DeploymentModeOverrides != nil {
+		in, out := &in.DeploymentModeOverrides, &out.DeploymentModeOverrides
+		*out = make([]DeploymentModeOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.NIM = in.NIM
+	in.Capabilities.DeepCopyInto(&out.Capabilities)
+	if in.ServingRuntimes != nil {
+		in, out := &in.ServingRuntimes, &out.ServingRuntimes
+		*out = make([]ServingRuntimeSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveCommonSpec.
@@ -968,6 +1256,11 @@ func (in *KserveCommonSpec) DeepCopy() *KserveCommonSpec {
 func (in *KserveCommonStatus) DeepCopyInto(out *KserveCommonStatus) {
 	*out = *in
 	in.ComponentReleaseStatus.DeepCopyInto(&out.ComponentReleaseStatus)
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]CapabilityStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveCommonStatus.
@@ -1015,7 +1308,7 @@ func (in *KserveList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KserveSpec) DeepCopyInto(out *KserveSpec) {
 	*out = *in
-	out.KserveCommonSpec = in.KserveCommonSpec
+	in.KserveCommonSpec.DeepCopyInto(&out.KserveCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveSpec.
@@ -1050,7 +1343,7 @@ func (in *Kueue) DeepCopyInto(out *Kueue) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1075,6 +1368,7 @@ func (in *Kueue) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueCommonSpec) DeepCopyInto(out *KueueCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueCommonSpec.
@@ -1106,6 +1400,18 @@ func (in *KueueCommonStatus) DeepCopy() *KueueCommonStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KueueDefaultQueueSpec) DeepCopyInto(out *KueueDefaultQueueSpec) {
 	*out = *in
+	if in.BootstrapDefaultQueues != nil {
+		in, out := &in.BootstrapDefaultQueues, &out.BootstrapDefaultQueues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultClusterQueueQuota != nil {
+		in, out := &in.DefaultClusterQueueQuota, &out.DefaultClusterQueueQuota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueDefaultQueueSpec.
@@ -1169,8 +1475,8 @@ func (in *KueueManagementSpec) DeepCopy() *KueueManagementSpec {
 func (in *KueueSpec) DeepCopyInto(out *KueueSpec) {
 	*out = *in
 	out.KueueManagementSpec = in.KueueManagementSpec
-	out.KueueCommonSpec = in.KueueCommonSpec
-	out.KueueDefaultQueueSpec = in.KueueDefaultQueueSpec
+	in.KueueCommonSpec.DeepCopyInto(&out.KueueCommonSpec)
+	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueSpec.
@@ -1200,12 +1506,63 @@ func (in *KueueStatus) DeepCopy() *KueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LlamaStackAPIKeySecretRef) DeepCopyInto(out *LlamaStackAPIKeySecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackAPIKeySecretRef.
+func (in *LlamaStackAPIKeySecretRef) DeepCopy() *LlamaStackAPIKeySecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(LlamaStackAPIKeySecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LlamaStackDistributionSpec) DeepCopyInto(out *LlamaStackDistributionSpec) {
+	*out = *in
+	in.ModelProvider.DeepCopyInto(&out.ModelProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackDistributionSpec.
+func (in *LlamaStackDistributionSpec) DeepCopy() *LlamaStackDistributionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LlamaStackDistributionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LlamaStackModelProviderSpec) DeepCopyInto(out *LlamaStackModelProviderSpec) {
+	*out = *in
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(LlamaStackAPIKeySecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackModelProviderSpec.
+func (in *LlamaStackModelProviderSpec) DeepCopy() *LlamaStackModelProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LlamaStackModelProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LlamaStackOperator) DeepCopyInto(out *LlamaStackOperator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1230,6 +1587,12 @@ func (in *LlamaStackOperator) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LlamaStackOperatorCommonSpec) DeepCopyInto(out *LlamaStackOperatorCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(LlamaStackDistributionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackOperatorCommonSpec.
@@ -1293,7 +1656,7 @@ func (in *LlamaStackOperatorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LlamaStackOperatorSpec) DeepCopyInto(out *LlamaStackOperatorSpec) {
 	*out = *in
-	out.LlamaStackOperatorCommonSpec = in.LlamaStackOperatorCommonSpec
+	in.LlamaStackOperatorCommonSpec.DeepCopyInto(&out.LlamaStackOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackOperatorSpec.
@@ -1354,6 +1717,7 @@ func (in *ModelController) DeepCopyObject() runtime.Object {
 func (in *ModelControllerKerveSpec) DeepCopyInto(out *ModelControllerKerveSpec) {
 	*out = *in
 	out.NIM = in.NIM
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelControllerKerveSpec.
@@ -1434,7 +1798,7 @@ func (in *ModelControllerSpec) DeepCopyInto(out *ModelControllerSpec) {
 	if in.Kserve != nil {
 		in, out := &in.Kserve, &out.Kserve
 		*out = new(ModelControllerKerveSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ModelRegistry != nil {
 		in, out := &in.ModelRegistry, &out.ModelRegistry
@@ -1490,7 +1854,7 @@ func (in *ModelRegistry) DeepCopyInto(out *ModelRegistry) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1515,6 +1879,19 @@ func (in *ModelRegistry) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelRegistryCommonSpec) DeepCopyInto(out *ModelRegistryCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(ModelRegistryDatabaseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]ModelRegistryInstanceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryCommonSpec.
@@ -1530,6 +1907,11 @@ func (in *ModelRegistryCommonSpec) DeepCopy() *ModelRegistryCommonSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelRegistryCommonStatus) DeepCopyInto(out *ModelRegistryCommonStatus) {
 	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]ModelRegistryInstanceStatus, len(*in))
+		copy(*out, *in)
+	}
 	in.ComponentReleaseStatus.DeepCopyInto(&out.ComponentReleaseStatus)
 }
 
@@ -1543,6 +1925,102 @@ func (in *ModelRegistryCommonStatus) DeepCopy() *ModelRegistryCommonStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRegistryDatabaseSpec) DeepCopyInto(out *ModelRegistryDatabaseSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(DatabaseTLSSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryDatabaseSpec.
+func (in *ModelRegistryDatabaseSpec) DeepCopy() *ModelRegistryDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRegistryDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRegistryGatewaySpec) DeepCopyInto(out *ModelRegistryGatewaySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryGatewaySpec.
+func (in *ModelRegistryGatewaySpec) DeepCopy() *ModelRegistryGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRegistryGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRegistryInstanceSpec) DeepCopyInto(out *ModelRegistryInstanceSpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(ModelRegistryDatabaseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(ModelRegistryIstioSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryInstanceSpec.
+func (in *ModelRegistryInstanceSpec) DeepCopy() *ModelRegistryInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRegistryInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRegistryInstanceStatus) DeepCopyInto(out *ModelRegistryInstanceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryInstanceStatus.
+func (in *ModelRegistryInstanceStatus) DeepCopy() *ModelRegistryInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRegistryInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRegistryIstioSpec) DeepCopyInto(out *ModelRegistryIstioSpec) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(ModelRegistryGatewaySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistryIstioSpec.
+func (in *ModelRegistryIstioSpec) DeepCopy() *ModelRegistryIstioSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRegistryIstioSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelRegistryList) DeepCopyInto(out *ModelRegistryList) {
 	*out = *in
@@ -1578,7 +2056,7 @@ func (in *ModelRegistryList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelRegistrySpec) DeepCopyInto(out *ModelRegistrySpec) {
 	*out = *in
-	out.ModelRegistryCommonSpec = in.ModelRegistryCommonSpec
+	in.ModelRegistryCommonSpec.DeepCopyInto(&out.ModelRegistryCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRegistrySpec.
@@ -1608,6 +2086,26 @@ func (in *ModelRegistryStatus) DeepCopy() *ModelRegistryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NimCapability) DeepCopyInto(out *NimCapability) {
+	*out = *in
+	if in.PullSecretNamespaceSelector != nil {
+		in, out := &in.PullSecretNamespaceSelector, &out.PullSecretNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NimCapability.
+func (in *NimCapability) DeepCopy() *NimCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NimCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NimSpec) DeepCopyInto(out *NimSpec) {
 	*out = *in
@@ -1623,12 +2121,143 @@ func (in *NimSpec) DeepCopy() *NimSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotebookCullingSpec) DeepCopyInto(out *NotebookCullingSpec) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotebookCullingSpec.
+func (in *NotebookCullingSpec) DeepCopy() *NotebookCullingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotebookCullingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotebookImageOverride) DeepCopyInto(out *NotebookImageOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotebookImageOverride.
+func (in *NotebookImageOverride) DeepCopy() *NotebookImageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NotebookImageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotebookImagesSpec) DeepCopyInto(out *NotebookImagesSpec) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]NotebookImageOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = make([]CustomNotebookImage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotebookImagesSpec.
+func (in *NotebookImagesSpec) DeepCopy() *NotebookImagesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotebookImagesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageCredentialsSecretRef) DeepCopyInto(out *ObjectStorageCredentialsSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageCredentialsSecretRef.
+func (in *ObjectStorageCredentialsSecretRef) DeepCopy() *ObjectStorageCredentialsSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageCredentialsSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelinesObjectStorageSpec) DeepCopyInto(out *PipelinesObjectStorageSpec) {
+	*out = *in
+	out.StorageSize = in.StorageSize.DeepCopy()
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalObjectStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelinesObjectStorageSpec.
+func (in *PipelinesObjectStorageSpec) DeepCopy() *PipelinesObjectStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelinesObjectStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RawDeploymentAutoscalingSpec) DeepCopyInto(out *RawDeploymentAutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetUtilizationPercentage != nil {
+		in, out := &in.TargetUtilizationPercentage, &out.TargetUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawDeploymentAutoscalingSpec.
+func (in *RawDeploymentAutoscalingSpec) DeepCopy() *RawDeploymentAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RawDeploymentAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ray) DeepCopyInto(out *Ray) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1650,9 +2279,37 @@ func (in *Ray) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterDefaultsSpec) DeepCopyInto(out *RayClusterDefaultsSpec) {
+	*out = *in
+	if in.ProjectQuota != nil {
+		in, out := &in.ProjectQuota, &out.ProjectQuota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterDefaultsSpec.
+func (in *RayClusterDefaultsSpec) DeepCopy() *RayClusterDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayCommonSpec) DeepCopyInto(out *RayCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.ClusterDefaults != nil {
+		in, out := &in.ClusterDefaults, &out.ClusterDefaults
+		*out = new(RayClusterDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCommonSpec.
@@ -1716,7 +2373,7 @@ func (in *RayList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RaySpec) DeepCopyInto(out *RaySpec) {
 	*out = *in
-	out.RayCommonSpec = in.RayCommonSpec
+	in.RayCommonSpec.DeepCopyInto(&out.RayCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RaySpec.
@@ -1746,12 +2403,87 @@ func (in *RayStatus) DeepCopy() *RayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessAutoscalingSpec) DeepCopyInto(out *ServerlessAutoscalingSpec) {
+	*out = *in
+	if in.TargetConcurrency != nil {
+		in, out := &in.TargetConcurrency, &out.TargetConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleToZeroGracePeriod != nil {
+		in, out := &in.ScaleToZeroGracePeriod, &out.ScaleToZeroGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessAutoscalingSpec.
+func (in *ServerlessAutoscalingSpec) DeepCopy() *ServerlessAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServingRuntimeSpec) DeepCopyInto(out *ServingRuntimeSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingRuntimeSpec.
+func (in *ServingRuntimeSpec) DeepCopy() *ServingRuntimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServingRuntimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrainingJobDefaultsSpec) DeepCopyInto(out *TrainingJobDefaultsSpec) {
+	*out = *in
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingJobDefaultsSpec.
+func (in *TrainingJobDefaultsSpec) DeepCopy() *TrainingJobDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrainingJobDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrainingOperator) DeepCopyInto(out *TrainingOperator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1776,6 +2508,12 @@ func (in *TrainingOperator) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrainingOperatorCommonSpec) DeepCopyInto(out *TrainingOperatorCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(TrainingJobDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorCommonSpec.
@@ -1839,7 +2577,7 @@ func (in *TrainingOperatorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrainingOperatorSpec) DeepCopyInto(out *TrainingOperatorSpec) {
 	*out = *in
-	out.TrainingOperatorCommonSpec = in.TrainingOperatorCommonSpec
+	in.TrainingOperatorCommonSpec.DeepCopyInto(&out.TrainingOperatorCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingOperatorSpec.
@@ -1874,7 +2612,7 @@ func (in *TrustyAI) DeepCopyInto(out *TrustyAI) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1900,6 +2638,12 @@ func (in *TrustyAI) DeepCopyObject() runtime.Object {
 func (in *TrustyAICommonSpec) DeepCopyInto(out *TrustyAICommonSpec) {
 	*out = *in
 	out.Eval = in.Eval
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(TrustyAIStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAICommonSpec.
@@ -1991,10 +2735,25 @@ func (in *TrustyAIList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustyAIRetentionSpec) DeepCopyInto(out *TrustyAIRetentionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAIRetentionSpec.
+func (in *TrustyAIRetentionSpec) DeepCopy() *TrustyAIRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustyAIRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustyAISpec) DeepCopyInto(out *TrustyAISpec) {
 	*out = *in
-	out.TrustyAICommonSpec = in.TrustyAICommonSpec
+	in.TrustyAICommonSpec.DeepCopyInto(&out.TrustyAICommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAISpec.
@@ -2024,12 +2783,33 @@ func (in *TrustyAIStatus) DeepCopy() *TrustyAIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustyAIStorageSpec) DeepCopyInto(out *TrustyAIStorageSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(TrustyAIRetentionSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustyAIStorageSpec.
+func (in *TrustyAIStorageSpec) DeepCopy() *TrustyAIStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustyAIStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workbenches) DeepCopyInto(out *Workbenches) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2054,6 +2834,17 @@ func (in *Workbenches) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkbenchesCommonSpec) DeepCopyInto(out *WorkbenchesCommonSpec) {
 	*out = *in
+	in.ComponentWorkloadSpec.DeepCopyInto(&out.ComponentWorkloadSpec)
+	if in.NotebookImages != nil {
+		in, out := &in.NotebookImages, &out.NotebookImages
+		*out = new(NotebookImagesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Culling != nil {
+		in, out := &in.Culling, &out.Culling
+		*out = new(NotebookCullingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesCommonSpec.
@@ -2117,7 +2908,7 @@ func (in *WorkbenchesList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkbenchesSpec) DeepCopyInto(out *WorkbenchesSpec) {
 	*out = *in
-	out.WorkbenchesCommonSpec = in.WorkbenchesCommonSpec
+	in.WorkbenchesCommonSpec.DeepCopyInto(&out.WorkbenchesCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesSpec.
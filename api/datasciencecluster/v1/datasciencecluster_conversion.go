@@ -118,6 +118,13 @@ func (c *DataScienceCluster) ConvertTo(dstRaw conversion.Hub) error {
 			FeastOperator:      c.Spec.Components.FeastOperator,
 			LlamaStackOperator: c.Spec.Components.LlamaStackOperator,
 		},
+		MaintenanceMode:       c.Spec.MaintenanceMode,
+		GCPolicy:              c.Spec.GCPolicy,
+		DeployMode:            c.Spec.DeployMode,
+		GitOps:                c.Spec.GitOps,
+		ImageDigests:          c.Spec.ImageDigests,
+		RollbackTo:            c.Spec.RollbackTo,
+		ApplicationsNamespace: c.Spec.ApplicationsNamespace,
 	}
 
 	// Convert status with field renaming: DataSciencePipelines -> AIPipelines
@@ -175,6 +182,13 @@ func (c *DataScienceCluster) ConvertFrom(srcRaw conversion.Hub) error {
 			FeastOperator:      src.Spec.Components.FeastOperator,
 			LlamaStackOperator: src.Spec.Components.LlamaStackOperator,
 		},
+		MaintenanceMode:       src.Spec.MaintenanceMode,
+		GCPolicy:              src.Spec.GCPolicy,
+		DeployMode:            src.Spec.DeployMode,
+		GitOps:                src.Spec.GitOps,
+		ImageDigests:          src.Spec.ImageDigests,
+		RollbackTo:            src.Spec.RollbackTo,
+		ApplicationsNamespace: src.Spec.ApplicationsNamespace,
 	}
 
 	// Convert status with field renaming: AIPipelines -> DataSciencePipelines
@@ -26,9 +26,60 @@ import (
 )
 
 // DataScienceClusterSpec defines the desired state of the cluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.deployMode) || self.deployMode != 'GitOps' || has(self.gitOps)",message="gitOps is required in the GitOps deploy mode"
 type DataScienceClusterSpec struct {
 	// Override and fine tune specific component configurations.
 	Components Components `json:"components,omitempty"`
+
+	// Put the platform in maintenance mode, e.g. during cluster upgrades or etcd restores.
+	// While enabled, component reconciliation is paused, components are neither created nor
+	// removed and the platform validating webhooks are set to fail open.
+	// +optional
+	MaintenanceMode bool `json:"maintenanceMode,omitempty"`
+
+	// How the resources deployed by the operator and no longer rendered, e.g. after a change of
+	// their labels, are garbage collected: Enabled deletes them, DryRun only logs them and records
+	// events on the components, Disabled keeps them. Resources annotated with opendatahub.io/gc-protect
+	// set to true are never deleted. Defaults to Enabled.
+	// +optional
+	GCPolicy common.GCPolicy `json:"gcPolicy,omitempty"`
+
+	// How the resources rendered by the operator are deployed: Apply applies them, GitOps publishes
+	// them to the OCI repository set in gitOps, for a GitOps tool such as Argo CD to apply them, and
+	// neither applies nor garbage collects them, the status of the components being still computed
+	// from the cluster. The resources of the platform API, e.g. the components, are applied in both
+	// modes. Defaults to Apply.
+	// +optional
+	DeployMode common.DeployMode `json:"deployMode,omitempty"`
+
+	// Where the rendered resources are published in the GitOps deploy mode.
+	// +optional
+	GitOps *common.GitOpsSpec `json:"gitOps,omitempty"`
+
+	// How the digests of the images the workloads reference by tag are resolved from their
+	// registries: Record records them in the status of the components, Pin also pins the images
+	// by digest. When unset, only the digests of the images the manifests pin are recorded.
+	// +optional
+	ImageDigests *common.ImageDigestsSpec `json:"imageDigests,omitempty"`
+
+	// Generation of a previously applied spec to restore, e.g. to undo a bad configuration change.
+	// The specs of the last 10 generations are kept in ConfigMaps labeled with
+	// platform.opendatahub.io/spec-history in the operator namespace. The field is cleared once
+	// the spec is restored.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RollbackTo *int64 `json:"rollbackTo,omitempty"`
+
+	// Applications namespace the components of this DataScienceCluster are deployed to, for several
+	// teams to run their own DataScienceCluster. When unset, the components are deployed to the
+	// applications namespace of the DSCInitialization, by the only DataScienceCluster allowed to leave
+	// it unset, which also deploys the cluster-scoped resources the components share. Each namespace
+	// can be bound to a single DataScienceCluster, and it can not be changed once set.
+	// +optional
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ApplicationsNamespace is immutable"
+	ApplicationsNamespace string `json:"applicationsNamespace,omitempty"`
 }
 
 // DSCKueueV1 contains all the configuration exposed in DSC v1 instance for Kueue component
@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -28,19 +29,19 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
-	out.Dashboard = in.Dashboard
-	out.Workbenches = in.Workbenches
+	in.Dashboard.DeepCopyInto(&out.Dashboard)
+	in.Workbenches.DeepCopyInto(&out.Workbenches)
 	out.ModelMeshServing = in.ModelMeshServing
 	in.DataSciencePipelines.DeepCopyInto(&out.DataSciencePipelines)
-	out.Kserve = in.Kserve
-	out.Kueue = in.Kueue
+	in.Kserve.DeepCopyInto(&out.Kserve)
+	in.Kueue.DeepCopyInto(&out.Kueue)
 	out.CodeFlare = in.CodeFlare
-	out.Ray = in.Ray
-	out.TrustyAI = in.TrustyAI
-	out.ModelRegistry = in.ModelRegistry
-	out.TrainingOperator = in.TrainingOperator
-	out.FeastOperator = in.FeastOperator
-	out.LlamaStackOperator = in.LlamaStackOperator
+	in.Ray.DeepCopyInto(&out.Ray)
+	in.TrustyAI.DeepCopyInto(&out.TrustyAI)
+	in.ModelRegistry.DeepCopyInto(&out.ModelRegistry)
+	in.TrainingOperator.DeepCopyInto(&out.TrainingOperator)
+	in.FeastOperator.DeepCopyInto(&out.FeastOperator)
+	in.LlamaStackOperator.DeepCopyInto(&out.LlamaStackOperator)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Components.
@@ -86,7 +87,7 @@ func (in *DSCKueueV1) DeepCopyInto(out *DSCKueueV1) {
 	*out = *in
 	out.KueueManagementSpecV1 = in.KueueManagementSpecV1
 	out.KueueCommonSpec = in.KueueCommonSpec
-	out.KueueDefaultQueueSpec = in.KueueDefaultQueueSpec
+	in.KueueDefaultQueueSpec.DeepCopyInto(&out.KueueDefaultQueueSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCKueueV1.
@@ -162,6 +163,21 @@ func (in *DataScienceClusterList) DeepCopyObject() runtime.Object {
 func (in *DataScienceClusterSpec) DeepCopyInto(out *DataScienceClusterSpec) {
 	*out = *in
 	in.Components.DeepCopyInto(&out.Components)
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(common.GitOpsSpec)
+		**out = **in
+	}
+	if in.ImageDigests != nil {
+		in, out := &in.ImageDigests, &out.ImageDigests
+		*out = new(common.ImageDigestsSpec)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterSpec.
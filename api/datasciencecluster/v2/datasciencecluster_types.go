@@ -24,9 +24,60 @@ import (
 )
 
 // DataScienceClusterSpec defines the desired state of the cluster.
+// +kubebuilder:validation:XValidation:rule="!has(self.deployMode) || self.deployMode != 'GitOps' || has(self.gitOps)",message="gitOps is required in the GitOps deploy mode"
 type DataScienceClusterSpec struct {
 	// Override and fine tune specific component configurations.
 	Components Components `json:"components,omitempty"`
+
+	// Put the platform in maintenance mode, e.g. during cluster upgrades or etcd restores.
+	// While enabled, component reconciliation is paused, components are neither created nor
+	// removed and the platform validating webhooks are set to fail open.
+	// +optional
+	MaintenanceMode bool `json:"maintenanceMode,omitempty"`
+
+	// How the resources deployed by the operator and no longer rendered, e.g. after a change of
+	// their labels, are garbage collected: Enabled deletes them, DryRun only logs them and records
+	// events on the components, Disabled keeps them. Resources annotated with opendatahub.io/gc-protect
+	// set to true are never deleted. Defaults to Enabled.
+	// +optional
+	GCPolicy common.GCPolicy `json:"gcPolicy,omitempty"`
+
+	// How the resources rendered by the operator are deployed: Apply applies them, GitOps publishes
+	// them to the OCI repository set in gitOps, for a GitOps tool such as Argo CD to apply them, and
+	// neither applies nor garbage collects them, the status of the components being still computed
+	// from the cluster. The resources of the platform API, e.g. the components, are applied in both
+	// modes. Defaults to Apply.
+	// +optional
+	DeployMode common.DeployMode `json:"deployMode,omitempty"`
+
+	// Where the rendered resources are published in the GitOps deploy mode.
+	// +optional
+	GitOps *common.GitOpsSpec `json:"gitOps,omitempty"`
+
+	// How the digests of the images the workloads reference by tag are resolved from their
+	// registries: Record records them in the status of the components, Pin also pins the images
+	// by digest. When unset, only the digests of the images the manifests pin are recorded.
+	// +optional
+	ImageDigests *common.ImageDigestsSpec `json:"imageDigests,omitempty"`
+
+	// Generation of a previously applied spec to restore, e.g. to undo a bad configuration change.
+	// The specs of the last 10 generations are kept in ConfigMaps labeled with
+	// platform.opendatahub.io/spec-history in the operator namespace. The field is cleared once
+	// the spec is restored.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RollbackTo *int64 `json:"rollbackTo,omitempty"`
+
+	// Applications namespace the components of this DataScienceCluster are deployed to, for several
+	// teams to run their own DataScienceCluster. When unset, the components are deployed to the
+	// applications namespace of the DSCInitialization, by the only DataScienceCluster allowed to leave
+	// it unset, which also deploys the cluster-scoped resources the components share. Each namespace
+	// can be bound to a single DataScienceCluster, and it can not be changed once set.
+	// +optional
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ApplicationsNamespace is immutable"
+	ApplicationsNamespace string `json:"applicationsNamespace,omitempty"`
 }
 
 type Components struct {
@@ -101,6 +152,34 @@ type ComponentsStatus struct {
 	LlamaStackOperator componentApi.DSCLlamaStackOperatorStatus `json:"llamastackoperator,omitempty"`
 }
 
+// ClusterHealthStatus is the overall health of the platform.
+// +kubebuilder:validation:Enum=Healthy;Degraded;Unhealthy
+type ClusterHealthStatus string
+
+const (
+	// ClusterHealthHealthy is the health of the platform when all the checks pass.
+	ClusterHealthHealthy ClusterHealthStatus = "Healthy"
+	// ClusterHealthDegraded is the health of the platform when it works with reduced capacity,
+	// e.g. some replicas of the components are not ready or a platform service is not ready.
+	ClusterHealthDegraded ClusterHealthStatus = "Degraded"
+	// ClusterHealthUnhealthy is the health of the platform when some of its parts do not work,
+	// e.g. a component has no ready deployment or a webhook failing closed is not reachable.
+	ClusterHealthUnhealthy ClusterHealthStatus = "Unhealthy"
+)
+
+// ClusterHealth summarizes the health of the platform, computed from the availability of the
+// deployments of the components, the reachability of the webhooks and the readiness of the
+// platform services.
+type ClusterHealth struct {
+	// Overall health of the platform: Healthy, Degraded or Unhealthy.
+	// +optional
+	Status ClusterHealthStatus `json:"status,omitempty"`
+	// Checks that failed, e.g. "component dashboard: 0/1 deployments ready".
+	// +optional
+	// +listType=atomic
+	FailedChecks []string `json:"failedChecks,omitempty"`
+}
+
 // DataScienceClusterStatus defines the observed state of DataScienceCluster.
 type DataScienceClusterStatus struct {
 	common.Status `json:",inline"`
@@ -117,6 +196,10 @@ type DataScienceClusterStatus struct {
 
 	// Version and release type
 	Release common.Release `json:"release,omitempty"`
+
+	// Health of the platform, also served by the /platform-health endpoint of the metrics server.
+	// +optional
+	ClusterHealth ClusterHealth `json:"clusterHealth,omitempty"`
 }
 
 func (s *DataScienceClusterStatus) GetConditions() []common.Condition {
@@ -133,6 +216,7 @@ func (s *DataScienceClusterStatus) SetConditions(conditions []common.Condition)
 // +kubebuilder:resource:scope=Cluster,shortName=dsc
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Health",type=string,JSONPath=`.status.clusterHealth.status`,description="Health"
 
 // DataScienceCluster is the Schema for the datascienceclusters API.
 type DataScienceCluster struct {
@@ -164,6 +248,12 @@ func (c *DataScienceCluster) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+// IsScoped returns whether the DataScienceCluster is bound to its own applications namespace,
+// instead of the one of the DSCInitialization.
+func (c *DataScienceCluster) IsScoped() bool {
+	return c.Spec.ApplicationsNamespace != ""
+}
+
 func init() {
 	SchemeBuilder.Register(&DataScienceCluster{}, &DataScienceClusterList{})
 }
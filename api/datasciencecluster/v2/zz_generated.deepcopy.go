@@ -21,24 +21,45 @@ limitations under the License.
 package v2
 
 import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealth) DeepCopyInto(out *ClusterHealth) {
+	*out = *in
+	if in.FailedChecks != nil {
+		in, out := &in.FailedChecks, &out.FailedChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealth.
+func (in *ClusterHealth) DeepCopy() *ClusterHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
-	out.Dashboard = in.Dashboard
-	out.Workbenches = in.Workbenches
+	in.Dashboard.DeepCopyInto(&out.Dashboard)
+	in.Workbenches.DeepCopyInto(&out.Workbenches)
 	in.AIPipelines.DeepCopyInto(&out.AIPipelines)
-	out.Kserve = in.Kserve
-	out.Kueue = in.Kueue
-	out.Ray = in.Ray
-	out.TrustyAI = in.TrustyAI
-	out.ModelRegistry = in.ModelRegistry
-	out.TrainingOperator = in.TrainingOperator
-	out.FeastOperator = in.FeastOperator
-	out.LlamaStackOperator = in.LlamaStackOperator
+	in.Kserve.DeepCopyInto(&out.Kserve)
+	in.Kueue.DeepCopyInto(&out.Kueue)
+	in.Ray.DeepCopyInto(&out.Ray)
+	in.TrustyAI.DeepCopyInto(&out.TrustyAI)
+	in.ModelRegistry.DeepCopyInto(&out.ModelRegistry)
+	in.TrainingOperator.DeepCopyInto(&out.TrainingOperator)
+	in.FeastOperator.DeepCopyInto(&out.FeastOperator)
+	in.LlamaStackOperator.DeepCopyInto(&out.LlamaStackOperator)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Components.
@@ -140,6 +161,21 @@ func (in *DataScienceClusterList) DeepCopyObject() runtime.Object {
 func (in *DataScienceClusterSpec) DeepCopyInto(out *DataScienceClusterSpec) {
 	*out = *in
 	in.Components.DeepCopyInto(&out.Components)
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(common.GitOpsSpec)
+		**out = **in
+	}
+	if in.ImageDigests != nil {
+		in, out := &in.ImageDigests, &out.ImageDigests
+		*out = new(common.ImageDigestsSpec)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterSpec.
@@ -163,6 +199,7 @@ func (in *DataScienceClusterStatus) DeepCopyInto(out *DataScienceClusterStatus)
 	}
 	in.Components.DeepCopyInto(&out.Components)
 	in.Release.DeepCopyInto(&out.Release)
+	in.ClusterHealth.DeepCopyInto(&out.ClusterHealth)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterStatus.
@@ -17,6 +17,9 @@ limitations under the License.
 package v1
 
 import (
+	"maps"
+	"slices"
+
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 )
@@ -30,11 +33,28 @@ func (c *DSCInitialization) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec = dsciv2.DSCInitializationSpec{
 		ApplicationsNamespace: c.Spec.ApplicationsNamespace,
 		Monitoring:            c.Spec.Monitoring,
+		Scheduling:            c.Spec.Scheduling.DeepCopy(),
+		IngressType:           c.Spec.IngressType,
+		NetworkPolicyProfile:  c.Spec.NetworkPolicyProfile,
+		PodSecurityProfile:    c.Spec.PodSecurityProfile,
+		FIPSCompliance:        c.Spec.FIPSCompliance,
+		DeploymentProfile:     c.Spec.DeploymentProfile,
+		ImageOverrides:        maps.Clone(c.Spec.ImageOverrides),
+		RollbackTo:            c.Spec.RollbackTo,
+		PreflightPolicy:       c.Spec.PreflightPolicy,
+		FeatureGates:          maps.Clone(c.Spec.FeatureGates),
+		ProjectTemplate:       c.Spec.ProjectTemplate.DeepCopy(),
+		Proxy:                 c.Spec.Proxy.DeepCopy(),
+		PriorityClasses:       c.Spec.PriorityClasses.DeepCopy(),
+		Dependencies:          slices.Clone(c.Spec.Dependencies),
 	}
 	if c.Spec.TrustedCABundle != nil {
 		dst.Spec.TrustedCABundle = &dsciv2.TrustedCABundleSpec{
-			ManagementState: c.Spec.TrustedCABundle.ManagementState,
-			CustomCABundle:  c.Spec.TrustedCABundle.CustomCABundle,
+			ManagementState:    c.Spec.TrustedCABundle.ManagementState,
+			CustomCABundle:     c.Spec.TrustedCABundle.CustomCABundle,
+			Sources:            c.Spec.TrustedCABundle.Sources,
+			NamespaceSelector:  c.Spec.TrustedCABundle.NamespaceSelector,
+			ExcludedNamespaces: c.Spec.TrustedCABundle.ExcludedNamespaces,
 		}
 	}
 	if c.Spec.DevFlags != nil {
@@ -49,6 +69,8 @@ func (c *DSCInitialization) ConvertTo(dstRaw conversion.Hub) error {
 		RelatedObjects: c.Status.RelatedObjects,
 		ErrorMessage:   c.Status.ErrorMessage,
 		Release:        c.Status.Release,
+		Accelerators:   c.Status.Accelerators,
+		Architectures:  c.Status.Architectures,
 	}
 
 	return nil
@@ -63,11 +85,28 @@ func (c *DSCInitialization) ConvertFrom(srcRaw conversion.Hub) error {
 	c.Spec = DSCInitializationSpec{
 		ApplicationsNamespace: src.Spec.ApplicationsNamespace,
 		Monitoring:            src.Spec.Monitoring,
+		Scheduling:            src.Spec.Scheduling.DeepCopy(),
+		IngressType:           src.Spec.IngressType,
+		NetworkPolicyProfile:  src.Spec.NetworkPolicyProfile,
+		PodSecurityProfile:    src.Spec.PodSecurityProfile,
+		FIPSCompliance:        src.Spec.FIPSCompliance,
+		DeploymentProfile:     src.Spec.DeploymentProfile,
+		ImageOverrides:        maps.Clone(src.Spec.ImageOverrides),
+		RollbackTo:            src.Spec.RollbackTo,
+		PreflightPolicy:       src.Spec.PreflightPolicy,
+		FeatureGates:          maps.Clone(src.Spec.FeatureGates),
+		ProjectTemplate:       src.Spec.ProjectTemplate.DeepCopy(),
+		Proxy:                 src.Spec.Proxy.DeepCopy(),
+		PriorityClasses:       src.Spec.PriorityClasses.DeepCopy(),
+		Dependencies:          slices.Clone(src.Spec.Dependencies),
 	}
 	if src.Spec.TrustedCABundle != nil {
 		c.Spec.TrustedCABundle = &TrustedCABundleSpec{
-			ManagementState: src.Spec.TrustedCABundle.ManagementState,
-			CustomCABundle:  src.Spec.TrustedCABundle.CustomCABundle,
+			ManagementState:    src.Spec.TrustedCABundle.ManagementState,
+			CustomCABundle:     src.Spec.TrustedCABundle.CustomCABundle,
+			Sources:            src.Spec.TrustedCABundle.Sources,
+			NamespaceSelector:  src.Spec.TrustedCABundle.NamespaceSelector,
+			ExcludedNamespaces: src.Spec.TrustedCABundle.ExcludedNamespaces,
 		}
 	}
 	if src.Spec.DevFlags != nil {
@@ -82,6 +121,8 @@ func (c *DSCInitialization) ConvertFrom(srcRaw conversion.Hub) error {
 		RelatedObjects: src.Status.RelatedObjects,
 		ErrorMessage:   src.Status.ErrorMessage,
 		Release:        src.Status.Release,
+		Accelerators:   src.Status.Accelerators,
+		Architectures:  src.Status.Architectures,
 	}
 
 	return nil
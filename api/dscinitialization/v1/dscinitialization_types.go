@@ -49,6 +49,20 @@ type TrustedCABundleSpec struct {
 	// ConfigMap .data.odh-ca-bundle.crt .
 	// +kubebuilder:default=""
 	CustomCABundle string `json:"customCABundle"`
+	// ConfigMaps and Secrets keys, in the applications namespace, holding additional CA certificates.
+	// Their certificates are merged with the custom CA bundle, removing duplicates, into the
+	// odh-trusted-ca-bundle ConfigMap .data.odh-ca-bundle.crt .
+	// +optional
+	// +listType=atomic
+	Sources []common.CABundleSource `json:"sources,omitempty"`
+	// Selects the namespaces the odh-trusted-ca-bundle ConfigMap is added to. When not set,
+	// the ConfigMap is added to all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Namespaces the odh-trusted-ca-bundle ConfigMap is not added to, even if matching the namespaceSelector.
+	// +optional
+	// +listType=set
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
 }
 
 // DSCInitializationStatus defines the observed state of DSCInitialization.
@@ -69,6 +83,14 @@ type DSCInitializationStatus struct {
 
 	// Version and release type
 	Release common.Release `json:"release,omitempty"`
+
+	// Accelerators detected on the cluster, by vendor. Available to the component templates.
+	// +optional
+	Accelerators []common.Accelerator `json:"accelerators,omitempty"`
+
+	// CPU architectures of the nodes of the cluster, e.g. amd64 and arm64.
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
 }
 
 // GetConditions returns the conditions slice
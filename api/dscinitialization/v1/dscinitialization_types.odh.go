@@ -19,6 +19,7 @@ limitations under the License.
 package v1
 
 import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 )
@@ -50,4 +51,91 @@ type DSCInitializationSpec struct {
 	// This is not recommended to be used in production environment.
 	// +optional
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
+	// Cluster-wide scheduling constraints of the component workloads deployed by the operator.
+	// Components can override them in the DataScienceCluster.
+	// +optional
+	Scheduling *common.SchedulingSpec `json:"scheduling,omitempty"`
+	// Ingress layer the components are exposed with: OpenShift Routes, Gateway API HTTPRoutes,
+	// which requires the Gateway API CRDs, or Kubernetes Ingresses. When not set, each component
+	// keeps its default on OpenShift and Ingresses are used on Kubernetes.
+	// +optional
+	IngressType infrav1.IngressType `json:"ingressType,omitempty"`
+	// NetworkPolicies deployed in the applications namespace: open deploys none, baseline allows the
+	// traffic from the platform namespaces, the ingress controller and the cluster monitoring, strict
+	// denies the traffic by default and each enabled component allows the traffic to the ports of
+	// its Services. Defaults to baseline.
+	// +optional
+	NetworkPolicyProfile infrav1.NetworkPolicyProfile `json:"networkPolicyProfile,omitempty"`
+	// Pod Security Standard the workloads deployed by the operator comply with: baseline deploys them
+	// as set by their manifests, restricted sets the runAsNonRoot, seccompProfile, allowPrivilegeEscalation
+	// and dropped capabilities settings their manifests leave unset and flags, through the
+	// PodSecurityNonCompliant condition of the components, the workloads that can't comply.
+	// Defaults to baseline on OpenShift and to restricted on Kubernetes.
+	// +optional
+	PodSecurityProfile infrav1.PodSecurityProfile `json:"podSecurityProfile,omitempty"`
+	// Whether the workloads deployed by the operator are required to use FIPS-compliant images: Ignore
+	// deploys the images of the manifests, Auto requires FIPS-compliant images when the cluster is
+	// installed in FIPS mode and Required always requires them. When required, the images with a
+	// FIPS variant are replaced by it and the components rendering an image without one are not
+	// deployed and reported as Degraded. Defaults to Ignore.
+	// +optional
+	FIPSCompliance infrav1.FIPSCompliance `json:"fipsCompliance,omitempty"`
+	// How the workloads deployed by the operator are sized: default deploys them as sized by their
+	// manifests, sno minimizes their footprint for single-node OpenShift and edge clusters, scaling them
+	// to one replica, capping their resource requests, removing their PodDisruptionBudgets and the
+	// sidecars their manifests mark as optional. Defaults to default.
+	// +optional
+	DeploymentProfile infrav1.DeploymentProfile `json:"deploymentProfile,omitempty"`
+	// Image references overrides applied to the containers of the workloads deployed by the operator,
+	// to pull the images from a mirror or a private registry without changing the manifests. Keys are
+	// the images, or the registries or repositories prefixes, to override and values their replacements,
+	// e.g. "quay.io/opendatahub": "mirror.example.com/opendatahub"; the longest matching key wins.
+	// Images pinned by digest whose repository is mirrored by an ImageDigestMirrorSet or an
+	// ImageContentSourcePolicy are left untouched, as the cluster already pulls them from the mirrors.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=128
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+	// Generation of a previously applied spec to restore, e.g. to undo a bad configuration change.
+	// The specs of the last 10 generations are kept in ConfigMaps labeled with
+	// platform.opendatahub.io/spec-history in the operator namespace. The field is cleared once
+	// the spec is restored.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RollbackTo *int64 `json:"rollbackTo,omitempty"`
+	// How the failures of the upgrade preflight checks, reported by the PreflightChecksPassed condition,
+	// are handled: Warn only reports them, Block also holds the major version upgrades of the components
+	// while a blocking check fails. Defaults to Warn.
+	// +optional
+	PreflightPolicy common.PreflightPolicy `json:"preflightPolicy,omitempty"`
+	// Feature gates enabling or disabling optional capabilities of the components, keyed by feature
+	// name, e.g. ModelRegistryIstio. Alpha features are disabled and Beta features enabled by default,
+	// GA features are always enabled. Each component reports the state of the features it consults
+	// with a <Feature>Enabled condition.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Resources added to the data science projects, the namespaces labeled with
+	// opendatahub.io/dashboard=true: namespace labels, a ResourceQuota, NetworkPolicies, a default
+	// Kueue LocalQueue and RoleBindings. The resources removed from the template are deleted.
+	// +optional
+	ProjectTemplate *common.DataScienceProjectTemplate `json:"projectTemplate,omitempty"`
+	// Proxy settings injected, along with the trusted CA bundle, in the workloads deployed by the operator
+	// when the cluster has no OpenShift cluster-wide Proxy configured, e.g. on vanilla Kubernetes. The
+	// settings of the OpenShift cluster-wide Proxy take precedence.
+	// +optional
+	Proxy *infrav1.ProxySpec `json:"proxy,omitempty"`
+	// PriorityClasses assigned to the workloads deployed by the operator, the controllers being
+	// assigned their own, so the platform is not evicted before the pods of the users on
+	// constrained clusters. The PriorityClasses can be created by the operator.
+	// +optional
+	PriorityClasses *infrav1.PriorityClassesSpec `json:"priorityClasses,omitempty"`
+	// Third-party operators the components depend on, e.g. cert-manager or Serverless. Each dependency
+	// required by the enabled components, or listed here and not Removed, is reported with a
+	// <Dependency>Ready condition tracking the health of its ClusterServiceVersion. Managed
+	// dependencies have their OLM Subscription created when the operator is not installed.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Dependencies []infrav1.DependencySpec `json:"dependencies,omitempty"`
 }
@@ -0,0 +1,26 @@
+package v1
+
+import operatorv1 "github.com/openshift/api/operator/v1"
+
+// DependencySpec configures a third-party operator the components depend on.
+type DependencySpec struct {
+	// Name of the dependency.
+	// +kubebuilder:validation:Enum=cert-manager;serverless;servicemesh;authorino;kueue;opentelemetry;cluster-observability;tempo
+	Name string `json:"name"`
+	// Managed creates the OLM Subscription of the operator when it is not installed, Unmanaged only
+	// reports its state and Removed neither installs nor reports it. Defaults to Unmanaged.
+	// +optional
+	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Channel of the Subscription created when Managed, defaults to the channel of the dependency,
+	// e.g. stable-v1 for cert-manager.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+	// CatalogSource of the Subscription created when Managed. Defaults to redhat-operators.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// Namespace of the CatalogSource of the Subscription created when Managed. Defaults to
+	// openshift-marketplace.
+	// +optional
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+}
@@ -0,0 +1,13 @@
+package v1
+
+// DeploymentProfile selects how the workloads deployed by the operator are sized.
+// +kubebuilder:validation:Enum=default;sno
+type DeploymentProfile string
+
+const (
+	// DeploymentProfileDefault deploys the workloads as sized by their manifests.
+	DeploymentProfileDefault DeploymentProfile = "default"
+	// DeploymentProfileSNO minimizes the resources used by the workloads, for single-node OpenShift and
+	// edge clusters: one replica, capped resource requests, no PodDisruptionBudgets and no optional sidecars.
+	DeploymentProfileSNO DeploymentProfile = "sno"
+)
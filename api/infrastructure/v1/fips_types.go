@@ -0,0 +1,14 @@
+package v1
+
+// FIPSCompliance selects whether the workloads deployed by the operator are required to use FIPS-compliant images.
+// +kubebuilder:validation:Enum=Ignore;Auto;Required
+type FIPSCompliance string
+
+const (
+	// FIPSComplianceIgnore deploys the workloads with the images of their manifests.
+	FIPSComplianceIgnore FIPSCompliance = "Ignore"
+	// FIPSComplianceAuto requires FIPS-compliant images when the cluster is installed in FIPS mode.
+	FIPSComplianceAuto FIPSCompliance = "Auto"
+	// FIPSComplianceRequired requires FIPS-compliant images regardless of the cluster FIPS mode.
+	FIPSComplianceRequired FIPSCompliance = "Required"
+)
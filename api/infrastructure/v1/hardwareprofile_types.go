@@ -20,6 +20,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 )
 
 // SchedulingType defines the scheduling method for the hardware profile.
@@ -122,13 +124,24 @@ type NodeSchedulingSpec struct {
 
 // HardwareProfileStatus defines the observed state of HardwareProfile.
 type HardwareProfileStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// The generation of the HardwareProfile reported by the status.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the HardwareProfile: Valid reports whether the profile can be applied to the
+	// workloads, AcceleratorsAvailable whether nodes of the cluster provide its accelerators.
+	// +optional
+	Conditions []common.Condition `json:"conditions,omitempty"`
+	// Number of nodes matching the node scheduling of the profile and providing the default
+	// count of each of its accelerators.
+	// +optional
+	AvailableNodes int32 `json:"availableNodes,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Valid",type=string,JSONPath=`.status.conditions[?(@.type=="Valid")].status`,description="Valid"
+// +kubebuilder:printcolumn:name="Nodes",type=integer,JSONPath=`.status.availableNodes`,description="Available nodes"
 
 // HardwareProfile is the Schema for the hardwareprofiles API.
 type HardwareProfile struct {
@@ -148,6 +161,14 @@ type HardwareProfileList struct {
 	Items           []HardwareProfile `json:"items"`
 }
 
+func (h *HardwareProfile) GetConditions() []common.Condition {
+	return h.Status.Conditions
+}
+
+func (h *HardwareProfile) SetConditions(conditions []common.Condition) {
+	h.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&HardwareProfile{}, &HardwareProfileList{})
 }
@@ -0,0 +1,16 @@
+package v1
+
+// IngressType selects the ingress layer the components are exposed with.
+// +kubebuilder:validation:Enum=route;gatewayapi;ingress
+type IngressType string
+
+const (
+	// IngressTypeRoute exposes the components with OpenShift Routes.
+	IngressTypeRoute IngressType = "route"
+	// IngressTypeGatewayAPI exposes the components with Gateway API HTTPRoutes attached to
+	// the data science Gateway.
+	IngressTypeGatewayAPI IngressType = "gatewayapi"
+	// IngressTypeIngress exposes the components with Kubernetes Ingresses, handled by the default
+	// IngressClass of the cluster. It is the default on Kubernetes, where Routes are not available.
+	IngressTypeIngress IngressType = "ingress"
+)
@@ -0,0 +1,16 @@
+package v1
+
+// NetworkPolicyProfile selects the NetworkPolicies the operator deploys in the applications namespace.
+// +kubebuilder:validation:Enum=open;baseline;strict
+type NetworkPolicyProfile string
+
+const (
+	// NetworkPolicyProfileOpen deploys no NetworkPolicy in the applications namespace.
+	NetworkPolicyProfileOpen NetworkPolicyProfile = "open"
+	// NetworkPolicyProfileBaseline allows the traffic from the platform namespaces, the ingress
+	// controller and the cluster monitoring to the applications namespace.
+	NetworkPolicyProfileBaseline NetworkPolicyProfile = "baseline"
+	// NetworkPolicyProfileStrict denies the traffic to the applications namespace by default, each
+	// component allowing the traffic to the ports of its Services.
+	NetworkPolicyProfileStrict NetworkPolicyProfile = "strict"
+)
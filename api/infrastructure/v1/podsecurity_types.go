@@ -0,0 +1,13 @@
+package v1
+
+// PodSecurityProfile selects the Pod Security Standard the workloads deployed by the operator comply with.
+// +kubebuilder:validation:Enum=baseline;restricted
+type PodSecurityProfile string
+
+const (
+	// PodSecurityProfileBaseline deploys the workloads with the securityContext set by their manifests.
+	PodSecurityProfileBaseline PodSecurityProfile = "baseline"
+	// PodSecurityProfileRestricted sets on the workloads the securityContext settings required by the
+	// restricted Pod Security Standard their manifests leave unset, flagging the ones that can't comply.
+	PodSecurityProfileRestricted PodSecurityProfile = "restricted"
+)
@@ -0,0 +1,20 @@
+package v1
+
+// PriorityClassesSpec holds the PriorityClasses assigned to the workloads deployed by the operator.
+type PriorityClassesSpec struct {
+	// PriorityClass of the controllers deployed by the operator, the Deployments and StatefulSets
+	// whose name has a controller, operator, manager or webhook segment, e.g. odh-model-controller or
+	// odh-notebook-controller-manager.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	Controllers string `json:"controllers,omitempty"`
+	// PriorityClass of the other workloads deployed by the operator, e.g. odh-dashboard.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	Workloads string `json:"workloads,omitempty"`
+	// Create the PriorityClasses when they do not exist, the controllers one above the workloads one,
+	// both above the pods of the users, which default to a priority of 0, so the platform is not
+	// evicted before them on constrained clusters.
+	// +optional
+	Create bool `json:"create,omitempty"`
+}
@@ -0,0 +1,16 @@
+package v1
+
+// ProxySpec holds the proxy settings of the cluster egress traffic.
+type ProxySpec struct {
+	// URL of the proxy for the HTTP requests.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// URL of the proxy for the HTTPS requests.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// Comma-separated list of the hosts, domains and CIDRs that are reached without the proxy.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
@@ -39,11 +39,86 @@ type AuthSpec struct {
 	// AllowedGroups cannot contain empty strings, but 'system:authenticated' is allowed for general access
 	// +kubebuilder:validation:XValidation:rule="self.all(group, group != '')",message="AllowedGroups cannot contain empty strings"
 	AllowedGroups []string `json:"allowedGroups"`
+	// GroupSync resolves additional admin and allowed groups from the Groups synced from an
+	// external identity provider, e.g. by the LDAP group sync or from the OIDC groups claim.
+	// +optional
+	GroupSync *GroupSyncSpec `json:"groupSync,omitempty"`
+	// Personas grants groups the role bundles of personas, aggregating the permissions on the
+	// APIs of each enabled component, on top of the admin and allowed groups split.
+	// +optional
+	// +listType=map
+	// +listMapKey=persona
+	Personas []PersonaBinding `json:"personas,omitempty"`
+}
+
+// Persona identifies a role bundle granting permissions on the APIs of the enabled components.
+// +kubebuilder:validation:Enum=data-scientist;ml-engineer;platform-admin
+type Persona string
+
+const (
+	// PersonaDataScientist manages the workloads, e.g. workbenches, jobs and inference services,
+	// and reads the configurations they rely on.
+	PersonaDataScientist Persona = "data-scientist"
+	// PersonaMLEngineer manages the workloads and their configurations, e.g. serving runtimes,
+	// pipelines servers and model registries.
+	PersonaMLEngineer Persona = "ml-engineer"
+	// PersonaPlatformAdmin has full access to the APIs of the enabled components and manages the
+	// DataScienceCluster and the components configuration.
+	PersonaPlatformAdmin Persona = "platform-admin"
+)
+
+// PersonaBinding grants the role bundle of a persona to groups.
+type PersonaBinding struct {
+	// Persona whose role bundle is granted.
+	// +required
+	Persona Persona `json:"persona"`
+	// Groups granted the role bundle.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self.all(group, group != 'system:authenticated' && group != '')",message="Groups cannot contain 'system:authenticated' or empty strings"
+	Groups []string `json:"groups"`
+	// Selects the namespaces the role bundle is granted in, e.g. the data science projects labeled
+	// with opendatahub.io/dashboard=true. When not set, the role bundle is granted cluster-wide.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// GroupSyncSpec defines how the admin and allowed groups are resolved from the Groups synced from
+// an external identity provider. The resolved groups are added to the ones listed in the AuthSpec.
+type GroupSyncSpec struct {
+	// Selects the Groups granted admin access, e.g. the Groups labeled with openshift.io/ldap.host
+	// by the LDAP group sync. Groups named 'system:authenticated' are ignored.
+	// +optional
+	AdminGroupsSelector *metav1.LabelSelector `json:"adminGroupsSelector,omitempty"`
+	// Selects the Groups allowed access.
+	// +optional
+	AllowedGroupsSelector *metav1.LabelSelector `json:"allowedGroupsSelector,omitempty"`
+	// Interval between two resolutions of the selected Groups, defaults to 10 minutes.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// GroupSyncStatus defines the groups resolved by the last group sync.
+type GroupSyncStatus struct {
+	// Admin groups resolved from the adminGroupsSelector.
+	// +optional
+	// +listType=set
+	AdminGroups []string `json:"adminGroups,omitempty"`
+	// Allowed groups resolved from the allowedGroupsSelector.
+	// +optional
+	// +listType=set
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+	// Time of the last group sync.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
 // AuthStatus defines the observed state of Auth
 type AuthStatus struct {
 	common.Status `json:",inline"`
+
+	// GroupSync reports the groups resolved from the external identity provider.
+	// +optional
+	GroupSync *GroupSyncStatus `json:"groupSync,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	DiagnosticBundleServiceName = "diagnosticbundle"
+	DiagnosticBundleKind        = "DiagnosticBundle"
+
+	// DiagnosticBundleKey is the key of the ConfigMap holding the bundle, a gzipped tarball.
+	DiagnosticBundleKey = "bundle.tar.gz"
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*DiagnosticBundle)(nil)
+
+// DiagnosticBundleSpec defines the desired state of DiagnosticBundle
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="DiagnosticBundle spec is immutable"
+type DiagnosticBundleSpec struct {
+	// Number of the most recent lines collected from the logs of each operator container. Defaults to 1000.
+	// +optional
+	// +kubebuilder:default=1000
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	LogLines int64 `json:"logLines,omitempty"`
+	// Age of the oldest events collected. Defaults to 1h.
+	// +optional
+	// +kubebuilder:default="1h"
+	EventsSince metav1.Duration `json:"eventsSince,omitempty"`
+}
+
+// DiagnosticBundleStatus defines the observed state of DiagnosticBundle
+type DiagnosticBundleStatus struct {
+	common.Status `json:",inline"`
+
+	// Name of the ConfigMap, in the operator namespace, holding the bundle under the bundle.tar.gz key.
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+	// Time the bundle was collected at.
+	// +optional
+	CollectedAt *metav1.Time `json:"collectedAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="ConfigMap",type=string,JSONPath=`.status.configMap`,description="ConfigMap"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+
+// DiagnosticBundle is the Schema for the diagnosticbundles API, its creation collects the logs of
+// the operator, the platform resources with their conditions and rendered resources, and the recent
+// events, secrets redacted, into a ConfigMap to attach to support cases.
+type DiagnosticBundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiagnosticBundleSpec   `json:"spec,omitempty"`
+	Status DiagnosticBundleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DiagnosticBundleList contains a list of DiagnosticBundle
+type DiagnosticBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DiagnosticBundle `json:"items"`
+}
+
+func (c *DiagnosticBundle) GetStatus() *common.Status {
+	return &c.Status.Status
+}
+
+func (c *DiagnosticBundle) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *DiagnosticBundle) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&DiagnosticBundle{}, &DiagnosticBundleList{})
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -65,6 +66,15 @@ type GatewayConfigSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
 	AuthTimeout string `json:"authTimeout,omitempty"`
+
+	// ServiceMesh selects how the data science services are exposed. With Managed, they are
+	// exposed through the data science Gateway, relying on the Istio based Gateway API
+	// implementation. With Removed, no Gateway is deployed and the services fall back to
+	// OpenShift Routes, authenticating the requests with oauth-proxy sidecars.
+	// +optional
+	// +kubebuilder:default=Managed
+	// +kubebuilder:validation:Enum=Managed;Removed
+	ServiceMesh operatorv1.ManagementState `json:"serviceMesh,omitempty"`
 }
 
 // OIDCConfig defines OIDC provider configuration
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	ManifestExportServiceName = "manifestexport"
+	ManifestExportKind        = "ManifestExport"
+
+	// ManifestExportKey is the key of the ConfigMap holding the export, a gzipped tarball.
+	ManifestExportKey = "export.tar.gz"
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*ManifestExport)(nil)
+
+// ManifestExportFormat is the layout of the exported manifests.
+// +kubebuilder:validation:Enum=Kustomize;Helm
+type ManifestExportFormat string
+
+const (
+	// ManifestExportKustomize exports a kustomize bundle, a kustomization.yaml listing the manifests.
+	ManifestExportKustomize ManifestExportFormat = "Kustomize"
+	// ManifestExportHelm exports a Helm chart whose templates are the manifests.
+	ManifestExportHelm ManifestExportFormat = "Helm"
+)
+
+// ManifestExportSpec defines the desired state of ManifestExport
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ManifestExport spec is immutable"
+type ManifestExportSpec struct {
+	// Layout of the exported manifests: Kustomize or Helm. Defaults to Kustomize.
+	// +optional
+	// +kubebuilder:default=Kustomize
+	Format ManifestExportFormat `json:"format,omitempty"`
+	// Version of the exported Helm chart. Defaults to the version of the operator.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	ChartVersion string `json:"chartVersion,omitempty"`
+}
+
+// ManifestExportStatus defines the observed state of ManifestExport
+type ManifestExportStatus struct {
+	common.Status `json:",inline"`
+
+	// Name of the ConfigMap, in the operator namespace, holding the export under the export.tar.gz key.
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+	// Number of the exported resources.
+	// +optional
+	Resources int `json:"exportedResources,omitempty"`
+	// Time the manifests were exported at.
+	// +optional
+	ExportedAt *metav1.Time `json:"exportedAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Format",type=string,JSONPath=`.spec.format`,description="Format"
+// +kubebuilder:printcolumn:name="ConfigMap",type=string,JSONPath=`.status.configMap`,description="ConfigMap"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+
+// ManifestExport is the Schema for the manifestexports API, its creation exports the manifests the
+// operator deploys for the DataScienceCluster and DSCInitialization, as rendered, secrets redacted,
+// as a kustomize bundle or Helm chart into a ConfigMap, for GitOps teams to review and commit.
+type ManifestExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManifestExportSpec   `json:"spec,omitempty"`
+	Status ManifestExportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ManifestExportList contains a list of ManifestExport
+type ManifestExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManifestExport `json:"items"`
+}
+
+func (c *ManifestExport) GetStatus() *common.Status {
+	return &c.Status.Status
+}
+
+func (c *ManifestExport) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *ManifestExport) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&ManifestExport{}, &ManifestExportList{})
+}
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +32,26 @@ const (
 	MonitoringKind         = "Monitoring"
 )
 
+const (
+	// TracesBackendTempo exports traces to the operator managed Tempo instance.
+	TracesBackendTempo = "tempo"
+	// TracesBackendJaeger exports traces to an external Jaeger collector over OTLP.
+	TracesBackendJaeger = "jaeger"
+	// TracesBackendOTLP exports traces to any external OTLP-compatible endpoint.
+	TracesBackendOTLP = "otlp"
+)
+
+const (
+	// MonitoringSizeSmall sizes the monitoring stack for development and small clusters.
+	MonitoringSizeSmall = "small"
+	// MonitoringSizeMedium sizes the monitoring stack for typical production clusters.
+	MonitoringSizeMedium = "medium"
+	// MonitoringSizeLarge sizes the monitoring stack for large, high-throughput clusters.
+	MonitoringSizeLarge = "large"
+	// MonitoringSizeCustom uses the resource requirements from customResources.
+	MonitoringSizeCustom = "custom"
+)
+
 // Check that the component implements common.PlatformObject.
 var _ common.PlatformObject = (*Monitoring)(nil)
 
@@ -53,13 +74,155 @@ type Metrics struct {
 	// Exporters defines custom metrics exporters for sending metrics to external observability tools.
 	// Each key represents the exporter name, and the value contains the exporter configuration.
 	// The configuration follows the OpenTelemetry Collector exporter format.
-	// Reserved names 'prometheus' and 'otlp/tempo' cannot be used as they conflict with built-in exporters.
+	// String values can reference a key of a secret in the monitoring namespace with
+	// valueFrom.secretKeyRef.name and valueFrom.secretKeyRef.key instead of holding plaintext credentials.
+	// Reserved names 'prometheus', 'otlp/tempo', 'otlp/jaeger' and 'otlp/backend' cannot be used as they conflict
+	// with built-in exporters.
 	// Maximum 10 exporters allowed, each config must be less than 10KB (enforced at reconciliation time).
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="!('prometheus' in self)",message="exporter name 'prometheus' is reserved and cannot be used"
 	// +kubebuilder:validation:XValidation:rule="!('otlp/tempo' in self)",message="exporter name 'otlp/tempo' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/jaeger' in self)",message="exporter name 'otlp/jaeger' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/backend' in self)",message="exporter name 'otlp/backend' is reserved and cannot be used"
 	// +kubebuilder:validation:XValidation:rule="size(self) <= 10",message="maximum 10 exporters allowed"
 	Exporters map[string]runtime.RawExtension `json:"exporters,omitempty"`
+	// Processors defines custom processors added to the metrics pipeline.
+	// Each key represents the processor name, and the value contains the processor configuration.
+	// The configuration follows the OpenTelemetry Collector processor format.
+	// Custom processors run after the built-in memory_limiter, k8sattributes, resourcedetection and resource/common-labels
+	// processors and before batch, in the order given by ProcessorOrder.
+	// Reserved names 'memory_limiter', 'batch', 'k8sattributes', 'resourcedetection' and 'resource/common-labels' cannot be used.
+	// Maximum 10 processors allowed, each config must be less than 10KB (enforced at reconciliation time).
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!('memory_limiter' in self) && !('batch' in self) && !('k8sattributes' in self) && !('resourcedetection' in self) && !('resource/common-labels' in self)",message="processor names 'memory_limiter', 'batch', 'k8sattributes', 'resourcedetection' and 'resource/common-labels' are reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 10",message="maximum 10 processors allowed"
+	Processors map[string]runtime.RawExtension `json:"processors,omitempty"`
+	// ProcessorOrder lists the custom processors in the order they run in the metrics pipeline.
+	// Processors not listed run after the listed ones, ordered by name.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=10
+	ProcessorOrder []string `json:"processorOrder,omitempty"`
+	// ScrapeConfigs configures metrics scraping for individual ODH components.
+	// A ServiceMonitor or PodMonitor is rendered for each enabled component.
+	// +optional
+	// +listType=map
+	// +listMapKey=component
+	ScrapeConfigs []MetricsScrapeConfig `json:"scrapeConfigs,omitempty"`
+	// RemoteWrite sends metrics to external Prometheus-compatible endpoints such as a central
+	// Prometheus, Mimir or Thanos Receive. When neither storage nor resources are configured,
+	// metrics are only remote written and the in-cluster MonitoringStack is not deployed.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=5
+	RemoteWrite []MetricsRemoteWrite `json:"remoteWrite,omitempty"`
+	// DeployDefaultStack deploys the in-cluster metrics stack (MonitoringStack with Prometheus and
+	// Alertmanager, and ThanosQuerier). When false, the collector only forwards metrics to the custom
+	// exporters and remote write endpoints, and a previously deployed stack is removed.
+	// +optional
+	// +kubebuilder:default=true
+	DeployDefaultStack *bool `json:"deployDefaultStack,omitempty"`
+}
+
+// MetricsRemoteWrite defines an external endpoint metrics are remote written to
+// +kubebuilder:validation:XValidation:rule="!has(self.writeRelabelConfigs) || self.writeRelabelConfigs.all(r, r.action in ['keep', 'drop'] && has(r.sourceLabels))",message="writeRelabelConfigs only support the keep and drop actions with sourceLabels"
+type MetricsRemoteWrite struct {
+	// Name identifies the remote write target and is used to name the collector exporter
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+	// URL of the remote write endpoint (e.g., "https://mimir.example.com/api/v1/push")
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern="^https?://"
+	URL string `json:"url"`
+	// Auth configures authentication against the remote write endpoint
+	// +optional
+	Auth *MetricsRemoteWriteAuth `json:"auth,omitempty"`
+	// TLS configures the connection to the remote write endpoint
+	// +optional
+	TLS *MetricsRemoteWriteTLS `json:"tls,omitempty"`
+	// WriteRelabelConfigs filter the samples sent to the endpoint. Only the keep and drop actions are
+	// supported; the __name__ label matches the metric name, other labels match data point attributes.
+	// +optional
+	WriteRelabelConfigs []MetricsRelabelConfig `json:"writeRelabelConfigs,omitempty"`
+}
+
+// MetricsRemoteWriteAuth defines the credentials used for a remote write endpoint.
+// Secrets must exist in the monitoring namespace.
+// +kubebuilder:validation:XValidation:rule="!(has(self.bearerTokenSecret) && has(self.basicAuthSecret))",message="only one of bearerTokenSecret or basicAuthSecret can be specified"
+type MetricsRemoteWriteAuth struct {
+	// BearerTokenSecret is the name of a secret holding the bearer token under the "token" key
+	// +optional
+	BearerTokenSecret string `json:"bearerTokenSecret,omitempty"`
+	// BasicAuthSecret is the name of a secret holding the "username" and "password" keys
+	// +optional
+	BasicAuthSecret string `json:"basicAuthSecret,omitempty"`
+}
+
+// MetricsRemoteWriteTLS defines the TLS settings for a remote write endpoint
+type MetricsRemoteWriteTLS struct {
+	// InsecureSkipVerify disables verification of the server certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// CAConfigMap is the name of a ConfigMap in the monitoring namespace holding the CA certificate under the "ca.crt" key
+	// +optional
+	CAConfigMap string `json:"caConfigMap,omitempty"`
+	// CertificateSecret is the name of a secret in the monitoring namespace holding the client certificate
+	// under the "tls.crt" and "tls.key" keys
+	// +optional
+	CertificateSecret string `json:"certificateSecret,omitempty"`
+}
+
+// MetricsScrapeConfig defines how metrics are scraped for a single ODH component
+type MetricsScrapeConfig struct {
+	// Component is the name of the ODH component whose workloads are scraped
+	// +kubebuilder:validation:Enum=dashboard;datasciencepipelines;feastoperator;kserve;kueue;llamastackoperator;modelcontroller;modelregistry;ray;trainingoperator;trustyai;workbenches
+	Component string `json:"component"`
+	// Enabled enables metrics scraping for the component
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+	// MonitorType selects the kind of monitor rendered for the component
+	// +kubebuilder:validation:Enum=ServiceMonitor;PodMonitor
+	// +kubebuilder:default=ServiceMonitor
+	MonitorType string `json:"monitorType,omitempty"`
+	// Port is the name of the service or container port exposing metrics
+	// +kubebuilder:default=metrics
+	Port string `json:"port,omitempty"`
+	// Path is the HTTP path metrics are exposed on
+	// +kubebuilder:default="/metrics"
+	Path string `json:"path,omitempty"`
+	// Interval at which metrics are scraped (e.g., "30s", "1m")
+	// +kubebuilder:default="30s"
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	Interval string `json:"interval,omitempty"`
+	// Relabelings are applied to the target labels before scraping
+	// +optional
+	Relabelings []MetricsRelabelConfig `json:"relabelings,omitempty"`
+}
+
+// MetricsRelabelConfig defines a Prometheus relabeling rule
+type MetricsRelabelConfig struct {
+	// SourceLabels selects values from existing labels
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	// Separator placed between concatenated source label values
+	// +optional
+	Separator string `json:"separator,omitempty"`
+	// TargetLabel is the label the resulting value is written to
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+	// Regex matched against the extracted value
+	// +optional
+	Regex string `json:"regex,omitempty"`
+	// Replacement value used when the regex matches
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+	// Action to perform based on the regex matching
+	// +kubebuilder:validation:Enum=replace;keep;drop;labelmap;labeldrop;labelkeep;hashmod;lowercase;uppercase
+	// +kubebuilder:default=replace
+	Action string `json:"action,omitempty"`
 }
 
 // MetricsStorage defines the storage configuration for the monitoring service
@@ -67,9 +230,17 @@ type MetricsStorage struct {
 	// Size specifies the storage size for the MonitoringStack (e.g, "5Gi", "10Mi")
 	// +kubebuilder:default="5Gi"
 	Size resource.Quantity `json:"size,omitempty"`
-	// Retention specifies how long metrics data should be retained (e.g., "1d", "2w")
+	// Retention specifies how long metrics data should be retained (e.g., "1d", "2w").
+	// It must be a valid Prometheus duration.
 	// +kubebuilder:default="90d"
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
 	Retention string `json:"retention,omitempty"`
+	// StorageClassName is the storage class of the Prometheus persistent volume claims.
+	// If not set, the default storage class of the cluster is used.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
+	StorageClassName string `json:"storageClassName,omitempty"`
 }
 
 // MetricsResources defines the resource requests and limits for the monitoring service
@@ -108,8 +279,90 @@ type Traces struct {
 	// Exporters defines custom trace exporters for sending traces to external observability tools.
 	// Each key represents the exporter name, and the value contains the exporter configuration.
 	// The configuration follows the OpenTelemetry Collector exporter format.
+	// String values can reference a key of a secret in the monitoring namespace with
+	// valueFrom.secretKeyRef.name and valueFrom.secretKeyRef.key instead of holding plaintext credentials.
+	// Reserved names 'prometheus', 'otlp/tempo', 'otlp/jaeger' and 'otlp/backend' cannot be used as they conflict
+	// with built-in exporters.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="!('prometheus' in self)",message="exporter name 'prometheus' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/tempo' in self)",message="exporter name 'otlp/tempo' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/jaeger' in self)",message="exporter name 'otlp/jaeger' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/backend' in self)",message="exporter name 'otlp/backend' is reserved and cannot be used"
 	Exporters map[string]runtime.RawExtension `json:"exporters,omitempty"`
+	// Processors defines custom processors added to the traces pipeline.
+	// Each key represents the processor name, and the value contains the processor configuration.
+	// The configuration follows the OpenTelemetry Collector processor format.
+	// Custom processors run after the built-in memory_limiter, k8sattributes, resourcedetection and resource/common-labels
+	// processors and before batch, in the order given by ProcessorOrder.
+	// Reserved names 'memory_limiter', 'batch', 'k8sattributes', 'resourcedetection' and 'resource/common-labels' cannot be used.
+	// Maximum 10 processors allowed, each config must be less than 10KB (enforced at reconciliation time).
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!('memory_limiter' in self) && !('batch' in self) && !('k8sattributes' in self) && !('resourcedetection' in self) && !('resource/common-labels' in self)",message="processor names 'memory_limiter', 'batch', 'k8sattributes', 'resourcedetection' and 'resource/common-labels' are reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 10",message="maximum 10 processors allowed"
+	Processors map[string]runtime.RawExtension `json:"processors,omitempty"`
+	// ProcessorOrder lists the custom processors in the order they run in the traces pipeline.
+	// Processors not listed run after the listed ones, ordered by name.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=10
+	ProcessorOrder []string `json:"processorOrder,omitempty"`
+	// Backend selects where the OpenTelemetry Collector sends traces.
+	// If not set, traces are sent to the Tempo instance deployed by the operator.
+	// +optional
+	Backend *TracesBackend `json:"backend,omitempty"`
+}
+
+// TracesBackend defines the OTLP-compatible backend traces are exported to
+// +kubebuilder:validation:XValidation:rule="self.type == 'tempo' || has(self.endpoint)",message="endpoint must be specified when type is jaeger or otlp"
+type TracesBackend struct {
+	// Type defines the trace backend type.
+	// Valid values are "tempo", "jaeger", and "otlp".
+	// When set to "tempo" the operator deploys and manages the Tempo instance.
+	// +kubebuilder:validation:Enum="tempo";"jaeger";"otlp"
+	// +kubebuilder:default="tempo"
+	Type string `json:"type"`
+	// Endpoint is the OTLP gRPC endpoint (host:port) of an external backend.
+	// Required when type is "jaeger" or "otlp", ignored for "tempo".
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	Endpoint string `json:"endpoint,omitempty"`
+	// TLS configuration for connections to an external backend
+	// +optional
+	TLS *TracesBackendTLS `json:"tls,omitempty"`
+	// Auth configuration for connections to an external backend
+	// +optional
+	Auth *TracesBackendAuth `json:"auth,omitempty"`
+}
+
+// TracesBackendTLS defines TLS configuration for an external trace backend
+type TracesBackendTLS struct {
+	// Insecure disables TLS for the connection to the backend
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// InsecureSkipVerify skips verification of the backend certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// CAConfigMap specifies the name of the ConfigMap in the monitoring namespace
+	// containing the CA certificate under the "ca.crt" key
+	// +optional
+	CAConfigMap string `json:"caConfigMap,omitempty"`
+	// CertificateSecret specifies the name of the secret in the monitoring namespace
+	// containing the client certificate ("tls.crt" and "tls.key") for mutual TLS
+	// +optional
+	CertificateSecret string `json:"certificateSecret,omitempty"`
+}
+
+// TracesBackendAuth defines authentication for an external trace backend
+// +kubebuilder:validation:XValidation:rule="!(has(self.bearerTokenSecret) && has(self.basicAuthSecret))",message="only one of bearerTokenSecret or basicAuthSecret can be specified"
+type TracesBackendAuth struct {
+	// BearerTokenSecret specifies the name of the secret in the monitoring namespace
+	// containing the bearer token under the "token" key
+	// +optional
+	BearerTokenSecret string `json:"bearerTokenSecret,omitempty"`
+	// BasicAuthSecret specifies the name of the secret in the monitoring namespace
+	// containing the "username" and "password" keys
+	// +optional
+	BasicAuthSecret string `json:"basicAuthSecret,omitempty"`
 }
 
 // TracesTLS defines TLS configuration for traces collection
@@ -150,8 +403,193 @@ type TracesStorage struct {
 	Retention metav1.Duration `json:"retention,omitempty"`
 }
 
+// Dashboards defines the curated Grafana dashboards deployed by the monitoring service
+type Dashboards struct {
+	// Enabled deploys the curated dashboards covering KServe latency, notebook resource usage and pipeline runs.
+	// GrafanaDashboard resources are created when the Grafana operator is installed, otherwise ConfigMaps
+	// labeled for the Grafana dashboard sidecar are created.
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+	// InstanceSelector are the labels of the Grafana instances the GrafanaDashboard resources are imported into.
+	// All Grafana instances are selected when empty.
+	// +optional
+	InstanceSelector map[string]string `json:"instanceSelector,omitempty"`
+}
+
+// Logs defines the desired state of log collection for the monitoring service
+type Logs struct {
+	// Exporters defines custom log exporters for sending logs to external observability tools.
+	// Each key represents the exporter name, and the value contains the exporter configuration.
+	// The configuration follows the OpenTelemetry Collector exporter format.
+	// String values can reference a key of a secret in the monitoring namespace with
+	// valueFrom.secretKeyRef.name and valueFrom.secretKeyRef.key instead of holding plaintext credentials.
+	// Reserved names 'prometheus', 'otlp/tempo', 'otlp/jaeger' and 'otlp/backend' cannot be used as they conflict
+	// with built-in exporters.
+	// Maximum 10 exporters allowed, each config must be less than 10KB (enforced at reconciliation time).
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!('prometheus' in self)",message="exporter name 'prometheus' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/tempo' in self)",message="exporter name 'otlp/tempo' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/jaeger' in self)",message="exporter name 'otlp/jaeger' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="!('otlp/backend' in self)",message="exporter name 'otlp/backend' is reserved and cannot be used"
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 10",message="maximum 10 exporters allowed"
+	Exporters map[string]runtime.RawExtension `json:"exporters,omitempty"`
+}
+
+// MonitoringCustomResources defines explicit resource requirements for the monitoring stack components
+type MonitoringCustomResources struct {
+	// Collector defines the resource requirements of the OpenTelemetry collector
+	// +optional
+	Collector *corev1.ResourceRequirements `json:"collector,omitempty"`
+	// Prometheus defines the resource requirements of the MonitoringStack Prometheus
+	// +optional
+	Prometheus *corev1.ResourceRequirements `json:"prometheus,omitempty"`
+	// Tempo defines the total resource requirements of the Tempo instance
+	// +optional
+	Tempo *corev1.ResourceRequirements `json:"tempo,omitempty"`
+}
+
 // Alerting configuration for Prometheus
+// +kubebuilder:validation:XValidation:rule="!has(self.route) || (has(self.receivers) && self.receivers.exists(r, r.name == self.route.receiver))",message="route receiver must reference a configured receiver"
+// +kubebuilder:validation:XValidation:rule="!has(self.route) || !has(self.route.routes) || self.route.routes.all(rt, has(self.receivers) && self.receivers.exists(r, r.name == rt.receiver))",message="child route receivers must reference a configured receiver"
 type Alerting struct {
+	// Receivers defines the notification integrations alerts can be sent to.
+	// The rendered Alertmanager configuration is stored in a secret in the monitoring namespace.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	Receivers []AlertingReceiver `json:"receivers,omitempty"`
+	// Route defines how alerts are routed to the configured receivers
+	// +optional
+	Route *AlertingRoute `json:"route,omitempty"`
+}
+
+// AlertingReceiver defines a single Alertmanager receiver.
+// Exactly one of slack, pagerDuty, email or webhook must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.slack), has(self.pagerDuty), has(self.email), has(self.webhook)].filter(x, x).size() == 1",message="exactly one of slack, pagerDuty, email or webhook must be specified"
+type AlertingReceiver struct {
+	// Name of the receiver, referenced from routes
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$"
+	Name string `json:"name"`
+	// Slack sends notifications to a Slack channel
+	// +optional
+	Slack *SlackReceiver `json:"slack,omitempty"`
+	// PagerDuty sends notifications to PagerDuty
+	// +optional
+	PagerDuty *PagerDutyReceiver `json:"pagerDuty,omitempty"`
+	// Email sends notifications by email
+	// +optional
+	Email *EmailReceiver `json:"email,omitempty"`
+	// Webhook sends notifications to a generic HTTP endpoint
+	// +optional
+	Webhook *WebhookReceiver `json:"webhook,omitempty"`
+}
+
+// SlackReceiver defines a Slack notification integration
+type SlackReceiver struct {
+	// APIURLSecret is the name of a secret in the monitoring namespace holding the Slack webhook URL under the "url" key
+	// +kubebuilder:validation:MinLength=1
+	APIURLSecret string `json:"apiURLSecret"`
+	// Channel is the Slack channel or user notifications are sent to
+	// +optional
+	Channel string `json:"channel,omitempty"`
+	// SendResolved controls whether notifications are sent for resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// PagerDutyReceiver defines a PagerDuty notification integration
+type PagerDutyReceiver struct {
+	// RoutingKeySecret is the name of a secret in the monitoring namespace holding the
+	// PagerDuty Events API v2 integration key under the "routingKey" key
+	// +kubebuilder:validation:MinLength=1
+	RoutingKeySecret string `json:"routingKeySecret"`
+	// Severity of the PagerDuty incident
+	// +optional
+	// +kubebuilder:validation:Enum=critical;error;warning;info
+	Severity string `json:"severity,omitempty"`
+	// SendResolved controls whether notifications are sent for resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// EmailReceiver defines an email notification integration
+type EmailReceiver struct {
+	// To is the email address notifications are sent to
+	// +kubebuilder:validation:MinLength=1
+	To string `json:"to"`
+	// From is the sender address
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+	// Smarthost is the SMTP host and port used to send emails (e.g., "smtp.example.com:587")
+	// +kubebuilder:validation:Pattern="^[^:\\s]+:[0-9]+$"
+	Smarthost string `json:"smarthost"`
+	// AuthUsername is the username used to authenticate against the SMTP host
+	// +optional
+	AuthUsername string `json:"authUsername,omitempty"`
+	// AuthPasswordSecret is the name of a secret in the monitoring namespace holding the
+	// SMTP password under the "password" key
+	// +optional
+	AuthPasswordSecret string `json:"authPasswordSecret,omitempty"`
+	// RequireTLS controls whether STARTTLS is required
+	// +kubebuilder:default=true
+	RequireTLS bool `json:"requireTLS"`
+	// SendResolved controls whether notifications are sent for resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// WebhookReceiver defines a generic webhook notification integration
+type WebhookReceiver struct {
+	// URL is the endpoint notifications are posted to
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern="^https?://"
+	URL string `json:"url"`
+	// SendResolved controls whether notifications are sent for resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// AlertingRoute defines the root of the Alertmanager routing tree
+type AlertingRoute struct {
+	// Receiver is the default receiver for alerts not matched by any child route
+	// +kubebuilder:validation:MinLength=1
+	Receiver string `json:"receiver"`
+	// GroupBy lists the labels alerts are grouped by
+	// +optional
+	GroupBy []string `json:"groupBy,omitempty"`
+	// GroupWait is how long to wait before sending the first notification for a group (e.g., "30s")
+	// +optional
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	GroupWait string `json:"groupWait,omitempty"`
+	// GroupInterval is how long to wait before notifying about new alerts in a group (e.g., "5m")
+	// +optional
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	GroupInterval string `json:"groupInterval,omitempty"`
+	// RepeatInterval is how long to wait before re-sending a notification (e.g., "4h")
+	// +optional
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	RepeatInterval string `json:"repeatInterval,omitempty"`
+	// Routes are child routes evaluated in order
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Routes []AlertingSubRoute `json:"routes,omitempty"`
+}
+
+// AlertingSubRoute defines a child route matching alerts to a receiver
+type AlertingSubRoute struct {
+	// Receiver alerts matching this route are sent to
+	// +kubebuilder:validation:MinLength=1
+	Receiver string `json:"receiver"`
+	// Matchers select the alerts handled by this route, using the Alertmanager
+	// matcher syntax (e.g., 'severity="critical"', 'namespace=~"opendatahub|redhat-ods-.*"')
+	// +optional
+	Matchers []string `json:"matchers,omitempty"`
+	// Continue controls whether matching continues with the following sibling routes
+	// +optional
+	Continue bool `json:"continue,omitempty"`
 }
 
 //+kubebuilder:object:root=true
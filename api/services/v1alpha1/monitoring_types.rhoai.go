@@ -20,7 +20,10 @@ package v1alpha1
 
 // MonitoringCommonSpec spec defines the shared desired state of Monitoring
 // +kubebuilder:validation:XValidation:rule="has(self.alerting) ? has(self.metrics.storage) || has(self.metrics.resources) : true",message="Alerting configuration requires metrics.storage or metrics.resources to be configured"
-// +kubebuilder:validation:XValidation:rule="!has(self.collectorReplicas) || (self.collectorReplicas > 0 && ((self.metrics.resources != null || self.metrics.storage != null) || self.traces != null))",message="CollectorReplicas can only be set when metrics.resources, metrics.storage or traces are configured, and must be > 0"
+// +kubebuilder:validation:XValidation:rule="!has(self.alerting) || !has(self.metrics) || !has(self.metrics.deployDefaultStack) || self.metrics.deployDefaultStack",message="Alerting configuration requires metrics.deployDefaultStack to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.size) || self.size != 'custom' || has(self.customResources)",message="customResources must be specified when size is custom"
+// +kubebuilder:validation:XValidation:rule="!has(self.customResources) || (has(self.size) && self.size == 'custom')",message="customResources can only be set when size is custom"
+// +kubebuilder:validation:XValidation:rule="!has(self.collectorReplicas) || (self.collectorReplicas > 0 && ((self.metrics.resources != null || self.metrics.storage != null || has(self.metrics.remoteWrite)) || self.traces != null || self.logs != null))",message="CollectorReplicas can only be set when metrics.resources, metrics.storage, metrics.remoteWrite, traces or logs are configured, and must be > 0"
 type MonitoringCommonSpec struct {
 	// monitoring spec exposed to DSCI api
 	// Namespace for monitoring if it is enabled
@@ -33,9 +36,36 @@ type MonitoringCommonSpec struct {
 	Metrics *Metrics `json:"metrics,omitempty"`
 	// Tracing configuration for OpenTelemetry instrumentation
 	Traces *Traces `json:"traces,omitempty"`
+	// Logs configuration for OpenTelemetry log collection
+	Logs *Logs `json:"logs,omitempty"`
 	// Alerting configuration for Prometheus
 	Alerting *Alerting `json:"alerting,omitempty"`
+	// Dashboards configuration for the curated Grafana dashboards
+	// +optional
+	Dashboards *Dashboards `json:"dashboards,omitempty"`
 	// CollectorReplicas specifies the number of replicas in opentelemetry-collector. If not set, it defaults
 	// to 1 on single-node clusters and 2 on multi-node clusters.
 	CollectorReplicas int32 `json:"collectorReplicas,omitempty"`
+	// Size selects a resource sizing preset for the OpenTelemetry collector, Prometheus and Tempo.
+	// Use "custom" together with customResources to set explicit resource requirements.
+	// Explicit replica counts take precedence over the preset replicas.
+	// +optional
+	// +kubebuilder:validation:Enum=small;medium;large;custom
+	Size string `json:"size,omitempty"`
+	// CustomResources defines the resource requirements used when size is "custom"
+	// +optional
+	CustomResources *MonitoringCustomResources `json:"customResources,omitempty"`
+	// CommonLabels are added to all exported telemetry so that it can be segregated by tenant or cluster
+	// in shared backends. They are set as resource attributes in every collector pipeline and as labels
+	// on the generated ServiceMonitors and PodMonitors.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=20
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) <= 63 && k.matches('^[a-zA-Z]([a-zA-Z0-9_]*[a-zA-Z0-9])?$'))",message="commonLabels keys must be valid Prometheus label names of at most 63 characters"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 63 && self[k].matches('^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$'))",message="commonLabels values must be valid Kubernetes label values"
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+	// RenderOnly renders the monitoring manifests into the data-science-monitoring-rendered ConfigMap
+	// in the monitoring namespace instead of applying them, so they can be reviewed before enabling
+	// monitoring. Secret data is redacted from the rendered manifests.
+	// +optional
+	RenderOnly bool `json:"renderOnly,omitempty"`
 }
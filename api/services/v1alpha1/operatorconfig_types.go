@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	OperatorConfigServiceName  = "operatorconfig"
+	OperatorConfigInstanceName = "default-operatorconfig"
+	OperatorConfigKind         = "OperatorConfig"
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*OperatorConfig)(nil)
+
+// OperatorConfigSpec defines the runtime tunables of the operator, they take precedence over
+// the flags and environment variables of the operator Deployment.
+type OperatorConfigSpec struct {
+	// Logging configuration of the operator, applied without restarting it.
+	// +optional
+	Logging OperatorLoggingSpec `json:"logging,omitempty"`
+	// Manager configuration of the operator, the operator restarts itself to apply a change.
+	// +optional
+	Manager OperatorManagerSpec `json:"manager,omitempty"`
+}
+
+// LogEncoding is the encoding of the logs of the operator.
+// +kubebuilder:validation:Enum=json;console
+type LogEncoding string
+
+const (
+	LogEncodingJSON    LogEncoding = "json"
+	LogEncodingConsole LogEncoding = "console"
+)
+
+// CacheScope is the scope of the cache of the resources the operator deploys.
+// +kubebuilder:validation:Enum=Cluster;Platform
+type CacheScope string
+
+const (
+	// CacheScopeCluster caches all the resources of the kinds the operator deploys.
+	CacheScopeCluster CacheScope = "Cluster"
+	// CacheScopePlatform only caches the resources of the platform.
+	CacheScopePlatform CacheScope = "Platform"
+)
+
+// OperatorLoggingSpec defines the logging configuration of the operator.
+type OperatorLoggingSpec struct {
+	// Log level of the operator: debug, info, error or a verbosity greater than 0, e.g. 3.
+	// Takes precedence over the log level set in the DSCInitialization devFlags.
+	// +optional
+	// +kubebuilder:validation:Pattern="^(debug|info|error|[1-9][0-9]?)$"
+	Level string `json:"level,omitempty"`
+	// Encoding of the logs of the operator: json or console.
+	// +optional
+	Encoding LogEncoding `json:"encoding,omitempty"`
+	// Log levels of single controllers, keyed by controller name, i.e. the lower case kind of the
+	// reconciled resources, e.g. kserve or datasciencecluster. Takes precedence over the log level
+	// of the operator for the logs of these controllers, e.g. to debug a single component.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k].matches('^(debug|info|error|[1-9][0-9]?)$'))",message="Log levels must be debug, info, error or a verbosity greater than 0"
+	Controllers map[string]string `json:"controllers,omitempty"`
+}
+
+// OperatorManagerSpec defines the configuration of the controller manager of the operator.
+type OperatorManagerSpec struct {
+	// Enables leader election, so only one replica of the operator reconciles the resources.
+	// +optional
+	LeaderElection *bool `json:"leaderElection,omitempty"`
+	// Minimum interval at which the cached resources are resynced, triggering their reconciliation.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Maximum number of concurrent reconciliations of each controller.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// Maximum number of concurrent reconciliations per controller, keyed by the lower case kind of the
+	// reconciled resources, e.g. dashboard or datasciencecluster. Overrides maxConcurrentReconciles.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] > 0)",message="Concurrent reconciliations must be greater than 0"
+	ControllerConcurrency map[string]int `json:"controllerConcurrency,omitempty"`
+	// Scope of the cache of the kinds the operator deploys in every namespace, i.e. ServiceAccounts,
+	// Services and ClusterRoleBindings: Cluster caches all of them, Platform only the ServiceAccounts
+	// and Services of the namespaces of the platform and the ClusterRoleBindings labeled as part of
+	// the platform, cutting the memory of the operator on large clusters. The resources missing from
+	// the cache are read from the API server. Defaults to Cluster.
+	// +optional
+	CacheScope CacheScope `json:"cacheScope,omitempty"`
+	// Retry policy of the failed reconciliations, applied to each controller with a retry budget of
+	// its own, so a failing component does not starve the others.
+	// +optional
+	Backoff *OperatorBackoffSpec `json:"backoff,omitempty"`
+}
+
+// OperatorBackoffSpec defines how the failed reconciliations of the controllers are retried.
+type OperatorBackoffSpec struct {
+	// Delay of the first retry of a failed reconciliation, doubled on each consecutive failure of
+	// the same resource. Defaults to 5ms.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+	// Maximum delay between the retries of a failed reconciliation. Defaults to 1000s.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+	// Number of consecutive failed reconciliations of a resource after which it is reported
+	// Degraded with the RetriesExhausted reason and only retried every maxDelay. Unlimited by default.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRetries *int `json:"maxRetries,omitempty"`
+	// Maximum number of retries per second of each controller. Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	QPS *int `json:"qps,omitempty"`
+	// Maximum number of retries of each controller in a burst above qps. Defaults to 100.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Burst *int `json:"burst,omitempty"`
+}
+
+// OperatorConfigStatus defines the observed state of OperatorConfig
+type OperatorConfigStatus struct {
+	common.Status `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-operatorconfig'",message="OperatorConfig name must be default-operatorconfig"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+
+// OperatorConfig is the Schema for the operatorconfigs API
+type OperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperatorConfigSpec   `json:"spec,omitempty"`
+	Status OperatorConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OperatorConfigList contains a list of OperatorConfig
+type OperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorConfig `json:"items"`
+}
+
+func (c *OperatorConfig) GetStatus() *common.Status {
+	return &c.Status.Status
+}
+
+func (c *OperatorConfig) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *OperatorConfig) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorConfig{}, &OperatorConfigList{})
+}
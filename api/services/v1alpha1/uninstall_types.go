@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	UninstallServiceName  = "uninstall"
+	UninstallInstanceName = "default-uninstall"
+	UninstallKind         = "Uninstall"
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*Uninstall)(nil)
+
+// UninstallScope defines the part of the platform an Uninstall removes.
+// +kubebuilder:validation:Enum=All;Components;KeepUserData
+type UninstallScope string
+
+const (
+	// UninstallScopeAll removes the DataScienceCluster, the DSCInitialization, the namespaces
+	// created by the operator and the operator itself.
+	UninstallScopeAll UninstallScope = "All"
+	// UninstallScopeComponents only removes the DataScienceCluster, hence the components, and
+	// keeps the operator installed.
+	UninstallScopeComponents UninstallScope = "Components"
+	// UninstallScopeKeepUserData removes all but the namespaces created by the operator, which
+	// may hold user data, e.g. the volumes of the workbenches.
+	UninstallScopeKeepUserData UninstallScope = "KeepUserData"
+)
+
+// UninstallStep is a step of an Uninstall, the steps run in the order they are declared in.
+type UninstallStep string
+
+const (
+	// UninstallStepDataScienceCluster removes the DataScienceCluster and waits for the components
+	// to be removed.
+	UninstallStepDataScienceCluster UninstallStep = "DataScienceCluster"
+	// UninstallStepDSCInitialization removes the DSCInitialization and waits for the platform
+	// services to be removed.
+	UninstallStepDSCInitialization UninstallStep = "DSCInitialization"
+	// UninstallStepNamespaces removes the namespaces created by the operator.
+	UninstallStepNamespaces UninstallStep = "Namespaces"
+	// UninstallStepOperator removes the operator Subscription and ClusterServiceVersion.
+	UninstallStepOperator UninstallStep = "Operator"
+)
+
+// UninstallSpec defines the desired state of Uninstall
+type UninstallSpec struct {
+	// Part of the platform to remove: All, Components or KeepUserData. Defaults to All.
+	// +optional
+	// +kubebuilder:default=All
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Scope is immutable"
+	Scope UninstallScope `json:"scope,omitempty"`
+}
+
+// UninstallStatus defines the observed state of Uninstall
+type UninstallStatus struct {
+	common.Status `json:",inline"`
+
+	// Step being run.
+	// +optional
+	CurrentStep UninstallStep `json:"currentStep,omitempty"`
+	// Steps completed, in the order they completed.
+	// +optional
+	CompletedSteps []UninstallStep `json:"completedSteps,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-uninstall'",message="Uninstall name must be default-uninstall"
+// +kubebuilder:printcolumn:name="Scope",type=string,JSONPath=`.spec.scope`,description="Scope"
+// +kubebuilder:printcolumn:name="Step",type=string,JSONPath=`.status.currentStep`,description="Step"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+
+// Uninstall is the Schema for the uninstalls API, its creation removes the platform.
+type Uninstall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UninstallSpec   `json:"spec,omitempty"`
+	Status UninstallStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// UninstallList contains a list of Uninstall
+type UninstallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Uninstall `json:"items"`
+}
+
+func (c *Uninstall) GetStatus() *common.Status {
+	return &c.Status.Status
+}
+
+func (c *Uninstall) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *Uninstall) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+// Steps returns the steps run to remove the part of the platform defined by the scope, in order.
+func (s UninstallScope) Steps() []UninstallStep {
+	switch s {
+	case UninstallScopeComponents:
+		return []UninstallStep{UninstallStepDataScienceCluster}
+	case UninstallScopeKeepUserData:
+		return []UninstallStep{UninstallStepDataScienceCluster, UninstallStepDSCInitialization, UninstallStepOperator}
+	default:
+		return []UninstallStep{UninstallStepDataScienceCluster, UninstallStepDSCInitialization, UninstallStepNamespaces, UninstallStepOperator}
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&Uninstall{}, &UninstallList{})
+}
@@ -18,8 +18,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	ocappsv1 "github.com/openshift/api/apps/v1" //nolint:importas //reason: conflicts with appsv1 "k8s.io/api/apps/v1"
@@ -80,10 +82,16 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/digests"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/bundle"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/sharding"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/migration"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/flags"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook/certs"
 
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/dashboard"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/datasciencepipelines"
@@ -99,9 +107,16 @@ import (
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/workbenches"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/auth"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/diagnosticbundle"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/hardwareprofile"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/kfdefmigration"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/manifestexport"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/operatorconfig"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/projecttemplate"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/uninstall"
 )
 
 var (
@@ -164,6 +179,24 @@ type OperatorConfig struct {
 	LogMode             string `mapstructure:"log-mode"`
 	PprofAddr           string `mapstructure:"pprof-bind-address"`
 
+	// Manifests bundle configuration
+	ManifestsSource           string `mapstructure:"manifests-source"`
+	ManifestsBundlePath       string `mapstructure:"manifests-bundle-path"`
+	ManifestsBundleImage      string `mapstructure:"manifests-bundle-image"`
+	ManifestsBundlePullSecret string `mapstructure:"manifests-bundle-pull-secret"`
+	ManifestsBundleChecksum   string `mapstructure:"manifests-bundle-checksum"`
+	ManifestsBundleCAFile     string `mapstructure:"manifests-bundle-ca-file"`
+	ManifestsBundleCacheDir   string `mapstructure:"manifests-bundle-cache-dir"`
+	ManifestsBundlePublicKey  string `mapstructure:"manifests-bundle-public-key"`
+	ManifestsBundleSignature  string `mapstructure:"manifests-bundle-signature"`
+
+	// Webhook certificates management
+	ManageWebhookCerts bool `mapstructure:"manage-webhook-certs"`
+
+	// Sharding of the component controllers
+	ShardCount int `mapstructure:"shard-count"`
+	ShardIndex int `mapstructure:"shard-index"`
+
 	// Zap logging configuration
 	ZapDevel        bool   `mapstructure:"zap-devel"`
 	ZapEncoder      string `mapstructure:"zap-encoder"`
@@ -219,8 +252,20 @@ func main() { //nolint:funlen,maintidx,gocyclo
 
 	ctrl.SetLogger(logger.NewLogger(oconfig.LogMode, &opts))
 
-	// root context
-	ctx := ctrl.SetupSignalHandler()
+	// On large clusters, the component controllers can be split across several shards, each run by
+	// the replicas of one Deployment under the leader election lease of the shard
+	shard := sharding.Config{Count: oconfig.ShardCount, Index: oconfig.ShardIndex}
+	if err := shard.Validate(); err != nil {
+		setupLog.Error(err, "invalid sharding configuration")
+		os.Exit(1)
+	}
+	if shard.IsSharded() {
+		setupLog.Info("running a shard of the component controllers", "shard", shard.Index, "shards", shard.Count)
+	}
+
+	// root context, canceled as well to restart the operator when its manager configuration changes
+	ctx, cancel := context.WithCancel(ctrl.SetupSignalHandler())
+	operatorconfig.OnRestart(cancel)
 	ctx = logf.IntoContext(ctx, setupLog)
 	// Create new uncached client to run initial setup
 	setupCfg, err := config.GetConfig()
@@ -241,10 +286,40 @@ func main() { //nolint:funlen,maintidx,gocyclo
 		os.Exit(1)
 	}
 
+	// The runtime tunables of the OperatorConfig take precedence over the flags
+	operatorConfig, err := operatorconfig.Load(ctx, setupClient)
+	if err != nil {
+		setupLog.Error(err, "unable to load the operator configuration")
+		os.Exit(1)
+	}
+
 	// Get operator platform
 	release := cluster.GetRelease()
 	platform := release.Name
 
+	// Load the manifests bundle, if any, before the services and components use the manifests
+	manifestsLoader := bundle.NewLoader(bundle.Config{
+		Source:         bundle.SourceType(oconfig.ManifestsSource),
+		Path:           oconfig.ManifestsBundlePath,
+		Image:          oconfig.ManifestsBundleImage,
+		PullSecretPath: oconfig.ManifestsBundlePullSecret,
+		Checksum:       oconfig.ManifestsBundleChecksum,
+		CAFile:         oconfig.ManifestsBundleCAFile,
+		CacheDir:       oconfig.ManifestsBundleCacheDir,
+		PublicKeyPath:  oconfig.ManifestsBundlePublicKey,
+		SignaturePath:  oconfig.ManifestsBundleSignature,
+	})
+
+	// A bundle not matching its checksum or signature is not deployed, the manifests of the operator
+	// image are kept and the rejection is reported by the DSCInitialization
+	manifestsVerificationErr := manifestsLoader.Load(ctx, odhdeploy.DefaultManifestPath)
+	if verr := (*bundle.VerificationError)(nil); errors.As(manifestsVerificationErr, &verr) {
+		setupLog.Error(manifestsVerificationErr, "manifests bundle rejected, using the manifests of the operator image")
+	} else if manifestsVerificationErr != nil {
+		setupLog.Error(manifestsVerificationErr, "unable to load the manifests bundle")
+		os.Exit(1)
+	}
+
 	if err := initServices(ctx, platform); err != nil {
 		setupLog.Error(err, "unable to init services")
 		os.Exit(1)
@@ -282,18 +357,14 @@ func main() { //nolint:funlen,maintidx,gocyclo
 			&corev1.ConfigMap{}: {
 				Namespaces: oDHCache,
 			},
-			// For domain to get OpenshiftIngress and default cert
-			&operatorv1.IngressController{}: {
-				Field: fields.Set{"metadata.name": "default"}.AsSelector(),
-			},
-			// For authentication CR "cluster"
-			&configv1.Authentication{}: {
-				Field: fields.Set{"metadata.name": cluster.ClusterAuthenticationObj}.AsSelector(),
-			},
 			// for prometheus and black-box deployment and ones we owns
 			&appsv1.Deployment{}: {
 				Namespaces: oDHCache,
 			},
+			// monitoring reports the readiness of the prometheus and alertmanager statefulsets
+			&appsv1.StatefulSet{}: {
+				Namespaces: oDHCache,
+			},
 			// kueue + monitoring need prometheusrules
 			&promv1.PrometheusRule{}: {
 				Namespaces: oDHCache,
@@ -301,9 +372,6 @@ func main() { //nolint:funlen,maintidx,gocyclo
 			&promv1.ServiceMonitor{}: {
 				Namespaces: oDHCache,
 			},
-			&routev1.Route{}: {
-				Namespaces: oDHCache,
-			},
 			&networkingv1.NetworkPolicy{}: {
 				Namespaces: oDHCache,
 			},
@@ -324,18 +392,63 @@ func main() { //nolint:funlen,maintidx,gocyclo
 		},
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{ // single pod does not need to have LeaderElection
-		Scheme:  scheme,
-		Metrics: ctrlmetrics.Options{BindAddress: oconfig.MetricsAddr},
-		WebhookServer: ctrlwebhook.NewServer(ctrlwebhook.Options{
-			Port: 9443,
-			// TLSOpts: , // TODO: it was not set in the old code
-		}),
+	// The OpenShift APIs are not served by the Kubernetes clusters, the cache can't be configured for them
+	if cluster.IsOpenShift() {
+		// For domain to get OpenshiftIngress and default cert
+		cacheOptions.ByObject[&operatorv1.IngressController{}] = cache.ByObject{
+			Field: fields.Set{"metadata.name": "default"}.AsSelector(),
+		}
+		// For authentication CR "cluster"
+		cacheOptions.ByObject[&configv1.Authentication{}] = cache.ByObject{
+			Field: fields.Set{"metadata.name": cluster.ClusterAuthenticationObj}.AsSelector(),
+		}
+		// For proxy CR "cluster"
+		cacheOptions.ByObject[&configv1.Proxy{}] = cache.ByObject{
+			Field: fields.Set{"metadata.name": cluster.ClusterProxyObj}.AsSelector(),
+		}
+		cacheOptions.ByObject[&routev1.Route{}] = cache.ByObject{
+			Namespaces: oDHCache,
+		}
+	}
+
+	webhookOptions := ctrlwebhook.Options{
+		Port: 9443,
+		// TLSOpts: , // TODO: it was not set in the old code
+	}
+
+	// Without OLM or the service CA, the operator generates and rotates the webhook certificates,
+	// synced once before the webhook server starts so it finds them
+	var certRotator *certs.Rotator
+	if oconfig.ManageWebhookCerts && shard.IsCore() {
+		operatorNs, err := cluster.GetOperatorNamespace()
+		if err != nil {
+			setupLog.Error(err, "unable to get the operator namespace for the webhook certificates")
+			os.Exit(1)
+		}
+
+		certRotator = &certs.Rotator{
+			Client:    setupClient,
+			Namespace: operatorNs,
+			CertDir:   certs.DefaultCertDir,
+		}
+
+		if err := certRotator.Sync(ctx); err != nil {
+			setupLog.Error(err, "unable to set up the webhook certificates")
+			os.Exit(1)
+		}
+
+		webhookOptions.CertDir = certRotator.CertDir
+	}
+
+	mgrOptions := ctrl.Options{ // single pod does not need to have LeaderElection
+		Scheme:                 scheme,
+		Metrics:                ctrlmetrics.Options{BindAddress: oconfig.MetricsAddr},
+		WebhookServer:          ctrlwebhook.NewServer(webhookOptions),
 		PprofBindAddress:       oconfig.PprofAddr,
 		HealthProbeBindAddress: oconfig.HealthProbeAddr,
 		Cache:                  cacheOptions,
 		LeaderElection:         oconfig.LeaderElection,
-		LeaderElectionID:       "07ed84f7.opendatahub.io",
+		LeaderElectionID:       shard.LeaderElectionID("07ed84f7.opendatahub.io"),
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -362,128 +475,179 @@ func main() { //nolint:funlen,maintidx,gocyclo
 				Unstructured: true,
 			},
 		},
-	})
-	if err != nil {
-		setupLog.Error(err, "unable to start manager")
-		os.Exit(1)
 	}
 
-	// Register all webhooks using the helper
-	if err := webhook.RegisterAllWebhooks(mgr); err != nil {
-		setupLog.Error(err, "unable to register webhooks")
+	if err := operatorconfig.ApplyManagerOptions(operatorConfig.Spec.Manager, scheme, oDHCache, &mgrOptions); err != nil {
+		setupLog.Error(err, "invalid manager configuration in the operator configuration")
 		os.Exit(1)
 	}
 
-	if err = (&dscictrl.DSCInitializationReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("dscinitialization-controller"),
-	}).SetupWithManager(ctx, mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DSCInitiatlization")
+	operatorconfig.ApplyBackoff(operatorConfig.Spec.Manager.Backoff)
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	if err = dscctrl.NewDataScienceClusterReconciler(ctx, mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DataScienceCluster")
-		os.Exit(1)
+	if certRotator != nil {
+		if err := mgr.Add(certRotator); err != nil {
+			setupLog.Error(err, "unable to schedule the webhook certificates rotation")
+			os.Exit(1)
+		}
 	}
 
-	// Initialize service reconcilers
-	if err := CreateServiceReconcilers(ctx, mgr); err != nil {
-		setupLog.Error(err, "unable to create service controllers")
+	// The digests of the deployed images are resolved from their registries in the background
+	if err := mgr.Add(digests.Default.Runnable(mgr.GetAPIReader())); err != nil {
+		setupLog.Error(err, "unable to schedule the image digests resolution")
 		os.Exit(1)
 	}
 
+	// The replicas of the other shards only run their component controllers
+	if shard.IsCore() {
+		// Register all webhooks using the helper
+		if err := webhook.RegisterAllWebhooks(mgr); err != nil {
+			setupLog.Error(err, "unable to register webhooks")
+			os.Exit(1)
+		}
+
+		if err = (&dscictrl.DSCInitializationReconciler{
+			Client:   mgr.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("dscinitialization-controller"),
+
+			ManifestsSource:            bundle.SourceType(oconfig.ManifestsSource),
+			ManifestsVerificationError: manifestsVerificationErr,
+		}).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DSCInitiatlization")
+			os.Exit(1)
+		}
+
+		if err = dscctrl.NewDataScienceClusterReconciler(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataScienceCluster")
+			os.Exit(1)
+		}
+
+		// Initialize service reconcilers
+		if err := CreateServiceReconcilers(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create service controllers")
+			os.Exit(1)
+		}
+	}
+
 	// Initialize component reconcilers
-	if err = CreateComponentReconcilers(ctx, mgr); err != nil {
+	if err = CreateComponentReconcilers(ctx, mgr, shard); err != nil {
 		setupLog.Error(err, "unable to create component controllers")
 		os.Exit(1)
 	}
 
-	// Check if user opted for disabling DSC configuration
-	disableDSCConfig, existDSCConfig := os.LookupEnv("DISABLE_DSC_CONFIG")
-	if existDSCConfig && disableDSCConfig != "false" {
-		setupLog.Info("DSCI auto creation is disabled")
-	} else {
-		var createDefaultDSCIFunc manager.RunnableFunc = func(ctx context.Context) error {
-			err := upgrade.CreateDefaultDSCI(ctx, setupClient, platform, oconfig.MonitoringNamespace)
+	if shard.IsCore() {
+		// Check if user opted for disabling DSC configuration
+		disableDSCConfig, existDSCConfig := os.LookupEnv("DISABLE_DSC_CONFIG")
+		if existDSCConfig && disableDSCConfig != "false" {
+			setupLog.Info("DSCI auto creation is disabled")
+		} else {
+			var createDefaultDSCIFunc manager.RunnableFunc = func(ctx context.Context) error {
+				err := upgrade.CreateDefaultDSCI(ctx, setupClient, platform, oconfig.MonitoringNamespace)
+				if err != nil {
+					setupLog.Error(err, "unable to create initial setup for the operator")
+				}
+				return err
+			}
+			err := mgr.Add(createDefaultDSCIFunc)
 			if err != nil {
-				setupLog.Error(err, "unable to create initial setup for the operator")
+				setupLog.Error(err, "error scheduling DSCI creation")
+				os.Exit(1)
 			}
-			return err
 		}
-		err := mgr.Add(createDefaultDSCIFunc)
-		if err != nil {
-			setupLog.Error(err, "error scheduling DSCI creation")
-			os.Exit(1)
+
+		// Create default DSC CR for managed RHOAI
+		if platform == cluster.ManagedRhoai {
+			var createDefaultDSCFunc manager.RunnableFunc = func(ctx context.Context) error {
+				err := upgrade.CreateDefaultDSC(ctx, setupClient)
+				if err != nil {
+					setupLog.Error(err, "unable to create default DSC CR by the operator")
+				}
+				return err
+			}
+			err := mgr.Add(createDefaultDSCFunc)
+			if err != nil {
+				setupLog.Error(err, "error scheduling DSC creation")
+				os.Exit(1)
+			}
 		}
-	}
 
-	// Create default DSC CR for managed RHOAI
-	if platform == cluster.ManagedRhoai {
-		var createDefaultDSCFunc manager.RunnableFunc = func(ctx context.Context) error {
-			err := upgrade.CreateDefaultDSC(ctx, setupClient)
+		var createDefaultGatewayFunc manager.RunnableFunc = func(ctx context.Context) error {
+			defaultGateway := &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: serviceApi.GatewayInstanceName,
+				},
+				Spec: serviceApi.GatewayConfigSpec{
+					Certificate: &infrav1.CertificateSpec{
+						Type:       infrav1.OpenshiftDefaultIngress,
+						SecretName: "default-gateway-tls",
+					},
+				},
+			}
+
+			existingGateway := &serviceApi.GatewayConfig{}
+			err := setupClient.Get(ctx, client.ObjectKey{Name: serviceApi.GatewayInstanceName}, existingGateway)
 			if err != nil {
-				setupLog.Error(err, "unable to create default DSC CR by the operator")
+				if client.IgnoreNotFound(err) == nil {
+					if createErr := setupClient.Create(ctx, defaultGateway); createErr != nil {
+						setupLog.Error(createErr, "unable to create default Gateway CR")
+						return createErr
+					}
+					setupLog.Info("Created default Gateway CR", "name", serviceApi.GatewayInstanceName)
+				} else {
+					setupLog.Error(err, "error checking for existing Gateway CR")
+					return err
+				}
+			} else {
+				setupLog.Info("Default Gateway CR already exists", "name", serviceApi.GatewayInstanceName)
 			}
-			return err
+
+			return nil
 		}
-		err := mgr.Add(createDefaultDSCFunc)
+		err = mgr.Add(createDefaultGatewayFunc)
 		if err != nil {
-			setupLog.Error(err, "error scheduling DSC creation")
+			setupLog.Error(err, "error scheduling Gateway creation")
 			os.Exit(1)
 		}
-	}
 
-	var createDefaultGatewayFunc manager.RunnableFunc = func(ctx context.Context) error {
-		defaultGateway := &serviceApi.GatewayConfig{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: serviceApi.GatewayInstanceName,
-			},
-			Spec: serviceApi.GatewayConfigSpec{
-				Certificate: &infrav1.CertificateSpec{
-					Type:       infrav1.OpenshiftDefaultIngress,
-					SecretName: "default-gateway-tls",
-				},
-			},
+		// Migrate the resources stored in older versions of the platform CRDs, a failed migration is
+		// retried on the next start and reported by the DSCInitialization upgrade preflight checks
+		var migrateStorageVersionsFunc manager.RunnableFunc = func(ctx context.Context) error {
+			if err := migration.Migrate(ctx, setupClient); err != nil {
+				setupLog.Error(err, "unable to migrate resources to the storage version of their CRD")
+			}
+			return nil
 		}
 
-		existingGateway := &serviceApi.GatewayConfig{}
-		err := setupClient.Get(ctx, client.ObjectKey{Name: serviceApi.GatewayInstanceName}, existingGateway)
+		err = mgr.Add(migrateStorageVersionsFunc)
 		if err != nil {
-			if client.IgnoreNotFound(err) == nil {
-				if createErr := setupClient.Create(ctx, defaultGateway); createErr != nil {
-					setupLog.Error(createErr, "unable to create default Gateway CR")
-					return createErr
-				}
-				setupLog.Info("Created default Gateway CR", "name", serviceApi.GatewayInstanceName)
-			} else {
-				setupLog.Error(err, "error checking for existing Gateway CR")
-				return err
-			}
-		} else {
-			setupLog.Info("Default Gateway CR already exists", "name", serviceApi.GatewayInstanceName)
+			setupLog.Error(err, "error scheduling the storage version migration")
 		}
 
-		return nil
-	}
-	err = mgr.Add(createDefaultGatewayFunc)
-	if err != nil {
-		setupLog.Error(err, "error scheduling Gateway creation")
-		os.Exit(1)
-	}
+		// Cleanup resources from previous v2 releases
+		var cleanExistingResourceFunc manager.RunnableFunc = func(ctx context.Context) error {
+			if err = upgrade.CleanupExistingResource(ctx, setupClient, platform, oldReleaseVersion); err != nil {
+				setupLog.Error(err, "unable to perform cleanup")
+			}
+			return err
+		}
 
-	// Cleanup resources from previous v2 releases
-	var cleanExistingResourceFunc manager.RunnableFunc = func(ctx context.Context) error {
-		if err = upgrade.CleanupExistingResource(ctx, setupClient, platform, oldReleaseVersion); err != nil {
-			setupLog.Error(err, "unable to perform cleanup")
+		err = mgr.Add(cleanExistingResourceFunc)
+		if err != nil {
+			setupLog.Error(err, "error remove deprecated resources from previous version")
 		}
-		return err
 	}
 
-	err = mgr.Add(cleanExistingResourceFunc)
-	if err != nil {
-		setupLog.Error(err, "error remove deprecated resources from previous version")
+	// The aggregated health of the platform is served by the metrics server, so external monitors
+	// scrape one signal, without affecting the readiness of the operator itself
+	if err := mgr.AddMetricsServerExtraHandler(dscctrl.HealthPath, dscctrl.NewHealthHandler(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "unable to set up the platform health endpoint")
+		os.Exit(1)
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -500,6 +664,10 @@ func main() { //nolint:funlen,maintidx,gocyclo
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	if operatorconfig.RestartRequested() {
+		setupLog.Info("manager stopped to apply the operator configuration, exiting to be restarted")
+	}
 }
 
 func getCommonCache(platform common.Platform) (map[string]cache.Config, error) {
@@ -549,10 +717,22 @@ func createODHGeneralCacheConfig(platform common.Platform) (map[string]cache.Con
 	return namespaceConfigs, nil
 }
 
-func CreateComponentReconcilers(ctx context.Context, mgr manager.Manager) error {
+func CreateComponentReconcilers(ctx context.Context, mgr manager.Manager, shard sharding.Config) error {
 	l := logf.FromContext(ctx)
 
+	names := make([]string, 0)
+	_ = cr.ForEach(func(ch cr.ComponentHandler) error {
+		names = append(names, ch.GetName())
+		return nil
+	})
+
+	selected := shard.Components(names)
+
 	return cr.ForEach(func(ch cr.ComponentHandler) error {
+		if !slices.Contains(selected, ch.GetName()) {
+			return nil
+		}
+
 		l.Info("creating reconciler", "type", "component", "name", ch.GetName())
 		if err := ch.NewComponentReconciler(ctx, mgr); err != nil {
 			return fmt.Errorf("error creating %s component reconciler: %w", ch.GetName(), err)
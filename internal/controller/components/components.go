@@ -4,6 +4,8 @@ import (
 	"embed"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 )
 
 //go:embed kueue/monitoring
@@ -27,3 +29,14 @@ func NormalizeManagementState(managementState operatorv1.ManagementState) operat
 	}
 	return managementState
 }
+
+// InstanceName returns the name of the component instance created by the given DataScienceCluster:
+// the singleton name of the component, suffixed with the applications namespace when the
+// DataScienceCluster is scoped.
+func InstanceName(dsc *dscv2.DataScienceCluster, name string) string {
+	if !dsc.IsScoped() {
+		return name
+	}
+
+	return name + "-" + dsc.Spec.ApplicationsNamespace
+}
@@ -54,7 +54,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.DashboardInstanceName,
+			Name: components.InstanceName(dsc, componentApi.DashboardInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(dsc.Spec.Components.Dashboard.ManagementState),
 			},
@@ -72,18 +72,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.Dashboard{}
-	c.Name = componentApi.DashboardInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.DashboardInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	ms := components.NormalizeManagementState(dsc.Spec.Components.Dashboard.ManagementState)
 
 	dsc.Status.Components.Dashboard.ManagementState = ms
@@ -20,10 +20,9 @@ import (
 	"context"
 	"fmt"
 
-	consolev1 "github.com/openshift/api/console/v1"
-	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -34,9 +33,20 @@ import (
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
@@ -58,19 +68,25 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		Owns(&rbacv1.RoleBinding{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		// By default, a predicated for changed generation is added by the Owns()
 		// method, however for deployments, we also need to retrieve status info
 		// hence we need a dedicated predicate to react to replicas status change
 		Owns(&appsv1.Deployment{}, reconciler.WithPredicates(resources.NewDeploymentPredicate())).
+		// Ingresses replace the Routes when the ingress type is set to ingress
+		Owns(&networkingv1.Ingress{}).
 		// operands - openshift
-		Owns(&routev1.Route{}).
-		Owns(&consolev1.ConsoleLink{}).
+		OwnsGVK(gvk.Route, reconciler.Dynamic(reconciler.CrdExists(gvk.Route))).
+		OwnsGVK(gvk.ConsoleLink, reconciler.Dynamic(reconciler.CrdExists(gvk.ConsoleLink))).
 		// Those APIs are provided by the component itself hence they should
 		// be watched dynamically
 		OwnsGVK(gvk.DashboardAcceleratorProfile, reconciler.Dynamic(reconciler.CrdExists(gvk.DashboardAcceleratorProfile))).
 		OwnsGVK(gvk.OdhApplication, reconciler.Dynamic()).
 		OwnsGVK(gvk.OdhDocument, reconciler.Dynamic()).
 		OwnsGVK(gvk.OdhQuickStart, reconciler.Dynamic()).
+		// HTTPRoutes replace the Routes when the gatewayapi ingress type is
+		// selected, which requires the Gateway API CRDs
+		OwnsGVK(gvk.HTTPRoute, reconciler.Dynamic(reconciler.CrdExists(gvk.HTTPRoute))).
 		// CRDs are not owned by the component and should be left on the cluster,
 		// so by default, the deploy action won't add all the annotation added to
 		// other resources. Hence, a custom handling is required in order to minimize
@@ -78,7 +94,7 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.DashboardInstanceName)),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Dashboard, componentApi.DashboardInstanceName)),
 			reconciler.WithPredicates(
 				component.ForLabel(labels.ODH.Component(componentName), labels.True)),
 		).
@@ -89,12 +105,22 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			reconciler.Dynamic(),
 			reconciler.WithPredicates(resources.Deleted()),
 		).
+		// the ConfigMaps of the catalog sources are not owned by the component
+		Watches(
+			&corev1.ConfigMap{},
+			reconciler.WithEventHandler(
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Dashboard, componentApi.DashboardInstanceName)),
+			reconciler.WithPredicates(
+				component.ForLabel(labels.DashboardCatalog, labels.True)),
+		).
 		WatchesGVK(gvk.DashboardHardwareProfile, reconciler.WithEventHandler(
-			handlers.ToNamed(componentApi.DashboardInstanceName),
+			handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Dashboard, componentApi.DashboardInstanceName),
 		), reconciler.WithPredicates(predicate.Funcs{
 			GenericFunc: func(tge event.TypedGenericEvent[client.Object]) bool { return false },
 			DeleteFunc:  func(tde event.TypedDeleteEvent[client.Object]) bool { return false },
 		}), reconciler.Dynamic(reconciler.CrdExists(gvk.DashboardHardwareProfile))).
+		// the ingress layer is selected in the DSCInitialization
+		WithWorkloadSettingsWatches(componentApi.DashboardInstanceName).
 		WithAction(initialize).
 		WithAction(setKustomizedParams).
 		WithAction(configureDependencies).
@@ -110,17 +136,33 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.ODH.Component(componentName), labels.True),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, componentName),
 		)).
+		WithAction(configureIngress).
+		WithAction(reconcileCatalog).
+		WithAction(platform.NewAction()).
+		WithAction(networkpolicy.NewAction()).
+		WithAction(podsecurity.NewAction()).
+		WithAction(fips.NewAction()).
+		WithAction(multiarch.NewAction()).
+		WithAction(highavailability.NewAction()).
+		WithAction(deploymentprofile.NewAction()).
+		WithAction(priorityclass.NewAction()).
+		WithAction(disconnected.NewAction()).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction()).
 		WithAction(deployments.NewAction()).
+		WithAction(verify.NewAction()).
+		WithAction(customizeDashboardConfig).
 		WithAction(reconcileHardwareProfiles).
 		WithAction(updateStatus).
 		// must be the final action
 		WithAction(gc.NewAction(
 			gc.WithUnremovables(gvk.OdhDashboardConfig),
+			gc.WithObjectPredicate(gcObjectPredicate),
 		)).
 		// declares the list of additional, controller specific conditions that are
 		// contributing to the controller readiness status
 		WithConditions(conditionTypes...).
+		WithStandardConditions().
 		Build(ctx)
 
 	if err != nil {
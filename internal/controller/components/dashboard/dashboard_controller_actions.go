@@ -12,20 +12,28 @@ import (
 	"github.com/go-logr/logr"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
@@ -76,8 +84,8 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 		return nil
 	}
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return err
 	}
@@ -101,19 +109,118 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
+// configureIngress replaces the rendered OpenShift Routes with Gateway API HTTPRoutes
+// when the gatewayapi ingress type is selected in the DSCInitialization.
+func configureIngress(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	ingress, err := cluster.IngressType(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if ingress != infrav1.IngressTypeGatewayAPI {
+		return nil
+	}
+
+	services := map[string]corev1.Service{}
+	routes := make([]client.Object, 0, 1)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Service {
+			return false, nil
+		}
+
+		svc := corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return false, err
+		}
+
+		services[svc.Name] = svc
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Route {
+			return false, nil
+		}
+
+		hr, err := toHTTPRoute(u, services)
+		if err != nil {
+			return false, err
+		}
+
+		routes = append(routes, hr)
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+		return u.GroupVersionKind() == gvk.Route
+	}); err != nil {
+		return err
+	}
+
+	return rr.AddResources(routes...)
+}
+
 func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	d, ok := rr.Instance.(*componentApi.Dashboard)
 	if !ok {
 		return errors.New("instance is not of type *odhTypes.Dashboard")
 	}
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	ingress, err := cluster.IngressType(ctx, rr.Client)
 	if err != nil {
 		return err
 	}
 
 	// url
+	if ingress == infrav1.IngressTypeGatewayAPI {
+		gatewayDomain, err := gateway.GetGatewayDomain(ctx, rr.Client)
+		if err != nil {
+			return fmt.Errorf("error getting gateway domain: %w", err)
+		}
+
+		d.Status.URL = fmt.Sprintf("https://%s%s", gatewayDomain, dashboardPath)
+
+		return nil
+	}
+
+	if ingress == infrav1.IngressTypeIngress {
+		il := networkingv1.IngressList{}
+		err = rr.Client.List(
+			ctx,
+			&il,
+			client.InNamespace(appNamespace),
+			client.MatchingLabels(map[string]string{
+				labels.PlatformPartOf: strings.ToLower(componentApi.DashboardKind),
+			}),
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list ingresses: %w", err)
+		}
+
+		d.Status.URL = ""
+		if len(il.Items) == 1 {
+			d.Status.URL = resources.KubernetesIngressHost(il.Items[0])
+		}
+
+		return nil
+	}
+
 	rl := routev1.RouteList{}
 	err = rr.Client.List(
 		ctx,
@@ -136,7 +243,130 @@ func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 	return nil
 }
 
+// reconcileCatalog adds the OdhApplications and OdhDocuments of the catalog sources to the rendered
+// resources. A source that can't be read is reported by the CatalogAvailable condition without
+// failing the reconciliation: a URL source serves its last valid content, while the gc action keeps
+// the catalog resources already deployed.
+func reconcileCatalog(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	d, ok := rr.Instance.(*componentApi.Dashboard)
+	if !ok {
+		return errors.New("instance is not of type *odhTypes.Dashboard")
+	}
+
+	if len(d.Spec.CatalogSources) == 0 {
+		rr.Conditions.MarkTrue(
+			status.ConditionCatalogAvailable,
+			conditions.WithReason(status.CatalogNotConfiguredReason),
+			conditions.WithMessage(status.CatalogNotConfiguredMessage),
+		)
+
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	decoder := serializer.NewCodecFactory(rr.Client.Scheme()).UniversalDeserializer()
+	catalog := make([]client.Object, 0)
+	unavailable := make([]string, 0)
+
+	for _, s := range d.Spec.CatalogSources {
+		objs, err := catalogSourceResources(ctx, rr.Client, decoder, s, appNamespace)
+		if err != nil {
+			unavailable = append(unavailable, fmt.Sprintf("%s: %v", catalogSourceName(s, appNamespace), err))
+		}
+
+		for i := range objs {
+			resources.SetLabel(&objs[i], labels.DashboardCatalog, labels.True)
+			catalog = append(catalog, &objs[i])
+		}
+	}
+
+	if err := rr.AddResources(catalog...); err != nil {
+		return fmt.Errorf("failed to add the catalog resources: %w", err)
+	}
+
+	if len(unavailable) != 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionCatalogAvailable,
+			conditions.WithReason(status.CatalogSourceUnavailableReason),
+			conditions.WithMessage(status.CatalogSourceUnavailableMessage, strings.Join(unavailable, "; ")),
+			conditions.WithSeverity(common.ConditionSeverityInfo),
+		)
+
+		return nil
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionCatalogAvailable,
+		conditions.WithMessage(status.CatalogAvailableMessage, len(catalog)),
+	)
+
+	return nil
+}
+
+// gcObjectPredicate keeps the catalog resources while a catalog source is unavailable, as the
+// resources removed from the source can't be told apart from the ones it can't serve.
+func gcObjectPredicate(rr *odhtypes.ReconciliationRequest, obj unstructured.Unstructured) (bool, error) {
+	c := rr.Conditions.GetCondition(status.ConditionCatalogAvailable)
+	if c != nil && c.Reason == status.CatalogSourceUnavailableReason && resources.HasLabel(&obj, labels.DashboardCatalog, labels.True) {
+		return false, nil
+	}
+
+	return gc.DefaultObjectPredicate(rr, obj)
+}
+
+// customizeDashboardConfig applies the customization of the dashboard to the OdhDashboardConfig
+// once it is created. The OdhDashboardConfig is owned by the users, so only the fields of the
+// customization are applied, with a dedicated field owner pruning the ones no longer set.
+func customizeDashboardConfig(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	d, ok := rr.Instance.(*componentApi.Dashboard)
+	if !ok {
+		return errors.New("instance is not of type *odhTypes.Dashboard")
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	current := resources.GvkToUnstructured(gvk.OdhDashboardConfig)
+	err = rr.Client.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: DashboardConfigName}, current)
+	switch {
+	case k8serr.IsNotFound(err):
+		// re-created by the deploy action, customized on the next reconciliation
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get %s: %w", DashboardConfigName, err)
+	}
+
+	desired, err := dashboardConfigFor(d.Spec.Customization, appNamespace)
+	if err != nil {
+		return err
+	}
+
+	err = resources.Apply(ctx, rr.Client, desired,
+		client.ForceOwnership,
+		client.FieldOwner(customizationFieldOwner),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to customize %s: %w", DashboardConfigName, err)
+	}
+
+	return nil
+}
+
 func reconcileHardwareProfiles(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	migrate, err := featuregates.Consult(ctx, rr, featuregates.HardwareProfileMigration)
+	if err != nil {
+		return err
+	}
+	if !migrate {
+		return nil
+	}
+
 	// If the dashboard HWP CRD doesn't exist, skip any migration logic
 	dashHwpCRDExists, err := cluster.HasCRD(ctx, rr.Client, gvk.DashboardHardwareProfile)
 	if err != nil {
@@ -2,19 +2,35 @@
 package dashboard
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
@@ -72,12 +88,19 @@ func TestMigrateHardwareProfiles(t *testing.T) {
 		fakeclient.WithScheme(fakeSchema),
 	)
 	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dashboard := &componentApi.Dashboard{}
 	rr := &types.ReconciliationRequest{
-		Client: cli,
+		Client:     cli,
+		Instance:   dashboard,
+		Conditions: conditions.NewManager(dashboard, status.ConditionTypeReady),
 	}
 
 	err = reconcileHardwareProfiles(ctx, rr)
 	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dashboard).Should(WithTransform(resources.ToUnstructured,
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, featuregates.HardwareProfileMigration.ConditionType()),
+	))
 
 	var createdInfraHWProfile infrav1.HardwareProfile
 	err = cli.Get(ctx, client.ObjectKey{
@@ -94,6 +117,41 @@ func TestMigrateHardwareProfiles(t *testing.T) {
 	g.Expect(createdInfraHWProfile.GetAnnotations()["opendatahub.io/disabled"]).Should(Equal("false"))
 }
 
+func TestMigrateHardwareProfilesDisabled(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "default-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			FeatureGates: map[string]bool{
+				featuregates.HardwareProfileMigration.Name: false,
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dashboard := &componentApi.Dashboard{}
+	rr := &types.ReconciliationRequest{
+		Client:     cli,
+		Instance:   dashboard,
+		Conditions: conditions.NewManager(dashboard, status.ConditionTypeReady),
+	}
+
+	// the dashboard hardware profiles are not even looked up
+	err = reconcileHardwareProfiles(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dashboard).Should(WithTransform(resources.ToUnstructured, And(
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, featuregates.HardwareProfileMigration.ConditionType()),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, featuregates.HardwareProfileMigration.ConditionType(), status.FeatureGateDisabledReason),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .severity == "Info"`, featuregates.HardwareProfileMigration.ConditionType()),
+	)))
+}
+
 func TestCreateInfraHardwareProfile(t *testing.T) {
 	ctx := t.Context()
 	g := NewWithT(t)
@@ -147,3 +205,332 @@ func TestCreateInfraHardwareProfile(t *testing.T) {
 	g.Expect(receivedHardwareProfile.GetAnnotations()["opendatahub.io/description"]).Should(Equal("Test Description"))
 	g.Expect(receivedHardwareProfile.GetAnnotations()["opendatahub.io/disabled"]).Should(Equal("false"))
 }
+
+func TestConfigureIngressGatewayAPI(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(gwapiv1.Install(s)).Should(Succeed())
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: v1.ObjectMeta{Name: "default-dsci"},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			IngressType:           infrav1.IngressTypeGatewayAPI,
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	for _, k := range []schema.GroupVersionKind{gvk.KubernetesGateway, gvk.HTTPRoute} {
+		m, err := cli.RESTMapper().RESTMapping(k.GroupKind(), k.Version)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(cli.Create(ctx, &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: v1.ObjectMeta{Name: m.Resource.GroupResource().String()},
+			Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{k.Version}},
+		})).Should(Succeed())
+	}
+
+	rr := &types.ReconciliationRequest{Client: cli}
+	g.Expect(rr.AddResources(
+		&corev1.Service{
+			ObjectMeta: v1.ObjectMeta{Name: "odh-dashboard", Namespace: "opendatahub"},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "dashboard-ui", Port: 8443, TargetPort: intstr.FromInt32(8443)}},
+			},
+		},
+		&routev1.Route{
+			ObjectMeta: v1.ObjectMeta{Name: "odh-dashboard", Namespace: "opendatahub"},
+			Spec: routev1.RouteSpec{
+				To:   routev1.RouteTargetReference{Kind: "Service", Name: "odh-dashboard"},
+				Port: &routev1.RoutePort{TargetPort: intstr.FromString("dashboard-ui")},
+			},
+		},
+	)).Should(Succeed())
+
+	g.Expect(configureIngress(ctx, rr)).Should(Succeed())
+
+	g.Expect(rr.Resources).Should(HaveLen(2))
+	g.Expect(rr.Resources).ShouldNot(ContainElement(WithTransform(func(u unstructured.Unstructured) schema.GroupVersionKind {
+		return u.GroupVersionKind()
+	}, Equal(gvk.Route))))
+
+	hr := rr.Resources[1]
+	g.Expect(hr.GroupVersionKind()).Should(Equal(gvk.HTTPRoute))
+	g.Expect(hr.GetNamespace()).Should(Equal("opendatahub"))
+	g.Expect(hr.Object).Should(And(
+		jq.Match(`.spec.parentRefs[0] == {"name": "%s", "namespace": "%s"}`, gateway.DefaultGatewayName, gateway.GatewayNamespace),
+		jq.Match(`.spec.rules[0].backendRefs[0].name == "odh-dashboard"`),
+		jq.Match(`.spec.rules[0].backendRefs[0].port == 8443`),
+	))
+}
+
+func TestConfigureIngressDefault(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := &types.ReconciliationRequest{Client: cli}
+	g.Expect(rr.AddResources(&routev1.Route{
+		ObjectMeta: v1.ObjectMeta{Name: "odh-dashboard", Namespace: "opendatahub"},
+	})).Should(Succeed())
+
+	g.Expect(configureIngress(ctx, rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveLen(1))
+	g.Expect(rr.Resources[0].GroupVersionKind()).Should(Equal(gvk.Route))
+}
+
+func TestDashboardConfigFor(t *testing.T) {
+	g := NewWithT(t)
+
+	u, err := dashboardConfigFor(&componentApi.DashboardCustomizationSpec{
+		ProductName: "Acme AI",
+		LogoURL:     "https://acme.example.com/logo.svg",
+		DocumentationLinks: []componentApi.DashboardLink{
+			{Name: "Getting started", URL: "https://docs.acme.example.com/ai"},
+		},
+		DisabledFeatures: []componentApi.DashboardFeature{"ISVBadges", "ModelMesh"},
+	}, "opendatahub")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(u).Should(And(
+		jq.Match(`.kind == "%s" and .metadata.name == "%s" and .metadata.namespace == "opendatahub"`, gvk.OdhDashboardConfig.Kind, DashboardConfigName),
+		jq.Match(`.spec.dashboardConfig == {"disableISVBadges": true, "disableModelMesh": true}`),
+		jq.Match(`.spec.branding | .productName == "Acme AI" and .logoURL == "https://acme.example.com/logo.svg"`),
+		jq.Match(`.spec.branding.documentationLinks == [{"name": "Getting started", "url": "https://docs.acme.example.com/ai"}]`),
+	))
+
+	// without customization, the fields previously applied are pruned
+	u, err = dashboardConfigFor(nil, "opendatahub")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(u).Should(jq.Match(`.spec == {}`))
+}
+
+func TestCustomizeDashboardConfigNotFound(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: v1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	d := &componentApi.Dashboard{
+		ObjectMeta: v1.ObjectMeta{Name: componentApi.DashboardInstanceName},
+		Spec: componentApi.DashboardSpec{
+			DashboardCommonSpec: componentApi.DashboardCommonSpec{
+				Customization: &componentApi.DashboardCustomizationSpec{ProductName: "Acme AI"},
+			},
+		},
+	}
+
+	rr := &types.ReconciliationRequest{Client: cli, Instance: d}
+	g.Expect(customizeDashboardConfig(ctx, rr)).Should(Succeed())
+}
+
+func TestReconcileCatalog(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	const catalog = `
+apiVersion: dashboard.opendatahub.io/v1
+kind: OdhApplication
+metadata:
+  name: internal-tool
+  namespace: other
+spec:
+  displayName: Internal tool
+---
+apiVersion: dashboard.opendatahub.io/v1
+kind: OdhDocument
+metadata:
+  name: internal-tool-doc
+spec:
+  displayName: Internal tool docs
+`
+
+	newDashboard := func(sources ...componentApi.DashboardCatalogSource) *componentApi.Dashboard {
+		return &componentApi.Dashboard{
+			ObjectMeta: v1.ObjectMeta{Name: componentApi.DashboardInstanceName},
+			Spec: componentApi.DashboardSpec{
+				DashboardCommonSpec: componentApi.DashboardCommonSpec{CatalogSources: sources},
+			},
+		}
+	}
+
+	newClient := func(g *WithT, objs ...client.Object) client.Client {
+		objs = append(objs, &dsciv2.DSCInitialization{
+			ObjectMeta: v1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+		})
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		return cli
+	}
+
+	t.Run("reports no catalog when no source is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		d := newDashboard()
+		rr := &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionCatalogAvailable)).Should(And(
+			HaveField("Status", v1.ConditionTrue),
+			HaveField("Reason", status.CatalogNotConfiguredReason),
+		))
+	})
+
+	t.Run("renders the resources of a ConfigMap in the applications namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newClient(g, &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{Name: "catalog", Namespace: appNamespace},
+			Data:       map[string]string{"catalog.yaml": catalog},
+		})
+
+		d := newDashboard(componentApi.DashboardCatalogSource{ConfigMap: "catalog"})
+		rr := &types.ReconciliationRequest{Client: cli, Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(2),
+			jq.Match(`.[0] | .kind == "%s" and .metadata.name == "internal-tool" and .metadata.namespace == "%s"`, gvk.OdhApplication.Kind, appNamespace),
+			jq.Match(`.[1] | .kind == "%s" and .metadata.name == "internal-tool-doc" and .metadata.namespace == "%s"`, gvk.OdhDocument.Kind, appNamespace),
+		))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionCatalogAvailable)).Should(
+			HaveField("Status", v1.ConditionTrue),
+		)
+	})
+
+	t.Run("renders the resources of a URL source", func(t *testing.T) {
+		g := NewWithT(t)
+
+		requests := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(catalog))
+		}))
+		defer srv.Close()
+
+		d := newDashboard(componentApi.DashboardCatalogSource{URL: srv.URL})
+		rr := &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(2),
+			jq.Match(`.[0].metadata.labels."%s" == "true"`, labels.DashboardCatalog),
+		))
+
+		// the content not modified since the last fetch is served from the cache
+		rr = &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(2))
+		g.Expect(requests).Should(Equal(2))
+	})
+
+	t.Run("reports an unavailable URL source without failing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+
+		d := newDashboard(componentApi.DashboardCatalogSource{URL: srv.URL})
+		rr := &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionCatalogAvailable)).Should(And(
+			HaveField("Status", v1.ConditionFalse),
+			HaveField("Reason", status.CatalogSourceUnavailableReason),
+		))
+	})
+
+	t.Run("serves the last content of an unavailable URL source", func(t *testing.T) {
+		g := NewWithT(t)
+
+		available := true
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !available {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			_, _ = w.Write([]byte(catalog))
+		}))
+		defer srv.Close()
+
+		d := newDashboard(componentApi.DashboardCatalogSource{URL: srv.URL})
+		rr := &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+
+		available = false
+		rr = &types.ReconciliationRequest{Client: newClient(g), Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(2))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionCatalogAvailable)).Should(And(
+			HaveField("Status", v1.ConditionFalse),
+			HaveField("Reason", status.CatalogSourceUnavailableReason),
+			HaveField("Message", ContainSubstring("503")),
+		))
+	})
+
+	t.Run("reports a source with an unsupported resource", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newClient(g, &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{Name: "catalog", Namespace: appNamespace},
+			Data: map[string]string{"catalog.yaml": `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+`},
+		})
+
+		d := newDashboard(componentApi.DashboardCatalogSource{ConfigMap: "catalog"})
+		rr := &types.ReconciliationRequest{Client: cli, Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+		g.Expect(reconcileCatalog(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionCatalogAvailable)).Should(
+			HaveField("Reason", status.CatalogSourceUnavailableReason),
+		)
+	})
+}
+
+func TestGCObjectPredicate(t *testing.T) {
+	g := NewWithT(t)
+
+	d := &componentApi.Dashboard{ObjectMeta: v1.ObjectMeta{Name: componentApi.DashboardInstanceName, UID: "uid"}}
+	rr := &types.ReconciliationRequest{Instance: d, Conditions: conditions.NewManager(d, ReadyConditionType)}
+
+	obj := resources.GvkToUnstructured(gvk.OdhApplication)
+	obj.SetLabels(map[string]string{labels.DashboardCatalog: labels.True})
+	obj.SetAnnotations(map[string]string{annotations.InstanceUID: "previous"})
+
+	rr.Conditions.MarkFalse(status.ConditionCatalogAvailable, conditions.WithReason(status.CatalogSourceUnavailableReason))
+	g.Expect(gcObjectPredicate(rr, *obj)).Should(BeFalse())
+
+	rr.Conditions.MarkTrue(status.ConditionCatalogAvailable)
+	g.Expect(gcObjectPredicate(rr, *obj)).Should(BeTrue())
+}
@@ -2,17 +2,32 @@ package dashboard
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
@@ -29,6 +44,19 @@ const (
 
 	// Dashboard path on the gateway.
 	dashboardPath = "/"
+
+	// DashboardConfigName is the name of the OdhDashboardConfig of the applications namespace.
+	DashboardConfigName = "odh-dashboard-config"
+
+	// customizationFieldOwner owns the fields of the OdhDashboardConfig rendered from the
+	// customization of the component, so the fields removed from it are pruned.
+	customizationFieldOwner = resources.PlatformFieldOwner + "/dashboard-customization"
+
+	// catalogFetchTimeout bounds the fetch of a URL catalog source.
+	catalogFetchTimeout = 10 * time.Second
+
+	// catalogMaxSize is the maximum size of a URL catalog source.
+	catalogMaxSize = 1 << 20
 )
 
 var (
@@ -53,9 +81,28 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionCatalogAvailable,
+		status.ConditionTypeReadinessChecksPassed,
+	}
+
+	// catalogKinds are the kinds of the resources a catalog source may hold.
+	catalogKinds = []string{
+		gvk.OdhApplication.Kind,
+		gvk.OdhDocument.Kind,
 	}
+
+	// catalogCache holds the last valid content of each URL catalog source, so an unavailable
+	// source keeps serving its resources.
+	catalogCache   = map[string]catalogContent{}
+	catalogCacheMu sync.Mutex
 )
 
+// catalogContent is the content of a URL catalog source along with its ETag.
+type catalogContent struct {
+	etag    string
+	content []byte
+}
+
 func defaultManifestInfo(p common.Platform) odhtypes.ManifestInfo {
 	return odhtypes.ManifestInfo{
 		Path:       odhdeploy.DefaultManifestPath,
@@ -94,3 +141,249 @@ func computeComponentName() string {
 
 	return name
 }
+
+// toHTTPRoute converts a rendered OpenShift Route into an HTTPRoute attached to the
+// default data science gateway, resolving the backend port from the rendered services
+// as HTTPRoute backends only accept numeric service ports.
+func toHTTPRoute(route *unstructured.Unstructured, services map[string]corev1.Service) (*gwapiv1.HTTPRoute, error) {
+	serviceName, _, err := unstructured.NestedString(route.Object, "spec", "to", "name")
+	if err != nil || serviceName == "" {
+		return nil, fmt.Errorf("route %s has no target service", route.GetName())
+	}
+
+	svc, ok := services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("service %s targeted by route %s not found", serviceName, route.GetName())
+	}
+
+	targetPort, found, err := unstructured.NestedFieldNoCopy(route.Object, "spec", "port", "targetPort")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read target port of route %s: %w", route.GetName(), err)
+	}
+
+	var port int32
+	for _, p := range svc.Spec.Ports {
+		if !found || p.Name == fmt.Sprint(targetPort) || p.TargetPort.String() == fmt.Sprint(targetPort) {
+			port = p.Port
+			break
+		}
+	}
+
+	if port == 0 {
+		return nil, fmt.Errorf("unable to resolve port %v of service %s targeted by route %s", targetPort, serviceName, route.GetName())
+	}
+
+	path := dashboardPath
+	pathPrefix := gwapiv1.PathMatchPathPrefix
+	gatewayNamespace := gwapiv1.Namespace(gateway.GatewayNamespace)
+	servicePort := gwapiv1.PortNumber(port)
+
+	return &gwapiv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gwapiv1.GroupVersion.String(),
+			Kind:       gvk.HTTPRoute.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        route.GetName(),
+			Namespace:   route.GetNamespace(),
+			Labels:      route.GetLabels(),
+			Annotations: route.GetAnnotations(),
+		},
+		Spec: gwapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gwapiv1.CommonRouteSpec{
+				ParentRefs: []gwapiv1.ParentReference{{
+					Name:      gwapiv1.ObjectName(gateway.DefaultGatewayName),
+					Namespace: &gatewayNamespace,
+				}},
+			},
+			Rules: []gwapiv1.HTTPRouteRule{{
+				Matches: []gwapiv1.HTTPRouteMatch{{
+					Path: &gwapiv1.HTTPPathMatch{
+						Type:  &pathPrefix,
+						Value: &path,
+					},
+				}},
+				BackendRefs: []gwapiv1.HTTPBackendRef{{
+					BackendRef: gwapiv1.BackendRef{
+						BackendObjectReference: gwapiv1.BackendObjectReference{
+							Name: gwapiv1.ObjectName(serviceName),
+							Port: &servicePort,
+						},
+					},
+				}},
+			}},
+		},
+	}, nil
+}
+
+// dashboardConfigFor returns the fields of the OdhDashboardConfig set from the customization of
+// the dashboard: each disabled feature turns its disable flag on in the dashboard config.
+func dashboardConfigFor(c *componentApi.DashboardCustomizationSpec, namespace string) (*unstructured.Unstructured, error) {
+	u := resources.GvkToUnstructured(gvk.OdhDashboardConfig)
+	u.SetName(DashboardConfigName)
+	u.SetNamespace(namespace)
+
+	spec := map[string]any{}
+
+	if c != nil {
+		if len(c.DisabledFeatures) != 0 {
+			flags := map[string]any{}
+			for _, f := range c.DisabledFeatures {
+				flags["disable"+string(f)] = true
+			}
+
+			spec["dashboardConfig"] = flags
+		}
+
+		branding := map[string]any{}
+		if c.ProductName != "" {
+			branding["productName"] = c.ProductName
+		}
+		if c.LogoURL != "" {
+			branding["logoURL"] = c.LogoURL
+		}
+		if len(c.DocumentationLinks) != 0 {
+			links := make([]any, 0, len(c.DocumentationLinks))
+			for _, l := range c.DocumentationLinks {
+				links = append(links, map[string]any{
+					"name": l.Name,
+					"url":  l.URL,
+				})
+			}
+
+			branding["documentationLinks"] = links
+		}
+
+		if len(branding) != 0 {
+			spec["branding"] = branding
+		}
+	}
+
+	if err := unstructured.SetNestedMap(u.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set the spec of %s: %w", DashboardConfigName, err)
+	}
+
+	return u, nil
+}
+
+// catalogSourceName identifies a catalog source in the conditions.
+func catalogSourceName(s componentApi.DashboardCatalogSource, ns string) string {
+	if s.URL != "" {
+		return s.URL
+	}
+
+	return "ConfigMap " + ns + "/" + s.ConfigMap
+}
+
+// catalogSourceResources returns the OdhApplication and OdhDocument resources of a catalog source.
+// A URL source that can't be read returns the resources of its last valid content, if any, along
+// with the error.
+func catalogSourceResources(ctx context.Context, cli client.Client, decoder runtime.Decoder, s componentApi.DashboardCatalogSource, ns string) ([]unstructured.Unstructured, error) {
+	if s.URL == "" {
+		cm := corev1.ConfigMap{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: s.ConfigMap}, &cm); err != nil {
+			return nil, err
+		}
+
+		content := make([][]byte, 0, len(cm.Data))
+		for _, k := range slices.Sorted(maps.Keys(cm.Data)) {
+			content = append(content, []byte(cm.Data[k]))
+		}
+
+		return catalogResources(decoder, content, ns)
+	}
+
+	catalogCacheMu.Lock()
+	cached, found := catalogCache[s.URL]
+	catalogCacheMu.Unlock()
+
+	var objs []unstructured.Unstructured
+
+	fetched, err := fetchCatalog(ctx, cli, s.URL, cached)
+	if err == nil {
+		objs, err = catalogResources(decoder, [][]byte{fetched.content}, ns)
+	}
+
+	switch {
+	case err == nil:
+		catalogCacheMu.Lock()
+		catalogCache[s.URL] = fetched
+		catalogCacheMu.Unlock()
+
+		return objs, nil
+	case !found:
+		return nil, err
+	}
+
+	// the cached content has already been decoded successfully
+	objs, _ = catalogResources(decoder, [][]byte{cached.content}, ns)
+
+	return objs, err
+}
+
+// fetchCatalog fetches the content of a URL catalog source through the cluster proxy, trusting the
+// cluster trusted CA bundle. The cached content is returned as is when not modified.
+func fetchCatalog(ctx context.Context, cli client.Client, url string, cached catalogContent) (catalogContent, error) {
+	httpClient, err := deploy.NewProxyHTTPClient(ctx, cli, catalogFetchTimeout)
+	if err != nil {
+		return catalogContent{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return catalogContent{}, err
+	}
+
+	if cached.etag != "" {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return catalogContent{}, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached.etag != "":
+		return cached, nil
+	case resp.StatusCode != http.StatusOK:
+		return catalogContent{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, catalogMaxSize+1))
+	if err != nil {
+		return catalogContent{}, err
+	}
+
+	if len(content) > catalogMaxSize {
+		return catalogContent{}, errors.New("content exceeds 1MiB")
+	}
+
+	return catalogContent{etag: resp.Header.Get("ETag"), content: content}, nil
+}
+
+// catalogResources decodes the OdhApplication and OdhDocument resources of a catalog source
+// and moves them to the applications namespace.
+func catalogResources(decoder runtime.Decoder, content [][]byte, ns string) ([]unstructured.Unstructured, error) {
+	result := make([]unstructured.Unstructured, 0)
+
+	for _, c := range content {
+		objs, err := resources.Decode(decoder, c)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range objs {
+			if objs[i].GroupVersionKind().Group != gvk.OdhApplication.Group || !slices.Contains(catalogKinds, objs[i].GetKind()) {
+				return nil, fmt.Errorf("unsupported resource %s %s", objs[i].GetKind(), objs[i].GetName())
+			}
+
+			objs[i].SetNamespace(ns)
+		}
+
+		result = append(result, objs...)
+	}
+
+	return result, nil
+}
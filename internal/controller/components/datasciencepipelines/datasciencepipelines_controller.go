@@ -19,20 +19,32 @@ package datasciencepipelines
 import (
 	"context"
 
-	securityv1 "github.com/openshift/api/security/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
@@ -51,33 +63,51 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		Owns(&rbacv1.RoleBinding{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&monitoringv1.ServiceMonitor{}).
 		Owns(&appsv1.Deployment{}, reconciler.WithPredicates(resources.NewDeploymentPredicate())).
-		Owns(&securityv1.SecurityContextConstraints{}).
+		OwnsGVK(gvk.SecurityContextConstraints, reconciler.Dynamic(reconciler.CrdExists(gvk.SecurityContextConstraints))).
+		Owns(&corev1.PersistentVolumeClaim{}).
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.DataSciencePipelinesInstanceName)),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.DataSciencePipelines, componentApi.DataSciencePipelinesInstanceName)),
 			reconciler.WithPredicates(
 				component.ForLabel(labels.ODH.Component(LegacyComponentName), labels.True)),
 		).
+		WithWorkloadSettingsWatches(componentApi.DataSciencePipelinesInstanceName).
 		WithAction(checkPreConditions).
+		WithAction(checkObjectStorage).
 		WithAction(initialize).
 		WithAction(argoWorkflowsControllersOptions).
+		WithAction(objectStorageOptions).
 		WithAction(releases.NewAction()).
 		WithAction(kustomize.NewAction(
 			kustomize.WithLabel(labels.ODH.Component(LegacyComponentName), labels.True),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, LegacyComponentName),
 		)).
+		WithAction(deployObjectStorage).
+		WithAction(platform.NewAction()).
+		WithAction(networkpolicy.NewAction()).
+		WithAction(podsecurity.NewAction()).
+		WithAction(fips.NewAction()).
+		WithAction(multiarch.NewAction()).
+		WithAction(highavailability.NewAction()).
+		WithAction(deploymentprofile.NewAction()).
+		WithAction(priorityclass.NewAction()).
+		WithAction(disconnected.NewAction()).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(verify.NewAction()).
 		// must be the final action
 		WithAction(gc.NewAction()).
 		// declares the list of additional, controller specific conditions that are
 		// contributing to the controller readiness status
 		WithConditions(conditionTypes...).
+		WithStandardConditions().
 		Build(ctx)
 
 	if err != nil {
@@ -23,7 +23,9 @@ import (
 	"path"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -137,3 +139,176 @@ func argoWorkflowsControllersOptions(ctx context.Context, rr *odhtypes.Reconcili
 
 	return nil
 }
+
+// checkObjectStorage validates the default object storage of the pipeline servers, when an
+// external one is set: the credentials Secret must exist in the applications namespace and
+// the endpoint must accept connections. The reconciliation is stopped otherwise, so the
+// pipeline servers are not configured with an object storage they can't use.
+func checkObjectStorage(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	dsp, ok := rr.Instance.(*componentApi.DataSciencePipelines)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.DataSciencePipelines)", rr.Instance)
+	}
+
+	objectStorage := dsp.Spec.ObjectStorage
+
+	switch {
+	case objectStorage == nil:
+		rr.Conditions.MarkTrue(
+			status.ConditionObjectStorageAvailable,
+			conditions.WithReason(status.ObjectStorageNotConfiguredReason),
+			conditions.WithMessage(status.ObjectStorageNotConfiguredMessage),
+		)
+
+		return nil
+	case objectStorage.Type == componentApi.ObjectStorageTypeMinIO:
+		// the availability of the in-cluster MinIO is reported with the other deployments
+		rr.Conditions.MarkTrue(
+			status.ConditionObjectStorageAvailable,
+			conditions.WithReason(status.ObjectStorageBundledReason),
+			conditions.WithMessage(status.ObjectStorageBundledMessage),
+		)
+
+		return nil
+	case objectStorage.External == nil:
+		return odherr.NewStopError("external is required with the %s object storage type", objectStorage.Type)
+	}
+
+	ext := objectStorage.External
+
+	ns, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	accessKeyKey, secretKeyKey := objectStorageCredentialKeys(ext)
+
+	credentials := corev1.Secret{}
+	err = rr.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: ext.CredentialsSecretRef.Name}, &credentials)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to get object storage credentials Secret %s: %w", ext.CredentialsSecretRef.Name, err)
+	}
+
+	if k8serr.IsNotFound(err) || len(credentials.Data[accessKeyKey]) == 0 || len(credentials.Data[secretKeyKey]) == 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionObjectStorageAvailable,
+			conditions.WithReason(status.ObjectStorageCredentialsInvalidReason),
+			conditions.WithMessage(status.ObjectStorageCredentialsInvalidMessage, ns, ext.CredentialsSecretRef.Name, accessKeyKey, secretKeyKey),
+		)
+
+		return odherr.NewStopError(status.ObjectStorageCredentialsInvalidMessage, ns, ext.CredentialsSecretRef.Name, accessKeyKey, secretKeyKey)
+	}
+
+	address := objectStorageAddress(ext)
+
+	if err := dialObjectStorage(ctx, address); err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionObjectStorageAvailable,
+			conditions.WithReason(status.ObjectStorageUnreachableReason),
+			conditions.WithMessage(status.ObjectStorageUnreachableMessage, address, err),
+		)
+
+		return odherr.NewStopError(status.ObjectStorageUnreachableMessage, address, err)
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionObjectStorageAvailable,
+		conditions.WithReason(status.ObjectStorageReachableReason),
+		conditions.WithMessage(status.ObjectStorageReachableMessage, address),
+	)
+
+	return nil
+}
+
+// objectStorageOptions sets the default object storage of the pipeline servers in the params
+// of the data science pipelines operator.
+func objectStorageOptions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	dsp, ok := rr.Instance.(*componentApi.DataSciencePipelines)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.DataSciencePipelines)", rr.Instance)
+	}
+
+	// the in-cluster MinIO is exposed in the applications namespace
+	appNamespace := ""
+	if objectStorage := dsp.Spec.ObjectStorage; objectStorage != nil && objectStorage.Type == componentApi.ObjectStorageTypeMinIO {
+		ns, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+		if err != nil {
+			return err
+		}
+
+		appNamespace = ns
+	}
+
+	paramsPath := path.Join(odhdeploy.DefaultManifestPath, ComponentName, "base")
+
+	if err := odhdeploy.ApplyParams(paramsPath, "params.env", nil, objectStorageParams(appNamespace, dsp.Spec.ObjectStorage)); err != nil {
+		return fmt.Errorf("failed to update params.env: %w", err)
+	}
+
+	return nil
+}
+
+// deployObjectStorage deploys the in-cluster MinIO in the applications namespace, with the
+// access and secret keys generated on its first deployment. Without it, its resources are
+// pruned by the gc action, but its volume.
+func deployObjectStorage(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	dsp, ok := rr.Instance.(*componentApi.DataSciencePipelines)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.DataSciencePipelines)", rr.Instance)
+	}
+
+	objectStorage := dsp.Spec.ObjectStorage
+	if objectStorage == nil || objectStorage.Type != componentApi.ObjectStorageTypeMinIO {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	accessKey, secretKey, err := minIOKeys(ctx, rr.Client, appNamespace)
+	if err != nil {
+		return err
+	}
+
+	err = rr.AddResources(
+		minIOCredentials(appNamespace, accessKey, secretKey),
+		minIOVolume(appNamespace, objectStorage.StorageSize),
+		minIODeployment(appNamespace, objectStorageBucket(objectStorage)),
+		minIOService(appNamespace),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add in-cluster MinIO: %w", err)
+	}
+
+	return nil
+}
+
+// minIOKeys returns the access and secret keys of the in-cluster MinIO, the ones of its
+// credentials Secret when it exists, or newly generated ones.
+func minIOKeys(ctx context.Context, cli client.Client, namespace string) (string, string, error) {
+	credentials := corev1.Secret{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: MinIOName}, &credentials)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return "", "", fmt.Errorf("failed to get MinIO credentials Secret %s: %w", MinIOName, err)
+	}
+
+	accessKey := string(credentials.Data[defaultObjectStorageAccessKeyKey])
+	secretKey := string(credentials.Data[defaultObjectStorageSecretKeyKey])
+
+	if accessKey != "" && secretKey != "" {
+		return accessKey, secretKey, nil
+	}
+
+	for _, key := range []*string{&accessKey, &secretKey} {
+		s, err := cluster.NewSecret(MinIOName, "random", minIOCredentialsLength)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate MinIO credentials: %w", err)
+		}
+
+		*key = s.Value
+	}
+
+	return accessKey, secretKey, nil
+}
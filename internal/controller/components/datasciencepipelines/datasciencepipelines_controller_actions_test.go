@@ -2,22 +2,31 @@
 package datasciencepipelines
 
 import (
+	"encoding/base64"
+	"net"
 	"os"
 	"path"
 	"testing"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherr "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
@@ -401,3 +410,267 @@ func TestArgoWorkflowsControllersOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckObjectStorage(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start object storage listener: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to parse object storage listener address: %v", err)
+	}
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "dsp-s3-credentials", Namespace: appNamespace},
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     []byte("access"),
+			"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+		},
+	}
+
+	newExternal := func(extPort string) *componentApi.PipelinesObjectStorageSpec {
+		p, err := net.LookupPort("tcp", extPort)
+		if err != nil {
+			t.Fatalf("failed to parse object storage port: %v", err)
+		}
+
+		return &componentApi.PipelinesObjectStorageSpec{
+			Type: componentApi.ObjectStorageTypeExternal,
+			External: &componentApi.ExternalObjectStorageSpec{
+				Host:                 host,
+				Port:                 int32(p), //nolint:gosec
+				Secure:               ptr.To(false),
+				CredentialsSecretRef: componentApi.ObjectStorageCredentialsSecretRef{Name: credentials.Name},
+			},
+		}
+	}
+
+	newRequest := func(objectStorage *componentApi.PipelinesObjectStorageSpec, objs ...client.Object) (*componentApi.DataSciencePipelines, *types.ReconciliationRequest) {
+		dsp := &componentApi.DataSciencePipelines{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.DataSciencePipelinesInstanceName},
+			Spec: componentApi.DataSciencePipelinesSpec{
+				DataSciencePipelinesCommonSpec: componentApi.DataSciencePipelinesCommonSpec{ObjectStorage: objectStorage},
+			},
+		}
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(append(objs, dsci.DeepCopy())...))
+		if err != nil {
+			t.Fatalf("failed to create fake client: %v", err)
+		}
+
+		return dsp, &types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   dsp,
+			Conditions: conditions.NewManager(dsp, ReadyConditionType),
+		}
+	}
+
+	t.Run("leaves the object storage to the pipeline servers when none is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsp, rr := newRequest(nil)
+
+		g.Expect(checkObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(dsp).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionObjectStorageAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionObjectStorageAvailable, status.ObjectStorageNotConfiguredReason),
+		)))
+	})
+
+	t.Run("reports the in-cluster MinIO", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsp, rr := newRequest(&componentApi.PipelinesObjectStorageSpec{Type: componentApi.ObjectStorageTypeMinIO})
+
+		g.Expect(checkObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(dsp).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionObjectStorageAvailable, status.ObjectStorageBundledReason),
+		))
+	})
+
+	t.Run("reports missing credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsp, rr := newRequest(newExternal(port))
+
+		err := checkObjectStorage(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherr.StopError{}))
+		g.Expect(dsp).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionObjectStorageAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionObjectStorageAvailable, status.ObjectStorageCredentialsInvalidReason),
+		)))
+	})
+
+	t.Run("reports an unreachable endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		_, closedPort, err := net.SplitHostPort(closed.Addr().String())
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(closed.Close()).Should(Succeed())
+
+		dsp, rr := newRequest(newExternal(closedPort), credentials.DeepCopy())
+
+		err = checkObjectStorage(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherr.StopError{}))
+		g.Expect(dsp).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionObjectStorageAvailable, status.ObjectStorageUnreachableReason),
+		))
+	})
+
+	t.Run("reports a reachable endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dsp, rr := newRequest(newExternal(port), credentials.DeepCopy())
+
+		g.Expect(checkObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(dsp).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionObjectStorageAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionObjectStorageAvailable, status.ObjectStorageReachableReason),
+		)))
+	})
+}
+
+func TestObjectStorageParams(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(objectStorageParams("", nil)).Should(And(
+		HaveKeyWithValue("OBJECTSTORAGE_TYPE", ""),
+		HaveKeyWithValue("OBJECTSTORAGE_HOST", ""),
+		HaveKeyWithValue("OBJECTSTORAGE_SECRET_NAME", ""),
+	))
+
+	g.Expect(objectStorageParams("opendatahub", &componentApi.PipelinesObjectStorageSpec{
+		Type: componentApi.ObjectStorageTypeMinIO,
+	})).Should(And(
+		HaveKeyWithValue("OBJECTSTORAGE_TYPE", "MinIO"),
+		HaveKeyWithValue("OBJECTSTORAGE_HOST", MinIOName+".opendatahub.svc"),
+		HaveKeyWithValue("OBJECTSTORAGE_PORT", "9000"),
+		HaveKeyWithValue("OBJECTSTORAGE_SCHEME", "http"),
+		HaveKeyWithValue("OBJECTSTORAGE_BUCKET", "pipelines"),
+		HaveKeyWithValue("OBJECTSTORAGE_SECRET_NAME", MinIOName),
+	))
+
+	g.Expect(objectStorageParams("opendatahub", &componentApi.PipelinesObjectStorageSpec{
+		Type:   componentApi.ObjectStorageTypeExternal,
+		Bucket: "artifacts",
+		External: &componentApi.ExternalObjectStorageSpec{
+			Host:                 "s3.us-east-1.amazonaws.com",
+			Region:               "us-east-1",
+			CredentialsSecretRef: componentApi.ObjectStorageCredentialsSecretRef{Name: "dsp-s3-credentials", SecretKeyKey: "secret"},
+		},
+	})).Should(And(
+		HaveKeyWithValue("OBJECTSTORAGE_TYPE", "External"),
+		HaveKeyWithValue("OBJECTSTORAGE_HOST", "s3.us-east-1.amazonaws.com"),
+		HaveKeyWithValue("OBJECTSTORAGE_PORT", "443"),
+		HaveKeyWithValue("OBJECTSTORAGE_SCHEME", "https"),
+		HaveKeyWithValue("OBJECTSTORAGE_BUCKET", "artifacts"),
+		HaveKeyWithValue("OBJECTSTORAGE_REGION", "us-east-1"),
+		HaveKeyWithValue("OBJECTSTORAGE_SECRET_NAME", "dsp-s3-credentials"),
+		HaveKeyWithValue("OBJECTSTORAGE_ACCESS_KEY_KEY", "AWS_ACCESS_KEY_ID"),
+		HaveKeyWithValue("OBJECTSTORAGE_SECRET_KEY_KEY", "secret"),
+	))
+}
+
+func TestDeployObjectStorage(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+
+	newRequest := func(t *testing.T, objectStorage *componentApi.PipelinesObjectStorageSpec, objs ...client.Object) *types.ReconciliationRequest {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(append(objs, dsci.DeepCopy())...))
+		if err != nil {
+			t.Fatalf("failed to create fake client: %v", err)
+		}
+
+		return &types.ReconciliationRequest{
+			Client: cli,
+			Instance: &componentApi.DataSciencePipelines{
+				ObjectMeta: metav1.ObjectMeta{Name: componentApi.DataSciencePipelinesInstanceName},
+				Spec: componentApi.DataSciencePipelinesSpec{
+					DataSciencePipelinesCommonSpec: componentApi.DataSciencePipelinesCommonSpec{ObjectStorage: objectStorage},
+				},
+			},
+		}
+	}
+
+	t.Run("does nothing with an external object storage", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, &componentApi.PipelinesObjectStorageSpec{Type: componentApi.ObjectStorageTypeExternal})
+
+		g.Expect(deployObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+	})
+
+	t.Run("deploys the in-cluster MinIO with generated credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, &componentApi.PipelinesObjectStorageSpec{
+			Type:        componentApi.ObjectStorageTypeMinIO,
+			Bucket:      "artifacts",
+			StorageSize: resource.MustParse("20Gi"),
+		})
+
+		g.Expect(deployObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(4))
+
+		g.Expect(rr.Resources[0].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.Secret.Kind),
+			jq.Match(`.metadata.namespace == "%s"`, appNamespace),
+			jq.Match(`.data.AWS_ACCESS_KEY_ID | length > 0`),
+			jq.Match(`.data.AWS_SECRET_ACCESS_KEY | length > 0`),
+		))
+		g.Expect(rr.Resources[1].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.PersistentVolumeClaim.Kind),
+			jq.Match(`.metadata.annotations."%s" == "false"`, annotations.ManagedByODHOperator),
+			jq.Match(`.spec.resources.requests.storage == "20Gi"`),
+		))
+		g.Expect(rr.Resources[2].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.Deployment.Kind),
+			jq.Match(`.spec.template.spec.containers[0].args[0] | contains("/data/artifacts")`),
+		))
+		g.Expect(rr.Resources[3].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.Service.Kind),
+			jq.Match(`.spec.ports[0].port == 9000`),
+		))
+	})
+
+	t.Run("keeps the credentials of the in-cluster MinIO", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, &componentApi.PipelinesObjectStorageSpec{Type: componentApi.ObjectStorageTypeMinIO}, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: MinIOName, Namespace: appNamespace},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+			},
+		})
+
+		g.Expect(deployObjectStorage(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources[0].Object).Should(And(
+			jq.Match(`.data.AWS_ACCESS_KEY_ID == "%s"`, base64.StdEncoding.EncodeToString([]byte("access"))),
+			jq.Match(`.data.AWS_SECRET_ACCESS_KEY == "%s"`, base64.StdEncoding.EncodeToString([]byte("secret"))),
+		))
+	})
+}
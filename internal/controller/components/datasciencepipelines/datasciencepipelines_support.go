@@ -1,15 +1,29 @@
 package datasciencepipelines
 
 import (
+	"context"
+	"net"
+	"os"
 	"path"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 const (
@@ -28,6 +42,23 @@ const (
 	platformVersionParamsKey          = "PLATFORMVERSION"
 	fipsEnabledParamsKey              = "FIPSENABLED"
 	argoWorkflowsControllersParamsKey = "ARGOWORKFLOWSCONTROLLERS"
+
+	// MinIOName is the name of the resources of the in-cluster MinIO deployed in the
+	// applications namespace.
+	MinIOName = "ds-pipelines-minio"
+	// minIOImageEnv is the environment variable overriding the image of the in-cluster MinIO.
+	minIOImageEnv     = "RELATED_IMAGE_DSP_MINIO_IMAGE"
+	defaultMinIOImage = "quay.io/opendatahub/minio:RELEASE.2019-08-14T20-37-41Z-license-compliance"
+	minIOPort         = 9000
+	// minIOCredentialsLength is the length of the generated access and secret keys of the
+	// in-cluster MinIO.
+	minIOCredentialsLength = 24
+
+	// objectStorageDialTimeout bounds the connectivity check of the external object storage.
+	objectStorageDialTimeout = 5 * time.Second
+
+	defaultObjectStorageAccessKeyKey = "AWS_ACCESS_KEY_ID"
+	defaultObjectStorageSecretKeyKey = "AWS_SECRET_ACCESS_KEY"
 )
 
 var (
@@ -62,7 +93,9 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionArgoWorkflowAvailable,
+		status.ConditionObjectStorageAvailable,
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 
 	paramsPath = path.Join(odhdeploy.DefaultManifestPath, ComponentName, "base")
@@ -75,3 +108,256 @@ func manifestPath(p common.Platform) types.ManifestInfo {
 		SourcePath: overlaysSourcePaths[p],
 	}
 }
+
+// objectStorageCredentialKeys returns the keys of the credentials Secret holding the access
+// and secret keys of the external object storage.
+func objectStorageCredentialKeys(ext *componentApi.ExternalObjectStorageSpec) (string, string) {
+	accessKeyKey := ext.CredentialsSecretRef.AccessKeyKey
+	if accessKeyKey == "" {
+		accessKeyKey = defaultObjectStorageAccessKeyKey
+	}
+
+	secretKeyKey := ext.CredentialsSecretRef.SecretKeyKey
+	if secretKeyKey == "" {
+		secretKeyKey = defaultObjectStorageSecretKeyKey
+	}
+
+	return accessKeyKey, secretKeyKey
+}
+
+// objectStorageSecure returns whether the connections to the external object storage use TLS.
+func objectStorageSecure(ext *componentApi.ExternalObjectStorageSpec) bool {
+	return ptr.Deref(ext.Secure, true)
+}
+
+// objectStoragePort returns the port of the external object storage, 443 or 80 depending on
+// TLS when none is set.
+func objectStoragePort(ext *componentApi.ExternalObjectStorageSpec) int32 {
+	switch {
+	case ext.Port != 0:
+		return ext.Port
+	case objectStorageSecure(ext):
+		return 443
+	default:
+		return 80
+	}
+}
+
+// objectStorageAddress returns the host:port address of the external object storage.
+func objectStorageAddress(ext *componentApi.ExternalObjectStorageSpec) string {
+	return net.JoinHostPort(ext.Host, strconv.Itoa(int(objectStoragePort(ext))))
+}
+
+// objectStorageBucket returns the bucket storing the pipeline artifacts.
+func objectStorageBucket(objectStorage *componentApi.PipelinesObjectStorageSpec) string {
+	if objectStorage.Bucket != "" {
+		return objectStorage.Bucket
+	}
+
+	return "pipelines"
+}
+
+// objectStorageParams returns the params.env entries configuring the default object storage of
+// the pipeline servers. All the entries are emptied when no object storage is set, so each
+// pipeline server keeps configuring its own.
+func objectStorageParams(appNamespace string, objectStorage *componentApi.PipelinesObjectStorageSpec) map[string]string {
+	params := map[string]string{
+		"OBJECTSTORAGE_TYPE":           "",
+		"OBJECTSTORAGE_HOST":           "",
+		"OBJECTSTORAGE_PORT":           "",
+		"OBJECTSTORAGE_SCHEME":         "",
+		"OBJECTSTORAGE_BUCKET":         "",
+		"OBJECTSTORAGE_REGION":         "",
+		"OBJECTSTORAGE_SECRET_NAME":    "",
+		"OBJECTSTORAGE_ACCESS_KEY_KEY": "",
+		"OBJECTSTORAGE_SECRET_KEY_KEY": "",
+	}
+
+	if objectStorage == nil {
+		return params
+	}
+
+	params["OBJECTSTORAGE_TYPE"] = string(objectStorage.Type)
+	params["OBJECTSTORAGE_BUCKET"] = objectStorageBucket(objectStorage)
+
+	switch {
+	case objectStorage.Type == componentApi.ObjectStorageTypeMinIO:
+		params["OBJECTSTORAGE_HOST"] = MinIOName + "." + appNamespace + ".svc"
+		params["OBJECTSTORAGE_PORT"] = strconv.Itoa(minIOPort)
+		params["OBJECTSTORAGE_SCHEME"] = "http"
+		params["OBJECTSTORAGE_SECRET_NAME"] = MinIOName
+		params["OBJECTSTORAGE_ACCESS_KEY_KEY"] = defaultObjectStorageAccessKeyKey
+		params["OBJECTSTORAGE_SECRET_KEY_KEY"] = defaultObjectStorageSecretKeyKey
+	case objectStorage.External != nil:
+		ext := objectStorage.External
+		accessKeyKey, secretKeyKey := objectStorageCredentialKeys(ext)
+
+		params["OBJECTSTORAGE_HOST"] = ext.Host
+		params["OBJECTSTORAGE_PORT"] = strconv.Itoa(int(objectStoragePort(ext)))
+		params["OBJECTSTORAGE_SCHEME"] = "http"
+		if objectStorageSecure(ext) {
+			params["OBJECTSTORAGE_SCHEME"] = "https"
+		}
+		params["OBJECTSTORAGE_REGION"] = ext.Region
+		params["OBJECTSTORAGE_SECRET_NAME"] = ext.CredentialsSecretRef.Name
+		params["OBJECTSTORAGE_ACCESS_KEY_KEY"] = accessKeyKey
+		params["OBJECTSTORAGE_SECRET_KEY_KEY"] = secretKeyKey
+	}
+
+	return params
+}
+
+// dialObjectStorage checks that a TCP connection can be established with the external object storage.
+func dialObjectStorage(ctx context.Context, address string) error {
+	d := net.Dialer{Timeout: objectStorageDialTimeout}
+
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// minIOImage returns the image of the in-cluster MinIO.
+func minIOImage() string {
+	if image := os.Getenv(minIOImageEnv); image != "" {
+		return image
+	}
+
+	return defaultMinIOImage
+}
+
+// minIOCredentials returns the Secret holding the access and secret keys of the in-cluster MinIO.
+func minIOCredentials(namespace string, accessKey string, secretKey string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Secret.GroupVersion().String(),
+			Kind:       gvk.Secret.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MinIOName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			defaultObjectStorageAccessKeyKey: []byte(accessKey),
+			defaultObjectStorageSecretKeyKey: []byte(secretKey),
+		},
+	}
+}
+
+// minIOVolume returns the PersistentVolumeClaim of the in-cluster MinIO, created once and then
+// left to the administrator, so that it can be expanded.
+func minIOVolume(namespace string, size resource.Quantity) *corev1.PersistentVolumeClaim {
+	if size.IsZero() {
+		size = resource.MustParse("10Gi")
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.PersistentVolumeClaim.GroupVersion().String(),
+			Kind:       gvk.PersistentVolumeClaim.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MinIOName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				annotations.ManagedByODHOperator: "false",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}
+}
+
+// minIODeployment returns the Deployment of the in-cluster MinIO, creating the bucket of the
+// pipeline artifacts on start.
+func minIODeployment(namespace string, bucket string) *appsv1.Deployment {
+	selector := map[string]string{"app": MinIOName}
+
+	credential := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: MinIOName},
+				Key:                  key,
+			},
+		}
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Deployment.GroupVersion().String(),
+			Kind:       gvk.Deployment.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MinIOName,
+			Namespace: namespace,
+			Labels:    selector,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			// the volume can't be mounted by two pods
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    "minio",
+						Image:   minIOImage(),
+						Command: []string{"/bin/sh", "-c"},
+						Args:    []string{"mkdir -p /data/" + bucket + " && exec minio server /data"},
+						Env: []corev1.EnvVar{
+							{Name: "MINIO_ACCESS_KEY", ValueFrom: credential(defaultObjectStorageAccessKeyKey)},
+							{Name: "MINIO_SECRET_KEY", ValueFrom: credential(defaultObjectStorageSecretKeyKey)},
+						},
+						Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: minIOPort}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(minIOPort)},
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+							RunAsNonRoot:             ptr.To(true),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: MinIOName},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// minIOService returns the Service exposing the in-cluster MinIO in the applications namespace.
+func minIOService(namespace string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Service.GroupVersion().String(),
+			Kind:       gvk.Service.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MinIOName,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": MinIOName},
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Port:       minIOPort,
+				TargetPort: intstr.FromInt32(minIOPort),
+			}},
+		},
+	}
+}
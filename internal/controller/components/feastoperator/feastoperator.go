@@ -39,7 +39,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.FeastOperatorInstanceName,
+			Name: components.InstanceName(dsc, componentApi.FeastOperatorInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(dsc.Spec.Components.FeastOperator.ManagementState),
 			},
@@ -65,18 +65,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.FeastOperator{}
-	c.Name = componentApi.FeastOperatorInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.FeastOperatorInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	ms := components.NormalizeManagementState(dsc.Spec.Components.FeastOperator.ManagementState)
 
 	dsc.Status.Components.FeastOperator.ManagementState = ms
@@ -5,16 +5,29 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
@@ -32,30 +45,47 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		Owns(&rbacv1.ClusterRole{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&appsv1.Deployment{}, reconciler.WithPredicates(resources.NewDeploymentPredicate())).
+		OwnsGVK(gvk.FeatureStore, reconciler.Dynamic(reconciler.CrdExists(gvk.FeatureStore))).
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.FeastOperatorInstanceName)),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.FeastOperator, componentApi.FeastOperatorInstanceName)),
 			reconciler.WithPredicates(
 				component.ForLabel(labels.ODH.Component(ComponentName), labels.True)),
 		).
+		WithWorkloadSettingsWatches(componentApi.FeastOperatorInstanceName).
 		// Add FeastOperator-specific actions
 		WithAction(initialize).
 		WithAction(releases.NewAction()).
+		WithAction(reconcileFeatureStore).
 		WithAction(kustomize.NewAction(
 			kustomize.WithLabel(labels.ODH.Component(ComponentName), labels.True),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, ComponentName),
 		)).
+		WithAction(platform.NewAction()).
+		WithAction(networkpolicy.NewAction()).
+		WithAction(podsecurity.NewAction()).
+		WithAction(fips.NewAction()).
+		WithAction(multiarch.NewAction()).
+		WithAction(highavailability.NewAction()).
+		WithAction(deploymentprofile.NewAction()).
+		WithAction(priorityclass.NewAction()).
+		WithAction(disconnected.NewAction()).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(verify.NewAction()).
+		WithAction(updateFeatureStoreStatus).
 		// must be the final action
 		WithAction(gc.NewAction()).
 		// declares the list of additional, controller specific conditions that are
 		// contributing to the controller readiness status
 		WithConditions(conditionTypes...).
+		WithStandardConditions().
 		Build(ctx)
 
 	if err != nil {
@@ -2,11 +2,170 @@ package feastoperator
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	rr.Manifests = append(rr.Manifests, manifestPath(rr.Release.Name))
 	return nil
 }
+
+// reconcileFeatureStore renders the FeatureStore resource of the central feature store declared
+// in the component spec. The feature store is only rendered once its namespace and the Secrets
+// of its stores are found, otherwise the reason is reported in the FeatureStoreAvailable condition.
+func reconcileFeatureStore(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	f, ok := rr.Instance.(*componentApi.FeastOperator)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.FeastOperator)", rr.Instance)
+	}
+
+	fs := f.Spec.FeatureStore
+	if fs == nil {
+		rr.Conditions.MarkTrue(
+			status.ConditionFeatureStoreAvailable,
+			conditions.WithReason(status.FeatureStoreNotConfiguredReason),
+			conditions.WithMessage(status.FeatureStoreNotConfiguredMessage),
+		)
+
+		return nil
+	}
+
+	// the FeatureStore CRD is installed with the Feast operator manifests, the CRD watch
+	// triggers a new reconciliation once it is created.
+	hasCRD, err := cluster.HasCRD(ctx, rr.Client, gvk.FeatureStore)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.FeatureStore, err)
+	}
+
+	if !hasCRD {
+		rr.Conditions.MarkUnknown(
+			status.ConditionFeatureStoreAvailable,
+			conditions.WithReason(status.FeatureStoreCRDMissingReason),
+			conditions.WithMessage(status.FeatureStoreCRDMissingMessage),
+		)
+
+		return nil
+	}
+
+	namespace := fs.Namespace
+	if namespace == "" {
+		namespace, err = cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+		if err != nil {
+			return err
+		}
+	}
+
+	ns := corev1.Namespace{}
+	err = rr.Client.Get(ctx, client.ObjectKey{Name: namespace}, &ns)
+	switch {
+	case k8serr.IsNotFound(err):
+		rr.Conditions.MarkFalse(
+			status.ConditionFeatureStoreAvailable,
+			conditions.WithReason(status.FeatureStoreNamespaceNotFoundReason),
+			conditions.WithMessage(status.FeatureStoreNamespaceNotFoundMessage, namespace),
+		)
+
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+
+	for _, s := range featureStores(fs) {
+		if s.Spec == nil {
+			continue
+		}
+
+		key := storeSecretKey(s.Spec)
+
+		secret := corev1.Secret{}
+		err := rr.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: s.Spec.SecretName}, &secret)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get %s store Secret %s/%s: %w", s.Name, namespace, s.Spec.SecretName, err)
+		}
+
+		if k8serr.IsNotFound(err) || len(secret.Data[key]) == 0 {
+			rr.Conditions.MarkFalse(
+				status.ConditionFeatureStoreAvailable,
+				conditions.WithReason(status.FeatureStoreSecretInvalidReason),
+				conditions.WithMessage(status.FeatureStoreSecretInvalidMessage, s.Name, namespace, s.Spec.SecretName, key),
+			)
+
+			return nil
+		}
+	}
+
+	obj, err := featureStoreFor(fs, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := rr.AddResources(obj); err != nil {
+		return fmt.Errorf("failed to add feature store %s/%s to manifests: %w", namespace, fs.Name, err)
+	}
+
+	rr.Conditions.MarkUnknown(
+		status.ConditionFeatureStoreAvailable,
+		conditions.WithReason(status.FeatureStorePendingReason),
+		conditions.WithMessage(status.FeatureStorePendingMessage, namespace, fs.Name),
+	)
+
+	return nil
+}
+
+// updateFeatureStoreStatus reports the phase of the rendered FeatureStore resource in the
+// FeatureStoreAvailable condition. A feature store that was not rendered keeps the reason
+// reported by reconcileFeatureStore.
+func updateFeatureStoreStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	c := rr.Conditions.GetCondition(status.ConditionFeatureStoreAvailable)
+	if c == nil || c.Reason != status.FeatureStorePendingReason {
+		return nil
+	}
+
+	for i := range rr.Resources {
+		if rr.Resources[i].GroupVersionKind() != gvk.FeatureStore {
+			continue
+		}
+
+		obj := resources.GvkToUnstructured(gvk.FeatureStore)
+		key := client.ObjectKeyFromObject(&rr.Resources[i])
+
+		err := rr.Client.Get(ctx, key, obj)
+		switch {
+		case k8serr.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get feature store %s: %w", key, err)
+		}
+
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		switch phase {
+		case "":
+		case featureStoreReadyPhase:
+			rr.Conditions.MarkTrue(
+				status.ConditionFeatureStoreAvailable,
+				conditions.WithMessage(status.FeatureStoreReadyMessage, key.Namespace, key.Name),
+			)
+		default:
+			rr.Conditions.MarkFalse(
+				status.ConditionFeatureStoreAvailable,
+				conditions.WithReason(status.FeatureStoreNotReadyReason),
+				conditions.WithMessage(status.FeatureStoreNotReadyMessage, key.Namespace, key.Name, phase),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+//nolint:testpackage
+package feastoperator
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	"github.com/onsi/gomega/types"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestReconcileFeatureStore(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	newFeastOperator := func(fs *componentApi.FeastFeatureStoreSpec) *componentApi.FeastOperator {
+		return &componentApi.FeastOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.FeastOperatorInstanceName},
+			Spec: componentApi.FeastOperatorSpec{
+				FeastOperatorCommonSpec: componentApi.FeastOperatorCommonSpec{FeatureStore: fs},
+			},
+		}
+	}
+
+	featureStoreCondition := func(s metav1.ConditionStatus, reason string) types.GomegaMatcher {
+		return WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionFeatureStoreAvailable, s),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionFeatureStoreAvailable, reason),
+		))
+	}
+
+	t.Run("reports no feature store when none is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		f := newFeastOperator(nil)
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: f, Conditions: conditions.NewManager(f, ReadyConditionType)}
+
+		g.Expect(reconcileFeatureStore(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(f).Should(featureStoreCondition(metav1.ConditionTrue, status.FeatureStoreNotConfiguredReason))
+	})
+
+	t.Run("waits for the FeatureStore CRD", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		f := newFeastOperator(&componentApi.FeastFeatureStoreSpec{Name: "default", Project: "odh"})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: f, Conditions: conditions.NewManager(f, ReadyConditionType)}
+
+		g.Expect(reconcileFeatureStore(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(f).Should(featureStoreCondition(metav1.ConditionUnknown, status.FeatureStoreCRDMissingReason))
+	})
+
+	t.Run("reports a missing namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newFeatureStoreTestClient(t)
+
+		f := newFeastOperator(&componentApi.FeastFeatureStoreSpec{Name: "default", Namespace: "feast", Project: "odh"})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: f, Conditions: conditions.NewManager(f, ReadyConditionType)}
+
+		g.Expect(reconcileFeatureStore(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(f).Should(featureStoreCondition(metav1.ConditionFalse, status.FeatureStoreNamespaceNotFoundReason))
+	})
+
+	t.Run("reports a store Secret without its key", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newFeatureStoreTestClient(t,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "feast"}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "feast-online", Namespace: "feast"},
+				Data:       map[string][]byte{"postgres": []byte("host: db")},
+			},
+		)
+
+		f := newFeastOperator(&componentApi.FeastFeatureStoreSpec{
+			Name:        "default",
+			Namespace:   "feast",
+			Project:     "odh",
+			OnlineStore: &componentApi.FeastStoreSpec{Type: "redis", SecretName: "feast-online"},
+		})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: f, Conditions: conditions.NewManager(f, ReadyConditionType)}
+
+		g.Expect(reconcileFeatureStore(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(f).Should(And(
+			featureStoreCondition(metav1.ConditionFalse, status.FeatureStoreSecretInvalidReason),
+			WithTransform(resources.ToUnstructured,
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("feast/feast-online")`, status.ConditionFeatureStoreAvailable),
+			),
+		))
+	})
+
+	t.Run("renders the feature store in the applications namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newFeatureStoreTestClient(t,
+			&dsciv2.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+				Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+			},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: appNamespace}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "feast-registry", Namespace: appNamespace},
+				Data:       map[string][]byte{"sql": []byte("path: postgresql://db")},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "feast-online", Namespace: appNamespace},
+				Data:       map[string][]byte{"online": []byte("connection_string: redis:6379")},
+			},
+		)
+
+		f := newFeastOperator(&componentApi.FeastFeatureStoreSpec{
+			Name:        "default",
+			Project:     "odh",
+			Registry:    &componentApi.FeastStoreSpec{Type: "sql", SecretName: "feast-registry"},
+			OnlineStore: &componentApi.FeastStoreSpec{Type: "redis", SecretName: "feast-online", SecretKeyName: "online"},
+		})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: f, Conditions: conditions.NewManager(f, ReadyConditionType)}
+
+		g.Expect(reconcileFeatureStore(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0] | .kind == "%s" and .metadata.name == "default" and .metadata.namespace == "%s"`, gvk.FeatureStore.Kind, appNamespace),
+			jq.Match(`.[0].spec.feastProject == "odh"`),
+			jq.Match(`.[0].spec.services.registry.local.persistence.store | .type == "sql" and .secretRef.name == "feast-registry" and .secretKeyName == "sql"`),
+			jq.Match(`.[0].spec.services.onlineStore.persistence.store | .type == "redis" and .secretRef.name == "feast-online" and .secretKeyName == "online"`),
+			jq.Match(`.[0].spec.services | has("offlineStore") | not`),
+		))
+		g.Expect(f).Should(featureStoreCondition(metav1.ConditionUnknown, status.FeatureStorePendingReason))
+	})
+}
+
+func TestUpdateFeatureStoreStatus(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name     string
+		phase    string
+		expected metav1.ConditionStatus
+		reason   string
+	}{
+		{name: "keeps a feature store without phase pending", phase: "", expected: metav1.ConditionUnknown, reason: status.FeatureStorePendingReason},
+		{name: "reports a ready feature store", phase: "Ready", expected: metav1.ConditionTrue, reason: ""},
+		{name: "reports a failed feature store", phase: "Failed", expected: metav1.ConditionFalse, reason: status.FeatureStoreNotReadyReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fs := resources.GvkToUnstructured(gvk.FeatureStore)
+			fs.SetName("default")
+			fs.SetNamespace("feast")
+			if tt.phase != "" {
+				g.Expect(unstructured.SetNestedField(fs.Object, tt.phase, "status", "phase")).Should(Succeed())
+			}
+
+			cli := newFeatureStoreTestClient(t, fs.DeepCopy())
+
+			f := &componentApi.FeastOperator{ObjectMeta: metav1.ObjectMeta{Name: componentApi.FeastOperatorInstanceName}}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:     cli,
+				Instance:   f,
+				Conditions: conditions.NewManager(f, ReadyConditionType),
+				Resources:  []unstructured.Unstructured{*fs},
+			}
+			rr.Conditions.MarkUnknown(status.ConditionFeatureStoreAvailable, conditions.WithReason(status.FeatureStorePendingReason))
+
+			g.Expect(updateFeatureStoreStatus(ctx, rr)).Should(Succeed())
+			g.Expect(rr.Conditions.GetCondition(status.ConditionFeatureStoreAvailable)).Should(And(
+				HaveField("Status", tt.expected),
+				HaveField("Reason", tt.reason),
+			))
+		})
+	}
+}
+
+func newFeatureStoreTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	s.AddKnownTypeWithName(gvk.FeatureStore, &unstructured.Unstructured{})
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.FeatureStore.GroupKind(), gvk.FeatureStore.Version)
+	if err != nil {
+		t.Fatalf("Failed to get the REST mapping of %s: %v", gvk.FeatureStore.Kind, err)
+	}
+
+	crd := mocks.NewMockCRD(gvk.FeatureStore.Group, gvk.FeatureStore.Version, gvk.FeatureStore.Kind, ComponentName)
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.FeatureStore.Version}
+
+	if err := cli.Create(t.Context(), crd); err != nil {
+		t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+	}
+
+	return cli
+}
@@ -1,18 +1,27 @@
 package feastoperator
 
 import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
 	ComponentName = componentApi.FeastOperatorComponentName
 
 	ReadyConditionType = componentApi.FeastOperatorKind + status.ReadySuffix
+
+	// featureStoreReadyPhase is the phase reported by the Feast operator on a ready FeatureStore.
+	featureStoreReadyPhase = "Ready"
 )
 
 var (
@@ -28,6 +37,8 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionFeatureStoreAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -38,3 +49,68 @@ func manifestPath(p common.Platform) types.ManifestInfo {
 		SourcePath: ManifestsSourcePath[p],
 	}
 }
+
+// featureStore is a store of the feature store, with the path of its persistence in the
+// FeatureStore services.
+type featureStore struct {
+	Name string
+	Spec *componentApi.FeastStoreSpec
+	Path []string
+}
+
+func featureStores(fs *componentApi.FeastFeatureStoreSpec) []featureStore {
+	return []featureStore{
+		{Name: "Registry", Spec: fs.Registry, Path: []string{"registry", "local", "persistence", "store"}},
+		{Name: "Online", Spec: fs.OnlineStore, Path: []string{"onlineStore", "persistence", "store"}},
+		{Name: "Offline", Spec: fs.OfflineStore, Path: []string{"offlineStore", "persistence", "store"}},
+	}
+}
+
+// storeSecretKey returns the key of the Secret holding the configuration of a store, the
+// Feast operator defaults it to the store type.
+func storeSecretKey(s *componentApi.FeastStoreSpec) string {
+	if s.SecretKeyName != "" {
+		return s.SecretKeyName
+	}
+
+	return s.Type
+}
+
+func featureStoreFor(fs *componentApi.FeastFeatureStoreSpec, namespace string) (*unstructured.Unstructured, error) {
+	services := map[string]any{}
+
+	for _, s := range featureStores(fs) {
+		if s.Spec == nil {
+			continue
+		}
+
+		store := map[string]any{
+			"type": s.Spec.Type,
+			"secretRef": map[string]any{
+				"name": s.Spec.SecretName,
+			},
+			"secretKeyName": storeSecretKey(s.Spec),
+		}
+
+		if err := unstructured.SetNestedMap(services, store, s.Path...); err != nil {
+			return nil, fmt.Errorf("failed to set the %s store of feature store %s: %w", s.Name, fs.Name, err)
+		}
+	}
+
+	u := resources.GvkToUnstructured(gvk.FeatureStore)
+	u.SetName(fs.Name)
+	u.SetNamespace(namespace)
+
+	spec := map[string]any{
+		"feastProject": fs.Project,
+	}
+	if len(services) != 0 {
+		spec["services"] = services
+	}
+
+	if err := unstructured.SetNestedMap(u.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set the spec of feature store %s: %w", fs.Name, err)
+	}
+
+	return u, nil
+}
@@ -1,7 +1,49 @@
 package kserve
 
+import "time"
+
 // ConfigMap Keys.
 const (
-	IngressConfigKeyName = "ingress"
-	ServiceConfigKeyName = "service"
+	IngressConfigKeyName    = "ingress"
+	ServiceConfigKeyName    = "service"
+	DeployConfigKeyName     = "deploy"
+	AutoscalerConfigKeyName = "autoscaler"
+)
+
+// openshiftIngressClassName is the IngressClass of the OpenShift router, which exposes the
+// Ingresses created for the InferenceServices with Routes.
+const openshiftIngressClassName = "openshift-default"
+
+// Keys of the Knative autoscaler configuration set in the KnativeServing instance.
+const (
+	knativeAutoscalerConfigName         = "autoscaler"
+	knativeTargetConcurrencyKey         = "container-concurrency-target-default"
+	knativeScaleToZeroGracePeriodKey    = "scale-to-zero-grace-period"
+	knativeMinScaleKey                  = "min-scale"
+	knativeMaxScaleKey                  = "max-scale"
+	rawDeploymentAutoscalingScaleMetric = "cpu"
+)
+
+// NVIDIA NIM capability.
+const (
+	nimCapabilityName  = "nim"
+	nimPullSecretName  = "nvidia-nim-image-pull"
+	nimAPIKeySecretKey = "api_key"
+	nimRetryInterval   = time.Minute
+)
+
+// ModelMesh to KServe migration.
+const (
+	modelMeshMigrationConfigMapName = "modelmesh-migration-report"
+	modelMeshMigrationStatusKey     = "status.yaml"
+	modelMeshMigrationInterval      = 5 * time.Minute
+	deploymentModeAnnotation        = "serving.kserve.io/deploymentMode"
+	modelMeshDeploymentMode         = "ModelMesh"
+)
+
+// Migration status of a ModelMesh InferenceService, as reported in the migration report.
+const (
+	modelMeshMigrationPending  = "Pending"
+	modelMeshMigrationMigrated = "Migrated"
+	modelMeshMigrationDeleted  = "Deleted"
 )
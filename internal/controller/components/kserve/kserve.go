@@ -38,6 +38,7 @@ const (
 var (
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -69,7 +70,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.KserveInstanceName,
+			Name: components.InstanceName(dsc, componentApi.KserveInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(dsc.Spec.Components.Kserve.ManagementState),
 			},
@@ -87,18 +88,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.Kserve{}
-	c.Name = componentApi.KserveInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.KserveInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	ms := components.NormalizeManagementState(dsc.Spec.Components.Kserve.ManagementState)
 
 	dsc.Status.Components.Kserve.ManagementState = ms
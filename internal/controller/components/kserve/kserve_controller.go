@@ -28,14 +28,28 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/hash"
@@ -73,6 +87,7 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		OwnsGVK(gvk.InferenceModelV1alpha2, reconciler.Dynamic(reconciler.CrdExists(gvk.InferenceModelV1alpha2))).
 		OwnsGVK(gvk.LLMInferenceServiceConfigV1Alpha1, reconciler.Dynamic(reconciler.CrdExists(gvk.LLMInferenceServiceConfigV1Alpha1))).
 		OwnsGVK(gvk.LLMInferenceServiceV1Alpha1, reconciler.Dynamic(reconciler.CrdExists(gvk.LLMInferenceServiceV1Alpha1))).
+		OwnsGVK(gvk.ClusterServingRuntime, reconciler.Dynamic(reconciler.CrdExists(gvk.ClusterServingRuntime))).
 
 		// operands - watched
 		//
@@ -85,14 +100,43 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KserveInstanceName)),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kserve, componentApi.KserveInstanceName)),
 			reconciler.WithPredicates(
 				component.ForLabel(labels.ODH.Component(LegacyComponentName), labels.True),
 			),
 		).
+		// the InferenceServices are exposed with the ingress type selected in the DSCInitialization,
+		// or with Routes when the service mesh is Removed
+		WithWorkloadSettingsWatches(componentApi.KserveInstanceName).
+		Watches(
+			&serviceApi.GatewayConfig{},
+			reconciler.WithEventHandler(
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kserve, componentApi.KserveInstanceName)),
+			reconciler.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+
+		// the NIM image pull secret is created by odh-model-controller and
+		// propagated to the namespaces selected by the nim capability
+		Watches(
+			&corev1.Secret{},
+			reconciler.WithEventHandler(
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kserve, componentApi.KserveInstanceName)),
+			reconciler.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool {
+				return o.GetName() == nimPullSecretName
+			})),
+		).
+		// the deployment mode overrides and the nim capability select namespaces by label
+		Watches(
+			&corev1.Namespace{},
+			reconciler.WithEventHandler(
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kserve, componentApi.KserveInstanceName)),
+			reconciler.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
 
 		// actions
 		WithAction(initialize).
+		WithAction(checkPreConditions).
+		WithAction(reportFeatures).
 		WithAction(releases.NewAction()).
 		WithAction(removeOwnershipFromUnmanagedResources).
 		WithAction(cleanUpTemplatedResources).
@@ -109,15 +153,32 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.K8SCommon.PartOf, LegacyComponentName),
 		)).
 		WithAction(customizeKserveConfigMap).
+		WithAction(reconcileServingRuntimes).
+		WithAction(reportServingRuntimeAccelerators).
+		WithAction(configureServerlessAutoscaling).
+		WithAction(reconcileNIM).
+		WithAction(reconcileModelMeshMigration).
+		WithAction(platform.NewAction()).
+		WithAction(networkpolicy.NewAction()).
+		WithAction(podsecurity.NewAction()).
+		WithAction(fips.NewAction()).
+		WithAction(multiarch.NewAction()).
+		WithAction(highavailability.NewAction()).
+		WithAction(deploymentprofile.NewAction()).
+		WithAction(priorityclass.NewAction()).
+		WithAction(disconnected.NewAction()).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(verify.NewAction()).
 		// must be the final action
 		WithAction(gc.NewAction()).
 		// declares the list of additional, controller specific conditions that are
 		// contributing to the controller readiness status
 		WithConditions(conditionTypes...).
+		WithStandardConditions().
 		Build(ctx)
 
 	return err
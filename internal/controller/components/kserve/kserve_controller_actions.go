@@ -3,21 +3,33 @@ package kserve
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -32,6 +44,36 @@ func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
 	return nil
 }
 
+func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	if !usesServerless(k) {
+		return nil
+	}
+
+	knative, err := cluster.HasCRD(ctx, rr.Client, gvk.KnativeService)
+	if err != nil {
+		return odherrors.NewStopError("failed to check %s CRDs version: %w", gvk.KnativeService, err)
+	}
+
+	if !knative {
+		return odherrors.NewStopError(status.KserveServerlessMissingMessage)
+	}
+
+	return nil
+}
+
+// reportFeatures reports the feature gates consulted by the component. The RawDeployment mode is
+// GA, so it is always enabled.
+func reportFeatures(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	_, err := featuregates.Consult(ctx, rr, featuregates.KserveRawDeployment)
+
+	return err
+}
+
 func removeOwnershipFromUnmanagedResources(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	for _, res := range rr.Resources {
 		if shouldRemoveOwnerRefAndLabel(res) {
@@ -93,7 +135,30 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 		serviceClusterIPNone = false
 	}
 
-	if err := updateInferenceCM(&kserveConfigMap, serviceClusterIPNone); err != nil {
+	ingress, err := inferenceServiceIngress(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := updateInferenceCM(&kserveConfigMap, serviceClusterIPNone, ingress); err != nil {
+		return err
+	}
+
+	namespaceModes, err := namespaceDeploymentModes(ctx, rr.Client, k.Spec.DeploymentModeOverrides)
+	if err != nil {
+		return err
+	}
+
+	if err := updateDeployConfig(&kserveConfigMap, defaultDeploymentMode(k), namespaceModes); err != nil {
+		return err
+	}
+
+	var rawAutoscaling *componentApi.RawDeploymentAutoscalingSpec
+	if k.Spec.Autoscaling != nil {
+		rawAutoscaling = k.Spec.Autoscaling.RawDeployment
+	}
+
+	if err := updateAutoscalerConfig(&kserveConfigMap, rawAutoscaling); err != nil {
 		return err
 	}
 
@@ -118,3 +183,394 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 
 	return nil
 }
+
+// reconcileServingRuntimes adds the ClusterServingRuntimes of the runtimes enabled in the
+// serving runtime catalog, the ones that are Removed or no longer listed are pruned by the
+// gc action.
+func reconcileServingRuntimes(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	if len(k.Spec.ServingRuntimes) == 0 {
+		return nil
+	}
+
+	// the ClusterServingRuntime CRD is deployed by the component, the CRD watch triggers a new
+	// reconciliation once it is available
+	has, err := cluster.HasCRD(ctx, rr.Client, gvk.ClusterServingRuntime)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return nil
+	}
+
+	for _, sr := range k.Spec.ServingRuntimes {
+		if sr.ManagementState == operatorv1.Removed {
+			continue
+		}
+
+		csr, err := clusterServingRuntimeFor(rr.Resources, sr)
+		if err != nil {
+			return err
+		}
+
+		if err := rr.AddResources(csr); err != nil {
+			return fmt.Errorf("failed to add serving runtime %s: %w", sr.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// reportServingRuntimeAccelerators reports the ClusterServingRuntimes recommending accelerators
+// none of which is detected on the cluster. They are still deployed, the accelerators may be added
+// later, so the condition does not affect the readiness of the component.
+func reportServingRuntimeAccelerators(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	runtimes := make([]*unstructured.Unstructured, 0)
+	for i := range rr.Resources {
+		if rr.Resources[i].GroupVersionKind() == gvk.ClusterServingRuntime {
+			runtimes = append(runtimes, &rr.Resources[i])
+		}
+	}
+
+	if len(runtimes) == 0 {
+		return rr.Conditions.ClearCondition(status.ConditionServingRuntimeAcceleratorsAvailable)
+	}
+
+	accelerators, err := cluster.Accelerators(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]string, 0)
+
+	for _, sr := range runtimes {
+		resourceNames, err := recommendedAccelerators(sr)
+		if err != nil {
+			return err
+		}
+
+		if len(resourceNames) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(resourceNames, func(name string) bool {
+			return cluster.HasAcceleratorResource(accelerators, name)
+		}) {
+			missing = append(missing, sr.GetName())
+		}
+	}
+
+	if len(missing) == 0 {
+		rr.Conditions.MarkTrue(
+			status.ConditionServingRuntimeAcceleratorsAvailable,
+			conditions.WithMessage(status.ServingRuntimeAcceleratorsAvailableMessage),
+		)
+
+		return nil
+	}
+
+	rr.Conditions.MarkFalse(
+		status.ConditionServingRuntimeAcceleratorsAvailable,
+		conditions.WithReason(status.ServingRuntimeAcceleratorsMissingReason),
+		conditions.WithMessage(status.ServingRuntimeAcceleratorsMissingMessage, strings.Join(missing, ", ")),
+		conditions.WithSeverity(common.ConditionSeverityInfo),
+	)
+
+	return nil
+}
+
+// configureServerlessAutoscaling sets the Knative autoscaling defaults in the autoscaler
+// configuration of the KnativeServing instances, which the Knative operator renders into
+// the config-autoscaler ConfigMap.
+func configureServerlessAutoscaling(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	if k.Spec.Autoscaling == nil {
+		return nil
+	}
+
+	config := knativeAutoscalerConfig(k.Spec.Autoscaling.Serverless)
+	if len(config) == 0 {
+		return nil
+	}
+
+	knative, err := cluster.HasCRD(ctx, rr.Client, gvk.KnativeServing)
+	if err != nil {
+		return err
+	}
+
+	if !knative {
+		return nil
+	}
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(gvk.KnativeServing.GroupVersion().WithKind(gvk.KnativeServing.Kind + "List"))
+
+	if err := rr.Client.List(ctx, &items); err != nil {
+		return fmt.Errorf("failed to list %s: %w", gvk.KnativeServing.Kind, err)
+	}
+
+	for i := range items.Items {
+		ks := &items.Items[i]
+
+		current, _, err := unstructured.NestedStringMap(ks.Object, "spec", "config", knativeAutoscalerConfigName)
+		if err != nil {
+			return fmt.Errorf("failed to read autoscaler configuration of %s: %w", resources.FormatObjectReference(ks), err)
+		}
+
+		updated := maps.Clone(current)
+		if updated == nil {
+			updated = map[string]string{}
+		}
+		maps.Copy(updated, config)
+
+		if maps.Equal(current, updated) {
+			continue
+		}
+
+		patch := client.MergeFrom(ks.DeepCopy())
+		if err := unstructured.SetNestedStringMap(ks.Object, updated, "spec", "config", knativeAutoscalerConfigName); err != nil {
+			return err
+		}
+
+		if err := rr.Client.Patch(ctx, ks, patch); err != nil {
+			return fmt.Errorf("failed to set autoscaler configuration of %s: %w", resources.FormatObjectReference(ks), err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileNIM validates the NGC API key of the NVIDIA NIM integration and propagates its image
+// pull secret, created by odh-model-controller, to the selected namespaces. The integration
+// itself is enabled in odh-model-controller by the ModelController component.
+func reconcileNIM(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	cs := componentApi.CapabilityStatus{
+		Name:            nimCapabilityName,
+		ManagementState: k.Spec.NIMManagementState(),
+	}
+
+	defer func() {
+		k.Status.Capabilities = []componentApi.CapabilityStatus{cs}
+	}()
+
+	if cs.ManagementState != operatorv1.Managed {
+		cs.Available = metav1.ConditionFalse
+		cs.Message = status.NIMRemovedMessage
+
+		rr.Conditions.MarkFalse(
+			status.ConditionNIMAvailable,
+			conditions.WithReason(status.NIMRemovedReason),
+			conditions.WithMessage(status.NIMRemovedMessage),
+			conditions.WithSeverity(common.ConditionSeverityInfo),
+		)
+
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	nim := k.Spec.Capabilities.NIM
+	if nim == nil {
+		nim = &componentApi.NimCapability{}
+	}
+
+	if nim.APIKeySecretName != "" {
+		apiKey := corev1.Secret{}
+		err := rr.Client.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: nim.APIKeySecretName}, &apiKey)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get NGC API key Secret %s: %w", nim.APIKeySecretName, err)
+		}
+
+		if k8serr.IsNotFound(err) || len(apiKey.Data[nimAPIKeySecretKey]) == 0 {
+			cs.Available = metav1.ConditionFalse
+			cs.Message = fmt.Sprintf(status.NIMAPIKeyInvalidMessage, nim.APIKeySecretName)
+
+			rr.Conditions.MarkFalse(
+				status.ConditionNIMAvailable,
+				conditions.WithReason(status.NIMAPIKeyInvalidReason),
+				conditions.WithMessage(status.NIMAPIKeyInvalidMessage, nim.APIKeySecretName),
+			)
+			rr.Requeue(nimRetryInterval)
+
+			return nil
+		}
+	}
+
+	if nim.PullSecretNamespaceSelector != nil {
+		pullSecret := corev1.Secret{}
+		err := rr.Client.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: nimPullSecretName}, &pullSecret)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get NIM image pull secret: %w", err)
+		}
+
+		if k8serr.IsNotFound(err) {
+			cs.Available = metav1.ConditionFalse
+			cs.Message = fmt.Sprintf(status.NIMPullSecretMissingMessage, nimPullSecretName, appNamespace)
+
+			rr.Conditions.MarkFalse(
+				status.ConditionNIMAvailable,
+				conditions.WithReason(status.NIMPullSecretMissingReason),
+				conditions.WithMessage(status.NIMPullSecretMissingMessage, nimPullSecretName, appNamespace),
+			)
+			rr.Requeue(nimRetryInterval)
+
+			return nil
+		}
+
+		secrets, err := nimPullSecrets(ctx, rr.Client, &pullSecret, nim.PullSecretNamespaceSelector)
+		if err != nil {
+			return err
+		}
+
+		if err := rr.AddResources(secrets...); err != nil {
+			return fmt.Errorf("failed to add NIM image pull secrets: %w", err)
+		}
+	}
+
+	cs.Available = metav1.ConditionTrue
+	rr.Conditions.MarkTrue(status.ConditionNIMAvailable)
+
+	return nil
+}
+
+// reconcileModelMeshMigration assists the migration of the InferenceServices served by ModelMesh,
+// which is no longer deployed, to KServe: it inventories them and reports the drafts of their
+// KServe InferenceServices, together with the migration status of each of them, in the migration
+// report ConfigMap of the applications namespace.
+func reconcileModelMeshMigration(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	has, err := cluster.HasCRD(ctx, rr.Client, gvk.InferenceServices)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	previous, err := modelMeshMigrationStatus(ctx, rr.Client, appNamespace)
+	if err != nil {
+		return err
+	}
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(gvk.InferenceServices.GroupVersion().WithKind(gvk.InferenceServices.Kind + "List"))
+
+	if err := rr.Client.List(ctx, &items); err != nil {
+		return fmt.Errorf("failed to list %s: %w", gvk.InferenceServices.Kind, err)
+	}
+
+	modes, err := namespaceDeploymentModes(ctx, rr.Client, k.Spec.DeploymentModeOverrides)
+	if err != nil {
+		return err
+	}
+
+	report := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      modelMeshMigrationConfigMapName,
+			Namespace: appNamespace,
+		},
+		Data: map[string]string{},
+	}
+
+	// the InferenceServices of a previous inventory no longer found have been deleted
+	migration := make(map[string]string, len(previous))
+	for key := range previous {
+		migration[key] = modelMeshMigrationDeleted
+	}
+
+	pending := 0
+
+	for i := range items.Items {
+		isvc := &items.Items[i]
+		key := isvc.GetNamespace() + "/" + isvc.GetName()
+
+		if isvc.GetAnnotations()[deploymentModeAnnotation] != modelMeshDeploymentMode {
+			if _, found := previous[key]; found {
+				migration[key] = modelMeshMigrationMigrated
+			}
+
+			continue
+		}
+
+		mode, found := modes[isvc.GetNamespace()]
+		if !found {
+			mode = defaultDeploymentMode(k)
+		}
+
+		draft, err := modelMeshDraft(isvc, mode)
+		if err != nil {
+			return err
+		}
+
+		report.Data[isvc.GetNamespace()+"."+isvc.GetName()+".yaml"] = draft
+		migration[key] = modelMeshMigrationPending
+		pending++
+	}
+
+	if len(migration) == 0 {
+		return nil
+	}
+
+	content, err := yaml.Marshal(migration)
+	if err != nil {
+		return fmt.Errorf("failed to generate ModelMesh migration report: %w", err)
+	}
+
+	report.Data[modelMeshMigrationStatusKey] = string(content)
+
+	if err := rr.AddResources(&report); err != nil {
+		return fmt.Errorf("failed to add ModelMesh migration report: %w", err)
+	}
+
+	if pending == 0 {
+		rr.Conditions.MarkTrue(
+			status.ConditionModelMeshMigrated,
+			conditions.WithMessage(status.ModelMeshMigratedMessage, len(migration)),
+		)
+
+		return nil
+	}
+
+	rr.Conditions.MarkFalse(
+		status.ConditionModelMeshMigrated,
+		conditions.WithReason(status.ModelMeshMigrationPendingReason),
+		conditions.WithMessage(status.ModelMeshMigrationPendingMessage, pending, len(migration), appNamespace, modelMeshMigrationConfigMapName),
+		conditions.WithSeverity(common.ConditionSeverityInfo),
+	)
+
+	// the InferenceServices are not watched, the inventory is refreshed periodically instead
+	rr.Requeue(modelMeshMigrationInterval)
+
+	return nil
+}
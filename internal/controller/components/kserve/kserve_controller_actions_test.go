@@ -4,18 +4,40 @@ package kserve
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 )
 
 func TestCustomizeKserveConfigMap(t *testing.T) {
@@ -41,6 +63,7 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		}
 
 		rr := &odhtypes.ReconciliationRequest{
+			Client:    newTestClient(t),
 			Instance:  kserve,
 			Resources: resources,
 		}
@@ -86,6 +109,7 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		}
 
 		rr := &odhtypes.ReconciliationRequest{
+			Client:    newTestClient(t),
 			Instance:  kserve,
 			Resources: resources,
 		}
@@ -108,6 +132,227 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		g.Expect(serviceData["serviceClusterIPNone"]).Should(BeFalse())
 	})
 
+	t.Run("Test KServe config: service mesh Removed", func(t *testing.T) {
+		kserve := &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: componentApi.KserveInstanceName,
+			},
+		}
+
+		resources := []unstructured.Unstructured{
+			*convertToUnstructured(t, createTestConfigMap()),
+			*convertToUnstructured(t, createTestDeployment()),
+		}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newTestClient(t, &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: serviceApi.GatewayInstanceName,
+				},
+				Spec: serviceApi.GatewayConfigSpec{
+					ServiceMesh: operatorv1.Removed,
+				},
+			}),
+			Instance:  kserve,
+			Resources: resources,
+		}
+
+		err := customizeKserveConfigMap(ctx, rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		updatedConfigMap := &corev1.ConfigMap{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, updatedConfigMap)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		// verify the Ingresses are created for the OpenShift router
+		var ingressData map[string]interface{}
+		err = json.Unmarshal([]byte(updatedConfigMap.Data[IngressConfigKeyName]), &ingressData)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(ingressData["disableIngressCreation"]).Should(BeFalse())
+		g.Expect(ingressData["ingressClassName"]).Should(Equal(openshiftIngressClassName))
+	})
+
+	t.Run("Test KServe config: ingress types", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			ingressType infrav1.IngressType
+			matcher     types.GomegaMatcher
+		}{
+			{
+				name:        "route",
+				ingressType: infrav1.IngressTypeRoute,
+				matcher: And(
+					HaveKeyWithValue("disableIngressCreation", false),
+					HaveKeyWithValue("ingressClassName", openshiftIngressClassName),
+				),
+			},
+			{
+				name:        "gatewayapi",
+				ingressType: infrav1.IngressTypeGatewayAPI,
+				matcher: And(
+					HaveKeyWithValue("disableIngressCreation", false),
+					HaveKeyWithValue("enableGatewayApi", true),
+					HaveKeyWithValue("kserveIngressGateway", "openshift-ingress/data-science-gateway"),
+				),
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				resources := []unstructured.Unstructured{
+					*convertToUnstructured(t, createTestConfigMap()),
+					*convertToUnstructured(t, createTestDeployment()),
+				}
+
+				rr := &odhtypes.ReconciliationRequest{
+					Client: newGatewayAPITestClient(t, &dsciv2.DSCInitialization{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "default-dsci",
+						},
+						Spec: dsciv2.DSCInitializationSpec{
+							IngressType: tt.ingressType,
+						},
+					}),
+					Instance:  &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}},
+					Resources: resources,
+				}
+
+				err := customizeKserveConfigMap(ctx, rr)
+				g.Expect(err).ShouldNot(HaveOccurred())
+
+				updatedConfigMap := &corev1.ConfigMap{}
+				err = runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, updatedConfigMap)
+				g.Expect(err).ShouldNot(HaveOccurred())
+
+				var ingressData map[string]interface{}
+				err = json.Unmarshal([]byte(updatedConfigMap.Data[IngressConfigKeyName]), &ingressData)
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(ingressData).Should(tt.matcher)
+			})
+		}
+	})
+
+	t.Run("Test KServe config: gatewayapi ingress type without Gateway API CRDs", func(t *testing.T) {
+		resources := []unstructured.Unstructured{
+			*convertToUnstructured(t, createTestConfigMap()),
+			*convertToUnstructured(t, createTestDeployment()),
+		}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newTestClient(t, &dsciv2.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "default-dsci",
+				},
+				Spec: dsciv2.DSCInitializationSpec{
+					IngressType: infrav1.IngressTypeGatewayAPI,
+				},
+			}),
+			Instance:  &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}},
+			Resources: resources,
+		}
+
+		err := customizeKserveConfigMap(ctx, rr)
+		g.Expect(err).Should(MatchError(ContainSubstring("Gateway API CRDs are not installed")))
+	})
+
+	t.Run("Test KServe deployment mode and per-namespace overrides", func(t *testing.T) {
+		kserve := &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: componentApi.KserveInstanceName,
+			},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{
+					DeploymentModeOverrides: []componentApi.DeploymentModeOverride{
+						{
+							NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"serving": "serverless"}},
+							DeploymentMode:    componentApi.DeploymentModeServerless,
+						},
+						{
+							NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+							DeploymentMode:    componentApi.DeploymentModeRawDeployment,
+						},
+					},
+				},
+			},
+		}
+
+		newNamespace := func(name string, l map[string]string) *corev1.Namespace {
+			return &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: l},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			}
+		}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newTestClient(t,
+				newNamespace("team-a-serverless", map[string]string{"serving": "serverless", "team": "a"}),
+				newNamespace("team-a", map[string]string{"team": "a"}),
+				newNamespace("team-b", map[string]string{"team": "b"}),
+			),
+			Instance: kserve,
+			Resources: []unstructured.Unstructured{
+				*convertToUnstructured(t, createTestConfigMap()),
+				*convertToUnstructured(t, createTestDeployment()),
+			},
+		}
+
+		err := customizeKserveConfigMap(ctx, rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		updatedConfigMap := &corev1.ConfigMap{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, updatedConfigMap)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(updatedConfigMap.Data[DeployConfigKeyName]).Should(And(
+			jq.Match(`.defaultDeploymentMode == "RawDeployment"`),
+			jq.Match(`.namespaceDeploymentModes == {"team-a-serverless": "Serverless", "team-a": "RawDeployment"}`),
+		))
+	})
+
+	t.Run("Test KServe RawDeployment autoscaling defaults", func(t *testing.T) {
+		kserve := &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: componentApi.KserveInstanceName,
+			},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{
+					Autoscaling: &componentApi.AutoscalingSpec{
+						RawDeployment: &componentApi.RawDeploymentAutoscalingSpec{
+							MinReplicas:                 ptr.To[int32](2),
+							MaxReplicas:                 ptr.To[int32](5),
+							TargetUtilizationPercentage: ptr.To[int32](70),
+						},
+					},
+				},
+			},
+		}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   newTestClient(t),
+			Instance: kserve,
+			Resources: []unstructured.Unstructured{
+				*convertToUnstructured(t, createTestConfigMap()),
+				*convertToUnstructured(t, createTestDeployment()),
+			},
+		}
+
+		err := customizeKserveConfigMap(ctx, rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		updatedConfigMap := &corev1.ConfigMap{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, updatedConfigMap)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(updatedConfigMap.Data[AutoscalerConfigKeyName]).Should(And(
+			jq.Match(`.minReplicas == 2`),
+			jq.Match(`.maxReplicas == 5`),
+			jq.Match(`.scaleMetric == "cpu"`),
+			jq.Match(`.scaleTarget == 70`),
+		))
+	})
+
 	t.Run("Test adding ConfigMap hash annotation to deployment", func(t *testing.T) {
 		kserve := &componentApi.Kserve{
 			ObjectMeta: metav1.ObjectMeta{
@@ -128,6 +373,7 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		}
 
 		rr := &odhtypes.ReconciliationRequest{
+			Client:    newTestClient(t),
 			Instance:  kserve,
 			Resources: resources,
 		}
@@ -161,6 +407,7 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 
 		// create reconciliation request without the required ConfigMap
 		rr := &odhtypes.ReconciliationRequest{
+			Client:    newTestClient(t),
 			Instance:  kserve,
 			Resources: []unstructured.Unstructured{},
 		}
@@ -185,6 +432,7 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		}
 
 		rr := &odhtypes.ReconciliationRequest{
+			Client:    newTestClient(t),
 			Instance:  kserve,
 			Resources: resources,
 		}
@@ -196,6 +444,586 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 	})
 }
 
+func TestCheckPreConditions(t *testing.T) {
+	ctx := t.Context()
+
+	newKserve := func(mode componentApi.DeploymentMode) *componentApi.Kserve {
+		return &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{DefaultDeploymentMode: mode},
+			},
+		}
+	}
+
+	t.Run("RawDeployment does not require Knative Serving", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   newTestClient(t),
+			Instance: newKserve(componentApi.DeploymentModeRawDeployment),
+		}
+
+		g.Expect(checkPreConditions(ctx, rr)).Should(Succeed())
+	})
+
+	t.Run("Serverless requires Knative Serving", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   newTestClient(t),
+			Instance: newKserve(componentApi.DeploymentModeServerless),
+		}
+
+		g.Expect(checkPreConditions(ctx, rr)).Should(MatchError(ContainSubstring(status.KserveServerlessMissingMessage)))
+	})
+
+	t.Run("Serverless override requires Knative Serving", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k := newKserve(componentApi.DeploymentModeRawDeployment)
+		k.Spec.DeploymentModeOverrides = []componentApi.DeploymentModeOverride{{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"serving": "serverless"}},
+			DeploymentMode:    componentApi.DeploymentModeServerless,
+		}}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   newTestClient(t),
+			Instance: k,
+		}
+
+		g.Expect(checkPreConditions(ctx, rr)).Should(MatchError(ContainSubstring(status.KserveServerlessMissingMessage)))
+	})
+}
+
+func TestConfigureServerlessAutoscaling(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	s.AddKnownTypeWithName(gvk.KnativeServing, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gvk.KnativeServing.GroupVersion().WithKind(gvk.KnativeServing.Kind+"List"), &unstructured.UnstructuredList{})
+
+	ks := &unstructured.Unstructured{}
+	ks.SetGroupVersionKind(gvk.KnativeServing)
+	ks.SetName("knative-serving")
+	ks.SetNamespace("knative-serving")
+	g.Expect(unstructured.SetNestedStringMap(ks.Object, map[string]string{"stable-window": "60s"}, "spec", "config", "autoscaler")).Should(Succeed())
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(ks))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.KnativeServing.GroupKind(), gvk.KnativeServing.Version)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	crd := mocks.NewMockCRD(gvk.KnativeServing.Group, gvk.KnativeServing.Version, gvk.KnativeServing.Kind, "knative")
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.KnativeServing.Version}
+	g.Expect(cli.Create(ctx, crd)).Should(Succeed())
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: cli,
+		Instance: &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{
+					Autoscaling: &componentApi.AutoscalingSpec{
+						Serverless: &componentApi.ServerlessAutoscalingSpec{
+							TargetConcurrency:      ptr.To[int32](10),
+							ScaleToZeroGracePeriod: &metav1.Duration{Duration: 45 * time.Second},
+							MinReplicas:            ptr.To[int32](0),
+							MaxReplicas:            ptr.To[int32](8),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(configureServerlessAutoscaling(ctx, rr)).Should(Succeed())
+
+	updated := &unstructured.Unstructured{}
+	updated.SetGroupVersionKind(gvk.KnativeServing)
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(ks), updated)).Should(Succeed())
+	g.Expect(updated.Object).Should(And(
+		jq.Match(`.spec.config.autoscaler."stable-window" == "60s"`),
+		jq.Match(`.spec.config.autoscaler."container-concurrency-target-default" == "10"`),
+		jq.Match(`.spec.config.autoscaler."scale-to-zero-grace-period" == "45s"`),
+		jq.Match(`.spec.config.autoscaler."min-scale" == "0"`),
+		jq.Match(`.spec.config.autoscaler."max-scale" == "8"`),
+	))
+}
+
+func TestReconcileNIM(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+
+	newKserve := func(nim *componentApi.NimCapability) *componentApi.Kserve {
+		return &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{
+					NIM:          componentApi.NimSpec{ManagementState: operatorv1.Removed},
+					Capabilities: componentApi.KserveCapabilities{NIM: nim},
+				},
+			},
+		}
+	}
+
+	newRequest := func(k *componentApi.Kserve, objs ...client.Object) *odhtypes.ReconciliationRequest {
+		return &odhtypes.ReconciliationRequest{
+			Client:     newTestClient(t, append([]client.Object{dsci}, objs...)...),
+			Instance:   k,
+			Conditions: conditions.NewManager(k, ReadyConditionType),
+		}
+	}
+
+	t.Run("reports the capability as removed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k := newKserve(nil)
+		rr := newRequest(k)
+
+		g.Expect(reconcileNIM(ctx, rr)).Should(Succeed())
+		g.Expect(k.Status.Capabilities).Should(ConsistOf(And(
+			HaveField("Name", nimCapabilityName),
+			HaveField("ManagementState", operatorv1.Removed),
+			HaveField("Available", metav1.ConditionFalse),
+		)))
+		g.Expect(k).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionNIMAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionNIMAvailable, status.NIMRemovedReason),
+		)))
+	})
+
+	t.Run("validates the NGC API key secret", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k := newKserve(&componentApi.NimCapability{
+			ManagementState:  operatorv1.Managed,
+			APIKeySecretName: "ngc-api-key",
+		})
+		rr := newRequest(k, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ngc-api-key", Namespace: appNamespace},
+			Data:       map[string][]byte{"other": []byte("value")},
+		})
+
+		g.Expect(reconcileNIM(ctx, rr)).Should(Succeed())
+		g.Expect(k.Status.Capabilities).Should(ConsistOf(HaveField("Available", metav1.ConditionFalse)))
+		g.Expect(rr.RequeueAfter).Should(Equal(nimRetryInterval))
+		g.Expect(k).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionNIMAvailable, status.NIMAPIKeyInvalidReason),
+		))
+	})
+
+	t.Run("propagates the image pull secret to the selected namespaces", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k := newKserve(&componentApi.NimCapability{
+			ManagementState:             operatorv1.Managed,
+			APIKeySecretName:            "ngc-api-key",
+			PullSecretNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"nim": "enabled"}},
+		})
+		rr := newRequest(k,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ngc-api-key", Namespace: appNamespace},
+				Data:       map[string][]byte{nimAPIKeySecretKey: []byte("key")},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: nimPullSecretName, Namespace: appNamespace},
+				Type:       corev1.SecretTypeDockerConfigJson,
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+			},
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "models", Labels: map[string]string{"nim": "enabled"}},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			},
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "other"},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			},
+		)
+
+		g.Expect(reconcileNIM(ctx, rr)).Should(Succeed())
+		g.Expect(k.Status.Capabilities).Should(ConsistOf(And(
+			HaveField("ManagementState", operatorv1.Managed),
+			HaveField("Available", metav1.ConditionTrue),
+		)))
+		g.Expect(rr.Resources).Should(HaveLen(1))
+		g.Expect(rr.Resources[0].Object).Should(And(
+			jq.Match(`.metadata.name == "%s"`, nimPullSecretName),
+			jq.Match(`.metadata.namespace == "models"`),
+			jq.Match(`.type == "%s"`, corev1.SecretTypeDockerConfigJson),
+		))
+	})
+}
+
+func TestReconcileServingRuntimes(t *testing.T) {
+	ctx := t.Context()
+
+	template := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "template.openshift.io/v1",
+		"kind":       "Template",
+		"metadata": map[string]any{
+			"name":      "vllm-cuda-runtime-template",
+			"namespace": "opendatahub",
+		},
+		"objects": []any{
+			map[string]any{
+				"apiVersion": "serving.kserve.io/v1alpha1",
+				"kind":       "ServingRuntime",
+				"metadata": map[string]any{
+					"name": "vllm-cuda-runtime",
+				},
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":  "kserve-container",
+							"image": "quay.io/vllm:latest",
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	newKserve := func(runtimes ...componentApi.ServingRuntimeSpec) *componentApi.Kserve {
+		return &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{ServingRuntimes: runtimes},
+			},
+		}
+	}
+
+	t.Run("adds the ClusterServingRuntimes of the managed runtimes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newServingRuntimeTestClient(t),
+			Instance: newKserve(
+				componentApi.ServingRuntimeSpec{
+					Name:            componentApi.ServingRuntimeVLLM,
+					ManagementState: operatorv1.Managed,
+					Image:           "quay.io/vllm:v0.9.0",
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+					},
+				},
+				componentApi.ServingRuntimeSpec{
+					Name:            componentApi.ServingRuntimeOVMS,
+					ManagementState: operatorv1.Removed,
+				},
+			),
+			Resources: []unstructured.Unstructured{*template.DeepCopy()},
+		}
+
+		g.Expect(reconcileServingRuntimes(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(2))
+
+		csr := rr.Resources[1]
+		g.Expect(csr.GroupVersionKind()).Should(Equal(gvk.ClusterServingRuntime))
+		g.Expect(csr.GetName()).Should(Equal("vllm-cuda-runtime"))
+		g.Expect(csr.GetNamespace()).Should(BeEmpty())
+		g.Expect(csr.Object).Should(And(
+			jq.Match(`.spec.containers[0].image == "quay.io/vllm:v0.9.0"`),
+			jq.Match(`.spec.containers[0].resources.limits.memory == "8Gi"`),
+		))
+
+		// the template is left untouched
+		g.Expect(rr.Resources[0].Object).Should(jq.Match(`.objects[0].spec.containers[0].image == "quay.io/vllm:latest"`))
+	})
+
+	t.Run("fails when the template of a runtime is not rendered", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newServingRuntimeTestClient(t),
+			Instance: newKserve(componentApi.ServingRuntimeSpec{
+				Name:            componentApi.ServingRuntimeTriton,
+				ManagementState: operatorv1.Managed,
+			}),
+			Resources: []unstructured.Unstructured{*template.DeepCopy()},
+		}
+
+		g.Expect(reconcileServingRuntimes(ctx, rr)).Should(MatchError(ContainSubstring("could not find template")))
+	})
+
+	t.Run("skips the runtimes until the ClusterServingRuntime CRD is available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client: newTestClient(t),
+			Instance: newKserve(componentApi.ServingRuntimeSpec{
+				Name:            componentApi.ServingRuntimeVLLM,
+				ManagementState: operatorv1.Managed,
+			}),
+			Resources: []unstructured.Unstructured{*template.DeepCopy()},
+		}
+
+		g.Expect(reconcileServingRuntimes(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(1))
+	})
+}
+
+func TestReportServingRuntimeAccelerators(t *testing.T) {
+	ctx := t.Context()
+
+	newRuntime := func(name string, recommended string) unstructured.Unstructured {
+		sr := unstructured.Unstructured{}
+		sr.SetGroupVersionKind(gvk.ClusterServingRuntime)
+		sr.SetName(name)
+
+		if recommended != "" {
+			sr.SetAnnotations(map[string]string{annotations.RecommendedAccelerators: recommended})
+		}
+
+		return sr
+	}
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Status: dsciv2.DSCInitializationStatus{
+			Accelerators: []common.Accelerator{{
+				Vendor:        common.AcceleratorVendorNVIDIA,
+				ResourceNames: []string{"nvidia.com/gpu"},
+				Nodes:         1,
+				Count:         1,
+			}},
+		},
+	}
+
+	newAcceleratorsRequest := func(t *testing.T, runtimes ...unstructured.Unstructured) *odhtypes.ReconciliationRequest {
+		t.Helper()
+
+		k := &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}}
+
+		return &odhtypes.ReconciliationRequest{
+			Client:     newTestClient(t, dsci.DeepCopy()),
+			Instance:   k,
+			Conditions: conditions.NewManager(k, ReadyConditionType),
+			Resources:  runtimes,
+		}
+	}
+
+	t.Run("reports the runtimes recommending accelerators not detected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newAcceleratorsRequest(t,
+			newRuntime("vllm-cuda-runtime", `["nvidia.com/gpu"]`),
+			newRuntime("vllm-rocm-runtime", `["amd.com/gpu"]`),
+			newRuntime("vllm-gaudi-runtime", `["habana.ai/gaudi"]`),
+			newRuntime("kserve-ovms", ""),
+		)
+
+		g.Expect(reportServingRuntimeAccelerators(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(4))
+		g.Expect(rr.Instance).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionServingRuntimeAcceleratorsAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionServingRuntimeAcceleratorsAvailable, status.ServingRuntimeAcceleratorsMissingReason),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .severity == "%s"`, status.ConditionServingRuntimeAcceleratorsAvailable, common.ConditionSeverityInfo),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("vllm-rocm-runtime, vllm-gaudi-runtime")`, status.ConditionServingRuntimeAcceleratorsAvailable),
+		)))
+	})
+
+	t.Run("reports the accelerators available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newAcceleratorsRequest(t,
+			newRuntime("vllm-cuda-runtime", `["nvidia.com/gpu", "amd.com/gpu"]`),
+		)
+
+		g.Expect(reportServingRuntimeAccelerators(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Instance).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionServingRuntimeAcceleratorsAvailable),
+		))
+	})
+
+	t.Run("fails on an invalid annotation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newAcceleratorsRequest(t, newRuntime("vllm-cuda-runtime", "nvidia.com/gpu"))
+
+		g.Expect(reportServingRuntimeAccelerators(ctx, rr)).Should(MatchError(ContainSubstring(annotations.RecommendedAccelerators)))
+	})
+}
+
+func TestReconcileModelMeshMigration(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	s.AddKnownTypeWithName(gvk.InferenceServices, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gvk.InferenceServices.GroupVersion().WithKind(gvk.InferenceServices.Kind+"List"), &unstructured.UnstructuredList{})
+
+	newISVC := func(namespace string, name string, mode string) *unstructured.Unstructured {
+		isvc := &unstructured.Unstructured{}
+		isvc.SetGroupVersionKind(gvk.InferenceServices)
+		isvc.SetNamespace(namespace)
+		isvc.SetName(name)
+		isvc.SetAnnotations(map[string]string{deploymentModeAnnotation: mode})
+		g.Expect(unstructured.SetNestedMap(isvc.Object, map[string]any{
+			"predictor": map[string]any{
+				"model": map[string]any{
+					"modelFormat": map[string]any{"name": "onnx"},
+					"runtime":     "ovms-modelmesh",
+					"storageUri":  "s3://models/mnist",
+				},
+			},
+		}, "spec")).Should(Succeed())
+
+		return isvc
+	}
+
+	report := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: modelMeshMigrationConfigMapName, Namespace: appNamespace},
+		Data: map[string]string{
+			modelMeshMigrationStatusKey: "models/migrated: Pending\nmodels/removed: Pending\n",
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(
+		&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+		},
+		report,
+		newISVC("models", "mnist", modelMeshDeploymentMode),
+		newISVC("models", "migrated", string(componentApi.DeploymentModeRawDeployment)),
+		newISVC("models", "other", string(componentApi.DeploymentModeServerless)),
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.InferenceServices.GroupKind(), gvk.InferenceServices.Version)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	crd := mocks.NewMockCRD(gvk.InferenceServices.Group, gvk.InferenceServices.Version, gvk.InferenceServices.Kind, "kserve")
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.InferenceServices.Version}
+	g.Expect(cli.Create(ctx, crd)).Should(Succeed())
+
+	k := &componentApi.Kserve{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName},
+	}
+	rr := &odhtypes.ReconciliationRequest{
+		Client:     cli,
+		Instance:   k,
+		Conditions: conditions.NewManager(k, ReadyConditionType),
+	}
+
+	g.Expect(reconcileModelMeshMigration(ctx, rr)).Should(Succeed())
+	g.Expect(rr.RequeueAfter).Should(Equal(modelMeshMigrationInterval))
+	g.Expect(rr.Resources).Should(HaveLen(1))
+
+	cm := corev1.ConfigMap{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, &cm)).Should(Succeed())
+	g.Expect(cm.Namespace).Should(Equal(appNamespace))
+	g.Expect(cm.Data).Should(HaveLen(2))
+	g.Expect(cm.Data[modelMeshMigrationStatusKey]).Should(Equal(
+		"models/migrated: Migrated\nmodels/mnist: Pending\nmodels/removed: Deleted\n"))
+
+	draft := unstructured.Unstructured{}
+	g.Expect(yaml.Unmarshal([]byte(cm.Data["models.mnist.yaml"]), &draft.Object)).Should(Succeed())
+	g.Expect(draft.Object).Should(And(
+		jq.Match(`.metadata.name == "mnist" and .metadata.namespace == "models"`),
+		jq.Match(`.metadata.annotations."%s" == "%s"`, deploymentModeAnnotation, componentApi.DeploymentModeRawDeployment),
+		jq.Match(`.spec.predictor.model.modelFormat.name == "onnx"`),
+		jq.Match(`.spec.predictor.model | has("runtime") | not`),
+	))
+
+	g.Expect(k).Should(WithTransform(resources.ToUnstructured, And(
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionModelMeshMigrated),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionModelMeshMigrated, status.ModelMeshMigrationPendingReason),
+	)))
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+	return cli
+}
+
+// newGatewayAPITestClient returns a fake client knowing the Gateway API CRDs.
+func newGatewayAPITestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	if err := gwapiv1.Install(s); err != nil {
+		t.Fatalf("Failed to add Gateway API to scheme: %v", err)
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	for _, g := range []schema.GroupVersionKind{gvk.KubernetesGateway, gvk.HTTPRoute} {
+		// the CRDs are named after the resources of the fake RESTMapper, which does
+		// not guess all plurals right
+		m, err := cli.RESTMapper().RESTMapping(g.GroupKind(), g.Version)
+		if err != nil {
+			t.Fatalf("Failed to get the REST mapping of %s: %v", g.Kind, err)
+		}
+
+		crd := mocks.NewMockCRD(g.Group, g.Version, g.Kind, componentName)
+		crd.Name = m.Resource.GroupResource().String()
+		crd.Status.StoredVersions = []string{g.Version}
+
+		if err := cli.Create(t.Context(), crd); err != nil {
+			t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+		}
+	}
+
+	return cli
+}
+
+// newServingRuntimeTestClient returns a fake client knowing the ClusterServingRuntime CRD.
+func newServingRuntimeTestClient(t *testing.T) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	s.AddKnownTypeWithName(gvk.ClusterServingRuntime, &unstructured.Unstructured{})
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.ClusterServingRuntime.GroupKind(), gvk.ClusterServingRuntime.Version)
+	if err != nil {
+		t.Fatalf("Failed to get the REST mapping of %s: %v", gvk.ClusterServingRuntime.Kind, err)
+	}
+
+	crd := mocks.NewMockCRD(gvk.ClusterServingRuntime.Group, gvk.ClusterServingRuntime.Version, gvk.ClusterServingRuntime.Kind, componentName)
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.ClusterServingRuntime.Version}
+
+	if err := cli.Create(t.Context(), crd); err != nil {
+		t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+	}
+
+	return cli
+}
+
 func createTestConfigMap() *corev1.ConfigMap {
 	return &corev1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
@@ -212,6 +1040,9 @@ func createTestConfigMap() *corev1.ConfigMap {
 			ServiceConfigKeyName: `{
 				"serviceClusterIPNone": false
 			}`,
+			DeployConfigKeyName: `{
+				"defaultDeploymentMode": "Serverless"
+			}`,
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -15,10 +16,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
@@ -34,6 +41,15 @@ var (
 		"kserve-llm-d-routing-sidecar":     "RELATED_IMAGE_ODH_LLM_D_ROUTING_SIDECAR_IMAGE",
 		"kube-rbac-proxy":                  "RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE",
 	}
+
+	// servingRuntimeTemplates maps the runtimes of the serving runtime catalog to the
+	// templates rendered by the component their ClusterServingRuntime is built from.
+	servingRuntimeTemplates = map[componentApi.ServingRuntimeName]string{
+		componentApi.ServingRuntimeVLLM:   "vllm-cuda-runtime-template",
+		componentApi.ServingRuntimeOVMS:   "kserve-ovms",
+		componentApi.ServingRuntimeTriton: "triton-kserve-serving-template",
+		componentApi.ServingRuntimeCaikit: "caikit-tgis-serving-template",
+	}
 )
 
 func kserveManifestInfo(sourcePath string) odhtypes.ManifestInfo {
@@ -44,14 +60,41 @@ func kserveManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 	}
 }
 
-func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless bool) error {
+// inferenceServiceIngress returns the ingress layer the InferenceServices are exposed with, empty
+// when ingress creation is disabled. When the service mesh is Removed, there is no Gateway so the
+// OpenShift router, or the Ingresses on Kubernetes, are used, otherwise the ingress type selected
+// in DSCInitialization.
+func inferenceServiceIngress(ctx context.Context, cli client.Client) (infrav1.IngressType, error) {
+	removed, err := gateway.IsServiceMeshRemoved(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if removed {
+		return cluster.DefaultIngressType(), nil
+	}
+
+	return cluster.IngressType(ctx, cli)
+}
+
+func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless bool, ingress infrav1.IngressType) error {
 	// ingress
-	// RawDeployment mode is the only supported mode, so always disable ingress creation
+	// RawDeployment mode is the only supported mode, so ingress creation is disabled unless an
+	// ingress layer is selected: either the OpenShift router, exposing the Ingresses with Routes,
+	// the data science Gateway, to which the HTTPRoutes are attached, or the default IngressClass
+	// of the cluster
 	var ingressData map[string]interface{}
 	if err := json.Unmarshal([]byte(inferenceServiceConfigMap.Data[IngressConfigKeyName]), &ingressData); err != nil {
 		return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", IngressConfigKeyName, kserveConfigMapName, err)
 	}
-	ingressData["disableIngressCreation"] = true
+	ingressData["disableIngressCreation"] = ingress == ""
+	switch ingress {
+	case infrav1.IngressTypeRoute:
+		ingressData["ingressClassName"] = openshiftIngressClassName
+	case infrav1.IngressTypeGatewayAPI:
+		ingressData["enableGatewayApi"] = true
+		ingressData["kserveIngressGateway"] = gateway.GatewayNamespace + "/" + gateway.DefaultGatewayName
+	}
 	ingressDataBytes, err := json.MarshalIndent(ingressData, "", " ")
 	if err != nil {
 		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
@@ -72,6 +115,287 @@ func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless b
 	return nil
 }
 
+// clusterServingRuntimeFor builds the ClusterServingRuntime of a runtime of the catalog from the
+// ServingRuntime of its rendered template, applying the image and resources overrides to the
+// runtime container.
+func clusterServingRuntimeFor(rs []unstructured.Unstructured, sr componentApi.ServingRuntimeSpec) (*unstructured.Unstructured, error) {
+	templateName, ok := servingRuntimeTemplates[sr.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown serving runtime %s", sr.Name)
+	}
+
+	var objects []any
+	for _, r := range rs {
+		if r.GroupVersionKind() != gvk.Template || r.GetName() != templateName {
+			continue
+		}
+
+		tmplObjects, _, err := unstructured.NestedSlice(r.Object, "objects")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read objects of template %s: %w", templateName, err)
+		}
+
+		objects = tmplObjects
+		break
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("could not find template %s of serving runtime %s in resources list", templateName, sr.Name)
+	}
+
+	obj, ok := objects[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object in template %s", templateName)
+	}
+
+	csr := &unstructured.Unstructured{Object: obj}
+	if csr.GetKind() != gvk.ServingRuntime.Kind {
+		return nil, fmt.Errorf("template %s does not contain a %s", templateName, gvk.ServingRuntime.Kind)
+	}
+
+	csr.SetGroupVersionKind(gvk.ClusterServingRuntime)
+	csr.SetNamespace("")
+
+	if sr.Image == "" && sr.Resources == nil {
+		return csr, nil
+	}
+
+	containers, _, err := unstructured.NestedSlice(csr.Object, "spec", "containers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read containers of serving runtime %s: %w", sr.Name, err)
+	}
+
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("serving runtime %s has no container", sr.Name)
+	}
+
+	container, ok := containers[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected container in serving runtime %s", sr.Name)
+	}
+
+	if sr.Image != "" {
+		container["image"] = sr.Image
+	}
+
+	if sr.Resources != nil {
+		res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(sr.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resources of serving runtime %s: %w", sr.Name, err)
+		}
+
+		container["resources"] = res
+	}
+
+	if err := unstructured.SetNestedSlice(csr.Object, containers, "spec", "containers"); err != nil {
+		return nil, fmt.Errorf("failed to set containers of serving runtime %s: %w", sr.Name, err)
+	}
+
+	return csr, nil
+}
+
+// recommendedAccelerators returns the extended resources of the accelerators recommended by a
+// serving runtime, none when it does not recommend any.
+func recommendedAccelerators(sr *unstructured.Unstructured) ([]string, error) {
+	value, ok := sr.GetAnnotations()[annotations.RecommendedAccelerators]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	var resourceNames []string
+	if err := json.Unmarshal([]byte(value), &resourceNames); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation of serving runtime %s: %w", annotations.RecommendedAccelerators, sr.GetName(), err)
+	}
+
+	return resourceNames, nil
+}
+
+// defaultDeploymentMode returns the deployment mode of the InferenceServices not setting one.
+func defaultDeploymentMode(k *componentApi.Kserve) componentApi.DeploymentMode {
+	if k.Spec.DefaultDeploymentMode == "" {
+		return componentApi.DeploymentModeRawDeployment
+	}
+
+	return k.Spec.DefaultDeploymentMode
+}
+
+// usesServerless returns whether the Serverless deployment mode is selected, either by default
+// or for some namespaces.
+func usesServerless(k *componentApi.Kserve) bool {
+	if defaultDeploymentMode(k) == componentApi.DeploymentModeServerless {
+		return true
+	}
+
+	for _, o := range k.Spec.DeploymentModeOverrides {
+		if o.DeploymentMode == componentApi.DeploymentModeServerless {
+			return true
+		}
+	}
+
+	return false
+}
+
+// namespaceDeploymentModes resolves the deployment mode overrides into the deployment mode of
+// each active namespace they match, the first matching override applying.
+func namespaceDeploymentModes(
+	ctx context.Context,
+	cli client.Client,
+	overrides []componentApi.DeploymentModeOverride,
+) (map[string]componentApi.DeploymentMode, error) {
+	modes := make(map[string]componentApi.DeploymentMode)
+
+	for i := range overrides {
+		selector, err := metav1.LabelSelectorAsSelector(&overrides[i].NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+
+		namespaces := corev1.NamespaceList{}
+		if err := cli.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		for _, ns := range namespaces.Items {
+			if !cluster.IsActiveNamespace(&ns) {
+				continue
+			}
+
+			if _, found := modes[ns.Name]; !found {
+				modes[ns.Name] = overrides[i].DeploymentMode
+			}
+		}
+	}
+
+	return modes, nil
+}
+
+func updateDeployConfig(
+	inferenceServiceConfigMap *corev1.ConfigMap,
+	mode componentApi.DeploymentMode,
+	namespaceModes map[string]componentApi.DeploymentMode,
+) error {
+	deployData := map[string]interface{}{}
+	if v, found := inferenceServiceConfigMap.Data[DeployConfigKeyName]; found {
+		if err := json.Unmarshal([]byte(v), &deployData); err != nil {
+			return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", DeployConfigKeyName, kserveConfigMapName, err)
+		}
+	}
+
+	deployData["defaultDeploymentMode"] = mode
+	delete(deployData, "namespaceDeploymentModes")
+	if len(namespaceModes) != 0 {
+		deployData["namespaceDeploymentModes"] = namespaceModes
+	}
+
+	deployDataBytes, err := json.MarshalIndent(deployData, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
+	}
+
+	if inferenceServiceConfigMap.Data == nil {
+		inferenceServiceConfigMap.Data = map[string]string{}
+	}
+	inferenceServiceConfigMap.Data[DeployConfigKeyName] = string(deployDataBytes)
+
+	return nil
+}
+
+func updateAutoscalerConfig(inferenceServiceConfigMap *corev1.ConfigMap, spec *componentApi.RawDeploymentAutoscalingSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	autoscalerData := map[string]interface{}{}
+	if v, found := inferenceServiceConfigMap.Data[AutoscalerConfigKeyName]; found {
+		if err := json.Unmarshal([]byte(v), &autoscalerData); err != nil {
+			return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", AutoscalerConfigKeyName, kserveConfigMapName, err)
+		}
+	}
+
+	if spec.MinReplicas != nil {
+		autoscalerData["minReplicas"] = *spec.MinReplicas
+	}
+	if spec.MaxReplicas != nil {
+		autoscalerData["maxReplicas"] = *spec.MaxReplicas
+	}
+	if spec.TargetUtilizationPercentage != nil {
+		autoscalerData["scaleMetric"] = rawDeploymentAutoscalingScaleMetric
+		autoscalerData["scaleTarget"] = *spec.TargetUtilizationPercentage
+	}
+
+	autoscalerDataBytes, err := json.MarshalIndent(autoscalerData, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
+	}
+
+	if inferenceServiceConfigMap.Data == nil {
+		inferenceServiceConfigMap.Data = map[string]string{}
+	}
+	inferenceServiceConfigMap.Data[AutoscalerConfigKeyName] = string(autoscalerDataBytes)
+
+	return nil
+}
+
+// knativeAutoscalerConfig returns the entries of the Knative autoscaler configuration
+// matching the Serverless autoscaling defaults.
+func knativeAutoscalerConfig(spec *componentApi.ServerlessAutoscalingSpec) map[string]string {
+	config := map[string]string{}
+	if spec == nil {
+		return config
+	}
+
+	if spec.TargetConcurrency != nil {
+		config[knativeTargetConcurrencyKey] = strconv.Itoa(int(*spec.TargetConcurrency))
+	}
+	if spec.ScaleToZeroGracePeriod != nil {
+		config[knativeScaleToZeroGracePeriodKey] = spec.ScaleToZeroGracePeriod.Duration.String()
+	}
+	if spec.MinReplicas != nil {
+		config[knativeMinScaleKey] = strconv.Itoa(int(*spec.MinReplicas))
+	}
+	if spec.MaxReplicas != nil {
+		config[knativeMaxScaleKey] = strconv.Itoa(int(*spec.MaxReplicas))
+	}
+
+	return config
+}
+
+// nimPullSecrets returns the copies of the NIM image pull secret for the active namespaces
+// matching the selector.
+func nimPullSecrets(ctx context.Context, cli client.Client, source *corev1.Secret, ls *metav1.LabelSelector) ([]client.Object, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := cli.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	secrets := make([]client.Object, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		if !cluster.IsActiveNamespace(&ns) || ns.Name == source.Namespace {
+			continue
+		}
+
+		secrets = append(secrets, &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      source.Name,
+				Namespace: ns.Name,
+			},
+			Type: source.Type,
+			Data: maps.Clone(source.Data),
+		})
+	}
+
+	return secrets, nil
+}
+
 func getIndexedResource(rs []unstructured.Unstructured, obj any, g schema.GroupVersionKind, name string) (int, error) {
 	var idx = -1
 	for i, r := range rs {
@@ -167,3 +491,53 @@ func isKserveOwnerRef(or metav1.OwnerReference) bool {
 	return or.APIVersion == componentApi.GroupVersion.String() &&
 		or.Kind == componentApi.KserveKind
 }
+
+// modelMeshMigrationStatus returns the migration status of the ModelMesh InferenceServices, keyed
+// by namespace/name, recorded in the migration report by a previous reconciliation.
+func modelMeshMigrationStatus(ctx context.Context, cli client.Client, namespace string) (map[string]string, error) {
+	report := corev1.ConfigMap{}
+
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: modelMeshMigrationConfigMapName}, &report)
+	switch {
+	case errors.IsNotFound(err):
+		return map[string]string{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get ModelMesh migration report: %w", err)
+	}
+
+	migration := map[string]string{}
+	if err := yaml.Unmarshal([]byte(report.Data[modelMeshMigrationStatusKey]), &migration); err != nil {
+		return nil, fmt.Errorf("invalid ModelMesh migration report: %w", err)
+	}
+
+	return migration, nil
+}
+
+// modelMeshDraft returns the KServe InferenceService equivalent to the given ModelMesh one, served
+// with the given deployment mode. The ModelMesh serving runtime is dropped, as it serves multiple
+// models and can not be used by KServe, so KServe selects a runtime supporting the model format.
+func modelMeshDraft(isvc *unstructured.Unstructured, mode componentApi.DeploymentMode) (string, error) {
+	draft := unstructured.Unstructured{Object: map[string]any{}}
+	draft.SetAPIVersion(isvc.GetAPIVersion())
+	draft.SetKind(isvc.GetKind())
+	draft.SetName(isvc.GetName())
+	draft.SetNamespace(isvc.GetNamespace())
+	draft.SetLabels(isvc.GetLabels())
+
+	annotations := maps.Clone(isvc.GetAnnotations())
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	annotations[deploymentModeAnnotation] = string(mode)
+	draft.SetAnnotations(annotations)
+
+	if spec, ok := isvc.Object["spec"].(map[string]any); ok {
+		draft.Object["spec"] = runtime.DeepCopyJSONValue(spec)
+		unstructured.RemoveNestedField(draft.Object, "spec", "predictor", "model", "runtime")
+	}
+
+	content, err := yaml.Marshal(draft.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate the KServe InferenceService of %s: %w", resources.FormatObjectReference(isvc), err)
+	}
+
+	return string(content), nil
+}
@@ -22,7 +22,8 @@ import (
 )
 
 const (
-	kueueOperator          = "kueue-operator"
+	// KueueOperator is the name prefix of the Red Hat build of Kueue operator.
+	KueueOperator          = "kueue-operator"
 	kueueOperatorNamespace = "openshift-kueue-operator"
 	kueueCRDname           = "kueues.kueue.openshift.io"
 )
@@ -49,7 +50,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.KueueInstanceName,
+			Name: components.InstanceName(dsc, componentApi.KueueInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(dsc.Spec.Components.Kueue.ManagementState),
 			},
@@ -80,18 +81,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.Kueue{}
-	c.Name = componentApi.KueueInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.KueueInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	ms := components.NormalizeManagementState(dsc.Spec.Components.Kueue.ManagementState)
 
 	dsc.Status.Components.Kueue.ManagementState = ms
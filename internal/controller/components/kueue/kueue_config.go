@@ -42,8 +42,8 @@ func lookupKueueManagerConfig(ctx context.Context, rr *odhtypes.ReconciliationRe
 	cm := corev1.ConfigMap{}
 	config := map[string]any{}
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return nil, err
 	}
@@ -38,10 +38,21 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates"
@@ -78,33 +89,33 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		).
 		WatchesGVK(gvk.LocalQueue,
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.Dynamic(reconciler.CrdExists(gvk.LocalQueue))).
 		WatchesGVK(gvk.ClusterQueue,
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.Dynamic(reconciler.CrdExists(gvk.ClusterQueue))).
 		WatchesGVK(gvk.ResourceFlavor,
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.Dynamic(reconciler.CrdExists(gvk.ResourceFlavor))).
 		WatchesGVK(gvk.KueueConfigV1,
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.Dynamic(reconciler.CrdExists(gvk.KueueConfigV1))).
 		WatchesGVK(gvk.OperatorCondition,
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
-			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamePrefixed(kueueOperator))).
+			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamePrefixed(KueueOperator))).
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName)),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName)),
 			reconciler.WithPredicates(predicate.Or(
 				component.ForLabel(labels.ODH.Component(LegacyComponentName), labels.True),
 				resources.CreatedOrUpdatedOrDeletedNamed(kueueCRDname),
@@ -112,13 +123,13 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		).
 		Watches(&rbacv1.ClusterRole{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.WithPredicates(resources.CreatedOrUpdatedName(ClusterQueueViewerRoleName), predicate.LabelChangedPredicate{}),
 		).
 		Watches(&corev1.Namespace{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 			reconciler.WithPredicates(
 				predicate.And(
@@ -129,9 +140,10 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		).
 		Watches(&serviceApi.Auth{},
 			reconciler.WithEventHandler(
-				handlers.ToNamed(componentApi.KueueInstanceName),
+				handlers.ToNamedAndScoped(mgr.GetAPIReader(), gvk.Kueue, componentApi.KueueInstanceName),
 			),
 		).
+		WithWorkloadSettingsWatches(componentApi.KueueInstanceName).
 		WithAction(checkPreConditions).
 		WithAction(initialize).
 		WithAction(releases.NewAction()).
@@ -141,10 +153,21 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		)).
 		WithAction(manageDefaultKueueResourcesAction).
 		WithAction(manageKueueAdminRoleBinding).
+		WithAction(platform.NewAction()).
+		WithAction(networkpolicy.NewAction()).
+		WithAction(podsecurity.NewAction()).
+		WithAction(fips.NewAction()).
+		WithAction(multiarch.NewAction()).
+		WithAction(highavailability.NewAction()).
+		WithAction(deploymentprofile.NewAction()).
+		WithAction(priorityclass.NewAction()).
+		WithAction(disconnected.NewAction()).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(verify.NewAction()).
 		WithAction(func(ctx context.Context, rr *types.ReconciliationRequest) error {
 			kueueCRInstance, ok := rr.Instance.(*componentApi.Kueue)
 			if !ok {
@@ -160,7 +183,8 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		WithAction(gc.NewAction()).
 		// declares the list of additional, controller specific conditions that are
 		// contributing to the controller readiness status
-		WithConditions(conditionTypes...)
+		WithConditions(conditionTypes...).
+		WithStandardConditions()
 
 	if _, err := b.Build(ctx); err != nil {
 		return err // no need customize error, it is done in the caller main
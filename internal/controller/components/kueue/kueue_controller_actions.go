@@ -8,15 +8,16 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
 )
 
 func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -29,7 +30,7 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 	case operatorv1.Managed:
 		return ErrKueueStateManagedNotSupported
 	case operatorv1.Unmanaged:
-		if found, err := cluster.OperatorExists(ctx, rr.Client, kueueOperator); err != nil || !found {
+		if found, err := dependency.Installed(ctx, rr.Client, dependency.Kueue); err != nil || !found {
 			if err != nil {
 				return odherrors.NewStopErrorW(err)
 			}
@@ -148,19 +149,6 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		rr.Resources = append(rr.Resources, *defaultKueueConfig)
 	}
 
-	clusterInfo, err := getClusterResourceInfo(ctx, rr.Client)
-	if err != nil {
-		return fmt.Errorf("failed to get cluster resource info: %w", err)
-	}
-
-	// Generate default ResourceFlavor.
-	resourcesFlavors := createDefaultResourceFlavors(clusterInfo)
-	rr.Resources = append(rr.Resources, resourcesFlavors...)
-
-	// Generate default ClusterQueue.
-	clusterQueue := createDefaultClusterQueue(kueueCRInstance.Spec.DefaultClusterQueueName, clusterInfo)
-	rr.Resources = append(rr.Resources, *clusterQueue)
-
 	// Get all managed namespaces (i.e. the one opted in with the addition of the proper labels).
 	managedNamespaces, err := getManagedNamespaces(ctx, rr.Client)
 	if err != nil {
@@ -172,6 +160,24 @@ func manageDefaultKueueResourcesAction(ctx context.Context, rr *odhtypes.Reconci
 		return fmt.Errorf("failed to add missing labels to managed namespaces: %v with error: %w", managedNamespaces, err)
 	}
 
+	// The queueing setup is left to the administrator.
+	if !ptr.Deref(kueueCRInstance.Spec.BootstrapDefaultQueues, true) {
+		return nil
+	}
+
+	clusterInfo, err := getClusterResourceInfo(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster resource info: %w", err)
+	}
+
+	// Generate default ResourceFlavor.
+	resourcesFlavors := createDefaultResourceFlavors(clusterInfo)
+	rr.Resources = append(rr.Resources, resourcesFlavors...)
+
+	// Generate default ClusterQueue, with the nominal quotas of the quota template.
+	clusterQueue := createDefaultClusterQueue(kueueCRInstance.Spec.DefaultClusterQueueName, clusterInfo, kueueCRInstance.Spec.DefaultClusterQueueQuota)
+	rr.Resources = append(rr.Resources, *clusterQueue)
+
 	// Generate LocalQueues in each managed namespaces.
 	for _, ns := range managedNamespaces {
 		localQueue := createDefaultLocalQueue(kueueCRInstance.Spec.DefaultLocalQueueName, kueueCRInstance.Spec.DefaultClusterQueueName, ns.Name)
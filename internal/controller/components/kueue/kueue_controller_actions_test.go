@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
@@ -62,7 +63,7 @@ func TestCheckPreConditions_Managed_KueueOperatorAlreadyInstalled(t *testing.T)
 	cli, err := fakeclient.New(
 		fakeclient.WithObjects(
 			&ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{
-				Name: kueueOperator,
+				Name: KueueOperator,
 			}},
 		),
 	)
@@ -628,6 +629,79 @@ func TestDefaultKueueResourcesAction(t *testing.T) {
 	}
 }
 
+func TestManageDefaultKueueResourcesAction_BootstrapDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	kueue := &componentApi.Kueue{
+		Spec: componentApi.KueueSpec{
+			KueueManagementSpec: componentApi.KueueManagementSpec{
+				ManagementState: operatorv1.Managed,
+			},
+			KueueDefaultQueueSpec: componentApi.KueueDefaultQueueSpec{
+				DefaultLocalQueueName:   "default",
+				DefaultClusterQueueName: "default",
+				BootstrapDefaultQueues:  ptr.To(false),
+			},
+		},
+	}
+
+	managedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-managed-ns",
+			Labels: map[string]string{
+				cluster.KueueManagedLabelKey: "true",
+			},
+		},
+	}
+
+	objs := append([]client.Object{managedNamespace}, getClusterNodes(t, true)...)
+	cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	rr := &types.ReconciliationRequest{
+		Instance: kueue,
+		Client:   cli,
+	}
+
+	err = manageDefaultKueueResourcesAction(t.Context(), rr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rr.Resources).To(BeEmpty())
+
+	// the managed namespaces are still labelled consistently
+	ns := &corev1.Namespace{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKeyFromObject(managedNamespace), ns)).To(Succeed())
+	g.Expect(ns.GetLabels()).To(HaveKeyWithValue(cluster.KueueLegacyManagedLabelKey, "true"))
+}
+
+func TestCreateDefaultClusterQueue_QuotaTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterInfo := ClusterResourceInfo{
+		CPU:    ResourceQuantity{Allocatable: resource.MustParse("16")},
+		Memory: ResourceQuantity{Allocatable: resource.MustParse("64Gi")},
+		GPUInfo: map[string]*ResourceQuantity{
+			NvidiaGPUResourceKey: {Allocatable: resource.MustParse("4")},
+		},
+	}
+
+	quota := corev1.ResourceList{
+		corev1.ResourceCPU:   resource.MustParse("8"),
+		NvidiaGPUResourceKey: resource.MustParse("2"),
+		"pods":               resource.MustParse("100"),
+	}
+
+	cq := createDefaultClusterQueue("default", clusterInfo, quota)
+
+	g.Expect(cq.Object).To(And(
+		jq.Match(`.spec.resourceGroups[0].coveredResources == ["cpu", "memory", "pods"]`),
+		jq.Match(`.spec.resourceGroups[0].flavors[0].resources[] | select(.name == "cpu") | .nominalQuota == "8"`),
+		jq.Match(`.spec.resourceGroups[0].flavors[0].resources[] | select(.name == "memory") | .nominalQuota == "64Gi"`),
+		jq.Match(`.spec.resourceGroups[0].flavors[0].resources[] | select(.name == "pods") | .nominalQuota == "100"`),
+		jq.Match(`.spec.resourceGroups[1].flavors[0].name == "%s"`, NvidiaFlavorName),
+		jq.Match(`.spec.resourceGroups[1].flavors[0].resources[0].nominalQuota == "2"`),
+	))
+}
+
 func assertClusterQueueCorrectness(g *WithT, clusterQueue *unstructured.Unstructured, withGPU bool, expectedClusterQueueName string, expectedFlavorNames []string) {
 	g.Expect(clusterQueue).ToNot(BeNil())
 	g.Expect(clusterQueue.GetName()).To(Equal(expectedClusterQueueName))
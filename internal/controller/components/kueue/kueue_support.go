@@ -52,6 +52,7 @@ const (
 var (
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 
 	supportedGPUMap = map[string]string{
@@ -181,17 +182,39 @@ func createResourceGroup(flavors []Flavors) map[string]interface{} {
 	}
 }
 
-func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo) *unstructured.Unstructured {
+// nominalQuota returns the nominal quota of a resource of the default ClusterQueue: the one set in
+// the quota template or else the allocatable capacity of the cluster.
+func nominalQuota(quota corev1.ResourceList, name string, allocatable resource.Quantity) string {
+	if q, ok := quota[corev1.ResourceName(name)]; ok {
+		return q.String()
+	}
+
+	return allocatable.String()
+}
+
+func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo, quota corev1.ResourceList) *unstructured.Unstructured {
 	clusterQueue := &unstructured.Unstructured{}
 
+	defaultResources := []FlavorResource{
+		{Name: "cpu", Value: nominalQuota(quota, "cpu", clusterInfo.CPU.Allocatable)},
+		{Name: "memory", Value: nominalQuota(quota, "memory", clusterInfo.Memory.Allocatable)},
+	}
+
+	// the resources of the quota template not found on the nodes are covered by the default flavor
+	for _, n := range slices.Sorted(maps.Keys(quota)) {
+		if n == corev1.ResourceCPU || n == corev1.ResourceMemory || clusterInfo.GPUInfo[string(n)] != nil {
+			continue
+		}
+
+		q := quota[n]
+		defaultResources = append(defaultResources, FlavorResource{Name: string(n), Value: q.String()})
+	}
+
 	resourceGroups := []any{
 		createResourceGroup([]Flavors{
 			{
-				Name: DefaultFlavorName,
-				Resources: []FlavorResource{
-					{Name: "cpu", Value: clusterInfo.CPU.Allocatable.String()},
-					{Name: "memory", Value: clusterInfo.Memory.Allocatable.String()},
-				},
+				Name:      DefaultFlavorName,
+				Resources: defaultResources,
 			},
 		}),
 	}
@@ -203,7 +226,7 @@ func createDefaultClusterQueue(name string, clusterInfo ClusterResourceInfo) *un
 			{
 				Name: supportedGPUMap[label],
 				Resources: []FlavorResource{
-					{Name: label, Value: gpuInfo.Allocatable.String()},
+					{Name: label, Value: nominalQuota(quota, label, gpuInfo.Allocatable)},
 				},
 			},
 		}))
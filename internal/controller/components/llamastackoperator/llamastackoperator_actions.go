@@ -2,7 +2,18 @@ package llamastackoperator
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
@@ -10,3 +21,113 @@ func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	rr.Manifests = append(rr.Manifests, manifestPath(rr.Release.Name))
 	return nil
 }
+
+// checkModelProvider verifies that the API key Secret of the model provider of the distribution
+// is found and that its inference endpoint accepts connections, before the distribution is
+// deployed.
+func checkModelProvider(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	l, ok := rr.Instance.(*componentApi.LlamaStackOperator)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.LlamaStackOperator)", rr.Instance)
+	}
+
+	d := l.Spec.Distribution
+	if d == nil {
+		rr.Conditions.MarkTrue(
+			status.ConditionModelProviderAvailable,
+			conditions.WithReason(status.ModelProviderNotConfiguredReason),
+			conditions.WithMessage(status.ModelProviderNotConfiguredMessage),
+		)
+
+		return nil
+	}
+
+	if ref := d.ModelProvider.APIKeySecretRef; ref != nil {
+		ns, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+		if err != nil {
+			return err
+		}
+
+		key := apiKeySecretKey(ref)
+
+		secret := corev1.Secret{}
+		err = rr.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: ref.Name}, &secret)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get model provider API key Secret %s: %w", ref.Name, err)
+		}
+
+		if k8serr.IsNotFound(err) || len(secret.Data[key]) == 0 {
+			rr.Conditions.MarkFalse(
+				status.ConditionModelProviderAvailable,
+				conditions.WithReason(status.ModelProviderCredentialsInvalidReason),
+				conditions.WithMessage(status.ModelProviderCredentialsInvalidMessage, ns, ref.Name, key),
+			)
+
+			return odherrors.NewStopError(status.ModelProviderCredentialsInvalidMessage, ns, ref.Name, key)
+		}
+	}
+
+	address, err := modelProviderAddress(d)
+	if err != nil {
+		return odherrors.NewStopErrorW(err)
+	}
+
+	if err := dialModelProvider(ctx, address); err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionModelProviderAvailable,
+			conditions.WithReason(status.ModelProviderUnreachableReason),
+			conditions.WithMessage(status.ModelProviderUnreachableMessage, d.Name, address, err),
+		)
+
+		return odherrors.NewStopError(status.ModelProviderUnreachableMessage, d.Name, address, err)
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionModelProviderAvailable,
+		conditions.WithReason(status.ModelProviderReachableReason),
+		conditions.WithMessage(status.ModelProviderReachableMessage, d.Name, address),
+	)
+
+	return nil
+}
+
+// reconcileDistribution renders the LlamaStackDistribution resource of the distribution declared
+// in the component spec, in the applications namespace.
+func reconcileDistribution(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	l, ok := rr.Instance.(*componentApi.LlamaStackOperator)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.LlamaStackOperator)", rr.Instance)
+	}
+
+	d := l.Spec.Distribution
+	if d == nil {
+		return nil
+	}
+
+	// the LlamaStackDistribution CRD is installed with the Llama Stack operator manifests, the
+	// CRD watch triggers a new reconciliation once it is created.
+	hasCRD, err := cluster.HasCRD(ctx, rr.Client, gvk.LlamaStackDistribution)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.LlamaStackDistribution, err)
+	}
+
+	if !hasCRD {
+		return nil
+	}
+
+	ns, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	obj, err := llamaStackDistributionFor(d, ns)
+	if err != nil {
+		return err
+	}
+
+	if err := rr.AddResources(obj); err != nil {
+		return fmt.Errorf("failed to add Llama Stack distribution %s/%s to manifests: %w", ns, DistributionName, err)
+	}
+
+	return nil
+}
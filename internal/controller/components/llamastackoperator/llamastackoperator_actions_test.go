@@ -0,0 +1,275 @@
+//nolint:testpackage
+package llamastackoperator
+
+import (
+	"net"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const appNamespace = "opendatahub"
+
+func newLlamaStackOperator(d *componentApi.LlamaStackDistributionSpec) *componentApi.LlamaStackOperator {
+	return &componentApi.LlamaStackOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.LlamaStackOperatorInstanceName},
+		Spec: componentApi.LlamaStackOperatorSpec{
+			LlamaStackOperatorCommonSpec: componentApi.LlamaStackOperatorCommonSpec{Distribution: d},
+		},
+	}
+}
+
+func TestCheckModelProvider(t *testing.T) {
+	ctx := t.Context()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start model provider listener: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a closed port: %v", err)
+	}
+	closedURL := "http://" + closed.Addr().String() + "/v1"
+	_ = closed.Close()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+	apiKey := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm-api-key", Namespace: appNamespace},
+		Data:       map[string][]byte{"token": []byte("secret")},
+	}
+
+	tests := []struct {
+		name         string
+		distribution *componentApi.LlamaStackDistributionSpec
+		stopError    bool
+		status       metav1.ConditionStatus
+		reason       string
+	}{
+		{
+			name:         "reports no distribution when none is set",
+			distribution: nil,
+			status:       metav1.ConditionTrue,
+			reason:       status.ModelProviderNotConfiguredReason,
+		},
+		{
+			name: "reports a reachable model provider",
+			distribution: &componentApi.LlamaStackDistributionSpec{
+				Name: componentApi.LlamaStackDistributionRemoteVLLM,
+				ModelProvider: componentApi.LlamaStackModelProviderSpec{
+					URL:             "http://" + listener.Addr().String() + "/v1",
+					Model:           "granite",
+					APIKeySecretRef: &componentApi.LlamaStackAPIKeySecretRef{Name: "vllm-api-key", Key: "token"},
+				},
+			},
+			status: metav1.ConditionTrue,
+			reason: status.ModelProviderReachableReason,
+		},
+		{
+			name: "stops on an API key Secret without its key",
+			distribution: &componentApi.LlamaStackDistributionSpec{
+				Name: componentApi.LlamaStackDistributionRemoteVLLM,
+				ModelProvider: componentApi.LlamaStackModelProviderSpec{
+					URL:             "http://" + listener.Addr().String() + "/v1",
+					Model:           "granite",
+					APIKeySecretRef: &componentApi.LlamaStackAPIKeySecretRef{Name: "vllm-api-key"},
+				},
+			},
+			stopError: true,
+			status:    metav1.ConditionFalse,
+			reason:    status.ModelProviderCredentialsInvalidReason,
+		},
+		{
+			name: "stops on an unreachable model provider",
+			distribution: &componentApi.LlamaStackDistributionSpec{
+				Name: componentApi.LlamaStackDistributionOllama,
+				ModelProvider: componentApi.LlamaStackModelProviderSpec{
+					URL:   closedURL,
+					Model: "llama3.2:3b",
+				},
+			},
+			stopError: true,
+			status:    metav1.ConditionFalse,
+			reason:    status.ModelProviderUnreachableReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(dsci.DeepCopy(), apiKey.DeepCopy()))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			l := newLlamaStackOperator(tt.distribution)
+			rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: l, Conditions: conditions.NewManager(l, ReadyConditionType)}
+
+			err = checkModelProvider(ctx, rr)
+			if tt.stopError {
+				g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			g.Expect(l).Should(WithTransform(resources.ToUnstructured, And(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionModelProviderAvailable, tt.status),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionModelProviderAvailable, tt.reason),
+			)))
+		})
+	}
+}
+
+func TestReconcileDistribution(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("waits for the LlamaStackDistribution CRD", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		l := newLlamaStackOperator(&componentApi.LlamaStackDistributionSpec{
+			Name:          componentApi.LlamaStackDistributionOllama,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{URL: "http://ollama:11434", Model: "llama3.2:3b"},
+		})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: l}
+
+		g.Expect(reconcileDistribution(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+	})
+
+	t.Run("renders the remote-vllm distribution", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newDistributionTestClient(t)
+
+		l := newLlamaStackOperator(&componentApi.LlamaStackDistributionSpec{
+			Name: componentApi.LlamaStackDistributionRemoteVLLM,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{
+				URL:             "http://vllm.models.svc:8000/v1",
+				Model:           "granite",
+				APIKeySecretRef: &componentApi.LlamaStackAPIKeySecretRef{Name: "vllm-api-key"},
+			},
+		})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: l}
+
+		g.Expect(reconcileDistribution(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0] | .kind == "%s" and .metadata.name == "%s" and .metadata.namespace == "%s"`, gvk.LlamaStackDistribution.Kind, DistributionName, appNamespace),
+			jq.Match(`.[0].spec.server.distribution.name == "remote-vllm"`),
+			jq.Match(`.[0].spec.server.containerSpec.env[] | select(.name == "INFERENCE_MODEL") | .value == "granite"`),
+			jq.Match(`.[0].spec.server.containerSpec.env[] | select(.name == "VLLM_URL") | .value == "http://vllm.models.svc:8000/v1"`),
+			jq.Match(`.[0].spec.server.containerSpec.env[] | select(.name == "VLLM_API_TOKEN") | .valueFrom.secretKeyRef | .name == "vllm-api-key" and .key == "apiKey"`),
+		))
+	})
+
+	t.Run("renders the together distribution", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newDistributionTestClient(t)
+
+		l := newLlamaStackOperator(&componentApi.LlamaStackDistributionSpec{
+			Name: componentApi.LlamaStackDistributionTogether,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{
+				Model:           "meta-llama/Llama-3.3-70B-Instruct-Turbo",
+				APIKeySecretRef: &componentApi.LlamaStackAPIKeySecretRef{Name: "together", Key: "key"},
+			},
+		})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: l}
+
+		g.Expect(reconcileDistribution(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0].spec.server.distribution.name == "together"`),
+			jq.Match(`.[0].spec.server.containerSpec.env | length == 2`),
+			jq.Match(`.[0].spec.server.containerSpec.env[] | select(.name == "TOGETHER_API_KEY") | .valueFrom.secretKeyRef | .name == "together" and .key == "key"`),
+		))
+	})
+}
+
+func TestModelProviderAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := map[string]*componentApi.LlamaStackDistributionSpec{
+		"vllm.models.svc:8000": {
+			Name:          componentApi.LlamaStackDistributionRemoteVLLM,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{URL: "http://vllm.models.svc:8000/v1"},
+		},
+		"vllm.example.com:443": {
+			Name:          componentApi.LlamaStackDistributionRemoteVLLM,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{URL: "https://vllm.example.com/v1"},
+		},
+		"ollama:80": {
+			Name:          componentApi.LlamaStackDistributionOllama,
+			ModelProvider: componentApi.LlamaStackModelProviderSpec{URL: "http://ollama"},
+		},
+		"api.together.xyz:443": {
+			Name: componentApi.LlamaStackDistributionTogether,
+		},
+	}
+
+	for expected, d := range tests {
+		address, err := modelProviderAddress(d)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(address).Should(Equal(expected))
+	}
+}
+
+func newDistributionTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	s.AddKnownTypeWithName(gvk.LlamaStackDistribution, &unstructured.Unstructured{})
+
+	objs = append(objs, &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	})
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.LlamaStackDistribution.GroupKind(), gvk.LlamaStackDistribution.Version)
+	if err != nil {
+		t.Fatalf("Failed to get the REST mapping of %s: %v", gvk.LlamaStackDistribution.Kind, err)
+	}
+
+	crd := mocks.NewMockCRD(gvk.LlamaStackDistribution.Group, gvk.LlamaStackDistribution.Version, gvk.LlamaStackDistribution.Kind, ComponentName)
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.LlamaStackDistribution.Version}
+
+	if err := cli.Create(t.Context(), crd); err != nil {
+		t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+	}
+
+	return cli
+}
@@ -1,18 +1,39 @@
 package llamastackoperator
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
 	ComponentName = componentApi.LlamaStackOperatorComponentName
 
 	ReadyConditionType = componentApi.LlamaStackOperatorKind + status.ReadySuffix
+
+	// DistributionName is the name of the LlamaStackDistribution deployed in the applications namespace.
+	DistributionName = "llama-stack"
+
+	// modelProviderDialTimeout bounds the connectivity check of the model provider.
+	modelProviderDialTimeout = 5 * time.Second
+
+	// togetherURL is the inference endpoint of the together distribution.
+	togetherURL = "https://api.together.xyz/v1"
+
+	defaultAPIKeySecretKey = "apiKey"
 )
 
 var (
@@ -30,6 +51,19 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionModelProviderAvailable,
+		status.ConditionTypeReadinessChecksPassed,
+	}
+
+	// distributionEnv maps each distribution to the environment variables of its Llama Stack
+	// server holding the URL and the API key of the model provider.
+	distributionEnv = map[componentApi.LlamaStackDistributionName]struct {
+		URL    string
+		APIKey string
+	}{
+		componentApi.LlamaStackDistributionRemoteVLLM: {URL: "VLLM_URL", APIKey: "VLLM_API_TOKEN"},
+		componentApi.LlamaStackDistributionOllama:     {URL: "OLLAMA_URL"},
+		componentApi.LlamaStackDistributionTogether:   {APIKey: "TOGETHER_API_KEY"},
 	}
 )
 
@@ -40,3 +74,99 @@ func manifestPath(p common.Platform) types.ManifestInfo {
 		SourcePath: ManifestsSourcePath[p],
 	}
 }
+
+// modelProviderURL returns the inference endpoint of the model provider of a distribution.
+func modelProviderURL(d *componentApi.LlamaStackDistributionSpec) string {
+	if d.Name == componentApi.LlamaStackDistributionTogether {
+		return togetherURL
+	}
+
+	return d.ModelProvider.URL
+}
+
+// modelProviderAddress returns the host and port of the inference endpoint of the model
+// provider, the port defaulting to the one of the URL scheme.
+func modelProviderAddress(d *componentApi.LlamaStackDistributionSpec) (string, error) {
+	u, err := url.Parse(modelProviderURL(d))
+	if err != nil {
+		return "", fmt.Errorf("invalid model provider URL: %w", err)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+func apiKeySecretKey(ref *componentApi.LlamaStackAPIKeySecretRef) string {
+	if ref.Key != "" {
+		return ref.Key
+	}
+
+	return defaultAPIKeySecretKey
+}
+
+func dialModelProvider(ctx context.Context, address string) error {
+	d := net.Dialer{Timeout: modelProviderDialTimeout}
+
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// llamaStackDistributionFor returns the LlamaStackDistribution resource reconciled by the Llama
+// Stack operator for the distribution of the component, its server being configured with the
+// model provider through environment variables.
+func llamaStackDistributionFor(d *componentApi.LlamaStackDistributionSpec, namespace string) (*unstructured.Unstructured, error) {
+	names := distributionEnv[d.Name]
+
+	env := []any{
+		map[string]any{"name": "INFERENCE_MODEL", "value": d.ModelProvider.Model},
+	}
+
+	if names.URL != "" {
+		env = append(env, map[string]any{"name": names.URL, "value": d.ModelProvider.URL})
+	}
+
+	if ref := d.ModelProvider.APIKeySecretRef; ref != nil && names.APIKey != "" {
+		env = append(env, map[string]any{
+			"name": names.APIKey,
+			"valueFrom": map[string]any{
+				"secretKeyRef": map[string]any{
+					"name": ref.Name,
+					"key":  apiKeySecretKey(ref),
+				},
+			},
+		})
+	}
+
+	u := resources.GvkToUnstructured(gvk.LlamaStackDistribution)
+	u.SetName(DistributionName)
+	u.SetNamespace(namespace)
+
+	spec := map[string]any{
+		"replicas": int64(1),
+		"server": map[string]any{
+			"distribution": map[string]any{
+				"name": string(d.Name),
+			},
+			"containerSpec": map[string]any{
+				"env": env,
+			},
+		},
+	}
+
+	if err := unstructured.SetNestedMap(u.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set the spec of Llama Stack distribution %s: %w", d.Name, err)
+	}
+
+	return u, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
@@ -43,6 +44,12 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 		mrState = operatorv1.Managed
 	}
 
+	// the workload settings of KServe apply to the model controller as well, except its
+	// readiness checks and developer settings, targeting the KServe manifests
+	workload := dsc.Spec.Components.Kserve.ComponentWorkloadSpec
+	workload.ReadinessChecks = nil
+	workload.DevFlags = nil
+
 	// ModelController is enabled only by KServe in RHOAI 3.0
 	managementState := kState
 
@@ -52,15 +59,16 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.ModelControllerInstanceName,
+			Name: components.InstanceName(dsc, componentApi.ModelControllerInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(managementState),
 			},
 		},
 		Spec: componentApi.ModelControllerSpec{
 			Kserve: &componentApi.ModelControllerKerveSpec{
-				ManagementState: kState,
-				NIM:             dsc.Spec.Components.Kserve.NIM,
+				ManagementState:       kState,
+				NIM:                   componentApi.NimSpec{ManagementState: dsc.Spec.Components.Kserve.NIMManagementState()},
+				ComponentWorkloadSpec: workload,
 			},
 			ModelRegistry: &componentApi.ModelControllerMRSpec{
 				ManagementState: mrState,
@@ -87,18 +95,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.ModelController{}
-	c.Name = componentApi.ModelControllerInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.ModelControllerInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	rr.Conditions.MarkFalse(ReadyConditionType)
 
 	if s.IsEnabled(dsc) {
@@ -4,13 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -22,16 +33,107 @@ func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	return nil
 }
 
+// checkDatabase validates the external database of the model registries, when one is set:
+// the credentials Secret and CA bundle must exist in the registries namespace and the
+// database server must accept connections. The reconciliation is stopped otherwise, so
+// the model registries are not configured with a database they can't use.
+func checkDatabase(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	mr, ok := rr.Instance.(*componentApi.ModelRegistry)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.ModelRegistry)", rr.Instance)
+	}
+
+	db := mr.Spec.Database
+	if db == nil {
+		rr.Conditions.MarkTrue(
+			status.ConditionDatabaseAvailable,
+			conditions.WithReason(status.DatabaseBundledReason),
+			conditions.WithMessage(status.DatabaseBundledMessage),
+		)
+
+		return nil
+	}
+
+	ns := mr.Spec.RegistriesNamespace
+	usernameKey, passwordKey := databaseCredentialKeys(db)
+
+	credentials := corev1.Secret{}
+	err := rr.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: db.CredentialsSecretRef.Name}, &credentials)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to get database credentials Secret %s: %w", db.CredentialsSecretRef.Name, err)
+	}
+
+	if k8serr.IsNotFound(err) || len(credentials.Data[usernameKey]) == 0 || len(credentials.Data[passwordKey]) == 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionDatabaseAvailable,
+			conditions.WithReason(status.DatabaseCredentialsInvalidReason),
+			conditions.WithMessage(status.DatabaseCredentialsInvalidMessage, ns, db.CredentialsSecretRef.Name, usernameKey, passwordKey),
+		)
+
+		return odherrors.NewStopError(status.DatabaseCredentialsInvalidMessage, ns, db.CredentialsSecretRef.Name, usernameKey, passwordKey)
+	}
+
+	if db.TLS != nil && db.TLS.CABundleConfigMapName != "" {
+		caBundle := corev1.ConfigMap{}
+		err := rr.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: db.TLS.CABundleConfigMapName}, &caBundle)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get database CA bundle ConfigMap %s: %w", db.TLS.CABundleConfigMapName, err)
+		}
+
+		if k8serr.IsNotFound(err) || caBundle.Data[databaseCABundleKey] == "" {
+			rr.Conditions.MarkFalse(
+				status.ConditionDatabaseAvailable,
+				conditions.WithReason(status.DatabaseCABundleMissingReason),
+				conditions.WithMessage(status.DatabaseCABundleMissingMessage, ns, db.TLS.CABundleConfigMapName),
+			)
+
+			return odherrors.NewStopError(status.DatabaseCABundleMissingMessage, ns, db.TLS.CABundleConfigMapName)
+		}
+	}
+
+	address := databaseAddress(db)
+
+	if err := dialDatabase(ctx, address); err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionDatabaseAvailable,
+			conditions.WithReason(status.DatabaseUnreachableReason),
+			conditions.WithMessage(status.DatabaseUnreachableMessage, db.Type, address, err),
+		)
+
+		return odherrors.NewStopError(status.DatabaseUnreachableMessage, db.Type, address, err)
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionDatabaseAvailable,
+		conditions.WithReason(status.DatabaseReachableReason),
+		conditions.WithMessage(status.DatabaseReachableMessage, db.Type, address),
+	)
+
+	return nil
+}
+
 func customizeManifests(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	mr, ok := rr.Instance.(*componentApi.ModelRegistry)
 	if !ok {
 		return fmt.Errorf("resource instance %v is not a componentApi.ModelRegistry)", rr.Instance)
 	}
 
-	// update registries namespace in manifests
+	serviceRoute, err := defaultServiceRoute(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	gatewayRef, err := defaultGateway(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	// update registries namespace, routing and external database in manifests
 	if err := odhdeploy.ApplyParams(rr.Manifests[0].String(), "params.env", nil, map[string]string{
-		"REGISTRIES_NAMESPACE": mr.Spec.RegistriesNamespace,
-	}); err != nil {
+		"REGISTRIES_NAMESPACE":  mr.Spec.RegistriesNamespace,
+		"DEFAULT_SERVICE_ROUTE": serviceRoute,
+		"DEFAULT_GATEWAY":       gatewayRef,
+	}, databaseParams(mr.Spec.Database)); err != nil {
 		return fmt.Errorf("failed to update params on path %s: %w", rr.Manifests[0].String(), err)
 	}
 	return nil
@@ -57,7 +159,124 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
-func updateStatus(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+// reconcileRegistries renders the ModelRegistry resources of the model registry instances
+// declared in the component spec. Each instance is handled independently: an instance whose
+// namespace or database credentials are missing is reported as not ready in the component
+// status, without preventing the other instances from being reconciled.
+func reconcileRegistries(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	mr, ok := rr.Instance.(*componentApi.ModelRegistry)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.ModelRegistry)", rr.Instance)
+	}
+
+	registries := make([]componentApi.ModelRegistryInstanceStatus, 0, len(mr.Spec.Registries))
+	defer func() {
+		mr.Status.Registries = registries
+	}()
+
+	// without the feature, the registries are rendered without their Istio integration
+	istio, err := featuregates.Consult(ctx, rr, featuregates.ModelRegistryIstio)
+	if err != nil {
+		return err
+	}
+
+	if len(mr.Spec.Registries) == 0 {
+		return nil
+	}
+
+	// the ModelRegistry CRD is installed with the model registry operator manifests, the
+	// CRD watch triggers a new reconciliation once it is created.
+	hasCRD, err := cluster.HasCRD(ctx, rr.Client, gvk.ModelRegistryInstance)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.ModelRegistryInstance, err)
+	}
+
+	gatewayDomain := ""
+
+	for _, r := range mr.Spec.Registries {
+		rs := componentApi.ModelRegistryInstanceStatus{
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Ready:     metav1.ConditionUnknown,
+			Message:   status.RegistryPendingMessage,
+		}
+		if rs.Namespace == "" {
+			rs.Namespace = mr.Spec.RegistriesNamespace
+		}
+
+		if !hasCRD {
+			rs.Message = status.RegistryCRDMissingMessage
+			registries = append(registries, rs)
+
+			continue
+		}
+
+		if rs.Namespace != mr.Spec.RegistriesNamespace {
+			ns := corev1.Namespace{}
+			err := rr.Client.Get(ctx, client.ObjectKey{Name: rs.Namespace}, &ns)
+			if err != nil && !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to get namespace %s: %w", rs.Namespace, err)
+			}
+
+			if k8serr.IsNotFound(err) {
+				rs.Ready = metav1.ConditionFalse
+				rs.Message = fmt.Sprintf(status.RegistryNamespaceNotFoundMessage, rs.Namespace)
+				registries = append(registries, rs)
+
+				continue
+			}
+		}
+
+		if !istio {
+			r.Istio = nil
+		}
+
+		db := registryDatabase(mr, r)
+		username := ""
+
+		if db != nil {
+			usernameKey, passwordKey := databaseCredentialKeys(db)
+
+			credentials := corev1.Secret{}
+			err := rr.Client.Get(ctx, client.ObjectKey{Namespace: rs.Namespace, Name: db.CredentialsSecretRef.Name}, &credentials)
+			if err != nil && !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to get database credentials Secret %s/%s: %w", rs.Namespace, db.CredentialsSecretRef.Name, err)
+			}
+
+			if k8serr.IsNotFound(err) || len(credentials.Data[usernameKey]) == 0 || len(credentials.Data[passwordKey]) == 0 {
+				rs.Ready = metav1.ConditionFalse
+				rs.Message = fmt.Sprintf(status.RegistryCredentialsInvalidMessage, db.CredentialsSecretRef.Name, usernameKey, passwordKey)
+				registries = append(registries, rs)
+
+				continue
+			}
+
+			username = string(credentials.Data[usernameKey])
+		}
+
+		if r.Istio != nil && r.Istio.Gateway != nil && r.Istio.Gateway.Domain == "" && gatewayDomain == "" {
+			gatewayDomain, err = gateway.GetGatewayDomain(ctx, rr.Client)
+			if err != nil {
+				return fmt.Errorf("failed to get the gateway domain: %w", err)
+			}
+		}
+
+		obj, err := modelRegistryFor(r, rs.Namespace, db, username, gatewayDomain)
+		if err != nil {
+			return err
+		}
+
+		if err := rr.AddResources(obj); err != nil {
+			return fmt.Errorf("failed to add model registry %s/%s to manifests: %w", rs.Namespace, r.Name, err)
+		}
+
+		registries = append(registries, rs)
+	}
+
+	return nil
+}
+
+func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	mr, ok := rr.Instance.(*componentApi.ModelRegistry)
 	if !ok {
 		return errors.New("instance is not of type *odhTypes.ModelRegistry")
@@ -65,5 +284,40 @@ func updateStatus(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
 
 	mr.Status.RegistriesNamespace = mr.Spec.RegistriesNamespace
 
+	notReady := make([]string, 0)
+
+	for i := range mr.Status.Registries {
+		rs := &mr.Status.Registries[i]
+
+		// only the rendered instances are pending, the other ones keep the reason reported
+		// by reconcileRegistries
+		if rs.Ready == metav1.ConditionUnknown && rs.Message == status.RegistryPendingMessage {
+			obj := resources.GvkToUnstructured(gvk.ModelRegistryInstance)
+
+			err := rr.Client.Get(ctx, client.ObjectKey{Namespace: rs.Namespace, Name: rs.Name}, obj)
+			switch {
+			case k8serr.IsNotFound(err):
+			case err != nil:
+				return fmt.Errorf("failed to get model registry %s/%s: %w", rs.Namespace, rs.Name, err)
+			default:
+				rs.Ready, rs.Message = registryAvailability(obj)
+			}
+		}
+
+		if rs.Ready != metav1.ConditionTrue {
+			notReady = append(notReady, rs.Namespace+"/"+rs.Name)
+		}
+	}
+
+	if len(notReady) != 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionRegistriesAvailable,
+			conditions.WithReason(status.RegistriesNotReadyReason),
+			conditions.WithMessage(status.RegistriesNotReadyMessage, strings.Join(notReady, ", ")),
+		)
+	} else {
+		rr.Conditions.MarkTrue(status.ConditionRegistriesAvailable)
+	}
+
 	return nil
 }
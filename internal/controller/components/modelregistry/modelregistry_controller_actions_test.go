@@ -0,0 +1,459 @@
+//nolint:testpackage
+package modelregistry
+
+import (
+	"net"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCheckDatabase(t *testing.T) {
+	ctx := t.Context()
+
+	const registriesNamespace = "odh-model-registries"
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start database listener: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to parse database listener address: %v", err)
+	}
+
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mr-db-credentials", Namespace: registriesNamespace},
+		Data: map[string][]byte{
+			"username": []byte("registry"),
+			"password": []byte("secret"),
+		},
+	}
+
+	newDatabase := func(dbPort string) *componentApi.ModelRegistryDatabaseSpec {
+		db := &componentApi.ModelRegistryDatabaseSpec{
+			Type:                 componentApi.DatabaseTypePostgres,
+			Host:                 host,
+			DatabaseName:         "model_registry",
+			CredentialsSecretRef: componentApi.DatabaseCredentialsSecretRef{Name: credentials.Name},
+		}
+
+		if dbPort != "" {
+			p, err := net.LookupPort("tcp", dbPort)
+			if err != nil {
+				t.Fatalf("failed to parse database port: %v", err)
+			}
+			db.Port = int32(p) //nolint:gosec
+		}
+
+		return db
+	}
+
+	newRequest := func(db *componentApi.ModelRegistryDatabaseSpec, objs ...client.Object) (*componentApi.ModelRegistry, *odhtypes.ReconciliationRequest) {
+		mr := &componentApi.ModelRegistry{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.ModelRegistryInstanceName},
+			Spec: componentApi.ModelRegistrySpec{
+				ModelRegistryCommonSpec: componentApi.ModelRegistryCommonSpec{
+					RegistriesNamespace: registriesNamespace,
+					Database:            db,
+				},
+			},
+		}
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+		if err != nil {
+			t.Fatalf("failed to create fake client: %v", err)
+		}
+
+		return mr, &odhtypes.ReconciliationRequest{
+			Client:     cli,
+			Instance:   mr,
+			Conditions: conditions.NewManager(mr, ReadyConditionType),
+		}
+	}
+
+	t.Run("uses the bundled database when none is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		mr, rr := newRequest(nil)
+
+		g.Expect(checkDatabase(ctx, rr)).Should(Succeed())
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionDatabaseAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionDatabaseAvailable, status.DatabaseBundledReason),
+		)))
+	})
+
+	t.Run("validates the credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+
+		mr, rr := newRequest(newDatabase(port), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: credentials.Name, Namespace: registriesNamespace},
+			Data:       map[string][]byte{"username": []byte("registry")},
+		})
+
+		err := checkDatabase(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionDatabaseAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionDatabaseAvailable, status.DatabaseCredentialsInvalidReason),
+		)))
+	})
+
+	t.Run("validates the CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		db := newDatabase(port)
+		db.TLS = &componentApi.DatabaseTLSSpec{
+			SSLMode:               componentApi.DatabaseSSLModeVerifyFull,
+			CABundleConfigMapName: "mr-db-ca",
+		}
+
+		mr, rr := newRequest(db, credentials.DeepCopy())
+
+		err := checkDatabase(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionDatabaseAvailable, status.DatabaseCABundleMissingReason),
+		))
+	})
+
+	t.Run("reports an unreachable database", func(t *testing.T) {
+		g := NewWithT(t)
+
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		_, closedPort, err := net.SplitHostPort(closed.Addr().String())
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(closed.Close()).Should(Succeed())
+
+		mr, rr := newRequest(newDatabase(closedPort), credentials.DeepCopy())
+
+		err = checkDatabase(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionDatabaseAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionDatabaseAvailable, status.DatabaseUnreachableReason),
+		)))
+	})
+
+	t.Run("reports a reachable database", func(t *testing.T) {
+		g := NewWithT(t)
+
+		db := newDatabase(port)
+		db.TLS = &componentApi.DatabaseTLSSpec{
+			SSLMode:               componentApi.DatabaseSSLModeVerifyCA,
+			CABundleConfigMapName: "mr-db-ca",
+		}
+
+		mr, rr := newRequest(db, credentials.DeepCopy(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr-db-ca", Namespace: registriesNamespace},
+			Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"},
+		})
+
+		g.Expect(checkDatabase(ctx, rr)).Should(Succeed())
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionDatabaseAvailable),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionDatabaseAvailable, status.DatabaseReachableReason),
+		)))
+	})
+}
+
+func TestDatabaseParams(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(databaseParams(nil)).Should(And(
+		HaveKeyWithValue("DATABASE_TYPE", ""),
+		HaveKeyWithValue("DATABASE_HOST", ""),
+		HaveKeyWithValue("DATABASE_SECRET_NAME", ""),
+	))
+
+	g.Expect(databaseParams(&componentApi.ModelRegistryDatabaseSpec{
+		Type:                 componentApi.DatabaseTypeMySQL,
+		Host:                 "mysql.example.com",
+		DatabaseName:         "model_registry",
+		CredentialsSecretRef: componentApi.DatabaseCredentialsSecretRef{Name: "mr-db-credentials", PasswordKey: "pass"},
+		TLS:                  &componentApi.DatabaseTLSSpec{SSLMode: componentApi.DatabaseSSLModeRequire},
+	})).Should(And(
+		HaveKeyWithValue("DATABASE_TYPE", "mysql"),
+		HaveKeyWithValue("DATABASE_HOST", "mysql.example.com"),
+		HaveKeyWithValue("DATABASE_PORT", "3306"),
+		HaveKeyWithValue("DATABASE_NAME", "model_registry"),
+		HaveKeyWithValue("DATABASE_SECRET_NAME", "mr-db-credentials"),
+		HaveKeyWithValue("DATABASE_USERNAME_KEY", "username"),
+		HaveKeyWithValue("DATABASE_PASSWORD_KEY", "pass"),
+		HaveKeyWithValue("DATABASE_SSL_MODE", "require"),
+		HaveKeyWithValue("DATABASE_CA_CONFIGMAP", ""),
+	))
+}
+
+func TestReconcileRegistries(t *testing.T) {
+	ctx := t.Context()
+
+	const registriesNamespace = "odh-model-registries"
+
+	newModelRegistry := func(registries ...componentApi.ModelRegistryInstanceSpec) *componentApi.ModelRegistry {
+		return &componentApi.ModelRegistry{
+			ObjectMeta: metav1.ObjectMeta{Name: componentApi.ModelRegistryInstanceName},
+			Spec: componentApi.ModelRegistrySpec{
+				ModelRegistryCommonSpec: componentApi.ModelRegistryCommonSpec{
+					RegistriesNamespace: registriesNamespace,
+					Database: &componentApi.ModelRegistryDatabaseSpec{
+						Type:                 componentApi.DatabaseTypeMySQL,
+						Host:                 "mysql.example.com",
+						DatabaseName:         "model_registry",
+						CredentialsSecretRef: componentApi.DatabaseCredentialsSecretRef{Name: "mr-db-credentials"},
+					},
+					Registries: registries,
+				},
+			},
+		}
+	}
+
+	t.Run("waits for the ModelRegistry CRD", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		mr := newModelRegistry(componentApi.ModelRegistryInstanceSpec{Name: "default"})
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: mr, Conditions: conditions.NewManager(mr, ReadyConditionType)}
+
+		g.Expect(reconcileRegistries(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+		g.Expect(mr.Status.Registries).Should(ConsistOf(And(
+			HaveField("Name", "default"),
+			HaveField("Namespace", registriesNamespace),
+			HaveField("Ready", metav1.ConditionUnknown),
+			HaveField("Message", status.RegistryCRDMissingMessage),
+		)))
+	})
+
+	t.Run("reconciles each registry independently", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newRegistriesTestClient(t,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a-db", Namespace: "team-a"},
+				Data: map[string][]byte{
+					"username": []byte("team-a"),
+					"password": []byte("secret"),
+				},
+			},
+		)
+
+		mr := newModelRegistry(
+			componentApi.ModelRegistryInstanceSpec{
+				Name: "shared",
+			},
+			componentApi.ModelRegistryInstanceSpec{
+				Name:      "team-a",
+				Namespace: "team-a",
+				Database: &componentApi.ModelRegistryDatabaseSpec{
+					Type:                 componentApi.DatabaseTypePostgres,
+					Host:                 "postgres.team-a.svc",
+					DatabaseName:         "team_a",
+					CredentialsSecretRef: componentApi.DatabaseCredentialsSecretRef{Name: "team-a-db"},
+					TLS: &componentApi.DatabaseTLSSpec{
+						SSLMode:               componentApi.DatabaseSSLModeVerifyFull,
+						CABundleConfigMapName: "team-a-db-ca",
+					},
+				},
+				Istio: &componentApi.ModelRegistryIstioSpec{
+					AuthProvider: "odh-auth-provider",
+					Gateway: &componentApi.ModelRegistryGatewaySpec{
+						Domain:         "apps.example.com",
+						CredentialName: "team-a-cert",
+					},
+				},
+			},
+			componentApi.ModelRegistryInstanceSpec{
+				Name:      "team-b",
+				Namespace: "team-b",
+			},
+		)
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: mr, Conditions: conditions.NewManager(mr, ReadyConditionType)}
+
+		g.Expect(reconcileRegistries(ctx, rr)).Should(Succeed())
+
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0] | .kind == "%s" and .metadata.namespace == "team-a"`, gvk.ModelRegistryInstance.Kind),
+			jq.Match(`.[0].spec.postgres | .host == "postgres.team-a.svc" and .port == 5432 and .username == "team-a"`),
+			jq.Match(`.[0].spec.postgres | .passwordSecret.name == "team-a-db" and .passwordSecret.key == "password"`),
+			jq.Match(`.[0].spec.postgres | .sslMode == "verify-full" and .sslRootCertificateConfigMap.name == "team-a-db-ca"`),
+			jq.Match(`.[0].spec.istio | .authProvider == "odh-auth-provider" and .gateway.domain == "apps.example.com"`),
+			jq.Match(`.[0].spec.istio.gateway.rest.tls.credentialName == "team-a-cert"`),
+		))
+
+		g.Expect(mr.Status.Registries).Should(ConsistOf(
+			And(
+				HaveField("Name", "shared"),
+				HaveField("Namespace", registriesNamespace),
+				HaveField("Ready", metav1.ConditionFalse),
+				HaveField("Message", ContainSubstring("mr-db-credentials")),
+			),
+			And(
+				HaveField("Name", "team-a"),
+				HaveField("Ready", metav1.ConditionUnknown),
+				HaveField("Message", status.RegistryPendingMessage),
+			),
+			And(
+				HaveField("Name", "team-b"),
+				HaveField("Ready", metav1.ConditionFalse),
+				HaveField("Message", ContainSubstring("team-b")),
+			),
+		))
+	})
+
+	t.Run("uses a bundled database when none is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newRegistriesTestClient(t)
+
+		mr := newModelRegistry(componentApi.ModelRegistryInstanceSpec{Name: "default"})
+		mr.Spec.Database = nil
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: mr, Conditions: conditions.NewManager(mr, ReadyConditionType)}
+
+		g.Expect(reconcileRegistries(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0].metadata.namespace == "%s"`, registriesNamespace),
+			jq.Match(`.[0].spec.postgres.generateDeployment == true`),
+			jq.Match(`.[0].spec | has("istio") | not`),
+		))
+	})
+
+	t.Run("renders the registries without Istio when the feature is disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli := newRegistriesTestClient(t, &dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv2.DSCInitializationSpec{
+				FeatureGates: map[string]bool{featuregates.ModelRegistryIstio.Name: false},
+			},
+		})
+
+		mr := newModelRegistry(componentApi.ModelRegistryInstanceSpec{
+			Name: "default",
+			Istio: &componentApi.ModelRegistryIstioSpec{
+				AuthProvider: "odh-auth-provider",
+			},
+		})
+		mr.Spec.Database = nil
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: mr, Conditions: conditions.NewManager(mr, ReadyConditionType)}
+
+		g.Expect(reconcileRegistries(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0].spec | has("istio") | not`),
+		))
+		g.Expect(mr.Spec.Registries[0].Istio).ShouldNot(BeNil())
+		g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, featuregates.ModelRegistryIstio.ConditionType()),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, featuregates.ModelRegistryIstio.ConditionType(), status.FeatureGateDisabledReason),
+		)))
+	})
+}
+
+func TestUpdateStatusRegistries(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	const registriesNamespace = "odh-model-registries"
+
+	available := resources.GvkToUnstructured(gvk.ModelRegistryInstance)
+	available.SetName("available")
+	available.SetNamespace(registriesNamespace)
+	g.Expect(unstructured.SetNestedSlice(available.Object, []any{
+		map[string]any{"type": "Available", "status": "True", "message": "Deployment is available"},
+	}, "status", "conditions")).Should(Succeed())
+
+	cli := newRegistriesTestClient(t, available)
+
+	mr := &componentApi.ModelRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.ModelRegistryInstanceName},
+		Spec: componentApi.ModelRegistrySpec{
+			ModelRegistryCommonSpec: componentApi.ModelRegistryCommonSpec{
+				RegistriesNamespace: registriesNamespace,
+			},
+		},
+		Status: componentApi.ModelRegistryStatus{
+			ModelRegistryCommonStatus: componentApi.ModelRegistryCommonStatus{
+				Registries: []componentApi.ModelRegistryInstanceStatus{
+					{Name: "available", Namespace: registriesNamespace, Ready: metav1.ConditionUnknown, Message: status.RegistryPendingMessage},
+					{Name: "pending", Namespace: registriesNamespace, Ready: metav1.ConditionUnknown, Message: status.RegistryPendingMessage},
+				},
+			},
+		},
+	}
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client:     cli,
+		Instance:   mr,
+		Conditions: conditions.NewManager(mr, ReadyConditionType),
+	}
+
+	g.Expect(updateStatus(ctx, rr)).Should(Succeed())
+	g.Expect(mr.Status.Registries).Should(ConsistOf(
+		And(HaveField("Name", "available"), HaveField("Ready", metav1.ConditionTrue)),
+		And(HaveField("Name", "pending"), HaveField("Ready", metav1.ConditionUnknown)),
+	))
+	g.Expect(mr).Should(WithTransform(resources.ToUnstructured, And(
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "False"`, status.ConditionRegistriesAvailable),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("%s/pending")`, status.ConditionRegistriesAvailable, registriesNamespace),
+	)))
+}
+
+func newRegistriesTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	s.AddKnownTypeWithName(gvk.ModelRegistryInstance, &unstructured.Unstructured{})
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.ModelRegistryInstance.GroupKind(), gvk.ModelRegistryInstance.Version)
+	if err != nil {
+		t.Fatalf("Failed to get the REST mapping of %s: %v", gvk.ModelRegistryInstance.Kind, err)
+	}
+
+	crd := mocks.NewMockCRD(gvk.ModelRegistryInstance.Group, gvk.ModelRegistryInstance.Version, gvk.ModelRegistryInstance.Kind, ComponentName)
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.ModelRegistryInstance.Version}
+
+	if err := cli.Create(t.Context(), crd); err != nil {
+		t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+	}
+
+	return cli
+}
@@ -1,12 +1,26 @@
 package modelregistry
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"path"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
@@ -21,6 +35,22 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "model-registry-operator"
+
+	// Values of the DEFAULT_SERVICE_ROUTE parameter, setting whether the model registries are
+	// exposed with a Route through their oauth-proxy sidecar.
+	serviceRouteEnabled  = "enabled"
+	serviceRouteDisabled = "disabled"
+
+	// databaseDialTimeout bounds the connectivity check of the external database.
+	databaseDialTimeout = 5 * time.Second
+
+	defaultDatabaseUsernameKey = "username"
+	defaultDatabasePasswordKey = "password"
+	databaseCABundleKey        = "ca.crt"
+
+	// registryAvailableConditionType is the condition reporting the availability of a model
+	// registry on the ModelRegistry resources of the model registry operator.
+	registryAvailableConditionType = "Available"
 )
 
 var (
@@ -40,6 +70,14 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionDatabaseAvailable,
+		status.ConditionRegistriesAvailable,
+		status.ConditionTypeReadinessChecksPassed,
+	}
+
+	databaseDefaultPorts = map[componentApi.DatabaseType]int32{
+		componentApi.DatabaseTypeMySQL:    3306,
+		componentApi.DatabaseTypePostgres: 5432,
 	}
 )
 
@@ -58,3 +96,248 @@ func extraManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 		SourcePath: path.Join(sourcePath, "extras"),
 	}
 }
+
+// defaultServiceRoute returns whether the model registries are exposed with a Route, which is
+// the case on OpenShift when the service mesh is Removed or the route ingress type is selected in
+// the DSCInitialization.
+func defaultServiceRoute(ctx context.Context, cli client.Client) (string, error) {
+	removed, err := gateway.IsServiceMeshRemoved(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if removed && cluster.IsOpenShift() {
+		return serviceRouteEnabled, nil
+	}
+
+	ingress, err := cluster.IngressType(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if ingress == infrav1.IngressTypeRoute {
+		return serviceRouteEnabled, nil
+	}
+
+	return serviceRouteDisabled, nil
+}
+
+// defaultGateway returns the namespace/name of the Gateway the model registries attach their
+// HTTPRoutes to when the gatewayapi ingress type is selected, or an empty string otherwise.
+func defaultGateway(ctx context.Context, cli client.Client) (string, error) {
+	ingress, err := cluster.IngressType(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	if ingress != infrav1.IngressTypeGatewayAPI {
+		return "", nil
+	}
+
+	return gateway.GatewayNamespace + "/" + gateway.DefaultGatewayName, nil
+}
+
+// databaseCredentialKeys returns the keys of the credentials Secret holding the database
+// user name and password.
+func databaseCredentialKeys(db *componentApi.ModelRegistryDatabaseSpec) (string, string) {
+	usernameKey := db.CredentialsSecretRef.UsernameKey
+	if usernameKey == "" {
+		usernameKey = defaultDatabaseUsernameKey
+	}
+
+	passwordKey := db.CredentialsSecretRef.PasswordKey
+	if passwordKey == "" {
+		passwordKey = defaultDatabasePasswordKey
+	}
+
+	return usernameKey, passwordKey
+}
+
+// databasePort returns the port of the external database, using the default port of the
+// database type when none is set.
+func databasePort(db *componentApi.ModelRegistryDatabaseSpec) int32 {
+	if db.Port != 0 {
+		return db.Port
+	}
+
+	return databaseDefaultPorts[db.Type]
+}
+
+// databaseAddress returns the host:port address of the external database.
+func databaseAddress(db *componentApi.ModelRegistryDatabaseSpec) string {
+	return net.JoinHostPort(db.Host, strconv.Itoa(int(databasePort(db))))
+}
+
+// databaseParams returns the params.env entries configuring the external database of the
+// model registries. All the entries are emptied when no external database is set, so the
+// model registries fall back to the bundled instance.
+func databaseParams(db *componentApi.ModelRegistryDatabaseSpec) map[string]string {
+	params := map[string]string{
+		"DATABASE_TYPE":         "",
+		"DATABASE_HOST":         "",
+		"DATABASE_PORT":         "",
+		"DATABASE_NAME":         "",
+		"DATABASE_SECRET_NAME":  "",
+		"DATABASE_USERNAME_KEY": "",
+		"DATABASE_PASSWORD_KEY": "",
+		"DATABASE_SSL_MODE":     "",
+		"DATABASE_CA_CONFIGMAP": "",
+	}
+
+	if db == nil {
+		return params
+	}
+
+	usernameKey, passwordKey := databaseCredentialKeys(db)
+
+	params["DATABASE_TYPE"] = string(db.Type)
+	params["DATABASE_HOST"] = db.Host
+	params["DATABASE_PORT"] = strconv.Itoa(int(databasePort(db)))
+	params["DATABASE_NAME"] = db.DatabaseName
+	params["DATABASE_SECRET_NAME"] = db.CredentialsSecretRef.Name
+	params["DATABASE_USERNAME_KEY"] = usernameKey
+	params["DATABASE_PASSWORD_KEY"] = passwordKey
+
+	if db.TLS != nil {
+		params["DATABASE_SSL_MODE"] = string(db.TLS.SSLMode)
+		params["DATABASE_CA_CONFIGMAP"] = db.TLS.CABundleConfigMapName
+	}
+
+	return params
+}
+
+// dialDatabase checks that a TCP connection can be established with the external database.
+func dialDatabase(ctx context.Context, address string) error {
+	d := net.Dialer{Timeout: databaseDialTimeout}
+
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// registryDatabase returns the database of a model registry instance, falling back to the
+// component database.
+func registryDatabase(mr *componentApi.ModelRegistry, r componentApi.ModelRegistryInstanceSpec) *componentApi.ModelRegistryDatabaseSpec {
+	if r.Database != nil {
+		return r.Database
+	}
+
+	return mr.Spec.Database
+}
+
+// modelRegistryFor returns the ModelRegistry resource reconciled by the model registry operator
+// for a model registry instance. The registry uses a bundled PostgreSQL deployment when no
+// database is set, and the given user name to connect to the external database otherwise.
+func modelRegistryFor(
+	r componentApi.ModelRegistryInstanceSpec,
+	namespace string,
+	db *componentApi.ModelRegistryDatabaseSpec,
+	username string,
+	gatewayDomain string,
+) (*unstructured.Unstructured, error) {
+	spec := map[string]any{
+		"grpc": map[string]any{},
+		"rest": map[string]any{},
+	}
+
+	if db == nil {
+		spec[string(componentApi.DatabaseTypePostgres)] = map[string]any{
+			"generateDeployment": true,
+		}
+	} else {
+		_, passwordKey := databaseCredentialKeys(db)
+
+		database := map[string]any{
+			"host":     db.Host,
+			"port":     int64(databasePort(db)),
+			"database": db.DatabaseName,
+			"username": username,
+			"passwordSecret": map[string]any{
+				"name": db.CredentialsSecretRef.Name,
+				"key":  passwordKey,
+			},
+		}
+
+		if db.TLS != nil {
+			if db.Type == componentApi.DatabaseTypePostgres && db.TLS.SSLMode != "" {
+				database["sslMode"] = string(db.TLS.SSLMode)
+			}
+			if db.TLS.CABundleConfigMapName != "" {
+				database["sslRootCertificateConfigMap"] = map[string]any{
+					"name": db.TLS.CABundleConfigMapName,
+					"key":  databaseCABundleKey,
+				}
+			}
+		}
+
+		spec[string(db.Type)] = database
+	}
+
+	if r.Istio != nil {
+		istio := map[string]any{}
+
+		if r.Istio.AuthProvider != "" {
+			istio["authProvider"] = r.Istio.AuthProvider
+		}
+
+		if gw := r.Istio.Gateway; gw != nil {
+			domain := gw.Domain
+			if domain == "" {
+				domain = gatewayDomain
+			}
+
+			gateway := map[string]any{
+				"domain": domain,
+			}
+
+			if gw.CredentialName != "" {
+				gateway["rest"] = map[string]any{
+					"tls": map[string]any{
+						"mode":           "SIMPLE",
+						"credentialName": gw.CredentialName,
+					},
+				}
+			}
+
+			istio["gateway"] = gateway
+		}
+
+		spec["istio"] = istio
+	}
+
+	u := resources.GvkToUnstructured(gvk.ModelRegistryInstance)
+	u.SetName(r.Name)
+	u.SetNamespace(namespace)
+
+	if err := unstructured.SetNestedMap(u.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set the spec of model registry %s: %w", r.Name, err)
+	}
+
+	return u, nil
+}
+
+// registryAvailability returns the status and message of the Available condition reported by
+// the model registry operator on a ModelRegistry resource.
+func registryAvailability(u *unstructured.Unstructured) (metav1.ConditionStatus, string) {
+	conditions, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return metav1.ConditionUnknown, err.Error()
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != registryAvailableConditionType {
+			continue
+		}
+
+		s, _ := condition["status"].(string)
+		msg, _ := condition["message"].(string)
+
+		return metav1.ConditionStatus(s), msg
+	}
+
+	return metav1.ConditionUnknown, status.RegistryPendingMessage
+}
@@ -20,16 +20,21 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	rr.Manifests = append(rr.Manifests, manifestPath())
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return err
 	}
@@ -46,3 +51,50 @@ func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 
 	return nil
 }
+
+// reconcileClusterDefaults publishes the defaults of the RayClusters in a ConfigMap, enforces them
+// with a ValidatingAdmissionPolicy taking the ConfigMap as parameters, and creates the ResourceQuota
+// of the quota template in the data science projects. Without defaults, they are pruned by the gc
+// action, but the ResourceQuotas already created.
+func reconcileClusterDefaults(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	ray, ok := rr.Instance.(*componentApi.Ray)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Ray)", rr.Instance)
+	}
+
+	defaults := ray.Spec.ClusterDefaults
+	if defaults == nil {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	err = rr.AddResources(
+		clusterDefaultsConfigMap(appNamespace, defaults),
+		clusterSecurityPolicy(),
+		clusterSecurityPolicyBinding(appNamespace),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add RayCluster security policy: %w", err)
+	}
+
+	if len(defaults.ProjectQuota) == 0 {
+		return nil
+	}
+
+	projects := corev1.NamespaceList{}
+	if err := rr.Client.List(ctx, &projects, client.MatchingLabels{labels.DataScienceProject: labels.True}); err != nil {
+		return fmt.Errorf("failed to list data science projects: %w", err)
+	}
+
+	for i := range projects.Items {
+		if err := rr.AddResources(projectQuota(projects.Items[i].Name, defaults.ProjectQuota)); err != nil {
+			return fmt.Errorf("failed to add ResourceQuota of project %s: %w", projects.Items[i].Name, err)
+		}
+	}
+
+	return nil
+}
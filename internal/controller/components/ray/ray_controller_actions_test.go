@@ -0,0 +1,125 @@
+//nolint:testpackage
+package ray
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestReconcileClusterDefaults(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+	}
+	project := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "project",
+			Labels: map[string]string{labels.DataScienceProject: labels.True},
+		},
+	}
+	other := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+	}
+
+	newRequest := func(t *testing.T, defaults *componentApi.RayClusterDefaultsSpec) *types.ReconciliationRequest {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(dsci.DeepCopy(), project.DeepCopy(), other.DeepCopy()))
+		if err != nil {
+			t.Fatalf("Failed to create fake client: %v", err)
+		}
+
+		return &types.ReconciliationRequest{
+			Client: cli,
+			Instance: &componentApi.Ray{
+				ObjectMeta: metav1.ObjectMeta{Name: componentApi.RayInstanceName},
+				Spec: componentApi.RaySpec{
+					RayCommonSpec: componentApi.RayCommonSpec{ClusterDefaults: defaults},
+				},
+			},
+		}
+	}
+
+	t.Run("does nothing without defaults", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, nil)
+
+		g.Expect(reconcileClusterDefaults(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(BeEmpty())
+	})
+
+	t.Run("adds the security policy of the defaults", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, &componentApi.RayClusterDefaultsSpec{
+			PodSecurity: componentApi.RayPodSecurityRestricted,
+			EnableTLS:   true,
+		})
+
+		g.Expect(reconcileClusterDefaults(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(3))
+
+		g.Expect(rr.Resources[0].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.ConfigMap.Kind),
+			jq.Match(`.metadata.name == "%s"`, ClusterDefaultsConfigMapName),
+			jq.Match(`.metadata.namespace == "%s"`, appNamespace),
+			jq.Match(`.data.podSecurity == "Restricted"`),
+			jq.Match(`.data.enableTLS == "true"`),
+		))
+		g.Expect(rr.Resources[1].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.ValidatingAdmissionPolicy.Kind),
+			jq.Match(`.metadata.name == "%s"`, ClusterSecurityPolicyName),
+			jq.Match(`.spec.paramKind.kind == "%s"`, gvk.ConfigMap.Kind),
+			jq.Match(`.spec.matchConstraints.resourceRules[0].apiGroups == ["%s"]`, gvk.RayClusterV1.Group),
+			jq.Match(`.spec.validations | length == %d`, len(clusterSecurityValidations)),
+		))
+		g.Expect(rr.Resources[2].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.ValidatingAdmissionPolicyBinding.Kind),
+			jq.Match(`.spec.policyName == "%s"`, ClusterSecurityPolicyName),
+			jq.Match(`.spec.paramRef.name == "%s"`, ClusterDefaultsConfigMapName),
+			jq.Match(`.spec.paramRef.namespace == "%s"`, appNamespace),
+			jq.Match(`.spec.validationActions == ["Deny"]`),
+		))
+	})
+
+	t.Run("adds the quota template to the data science projects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, &componentApi.RayClusterDefaultsSpec{
+			ProjectQuota: corev1.ResourceList{
+				corev1.ResourceLimitsCPU:    resource.MustParse("16"),
+				corev1.ResourceLimitsMemory: resource.MustParse("64Gi"),
+			},
+		})
+
+		g.Expect(reconcileClusterDefaults(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(4))
+		g.Expect(rr.Resources[3].Object).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.ResourceQuota.Kind),
+			jq.Match(`.metadata.name == "%s"`, ProjectQuotaName),
+			jq.Match(`.metadata.namespace == "%s"`, project.Name),
+			jq.Match(`.metadata.annotations."%s" == "false"`, annotations.ManagedByODHOperator),
+			jq.Match(`.spec.hard."limits.cpu" == "16"`),
+			jq.Match(`.spec.hard."limits.memory" == "64Gi"`),
+		))
+	})
+}
@@ -1,10 +1,19 @@
 package ray
 
 import (
+	"strconv"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 const (
@@ -16,6 +25,17 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "ray"
+
+	// ClusterDefaultsConfigMapName is the ConfigMap of the applications namespace publishing the
+	// defaults of the RayClusters, the parameters of the security policy.
+	ClusterDefaultsConfigMapName = "ray-cluster-defaults"
+	// ClusterSecurityPolicyName is the ValidatingAdmissionPolicy enforcing the defaults of the RayClusters.
+	ClusterSecurityPolicyName = "ray-cluster-security"
+	// ProjectQuotaName is the ResourceQuota created in the data science projects from the quota template.
+	ProjectQuotaName = "ray-default-quota"
+
+	podSecurityKey = "podSecurity"
+	enableTLSKey   = "enableTLS"
 )
 
 var (
@@ -25,6 +45,7 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -35,3 +56,135 @@ func manifestPath() types.ManifestInfo {
 		SourcePath: "openshift",
 	}
 }
+
+// clusterSecurityValidations are the validations of the RayClusters against the defaults published
+// in the ConfigMap, the parameters of the policy.
+var clusterSecurityValidations = []admissionregistrationv1.Validation{
+	{
+		Expression: `!(params.data.podSecurity in ['Baseline', 'Restricted']) || variables.podSpecs.all(p, p.containers.all(c,
+			!has(c.securityContext) || !has(c.securityContext.privileged) || !c.securityContext.privileged))`,
+		Message: "The containers of the RayClusters can not be privileged",
+	},
+	{
+		Expression: `params.data.podSecurity != 'Restricted' || variables.podSpecs.all(p, p.containers.all(c,
+			(has(c.securityContext) && has(c.securityContext.runAsNonRoot) ? c.securityContext.runAsNonRoot :
+				has(p.securityContext) && has(p.securityContext.runAsNonRoot) && p.securityContext.runAsNonRoot) &&
+			has(c.securityContext) && has(c.securityContext.allowPrivilegeEscalation) && !c.securityContext.allowPrivilegeEscalation &&
+			has(c.securityContext.capabilities) && has(c.securityContext.capabilities.drop) && 'ALL' in c.securityContext.capabilities.drop))`,
+		Message: "The containers of the RayClusters must run as non root, disallow privilege escalation and drop all the capabilities",
+	},
+	{
+		Expression: `params.data.enableTLS != 'true' || variables.podSpecs.all(p, p.containers.all(c,
+			has(c.env) && c.env.exists(e, e.name == 'RAY_USE_TLS' && has(e.value) && e.value == '1')))`,
+		Message: "The containers of the RayClusters must enable TLS with the RAY_USE_TLS environment variable",
+	},
+}
+
+// clusterDefaultsConfigMap returns the ConfigMap publishing the defaults of the RayClusters.
+func clusterDefaultsConfigMap(namespace string, defaults *componentApi.RayClusterDefaultsSpec) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.ConfigMap.GroupVersion().String(),
+			Kind:       gvk.ConfigMap.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterDefaultsConfigMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			podSecurityKey: string(defaults.PodSecurity),
+			enableTLSKey:   strconv.FormatBool(defaults.EnableTLS),
+		},
+	}
+}
+
+// clusterSecurityPolicy returns the ValidatingAdmissionPolicy validating the RayClusters against
+// the defaults published in the ConfigMap.
+func clusterSecurityPolicy() *admissionregistrationv1.ValidatingAdmissionPolicy {
+	return &admissionregistrationv1.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.ValidatingAdmissionPolicy.GroupVersion().String(),
+			Kind:       gvk.ValidatingAdmissionPolicy.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ClusterSecurityPolicyName,
+		},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+			ParamKind: &admissionregistrationv1.ParamKind{
+				APIVersion: gvk.ConfigMap.GroupVersion().String(),
+				Kind:       gvk.ConfigMap.Kind,
+			},
+			MatchConstraints: &admissionregistrationv1.MatchResources{
+				ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{{
+					RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+							admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{gvk.RayClusterV1.Group},
+							APIVersions: []string{gvk.RayClusterV1.Version},
+							Resources:   []string{"rayclusters"},
+						},
+					},
+				}},
+			},
+			// the RayClusters already created are not denied the updates of their metadata or status
+			MatchConditions: []admissionregistrationv1.MatchCondition{{
+				Name:       "spec-changed",
+				Expression: `request.operation == 'CREATE' || object.spec != oldObject.spec`,
+			}},
+			Variables: []admissionregistrationv1.Variable{{
+				Name: "podSpecs",
+				Expression: `[object.spec.headGroupSpec.template.spec] +
+					(has(object.spec.workerGroupSpecs) ? object.spec.workerGroupSpecs.map(w, w.template.spec) : [])`,
+			}},
+			Validations: clusterSecurityValidations,
+		},
+	}
+}
+
+// clusterSecurityPolicyBinding returns the binding of the security policy to the ConfigMap
+// publishing the defaults of the RayClusters.
+func clusterSecurityPolicyBinding(namespace string) *admissionregistrationv1.ValidatingAdmissionPolicyBinding {
+	return &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.ValidatingAdmissionPolicyBinding.GroupVersion().String(),
+			Kind:       gvk.ValidatingAdmissionPolicyBinding.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ClusterSecurityPolicyName,
+		},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName: ClusterSecurityPolicyName,
+			ParamRef: &admissionregistrationv1.ParamRef{
+				Name:                    ClusterDefaultsConfigMapName,
+				Namespace:               namespace,
+				ParameterNotFoundAction: ptr.To(admissionregistrationv1.AllowAction),
+			},
+			ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+		},
+	}
+}
+
+// projectQuota returns the ResourceQuota of the quota template of a data science project, created
+// once and then left to the administrator of the project.
+func projectQuota(namespace string, hard corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.ResourceQuota.GroupVersion().String(),
+			Kind:       gvk.ResourceQuota.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ProjectQuotaName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				annotations.ManagedByODHOperator: "false",
+			},
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: hard.DeepCopy(),
+		},
+	}
+}
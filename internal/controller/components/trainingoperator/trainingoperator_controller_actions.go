@@ -18,11 +18,29 @@ package trainingoperator
 
 import (
 	"context"
+	"fmt"
 
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	rr.Manifests = append(rr.Manifests, manifestPath())
 	return nil
 }
+
+// configureJobDefaults renders the defaults of the training jobs in the params of the training
+// operator configuration.
+func configureJobDefaults(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	to, ok := rr.Instance.(*componentApi.TrainingOperator)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.TrainingOperator)", rr.Instance)
+	}
+
+	if err := odhdeploy.ApplyParams(manifestPath().String(), "params.env", nil, jobDefaultsParams(to.Spec.JobDefaults)); err != nil {
+		return fmt.Errorf("failed to update params on path %s: %w", manifestPath(), err)
+	}
+
+	return nil
+}
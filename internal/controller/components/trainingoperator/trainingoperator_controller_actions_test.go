@@ -0,0 +1,98 @@
+//nolint:testpackage
+package trainingoperator
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConfigureJobDefaults(t *testing.T) {
+	ctx := t.Context()
+
+	oldDeployPath := odhdeploy.DefaultManifestPath
+	defer func() {
+		odhdeploy.DefaultManifestPath = oldDeployPath
+	}()
+
+	tests := []struct {
+		name     string
+		defaults *componentApi.TrainingJobDefaultsSpec
+		expected []string
+	}{
+		{
+			name:     "renders the defaults of the training operator without job defaults",
+			defaults: nil,
+			expected: []string{
+				"GANG_SCHEDULER=none",
+				"JOB_DEFAULT_BACKOFF_LIMIT=\n",
+				"JOB_DEFAULT_PRIORITY_CLASS=\n",
+			},
+		},
+		{
+			name: "renders the job defaults",
+			defaults: &componentApi.TrainingJobDefaultsSpec{
+				GangScheduler:     componentApi.GangSchedulerVolcano,
+				BackoffLimit:      ptr.To[int32](3),
+				PriorityClassName: "training-low",
+			},
+			expected: []string{
+				"GANG_SCHEDULER=volcano",
+				"JOB_DEFAULT_BACKOFF_LIMIT=3",
+				"JOB_DEFAULT_PRIORITY_CLASS=training-low",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			odhdeploy.DefaultManifestPath = t.TempDir()
+
+			g.Expect(os.MkdirAll(manifestPath().String(), 0o755)).Should(Succeed())
+
+			paramsPath := path.Join(manifestPath().String(), "params.env")
+			g.Expect(os.WriteFile(paramsPath, []byte("GANG_SCHEDULER=none\nJOB_DEFAULT_BACKOFF_LIMIT=\nJOB_DEFAULT_PRIORITY_CLASS=\n"), 0o600)).Should(Succeed())
+
+			rr := types.ReconciliationRequest{
+				Instance: &componentApi.TrainingOperator{
+					ObjectMeta: metav1.ObjectMeta{Name: componentApi.TrainingOperatorInstanceName},
+					Spec: componentApi.TrainingOperatorSpec{
+						TrainingOperatorCommonSpec: componentApi.TrainingOperatorCommonSpec{JobDefaults: tt.defaults},
+					},
+				},
+			}
+
+			g.Expect(configureJobDefaults(ctx, &rr)).Should(Succeed())
+
+			content, err := os.ReadFile(paramsPath)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			for _, e := range tt.expected {
+				g.Expect(string(content)).Should(ContainSubstring(e))
+			}
+		})
+	}
+}
+
+func TestConfigureJobDefaultsWrongInstance(t *testing.T) {
+	g := NewWithT(t)
+
+	rr := types.ReconciliationRequest{
+		Instance: &componentApi.Dashboard{},
+	}
+
+	err := configureJobDefaults(t.Context(), &rr)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("is not a componentApi.TrainingOperator"))
+}
@@ -1,6 +1,9 @@
 package trainingoperator
 
 import (
+	"strconv"
+	"strings"
+
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
@@ -16,6 +19,11 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "trainingoperator"
+
+	// params of the training operator configuration rendering the defaults of the training jobs.
+	gangSchedulerParamsKey     = "GANG_SCHEDULER"
+	backoffLimitParamsKey      = "JOB_DEFAULT_BACKOFF_LIMIT"
+	priorityClassNameParamsKey = "JOB_DEFAULT_PRIORITY_CLASS"
 )
 
 var (
@@ -25,6 +33,7 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -35,3 +44,28 @@ func manifestPath() types.ManifestInfo {
 		SourcePath: "rhoai",
 	}
 }
+
+// jobDefaultsParams returns the params rendering the defaults of the training jobs, empty for
+// the defaults not set so that the ones of the training operator apply.
+func jobDefaultsParams(defaults *componentApi.TrainingJobDefaultsSpec) map[string]string {
+	params := map[string]string{
+		gangSchedulerParamsKey:     strings.ToLower(string(componentApi.GangSchedulerNone)),
+		backoffLimitParamsKey:      "",
+		priorityClassNameParamsKey: "",
+	}
+
+	if defaults == nil {
+		return params
+	}
+
+	if defaults.GangScheduler != "" {
+		params[gangSchedulerParamsKey] = strings.ToLower(string(defaults.GangScheduler))
+	}
+	if defaults.BackoffLimit != nil {
+		params[backoffLimitParamsKey] = strconv.FormatInt(int64(*defaults.BackoffLimit), 10)
+	}
+
+	params[priorityClassNameParamsKey] = defaults.PriorityClassName
+
+	return params
+}
@@ -44,6 +44,14 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 
 	// Copy eval section exactly as it exists in the DSC
 	spec.Eval = dsc.Spec.Components.TrustyAI.Eval
+	spec.Resources = dsc.Spec.Components.TrustyAI.Resources
+	spec.Scheduling = dsc.Spec.Components.TrustyAI.Scheduling
+	spec.UpgradeStrategy = dsc.Spec.Components.TrustyAI.UpgradeStrategy
+	spec.DriftPolicy = dsc.Spec.Components.TrustyAI.DriftPolicy
+	spec.AdoptionPolicy = dsc.Spec.Components.TrustyAI.AdoptionPolicy
+	spec.ReadinessChecks = dsc.Spec.Components.TrustyAI.ReadinessChecks
+	spec.HighAvailability = dsc.Spec.Components.TrustyAI.HighAvailability
+	spec.DevFlags = dsc.Spec.Components.TrustyAI.DevFlags
 
 	// Ensure defaults are applied when strings are empty
 	if spec.Eval.LMEval.PermitCodeExecution == "" {
@@ -59,7 +67,7 @@ func (s *componentHandler) NewCRObject(dsc *dscv2.DataScienceCluster) common.Pla
 			APIVersion: componentApi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: componentApi.TrustyAIInstanceName,
+			Name: components.InstanceName(dsc, componentApi.TrustyAIInstanceName),
 			Annotations: map[string]string{
 				annotations.ManagementStateAnnotation: string(dsc.Spec.Components.TrustyAI.ManagementState),
 			},
@@ -87,18 +95,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv2.DataScienceCluster) bool {
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
+	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return cs, errors.New("failed to convert to DataScienceCluster")
+	}
+
 	c := componentApi.TrustyAI{}
-	c.Name = componentApi.TrustyAIInstanceName
+	c.Name = components.InstanceName(dsc, componentApi.TrustyAIInstanceName)
 
 	if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(&c), &c); err != nil && !k8serr.IsNotFound(err) {
 		return cs, nil
 	}
 
-	dsc, ok := rr.Instance.(*dscv2.DataScienceCluster)
-	if !ok {
-		return cs, errors.New("failed to convert to DataScienceCluster")
-	}
-
 	ms := components.NormalizeManagementState(dsc.Spec.Components.TrustyAI.ManagementState)
 
 	dsc.Status.Components.TrustyAI.ManagementState = ms
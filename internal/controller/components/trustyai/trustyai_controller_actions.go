@@ -19,6 +19,7 @@ package trustyai
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -56,8 +57,8 @@ func createConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRequest) er
 		return fmt.Errorf("resource instance %v is not a componentApi.TrustyAI)", rr.Instance)
 	}
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return err
 	}
@@ -83,5 +84,7 @@ func createConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRequest) er
 	configMap.Data["eval.lmeval.permitCodeExecution"] = strconv.FormatBool(permitCodeExecution)
 	configMap.Data["eval.lmeval.permitOnline"] = strconv.FormatBool(permitOnline)
 
+	maps.Copy(configMap.Data, storageConfig(trustyai.Spec.Storage))
+
 	return rr.AddResources(configMap)
 }
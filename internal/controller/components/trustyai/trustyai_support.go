@@ -1,6 +1,8 @@
 package trustyai
 
 import (
+	"strconv"
+
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -18,6 +20,9 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "trustyai"
+
+	// defaultStorageSize is the size of the volume of each TrustyAI service with the PVC format.
+	defaultStorageSize = "1Gi"
 )
 
 var (
@@ -41,6 +46,7 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -51,3 +57,42 @@ func manifestsPath(p common.Platform) types.ManifestInfo {
 		SourcePath: overlaysSourcePaths[p],
 	}
 }
+
+// storageConfig returns the entries of the TrustyAI configuration setting the storage and
+// retention policy of the TrustyAI services, none when no storage is set so that the defaults
+// of the TrustyAI service operator apply.
+func storageConfig(storage *componentApi.TrustyAIStorageSpec) map[string]string {
+	config := map[string]string{}
+
+	if storage == nil {
+		return config
+	}
+
+	format := storage.Format
+	if format == "" {
+		format = componentApi.TrustyAIStorageFormatPVC
+	}
+
+	config["storage.format"] = string(format)
+
+	switch format {
+	case componentApi.TrustyAIStorageFormatDatabase:
+		config["storage.databaseConfigurations"] = storage.DatabaseConfigurations
+	default:
+		config["storage.size"] = defaultStorageSize
+		if !storage.Size.IsZero() {
+			config["storage.size"] = storage.Size.String()
+		}
+	}
+
+	if r := storage.Retention; r != nil {
+		if r.MaxAgeDays > 0 {
+			config["storage.retention.maxAgeDays"] = strconv.Itoa(int(r.MaxAgeDays))
+		}
+		if r.MaxInferences > 0 {
+			config["storage.retention.maxInferences"] = strconv.FormatInt(r.MaxInferences, 10)
+		}
+	}
+
+	return config
+}
@@ -7,6 +7,7 @@ import (
 
 	gt "github.com/onsi/gomega/types"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -178,6 +179,74 @@ func TestCreateConfigMap(t *testing.T) {
 		g.Expect(found).Should(BeTrue())
 		g.Expect(data["eval.lmeval.permitCodeExecution"]).Should(Equal("true"))
 		g.Expect(data["eval.lmeval.permitOnline"]).Should(Equal("false"))
+		g.Expect(data).ShouldNot(HaveKey("storage.format"))
+	})
+
+	t.Run("should render the storage and retention policy", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		trustyai := createTrustyAICR(true)
+		trustyai.Spec.Storage = &componentApi.TrustyAIStorageSpec{
+			Format: componentApi.TrustyAIStorageFormatPVC,
+			Size:   resource.MustParse("5Gi"),
+			Retention: &componentApi.TrustyAIRetentionSpec{
+				MaxAgeDays:    30,
+				MaxInferences: 100000,
+			},
+		}
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(createDSCI("test-namespace")))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   cli,
+			Instance: trustyai,
+		}
+
+		g.Expect(createConfigMap(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(1))
+
+		data, found, err := unstructured.NestedStringMap(rr.Resources[0].Object, "data")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(found).Should(BeTrue())
+		g.Expect(data).Should(And(
+			HaveKeyWithValue("storage.format", "PVC"),
+			HaveKeyWithValue("storage.size", "5Gi"),
+			HaveKeyWithValue("storage.retention.maxAgeDays", "30"),
+			HaveKeyWithValue("storage.retention.maxInferences", "100000"),
+			Not(HaveKey("storage.databaseConfigurations")),
+		))
+	})
+
+	t.Run("should render the database storage", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+
+		trustyai := createTrustyAICR(true)
+		trustyai.Spec.Storage = &componentApi.TrustyAIStorageSpec{
+			Format:                 componentApi.TrustyAIStorageFormatDatabase,
+			DatabaseConfigurations: "db-credentials",
+		}
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(createDSCI("test-namespace")))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   cli,
+			Instance: trustyai,
+		}
+
+		g.Expect(createConfigMap(ctx, rr)).Should(Succeed())
+
+		data, _, err := unstructured.NestedStringMap(rr.Resources[0].Object, "data")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(data).Should(And(
+			HaveKeyWithValue("storage.format", "Database"),
+			HaveKeyWithValue("storage.databaseConfigurations", "db-credentials"),
+			Not(HaveKey("storage.size")),
+			Not(HaveKey("storage.retention.maxAgeDays")),
+		))
 	})
 }
 
@@ -2,14 +2,19 @@ package workbenches
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -51,6 +56,128 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
+// customizeNotebookImages applies the notebook images configuration to the rendered notebook
+// image ImageStreams: removed images are dropped, so that they are pruned from the cluster,
+// pinned images only keep the selected tag, and the custom images are added.
+func customizeNotebookImages(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	workbench, ok := rr.Instance.(*componentApi.Workbenches)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Workbenches", rr.Instance)
+	}
+
+	ni := workbench.Spec.NotebookImages
+	if ni == nil {
+		return nil
+	}
+
+	overrides := make(map[string]componentApi.NotebookImageOverride, len(ni.Overrides))
+	for _, o := range ni.Overrides {
+		overrides[o.Name] = o
+	}
+
+	rendered := make(map[string]struct{})
+
+	err := rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+		if u.GroupVersionKind() != gvk.ImageStream {
+			return false
+		}
+
+		rendered[u.GetName()] = struct{}{}
+
+		return overrides[u.GetName()].ManagementState == operatorv1.Removed
+	})
+	if err != nil {
+		return err
+	}
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.ImageStream {
+			return false, nil
+		}
+
+		o, ok := overrides[u.GetName()]
+		if !ok || o.Tag == "" {
+			return false, nil
+		}
+
+		return false, pinNotebookImage(u, o.Tag, o.Digest)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(ni.Custom) == 0 {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	for _, ci := range ni.Custom {
+		if _, ok := rendered[ci.Name]; ok {
+			return fmt.Errorf("custom notebook image %s conflicts with an out-of-the-box notebook image", ci.Name)
+		}
+
+		if err := rr.AddResources(customNotebookImage(ci, appNamespace)); err != nil {
+			return fmt.Errorf("failed to add custom notebook image %s: %w", ci.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// configureCulling renders the culler ConfigMap of the kf notebook controller from the culling
+// settings of the component, and annotates the controller Deployment with the hash of the
+// settings so that it is rolled out when they change.
+func configureCulling(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	workbench, ok := rr.Instance.(*componentApi.Workbenches)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Workbenches", rr.Instance)
+	}
+
+	culling := workbench.Spec.Culling
+	if culling == nil {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	cm := cullerConfigMap(culling, appNamespace)
+
+	u, err := resources.ToUnstructured(cm)
+	if err != nil {
+		return err
+	}
+
+	h, err := resources.Hash(u)
+	if err != nil {
+		return err
+	}
+
+	if err := rr.AddResources(cm); err != nil {
+		return fmt.Errorf("failed to add culler ConfigMap: %w", err)
+	}
+
+	return rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Deployment || u.GetName() != kfNotebookControllerDeploymentName {
+			return false, nil
+		}
+
+		err := unstructured.SetNestedField(
+			u.Object,
+			base64.RawURLEncoding.EncodeToString(h),
+			"spec", "template", "metadata", "annotations", cullerConfigHashAnnotation,
+		)
+
+		return true, err
+	})
+}
+
 func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	workbench, ok := rr.Instance.(*componentApi.Workbenches)
 	if !ok {
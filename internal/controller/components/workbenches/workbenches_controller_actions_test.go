@@ -0,0 +1,234 @@
+//nolint:testpackage
+package workbenches
+
+import (
+	"testing"
+	"time"
+
+	imagev1 "github.com/openshift/api/image/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func TestCustomizeNotebookImages(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	newImageStream := func(name string, tags ...string) unstructured.Unstructured {
+		is := &imagev1.ImageStream{
+			TypeMeta:   metav1.TypeMeta{APIVersion: imagev1.GroupVersion.String(), Kind: "ImageStream"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: appNamespace},
+		}
+
+		for _, tag := range tags {
+			is.Spec.Tags = append(is.Spec.Tags, imagev1.TagReference{
+				Name: tag,
+				From: &corev1.ObjectReference{Kind: "DockerImage", Name: "quay.io/opendatahub/" + name + ":" + tag},
+			})
+		}
+
+		u, err := resources.ToUnstructured(is)
+		if err != nil {
+			t.Fatalf("failed to convert ImageStream %s: %v", name, err)
+		}
+
+		return *u
+	}
+
+	newRequest := func(ni *componentApi.NotebookImagesSpec) *odhtypes.ReconciliationRequest {
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+		}))
+		if err != nil {
+			t.Fatalf("failed to create fake client: %v", err)
+		}
+
+		return &odhtypes.ReconciliationRequest{
+			Client: cli,
+			Instance: &componentApi.Workbenches{
+				ObjectMeta: metav1.ObjectMeta{Name: componentApi.WorkbenchesInstanceName},
+				Spec: componentApi.WorkbenchesSpec{
+					WorkbenchesCommonSpec: componentApi.WorkbenchesCommonSpec{NotebookImages: ni},
+				},
+			},
+			Resources: []unstructured.Unstructured{
+				newImageStream("jupyter-minimal", "2025.1", "2025.2"),
+				newImageStream("jupyter-pytorch", "2025.1", "2025.2"),
+				newImageStream("code-server", "2025.2"),
+			},
+		}
+	}
+
+	t.Run("keeps the rendered images when not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(nil)
+
+		g.Expect(customizeNotebookImages(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(3))
+	})
+
+	t.Run("removes, pins and adds notebook images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&componentApi.NotebookImagesSpec{
+			Overrides: []componentApi.NotebookImageOverride{
+				{Name: "jupyter-pytorch", ManagementState: operatorv1.Removed},
+				{Name: "jupyter-minimal", ManagementState: operatorv1.Managed, Tag: "2025.1", Digest: testDigest},
+			},
+			Custom: []componentApi.CustomNotebookImage{{
+				Name:        "team-image",
+				DisplayName: "Team image",
+				Description: "Notebook image of the team",
+				Image:       "quay.io/team/notebook@" + testDigest,
+			}},
+		})
+
+		g.Expect(customizeNotebookImages(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(3),
+			jq.Match(`map(.metadata.name) == ["jupyter-minimal", "code-server", "team-image"]`),
+			jq.Match(`.[0].spec.tags | length == 1`),
+			jq.Match(`.[0].spec.tags[0] | .name == "2025.1" and .from.name == "quay.io/opendatahub/jupyter-minimal@%s"`, testDigest),
+			jq.Match(`.[1].spec.tags | length == 1`),
+			jq.Match(`.[2].metadata | .namespace == "%s" and .labels["%s"] == "true"`, appNamespace, notebookImageLabel),
+			jq.Match(`.[2].metadata.annotations["%s"] == "Team image"`, notebookImageNameAnnotation),
+			jq.Match(`.[2].spec.tags[0] | .name == "%s" and .from.name == "quay.io/team/notebook@%s"`, defaultNotebookImageTag, testDigest),
+		))
+	})
+
+	t.Run("fails on an unknown pinned tag", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&componentApi.NotebookImagesSpec{
+			Overrides: []componentApi.NotebookImageOverride{
+				{Name: "code-server", Tag: "2024.1"},
+			},
+		})
+
+		g.Expect(customizeNotebookImages(ctx, rr)).Should(MatchError(ContainSubstring("tag 2024.1 not found")))
+	})
+
+	t.Run("fails on a custom image conflicting with a rendered one", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&componentApi.NotebookImagesSpec{
+			Custom: []componentApi.CustomNotebookImage{{
+				Name:        "code-server",
+				DisplayName: "Code Server",
+				Image:       "quay.io/team/code-server:latest",
+			}},
+		})
+
+		g.Expect(customizeNotebookImages(ctx, rr)).Should(MatchError(ContainSubstring("conflicts")))
+	})
+}
+
+func TestConfigureCulling(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	newRequest := func(culling *componentApi.NotebookCullingSpec) *odhtypes.ReconciliationRequest {
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace},
+		}))
+		if err != nil {
+			t.Fatalf("failed to create fake client: %v", err)
+		}
+
+		deployment, err := resources.ToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: kfNotebookControllerDeploymentName, Namespace: appNamespace},
+		})
+		if err != nil {
+			t.Fatalf("failed to convert Deployment: %v", err)
+		}
+
+		return &odhtypes.ReconciliationRequest{
+			Client: cli,
+			Instance: &componentApi.Workbenches{
+				ObjectMeta: metav1.ObjectMeta{Name: componentApi.WorkbenchesInstanceName},
+				Spec: componentApi.WorkbenchesSpec{
+					WorkbenchesCommonSpec: componentApi.WorkbenchesCommonSpec{Culling: culling},
+				},
+			},
+			Resources: []unstructured.Unstructured{*deployment},
+		}
+	}
+
+	t.Run("leaves the culler settings to the dashboard when not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(nil)
+
+		g.Expect(configureCulling(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			jq.Match(`.[0].spec.template.metadata.annotations // {} | has("%s") | not`, cullerConfigHashAnnotation),
+		))
+	})
+
+	t.Run("renders the culler ConfigMap", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&componentApi.NotebookCullingSpec{
+			ManagementState: operatorv1.Managed,
+			IdleTimeout:     &metav1.Duration{Duration: 90 * time.Minute},
+		})
+
+		g.Expect(configureCulling(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(2),
+			jq.Match(`.[0].spec.template.metadata.annotations["%s"] != ""`, cullerConfigHashAnnotation),
+			jq.Match(`.[1].metadata | .name == "%s" and .namespace == "%s"`, cullerConfigMapName, appNamespace),
+			jq.Match(`.[1].data | .ENABLE_CULLING == "true" and .CULL_IDLE_TIME == "90" and .IDLENESS_CHECK_PERIOD == "1"`),
+		))
+	})
+
+	t.Run("rolls out the controller when the settings change", func(t *testing.T) {
+		g := NewWithT(t)
+
+		enabled := newRequest(&componentApi.NotebookCullingSpec{ManagementState: operatorv1.Managed})
+		g.Expect(configureCulling(ctx, enabled)).Should(Succeed())
+
+		disabled := newRequest(&componentApi.NotebookCullingSpec{ManagementState: operatorv1.Removed})
+		g.Expect(configureCulling(ctx, disabled)).Should(Succeed())
+		g.Expect(disabled.Resources).Should(jq.Match(`.[1].data.ENABLE_CULLING == "false"`))
+
+		enabledHash, _, err := unstructured.NestedString(enabled.Resources[0].Object, "spec", "template", "metadata", "annotations", cullerConfigHashAnnotation)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		disabledHash, _, err := unstructured.NestedString(disabled.Resources[0].Object, "spec", "template", "metadata", "annotations", cullerConfigHashAnnotation)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(disabledHash).ShouldNot(Equal(enabledHash))
+	})
+}
+
+func TestImageRepository(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(imageRepository("quay.io/org/image:tag")).Should(Equal("quay.io/org/image"))
+	g.Expect(imageRepository("quay.io/org/image@" + testDigest)).Should(Equal("quay.io/org/image"))
+	g.Expect(imageRepository("registry:5000/org/image")).Should(Equal("registry:5000/org/image"))
+	g.Expect(imageRepository("registry:5000/org/image:tag")).Should(Equal("registry:5000/org/image"))
+}
@@ -1,12 +1,23 @@
 package workbenches
 
 import (
+	"fmt"
 	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	imagev1 "github.com/openshift/api/image/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 const (
@@ -28,6 +39,23 @@ const (
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
 	// deployment to the new component name, so keep it around till we figure out a solution.
 	LegacyComponentName = "workbenches"
+
+	// The dashboard lists the ImageStreams carrying the notebook image label in the
+	// workbench image picker, using the annotations for their display name and description.
+	notebookImageLabel          = "opendatahub.io/notebook-image"
+	notebookImageNameAnnotation = "opendatahub.io/notebook-image-name"
+	notebookImageDescAnnotation = "opendatahub.io/notebook-image-desc"
+
+	defaultNotebookImageTag = "latest"
+
+	// The kf notebook controller reads its culling settings from the environment variables
+	// of the culler ConfigMap, only when its Deployment is rolled out.
+	cullerConfigMapName                = "notebook-controller-culler-config"
+	cullerConfigHashAnnotation         = labels.ODHAppPrefix + "/CullerConfigHash"
+	kfNotebookControllerDeploymentName = "notebook-controller-deployment"
+
+	defaultCullIdleTimeout   = 24 * time.Hour
+	defaultCullCheckInterval = time.Minute
 )
 
 var (
@@ -39,6 +67,7 @@ var (
 var (
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionTypeReadinessChecksPassed,
 	}
 )
 
@@ -68,3 +97,123 @@ func notebookImagesManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 		SourcePath: sourcePath,
 	}
 }
+
+// pinNotebookImage keeps only the given tag of a notebook image ImageStream, pointing it to the
+// given digest of its image repository when one is set.
+func pinNotebookImage(is *unstructured.Unstructured, tag string, digest string) error {
+	tags, _, err := unstructured.NestedSlice(is.Object, "spec", "tags")
+	if err != nil {
+		return fmt.Errorf("failed to read the tags of notebook image %s: %w", is.GetName(), err)
+	}
+
+	pinned := make([]any, 0, 1)
+
+	for _, t := range tags {
+		m, ok := t.(map[string]any)
+		if !ok || m["name"] != tag {
+			continue
+		}
+
+		if digest != "" {
+			ref, _, err := unstructured.NestedString(m, "from", "name")
+			if err != nil {
+				return fmt.Errorf("failed to read the image of tag %s of notebook image %s: %w", tag, is.GetName(), err)
+			}
+
+			if err := unstructured.SetNestedField(m, imageRepository(ref)+"@"+digest, "from", "name"); err != nil {
+				return fmt.Errorf("failed to pin tag %s of notebook image %s: %w", tag, is.GetName(), err)
+			}
+		}
+
+		pinned = append(pinned, m)
+	}
+
+	if len(pinned) == 0 {
+		return fmt.Errorf("tag %s not found in notebook image %s", tag, is.GetName())
+	}
+
+	return unstructured.SetNestedSlice(is.Object, pinned, "spec", "tags")
+}
+
+// imageRepository returns the repository of an image reference, without its tag or digest.
+func imageRepository(ref string) string {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		return ref[:i]
+	}
+
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i]
+	}
+
+	return ref
+}
+
+// customNotebookImage returns the ImageStream registering a custom notebook image in the
+// workbench image picker.
+func customNotebookImage(ci componentApi.CustomNotebookImage, namespace string) *imagev1.ImageStream {
+	tag := ci.Tag
+	if tag == "" {
+		tag = defaultNotebookImageTag
+	}
+
+	return &imagev1.ImageStream{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: imagev1.GroupVersion.String(),
+			Kind:       "ImageStream",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ci.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				notebookImageLabel: "true",
+			},
+			Annotations: map[string]string{
+				notebookImageNameAnnotation: ci.DisplayName,
+				notebookImageDescAnnotation: ci.Description,
+			},
+		},
+		Spec: imagev1.ImageStreamSpec{
+			LookupPolicy: imagev1.ImageLookupPolicy{Local: true},
+			Tags: []imagev1.TagReference{{
+				Name: tag,
+				From: &corev1.ObjectReference{
+					Kind: "DockerImage",
+					Name: ci.Image,
+				},
+				ReferencePolicy: imagev1.TagReferencePolicy{
+					Type: imagev1.LocalTagReferencePolicy,
+				},
+			}},
+		},
+	}
+}
+
+// cullerConfigMap returns the ConfigMap holding the culling settings of the kf notebook
+// controller, with the durations expressed in minutes.
+func cullerConfigMap(culling *componentApi.NotebookCullingSpec, namespace string) *corev1.ConfigMap {
+	idleTimeout := defaultCullIdleTimeout
+	if culling.IdleTimeout != nil {
+		idleTimeout = culling.IdleTimeout.Duration
+	}
+
+	checkInterval := defaultCullCheckInterval
+	if culling.CheckInterval != nil {
+		checkInterval = culling.CheckInterval.Duration
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cullerConfigMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"ENABLE_CULLING":        strconv.FormatBool(culling.ManagementState != operatorv1.Removed),
+			"CULL_IDLE_TIME":        strconv.FormatInt(int64(idleTimeout/time.Minute), 10),
+			"IDLENESS_CHECK_PERIOD": strconv.FormatInt(int64(checkInterval/time.Minute), 10),
+		},
+	}
+}
@@ -19,6 +19,8 @@ package datasciencecluster
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,14 +34,16 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/dependent"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhresources "github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func NewDataScienceClusterReconciler(ctx context.Context, mgr ctrl.Manager) error {
 	componentsPredicate := dependent.New(dependent.WithWatchStatus(true))
 
-	_, err := reconciler.ReconcilerFor(mgr, &dscv2.DataScienceCluster{}).
+	b := reconciler.ReconcilerFor(mgr, &dscv2.DataScienceCluster{}).
 		Owns(&componentApi.Dashboard{}, reconciler.WithPredicates(componentsPredicate)).
 		Owns(&componentApi.Workbenches{}, reconciler.WithPredicates(componentsPredicate)).
 		Owns(&componentApi.Ray{}, reconciler.WithPredicates(componentsPredicate)).
@@ -56,10 +60,29 @@ func NewDataScienceClusterReconciler(ctx context.Context, mgr ctrl.Manager) erro
 			&dsciv2.DSCInitialization{},
 			reconciler.WithEventMapper(func(ctx context.Context, _ client.Object) []reconcile.Request {
 				return watchDataScienceClusters(ctx, mgr.GetClient())
-			})).
+			}))
+
+	// A component removal blocked by user workloads is re-evaluated once they get deleted, only
+	// their metadata is cached as there may be many of them
+	for _, name := range slices.Sorted(maps.Keys(componentWorkloads)) {
+		for _, wgvk := range componentWorkloads[name] {
+			b = b.Watches(odhresources.GvkToPartial(wgvk),
+				reconciler.WithEventMapper(func(ctx context.Context, _ client.Object) []reconcile.Request {
+					return watchDataScienceClusters(ctx, mgr.GetClient())
+				}),
+				reconciler.WithPredicates(resources.Deleted()),
+				reconciler.Dynamic(reconciler.CrdExists(wgvk)),
+			)
+		}
+	}
+
+	_, err := b.
 		WithAction(initialize).
+		WithAction(rollbackSpec).
 		WithAction(checkPreConditions).
+		WithAction(provisionApplicationsNamespace).
 		WithAction(updateStatus).
+		WithAction(configureMaintenanceMode).
 		WithAction(provisionComponents).
 		WithAction(deploy.NewAction(
 			deploy.WithCache()),
@@ -71,6 +94,7 @@ func NewDataScienceClusterReconciler(ctx context.Context, mgr ctrl.Manager) erro
 				},
 			),
 		)).
+		WithAction(publishManifests).
 		WithConditions(status.ConditionTypeComponentsReady).
 		Build(ctx)
 
@@ -3,16 +3,29 @@ package datasciencecluster
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/history"
 	odhtype "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
@@ -36,22 +49,137 @@ func initialize(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
 	return nil
 }
 
+// rollbackSpec restores the spec recorded at the generation requested with spec.rollbackTo,
+// and records the spec of the current generation in the history otherwise.
+func rollbackSpec(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
+	}
+
+	ns, err := cluster.GetOperatorNamespace()
+
+	if instance.Spec.RollbackTo == nil {
+		// the history is best effort, it must not prevent the reconciliation
+		if err == nil {
+			err = history.Record(ctx, rr.Client, ns, instance, instance.Spec)
+		}
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "failed to record DataScienceCluster spec")
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	generation := *instance.Spec.RollbackTo
+
+	spec := dscv2.DataScienceClusterSpec{}
+	if err := history.Get(ctx, rr.Client, ns, instance, generation, &spec); err != nil {
+		return fmt.Errorf("failed to roll back DataScienceCluster spec: %w", err)
+	}
+
+	instance.Spec = spec
+	instance.Spec.RollbackTo = nil
+
+	if err := rr.Client.Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to roll back DataScienceCluster spec to generation %d: %w", generation, err)
+	}
+
+	rr.Eventf(corev1.EventTypeNormal, status.SpecRolledBackReason, "Spec rolled back to generation %d", generation)
+
+	// the restored spec is reconciled with the next generation, stop here so the remaining actions
+	// don't act on the spec that has just been replaced
+	return odherrors.NewStopError("DataScienceCluster spec rolled back to generation %d", generation)
+}
+
 func checkPreConditions(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
+	}
+
 	// This case should not happen, since there is a webhook that blocks the creation
 	// of more than one instance of the DataScienceCluster, however one can create a
 	// DataScienceCluster instance while the operator is stopped, hence this extra check
 
-	if _, err := cluster.GetDSCI(ctx, rr.Client); err != nil {
-		return fmt.Errorf("failed to get a valid DataScienceCluster instance, %w", err)
+	dsci, err := cluster.GetDSCI(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get a valid DSCInitialization instance, %w", err)
 	}
 
+	// a scoped DataScienceCluster relies on the one bound to the DSCInitialization applications
+	// namespace, which deploys the cluster-scoped resources the components share
 	if _, err := cluster.GetDSC(ctx, rr.Client); err != nil {
-		return fmt.Errorf("failed to get a valid DSCInitialization instance, %w", err)
+		return fmt.Errorf("failed to get a valid DataScienceCluster instance, %w", err)
+	}
+
+	if instance.IsScoped() && instance.Spec.ApplicationsNamespace == dsci.Spec.ApplicationsNamespace {
+		return fmt.Errorf("applications namespace %s of the DataScienceCluster is the one of the DSCInitialization",
+			instance.Spec.ApplicationsNamespace)
 	}
 
 	return nil
 }
 
+// provisionApplicationsNamespace creates the applications namespace of a scoped DataScienceCluster,
+// labeled with the DataScienceCluster it is bound to. A namespace the operator creates is deleted when
+// the platform is uninstalled, not when the DataScienceCluster is deleted, as it may hold user data.
+func provisionApplicationsNamespace(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
+	}
+
+	if !instance.IsScoped() {
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	ns.Name = instance.Spec.ApplicationsNamespace
+
+	values := map[string]string{
+		labels.SecurityEnforce:    "baseline",
+		labels.DataScienceCluster: instance.Name,
+	}
+
+	err := rr.Client.Get(ctx, client.ObjectKeyFromObject(ns), ns)
+	switch {
+	case k8serr.IsNotFound(err):
+		values[labels.ODH.OwnedNamespace] = labels.True
+	case err != nil:
+		return fmt.Errorf("failed to get applications namespace %s: %w", ns.Name, err)
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, rr.Client, ns, func() error {
+		resources.SetLabels(ns, values)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to provision applications namespace %s: %w", ns.Name, err)
+	}
+
+	return nil
+}
+
+// componentLabels returns the labels partitioning the components between the DataScienceClusters:
+// the DataScienceCluster a component instance belongs to and, for a scoped DataScienceCluster, the
+// applications namespace its resources are deployed to.
+func componentLabels(dsc *dscv2.DataScienceCluster) map[string]string {
+	values := map[string]string{
+		labels.DataScienceCluster: dsc.Name,
+	}
+
+	if dsc.IsScoped() {
+		values[labels.ApplicationsNamespace] = dsc.Spec.ApplicationsNamespace
+	}
+
+	return values
+}
+
 func watchDataScienceClusters(ctx context.Context, cli client.Client) []reconcile.Request {
 	instanceList := &dscv2.DataScienceClusterList{}
 	err := cli.List(ctx, instanceList)
@@ -67,7 +195,30 @@ func watchDataScienceClusters(ctx context.Context, cli client.Client) []reconcil
 	return requests
 }
 
-func provisionComponents(_ context.Context, rr *odhtype.ReconciliationRequest) error {
+func configureMaintenanceMode(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
+	}
+
+	if err := setWebhooksFailOpen(ctx, rr.Client, instance.Spec.MaintenanceMode); err != nil {
+		return err
+	}
+
+	if instance.Spec.MaintenanceMode {
+		rr.Conditions.SetCondition(common.Condition{
+			Type:     status.ConditionTypeMaintenanceMode,
+			Status:   metav1.ConditionTrue,
+			Severity: common.ConditionSeverityInfo,
+			Reason:   status.MaintenanceModeReason,
+			Message:  status.MaintenanceModeMessage,
+		})
+	}
+
+	return nil
+}
+
+func provisionComponents(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
 	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
 	if !ok {
 		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
@@ -76,23 +227,116 @@ func provisionComponents(_ context.Context, rr *odhtype.ReconciliationRequest) e
 	// force gc to run
 	rr.Generated = true
 
-	err := cr.ForEach(func(component cr.ComponentHandler) error {
+	handlers := make([]cr.ComponentHandler, 0)
+	byName := make(map[string]cr.ComponentHandler)
+	_ = cr.ForEach(func(component cr.ComponentHandler) error {
+		handlers = append(handlers, component)
+		byName[component.GetName()] = component
+		return nil
+	})
+
+	sorted, err := sortComponents(handlers, componentDependencies)
+	if err != nil {
+		return err
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	removalBlocked := make([]string, 0)
+
+	for _, component := range sorted {
+		ci := component.NewCRObject(instance)
+		resources.SetLabels(ci, componentLabels(instance))
+
+		if w, ok := ci.(common.WithComponentWorkload); ok && w.GetWorkloadSpec() != nil {
+			w.GetWorkloadSpec().Scheduling = mergeScheduling(dsci.Spec.Scheduling, w.GetWorkloadSpec().Scheduling)
+		}
+
+		// In maintenance mode the deployed components are kept as they are, with their
+		// reconciliation paused, and no component is created or removed
+		if instance.Spec.MaintenanceMode {
+			err := rr.Client.Get(ctx, client.ObjectKeyFromObject(ci), component.NewCRObject(instance))
+			switch {
+			case k8serr.IsNotFound(err):
+				continue
+			case err != nil:
+				return fmt.Errorf("failed to get component %s: %w", component.GetName(), err)
+			}
+
+			resources.SetAnnotation(ci, annotations.ReconcilePaused, "true")
+			if err := rr.AddResources(ci); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		if !component.IsEnabled(instance) {
-			return nil
+			components, workloads, err := removalBlockers(ctx, rr.Client, instance, byName, component.GetName())
+			if err != nil {
+				return err
+			}
+
+			if len(workloads) > 0 {
+				removalBlocked = append(removalBlocked, fmt.Sprintf("%s (%s)", component.GetName(), formatWorkloads(workloads)))
+			}
+
+			// The Component CR is kept, and thus not garbage collected, until nothing relies on it anymore
+			if len(components) > 0 || len(workloads) > 0 {
+				if err := rr.AddResources(ci); err != nil {
+					return err
+				}
+			}
+
+			continue
 		}
 
-		ci := component.NewCRObject(instance)
-		if err := rr.AddResources(ci); err != nil {
+		pending, err := pendingDependencies(ctx, rr.Client, instance, byName, component.GetName())
+		if err != nil {
 			return err
 		}
 
-		return nil
-	})
+		// A component that is already deployed keeps being reconciled, only its first
+		// provisioning waits for the dependencies to be ready
+		if len(pending) > 0 {
+			err := rr.Client.Get(ctx, client.ObjectKeyFromObject(ci), component.NewCRObject(instance))
+			switch {
+			case k8serr.IsNotFound(err):
+				rr.Conditions.MarkFalse(
+					ci.GetObjectKind().GroupVersionKind().Kind+status.ReadySuffix,
+					conditions.WithReason(status.BlockedOnDependencyReason),
+					conditions.WithMessage("Waiting for dependencies to be ready: %s", strings.Join(pending, ",")),
+				)
+				continue
+			case err != nil:
+				return fmt.Errorf("failed to get component %s: %w", component.GetName(), err)
+			}
+		}
 
-	if err != nil {
-		return err
+		if err := rr.AddResources(ci); err != nil {
+			return err
+		}
+	}
+
+	if len(removalBlocked) == 0 {
+		return rr.Conditions.ClearCondition(status.ConditionTypeRemovalBlocked)
 	}
 
+	rr.Conditions.SetCondition(common.Condition{
+		Type:     status.ConditionTypeRemovalBlocked,
+		Status:   metav1.ConditionTrue,
+		Severity: common.ConditionSeverityInfo,
+		Reason:   status.DependentWorkloadsReason,
+		Message: fmt.Sprintf(
+			"Removal blocked by existing workloads: %s. Delete them or set the %s=true annotation on the component to proceed",
+			strings.Join(removalBlocked, "; "),
+			annotations.ForceRemoval,
+		),
+	})
+
 	return nil
 }
 
@@ -109,5 +353,10 @@ func updateStatus(ctx context.Context, rr *odhtype.ReconciliationRequest) error
 		return err
 	}
 
+	instance.Status.ClusterHealth, err = computeClusterHealth(ctx, rr.Client, cr.DefaultRegistry(), instance)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
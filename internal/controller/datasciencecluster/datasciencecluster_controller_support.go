@@ -4,17 +4,53 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
+// maxListedWorkloads is the maximum number of user workloads listed in the RemovalBlocked condition.
+const maxListedWorkloads = 5
+
+// componentDependencies maps a component to the components that must be ready before it is provisioned.
+var componentDependencies = map[string][]string{
+	// odh-model-controller reconciles KServe resources and needs the KServe CRDs and webhooks in place
+	componentApi.ModelControllerComponentName: {componentApi.KserveComponentName},
+}
+
+// failOpenWebhooks lists the validating webhooks that are set to fail open in maintenance mode. Only the
+// webhooks whose checks are enforced again by the controllers once the maintenance is over are listed.
+var failOpenWebhooks = []string{
+	"datasciencecluster-v1-validator.opendatahub.io",
+	"datasciencecluster-v2-validator.opendatahub.io",
+	"monitoring-validator.opendatahub.io",
+}
+
+// componentWorkloads maps a component to the kinds of user workloads that stop working once it is removed.
+var componentWorkloads = map[string][]schema.GroupVersionKind{
+	componentApi.KserveComponentName:           {gvk.InferenceServices, gvk.LLMInferenceServiceV1Alpha1},
+	componentApi.WorkbenchesComponentName:      {gvk.Notebook},
+	componentApi.RayComponentName:              {gvk.RayClusterV1},
+	componentApi.TrainingOperatorComponentName: {gvk.PyTorchJob},
+}
+
 // computeComponentsStatus checks the status of all registered components in a DataScienceCluster instance
 // and updates the status condition accordingly.
 //
@@ -84,3 +120,295 @@ func computeComponentsStatus(
 
 	return nil
 }
+
+// sortComponents returns the component handlers ordered so that each component comes after its
+// dependencies. Components without ordering constraints keep their registration order.
+//
+// Parameters:
+// - handlers: The component handlers in registration order.
+// - dependencies: The names of the components each component depends on.
+//
+// Returns:
+// - []cr.ComponentHandler: The handlers in dependency order.
+// - error: An error if a dependency is not registered or the dependencies contain a cycle.
+func sortComponents(handlers []cr.ComponentHandler, dependencies map[string][]string) ([]cr.ComponentHandler, error) {
+	registered := make(map[string]bool, len(handlers))
+	for _, h := range handlers {
+		registered[h.GetName()] = true
+	}
+
+	for name, deps := range dependencies {
+		for _, dep := range deps {
+			if !registered[dep] {
+				return nil, fmt.Errorf("component %s depends on unregistered component %s", name, dep)
+			}
+		}
+	}
+
+	sorted := make([]cr.ComponentHandler, 0, len(handlers))
+	done := make(map[string]bool, len(handlers))
+
+	for len(sorted) < len(handlers) {
+		progressed := false
+
+		for _, h := range handlers {
+			if done[h.GetName()] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range dependencies[h.GetName()] {
+				if !done[dep] {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+
+			sorted = append(sorted, h)
+			done[h.GetName()] = true
+			progressed = true
+		}
+
+		if !progressed {
+			pending := make([]string, 0)
+			for _, h := range handlers {
+				if !done[h.GetName()] {
+					pending = append(pending, h.GetName())
+				}
+			}
+			return nil, fmt.Errorf("component dependencies contain a cycle between: %s", strings.Join(pending, ","))
+		}
+	}
+
+	return sorted, nil
+}
+
+// pendingDependencies returns the dependencies of a component that are not enabled or not ready yet.
+//
+// Parameters:
+// - ctx: The context for managing request deadlines and cancellation.
+// - cli: The client used to read the dependency Component CRs.
+// - dsc: The DataScienceCluster instance being reconciled.
+// - handlers: The registered component handlers, keyed by component name.
+// - name: The name of the component whose dependencies are checked.
+//
+// Returns:
+// - []string: The pending dependencies, in declaration order.
+// - error: An error if a dependency Component CR can not be read.
+func pendingDependencies(
+	ctx context.Context,
+	cli client.Client,
+	dsc *dscv2.DataScienceCluster,
+	handlers map[string]cr.ComponentHandler,
+	name string,
+) ([]string, error) {
+	pending := make([]string, 0)
+
+	for _, dep := range componentDependencies[name] {
+		h, ok := handlers[dep]
+		if !ok || !h.IsEnabled(dsc) {
+			pending = append(pending, dep)
+			continue
+		}
+
+		obj := h.NewCRObject(dsc)
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if k8serr.IsNotFound(err) {
+				pending = append(pending, dep)
+				continue
+			}
+			return nil, fmt.Errorf("failed to get component %s: %w", dep, err)
+		}
+
+		if rc := conditions.FindStatusCondition(obj.GetStatus(), status.ConditionTypeReady); rc == nil || rc.Status != metav1.ConditionTrue {
+			pending = append(pending, dep)
+		}
+	}
+
+	return pending, nil
+}
+
+// mergeScheduling combines the cluster-wide scheduling constraints with the component ones.
+// Each of nodeSelector, tolerations and affinity is taken from the component when set,
+// falling back to the cluster-wide value otherwise.
+//
+// Parameters:
+// - defaults: The scheduling constraints set in the DSCInitialization, may be nil.
+// - override: The scheduling constraints set for the component in the DataScienceCluster, may be nil.
+//
+// Returns:
+// - *common.SchedulingSpec: The effective scheduling constraints, nil if none is set.
+func mergeScheduling(defaults *common.SchedulingSpec, override *common.SchedulingSpec) *common.SchedulingSpec {
+	if defaults == nil {
+		return override.DeepCopy()
+	}
+
+	res := defaults.DeepCopy()
+	if override == nil {
+		return res
+	}
+
+	o := override.DeepCopy()
+	if o.NodeSelector != nil {
+		res.NodeSelector = o.NodeSelector
+	}
+	if o.Tolerations != nil {
+		res.Tolerations = o.Tolerations
+	}
+	if o.Affinity != nil {
+		res.Affinity = o.Affinity
+	}
+
+	return res
+}
+
+// removalBlockers returns what prevents a component switched to Removed from being torn down.
+// A component is removed only after the components depending on it are gone and, unless the
+// Component CR carries the force-removal annotation, after the user workloads relying on it
+// have been deleted.
+//
+// Parameters:
+// - ctx: The context for managing request deadlines and cancellation.
+// - cli: The client used to read the Component CRs and the user workloads.
+// - dsc: The DataScienceCluster instance being reconciled.
+// - handlers: The registered component handlers, keyed by component name.
+// - name: The name of the component being removed.
+//
+// Returns:
+// - []string: The dependent components that are still being torn down.
+// - []string: The user workloads relying on the component, as "Kind namespace/name".
+// - error: An error if the Component CRs or the user workloads can not be read.
+func removalBlockers(
+	ctx context.Context,
+	cli client.Client,
+	dsc *dscv2.DataScienceCluster,
+	handlers map[string]cr.ComponentHandler,
+	name string,
+) ([]string, []string, error) {
+	obj := handlers[name].NewCRObject(dsc)
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get component %s: %w", name, err)
+	}
+
+	components := make([]string, 0)
+	for dependent, deps := range componentDependencies {
+		h, ok := handlers[dependent]
+		if !ok || h.IsEnabled(dsc) || !slices.Contains(deps, name) {
+			continue
+		}
+
+		dobj := h.NewCRObject(dsc)
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(dobj), dobj); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to get component %s: %w", dependent, err)
+		}
+
+		components = append(components, dependent)
+	}
+
+	slices.Sort(components)
+
+	if obj.GetAnnotations()[annotations.ForceRemoval] == "true" {
+		return components, nil, nil
+	}
+
+	workloads := make([]string, 0)
+	for _, wgvk := range componentWorkloads[name] {
+		exists, err := cluster.HasCRD(ctx, cli, wgvk)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check CRD for %s: %w", wgvk.Kind, err)
+		}
+		if !exists {
+			continue
+		}
+
+		// the workloads are watched through their metadata only
+		items := metav1.PartialObjectMetadataList{}
+		items.SetGroupVersionKind(wgvk.GroupVersion().WithKind(wgvk.Kind + "List"))
+
+		if err := cli.List(ctx, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to list %s: %w", wgvk.Kind, err)
+		}
+
+		for i := range items.Items {
+			workloads = append(workloads, fmt.Sprintf("%s %s/%s", wgvk.Kind, items.Items[i].GetNamespace(), items.Items[i].GetName()))
+		}
+	}
+
+	return components, workloads, nil
+}
+
+// formatWorkloads joins the given workloads, listing at most maxListedWorkloads of them.
+func formatWorkloads(workloads []string) string {
+	if len(workloads) <= maxListedWorkloads {
+		return strings.Join(workloads, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(workloads[:maxListedWorkloads], ", "), len(workloads)-maxListedWorkloads)
+}
+
+// setWebhooksFailOpen sets the failure policy of the failOpenWebhooks to Ignore when the maintenance
+// mode is enabled, and restores it to Fail once it is disabled. The webhook configurations changed by
+// the operator are marked with the maintenance-fail-open annotation, so only those get restored.
+//
+// Parameters:
+// - ctx: The context for managing request deadlines and cancellation.
+// - cli: The client used to read and update the ValidatingWebhookConfigurations.
+// - enabled: Whether the maintenance mode is enabled.
+//
+// Returns:
+// - error: An error if the ValidatingWebhookConfigurations can not be listed or updated.
+func setWebhooksFailOpen(ctx context.Context, cli client.Client, enabled bool) error {
+	policy := admissionregistrationv1.Fail
+	if enabled {
+		policy = admissionregistrationv1.Ignore
+	}
+
+	configs := admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := cli.List(ctx, &configs); err != nil {
+		return fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+	}
+
+	for i := range configs.Items {
+		wc := &configs.Items[i]
+
+		marked := resources.HasAnnotation(wc, annotations.MaintenanceFailOpen, "true")
+		if marked == enabled {
+			continue
+		}
+
+		changed := false
+		for j := range wc.Webhooks {
+			if !slices.Contains(failOpenWebhooks, wc.Webhooks[j].Name) {
+				continue
+			}
+
+			wc.Webhooks[j].FailurePolicy = &policy
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+
+		if enabled {
+			resources.SetAnnotation(wc, annotations.MaintenanceFailOpen, "true")
+		} else {
+			resources.RemoveAnnotation(wc, annotations.MaintenanceFailOpen)
+		}
+
+		if err := cli.Update(ctx, wc); err != nil {
+			return fmt.Errorf("failed to update ValidatingWebhookConfiguration %s: %w", wc.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,426 @@
+//nolint:testpackage
+package datasciencecluster
+
+import (
+	"context"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeComponent is a minimal ComponentHandler for testing the dependency handling.
+type fakeComponent struct {
+	name    string
+	enabled bool
+	obj     func() common.PlatformObject
+}
+
+func (f *fakeComponent) Init(_ common.Platform) error { return nil }
+func (f *fakeComponent) GetName() string              { return f.name }
+func (f *fakeComponent) NewComponentReconciler(_ context.Context, _ ctrl.Manager) error {
+	return nil
+}
+func (f *fakeComponent) IsEnabled(_ *dscv2.DataScienceCluster) bool { return f.enabled }
+func (f *fakeComponent) UpdateDSCStatus(_ context.Context, _ *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
+	return metav1.ConditionTrue, nil
+}
+func (f *fakeComponent) NewCRObject(_ *dscv2.DataScienceCluster) common.PlatformObject {
+	if f.obj != nil {
+		return f.obj()
+	}
+	return &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}}
+}
+
+func componentNames(handlers []cr.ComponentHandler) []string {
+	names := make([]string, 0, len(handlers))
+	for _, h := range handlers {
+		names = append(names, h.GetName())
+	}
+	return names
+}
+
+func TestSortComponents(t *testing.T) {
+	handlers := []cr.ComponentHandler{
+		&fakeComponent{name: "a"},
+		&fakeComponent{name: "b"},
+		&fakeComponent{name: "c"},
+		&fakeComponent{name: "d"},
+	}
+
+	tests := []struct {
+		name          string
+		dependencies  map[string][]string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "registration order without dependencies",
+			expected: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:         "dependencies come first",
+			dependencies: map[string][]string{"a": {"c"}, "c": {"d"}},
+			expected:     []string{"b", "d", "c", "a"},
+		},
+		{
+			name:          "unregistered dependency",
+			dependencies:  map[string][]string{"a": {"e"}},
+			expectedError: "component a depends on unregistered component e",
+		},
+		{
+			name:          "cycle",
+			dependencies:  map[string][]string{"a": {"b"}, "b": {"a"}},
+			expectedError: "component dependencies contain a cycle between: a,b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			sorted, err := sortComponents(handlers, tt.dependencies)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(tt.expectedError))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(componentNames(sorted)).Should(Equal(tt.expected))
+		})
+	}
+}
+
+func TestComponentDependenciesAreAcyclic(t *testing.T) {
+	g := NewWithT(t)
+
+	handlers := make([]cr.ComponentHandler, 0)
+	for name, deps := range componentDependencies {
+		handlers = append(handlers, &fakeComponent{name: name})
+		for _, dep := range deps {
+			if _, ok := componentDependencies[dep]; !ok {
+				handlers = append(handlers, &fakeComponent{name: dep})
+			}
+		}
+	}
+
+	_, err := sortComponents(handlers, componentDependencies)
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestPendingDependencies(t *testing.T) {
+	kserve := func(ready metav1.ConditionStatus) client.Object {
+		k := &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}}
+		k.Status.Conditions = []common.Condition{{Type: status.ConditionTypeReady, Status: ready}}
+		return k
+	}
+
+	tests := []struct {
+		name          string
+		kserveEnabled bool
+		objects       []client.Object
+		expected      []string
+	}{
+		{
+			name:     "dependency not enabled",
+			expected: []string{componentApi.KserveComponentName},
+		},
+		{
+			name:          "dependency not deployed yet",
+			kserveEnabled: true,
+			expected:      []string{componentApi.KserveComponentName},
+		},
+		{
+			name:          "dependency not ready",
+			kserveEnabled: true,
+			objects:       []client.Object{kserve(metav1.ConditionFalse)},
+			expected:      []string{componentApi.KserveComponentName},
+		},
+		{
+			name:          "dependency ready",
+			kserveEnabled: true,
+			objects:       []client.Object{kserve(metav1.ConditionTrue)},
+			expected:      []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(tt.objects...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			handlers := map[string]cr.ComponentHandler{
+				componentApi.KserveComponentName: &fakeComponent{name: componentApi.KserveComponentName, enabled: tt.kserveEnabled},
+			}
+
+			pending, err := pendingDependencies(t.Context(), cli, &dscv2.DataScienceCluster{}, handlers, componentApi.ModelControllerComponentName)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(pending).Should(Equal(tt.expected))
+		})
+	}
+}
+
+func TestMergeScheduling(t *testing.T) {
+	clusterWide := &common.SchedulingSpec{
+		NodeSelector: map[string]string{"node-role": "worker"},
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpExists},
+		},
+	}
+	component := &common.SchedulingSpec{
+		NodeSelector: map[string]string{"node-role": "gpu"},
+		Affinity:     &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+	}
+
+	tests := []struct {
+		name     string
+		defaults *common.SchedulingSpec
+		override *common.SchedulingSpec
+		expected *common.SchedulingSpec
+	}{
+		{
+			name: "none set",
+		},
+		{
+			name:     "cluster-wide only",
+			defaults: clusterWide,
+			expected: clusterWide,
+		},
+		{
+			name:     "component only",
+			override: component,
+			expected: component,
+		},
+		{
+			name:     "component fields take precedence",
+			defaults: clusterWide,
+			override: component,
+			expected: &common.SchedulingSpec{
+				NodeSelector: component.NodeSelector,
+				Tolerations:  clusterWide.Tolerations,
+				Affinity:     component.Affinity,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := mergeScheduling(tt.defaults, tt.override)
+			g.Expect(res).To(Equal(tt.expected))
+
+			if res != nil && tt.defaults != nil {
+				g.Expect(res).NotTo(BeIdenticalTo(tt.defaults))
+			}
+		})
+	}
+}
+
+func TestRemovalBlockers(t *testing.T) {
+	kserve := func(ann map[string]string) client.Object {
+		return &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName, Annotations: ann}}
+	}
+	modelController := func() common.PlatformObject {
+		return &componentApi.ModelController{ObjectMeta: metav1.ObjectMeta{Name: componentApi.ModelControllerInstanceName}}
+	}
+
+	isvcCRD := mocks.NewMockCRD(gvk.InferenceServices.Group, gvk.InferenceServices.Version, gvk.InferenceServices.Kind, componentApi.KserveComponentName)
+	isvcCRD.Status.StoredVersions = []string{gvk.InferenceServices.Version}
+
+	isvc := &unstructured.Unstructured{}
+	isvc.SetGroupVersionKind(gvk.InferenceServices)
+	isvc.SetNamespace("user")
+	isvc.SetName("model")
+
+	tests := []struct {
+		name               string
+		objects            []client.Object
+		expectedComponents []string
+		expectedWorkloads  []string
+	}{
+		{
+			name:    "component not deployed",
+			objects: []client.Object{isvcCRD, isvc},
+		},
+		{
+			name:               "no workloads",
+			objects:            []client.Object{isvcCRD, kserve(nil)},
+			expectedComponents: []string{},
+			expectedWorkloads:  []string{},
+		},
+		{
+			name:               "workloads exist",
+			objects:            []client.Object{isvcCRD, isvc, kserve(nil)},
+			expectedComponents: []string{},
+			expectedWorkloads:  []string{"InferenceService user/model"},
+		},
+		{
+			name:               "workloads exist and removal is forced",
+			objects:            []client.Object{isvcCRD, isvc, kserve(map[string]string{annotations.ForceRemoval: "true"})},
+			expectedComponents: []string{},
+		},
+		{
+			name:               "dependent component still deployed",
+			objects:            []client.Object{isvcCRD, kserve(nil), modelController()},
+			expectedComponents: []string{componentApi.ModelControllerComponentName},
+			expectedWorkloads:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			s.AddKnownTypeWithName(gvk.InferenceServices, &unstructured.Unstructured{})
+			s.AddKnownTypeWithName(gvk.InferenceServices.GroupVersion().WithKind(gvk.InferenceServices.Kind+"List"), &unstructured.UnstructuredList{})
+
+			cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(tt.objects...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			handlers := map[string]cr.ComponentHandler{
+				componentApi.KserveComponentName:          &fakeComponent{name: componentApi.KserveComponentName},
+				componentApi.ModelControllerComponentName: &fakeComponent{name: componentApi.ModelControllerComponentName, obj: modelController},
+			}
+
+			components, workloads, err := removalBlockers(t.Context(), cli, &dscv2.DataScienceCluster{}, handlers, componentApi.KserveComponentName)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(components).Should(Equal(tt.expectedComponents))
+			g.Expect(workloads).Should(Equal(tt.expectedWorkloads))
+		})
+	}
+}
+
+func TestFormatWorkloads(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(formatWorkloads([]string{"a", "b"})).Should(Equal("a, b"))
+	g.Expect(formatWorkloads([]string{"a", "b", "c", "d", "e", "f", "g"})).Should(Equal("a, b, c, d, e and 2 more"))
+}
+
+func TestSetWebhooksFailOpen(t *testing.T) {
+	g := NewWithT(t)
+
+	fail := admissionregistrationv1.Fail
+	operator := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{Name: "datasciencecluster-v2-validator.opendatahub.io", FailurePolicy: &fail},
+			{Name: "dscinitialization-v2-validator.opendatahub.io", FailurePolicy: &fail},
+		},
+	}
+	other := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{Name: "other.example.com", FailurePolicy: &fail},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(operator, other))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	policies := func(name string) []admissionregistrationv1.FailurePolicyType {
+		wc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: name}, wc)).Should(Succeed())
+
+		res := make([]admissionregistrationv1.FailurePolicyType, 0, len(wc.Webhooks))
+		for _, w := range wc.Webhooks {
+			res = append(res, *w.FailurePolicy)
+		}
+		return res
+	}
+
+	g.Expect(setWebhooksFailOpen(t.Context(), cli, true)).Should(Succeed())
+	g.Expect(policies("operator")).Should(Equal([]admissionregistrationv1.FailurePolicyType{admissionregistrationv1.Ignore, admissionregistrationv1.Fail}))
+	g.Expect(policies("other")).Should(Equal([]admissionregistrationv1.FailurePolicyType{admissionregistrationv1.Fail}))
+
+	wc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "operator"}, wc)).Should(Succeed())
+	g.Expect(resources.HasAnnotation(wc, annotations.MaintenanceFailOpen, "true")).Should(BeTrue())
+
+	g.Expect(setWebhooksFailOpen(t.Context(), cli, false)).Should(Succeed())
+	g.Expect(policies("operator")).Should(Equal([]admissionregistrationv1.FailurePolicyType{admissionregistrationv1.Fail, admissionregistrationv1.Fail}))
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "operator"}, wc)).Should(Succeed())
+	g.Expect(wc.GetAnnotations()).ShouldNot(HaveKey(annotations.MaintenanceFailOpen))
+}
+
+func TestProvisionApplicationsNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	existing := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(existing))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	provision := func(dsc *dscv2.DataScienceCluster) {
+		g.Expect(provisionApplicationsNamespace(t.Context(), &types.ReconciliationRequest{Client: cli, Instance: dsc})).Should(Succeed())
+	}
+
+	provision(&dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}})
+
+	nsl := &corev1.NamespaceList{}
+	g.Expect(cli.List(t.Context(), nsl)).Should(Succeed())
+	g.Expect(nsl.Items).Should(HaveLen(1))
+
+	provision(&dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec:       dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team-a"},
+	})
+
+	ns := &corev1.Namespace{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "team-a"}, ns)).Should(Succeed())
+	g.Expect(ns.GetLabels()).Should(And(
+		HaveKeyWithValue(labels.DataScienceCluster, "team-a"),
+		HaveKeyWithValue(labels.ODH.OwnedNamespace, labels.True),
+	))
+
+	// a namespace that already exists is not deleted with the platform
+	provision(&dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-b"},
+		Spec:       dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team-b"},
+	})
+
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: "team-b"}, ns)).Should(Succeed())
+	g.Expect(ns.GetLabels()).Should(HaveKeyWithValue(labels.DataScienceCluster, "team-b"))
+	g.Expect(ns.GetLabels()).ShouldNot(HaveKey(labels.ODH.OwnedNamespace))
+}
+
+func TestComponentLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(componentLabels(&dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}})).Should(Equal(map[string]string{
+		labels.DataScienceCluster: "default-dsc",
+	}))
+
+	g.Expect(componentLabels(&dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec:       dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team-a"},
+	})).Should(Equal(map[string]string{
+		labels.DataScienceCluster:    "team-a",
+		labels.ApplicationsNamespace: "team-a",
+	}))
+}
@@ -0,0 +1,190 @@
+package datasciencecluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtype "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/export"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/archive"
+)
+
+const (
+	// manifestsArtifactType is the type of the OCI artifacts the rendered resources are published as.
+	manifestsArtifactType = "application/vnd.opendatahub.manifests.v1"
+
+	// manifestsLayerTitle is the name of the kustomize bundle held by the published artifacts.
+	manifestsLayerTitle = "manifests.tar.gz"
+)
+
+// published holds, by reference, the digest of the resources last published, so they are only
+// pushed again once they change.
+var published = struct {
+	sync.Mutex
+	digests map[string]string
+}{
+	digests: make(map[string]string),
+}
+
+// publishManifests publishes, in the GitOps deploy mode, the resources rendered by the controllers of
+// the platform as a kustomize bundle pushed to the OCI repository set in spec.gitOps, for a GitOps tool
+// to apply them.
+func publishManifests(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+	instance, ok := rr.Instance.(*dscv2.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a dscv2.DataScienceCluster)", rr.Instance)
+	}
+
+	if instance.Spec.DeployMode != common.DeployModeGitOps {
+		return rr.Conditions.ClearCondition(status.ConditionTypeManifestsPublished)
+	}
+
+	ref, count, err := publish(ctx, rr.Client, instance.Spec.GitOps)
+	if err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionTypeManifestsPublished,
+			conditions.WithReason(status.PublishFailedReason),
+			conditions.WithMessage("Failed to publish the rendered manifests: %v", err),
+		)
+
+		return err
+	}
+
+	rr.Conditions.SetCondition(common.Condition{
+		Type:     status.ConditionTypeManifestsPublished,
+		Status:   metav1.ConditionTrue,
+		Severity: common.ConditionSeverityInfo,
+		Reason:   status.ManifestsPublishedReason,
+		Message:  fmt.Sprintf("%d rendered manifests published to %s", count, ref),
+	})
+
+	return nil
+}
+
+// publish pushes the rendered resources to the OCI repository of the given configuration, unless
+// they did not change since they were last pushed, and returns the reference pushed and the number
+// of resources.
+func publish(ctx context.Context, cli client.Client, gitOps *common.GitOpsSpec) (oci.Reference, int, error) {
+	if gitOps == nil {
+		return oci.Reference{}, 0, errors.New("no OCI repository set in spec.gitOps")
+	}
+
+	ref, err := oci.ParseReference(gitOps.Repository, gitOps.Tag)
+	if err != nil {
+		return oci.Reference{}, 0, err
+	}
+
+	resources, err := export.DefaultStore().Deployed(ctx, cli, cli.Scheme())
+	if err != nil {
+		return ref, 0, err
+	}
+
+	// the Secrets are applied by the operator, their values are not published
+	count := 0
+	for controller, items := range resources {
+		resources[controller] = slices.DeleteFunc(slices.Clone(items), func(u unstructured.Unstructured) bool {
+			return u.GroupVersionKind() == gvk.Secret
+		})
+
+		count += len(resources[controller])
+	}
+
+	files, err := export.Kustomize(resources)
+	if err != nil {
+		return ref, 0, err
+	}
+
+	d := filesDigest(files)
+
+	published.Lock()
+	defer published.Unlock()
+
+	if published.digests[ref.String()] == d {
+		return ref, count, nil
+	}
+
+	content, err := archive.TarGz(files)
+	if err != nil {
+		return ref, 0, fmt.Errorf("failed to archive the rendered manifests: %w", err)
+	}
+
+	c := oci.Client{Insecure: gitOps.Insecure}
+
+	if gitOps.CredentialsSecret != "" {
+		creds, err := registryCredentials(ctx, cli, gitOps.CredentialsSecret, ref.Registry)
+		if err != nil {
+			return ref, 0, err
+		}
+
+		c.Credentials = &creds
+	}
+
+	manifestDigest, err := c.Push(ctx, ref, manifestsArtifactType, []oci.Layer{{
+		MediaType:   oci.MediaTypeLayerTarGz,
+		Content:     content,
+		Annotations: map[string]string{oci.AnnotationTitle: manifestsLayerTitle},
+	}}, nil)
+	if err != nil {
+		return ref, 0, fmt.Errorf("failed to push the rendered manifests to %s: %w", ref, err)
+	}
+
+	published.digests[ref.String()] = d
+
+	logf.FromContext(ctx).Info("Rendered manifests published", "reference", ref.String(), "digest", manifestDigest, "resources", count)
+
+	return ref, count, nil
+}
+
+// registryCredentials returns the credentials of the given registry held by the given
+// kubernetes.io/dockerconfigjson Secret of the operator namespace.
+func registryCredentials(ctx context.Context, cli client.Client, name string, registry string) (oci.Credentials, error) {
+	ns, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return oci.Credentials{}, err
+	}
+
+	secret := corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, &secret); err != nil {
+		return oci.Credentials{}, fmt.Errorf("failed to get registry credentials Secret %s: %w", name, err)
+	}
+
+	content, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return oci.Credentials{}, fmt.Errorf("registry credentials Secret %s has no %s key", name, corev1.DockerConfigJsonKey)
+	}
+
+	return oci.CredentialsFromDockerConfig(content, registry)
+}
+
+// filesDigest returns the digest of the given files, by path, regardless of their order.
+func filesDigest(files map[string]string) string {
+	h := sha256.New()
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(files[name]))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,265 @@
+package datasciencecluster
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// HealthPath is the path of the metrics server the health of the platform is served at.
+const HealthPath = "/platform-health"
+
+// healthServices are the kinds of the platform services whose Ready condition is checked.
+var healthServices = []schema.GroupVersionKind{
+	gvk.DSCInitialization,
+	gvk.Auth,
+	gvk.GatewayConfig,
+	gvk.Monitoring,
+}
+
+// healthReport accumulates the failed checks of the platform health, the worst failure
+// determining the overall health.
+type healthReport struct {
+	dscv2.ClusterHealth
+}
+
+func (r *healthReport) fail(health dscv2.ClusterHealthStatus, format string, args ...any) {
+	r.FailedChecks = append(r.FailedChecks, fmt.Sprintf(format, args...))
+
+	if r.Status != dscv2.ClusterHealthUnhealthy {
+		r.Status = health
+	}
+}
+
+// computeClusterHealth returns the health of the platform: it is Unhealthy when a component
+// has no ready deployment or a webhook failing closed has no ready endpoint, Degraded when a
+// component has some deployments not ready, a webhook failing open has no ready endpoint or a
+// platform service is not ready, and Healthy otherwise.
+func computeClusterHealth(ctx context.Context, cli client.Client, reg *cr.Registry, dsc *dscv2.DataScienceCluster) (dscv2.ClusterHealth, error) {
+	r := healthReport{}
+	r.Status = dscv2.ClusterHealthHealthy
+
+	if err := checkComponents(ctx, cli, reg, dsc, &r); err != nil {
+		return dscv2.ClusterHealth{}, err
+	}
+
+	if err := checkWebhooks(ctx, cli, &r); err != nil {
+		return dscv2.ClusterHealth{}, err
+	}
+
+	if err := checkServices(ctx, cli, &r); err != nil {
+		return dscv2.ClusterHealth{}, err
+	}
+
+	return r.ClusterHealth, nil
+}
+
+// checkComponents checks that the deployments of the enabled components are ready.
+func checkComponents(ctx context.Context, cli client.Client, reg *cr.Registry, dsc *dscv2.DataScienceCluster, r *healthReport) error {
+	return reg.ForEach(func(component cr.ComponentHandler) error {
+		if !component.IsEnabled(dsc) {
+			return nil
+		}
+
+		deployments := appsv1.DeploymentList{}
+		if err := cli.List(ctx, &deployments, client.MatchingLabels{labels.PlatformPartOf: component.GetName()}); err != nil {
+			return fmt.Errorf("failed to list the deployments of component %s: %w", component.GetName(), err)
+		}
+
+		ready := 0
+		for _, d := range deployments.Items {
+			if d.Status.ReadyReplicas == d.Status.Replicas && d.Status.Replicas != 0 {
+				ready++
+			}
+		}
+
+		switch {
+		case len(deployments.Items) == 0:
+			// not deployed yet, reported by the ComponentsReady condition
+		case ready == 0:
+			r.fail(dscv2.ClusterHealthUnhealthy, "component %s: %d/%d deployments ready", component.GetName(), ready, len(deployments.Items))
+		case ready != len(deployments.Items):
+			r.fail(dscv2.ClusterHealthDegraded, "component %s: %d/%d deployments ready", component.GetName(), ready, len(deployments.Items))
+		}
+
+		return nil
+	})
+}
+
+// checkWebhooks checks that the services of the webhooks of the platform, those served from the
+// operator and applications namespaces, have a ready endpoint.
+func checkWebhooks(ctx context.Context, cli client.Client, r *healthReport) error {
+	namespaces := []string{cluster.GetApplicationNamespace()}
+	if ns, err := cluster.GetOperatorNamespace(); err == nil {
+		namespaces = append(namespaces, ns)
+	}
+
+	// the webhooks failing closed, by service, prevail over those failing open
+	services := make(map[client.ObjectKey]bool)
+
+	add := func(cc admissionregistrationv1.WebhookClientConfig, policy *admissionregistrationv1.FailurePolicyType) {
+		if cc.Service == nil || !slices.Contains(namespaces, cc.Service.Namespace) {
+			return
+		}
+
+		key := client.ObjectKey{Namespace: cc.Service.Namespace, Name: cc.Service.Name}
+		services[key] = services[key] || policy == nil || *policy == admissionregistrationv1.Fail
+	}
+
+	validating := admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := cli.List(ctx, &validating); err != nil {
+		return fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+	}
+
+	for i := range validating.Items {
+		for _, w := range validating.Items[i].Webhooks {
+			add(w.ClientConfig, w.FailurePolicy)
+		}
+	}
+
+	mutating := admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := cli.List(ctx, &mutating); err != nil {
+		return fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)
+	}
+
+	for i := range mutating.Items {
+		for _, w := range mutating.Items[i].Webhooks {
+			add(w.ClientConfig, w.FailurePolicy)
+		}
+	}
+
+	keys := make([]client.ObjectKey, 0, len(services))
+	for k := range services {
+		keys = append(keys, k)
+	}
+
+	slices.SortFunc(keys, func(a client.ObjectKey, b client.ObjectKey) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	for _, k := range keys {
+		endpoints := corev1.Endpoints{}
+
+		err := cli.Get(ctx, k, &endpoints)
+		if err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to get the endpoints of webhook service %s: %w", k, err)
+		}
+
+		if hasReadyAddress(&endpoints) {
+			continue
+		}
+
+		health := dscv2.ClusterHealthDegraded
+		if services[k] {
+			health = dscv2.ClusterHealthUnhealthy
+		}
+
+		r.fail(health, "webhook service %s: no ready endpoint", k)
+	}
+
+	return nil
+}
+
+func hasReadyAddress(endpoints *corev1.Endpoints) bool {
+	for _, s := range endpoints.Subsets {
+		if len(s.Addresses) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkServices checks that the platform services are ready.
+func checkServices(ctx context.Context, cli client.Client, r *healthReport) error {
+	for _, kind := range healthServices {
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+
+		err := cli.List(ctx, &items)
+		switch {
+		case meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+		}
+
+		for i := range items.Items {
+			conds, _, err := unstructured.NestedSlice(items.Items[i].Object, "status", "conditions")
+			if err != nil {
+				return fmt.Errorf("invalid conditions of %s %s: %w", kind.Kind, items.Items[i].GetName(), err)
+			}
+
+			for _, c := range conds {
+				m, ok := c.(map[string]any)
+				if !ok || m["type"] != status.ConditionTypeReady || m["status"] != string(metav1.ConditionFalse) {
+					continue
+				}
+
+				r.fail(dscv2.ClusterHealthDegraded, "service %s %s: %v", kind.Kind, items.Items[i].GetName(), m["message"])
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewHealthHandler returns the handler serving the health of the platform, computed on each
+// request, as JSON: the status code is 503 when the platform is Unhealthy or not installed, 200
+// otherwise.
+func NewHealthHandler(cli client.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		health := dscv2.ClusterHealth{
+			Status: dscv2.ClusterHealthUnhealthy,
+		}
+
+		dsc, err := cluster.GetDSC(ctx, cli)
+		switch {
+		case k8serr.IsNotFound(err):
+			health.FailedChecks = []string{"no DataScienceCluster found"}
+		case err != nil:
+			health.FailedChecks = []string{err.Error()}
+		default:
+			health, err = computeClusterHealth(ctx, cli, cr.DefaultRegistry(), dsc)
+			if err != nil {
+				logf.FromContext(ctx).Error(err, "failed to compute the platform health")
+				health = dscv2.ClusterHealth{Status: dscv2.ClusterHealthUnhealthy, FailedChecks: []string{err.Error()}}
+			}
+		}
+
+		code := http.StatusOK
+		if health.Status == dscv2.ClusterHealthUnhealthy {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+
+		if err := json.NewEncoder(w).Encode(health); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to write the platform health")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+//nolint:testpackage
+package datasciencecluster
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDeployment(name string, component string, replicas int32, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "opendatahub",
+			Labels:    map[string]string{labels.PlatformPartOf: component},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: replicas, ReadyReplicas: ready},
+	}
+}
+
+func newWebhook(name string, service string, policy admissionregistrationv1.FailurePolicyType) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: name + ".opendatahub.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: "opendatahub", Name: service},
+			},
+			FailurePolicy: ptr.To(policy),
+		}},
+	}
+}
+
+func TestComputeClusterHealth(t *testing.T) {
+	reg := &cr.Registry{}
+	reg.Add(&fakeComponent{name: "dashboard", enabled: true})
+	reg.Add(&fakeComponent{name: "kserve", enabled: true})
+	reg.Add(&fakeComponent{name: "ray", enabled: false})
+
+	ready := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready-webhook", Namespace: "opendatahub"},
+		Subsets:    []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}}},
+	}
+
+	tests := []struct {
+		name     string
+		objs     []client.Object
+		expected dscv2.ClusterHealthStatus
+		failed   []string
+	}{
+		{
+			name: "healthy",
+			objs: []client.Object{
+				newDeployment("dashboard", "dashboard", 2, 2),
+				newDeployment("ray", "ray", 1, 0),
+				newWebhook("ready", "ready-webhook", admissionregistrationv1.Fail),
+				ready,
+			},
+			expected: dscv2.ClusterHealthHealthy,
+		},
+		{
+			name: "component partially ready",
+			objs: []client.Object{
+				newDeployment("dashboard", "dashboard", 1, 1),
+				newDeployment("kserve-a", "kserve", 1, 1),
+				newDeployment("kserve-b", "kserve", 1, 0),
+			},
+			expected: dscv2.ClusterHealthDegraded,
+			failed:   []string{"component kserve: 1/2 deployments ready"},
+		},
+		{
+			name: "component not ready",
+			objs: []client.Object{
+				newDeployment("dashboard", "dashboard", 1, 0),
+			},
+			expected: dscv2.ClusterHealthUnhealthy,
+			failed:   []string{"component dashboard: 0/1 deployments ready"},
+		},
+		{
+			name: "webhook failing open unreachable",
+			objs: []client.Object{
+				newWebhook("ignored", "ignored-webhook", admissionregistrationv1.Ignore),
+			},
+			expected: dscv2.ClusterHealthDegraded,
+			failed:   []string{"webhook service opendatahub/ignored-webhook: no ready endpoint"},
+		},
+		{
+			name: "webhook failing closed unreachable",
+			objs: []client.Object{
+				newWebhook("ignored", "webhook", admissionregistrationv1.Ignore),
+				newWebhook("failing", "webhook", admissionregistrationv1.Fail),
+			},
+			expected: dscv2.ClusterHealthUnhealthy,
+			failed:   []string{"webhook service opendatahub/webhook: no ready endpoint"},
+		},
+		{
+			name: "service not ready",
+			objs: []client.Object{
+				&serviceApi.Auth{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceApi.AuthInstanceName},
+					Status: serviceApi.AuthStatus{Status: common.Status{Conditions: []common.Condition{{
+						Type:    status.ConditionTypeReady,
+						Status:  metav1.ConditionFalse,
+						Message: "group sync failed",
+					}}}},
+				},
+			},
+			expected: dscv2.ClusterHealthDegraded,
+			failed:   []string{"service Auth auth: group sync failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(tt.objs...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			health, err := computeClusterHealth(t.Context(), cli, reg, &dscv2.DataScienceCluster{})
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(health.Status).Should(Equal(tt.expected))
+			g.Expect(health.FailedChecks).Should(Equal(tt.failed))
+		})
+	}
+}
+
+func TestHealthHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rec := httptest.NewRecorder()
+	NewHealthHandler(cli).ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, HealthPath, nil))
+
+	health := dscv2.ClusterHealth{}
+	g.Expect(rec.Code).Should(Equal(http.StatusServiceUnavailable))
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &health)).Should(Succeed())
+	g.Expect(health.Status).Should(Equal(dscv2.ClusterHealthUnhealthy))
+	g.Expect(health.FailedChecks).Should(ConsistOf("no DataScienceCluster found"))
+
+	cli, err = fakeclient.New(fakeclient.WithObjects(&dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rec = httptest.NewRecorder()
+	NewHealthHandler(cli).ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, HealthPath, nil))
+
+	g.Expect(rec.Code).Should(Equal(http.StatusOK))
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &health)).Should(Succeed())
+	g.Expect(health.Status).Should(Equal(dscv2.ClusterHealthHealthy))
+}
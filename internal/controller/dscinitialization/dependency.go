@@ -0,0 +1,81 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
+)
+
+// dependencyRequeueAfter is the delay of the next reconciliation while a dependency is being
+// installed, as the ClusterServiceVersions are not watched.
+const dependencyRequeueAfter = 30 * time.Second
+
+// reconcileDependencies observes the third-party operators the components depend on and subscribes
+// to the Managed ones which are missing. It returns the tracked dependencies and the observed states,
+// the dependencies that can not be observed are only logged, so they do not prevent the
+// reconciliation of the DSCInitialization.
+func (r *DSCInitializationReconciler) reconcileDependencies(ctx context.Context, dscInit *dsciv2.DSCInitialization) ([]dependency.Dependency, []dependency.State) {
+	log := logf.FromContext(ctx)
+
+	var dsc *dscv2.DataScienceCluster
+	switch instance, err := cluster.GetDSC(ctx, r.Client); {
+	case err == nil:
+		dsc = instance
+	case !k8serr.IsNotFound(err):
+		log.Error(err, "failed to get the DataScienceCluster, only the dependencies listed in the DSCInitialization are tracked")
+	}
+
+	states, err := dependency.Reconcile(ctx, r.Client, dscInit, dsc)
+	if err != nil {
+		log.Error(err, "failed to reconcile the dependencies")
+	}
+
+	return dependency.Tracked(dscInit, dsc), states
+}
+
+// setDependencyConditions reports the state of each tracked dependency with its condition. The
+// conditions of the dependencies no longer tracked are removed, while the ones of the dependencies
+// that could not be observed are kept as they are.
+func setDependencyConditions(conditions *[]common.Condition, tracked []dependency.Dependency, states []dependency.State) {
+	for _, d := range dependency.All {
+		if !slices.ContainsFunc(tracked, func(t dependency.Dependency) bool { return t.Name == d.Name }) {
+			status.RemoveCondition(conditions, d.ConditionType)
+		}
+	}
+
+	for _, s := range states {
+		d := s.Dependency
+
+		switch s.Phase {
+		case dependency.PhaseReady:
+			status.SetCondition(conditions, d.ConditionType, status.DependencyReadyReason,
+				fmt.Sprintf(status.DependencyReadyMessage, d.DisplayName, s.ClusterServiceVersion), metav1.ConditionTrue)
+		case dependency.PhaseInstalling:
+			status.SetCondition(conditions, d.ConditionType, status.DependencyInstallingReason,
+				fmt.Sprintf(status.DependencyInstallingMessage, d.DisplayName, s.Message), metav1.ConditionFalse)
+		case dependency.PhaseFailed:
+			status.SetCondition(conditions, d.ConditionType, status.DependencyFailedReason,
+				fmt.Sprintf(status.DependencyFailedMessage, d.DisplayName, s.Message), metav1.ConditionFalse)
+		default:
+			status.SetCondition(conditions, d.ConditionType, status.MissingOperatorReason,
+				fmt.Sprintf(status.DependencyMissingMessage, d.DisplayName, d.Name), metav1.ConditionFalse)
+		}
+	}
+}
+
+// dependenciesInstalling returns whether one of the given dependencies is being installed.
+func dependenciesInstalling(states []dependency.State) bool {
+	return slices.ContainsFunc(states, func(s dependency.State) bool { return s.Phase == dependency.PhaseInstalling })
+}
@@ -21,14 +21,17 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,10 +39,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -54,8 +60,11 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	rp "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/bundle"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
@@ -63,6 +72,10 @@ import (
 const (
 	finalizerName = "dscinitialization.opendatahub.io/finalizer"
 	fieldManager  = "dscinitialization.opendatahub.io"
+
+	// DenyByDefaultNetworkPolicyName is the NetworkPolicy of the strict profile denying the
+	// traffic to the applications namespace.
+	DenyByDefaultNetworkPolicyName = "deny-by-default"
 )
 
 // DSCInitializationReconciler reconciles a DSCInitialization object.
@@ -70,6 +83,11 @@ type DSCInitializationReconciler struct {
 	Client   client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// ManifestsSource is the source of the manifests bundle loaded on startup, and
+	// ManifestsVerificationError the reason the bundle was rejected, if any.
+	ManifestsSource            bundle.SourceType
+	ManifestsVerificationError error
 }
 
 // Reconcile contains controller logic specific to DSCInitialization instance updates.
@@ -136,6 +154,21 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, nil
 	}
 
+	// The update of the spec triggers a new reconciliation with the restored spec
+	if rollbackTo := instance.Spec.RollbackTo; rollbackTo != nil {
+		if err := r.rollbackSpec(ctx, instance, *rollbackTo); err != nil {
+			log.Error(err, "Failed to roll back DSCInitialization spec")
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError",
+				"Failed to roll back spec to generation %d", *rollbackTo)
+
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	r.recordSpec(ctx, instance)
+
 	// Start reconciling
 	if instance.Status.Conditions == nil {
 		reason := status.ReconcileInit
@@ -302,8 +335,46 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			return ctrl.Result{}, err
 		}
 
+		// Create the PriorityClasses assigned to the workloads of the components
+		if err = CreatePriorityClasses(ctx, r.Client, instance); err != nil {
+			log.Error(err, "failed to create PriorityClasses")
+			return ctrl.Result{}, err
+		}
+
+		// Report whether the Gateway API ingress layer can be selected
+		gatewayAPI, err := cluster.HasGatewayAPI(ctx, r.Client)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Publish the accelerators detected on the cluster, available to the component templates
+		accelerators, err := cluster.DetectAccelerators(ctx, r.Client)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Publish the architectures of the nodes, the workloads of the components are scheduled on the
+		// nodes of the architectures their images support
+		architectures, err := cluster.DetectArchitectures(ctx, r.Client)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Report the upgrade preflight checks, the components hold their major version upgrades while blocked
+		failures := r.runPreflightChecks(ctx, instance)
+
+		// Report the third-party operators the components depend on, subscribing to the Managed ones
+		tracked, dependencies := r.reconcileDependencies(ctx, instance)
+
 		// Finish reconciling
 		_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv2.DSCInitialization) {
+			setGatewayAPICondition(&saved.Status.Conditions, gatewayAPI)
+			setPreflightCondition(&saved.Status.Conditions, saved.Spec.PreflightPolicy, failures)
+			setFeatureGatesCondition(&saved.Status.Conditions, saved.Spec.FeatureGates)
+			setManifestsVerifiedCondition(&saved.Status.Conditions, r.ManifestsSource, r.ManifestsVerificationError)
+			setDependencyConditions(&saved.Status.Conditions, tracked, dependencies)
+			saved.Status.Accelerators = accelerators
+			saved.Status.Architectures = architectures
 			status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompleted, status.ReconcileCompletedMessage)
 			saved.Status.Phase = status.PhaseReady
 		})
@@ -312,13 +383,19 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "Failed to update DSCInitialization status")
 		}
 
+		if dependenciesInstalling(dependencies) {
+			return ctrl.Result{RequeueAfter: dependencyRequeueAfter}, nil
+		}
+
 		return ctrl.Result{}, nil
 	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
 		// add predicates prevents meaningless reconciliations from being triggered
 		// not use WithEventFilter() because it conflict with secret and configmap predicate
 		For(
@@ -358,9 +435,6 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns(
 			&corev1.Service{},
 			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
-		Owns(
-			&routev1.Route{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
 		Owns(&corev1.PersistentVolumeClaim{},
 			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
 		Owns( // ensure always have default one for AcceleratorProfile/HardwareProfile blocking
@@ -372,6 +446,9 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns( // ensure always have one platform's HardwareProfile in the cluster.
 			&infrav1.HardwareProfile{},
 			builder.WithPredicates(rp.Deleted())).
+		Owns( // recreate the PriorityClasses created for the workloads of the components
+			&schedulingv1.PriorityClass{},
+			builder.WithPredicates(rp.Deleted())).
 		Watches(
 			&dscv2.DataScienceCluster{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
@@ -379,6 +456,20 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 			}),
 			builder.WithPredicates(rp.DSCDeletionPredicate), // TODO: is it needed?
 		).
+		Watches( // track the dependencies required by the components enabled in the DataScienceCluster
+			&dscv2.DataScienceCluster{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+				return r.dsciRequests(ctx)
+			}),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches( // report the dependencies installed or removed
+			&ofapiv2.OperatorCondition{},
+			handler.EnqueueRequestsFromMapFunc(r.watchDependencyResource),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(event.UpdateEvent) bool { return false },
+			}),
+		).
 		Watches(
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.watchMonitoringSecretResource),
@@ -393,6 +484,11 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 			&serviceApi.Auth{},
 			handler.EnqueueRequestsFromMapFunc(r.watchAuthResource),
 		).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.watchNodeResource),
+			builder.WithPredicates(rp.NodeAllocatableChangedPredicate),
+		).
 		Watches( // TODO: this might not be needed after v3.3.
 			&apiextensionsv1.CustomResourceDefinition{},
 			handler.EnqueueRequestsFromMapFunc(r.watchHWProfileCRDResource),
@@ -400,8 +496,16 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 				rp.CreatedOrUpdatedName("acceleratorprofiles.dashboard.opendatahub.io"),
 				rp.CreatedOrUpdatedName("hardwareprofiles.dashboard.opendatahub.io"),
 			)),
-		).
-		Complete(r)
+		)
+
+	// Routes are only served by OpenShift
+	if cluster.IsOpenShift() {
+		b = b.Owns(
+			&routev1.Route{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})))
+	}
+
+	return b.Complete(r)
 }
 
 func (r *DSCInitializationReconciler) watchMonitoringConfigMapResource(ctx context.Context, a client.Object) []reconcile.Request {
@@ -437,7 +541,7 @@ func (r *DSCInitializationReconciler) watchDSCResource(ctx context.Context) []re
 		log.Error(err, "Failed to get DataScienceClusterList")
 		return nil
 	}
-	if len(instanceList.Items) == 0 && !upgrade.HasDeleteConfigMap(ctx, r.Client) {
+	if len(instanceList.Items) == 0 && !upgrade.IsUninstalling(ctx, r.Client) {
 		log.Info("Found no DSC instance in cluster but not in uninstallation process, reset monitoring stack config")
 
 		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "backup"}}}
@@ -462,6 +566,40 @@ func (r *DSCInitializationReconciler) watchAuthResource(ctx context.Context, a c
 	return nil
 }
 
+// watchNodeResource triggers DSCI reconciliation when the allocatable resources of a Node change, to
+// refresh the accelerators detected on the cluster.
+func (r *DSCInitializationReconciler) watchNodeResource(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.dsciRequests(ctx)
+}
+
+// watchDependencyResource triggers DSCI reconciliation when the operator of a dependency is
+// installed or removed.
+func (r *DSCInitializationReconciler) watchDependencyResource(ctx context.Context, a client.Object) []reconcile.Request {
+	for _, d := range dependency.All {
+		if strings.HasPrefix(a.GetName(), d.OperatorPrefix) {
+			return r.dsciRequests(ctx)
+		}
+	}
+
+	return nil
+}
+
+// dsciRequests returns the requests reconciling each DSCInitialization.
+func (r *DSCInitializationReconciler) dsciRequests(ctx context.Context) []reconcile.Request {
+	instanceList := &dsciv2.DSCInitializationList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to get DSCInitializationList")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(instanceList.Items))
+	for _, dsci := range instanceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: dsci.Name}})
+	}
+
+	return requests
+}
+
 func (r *DSCInitializationReconciler) deleteMonitoringCR(ctx context.Context) error {
 	defaultMonitoring := &serviceApi.Monitoring{
 		ObjectMeta: metav1.ObjectMeta{
@@ -493,7 +631,10 @@ func (r *DSCInitializationReconciler) newMonitoringCR(ctx context.Context, dsci
 		},
 	}
 
-	metricsEnabled := dsci.Spec.Monitoring.Metrics != nil && (dsci.Spec.Monitoring.Metrics.Storage != nil || dsci.Spec.Monitoring.Metrics.Resources != nil)
+	metrics := dsci.Spec.Monitoring.Metrics
+	metricsEnabled := metrics != nil && (metrics.Storage != nil || metrics.Resources != nil || len(metrics.RemoteWrite) > 0 ||
+		// the collector alone forwards metrics to the custom exporters
+		(!ptr.Deref(metrics.DeployDefaultStack, true) && len(metrics.Exporters) > 0))
 	tracesEnabled := dsci.Spec.Monitoring.Traces != nil
 
 	if metricsEnabled {
@@ -509,11 +650,24 @@ func (r *DSCInitializationReconciler) newMonitoringCR(ctx context.Context, dsci
 	}
 
 	defaultMonitoring.Spec.Alerting = dsci.Spec.Monitoring.Alerting
-
-	if metricsEnabled || tracesEnabled {
-		if dsci.Spec.Monitoring.CollectorReplicas != 0 {
+	defaultMonitoring.Spec.Dashboards = dsci.Spec.Monitoring.Dashboards
+	defaultMonitoring.Spec.Logs = dsci.Spec.Monitoring.Logs
+	logsEnabled := dsci.Spec.Monitoring.Logs != nil
+
+	defaultMonitoring.Spec.Size = dsci.Spec.Monitoring.Size
+	defaultMonitoring.Spec.CustomResources = dsci.Spec.Monitoring.CustomResources
+	defaultMonitoring.Spec.CommonLabels = dsci.Spec.Monitoring.CommonLabels
+	defaultMonitoring.Spec.RenderOnly = dsci.Spec.Monitoring.RenderOnly
+	// Sizing presets provide their own collector replicas
+	sizePreset := dsci.Spec.Monitoring.Size != "" && dsci.Spec.Monitoring.Size != serviceApi.MonitoringSizeCustom
+
+	if metricsEnabled || tracesEnabled || logsEnabled {
+		switch {
+		case dsci.Spec.Monitoring.CollectorReplicas != 0:
 			defaultMonitoring.Spec.CollectorReplicas = dsci.Spec.Monitoring.CollectorReplicas
-		} else {
+		case sizePreset:
+			// Leave unset so the monitoring controller applies the preset replicas
+		default:
 			isSNO := cluster.IsSingleNodeCluster(ctx, r.Client)
 			if isSNO {
 				defaultMonitoring.Spec.CollectorReplicas = 1
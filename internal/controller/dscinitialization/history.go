@@ -0,0 +1,52 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/history"
+)
+
+// recordSpec records the spec of the current generation of the DSCInitialization in the history.
+// The history is best effort, so a failure is only logged.
+func (r *DSCInitializationReconciler) recordSpec(ctx context.Context, dscInit *dsciv2.DSCInitialization) {
+	ns, err := cluster.GetOperatorNamespace()
+	if err == nil {
+		err = history.Record(ctx, r.Client, ns, dscInit, dscInit.Spec)
+	}
+
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to record DSCInitialization spec")
+	}
+}
+
+// rollbackSpec restores the spec of the DSCInitialization recorded at the given generation, as
+// requested with spec.rollbackTo.
+func (r *DSCInitializationReconciler) rollbackSpec(ctx context.Context, dscInit *dsciv2.DSCInitialization, generation int64) error {
+	ns, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return err
+	}
+
+	spec := dsciv2.DSCInitializationSpec{}
+	if err := history.Get(ctx, r.Client, ns, dscInit, generation, &spec); err != nil {
+		return fmt.Errorf("failed to roll back DSCInitialization spec: %w", err)
+	}
+
+	dscInit.Spec = spec
+	dscInit.Spec.RollbackTo = nil
+
+	if err := r.Client.Update(ctx, dscInit); err != nil {
+		return fmt.Errorf("failed to roll back DSCInitialization spec to generation %d: %w", generation, err)
+	}
+
+	r.Recorder.Eventf(dscInit, corev1.EventTypeNormal, status.SpecRolledBackReason, "Spec rolled back to generation %d", generation)
+
+	return nil
+}
@@ -10,6 +10,8 @@ package dscinitialization
 /* Auth */
 // +kubebuilder:rbac:groups="config.openshift.io",resources=authentications;infrastructures,verbs=get;watch;list
 
+// +kubebuilder:rbac:groups="scheduling.k8s.io",resources=priorityclasses,verbs=get;list;watch;create
+
 // TODO: move to monitoring own file
 // +kubebuilder:rbac:groups="route.openshift.io",resources=routers/metrics,verbs=get
 // +kubebuilder:rbac:groups="route.openshift.io",resources=routers/federate,verbs=get
@@ -39,6 +41,19 @@ package dscinitialization
 // +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=auths/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=auths/finalizers,verbs=update
 
+// OperatorConfig
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=operatorconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=operatorconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=uninstalls,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=uninstalls/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=uninstalls/finalizers,verbs=update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=diagnosticbundles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=diagnosticbundles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=diagnosticbundles/finalizers,verbs=update
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=manifestexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=manifestexports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=manifestexports/finalizers,verbs=update
+
 // Gateway
 // CR management
 // +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=gatewayconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -60,9 +75,13 @@ package dscinitialization
 //+kubebuilder:rbac:groups=perses.dev,resources=persesdatasources,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=perses.dev,resources=persesdatasources/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=perses.dev,resources=persesdatasources/finalizers,verbs=update
+//+kubebuilder:rbac:groups=grafana.integreatly.org,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=servicemonitors/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=servicemonitors/finalizers,verbs=update
+//+kubebuilder:rbac:groups=monitoring.rhobs,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=monitoring.rhobs,resources=podmonitors/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=monitoring.rhobs,resources=podmonitors/finalizers,verbs=update
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=monitoringstacks,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=monitoringstacks/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=monitoring.rhobs,resources=monitoringstacks/finalizers,verbs=update
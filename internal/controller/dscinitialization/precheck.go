@@ -0,0 +1,49 @@
+package dscinitialization
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/precheck"
+)
+
+const preflightChecksPassedMessage = "All the upgrade preflight checks passed"
+
+// runPreflightChecks runs the upgrade preflight checks. The checks that can not be run are only
+// logged, so they do not prevent the reconciliation of the DSCInitialization.
+func (r *DSCInitializationReconciler) runPreflightChecks(ctx context.Context, dscInit *dsciv2.DSCInitialization) []precheck.Failure {
+	failures, err := precheck.Run(ctx, r.Client, dscInit, precheck.DefaultChecks...)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to run the upgrade preflight checks")
+	}
+
+	return failures
+}
+
+// setPreflightCondition reports the failures of the upgrade preflight checks. With the Block policy, a
+// blocking failure marks the upgrade as blocked, so the components hold their major version upgrades.
+func setPreflightCondition(conditions *[]common.Condition, policy common.PreflightPolicy, failures []precheck.Failure) {
+	if len(failures) == 0 {
+		status.SetCondition(conditions, status.ConditionTypePreflightChecksPassed, status.PreflightPassedReason,
+			preflightChecksPassedMessage, metav1.ConditionTrue)
+		return
+	}
+
+	messages := make([]string, 0, len(failures))
+	for _, f := range failures {
+		messages = append(messages, f.String())
+	}
+
+	reason := status.PreflightFailedReason
+	if policy == common.PreflightPolicyBlock && precheck.Blocking(failures) {
+		reason = status.UpgradeBlockedReason
+	}
+
+	status.SetCondition(conditions, status.ConditionTypePreflightChecksPassed, reason, strings.Join(messages, "; "), metav1.ConditionFalse)
+}
@@ -0,0 +1,78 @@
+package dscinitialization
+
+import (
+	"context"
+	"fmt"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+)
+
+const (
+	// ControllersPriority is the priority of the controllers PriorityClass created by the operator.
+	ControllersPriority int32 = 100000
+
+	// WorkloadsPriority is the priority of the workloads PriorityClass created by the operator, above
+	// the priority of 0 of the pods of the users.
+	WorkloadsPriority int32 = 10000
+)
+
+// CreatePriorityClasses creates, when requested in the DSCInitialization, the PriorityClasses
+// assigned to the workloads deployed by the operator which do not exist, owned by the
+// DSCInitialization. The existing PriorityClasses are left as they are.
+func CreatePriorityClasses(ctx context.Context, cli client.Client, dscInit *dsciv2.DSCInitialization) error {
+	pcs := dscInit.Spec.PriorityClasses
+	if pcs == nil || !pcs.Create {
+		return nil
+	}
+
+	classes := []struct {
+		name        string
+		value       int32
+		description string
+	}{
+		{pcs.Controllers, ControllersPriority, "Priority of the controllers deployed by the Open Data Hub operator"},
+		{pcs.Workloads, WorkloadsPriority, "Priority of the workloads deployed by the Open Data Hub operator"},
+	}
+
+	for _, c := range classes {
+		if c.name == "" {
+			continue
+		}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: c.name}, &schedulingv1.PriorityClass{})
+		if !k8serr.IsNotFound(err) {
+			if err != nil {
+				return fmt.Errorf("failed to get PriorityClass %s: %w", c.name, err)
+			}
+
+			continue
+		}
+
+		pc := &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: c.name,
+			},
+			Value:       c.value,
+			Description: c.description,
+		}
+
+		if err := controllerutil.SetControllerReference(dscInit, pc, cli.Scheme()); err != nil {
+			return err
+		}
+
+		if err := cli.Create(ctx, pc); err != nil && !k8serr.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create PriorityClass %s: %w", c.name, err)
+		}
+
+		logf.FromContext(ctx).Info("PriorityClass created", "name", c.name, "value", c.value)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package dscinitialization_test
+
+import (
+	"testing"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCreatePriorityClasses(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	// an existing PriorityClass is left as it is
+	existing := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "odh-workloads"},
+		Value:      42,
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(existing))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dscInit := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci", UID: "dsci-uid"},
+		Spec: dsciv2.DSCInitializationSpec{
+			PriorityClasses: &infrav1.PriorityClassesSpec{
+				Controllers: "odh-controllers",
+				Workloads:   "odh-workloads",
+			},
+		},
+	}
+
+	// nothing is created unless requested
+	g.Expect(dscinitialization.CreatePriorityClasses(ctx, cli, dscInit)).Should(Succeed())
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: "odh-controllers"}, &schedulingv1.PriorityClass{})).ShouldNot(Succeed())
+
+	dscInit.Spec.PriorityClasses.Create = true
+	g.Expect(dscinitialization.CreatePriorityClasses(ctx, cli, dscInit)).Should(Succeed())
+
+	pc := schedulingv1.PriorityClass{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: "odh-controllers"}, &pc)).Should(Succeed())
+	g.Expect(pc.Value).Should(Equal(dscinitialization.ControllersPriority))
+	g.Expect(pc.OwnerReferences).Should(ContainElement(HaveField("Name", dscInit.Name)))
+
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: "odh-workloads"}, &pc)).Should(Succeed())
+	g.Expect(pc.Value).Should(BeNumerically("==", 42))
+	g.Expect(pc.OwnerReferences).Should(BeEmpty())
+
+	// the PriorityClasses created are not created again
+	g.Expect(dscinitialization.CreatePriorityClasses(ctx, cli, dscInit)).Should(Succeed())
+}
@@ -19,8 +19,12 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/bundle"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
@@ -160,6 +164,11 @@ func ReconcileDefaultNetworkPolicy(
 	dscInit *dsciv2.DSCInitialization,
 	platform common.Platform,
 ) error {
+	profile := dscInit.Spec.NetworkPolicyProfile
+	if profile == "" {
+		profile = infrav1.NetworkPolicyProfileBaseline
+	}
+
 	if platform == cluster.ManagedRhoai || platform == cluster.SelfManagedRhoai {
 		log := logf.FromContext(ctx)
 
@@ -184,14 +193,56 @@ func ReconcileDefaultNetworkPolicy(
 			}
 		}
 		// Deploy networkpolicy for applications namespace
-		err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/applications", dscInit.Spec.ApplicationsNamespace, "networkpolicy", true)
-		if err != nil {
-			log.Error(err, "error to set networkpolicy in applications namespace", "path", networkpolicyPath)
+		if profile == infrav1.NetworkPolicyProfileBaseline {
+			err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/applications", dscInit.Spec.ApplicationsNamespace, "networkpolicy", true)
+			if err != nil {
+				log.Error(err, "error to set networkpolicy in applications namespace", "path", networkpolicyPath)
+				return err
+			}
+		}
+	} else if profile == infrav1.NetworkPolicyProfileBaseline {
+		if err := reconcileBaselineNetworkPolicy(ctx, cli, dscInit); err != nil {
 			return err
 		}
+	}
+
+	if profile != infrav1.NetworkPolicyProfileBaseline {
+		if err := deleteBaselineNetworkPolicies(ctx, cli, dscInit.Spec.ApplicationsNamespace); err != nil {
+			return err
+		}
+	}
+
+	if profile != infrav1.NetworkPolicyProfileStrict {
+		np := networkingv1.NetworkPolicy{}
+		np.Name = DenyByDefaultNetworkPolicyName
+		np.Namespace = dscInit.Spec.ApplicationsNamespace
+
+		if err := cli.Delete(ctx, &np); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("unable to delete NetworkPolicy %s: %w", DenyByDefaultNetworkPolicyName, err)
+		}
+
 		return nil
 	}
 
+	// The deny-by-default NetworkPolicy selects all the pods of the applications namespace
+	// without allowing any ingress traffic: the components add the NetworkPolicies allowing
+	// the traffic to the ports of their Services.
+	np := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DenyByDefaultNetworkPolicyName,
+			Namespace: dscInit.Spec.ApplicationsNamespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+			},
+		},
+	}
+
+	return applyNetworkPolicy(ctx, cli, dscInit, &np)
+}
+
+func reconcileBaselineNetworkPolicy(ctx context.Context, cli client.Client, dscInit *dsciv2.DSCInitialization) error {
 	// Expected namespace for the given name in ODH
 	np := networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -212,18 +263,22 @@ func ReconcileDefaultNetworkPolicy(
 		},
 	}
 
-	if err := resources.EnsureGroupVersionKind(cli.Scheme(), &np); err != nil {
+	return applyNetworkPolicy(ctx, cli, dscInit, &np)
+}
+
+func applyNetworkPolicy(ctx context.Context, cli client.Client, dscInit *dsciv2.DSCInitialization, np *networkingv1.NetworkPolicy) error {
+	if err := resources.EnsureGroupVersionKind(cli.Scheme(), np); err != nil {
 		return fmt.Errorf("unable to set GVK to NetworkPolicy: %w", err)
 	}
 
-	if err := controllerutil.SetControllerReference(dscInit, &np, cli.Scheme()); err != nil {
+	if err := controllerutil.SetControllerReference(dscInit, np, cli.Scheme()); err != nil {
 		return fmt.Errorf("unable to add OwnerReference to the Network policy: %w", err)
 	}
 
 	err := resources.Apply(
 		ctx,
 		cli,
-		&np,
+		np,
 		client.FieldOwner(fieldManager),
 		client.ForceOwnership,
 	)
@@ -235,6 +290,28 @@ func ReconcileDefaultNetworkPolicy(
 	return nil
 }
 
+// deleteBaselineNetworkPolicies deletes the NetworkPolicies of the baseline profile from the applications
+// namespace, the one created on Open Data Hub and the ones deployed from the manifests otherwise.
+func deleteBaselineNetworkPolicies(ctx context.Context, cli client.Client, namespace string) error {
+	np := networkingv1.NetworkPolicy{}
+	np.Name = namespace
+	np.Namespace = namespace
+
+	if err := cli.Delete(ctx, &np); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("unable to delete NetworkPolicy %s: %w", namespace, err)
+	}
+
+	err := cli.DeleteAllOf(ctx, &networkingv1.NetworkPolicy{},
+		client.InNamespace(namespace),
+		client.MatchingLabels{labels.ODH.Component("networkpolicy"): labels.True},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to delete the NetworkPolicies of namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
+
 func (r *DSCInitializationReconciler) waitForManagedSecret(ctx context.Context, name string, namespace string) (*corev1.Secret, error) {
 	managedSecret := &corev1.Secret{}
 	backoff := wait.Backoff{
@@ -280,3 +357,39 @@ func createNetworkPolicyPeer(key, value string) []networkingv1.NetworkPolicyPeer
 		},
 	}}
 }
+
+// setGatewayAPICondition reports whether the Gateway API CRDs are installed, which is required
+// to select the gatewayapi ingress type.
+func setGatewayAPICondition(conditions *[]common.Condition, available bool) {
+	if available {
+		status.SetCondition(conditions, status.ConditionGatewayAPIAvailable, status.AvailableReason, status.GatewayAPIAvailableMessage, metav1.ConditionTrue)
+		return
+	}
+
+	status.SetCondition(conditions, status.ConditionGatewayAPIAvailable, status.GatewayAPIMissingReason, status.GatewayAPIMissingMessage, metav1.ConditionFalse)
+}
+
+// setManifestsVerifiedCondition reports the verification of the manifests bundle loaded on startup,
+// a rejected bundle being replaced by the manifests of the operator image.
+func setManifestsVerifiedCondition(conditions *[]common.Condition, source bundle.SourceType, err error) {
+	switch {
+	case source == bundle.SourceBuiltin:
+		status.RemoveCondition(conditions, status.ConditionManifestsVerified)
+	case err != nil:
+		status.SetCondition(conditions, status.ConditionManifestsVerified, status.ManifestsVerificationFailedReason,
+			fmt.Sprintf(status.ManifestsVerificationFailedMessage, err), metav1.ConditionFalse)
+	default:
+		status.SetCondition(conditions, status.ConditionManifestsVerified, status.ManifestsVerifiedReason, status.ManifestsVerifiedMessage, metav1.ConditionTrue)
+	}
+}
+
+// setFeatureGatesCondition reports the feature gates of unknown features and the gates disabling GA
+// features, which are ignored by the components.
+func setFeatureGatesCondition(conditions *[]common.Condition, gates featuregates.Gates) {
+	if err := gates.Validate(); err != nil {
+		status.SetCondition(conditions, status.ConditionFeatureGatesValid, status.InvalidConfigReason, err.Error(), metav1.ConditionFalse)
+		return
+	}
+
+	status.SetCondition(conditions, status.ConditionFeatureGatesValid, status.FeatureGatesValidReason, status.FeatureGatesValidMessage, metav1.ConditionTrue)
+}
@@ -6,13 +6,16 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/rs/xid"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 
@@ -105,3 +108,42 @@ func TestPatchExistingMonitoringNS(t *testing.T) {
 	g.Expect(ns.Labels).To(HaveKeyWithValue(labels.SecurityEnforce, "baseline"))
 	g.Expect(ns.Labels).To(HaveKeyWithValue(labels.ClusterMonitoring, labels.True))
 }
+
+func TestReconcileDefaultNetworkPolicyOpen(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	appsNS := xid.New().String()
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: appsNS, Namespace: appsNS}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: dscinitialization.DenyByDefaultNetworkPolicyName, Namespace: appsNS}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+			Name:      "manifests",
+			Namespace: appsNS,
+			Labels:    map[string]string{labels.ODH.Component("networkpolicy"): labels.True},
+		}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: appsNS}},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dscInit := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsc",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: appsNS,
+			NetworkPolicyProfile:  infrav1.NetworkPolicyProfileOpen,
+		},
+	}
+
+	err = dscinitialization.ReconcileDefaultNetworkPolicy(ctx, cli, dscInit, cluster.OpenDataHub)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	policies := networkingv1.NetworkPolicyList{}
+	g.Expect(cli.List(ctx, &policies, client.InNamespace(appsNS))).Should(Succeed())
+	g.Expect(policies.Items).Should(And(
+		HaveLen(1),
+		HaveEach(HaveField("Name", "user")),
+	))
+}
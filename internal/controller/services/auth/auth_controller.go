@@ -21,15 +21,20 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 )
 
@@ -60,11 +65,28 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		Owns(&rbacv1.ClusterRole{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
+		// the personas role bundles depend on the enabled components
+		// and on the labels of the namespaces they are granted in
+		Watches(&dscv2.DataScienceCluster{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(serviceApi.AuthInstanceName),
+			),
+			reconciler.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(&corev1.Namespace{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(serviceApi.AuthInstanceName),
+			),
+			reconciler.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
 		// actions
 		WithAction(initialize).
 		WithAction(template.NewAction()).
 		WithAction(createDefaultGroup).
+		WithAction(syncGroups).
 		WithAction(managePermissions).
+		WithAction(managePersonas).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
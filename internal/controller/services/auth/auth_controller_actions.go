@@ -25,11 +25,14 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -139,17 +142,25 @@ func managePermissions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 		return errors.New("instance is not of type *services.Auth")
 	}
 
-	err := bindRole(ctx, rr, ai.Spec.AdminGroups, "admingroup-rolebinding", "admingroup-role")
+	adminGroups := ai.Spec.AdminGroups
+	allowedGroups := ai.Spec.AllowedGroups
+
+	if ai.Spec.GroupSync != nil && ai.Status.GroupSync != nil {
+		adminGroups = mergeGroups(adminGroups, ai.Status.GroupSync.AdminGroups)
+		allowedGroups = mergeGroups(allowedGroups, ai.Status.GroupSync.AllowedGroups)
+	}
+
+	err := bindRole(ctx, rr, adminGroups, "admingroup-rolebinding", "admingroup-role")
 	if err != nil {
 		return err
 	}
 
-	err = bindClusterRole(ctx, rr, ai.Spec.AdminGroups, "admingroupcluster-rolebinding", "admingroupcluster-role")
+	err = bindClusterRole(ctx, rr, adminGroups, "admingroupcluster-rolebinding", "admingroupcluster-role")
 	if err != nil {
 		return err
 	}
 
-	err = bindClusterRole(ctx, rr, ai.Spec.AllowedGroups, "allowedgroupcluster-rolebinding", "allowedgroupcluster-role")
+	err = bindClusterRole(ctx, rr, allowedGroups, "allowedgroupcluster-rolebinding", "allowedgroupcluster-role")
 	if err != nil {
 		return err
 	}
@@ -157,6 +168,97 @@ func managePermissions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 	return nil
 }
 
+// syncGroups resolves the admin and allowed groups from the Groups synced from an external identity
+// provider and records them in the status, where managePermissions picks them up. The resolution is
+// repeated periodically, as the Groups are not watched.
+func syncGroups(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	ai, ok := rr.Instance.(*serviceApi.Auth)
+	if !ok {
+		return errors.New("instance is not of type *services.Auth")
+	}
+
+	gs := ai.Spec.GroupSync
+	if gs == nil {
+		ai.Status.GroupSync = nil
+		return nil
+	}
+
+	rr.Requeue(groupSyncInterval(gs))
+
+	adminGroups, err := resolveGroups(ctx, rr.Client, gs.AdminGroupsSelector)
+	if err != nil {
+		return groupSyncFailed(ctx, rr, err)
+	}
+
+	allowedGroups, err := resolveGroups(ctx, rr.Client, gs.AllowedGroupsSelector)
+	if err != nil {
+		return groupSyncFailed(ctx, rr, err)
+	}
+
+	ai.Status.GroupSync = &serviceApi.GroupSyncStatus{
+		AdminGroups:   adminGroups,
+		AllowedGroups: allowedGroups,
+		LastSyncTime:  ptr.To(metav1.Now()),
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionTypeGroupsSynced,
+		conditions.WithMessage("Resolved %d admin and %d allowed groups", len(adminGroups), len(allowedGroups)),
+	)
+
+	return nil
+}
+
+// groupSyncFailed reports a failed group sync, keeping the groups resolved by the last successful
+// one so a transient failure does not revoke the access granted to their members.
+func groupSyncFailed(ctx context.Context, rr *odhtypes.ReconciliationRequest, err error) error {
+	logf.FromContext(ctx).Error(err, "group sync failed")
+
+	rr.Conditions.MarkFalse(
+		status.ConditionTypeGroupsSynced,
+		conditions.WithReason(status.GroupSyncFailedReason),
+		conditions.WithMessage("Failed to resolve the synced groups: %v", err),
+	)
+
+	return nil
+}
+
+// managePersonas generates the role bundle of each persona set in the spec: a ClusterRole aggregating
+// the ClusterRoles generated for each enabled component, bound to the persona groups either cluster-wide
+// or in the selected namespaces. The resources of the role bundles no longer desired are deleted.
+func managePersonas(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	ai, ok := rr.Instance.(*serviceApi.Auth)
+	if !ok {
+		return errors.New("instance is not of type *services.Auth")
+	}
+
+	desired := make(map[string]struct{})
+
+	if len(ai.Spec.Personas) != 0 {
+		components, err := personaComponents(ctx, rr.Client)
+		if err != nil {
+			return err
+		}
+
+		for _, pb := range ai.Spec.Personas {
+			objs, err := personaResources(ctx, rr.Client, pb, components)
+			if err != nil {
+				return fmt.Errorf("unable to generate the role bundle of persona %s: %w", pb.Persona, err)
+			}
+
+			for _, obj := range objs {
+				if err := rr.AddResources(obj); err != nil {
+					return fmt.Errorf("unable to add the role bundle of persona %s: %w", pb.Persona, err)
+				}
+
+				desired[personaResourceKey(obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName())] = struct{}{}
+			}
+		}
+	}
+
+	return deleteStalePersonaResources(ctx, rr, desired)
+}
+
 func addUserGroup(ctx context.Context, rr *odhtypes.ReconciliationRequest, userGroupName string) error {
 	namespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
 	if err != nil {
@@ -3,20 +3,30 @@ package auth
 
 import (
 	"testing"
+	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	userv1 "github.com/openshift/api/user/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 
 	. "github.com/onsi/gomega"
 )
@@ -258,3 +268,192 @@ func TestCreateDefaultGroupBasic(t *testing.T) {
 	err := createDefaultGroup(ctx, rr)
 	g.Expect(err).ToNot(HaveOccurred(), "Should handle group creation without error")
 }
+
+// TestSyncGroups validates the resolution of the admin and allowed groups from the
+// Groups synced from an external identity provider, and their binding along with the
+// groups listed in the spec.
+func TestSyncGroups(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	fakeClient := setupTestClient(g, false)
+
+	for name, labels := range map[string]map[string]string{
+		"ldap-admins": {"openshift.io/ldap.host": "ldap.example.com", "role": "admin"},
+		"ldap-users":  {"openshift.io/ldap.host": "ldap.example.com"},
+		"local":       nil,
+	} {
+		group := &userv1.Group{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Users:      []string{},
+		}
+		g.Expect(fakeClient.Create(ctx, group)).Should(Succeed())
+	}
+
+	auth := &serviceApi.Auth{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "auth",
+		},
+		Spec: serviceApi.AuthSpec{
+			AdminGroups:   []string{"admin1", "ldap-admins"},
+			AllowedGroups: []string{"user1"},
+			GroupSync: &serviceApi.GroupSyncSpec{
+				AdminGroupsSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"role": "admin"},
+				},
+				AllowedGroupsSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "openshift.io/ldap.host",
+						Operator: metav1.LabelSelectorOpExists,
+					}},
+				},
+			},
+		},
+	}
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client:     fakeClient,
+		Instance:   auth,
+		Conditions: conditions.NewManager(auth, status.ConditionTypeReady),
+		Resources:  []unstructured.Unstructured{},
+	}
+
+	g.Expect(syncGroups(ctx, rr)).Should(Succeed())
+	g.Expect(rr.RequeueAfter).Should(Equal(DefaultGroupSyncInterval))
+	g.Expect(auth.Status.GroupSync).ShouldNot(BeNil())
+	g.Expect(auth.Status.GroupSync.AdminGroups).Should(Equal([]string{"ldap-admins"}))
+	g.Expect(auth.Status.GroupSync.AllowedGroups).Should(Equal([]string{"ldap-admins", "ldap-users"}))
+	g.Expect(auth.Status.GroupSync.LastSyncTime).ShouldNot(BeNil())
+	g.Expect(rr.Conditions.GetCondition(status.ConditionTypeGroupsSynced)).Should(
+		HaveField("Status", metav1.ConditionTrue),
+	)
+
+	g.Expect(managePermissions(ctx, rr)).Should(Succeed())
+
+	subjects := make(map[string][]string)
+	for _, res := range rr.Resources {
+		items, _, err := unstructured.NestedSlice(res.Object, "subjects")
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		for _, item := range items {
+			subject, ok := item.(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			subjects[res.GetName()] = append(subjects[res.GetName()], subject["name"].(string))
+		}
+	}
+
+	g.Expect(subjects).Should(HaveKeyWithValue("admingroup-rolebinding", []string{"admin1", "ldap-admins"}))
+	g.Expect(subjects).Should(HaveKeyWithValue("allowedgroupcluster-rolebinding", []string{"user1", "ldap-admins", "ldap-users"}))
+
+	// an invalid selector keeps the groups resolved by the last sync
+	auth.Spec.GroupSync.Interval = &metav1.Duration{Duration: time.Minute}
+	auth.Spec.GroupSync.AdminGroupsSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "role", Operator: "Unknown"}},
+	}
+
+	rr.RequeueAfter = 0
+	g.Expect(syncGroups(ctx, rr)).Should(Succeed())
+	g.Expect(rr.RequeueAfter).Should(Equal(time.Minute))
+	g.Expect(auth.Status.GroupSync.AdminGroups).Should(Equal([]string{"ldap-admins"}))
+	g.Expect(rr.Conditions.GetCondition(status.ConditionTypeGroupsSynced)).Should(And(
+		HaveField("Status", metav1.ConditionFalse),
+		HaveField("Reason", status.GroupSyncFailedReason),
+	))
+
+	// removing the group sync clears the resolved groups
+	auth.Spec.GroupSync = nil
+	g.Expect(syncGroups(ctx, rr)).Should(Succeed())
+	g.Expect(auth.Status.GroupSync).Should(BeNil())
+}
+
+func TestPersonaResources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	objs, err := personaResources(ctx, cli, serviceApi.PersonaBinding{
+		Persona:           serviceApi.PersonaDataScientist,
+		Groups:            []string{"data-scientists"},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+	}, []string{componentApi.DashboardComponentName, componentApi.KserveComponentName})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(objs).Should(HaveLen(4))
+
+	aggregated, ok := objs[0].(*rbacv1.ClusterRole)
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(aggregated.Name).Should(Equal("odh-persona-data-scientist"))
+	g.Expect(aggregated.AggregationRule.ClusterRoleSelectors).Should(ConsistOf(metav1.LabelSelector{
+		MatchLabels: map[string]string{AggregateToPersonaLabelPrefix + "data-scientist": "true"},
+	}))
+
+	g.Expect(objs[1].GetName()).Should(Equal("odh-persona-data-scientist-" + componentApi.DashboardComponentName))
+	g.Expect(objs[2].GetName()).Should(Equal("odh-persona-data-scientist-" + componentApi.KserveComponentName))
+	g.Expect(objs[2].GetLabels()).Should(HaveKeyWithValue(AggregateToPersonaLabelPrefix+"data-scientist", "true"))
+
+	rb, ok := objs[3].(*rbacv1.RoleBinding)
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(rb.Namespace).Should(Equal("team-a"))
+	g.Expect(rb.RoleRef.Name).Should(Equal("odh-persona-data-scientist"))
+	g.Expect(rb.Subjects).Should(ConsistOf(HaveField("Name", "data-scientists")))
+}
+
+func TestManagePersonas(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	stale := &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "odh-persona-ml-engineer",
+			Labels: map[string]string{PersonaLabel: string(serviceApi.PersonaMLEngineer)},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(stale.DeepCopy()))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dyn := dynamicfake.NewSimpleDynamicClient(cli.Scheme(), stale.DeepCopy())
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: cli,
+		Instance: &serviceApi.Auth{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.AuthInstanceName},
+			Spec: serviceApi.AuthSpec{
+				Personas: []serviceApi.PersonaBinding{{
+					Persona: serviceApi.PersonaPlatformAdmin,
+					Groups:  []string{"platform-admins"},
+				}},
+			},
+		},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("GetDynamicClient").Return(dyn)
+		}),
+	}
+
+	g.Expect(managePersonas(ctx, rr)).Should(Succeed())
+
+	g.Expect(rr.Resources).Should(And(
+		HaveLen(3),
+		ContainElement(jq.Match(`.kind == "ClusterRole" and .metadata.name == "odh-persona-platform-admin"`)),
+		ContainElement(jq.Match(`.kind == "ClusterRole" and .metadata.name == "odh-persona-platform-admin-platform"`)),
+		ContainElement(jq.Match(`.kind == "ClusterRoleBinding" and .subjects[0].name == "platform-admins"`)),
+	))
+
+	err = cli.Get(ctx, client.ObjectKeyFromObject(stale), &rbacv1.ClusterRoleBinding{})
+	g.Expect(k8serr.IsNotFound(err)).Should(BeTrue())
+}
@@ -1,14 +1,383 @@
 package auth
 
 import (
+	"context"
 	"embed"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	userv1 "github.com/openshift/api/user/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 const (
 	AdminGroupRoleTemplate          = "resources/admingroup-role.tmpl.yaml"
 	AdminGroupClusterRoleTemplate   = "resources/admingroup-clusterrole.tmpl.yaml"
 	AllowedGroupClusterRoleTemplate = "resources/allowedgroup-clusterrole.tmpl.yaml"
+
+	// DefaultGroupSyncInterval is the interval between two resolutions of the synced groups.
+	DefaultGroupSyncInterval = 10 * time.Minute
 )
 
 //go:embed resources
 var resourcesFS embed.FS
+
+func groupSyncInterval(gs *serviceApi.GroupSyncSpec) time.Duration {
+	if gs.Interval == nil || gs.Interval.Duration <= 0 {
+		return DefaultGroupSyncInterval
+	}
+
+	return gs.Interval.Duration
+}
+
+// resolveGroups returns the sorted names of the Groups matching the given selector,
+// none if the selector is not set.
+func resolveGroups(ctx context.Context, cli client.Client, ls *metav1.LabelSelector) ([]string, error) {
+	if ls == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group selector: %w", err)
+	}
+
+	groups := userv1.GroupList{}
+	if err := cli.List(ctx, &groups, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	names := make([]string, 0, len(groups.Items))
+	for _, g := range groups.Items {
+		names = append(names, g.Name)
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// mergeGroups appends the synced groups to the ones listed in the spec, skipping duplicates.
+func mergeGroups(groups []string, synced []string) []string {
+	merged := slices.Clone(groups)
+	for _, g := range synced {
+		if !slices.Contains(merged, g) {
+			merged = append(merged, g)
+		}
+	}
+
+	return merged
+}
+
+const (
+	// PersonaLabel marks the resources making up the role bundle of a persona.
+	PersonaLabel = "opendatahub.io/persona"
+	// AggregateToPersonaLabelPrefix, followed by the persona name, marks the ClusterRoles aggregated
+	// into the ClusterRole of a persona.
+	AggregateToPersonaLabelPrefix = "opendatahub.io/aggregate-to-persona-"
+)
+
+var (
+	personaReadVerbs  = []string{"get", "list", "watch"}
+	personaEditVerbs  = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+	personaAdminVerbs = []string{"*"}
+)
+
+// componentAPIs groups the APIs of a component by usage: the workloads run by the users,
+// and the configurations those workloads rely on.
+type componentAPIs struct {
+	workloads []rbacv1.PolicyRule
+	configs   []rbacv1.PolicyRule
+}
+
+// personaComponentAPIs lists, for each component, the APIs the personas are granted access to.
+var personaComponentAPIs = map[string]componentAPIs{
+	componentApi.DashboardComponentName: {
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"infrastructure.opendatahub.io"}, Resources: []string{"hardwareprofiles"}},
+		},
+	},
+	componentApi.WorkbenchesComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"kubeflow.org"}, Resources: []string{"notebooks"}},
+		},
+	},
+	componentApi.KserveComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"serving.kserve.io"}, Resources: []string{"inferenceservices", "llminferenceservices"}},
+		},
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"serving.kserve.io"}, Resources: []string{"servingruntimes", "inferencegraphs"}},
+		},
+	},
+	componentApi.DataSciencePipelinesComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"pipelines.kubeflow.org"}, Resources: []string{"pipelines", "pipelineversions"}},
+			{APIGroups: []string{"argoproj.io"}, Resources: []string{"workflows"}},
+		},
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"datasciencepipelinesapplications.opendatahub.io"}, Resources: []string{"datasciencepipelinesapplications"}},
+		},
+	},
+	componentApi.RayComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"ray.io"}, Resources: []string{"rayclusters", "rayjobs", "rayservices"}},
+		},
+	},
+	componentApi.TrainingOperatorComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"kubeflow.org"}, Resources: []string{"pytorchjobs", "tfjobs", "mpijobs", "xgboostjobs", "paddlejobs", "jaxjobs"}},
+		},
+	},
+	componentApi.KueueComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"kueue.x-k8s.io"}, Resources: []string{"workloads"}},
+		},
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"kueue.x-k8s.io"}, Resources: []string{"localqueues"}},
+		},
+	},
+	componentApi.ModelRegistryComponentName: {
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"modelregistry.opendatahub.io"}, Resources: []string{"modelregistries"}},
+		},
+	},
+	componentApi.TrustyAIComponentName: {
+		workloads: []rbacv1.PolicyRule{
+			{APIGroups: []string{"trustyai.opendatahub.io"}, Resources: []string{"lmevaljobs"}},
+		},
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"trustyai.opendatahub.io"}, Resources: []string{"trustyaiservices", "guardrailsorchestrators"}},
+		},
+	},
+	componentApi.FeastOperatorComponentName: {
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"feast.dev"}, Resources: []string{"featurestores"}},
+		},
+	},
+	componentApi.LlamaStackOperatorComponentName: {
+		configs: []rbacv1.PolicyRule{
+			{APIGroups: []string{"llamastack.io"}, Resources: []string{"llamastackdistributions"}},
+		},
+	},
+}
+
+// platformAdminRules are granted to the platform-admin persona regardless of the enabled components.
+var platformAdminRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"datasciencecluster.opendatahub.io"},
+		Resources: []string{"datascienceclusters"},
+		Verbs:     []string{"get", "list", "watch", "update", "patch"},
+	},
+	{
+		APIGroups: []string{"components.platform.opendatahub.io"},
+		Resources: []string{"*"},
+		Verbs:     []string{"get", "list", "watch", "update", "patch"},
+	},
+}
+
+// personaRules returns the rules granted to a persona on the APIs of a component.
+func personaRules(persona serviceApi.Persona, apis componentAPIs) []rbacv1.PolicyRule {
+	workloadVerbs, configVerbs := personaEditVerbs, personaReadVerbs
+
+	switch persona {
+	case serviceApi.PersonaMLEngineer:
+		configVerbs = personaEditVerbs
+	case serviceApi.PersonaPlatformAdmin:
+		workloadVerbs, configVerbs = personaAdminVerbs, personaAdminVerbs
+	}
+
+	rules := make([]rbacv1.PolicyRule, 0, len(apis.workloads)+len(apis.configs))
+	for _, r := range apis.workloads {
+		r.Verbs = workloadVerbs
+		rules = append(rules, r)
+	}
+	for _, r := range apis.configs {
+		r.Verbs = configVerbs
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+func personaRoleName(persona serviceApi.Persona) string {
+	return "odh-persona-" + string(persona)
+}
+
+func personaResourceKey(kind string, namespace string, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// personaComponents returns the sorted names of the enabled components the personas are granted access to.
+func personaComponents(ctx context.Context, cli client.Client) ([]string, error) {
+	dsc, err := cluster.GetDSC(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DataScienceCluster: %w", err)
+	}
+
+	components := make([]string, 0, len(personaComponentAPIs))
+	for _, name := range slices.Sorted(maps.Keys(personaComponentAPIs)) {
+		if cr.IsComponentEnabled(name, dsc) {
+			components = append(components, name)
+		}
+	}
+
+	return components, nil
+}
+
+// personaResources returns the resources making up the role bundle of a persona.
+func personaResources(
+	ctx context.Context,
+	cli client.Client,
+	pb serviceApi.PersonaBinding,
+	components []string,
+) ([]client.Object, error) {
+	roleName := personaRoleName(pb.Persona)
+	aggregateLabel := AggregateToPersonaLabelPrefix + string(pb.Persona)
+
+	objs := []client.Object{
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   roleName,
+				Labels: map[string]string{PersonaLabel: string(pb.Persona)},
+			},
+			AggregationRule: &rbacv1.AggregationRule{
+				ClusterRoleSelectors: []metav1.LabelSelector{{
+					MatchLabels: map[string]string{aggregateLabel: labels.True},
+				}},
+			},
+		},
+	}
+
+	aggregated := make(map[string][]rbacv1.PolicyRule, len(components)+1)
+	for _, name := range components {
+		if rules := personaRules(pb.Persona, personaComponentAPIs[name]); len(rules) != 0 {
+			aggregated[name] = rules
+		}
+	}
+	if pb.Persona == serviceApi.PersonaPlatformAdmin {
+		aggregated["platform"] = platformAdminRules
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(aggregated)) {
+		objs = append(objs, &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: roleName + "-" + name,
+				Labels: map[string]string{
+					PersonaLabel:   string(pb.Persona),
+					aggregateLabel: labels.True,
+				},
+			},
+			Rules: aggregated[name],
+		})
+	}
+
+	subjects := make([]rbacv1.Subject, 0, len(pb.Groups))
+	for _, g := range pb.Groups {
+		subjects = append(subjects, rbacv1.Subject{
+			Kind:     gvk.Group.Kind,
+			APIGroup: gvk.Group.Group,
+			Name:     g,
+		})
+	}
+
+	roleRef := rbacv1.RoleRef{
+		APIGroup: gvk.ClusterRole.Group,
+		Kind:     gvk.ClusterRole.Kind,
+		Name:     roleName,
+	}
+
+	if pb.NamespaceSelector == nil {
+		objs = append(objs, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   roleName,
+				Labels: map[string]string{PersonaLabel: string(pb.Persona)},
+			},
+			Subjects: subjects,
+			RoleRef:  roleRef,
+		})
+
+		return objs, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pb.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := cli.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	for _, ns := range namespaces.Items {
+		if !cluster.IsActiveNamespace(&ns) {
+			continue
+		}
+
+		objs = append(objs, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName,
+				Namespace: ns.Name,
+				Labels:    map[string]string{PersonaLabel: string(pb.Persona)},
+			},
+			Subjects: subjects,
+			RoleRef:  roleRef,
+		})
+	}
+
+	return objs, nil
+}
+
+// deleteStalePersonaResources deletes the resources of the personas role bundles that are not desired
+// anymore. RoleBindings may live in any namespace, so they are listed bypassing the cache.
+func deleteStalePersonaResources(ctx context.Context, rr *odhtypes.ReconciliationRequest, desired map[string]struct{}) error {
+	lo := metav1.ListOptions{
+		LabelSelector: PersonaLabel,
+	}
+
+	for _, res := range []schema.GroupVersionResource{
+		rbacv1.SchemeGroupVersion.WithResource("clusterroles"),
+		rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings"),
+		rbacv1.SchemeGroupVersion.WithResource("rolebindings"),
+	} {
+		items, err := rr.Controller.GetDynamicClient().Resource(res).Namespace("").List(ctx, lo)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", res.Resource, err)
+		}
+
+		for i := range items.Items {
+			obj := &items.Items[i]
+			if _, ok := desired[personaResourceKey(obj.GetKind(), obj.GetNamespace(), obj.GetName())]; ok {
+				continue
+			}
+
+			logf.FromContext(ctx).Info("deleting stale persona resource", "kind", obj.GetKind(), "namespace", obj.GetNamespace(), "name", obj.GetName())
+
+			if err := rr.Client.Delete(ctx, obj); err != nil && !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
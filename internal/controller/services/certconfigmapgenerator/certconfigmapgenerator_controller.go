@@ -15,7 +15,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	respredicates "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	odhlabels "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -96,6 +99,8 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 	r.certClient = targetClient
 
 	b := ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
 		Named("cert-configmap-generator-controller")
 
 	//
@@ -115,7 +120,11 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 			mgr.GetCache(),
 			&corev1.Namespace{},
 			handlers.RequestFromObject(),
-			respredicates.AnnotationChanged(annotation.InjectionOfCABundleAnnotatoion),
+			predicate.Or(
+				respredicates.AnnotationChanged(annotation.InjectionOfCABundleAnnotatoion),
+				// the labels may change whether the namespace is matched by the namespaceSelector
+				predicate.LabelChangedPredicate{},
+			),
 		),
 	)
 
@@ -152,18 +161,33 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 		source.TypedKind[client.Object, ctrl.Request](
 			mgr.GetCache(),
 			&dsciv2.DSCInitialization{},
-			dsciEventHandler(r.sharedClient),
+			dsciEventHandler(r.sharedClient, targetCache),
 			dsciPredicates(r.sharedClient),
 		),
 	)
 
+	//
+	// CA bundle sources
+	//
+	for _, obj := range []client.Object{&corev1.ConfigMap{}, &corev1.Secret{}} {
+		b = b.WatchesRawSource(
+			// The ConfigMaps and Secrets holding additional CA certificates live in the applications
+			// namespace, which is already cached by the manager's shared cache.
+			source.TypedKind[client.Object, ctrl.Request](
+				mgr.GetCache(),
+				obj,
+				caBundleSourceEventHandler(r.sharedClient, targetCache),
+			),
+		)
+	}
+
 	return b.Complete(
 		reconcile.AsReconciler[*corev1.Namespace](r.sharedClient, &r),
 	)
 }
 
 // Reconcile will generate new configmap, odh-trusted-ca-bundle, that includes cluster-wide
-// trusted-ca bundle and custom ca bundle in every new namespace created.
+// trusted-ca bundle, custom ca bundle and the ca bundle sources in every new namespace created.
 func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *corev1.Namespace) (ctrl.Result, error) {
 	l := logf.FromContext(ctx)
 
@@ -185,6 +209,11 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 		return ctrl.Result{}, fmt.Errorf("failed to retrieve DSCInitialization: %w", err)
 	}
 
+	selected, err := IsSelectedNamespace(dsci, ns)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	switch {
 	case dsci.Spec.TrustedCABundle == nil:
 		l.Info("Trusted CA Bundle is not configured in DSCI, skip CA bundle injection and delete existing configmap")
@@ -200,6 +229,13 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
 		}
 
+	case !selected:
+		l.Info("Namespace is not selected for CA bundle injection, deleting it")
+
+		if err := DeleteOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
+		}
+
 	case dsci.Spec.TrustedCABundle.ManagementState == operatorv1.Removed:
 		l.Info("Trusted CA Bundle injection is set to `Removed` state, skip CA bundle injection and delete existing configmap")
 
@@ -213,7 +249,12 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 	default:
 		l.Info("Adding CA bundle configmap")
 
-		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, dsci.Spec.TrustedCABundle.CustomCABundle); err != nil {
+		caBundle, err := CABundle(ctx, r.sharedClient, dsci)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error reading CA bundle sources: %w", err)
+		}
+
+		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, caBundle); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error adding configmap to namespace: %w", err)
 		}
 	}
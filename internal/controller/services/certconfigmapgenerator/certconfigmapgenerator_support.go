@@ -2,21 +2,30 @@
 package certconfigmapgenerator
 
 import (
+	"bytes"
 	"context"
+	"encoding/pem"
+	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -73,6 +82,141 @@ func CreateOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, n
 	return nil
 }
 
+// CABundle returns the content of the odh-trusted-ca-bundle ConfigMaps .data.odh-ca-bundle.crt: the custom
+// CA bundle merged with the CA certificates held by the sources set in the DSCInitialization.
+func CABundle(ctx context.Context, cli client.Client, dsci *dsciv2.DSCInitialization) (string, error) {
+	if dsci.Spec.TrustedCABundle == nil {
+		return "", nil
+	}
+
+	bundles := []string{dsci.Spec.TrustedCABundle.CustomCABundle}
+
+	for _, src := range dsci.Spec.TrustedCABundle.Sources {
+		data, err := readCABundleSource(ctx, cli, dsci.Spec.ApplicationsNamespace, src)
+		if err != nil {
+			return "", err
+		}
+
+		bundles = append(bundles, data)
+	}
+
+	return MergeCABundles(bundles...), nil
+}
+
+// MergeCABundles concatenates the PEM encoded certificates of the given bundles, dropping duplicates.
+// Bundles not holding any PEM block are kept as they are.
+func MergeCABundles(bundles ...string) string {
+	seen := make(map[string]struct{})
+	parts := make([]string, 0, len(bundles))
+
+	for _, bundle := range bundles {
+		bundle = strings.TrimSpace(bundle)
+		if bundle == "" {
+			continue
+		}
+
+		rest := []byte(bundle)
+		found := false
+
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+
+			found = true
+
+			key := block.Type + string(block.Bytes)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			parts = append(parts, string(bytes.TrimSpace(pem.EncodeToMemory(block))))
+		}
+
+		if !found {
+			if _, ok := seen[bundle]; ok {
+				continue
+			}
+
+			seen[bundle] = struct{}{}
+			parts = append(parts, bundle)
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+func readCABundleSource(ctx context.Context, cli client.Client, namespace string, src common.CABundleSource) (string, error) {
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+
+		cm := corev1.ConfigMap{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &cm)
+		switch {
+		case k8serr.IsNotFound(err) && ptr.Deref(ref.Optional, false):
+			return "", nil
+		case err != nil:
+			return "", fmt.Errorf("failed to get CA bundle ConfigMap %s/%s: %w", namespace, ref.Name, err)
+		}
+
+		data, ok := cm.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return "", fmt.Errorf("key %s not found in CA bundle ConfigMap %s/%s", ref.Key, namespace, ref.Name)
+		}
+
+		return data, nil
+
+	case src.SecretKeyRef != nil:
+		ref := src.SecretKeyRef
+
+		secret := corev1.Secret{}
+		err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &secret)
+		switch {
+		case k8serr.IsNotFound(err) && ptr.Deref(ref.Optional, false):
+			return "", nil
+		case err != nil:
+			return "", fmt.Errorf("failed to get CA bundle Secret %s/%s: %w", namespace, ref.Name, err)
+		}
+
+		data, ok := secret.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return "", fmt.Errorf("key %s not found in CA bundle Secret %s/%s", ref.Key, namespace, ref.Name)
+		}
+
+		return string(data), nil
+
+	default:
+		return "", nil
+	}
+}
+
+// isCABundleSource returns true if the given ConfigMap or Secret is one of the CA bundle sources
+// set in the DSCInitialization.
+func isCABundleSource(dsci *dsciv2.DSCInitialization, obj client.Object) bool {
+	if dsci.Spec.TrustedCABundle == nil || obj.GetNamespace() != dsci.Spec.ApplicationsNamespace {
+		return false
+	}
+
+	for _, src := range dsci.Spec.TrustedCABundle.Sources {
+		switch obj.(type) {
+		case *corev1.ConfigMap:
+			if src.ConfigMapKeyRef != nil && src.ConfigMapKeyRef.Name == obj.GetName() {
+				return true
+			}
+		case *corev1.Secret:
+			if src.SecretKeyRef != nil && src.SecretKeyRef.Name == obj.GetName() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func DeleteOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, namespace string) error {
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -103,44 +247,112 @@ func ShouldInjectTrustedCABundle(obj client.Object) bool {
 }
 
 // dsciEventHandler creates an event handler for DSCInitialization events. When a DSCInitialization
-// resource changes, this handler enqueues reconciliation requests for all namespaces in the cluster,
-// allowing the controller to update CA Bundle configuration across all namespaces.
+// resource changes, this handler enqueues reconciliation requests for the namespaces selected by its
+// TrustedCABundle configuration and for the ones already holding the CA bundle ConfigMap, allowing the
+// controller to update or remove the CA Bundle configuration without visiting every namespace.
 //
 // Parameters:
 //   - cli: Kubernetes client used to list namespaces
+//   - certReader: Reader of the CA bundle ConfigMaps metadata
 //
 // Returns:
 //   - handler.EventHandler: Event handler that maps DSCInitialization events to namespace reconcile requests
-func dsciEventHandler(cli client.Client) handler.EventHandler {
+func dsciEventHandler(cli client.Client, certReader client.Reader) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
-		requests := make([]reconcile.Request, 0)
+		dsci, ok := obj.(*dsciv2.DSCInitialization)
+		if !ok {
+			return []reconcile.Request{}
+		}
+
+		return namespaceRequests(ctx, cli, certReader, dsci)
+	})
+}
 
-		lo := client.ListOptions{
-			Limit: NSListLimit,
+// caBundleSourceEventHandler creates an event handler for the ConfigMaps and Secrets events. When
+// one of the CA bundle sources set in the DSCInitialization changes, this handler enqueues reconciliation
+// requests for the selected namespaces, so the merged CA bundle is propagated.
+func caBundleSourceEventHandler(cli client.Client, certReader client.Reader) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		dsci, err := cluster.GetDSCI(ctx, cli)
+		if err != nil || !isCABundleSource(dsci, obj) {
+			return []reconcile.Request{}
 		}
 
-		for {
-			namespaces := corev1.NamespaceList{}
+		return namespaceRequests(ctx, cli, certReader, dsci)
+	})
+}
 
-			if err := cli.List(ctx, &namespaces, &lo); err != nil {
+// IsSelectedNamespace returns true if the CA bundle ConfigMap should be added to the given namespace
+// according to the namespaceSelector and the excludedNamespaces set in the DSCInitialization.
+func IsSelectedNamespace(dsci *dsciv2.DSCInitialization, ns *corev1.Namespace) (bool, error) {
+	spec := dsci.Spec.TrustedCABundle
+	if spec == nil || slices.Contains(spec.ExcludedNamespaces, ns.Name) {
+		return false, nil
+	}
+
+	if spec.NamespaceSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid trusted CA bundle namespace selector: %w", err)
+	}
+
+	return selector.Matches(k8slabels.Set(ns.GetLabels())), nil
+}
+
+// namespaceRequests returns the reconcile requests for the namespaces the CA bundle ConfigMap must be
+// added to, and for the ones already holding it, so it can be removed from the ones no longer selected.
+func namespaceRequests(ctx context.Context, cli client.Client, certReader client.Reader, dsci *dsciv2.DSCInitialization) []reconcile.Request {
+	requests := make([]reconcile.Request, 0)
+
+	configMaps := metav1.PartialObjectMetadataList{}
+	configMaps.SetGroupVersionKind(gvk.ConfigMap.GroupVersion().WithKind(gvk.ConfigMap.Kind + "List"))
+
+	if err := certReader.List(ctx, &configMaps); err != nil {
+		return []reconcile.Request{}
+	}
+
+	holders := make(map[string]struct{}, len(configMaps.Items))
+	for _, cm := range configMaps.Items {
+		holders[cm.Namespace] = struct{}{}
+	}
+
+	lo := client.ListOptions{
+		Limit: NSListLimit,
+	}
+
+	for {
+		namespaces := corev1.NamespaceList{}
+
+		if err := cli.List(ctx, &namespaces, &lo); err != nil {
+			return []reconcile.Request{}
+		}
+
+		for _, ns := range namespaces.Items {
+			selected, err := IsSelectedNamespace(dsci, &ns)
+			if err != nil {
 				return []reconcile.Request{}
 			}
 
-			for _, ns := range namespaces.Items {
-				requests = append(requests, reconcile.Request{
-					NamespacedName: resources.NamespacedNameFromObject(&ns),
-				})
+			if _, found := holders[ns.Name]; !selected && !found {
+				continue
 			}
 
-			if namespaces.Continue == "" {
-				break
-			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: resources.NamespacedNameFromObject(&ns),
+			})
+		}
 
-			lo.Continue = namespaces.Continue
+		if namespaces.Continue == "" {
+			break
 		}
 
-		return requests
-	})
+		lo.Continue = namespaces.Continue
+	}
+
+	return requests
 }
 
 // dsciPredicates creates predicates for filtering DSCInitialization events. It determines when
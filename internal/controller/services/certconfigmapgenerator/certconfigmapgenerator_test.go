@@ -2,6 +2,8 @@ package certconfigmapgenerator_test
 
 import (
 	"context"
+	"encoding/pem"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,16 +13,19 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 
 	. "github.com/onsi/gomega"
 )
@@ -332,3 +337,167 @@ func TestInjectTrustedCABundle(t *testing.T) {
 		})
 	}
 }
+
+func testCertificate(data string) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(data)}))
+}
+
+func TestMergeCABundles(t *testing.T) {
+	g := NewWithT(t)
+
+	ca1 := testCertificate("ca1")
+	ca2 := testCertificate("ca2")
+	ca3 := testCertificate("ca3")
+
+	g.Expect(certconfigmapgenerator.MergeCABundles()).Should(BeEmpty())
+	g.Expect(certconfigmapgenerator.MergeCABundles("", "  \n")).Should(BeEmpty())
+	g.Expect(certconfigmapgenerator.MergeCABundles("not a certificate\n", "not a certificate")).Should(Equal("not a certificate"))
+
+	merged := certconfigmapgenerator.MergeCABundles(ca1+ca2, "\n"+ca2+"\n", ca3+ca1)
+	g.Expect(merged).Should(Equal(strings.TrimSpace(ca1) + "\n" + strings.TrimSpace(ca2) + "\n" + strings.TrimSpace(ca3)))
+}
+
+func TestCABundle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	ns := xid.New().String()
+	ca1 := testCertificate("ca1")
+	ca2 := testCertificate("ca2")
+	ca3 := testCertificate("ca3")
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-configmap", Namespace: ns},
+			Data:       map[string]string{"ca.crt": ca2 + ca1},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: ns},
+			Data:       map[string][]byte{"tls.crt": []byte(ca3)},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsci := &dsciv2.DSCInitialization{}
+	dsci.Spec.ApplicationsNamespace = ns
+	dsci.Spec.TrustedCABundle = &dsciv2.TrustedCABundleSpec{
+		ManagementState: operatorv1.Managed,
+		CustomCABundle:  ca1,
+		Sources: []common.CABundleSource{
+			{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ca-configmap"},
+				Key:                  "ca.crt",
+			}},
+			{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ca-secret"},
+				Key:                  "tls.crt",
+			}},
+			{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+				Key:                  "tls.crt",
+				Optional:             ptr.To(true),
+			}},
+		},
+	}
+
+	bundle, err := certconfigmapgenerator.CABundle(ctx, cli, dsci)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(bundle).Should(Equal(strings.TrimSpace(ca1) + "\n" + strings.TrimSpace(ca2) + "\n" + strings.TrimSpace(ca3)))
+
+	dsci.Spec.TrustedCABundle.Sources = append(dsci.Spec.TrustedCABundle.Sources, common.CABundleSource{
+		ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "ca-configmap"},
+			Key:                  "missing.crt",
+		},
+	})
+
+	_, err = certconfigmapgenerator.CABundle(ctx, cli, dsci)
+	g.Expect(err).Should(MatchError(ContainSubstring("key missing.crt not found")))
+}
+
+func TestIsSelectedNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *dsciv2.TrustedCABundleSpec
+		labels   map[string]string
+		selected bool
+		err      bool
+	}{
+		{
+			name:     "trusted CA bundle not configured",
+			selected: false,
+		},
+		{
+			name:     "no selector",
+			spec:     &dsciv2.TrustedCABundleSpec{},
+			selected: true,
+		},
+		{
+			name: "excluded",
+			spec: &dsciv2.TrustedCABundleSpec{
+				ExcludedNamespaces: []string{"other", "test-namespace"},
+			},
+			selected: false,
+		},
+		{
+			name: "matching selector",
+			spec: &dsciv2.TrustedCABundleSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ml"}},
+			},
+			labels:   map[string]string{"team": "ml"},
+			selected: true,
+		},
+		{
+			name: "matching selector but excluded",
+			spec: &dsciv2.TrustedCABundleSpec{
+				NamespaceSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ml"}},
+				ExcludedNamespaces: []string{"test-namespace"},
+			},
+			labels:   map[string]string{"team": "ml"},
+			selected: false,
+		},
+		{
+			name: "not matching selector",
+			spec: &dsciv2.TrustedCABundleSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ml"}},
+			},
+			labels:   map[string]string{"team": "web"},
+			selected: false,
+		},
+		{
+			name: "invalid selector",
+			spec: &dsciv2.TrustedCABundleSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "team",
+					Operator: "Unknown",
+				}}},
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dsci := &dsciv2.DSCInitialization{}
+			dsci.Spec.TrustedCABundle = tt.spec
+
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test-namespace",
+					Labels: tt.labels,
+				},
+			}
+
+			selected, err := certconfigmapgenerator.IsSelectedNamespace(dsci, ns)
+			if tt.err {
+				g.Expect(err).Should(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(selected).Should(Equal(tt.selected))
+		})
+	}
+}
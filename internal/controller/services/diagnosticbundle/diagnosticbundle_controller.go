@@ -0,0 +1,152 @@
+package diagnosticbundle
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/archive"
+)
+
+const (
+	// configMapPrefix prefixes the name of the DiagnosticBundle to name the ConfigMap holding it.
+	configMapPrefix = "diagnostic-bundle-"
+
+	// maxBundleSize keeps the ConfigMap holding the bundle under the 1MiB size limit of the
+	// resources.
+	maxBundleSize = 900 * 1024
+)
+
+// DiagnosticBundleReconciler collects, once per DiagnosticBundle, the logs of the operator, the
+// platform resources, their conditions and the resources rendered for them, and the recent events
+// into a gzipped tarball, secrets redacted, stored in a ConfigMap of the operator namespace owned
+// by the DiagnosticBundle, so it is removed with it.
+type DiagnosticBundleReconciler struct {
+	client.Client
+
+	// APIReader reads the collected resources without caching them.
+	APIReader client.Reader
+
+	// Logs returns the logs of the containers of the operator pods.
+	Logs LogsFunc
+
+	// Namespace is the operator namespace, the bundles are stored in.
+	Namespace string
+}
+
+func (r *DiagnosticBundleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("DiagnosticBundle")
+
+	db := serviceApi.DiagnosticBundle{}
+	if err := r.Client.Get(ctx, req.NamespacedName, &db); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// a bundle is collected once, a new DiagnosticBundle collects a new one
+	if db.Status.CollectedAt != nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Collecting diagnostic bundle", "name", db.Name)
+
+	cm, err := r.collect(ctx, &db)
+	if err != nil {
+		return ctrl.Result{}, r.updateStatus(ctx, &db, err)
+	}
+
+	now := metav1.Now()
+	db.Status.ConfigMap = cm.Name
+	db.Status.CollectedAt = &now
+
+	log.Info("Diagnostic bundle collected", "name", db.Name, "configMap", cm.Name, "size", len(cm.BinaryData[serviceApi.DiagnosticBundleKey]))
+
+	return ctrl.Result{}, r.updateStatus(ctx, &db, nil)
+}
+
+// collect gathers the bundle and stores it in the ConfigMap returned.
+func (r *DiagnosticBundleReconciler) collect(ctx context.Context, db *serviceApi.DiagnosticBundle) (*corev1.ConfigMap, error) {
+	c := collector{
+		reader:    r.APIReader,
+		scheme:    r.Client.Scheme(),
+		logs:      r.Logs,
+		namespace: r.Namespace,
+	}
+
+	content, err := archive.TarGz(c.collect(ctx, db))
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive the diagnostic bundle: %w", err)
+	}
+
+	if len(content) > maxBundleSize {
+		return nil, fmt.Errorf("diagnostic bundle of %d bytes exceeds the %d bytes a ConfigMap can hold, collect fewer log lines", len(content), maxBundleSize)
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapPrefix + db.Name,
+			Namespace: r.Namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, &cm, func() error {
+		cm.BinaryData = map[string][]byte{serviceApi.DiagnosticBundleKey: content}
+		return controllerutil.SetControllerReference(db, &cm, r.Client.Scheme())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store the diagnostic bundle in ConfigMap %s: %w", client.ObjectKeyFromObject(&cm), err)
+	}
+
+	return &cm, nil
+}
+
+// updateStatus reports the collection of the bundle, or its error, which is returned so the
+// collection is retried.
+func (r *DiagnosticBundleReconciler) updateStatus(ctx context.Context, db *serviceApi.DiagnosticBundle, collectErr error) error {
+	ready := common.Condition{
+		Type:    status.ConditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  status.BundleCollectedReason,
+		Message: fmt.Sprintf("Diagnostic bundle stored in ConfigMap %s", db.Status.ConfigMap),
+	}
+	phase := status.PhaseReady
+
+	if collectErr != nil {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.BundleFailedReason
+		ready.Message = fmt.Sprintf("Diagnostic bundle collection failed: %v", collectErr)
+		phase = status.PhaseError
+	}
+
+	db.Status.ObservedGeneration = db.Generation
+	db.Status.Phase = phase
+	conditions.SetStatusCondition(db, ready)
+
+	if err := r.Client.Status().Update(ctx, db); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to update DiagnosticBundle status: %w", err)
+	}
+
+	return collectErr
+}
+
+func (r *DiagnosticBundleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		For(&serviceApi.DiagnosticBundle{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
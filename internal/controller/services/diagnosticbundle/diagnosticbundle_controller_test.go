@@ -0,0 +1,200 @@
+package diagnosticbundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/diagnosticbundle"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+func newClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(objs...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not know the status subresource of the DiagnosticBundle
+			SubResourceUpdate: func(ctx context.Context, cli client.Client, _ string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				return cli.Update(ctx, obj)
+			},
+		}),
+	)
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return cli
+}
+
+// extract returns the files of the given gzipped tarball, by path.
+func extract(t *testing.T, content []byte) map[string]string {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to read the bundle: %v", err)
+	}
+
+	files := make(map[string]string)
+
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("failed to read the bundle: %v", err)
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", h.Name, err)
+		}
+
+		files[h.Name] = string(b)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	db := &serviceApi.DiagnosticBundle{
+		ObjectMeta: metav1.ObjectMeta{Name: "case-1234"},
+		Spec: serviceApi.DiagnosticBundleSpec{
+			LogLines:    100,
+			EventsSince: metav1.Duration{Duration: time.Hour},
+		},
+	}
+
+	dashboard := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName},
+		Status: componentApi.DashboardStatus{
+			Status: common.Status{
+				Conditions: []common.Condition{{
+					Type:    status.ConditionTypeReady,
+					Status:  metav1.ConditionFalse,
+					Reason:  "DeploymentsNotReady",
+					Message: "0/1 deployments ready",
+				}},
+				Resources: []common.ManagedResource{{
+					Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "opendatahub", Name: "odh-dashboard", Hash: "abc123",
+				}},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-operator-controller-manager-0", Namespace: operatorNamespace},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}},
+	}
+
+	recent := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "recent", Namespace: operatorNamespace},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod.Name},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+		LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+	}
+
+	old := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "old", Namespace: operatorNamespace},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod.Name},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Pulled",
+		Message:        "Container image pulled",
+		LastTimestamp:  metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: operatorNamespace},
+		StringData: map[string]string{"password": "hunter2"},
+	}
+
+	cli := newClient(t, db, dashboard, pod, recent, old, secret)
+
+	var tailed int64
+	calls := 0
+	rec := diagnosticbundle.DiagnosticBundleReconciler{
+		Client:    cli,
+		APIReader: cli,
+		Namespace: operatorNamespace,
+		Logs: func(_ context.Context, _ string, _ string, _ string, lines int64) (string, error) {
+			tailed = lines
+			calls++
+			return "INFO starting manager\nDEBUG connecting with token=s3cr3t-value\n", nil
+		},
+	}
+
+	_, err := rec.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(db)})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(tailed).Should(Equal(db.Spec.LogLines))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(db), db)).Should(Succeed())
+	g.Expect(db.Status.ConfigMap).Should(Equal("diagnostic-bundle-case-1234"))
+	g.Expect(db.Status.CollectedAt).ShouldNot(BeNil())
+	g.Expect(db.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionTypeReady),
+		HaveField("Status", metav1.ConditionTrue),
+		HaveField("Reason", status.BundleCollectedReason),
+	)))
+
+	cm := corev1.ConfigMap{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: operatorNamespace, Name: db.Status.ConfigMap}, &cm)).Should(Succeed())
+	g.Expect(cm.OwnerReferences).Should(ContainElement(HaveField("Name", db.Name)))
+
+	files := extract(t, cm.BinaryData[serviceApi.DiagnosticBundleKey])
+
+	g.Expect(files).Should(HaveKeyWithValue("resources/components.platform.opendatahub.io/dashboard.yaml", ContainSubstring("default-dashboard")))
+	g.Expect(files).Should(HaveKeyWithValue("conditions.txt", ContainSubstring("Dashboard/default-dashboard Ready=False reason=DeploymentsNotReady")))
+	g.Expect(files).Should(HaveKeyWithValue("rendered-resources.txt", ContainSubstring("Dashboard/default-dashboard apps/v1, Kind=Deployment opendatahub odh-dashboard abc123")))
+
+	g.Expect(files).Should(HaveKeyWithValue("events/"+operatorNamespace+".txt", And(
+		ContainSubstring("Back-off restarting failed container"),
+		Not(ContainSubstring("Container image pulled")),
+	)))
+
+	g.Expect(files).Should(HaveKeyWithValue("logs/"+pod.Name+"/manager.log", And(
+		ContainSubstring("starting manager"),
+		ContainSubstring("token=[REDACTED]"),
+		Not(ContainSubstring("s3cr3t-value")),
+	)))
+
+	for _, content := range files {
+		g.Expect(content).ShouldNot(ContainSubstring("hunter2"))
+	}
+
+	// a bundle is collected once
+	_, err = rec.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(db)})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(calls).Should(Equal(1))
+}
+
+func TestRedact(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(diagnosticbundle.Redact(`Authorization: Bearer eyJhbGci password=pa55 "apiKey": "k3y" secretName: dashboard-tls`)).Should(Equal(
+		`Authorization: Bearer [REDACTED] password=[REDACTED] "apiKey": "[REDACTED]" secretName: dashboard-tls`,
+	))
+}
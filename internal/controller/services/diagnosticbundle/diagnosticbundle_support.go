@@ -0,0 +1,268 @@
+package diagnosticbundle
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+const redactedValue = "[REDACTED]"
+
+// platformGroupVersions are the API versions of the platform resources collected in the bundle.
+var platformGroupVersions = []schema.GroupVersion{
+	dscv2.GroupVersion,
+	dsciv2.GroupVersion,
+	componentApi.GroupVersion,
+	serviceApi.GroupVersion,
+}
+
+// sensitivePatterns match the values of the common credentials found in logs and messages, the
+// value being the second group.
+var sensitivePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(authorization:\s*bearer\s+)[^\s"']+`),
+	regexp.MustCompile(`(?i)(password["']?[=:\s]+["']?)[^\s&"',]+`),
+	regexp.MustCompile(`(?i)(token["']?[=:\s]+["']?)[^\s&"',]+`),
+	regexp.MustCompile(`(?i)(secret["']?[=:\s]+["']?)[^\s&"',]+`),
+	regexp.MustCompile(`(?i)(api[_-]?key["']?[=:\s]+["']?)[^\s&"',]+`),
+	regexp.MustCompile(`(?i)(access[_-]?key["']?[=:\s]+["']?)[^\s&"',]+`),
+}
+
+// LogsFunc returns the given number of the most recent lines of the logs of the given container.
+type LogsFunc func(ctx context.Context, namespace string, pod string, container string, lines int64) (string, error)
+
+// PodLogs returns a LogsFunc reading the logs of the containers with the given clientset.
+func PodLogs(cs kubernetes.Interface) LogsFunc {
+	return func(ctx context.Context, namespace string, pod string, container string, lines int64) (string, error) {
+		content, err := cs.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+			Container: container,
+			TailLines: &lines,
+		}).DoRaw(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return string(content), nil
+	}
+}
+
+// collector gathers the files of a bundle. The collection is best effort: what fails to be
+// collected is reported in the errors.txt file of the bundle rather than failing the bundle.
+type collector struct {
+	reader    client.Reader
+	scheme    *runtime.Scheme
+	logs      LogsFunc
+	namespace string
+
+	files map[string]string
+	errs  []string
+}
+
+// collect returns the files of the bundle, by path, their content redacted.
+func (c *collector) collect(ctx context.Context, db *serviceApi.DiagnosticBundle) map[string]string {
+	c.files = make(map[string]string)
+	c.errs = nil
+
+	c.collectResources(ctx)
+	c.collectEvents(ctx, db.Spec.EventsSince.Duration)
+	c.collectLogs(ctx, db.Spec.LogLines)
+
+	if len(c.errs) != 0 {
+		c.files["errors.txt"] = strings.Join(c.errs, "\n") + "\n"
+	}
+
+	for k, v := range c.files {
+		c.files[k] = Redact(v)
+	}
+
+	return c.files
+}
+
+func (c *collector) fail(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Sprintf(format, args...))
+}
+
+// collectResources writes the platform resources, one file per kind, along with the summary of
+// their conditions and of the resources rendered for them with their hash.
+func (c *collector) collectResources(ctx context.Context) {
+	var conditions strings.Builder
+	var rendered strings.Builder
+
+	for _, gv := range platformGroupVersions {
+		known := c.scheme.KnownTypes(gv)
+
+		kinds := make([]string, 0, len(known))
+		for kind := range known {
+			if _, ok := known[kind+"List"]; ok {
+				kinds = append(kinds, kind)
+			}
+		}
+
+		slices.Sort(kinds)
+
+		for _, kind := range kinds {
+			items := unstructured.UnstructuredList{}
+			items.SetGroupVersionKind(gv.WithKind(kind + "List"))
+
+			err := c.reader.List(ctx, &items)
+			switch {
+			case meta.IsNoMatchError(err):
+				continue
+			case err != nil:
+				c.fail("failed to list %s: %v", gv.WithKind(kind), err)
+				continue
+			case len(items.Items) == 0:
+				continue
+			}
+
+			var content strings.Builder
+
+			for i := range items.Items {
+				u := &items.Items[i]
+				u.SetManagedFields(nil)
+
+				b, err := yaml.Marshal(u.Object)
+				if err != nil {
+					c.fail("failed to marshal %s %s: %v", kind, u.GetName(), err)
+					continue
+				}
+
+				content.WriteString("---\n")
+				content.Write(b)
+
+				summarize(&conditions, &rendered, u)
+			}
+
+			c.files[path.Join("resources", gv.Group, strings.ToLower(kind)+".yaml")] = content.String()
+		}
+	}
+
+	c.files["conditions.txt"] = conditions.String()
+	c.files["rendered-resources.txt"] = rendered.String()
+}
+
+// summarize writes a line per condition and per rendered resource of the given platform resource.
+func summarize(conditions *strings.Builder, rendered *strings.Builder, u *unstructured.Unstructured) {
+	s := struct {
+		Status common.Status `json:"status"`
+	}{}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &s); err != nil {
+		return
+	}
+
+	ref := u.GetKind() + "/" + u.GetName()
+
+	for _, cond := range s.Status.Conditions {
+		fmt.Fprintf(conditions, "%s %s=%s reason=%s: %s\n", ref, cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+
+	for _, mr := range s.Status.Resources {
+		gv := schema.GroupVersion{Group: mr.Group, Version: mr.Version}
+		fmt.Fprintf(rendered, "%s %s %s %s %s\n", ref, gv.WithKind(mr.Kind), mr.Namespace, mr.Name, mr.Hash)
+	}
+}
+
+// collectEvents writes, one file per namespace, the events of the namespaces of the operator and
+// of the applications more recent than the given age, oldest first.
+func (c *collector) collectEvents(ctx context.Context, since time.Duration) {
+	namespaces := []string{c.namespace}
+	if ns := cluster.GetApplicationNamespace(); ns != "" && ns != c.namespace {
+		namespaces = append(namespaces, ns)
+	}
+
+	oldest := time.Now().Add(-since)
+
+	for _, ns := range namespaces {
+		events := corev1.EventList{}
+		if err := c.reader.List(ctx, &events, client.InNamespace(ns)); err != nil {
+			c.fail("failed to list the events of namespace %s: %v", ns, err)
+			continue
+		}
+
+		recent := make([]corev1.Event, 0, len(events.Items))
+		for i := range events.Items {
+			if eventTime(&events.Items[i]).After(oldest) {
+				recent = append(recent, events.Items[i])
+			}
+		}
+
+		sort.SliceStable(recent, func(i, j int) bool {
+			return eventTime(&recent[i]).Before(eventTime(&recent[j]))
+		})
+
+		var content strings.Builder
+		for i := range recent {
+			e := &recent[i]
+			fmt.Fprintf(&content, "%s %s %s %s/%s: %s\n",
+				eventTime(e).UTC().Format(time.RFC3339), e.Type, e.Reason, e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Message)
+		}
+
+		c.files[path.Join("events", ns+".txt")] = content.String()
+	}
+}
+
+// eventTime returns the time the given event last occurred at.
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// collectLogs writes the given number of the most recent lines of the logs of each container of
+// the pods of the operator namespace.
+func (c *collector) collectLogs(ctx context.Context, lines int64) {
+	pods := corev1.PodList{}
+	if err := c.reader.List(ctx, &pods, client.InNamespace(c.namespace)); err != nil {
+		c.fail("failed to list the pods of namespace %s: %v", c.namespace, err)
+		return
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		for _, container := range pod.Spec.Containers {
+			logs, err := c.logs(ctx, pod.Namespace, pod.Name, container.Name, lines)
+			if err != nil {
+				c.fail("failed to get the logs of container %s of pod %s: %v", container.Name, pod.Name, err)
+				continue
+			}
+
+			c.files[path.Join("logs", pod.Name, container.Name+".log")] = logs
+		}
+	}
+}
+
+// Redact replaces the values of the credentials found in the given content.
+func Redact(content string) string {
+	for _, p := range sensitivePatterns {
+		content = p.ReplaceAllString(content, "${1}"+redactedValue)
+	}
+
+	return content
+}
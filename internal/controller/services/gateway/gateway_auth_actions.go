@@ -23,6 +23,11 @@ func createKubeAuthProxyInfrastructure(ctx context.Context, rr *odhtypes.Reconci
 		return errors.New("instance is not of type *services.GatewayConfig")
 	}
 
+	// without the Gateway, requests are authenticated by the oauth-proxy sidecars of the services
+	if serviceMeshRemoved(gatewayConfig) {
+		return nil
+	}
+
 	l.V(1).Info("creating auth proxy for gateway", "gateway", gatewayConfig.Name)
 
 	// Resolve domain consistently with createGatewayInfrastructure
@@ -92,6 +97,10 @@ func createEnvoyFilter(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 		return errors.New("instance is not of type *services.GatewayConfig")
 	}
 
+	if serviceMeshRemoved(gatewayConfig) {
+		return nil
+	}
+
 	authTimeout := getGatewayAuthTimeout(gatewayConfig)
 
 	// using yaml templates due to complexity of k8s api struct for envoy filter
@@ -35,6 +35,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 )
@@ -131,6 +132,7 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(createEnvoyFilter).                    // Service mesh integration
 		WithAction(createDestinationRule).                // Traffic management
 		WithAction(template.NewAction()).                 // Template rendering
+		WithAction(validation.NewAction()).               // Schema validation
 		WithAction(deploy.NewAction(deploy.WithCache())). // Resource deployment with caching
 		WithAction(syncGatewayConfigStatus).              // Status synchronization
 		WithAction(gc.NewAction())                        // Garbage collection
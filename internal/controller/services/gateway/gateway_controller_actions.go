@@ -30,6 +30,7 @@ import (
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	cond "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
@@ -47,6 +48,12 @@ func createGatewayInfrastructure(ctx context.Context, rr *odhtypes.Reconciliatio
 	if err != nil {
 		return err
 	}
+
+	if serviceMeshRemoved(gatewayConfig) {
+		l.V(1).Info("Service mesh is Removed, skipping Gateway infrastructure", "gateway", gatewayConfig.Name)
+		return nil
+	}
+
 	l.V(1).Info("Creating Gateway infrastructure", "gateway", gatewayConfig.Name)
 
 	domain, err := resolveDomain(ctx, rr.Client, gatewayConfig)
@@ -84,6 +91,10 @@ func createDestinationRule(ctx context.Context, rr *odhtypes.ReconciliationReque
 		return errors.New("reconciliation request cannot be nil")
 	}
 
+	if gatewayConfig, ok := rr.Instance.(*serviceApi.GatewayConfig); ok && serviceMeshRemoved(gatewayConfig) {
+		return nil
+	}
+
 	l := logf.FromContext(ctx).WithName("createDestinationRule")
 	l.V(1).Info("Creating DestinationRule for TLS configuration")
 
@@ -113,6 +124,16 @@ func syncGatewayConfigStatus(ctx context.Context, rr *odhtypes.ReconciliationReq
 		return err
 	}
 
+	if serviceMeshRemoved(gatewayConfig) {
+		cond.SetStatusCondition(gatewayConfig, common.Condition{
+			Type:    status.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  status.ReadyReason,
+			Message: status.GatewayServiceMeshRemovedMessage,
+		})
+		return nil
+	}
+
 	gateway := &gwapiv1.Gateway{}
 	err = rr.Client.Get(ctx, types.NamespacedName{
 		Name:      DefaultGatewayName,
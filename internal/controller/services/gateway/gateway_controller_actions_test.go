@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -204,6 +205,20 @@ func TestSyncGatewayConfigStatus(t *testing.T) {
 			expectedMessage:   status.GatewayNotFoundMessage,
 			description:       "should set not found condition when gateway doesn't exist",
 		},
+		{
+			name: "service mesh removed",
+			gatewayConfig: &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-gateway"},
+				Spec: serviceApi.GatewayConfigSpec{
+					ServiceMesh: operatorv1.Removed,
+				},
+			},
+			gatewayNotFound:   true,
+			expectedCondition: metav1.ConditionTrue,
+			expectedReason:    status.ReadyReason,
+			expectedMessage:   status.GatewayServiceMeshRemovedMessage,
+			description:       "should set ready condition without gateway when service mesh is removed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -312,3 +327,26 @@ func TestCreateGatewayInfrastructureWithProvidedCertificate(t *testing.T) {
 	g.Expect(gateway.GetName()).To(Equal(expectedGatewayName))
 	g.Expect(gateway.GetNamespace()).To(Equal(GatewayNamespace))
 }
+
+// TestCreateGatewayInfrastructureServiceMeshRemoved tests that no gateway resources are generated when the service mesh is Removed.
+func TestCreateGatewayInfrastructureServiceMeshRemoved(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: setupTestClient(),
+		Instance: &serviceApi.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayInstanceName},
+			Spec: serviceApi.GatewayConfigSpec{
+				ServiceMesh: operatorv1.Removed,
+			},
+		},
+	}
+
+	g.Expect(createGatewayInfrastructure(ctx, rr)).To(Succeed())
+	g.Expect(createKubeAuthProxyInfrastructure(ctx, rr)).To(Succeed())
+	g.Expect(createEnvoyFilter(ctx, rr)).To(Succeed())
+	g.Expect(createDestinationRule(ctx, rr)).To(Succeed())
+	g.Expect(rr.Resources).To(BeEmpty())
+}
@@ -11,6 +11,7 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	oauthv1 "github.com/openshift/api/oauth/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
@@ -184,6 +185,25 @@ func GetGatewayDomain(ctx context.Context, cli client.Client) (string, error) {
 	return getClusterDomain(ctx, cli, subdomain)
 }
 
+// IsServiceMeshRemoved reads the GatewayConfig and returns whether the services are exposed with
+// OpenShift Routes instead of the Gateway. Without a GatewayConfig, the Gateway is used.
+func IsServiceMeshRemoved(ctx context.Context, cli client.Client) (bool, error) {
+	gatewayConfig := &serviceApi.GatewayConfig{}
+	err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.GatewayInstanceName}, gatewayConfig)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get GatewayConfig: %w", err)
+	}
+
+	return serviceMeshRemoved(gatewayConfig), nil
+}
+
+func serviceMeshRemoved(gatewayConfig *serviceApi.GatewayConfig) bool {
+	return gatewayConfig != nil && gatewayConfig.Spec.ServiceMesh == operatorv1.Removed
+}
+
 // createListeners creates the Gateway listeners configuration with namespace restrictions.
 func createListeners(certSecretName string, domain string) []gwapiv1.Listener {
 	// Early return for empty certificate - avoid unnecessary allocations
@@ -349,8 +369,14 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 	return rr.AddResources(gateway)
 }
 
-// detectClusterAuthMode determines the authentication mode from cluster configuration.
+// detectClusterAuthMode determines the authentication mode from cluster configuration. Kubernetes
+// clusters have no OpenShift OAuth server, so the users are authenticated by an external OIDC
+// provider, e.g. dex.
 func detectClusterAuthMode(ctx context.Context, rr *odhtypes.ReconciliationRequest) (AuthMode, error) {
+	if !cluster.IsOpenShift() {
+		return AuthModeOIDC, nil
+	}
+
 	auth := &configv1.Authentication{}
 	err := rr.Client.Get(ctx, types.NamespacedName{Name: "cluster"}, auth)
 	if err != nil {
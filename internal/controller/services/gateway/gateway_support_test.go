@@ -4,6 +4,7 @@ package gateway
 import (
 	"testing"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -982,3 +983,58 @@ func TestSecretHashAnnotationWithoutSecret(t *testing.T) {
 	hash := deployment.Spec.Template.Annotations["opendatahub.io/secret-hash"]
 	g.Expect(hash).To(BeEmpty(), "secret hash should be empty string when secret doesn't exist")
 }
+
+// TestIsServiceMeshRemoved tests the IsServiceMeshRemoved function.
+func TestIsServiceMeshRemoved(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		gatewayConfig *serviceApi.GatewayConfig
+		expected      bool
+	}{
+		{
+			name:     "gateway config not found",
+			expected: false,
+		},
+		{
+			name: "service mesh not set",
+			gatewayConfig: &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayInstanceName},
+			},
+			expected: false,
+		},
+		{
+			name: "service mesh managed",
+			gatewayConfig: &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayInstanceName},
+				Spec:       serviceApi.GatewayConfigSpec{ServiceMesh: operatorv1.Managed},
+			},
+			expected: false,
+		},
+		{
+			name: "service mesh removed",
+			gatewayConfig: &serviceApi.GatewayConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayInstanceName},
+				Spec:       serviceApi.GatewayConfigSpec{ServiceMesh: operatorv1.Removed},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := setupTestClient()
+			if tc.gatewayConfig != nil {
+				cli = setupTestClientWithObjects(tc.gatewayConfig)
+			}
+
+			removed, err := IsServiceMeshRemoved(t.Context(), cli)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(removed).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package hardwareprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+)
+
+// requeueAfter is the interval the availability of the accelerators is refreshed at, as the nodes
+// are not watched.
+const requeueAfter = 5 * time.Minute
+
+// HardwareProfileReconciler validates the HardwareProfiles, which the hardware profile webhook
+// applies to the Notebooks and the InferenceServices referencing them, and reports the nodes of
+// the cluster providing their accelerators.
+type HardwareProfileReconciler struct {
+	client.Client
+}
+
+func (r *HardwareProfileReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	hwp := infrav1.HardwareProfile{}
+	if err := r.Client.Get(ctx, req.NamespacedName, &hwp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	valid := common.Condition{
+		Type:    status.ConditionHardwareProfileValid,
+		Status:  metav1.ConditionTrue,
+		Reason:  status.HardwareProfileValidReason,
+		Message: status.HardwareProfileValidMessage,
+	}
+
+	if err := validate(&hwp); err != nil {
+		valid.Status = metav1.ConditionFalse
+		valid.Reason = status.InvalidConfigReason
+		valid.Message = err.Error()
+	}
+
+	nodes := corev1.NodeList{}
+	if err := r.Client.List(ctx, &nodes); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	available, missing := availableNodes(&hwp, nodes.Items)
+
+	accelerators := common.Condition{
+		Type:    status.ConditionAcceleratorsAvailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  status.AvailableReason,
+		Message: fmt.Sprintf(status.AcceleratorsAvailableMessage, available),
+	}
+
+	switch {
+	case len(acceleratorIdentifiers(&hwp)) == 0:
+		accelerators.Reason = status.NoAcceleratorsReason
+		accelerators.Message = status.NoAcceleratorsMessage
+	case available == 0:
+		accelerators.Status = metav1.ConditionFalse
+		accelerators.Reason = status.NoMatchingNodesReason
+		accelerators.Message = fmt.Sprintf(status.AcceleratorsUnavailableMessage, missing)
+	}
+
+	hwp.Status.ObservedGeneration = hwp.Generation
+	hwp.Status.AvailableNodes = available
+	conditions.SetStatusCondition(&hwp, valid)
+	conditions.SetStatusCondition(&hwp, accelerators)
+
+	if err := r.Client.Status().Update(ctx, &hwp); err != nil && !k8serr.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to update HardwareProfile status: %w", err)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *HardwareProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		For(&infrav1.HardwareProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,168 @@
+package hardwareprofile_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/hardwareprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newGPUNode(name string, gpus int64, nodeLabels map[string]string, taints ...corev1.Taint) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels},
+		Spec:       corev1.NodeSpec{Taints: taints},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func newGPUProfile(minCount, defaultCount int32, node *infrav1.NodeSchedulingSpec) *infrav1.HardwareProfile {
+	hwp := &infrav1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu", Namespace: "opendatahub"},
+		Spec: infrav1.HardwareProfileSpec{
+			Identifiers: []infrav1.HardwareIdentifier{
+				{
+					DisplayName:  "CPU",
+					Identifier:   "cpu",
+					MinCount:     intstr.FromString("1"),
+					DefaultCount: intstr.FromString("2"),
+					ResourceType: "CPU",
+				},
+				{
+					DisplayName:  "GPU",
+					Identifier:   "nvidia.com/gpu",
+					MinCount:     intstr.FromInt32(minCount),
+					DefaultCount: intstr.FromInt32(defaultCount),
+					ResourceType: "Accelerator",
+				},
+			},
+		},
+	}
+
+	if node != nil {
+		hwp.Spec.SchedulingSpec = &infrav1.SchedulingSpec{
+			SchedulingType: infrav1.NodeScheduling,
+			Node:           node,
+		}
+	}
+
+	return hwp
+}
+
+func reconcile(t *testing.T, hwp *infrav1.HardwareProfile, objs ...client.Object) *infrav1.HardwareProfile {
+	t.Helper()
+
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(append(objs, hwp)...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not know the status subresource of the HardwareProfile
+			SubResourceUpdate: func(ctx context.Context, cli client.Client, _ string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				return cli.Update(ctx, obj)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rec := hardwareprofile.HardwareProfileReconciler{Client: cli}
+
+	_, err = rec.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(hwp)})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	result := infrav1.HardwareProfile{}
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(hwp), &result)).Should(Succeed())
+
+	return &result
+}
+
+func TestReconcileAvailable(t *testing.T) {
+	g := NewWithT(t)
+
+	toleration := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	taint := corev1.Taint{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule}
+	gpuLabels := map[string]string{"nvidia.com/gpu.present": "true"}
+
+	hwp := reconcile(t,
+		newGPUProfile(1, 2, &infrav1.NodeSchedulingSpec{
+			NodeSelector: gpuLabels,
+			Tolerations:  []corev1.Toleration{toleration},
+		}),
+		newGPUNode("gpu-4", 4, gpuLabels, taint),
+		newGPUNode("gpu-1", 1, gpuLabels, taint),
+		newGPUNode("unlabeled", 4, nil),
+		newGPUNode("untolerated", 4, gpuLabels, corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoExecute}),
+	)
+
+	g.Expect(hwp.Status.AvailableNodes).Should(Equal(int32(1)))
+	g.Expect(hwp.Status.Conditions).Should(ContainElements(
+		And(
+			HaveField("Type", status.ConditionHardwareProfileValid),
+			HaveField("Status", metav1.ConditionTrue),
+		),
+		And(
+			HaveField("Type", status.ConditionAcceleratorsAvailable),
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Message", "1 nodes provide the accelerators of the hardware profile"),
+		),
+	))
+}
+
+func TestReconcileUnavailable(t *testing.T) {
+	g := NewWithT(t)
+
+	hwp := reconcile(t,
+		newGPUProfile(1, 1, &infrav1.NodeSchedulingSpec{NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"}}),
+		newGPUNode("unlabeled", 4, nil),
+	)
+
+	g.Expect(hwp.Status.AvailableNodes).Should(BeZero())
+	g.Expect(hwp.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionAcceleratorsAvailable),
+		HaveField("Status", metav1.ConditionFalse),
+		HaveField("Reason", status.NoMatchingNodesReason),
+		HaveField("Message", ContainSubstring("nvidia.com/gpu")),
+	)))
+}
+
+func TestReconcileInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	hwp := newGPUProfile(2, 1, nil)
+	hwp.Spec.Identifiers = append(hwp.Spec.Identifiers, infrav1.HardwareIdentifier{
+		DisplayName:  "Memory",
+		Identifier:   "mem",
+		MinCount:     intstr.FromString("2Gi"),
+		DefaultCount: intstr.FromString("4Gi"),
+		ResourceType: "Memory",
+	})
+
+	hwp = reconcile(t, hwp)
+
+	g.Expect(hwp.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionHardwareProfileValid),
+		HaveField("Status", metav1.ConditionFalse),
+		HaveField("Reason", status.InvalidConfigReason),
+		HaveField("Message", And(
+			ContainSubstring("identifier nvidia.com/gpu: default count 1 is lower than the minimum count 2"),
+			ContainSubstring("identifier mem of type Memory must be memory"),
+		)),
+	)))
+}
@@ -0,0 +1,192 @@
+package hardwareprofile
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+)
+
+const (
+	resourceTypeCPU         = "CPU"
+	resourceTypeMemory      = "Memory"
+	resourceTypeAccelerator = "Accelerator"
+)
+
+// validate checks the constraints of the identifiers of the profile that the CRD schema does not
+// enforce: the identifiers are unique, the CPU and Memory identifiers name the cpu and memory
+// resources, and the counts are quantities with the default count within the minimum and maximum
+// counts.
+func validate(hwp *infrav1.HardwareProfile) error {
+	var errs []error
+
+	seen := make(map[string]bool, len(hwp.Spec.Identifiers))
+
+	for _, id := range hwp.Spec.Identifiers {
+		if seen[id.Identifier] {
+			errs = append(errs, fmt.Errorf("identifier %s is duplicated", id.Identifier))
+			continue
+		}
+
+		seen[id.Identifier] = true
+
+		switch {
+		case id.ResourceType == resourceTypeCPU && id.Identifier != corev1.ResourceCPU.String():
+			errs = append(errs, fmt.Errorf("identifier %s of type %s must be %s", id.Identifier, id.ResourceType, corev1.ResourceCPU))
+		case id.ResourceType == resourceTypeMemory && id.Identifier != corev1.ResourceMemory.String():
+			errs = append(errs, fmt.Errorf("identifier %s of type %s must be %s", id.Identifier, id.ResourceType, corev1.ResourceMemory))
+		}
+
+		if err := validateCounts(id); err != nil {
+			errs = append(errs, fmt.Errorf("identifier %s: %w", id.Identifier, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateCounts(id infrav1.HardwareIdentifier) error {
+	minCount, err := quantity(id.MinCount)
+	if err != nil {
+		return fmt.Errorf("invalid minimum count: %w", err)
+	}
+
+	defaultCount, err := quantity(id.DefaultCount)
+	if err != nil {
+		return fmt.Errorf("invalid default count: %w", err)
+	}
+
+	if defaultCount.Cmp(minCount) < 0 {
+		return fmt.Errorf("default count %s is lower than the minimum count %s", id.DefaultCount.String(), id.MinCount.String())
+	}
+
+	if id.MaxCount == nil {
+		return nil
+	}
+
+	maxCount, err := quantity(*id.MaxCount)
+	if err != nil {
+		return fmt.Errorf("invalid maximum count: %w", err)
+	}
+
+	if defaultCount.Cmp(maxCount) > 0 {
+		return fmt.Errorf("default count %s is greater than the maximum count %s", id.DefaultCount.String(), id.MaxCount.String())
+	}
+
+	return nil
+}
+
+func quantity(value intstr.IntOrString) (resource.Quantity, error) {
+	if value.Type == intstr.Int {
+		return *resource.NewQuantity(int64(value.IntVal), resource.DecimalSI), nil
+	}
+
+	return resource.ParseQuantity(value.StrVal)
+}
+
+func acceleratorIdentifiers(hwp *infrav1.HardwareProfile) []infrav1.HardwareIdentifier {
+	var accelerators []infrav1.HardwareIdentifier
+
+	for _, id := range hwp.Spec.Identifiers {
+		if id.ResourceType == resourceTypeAccelerator {
+			accelerators = append(accelerators, id)
+		}
+	}
+
+	return accelerators
+}
+
+// availableNodes returns the number of schedulable nodes matching the node scheduling of the
+// profile whose allocatable resources cover the default count of each accelerator of the profile,
+// and the accelerators no matching node provides. With queue scheduling, the nodes are selected
+// by Kueue, so only the accelerators are taken into account.
+func availableNodes(hwp *infrav1.HardwareProfile, nodes []corev1.Node) (int32, string) {
+	var node *infrav1.NodeSchedulingSpec
+	if s := hwp.Spec.SchedulingSpec; s != nil && s.SchedulingType == infrav1.NodeScheduling {
+		node = s.Node
+	}
+
+	accelerators := acceleratorIdentifiers(hwp)
+	provided := make(map[string]bool, len(accelerators))
+
+	available := int32(0)
+
+	for i := range nodes {
+		if nodes[i].Spec.Unschedulable || !matchesNode(node, &nodes[i]) {
+			continue
+		}
+
+		all := true
+
+		for _, id := range accelerators {
+			allocatable, ok := nodes[i].Status.Allocatable[corev1.ResourceName(id.Identifier)]
+			if !ok || allocatable.IsZero() {
+				all = false
+				continue
+			}
+
+			provided[id.Identifier] = true
+
+			if defaultCount, err := quantity(id.DefaultCount); err != nil || allocatable.Cmp(defaultCount) < 0 {
+				all = false
+			}
+		}
+
+		if all {
+			available++
+		}
+	}
+
+	missing := make([]string, 0, len(accelerators))
+	for _, id := range accelerators {
+		if !provided[id.Identifier] {
+			missing = append(missing, id.Identifier)
+		}
+	}
+
+	if len(missing) == 0 {
+		// the accelerators are provided, but not in the default count by a single node
+		for _, id := range accelerators {
+			missing = append(missing, fmt.Sprintf("%s %s", id.DefaultCount.String(), id.Identifier))
+		}
+	}
+
+	slices.Sort(missing)
+
+	return available, strings.Join(missing, ", ")
+}
+
+// matchesNode returns whether the workloads scheduled with the node scheduling can run on the
+// node: the node matches the node selector and its NoSchedule and NoExecute taints are tolerated.
+func matchesNode(spec *infrav1.NodeSchedulingSpec, node *corev1.Node) bool {
+	if spec == nil {
+		spec = &infrav1.NodeSchedulingSpec{}
+	}
+
+	if !labels.SelectorFromSet(spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		tolerated := slices.ContainsFunc(spec.Tolerations, func(t corev1.Toleration) bool {
+			return t.ToleratesTaint(taint)
+		})
+		if !tolerated {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,77 @@
+package kfdefmigration
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/kfdef"
+)
+
+// KfDefMigrationReconciler proposes the DataScienceCluster equivalent to the KfDef resources of
+// legacy installs, and creates it once the proposal is approved, see kfdef.Adopt.
+type KfDefMigrationReconciler struct {
+	client.Client
+
+	// Namespace holding the proposal ConfigMap, i.e. the operator namespace.
+	Namespace string
+}
+
+func (r *KfDefMigrationReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	if err := kfdef.Adopt(ctx, r.Client, r.Namespace); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to migrate the KfDef resources: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *KfDefMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return fmt.Errorf("failed to get operator namespace: %w", err)
+	}
+
+	r.Namespace = operatorNs
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		Named(ServiceName).
+		// the creation, approval and deletion of the proposal, a deleted proposal being renewed
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.isProposal)))
+
+	// the KfDef resources are only watched when their CRD exists, the CRD of the legacy installs
+	// not being installed afterwards
+	_, err = mgr.GetRESTMapper().RESTMapping(gvk.KfDef.GroupKind(), gvk.KfDef.Version)
+	switch {
+	case err == nil:
+		b = b.Watches(
+			resources.GvkToPartial(gvk.KfDef),
+			handlers.ToNamed(kfdef.ProposalName),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+	case !meta.IsNoMatchError(err):
+		return fmt.Errorf("failed to check the %s CRD: %w", gvk.KfDef.Kind, err)
+	default:
+		logf.Log.WithName(ServiceName).V(3).Info("KfDef CRD not found, only the proposal is watched")
+	}
+
+	return b.Complete(r)
+}
+
+func (r *KfDefMigrationReconciler) isProposal(obj client.Object) bool {
+	return obj.GetNamespace() == r.Namespace && obj.GetName() == kfdef.ProposalName
+}
@@ -0,0 +1,64 @@
+//nolint:testpackage
+package kfdefmigration
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/kfdef"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+func TestIsProposal(t *testing.T) {
+	g := NewWithT(t)
+
+	r := KfDefMigrationReconciler{Namespace: operatorNamespace}
+
+	g.Expect(r.isProposal(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: kfdef.ProposalName, Namespace: operatorNamespace}})).Should(BeTrue())
+	g.Expect(r.isProposal(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: kfdef.ProposalName, Namespace: "other"}})).Should(BeFalse())
+	g.Expect(r.isProposal(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: operatorNamespace}})).Should(BeFalse())
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	s.AddKnownTypeWithName(gvk.KfDef, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gvk.KfDef.GroupVersion().WithKind(gvk.KfDef.Kind+"List"), &unstructured.UnstructuredList{})
+
+	crd := mocks.NewMockCRD(gvk.KfDef.Group, gvk.KfDef.Version, gvk.KfDef.Kind, "kfdef")
+	crd.Status.StoredVersions = []string{gvk.KfDef.Version}
+
+	kf := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{
+		"applications": []any{map[string]any{"name": "odh-dashboard"}},
+	}}}
+	kf.SetGroupVersionKind(gvk.KfDef)
+	kf.SetName("opendatahub")
+	kf.SetNamespace("opendatahub")
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(crd, kf))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	r := KfDefMigrationReconciler{Client: cli, Namespace: operatorNamespace}
+
+	_, err = r.Reconcile(ctx, ctrl.Request{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	proposal := corev1.ConfigMap{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: operatorNamespace, Name: kfdef.ProposalName}, &proposal)).Should(Succeed())
+	g.Expect(proposal.Data).Should(HaveKey(kfdef.ProposalKey))
+}
@@ -0,0 +1,181 @@
+package manifestexport
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/export"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/archive"
+)
+
+const (
+	// configMapPrefix prefixes the name of the ManifestExport to name the ConfigMap holding it.
+	configMapPrefix = "manifest-export-"
+
+	// chartName is the name of the exported Helm chart.
+	chartName = "opendatahub-platform"
+
+	// maxExportSize keeps the ConfigMap holding the export under the 1MiB size limit of the
+	// resources.
+	maxExportSize = 900 * 1024
+)
+
+// ManifestExportReconciler exports, once per ManifestExport, the resources last deployed by the
+// controllers of the resources of the platform, as rendered, into a gzipped tarball laid out as a
+// kustomize bundle or a Helm chart, stored in a ConfigMap of the operator namespace owned by the
+// ManifestExport, so it is removed with it.
+type ManifestExportReconciler struct {
+	client.Client
+
+	// APIReader reads the resources of the platform without caching them.
+	APIReader client.Reader
+
+	// Store holds the resources deployed by the controllers.
+	Store *export.Store
+
+	// Namespace is the operator namespace, the exports are stored in.
+	Namespace string
+}
+
+func (r *ManifestExportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("ManifestExport")
+
+	me := serviceApi.ManifestExport{}
+	if err := r.Client.Get(ctx, req.NamespacedName, &me); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// the manifests are exported once, a new ManifestExport exports them again
+	if me.Status.ExportedAt != nil {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Exporting manifests", "name", me.Name, "format", me.Spec.Format)
+
+	cm, count, err := r.export(ctx, &me)
+	if err != nil {
+		return ctrl.Result{}, r.updateStatus(ctx, &me, err)
+	}
+
+	now := metav1.Now()
+	me.Status.ConfigMap = cm.Name
+	me.Status.Resources = count
+	me.Status.ExportedAt = &now
+
+	log.Info("Manifests exported", "name", me.Name, "configMap", cm.Name, "resources", count)
+
+	return ctrl.Result{}, r.updateStatus(ctx, &me, nil)
+}
+
+// export lays the deployed resources out in the format of the given ManifestExport and stores
+// them in the ConfigMap returned, along with the number of resources exported.
+func (r *ManifestExportReconciler) export(ctx context.Context, me *serviceApi.ManifestExport) (*corev1.ConfigMap, int, error) {
+	resources, err := r.Store.Deployed(ctx, r.APIReader, r.Client.Scheme())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count := 0
+	for _, items := range resources {
+		count += len(items)
+	}
+
+	var files map[string]string
+
+	switch me.Spec.Format {
+	case serviceApi.ManifestExportHelm:
+		version := cluster.GetRelease().Version.String()
+
+		files, err = export.HelmChart(resources, export.ChartMetadata{
+			Name:       chartName,
+			Version:    cmp.Or(me.Spec.ChartVersion, version),
+			AppVersion: version,
+		})
+	default:
+		files, err = export.Kustomize(resources)
+	}
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	content, err := archive.TarGz(files)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to archive the exported manifests: %w", err)
+	}
+
+	if len(content) > maxExportSize {
+		return nil, 0, fmt.Errorf("exported manifests of %d bytes exceed the %d bytes a ConfigMap can hold", len(content), maxExportSize)
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapPrefix + me.Name,
+			Namespace: r.Namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, &cm, func() error {
+		cm.BinaryData = map[string][]byte{serviceApi.ManifestExportKey: content}
+		return controllerutil.SetControllerReference(me, &cm, r.Client.Scheme())
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to store the exported manifests in ConfigMap %s: %w", client.ObjectKeyFromObject(&cm), err)
+	}
+
+	return &cm, count, nil
+}
+
+// updateStatus reports the export, or its error, which is returned so the export is retried.
+func (r *ManifestExportReconciler) updateStatus(ctx context.Context, me *serviceApi.ManifestExport, exportErr error) error {
+	ready := common.Condition{
+		Type:    status.ConditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  status.ManifestsExportedReason,
+		Message: fmt.Sprintf("%d manifests exported to ConfigMap %s", me.Status.Resources, me.Status.ConfigMap),
+	}
+	phase := status.PhaseReady
+
+	if exportErr != nil {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.ExportFailedReason
+		ready.Message = fmt.Sprintf("Manifest export failed: %v", exportErr)
+		phase = status.PhaseError
+	}
+
+	me.Status.ObservedGeneration = me.Generation
+	me.Status.Phase = phase
+	conditions.SetStatusCondition(me, ready)
+
+	if err := r.Client.Status().Update(ctx, me); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to update ManifestExport status: %w", err)
+	}
+
+	return exportErr
+}
+
+func (r *ManifestExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		For(&serviceApi.ManifestExport{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,198 @@
+package manifestexport_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/manifestexport"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/export"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+func newClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(objs...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not know the status subresource of the ManifestExport
+			SubResourceUpdate: func(ctx context.Context, cli client.Client, _ string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				return cli.Update(ctx, obj)
+			},
+		}),
+	)
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return cli
+}
+
+// extract returns the files of the given gzipped tarball, by path.
+func extract(t *testing.T, content []byte) map[string]string {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to read the export: %v", err)
+	}
+
+	files := make(map[string]string)
+
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("failed to read the export: %v", err)
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", h.Name, err)
+		}
+
+		files[h.Name] = string(b)
+	}
+}
+
+func newResource(apiVersion string, kind string, namespace string, name string, fields map[string]any) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: fields}
+	if u.Object == nil {
+		u.Object = map[string]any{}
+	}
+
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+
+	return u
+}
+
+func newStore() *export.Store {
+	store := export.NewStore()
+
+	store.Record("dashboard", []unstructured.Unstructured{
+		newResource("apps/v1", "Deployment", "opendatahub", "odh-dashboard", nil),
+		newResource("v1", "Secret", "opendatahub", "dashboard-oauth", map[string]any{
+			"stringData": map[string]any{"cookie-secret": "s3cr3t"},
+		}),
+		newResource("monitoring.coreos.com/v1", "PrometheusRule", "opendatahub", "dashboard-alerts", map[string]any{
+			"spec": map[string]any{"summary": "{{ $labels.pod }} is down"},
+		}),
+	})
+
+	// the Ray component has been removed since it was deployed
+	store.Record("ray", []unstructured.Unstructured{
+		newResource("apps/v1", "Deployment", "opendatahub", "kuberay-operator", nil),
+	})
+
+	return store
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name   string
+		format serviceApi.ManifestExportFormat
+		check  func(g Gomega, files map[string]string)
+	}{
+		{
+			name:   "kustomize",
+			format: serviceApi.ManifestExportKustomize,
+			check: func(g Gomega, files map[string]string) {
+				g.Expect(files).Should(HaveKeyWithValue("kustomization.yaml", And(
+					ContainSubstring("dashboard/deployment_opendatahub_odh-dashboard.yaml"),
+					ContainSubstring("dashboard/secret_opendatahub_dashboard-oauth.yaml"),
+				)))
+				g.Expect(files).Should(HaveKeyWithValue("dashboard/prometheusrule_opendatahub_dashboard-alerts.yaml",
+					ContainSubstring("'{{ $labels.pod }} is down'")))
+				g.Expect(files).Should(HaveKeyWithValue("dashboard/secret_opendatahub_dashboard-oauth.yaml", And(
+					ContainSubstring("cookie-secret: <redacted>"),
+					Not(ContainSubstring("s3cr3t")),
+				)))
+				g.Expect(files).ShouldNot(HaveKey("ray/deployment_opendatahub_kuberay-operator.yaml"))
+			},
+		},
+		{
+			name:   "helm",
+			format: serviceApi.ManifestExportHelm,
+			check: func(g Gomega, files map[string]string) {
+				g.Expect(files).Should(HaveKeyWithValue("opendatahub-platform/Chart.yaml", And(
+					ContainSubstring("name: opendatahub-platform"),
+					ContainSubstring("version: 1.2.3"),
+				)))
+				g.Expect(files).Should(HaveKey("opendatahub-platform/templates/dashboard/deployment_opendatahub_odh-dashboard.yaml"))
+				g.Expect(files).Should(HaveKeyWithValue("opendatahub-platform/templates/dashboard/prometheusrule_opendatahub_dashboard-alerts.yaml",
+					ContainSubstring("{{`{{`}} $labels.pod {{`}}`}} is down")))
+				g.Expect(files).ShouldNot(HaveKey("opendatahub-platform/templates/ray/deployment_opendatahub_kuberay-operator.yaml"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			me := &serviceApi.ManifestExport{
+				ObjectMeta: metav1.ObjectMeta{Name: "gitops"},
+				Spec: serviceApi.ManifestExportSpec{
+					Format:       tt.format,
+					ChartVersion: "1.2.3",
+				},
+			}
+
+			dashboard := &componentApi.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName},
+			}
+
+			cli := newClient(t, me, dashboard)
+
+			rec := manifestexport.ManifestExportReconciler{
+				Client:    cli,
+				APIReader: cli,
+				Store:     newStore(),
+				Namespace: operatorNamespace,
+			}
+
+			_, err := rec.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(me)})
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(me), me)).Should(Succeed())
+			g.Expect(me.Status.ConfigMap).Should(Equal("manifest-export-gitops"))
+			g.Expect(me.Status.Resources).Should(Equal(3))
+			g.Expect(me.Status.ExportedAt).ShouldNot(BeNil())
+			g.Expect(me.Status.Conditions).Should(ContainElement(And(
+				HaveField("Type", status.ConditionTypeReady),
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", status.ManifestsExportedReason),
+			)))
+
+			cm := corev1.ConfigMap{}
+			g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: operatorNamespace, Name: me.Status.ConfigMap}, &cm)).Should(Succeed())
+			g.Expect(cm.OwnerReferences).Should(ContainElement(HaveField("Name", me.Name)))
+
+			tt.check(g, extract(t, cm.BinaryData[serviceApi.ManifestExportKey]))
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
-	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
@@ -38,6 +41,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
@@ -88,8 +92,10 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 	_, err := reconciler.ReconcilerFor(mgr, &serviceApi.Monitoring{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		// operands - openshift
-		Owns(&routev1.Route{}).
+		OwnsGVK(gvk.Route, reconciler.Dynamic(reconciler.CrdExists(gvk.Route))).
 		// operands - owned dynmically depends on external operators are installed for monitoring
 		// TODO: add more here later when enable other operator
 		OwnsGVK(gvk.MonitoringStack, reconciler.Dynamic(reconciler.CrdExists(gvk.MonitoringStack))).
@@ -98,11 +104,13 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		OwnsGVK(gvk.Instrumentation, reconciler.Dynamic(reconciler.CrdExists(gvk.Instrumentation))).
 		OwnsGVK(gvk.OpenTelemetryCollector, reconciler.Dynamic(reconciler.CrdExists(gvk.OpenTelemetryCollector))).
 		OwnsGVK(gvk.ServiceMonitor, reconciler.Dynamic(reconciler.CrdExists(gvk.ServiceMonitor))).
+		OwnsGVK(gvk.PodMonitor, reconciler.Dynamic(reconciler.CrdExists(gvk.PodMonitor))).
 		OwnsGVK(gvk.PrometheusRule, reconciler.Dynamic(reconciler.CrdExists(gvk.PrometheusRule))).
 		OwnsGVK(gvk.ThanosQuerier, reconciler.Dynamic(reconciler.CrdExists(gvk.ThanosQuerier))).
 		OwnsGVK(gvk.Perses, reconciler.Dynamic(reconciler.CrdExists(gvk.Perses))).
 		OwnsGVK(gvk.PersesDatasource, reconciler.Dynamic(reconciler.CrdExists(gvk.PersesDatasource))).
 		OwnsGVK(gvk.PersesDashboard, reconciler.Dynamic(reconciler.CrdExists(gvk.PersesDashboard))).
+		OwnsGVK(gvk.GrafanaDashboard, reconciler.Dynamic(reconciler.CrdExists(gvk.GrafanaDashboard))).
 		// operands - watched
 		//
 		// By default the Watches functions adds:
@@ -126,6 +134,35 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 			reconciler.WithEventHandler(
 				handlers.ToNamed(serviceApi.MonitoringInstanceName)),
 		).
+		// credentials referenced by the collector, e.g. remote write auth and TLS, and by the alerting receivers
+		Watches(
+			&corev1.Secret{},
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(
+				predicate.Funcs{
+					CreateFunc: func(e event.CreateEvent) bool {
+						return isMonitoringSecret(ctx, mgr.GetClient(), e.Object)
+					},
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return isMonitoringSecret(ctx, mgr.GetClient(), e.ObjectNew)
+					},
+					DeleteFunc: func(e event.DeleteEvent) bool {
+						return isMonitoringSecret(ctx, mgr.GetClient(), e.Object)
+					},
+				},
+			),
+		).
+		// workloads created by the dependent operators, used to report the pipelines health
+		Watches(
+			&appsv1.Deployment{},
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(pipelineWorkloadReadinessChanged),
+		).
+		Watches(
+			&appsv1.StatefulSet{},
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(pipelineWorkloadReadinessChanged),
+		).
 		// These are only for SRE Monitoring
 		WithAction(initialize).
 		WithAction(updatePrometheusConfigMap).
@@ -137,13 +174,19 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(deployOpenTelemetryCollector).
 		WithAction(deployPerses).
 		WithAction(deployPersesDatasource).
+		WithAction(deployGrafanaDashboards).
 		WithAction(template.NewAction(
 			template.WithDataFn(getTemplateData),
 		)).
+		WithAction(renderOnly).
+		WithAction(validation.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
-		WithAction(gc.NewAction()).
+		WithAction(updatePipelineConditions).
+		WithAction(gc.NewAction(
+			gc.WithObjectPredicate(gcObjectPredicate),
+		)).
 		Build(ctx)
 
 	if err != nil {
@@ -5,10 +5,15 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
@@ -17,6 +22,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
@@ -26,6 +32,7 @@ const (
 	// Template files.
 	MonitoringStackTemplate                 = "resources/monitoring-stack.tmpl.yaml"
 	MonitoringStackAlertmanagerRBACTemplate = "resources/monitoringstack-alertmanager-rbac.tmpl.yaml"
+	AlertmanagerConfigTemplate              = "resources/alertmanager-config.tmpl.yaml"
 	TempoMonolithicTemplate                 = "resources/tempo-monolithic.tmpl.yaml"
 	TempoStackTemplate                      = "resources/tempo-stack.tmpl.yaml"
 	OpenTelemetryCollectorTemplate          = "resources/opentelemetry-collector.tmpl.yaml"
@@ -38,10 +45,24 @@ const (
 	PersesTemplate                          = "resources/perses.tmpl.yaml"
 	PersesTempoDatasourceTemplate           = "resources/perses-tempo-datasource.tmpl.yaml"
 	PersesTempoDashboardTemplate            = "resources/perses-tempo-dashboard.tmpl.yaml"
+	ComponentMonitorsTemplate               = "resources/component-monitors.tmpl.yaml"
+	OperatorMonitorTemplate                 = "resources/operator-monitor.tmpl.yaml"
+	GrafanaDashboardsTemplate               = "resources/grafana-dashboards.tmpl.yaml"
+	GrafanaDashboardsDir                    = "resources/grafana"
 
 	// Resource names.
 	PersesTempoDatasourceName = "tempo-datasource"
 	PersesTempoDashboardName  = "data-science-tempo-traces"
+	TempoMonolithicName       = "data-science-tempomonolithic"
+	TempoStackName            = "data-science-tempostack"
+	RenderedManifestsName     = "data-science-monitoring-rendered"
+	RenderedManifestsKey      = "manifests.yaml"
+
+	// Workloads created by the dependent operators for the monitoring resources.
+	CollectorDeploymentName     = "data-science-collector-collector"
+	PrometheusStatefulSetName   = "prometheus-data-science-monitoringstack"
+	AlertmanagerStatefulSetName = "alertmanager-data-science-monitoringstack"
+	TempoWorkloadPrefix         = "tempo-data-science-"
 )
 
 // CRDRequirement defines a required CRD and its associated condition for monitoring components.
@@ -65,6 +86,23 @@ var componentRules = map[string]string{
 	componentApi.LlamaStackOperatorComponentName:   "llamastackoperator",
 }
 
+// componentScrapeLabels maps component names to the app.opendatahub.io/<name> label set on
+// their workloads by the component controllers, used to select the scrape targets.
+var componentScrapeLabels = map[string]string{
+	componentApi.DashboardComponentName:            "dashboard",
+	componentApi.WorkbenchesComponentName:          "workbenches",
+	componentApi.KueueComponentName:                "kueue",
+	componentApi.DataSciencePipelinesComponentName: "data-science-pipelines-operator",
+	componentApi.RayComponentName:                  "ray",
+	componentApi.TrustyAIComponentName:             "trustyai",
+	componentApi.KserveComponentName:               "kserve",
+	componentApi.TrainingOperatorComponentName:     "trainingoperator",
+	componentApi.ModelRegistryComponentName:        "model-registry-operator",
+	componentApi.ModelControllerComponentName:      "odh-model-controller",
+	componentApi.FeastOperatorComponentName:        "feastoperator",
+	componentApi.LlamaStackOperatorComponentName:   "llamastackoperator",
+}
+
 //go:embed resources
 //go:embed monitoring
 var resourcesFS embed.FS
@@ -173,6 +211,20 @@ func deployMonitoringStackWithQuerier(ctx context.Context, rr *odhtypes.Reconcil
 		return nil
 	}
 
+	// Metrics are only forwarded by the collector to the custom exporters and remote write endpoints
+	if isDefaultStackDisabled(monitoring.Spec.Metrics) {
+		setConditionFalse(rr, status.ConditionMonitoringStackAvailable, status.MetricsDefaultStackDisabledReason, status.MetricsDefaultStackDisabledMessage)
+		setConditionFalse(rr, status.ConditionThanosQuerierAvailable, status.MetricsDefaultStackDisabledReason, status.MetricsDefaultStackDisabledMessage)
+		return nil
+	}
+
+	// Metrics are shipped by the collector straight to the remote write endpoints
+	if isRemoteWriteOnly(monitoring.Spec.Metrics) {
+		setConditionFalse(rr, status.ConditionMonitoringStackAvailable, status.MetricsRemoteWriteOnlyReason, status.MetricsRemoteWriteOnlyMessage)
+		setConditionFalse(rr, status.ConditionThanosQuerierAvailable, status.MetricsRemoteWriteOnlyReason, status.MetricsRemoteWriteOnlyMessage)
+		return nil
+	}
+
 	// Define required CRDs and their corresponding conditions for validation
 	requirements := []CRDRequirement{
 		{GVK: gvk.MonitoringStack, ConditionType: status.ConditionMonitoringStackAvailable},
@@ -197,6 +249,16 @@ func deployMonitoringStackWithQuerier(ctx context.Context, rr *odhtypes.Reconcil
 		{FS: resourcesFS, Path: ThanosQuerierRouteTemplate},
 	}
 
+	// Component monitors are served by the same Prometheus operator as the MonitoringStack
+	if len(monitoring.Spec.Metrics.ScrapeConfigs) > 0 {
+		templates = append(templates, odhtypes.TemplateInfo{FS: resourcesFS, Path: ComponentMonitorsTemplate})
+	}
+
+	// The operator exposes the reconciliation metrics of the platform, it is scraped from its own namespace
+	if ns, err := cluster.GetOperatorNamespace(); err == nil && ns != "" {
+		templates = append(templates, odhtypes.TemplateInfo{FS: resourcesFS, Path: OperatorMonitorTemplate})
+	}
+
 	// Deploy both components atomically with the same generation annotation
 	rr.Templates = append(rr.Templates, templates...)
 	return nil
@@ -222,6 +284,23 @@ func deployTracingStack(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 
 	traces := monitoring.Spec.Traces
 
+	// When traces are exported to an external backend, only Instrumentation is deployed
+	if !isTempoTracesBackend(traces) {
+		setConditionFalse(rr, status.ConditionTempoAvailable,
+			status.TempoNotSelectedReason, status.TempoNotSelectedMessage)
+
+		requirements := []CRDRequirement{
+			{GVK: gvk.Instrumentation, ConditionType: status.ConditionInstrumentationAvailable},
+		}
+		if !validateRequiredCRDs(ctx, rr, requirements) {
+			return nil
+		}
+
+		rr.Conditions.MarkTrue(status.ConditionInstrumentationAvailable)
+		rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{FS: resourcesFS, Path: InstrumentationTemplate})
+		return nil
+	}
+
 	// Determine required Tempo CRD based on storage backend
 	var tempoCRD schema.GroupVersionKind
 	var tempoTemplate string
@@ -263,8 +342,8 @@ func deployOpenTelemetryCollector(ctx context.Context, rr *odhtypes.Reconciliati
 		return errors.New("instance is not of type *services.Monitoring")
 	}
 
-	// Read metrics and traces configuration directly from Monitoring CR
-	if monitoring.Spec.Metrics == nil && monitoring.Spec.Traces == nil {
+	// Read metrics, traces and logs configuration directly from Monitoring CR
+	if monitoring.Spec.Metrics == nil && monitoring.Spec.Traces == nil && monitoring.Spec.Logs == nil {
 		// No metrics and traces configuration - skip OpenTelemetry collector deployment
 		rr.Conditions.MarkFalse(
 			status.ConditionOpenTelemetryCollectorAvailable,
@@ -348,6 +427,11 @@ func deployAlerting(ctx context.Context, rr *odhtypes.ReconciliationRequest) err
 			Path: "monitoring/operator-prometheusrules.tmpl.yaml",
 		},
 	}
+
+	// Alertmanager reads its configuration from the secret named after the MonitoringStack
+	if len(monitoring.Spec.Alerting.Receivers) > 0 {
+		templates = append(templates, odhtypes.TemplateInfo{FS: resourcesFS, Path: AlertmanagerConfigTemplate})
+	}
 	rr.Templates = append(rr.Templates, templates...)
 
 	dsc, err := cluster.GetDSC(ctx, rr.Client)
@@ -477,8 +561,8 @@ func deployPersesDatasource(ctx context.Context, rr *odhtypes.ReconciliationRequ
 		return fmt.Errorf("failed to check if PersesDashboard CRD exists: %w", err)
 	}
 
-	// Only create Perses datasource if traces are configured
-	if monitoring.Spec.Traces == nil {
+	// Only create Perses datasource if traces are configured and stored in Tempo
+	if monitoring.Spec.Traces == nil || !isTempoTracesBackend(monitoring.Spec.Traces) {
 		// Clean up existing datasource if its CRD exists
 		if persesDatasourceExists {
 			// Delete datasource
@@ -509,11 +593,13 @@ func deployPersesDatasource(ctx context.Context, rr *odhtypes.ReconciliationRequ
 			}
 		}
 
-		rr.Conditions.MarkFalse(
-			status.ConditionPersesTempoDataSourceAvailable,
-			conditions.WithReason(status.TracesNotConfiguredReason),
-			conditions.WithMessage(status.TracesNotConfiguredMessage),
-		)
+		if monitoring.Spec.Traces == nil {
+			setConditionFalse(rr, status.ConditionPersesTempoDataSourceAvailable,
+				status.TracesNotConfiguredReason, status.TracesNotConfiguredMessage)
+		} else {
+			setConditionFalse(rr, status.ConditionPersesTempoDataSourceAvailable,
+				status.TempoNotSelectedReason, status.TempoNotSelectedMessage)
+		}
 		return nil
 	}
 
@@ -548,3 +634,174 @@ func deployPersesDatasource(ctx context.Context, rr *odhtypes.ReconciliationRequ
 
 	return nil
 }
+
+// deployGrafanaDashboards deploys the curated Grafana dashboards. GrafanaDashboard resources are
+// used when the Grafana operator is installed, ConfigMaps for the Grafana sidecar otherwise.
+func deployGrafanaDashboards(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.Dashboards == nil || !monitoring.Spec.Dashboards.Enabled {
+		setConditionFalse(rr, status.ConditionGrafanaDashboardsAvailable,
+			status.DashboardsNotConfiguredReason, status.DashboardsNotConfiguredMessage)
+		return nil
+	}
+
+	grafanaExists, err := cluster.HasCRD(ctx, rr.Client, gvk.GrafanaDashboard)
+	if err != nil {
+		return fmt.Errorf("failed to check if CRD GrafanaDashboard exists: %w", err)
+	}
+	if grafanaExists {
+		rr.Conditions.MarkTrue(status.ConditionGrafanaDashboardsAvailable)
+	} else {
+		rr.Conditions.MarkTrue(
+			status.ConditionGrafanaDashboardsAvailable,
+			conditions.WithReason(status.DashboardConfigMapsReason),
+			conditions.WithMessage(status.DashboardConfigMapsMessage),
+		)
+	}
+
+	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{FS: resourcesFS, Path: GrafanaDashboardsTemplate})
+
+	return nil
+}
+
+// updatePipelineConditions reports the health of each telemetry pipeline from the readiness of the
+// workloads serving it, as determined by their health endpoint probes.
+func updatePipelineConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.RenderOnly {
+		for _, c := range []string{status.ConditionMetricsPipelineReady, status.ConditionTracesPipelineReady, status.ConditionAlertingReady} {
+			setConditionFalse(rr, c, status.RenderOnlyReason, status.RenderOnlyMessage)
+		}
+		return nil
+	}
+
+	ns := monitoring.Spec.Namespace
+	collector := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: CollectorDeploymentName, Namespace: ns}}
+	prometheus := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: PrometheusStatefulSetName, Namespace: ns}}
+	alertmanager := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: AlertmanagerStatefulSetName, Namespace: ns}}
+
+	if metrics := monitoring.Spec.Metrics; metrics == nil {
+		setConditionFalse(rr, status.ConditionMetricsPipelineReady, status.MetricsNotConfiguredReason, status.MetricsNotConfiguredMessage)
+	} else {
+		workloads := []client.Object{collector}
+		if deploysDefaultMetricsStack(metrics) {
+			workloads = append(workloads, prometheus)
+		}
+		if err := markPipelineCondition(ctx, rr, status.ConditionMetricsPipelineReady, workloads, nil); err != nil {
+			return err
+		}
+	}
+
+	if traces := monitoring.Spec.Traces; traces == nil {
+		setConditionFalse(rr, status.ConditionTracesPipelineReady, status.TracesNotConfiguredReason, status.TracesNotConfiguredMessage)
+	} else {
+		var tempo *unstructured.Unstructured
+		if isTempoTracesBackend(traces) {
+			tempo = &unstructured.Unstructured{}
+			if traces.Storage.Backend == "pv" {
+				tempo.SetGroupVersionKind(gvk.TempoMonolithic)
+				tempo.SetName(TempoMonolithicName)
+			} else {
+				tempo.SetGroupVersionKind(gvk.TempoStack)
+				tempo.SetName(TempoStackName)
+			}
+			tempo.SetNamespace(ns)
+		}
+		if err := markPipelineCondition(ctx, rr, status.ConditionTracesPipelineReady, []client.Object{collector}, tempo); err != nil {
+			return err
+		}
+	}
+
+	if monitoring.Spec.Alerting == nil {
+		setConditionFalse(rr, status.ConditionAlertingReady, status.AlertingNotConfiguredReason, status.AlertingNotConfiguredMessage)
+	} else {
+		// Alerting rules are evaluated by Prometheus and routed by Alertmanager
+		if err := markPipelineCondition(ctx, rr, status.ConditionAlertingReady, []client.Object{prometheus, alertmanager}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markPipelineCondition sets the pipeline condition to True if all its workloads and the optional
+// custom resource are ready, or to False with the reasons they are not.
+func markPipelineCondition(ctx context.Context, rr *odhtypes.ReconciliationRequest, conditionType string, workloads []client.Object, cr *unstructured.Unstructured) error {
+	var notReady []string
+
+	for _, w := range workloads {
+		msg, err := workloadNotReadyMessage(ctx, rr.Client, w)
+		if err != nil {
+			return err
+		}
+		if msg != "" {
+			notReady = append(notReady, msg)
+		}
+	}
+
+	if cr != nil {
+		msg, err := resourceNotReadyMessage(ctx, rr.Client, cr)
+		if err != nil {
+			return err
+		}
+		if msg != "" {
+			notReady = append(notReady, msg)
+		}
+	}
+
+	if len(notReady) > 0 {
+		setConditionFalse(rr, conditionType, status.PipelineWorkloadNotReadyReason, strings.Join(notReady, "; "))
+		return nil
+	}
+
+	rr.Conditions.MarkTrue(conditionType)
+
+	return nil
+}
+
+// renderOnly replaces the rendered resources with a ConfigMap holding their manifests when the
+// Monitoring CR is in render-only mode, so that nothing but the ConfigMap is applied.
+func renderOnly(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if !monitoring.Spec.RenderOnly {
+		return nil
+	}
+
+	manifests, err := renderManifests(rr.Resources)
+	if err != nil {
+		return err
+	}
+
+	rr.Resources = nil
+
+	return rr.AddResources(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RenderedManifestsName,
+			Namespace: monitoring.Spec.Namespace,
+		},
+		Data: map[string]string{
+			RenderedManifestsKey: manifests,
+		},
+	})
+}
+
+// gcObjectPredicate keeps the previously deployed resources while the Monitoring CR is in render-only mode.
+func gcObjectPredicate(rr *odhtypes.ReconciliationRequest, obj unstructured.Unstructured) (bool, error) {
+	if monitoring, ok := rr.Instance.(*serviceApi.Monitoring); ok && monitoring.Spec.RenderOnly {
+		return false, nil
+	}
+
+	return gc.DefaultObjectPredicate(rr, obj)
+}
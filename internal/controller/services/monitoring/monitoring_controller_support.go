@@ -2,22 +2,37 @@ package monitoring
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	sigsyaml "sigs.k8s.io/yaml"
 
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	componentMonitoring "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -27,24 +42,42 @@ import (
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	cond "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 const (
-	// Dependent operators names. match the one in the operatorcondition..
-	opentelemetryOperator        = "opentelemetry-operator"
-	clusterObservabilityOperator = "cluster-observability-operator"
-	tempoOperator                = "tempo-operator"
-
 	defaultCPULimit      = "500m"
 	defaultMemoryLimit   = "512Mi"
 	defaultCPURequest    = "100m"
 	defaultMemoryRequest = "256Mi"
 	defaultStorageSize   = "5Gi"
 	defaultRetention     = "90d"
+
+	// Exporter names used by the collector for the built-in trace backends.
+	tempoTracesExporter   = "otlp/tempo"
+	jaegerTracesExporter  = "otlp/jaeger"
+	backendTracesExporter = "otlp/backend"
 )
 
+// grafanaDashboards are the curated dashboards deployed when dashboards are enabled, named after
+// their JSON definition in GrafanaDashboardsDir.
+var grafanaDashboards = []string{"kserve-latency", "notebook-resources", "pipeline-runs"}
+
+// commonLabelsProcessor sets the Monitoring common labels as resource attributes.
+const commonLabelsProcessor = "resource/common-labels"
+
+// builtinProcessors are the processors every collector pipeline is built with.
+var builtinProcessors = []string{"memory_limiter", "batch", "k8sattributes", "resourcedetection", commonLabelsProcessor}
+
 var componentIDRE = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(?:/[A-Za-z0-9][A-Za-z0-9_-]*)?$`)
 
+// prometheusDurationRE matches Prometheus durations, e.g. "90d" or "1w2d".
+var prometheusDurationRE = regexp.MustCompile(`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`)
+
+// nonEnvNameCharRE matches the characters not allowed in collector env var names.
+var nonEnvNameCharRE = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
 // getPersesImage returns the Perses image from environment variable.
 // For RHOAI deployments, this comes from the CSV (via RHOAI-Build-Config/bundle/additional-images-patch.yaml).
 // For ODH deployments, this comes from config/manager/manager.yaml.
@@ -95,20 +128,35 @@ func isLocalServiceEndpoint(endpoint string) bool {
 	return false
 }
 
+// isReservedName reports whether the exporter name is used by the collector for a built-in exporter.
+// The names of the jaeger and otlp trace backends were reserved after their introduction, custom
+// exporters using them must be renamed before upgrading.
 func isReservedName(n string) bool {
 	reservedNames := map[string]bool{
-		"otlp/tempo": true,
-		"prometheus": true,
+		tempoTracesExporter:   true,
+		jaegerTracesExporter:  true,
+		backendTracesExporter: true,
+		"prometheus":          true,
 	}
 	return reservedNames[n]
 }
 
 func validateExporters(exporters map[string]runtime.RawExtension) (map[string]string, error) {
-	validatedExporters := make(map[string]string)
+	return validateComponentConfigs("exporter", exporters, ValidateExporter)
+}
+
+// validateComponentConfigs validates a set of collector component configs of the given kind
+// and returns the non-empty ones as YAML strings keyed by component name.
+func validateComponentConfigs(
+	kind string,
+	configs map[string]runtime.RawExtension,
+	validate func(name string, rawConfig runtime.RawExtension) (string, error),
+) (map[string]string, error) {
+	validated := make(map[string]string)
 
-	// Validate total size of all exporters combined
+	// Validate total size of all configs combined
 	totalSize := 0
-	for _, rawConfig := range exporters {
+	for _, rawConfig := range configs {
 		var raw []byte
 		switch {
 		case len(rawConfig.Raw) > 0:
@@ -123,74 +171,207 @@ func validateExporters(exporters map[string]runtime.RawExtension) (map[string]st
 		totalSize += len(raw)
 	}
 	if totalSize > maxTotalExporterSize {
-		return nil, fmt.Errorf("total exporter config size exceeds maximum of %d bytes (actual: %d bytes)",
-			maxTotalExporterSize, totalSize)
+		return nil, fmt.Errorf("total %s config size exceeds maximum of %d bytes (actual: %d bytes)",
+			kind, maxTotalExporterSize, totalSize)
 	}
 
-	for name, rawConfig := range exporters {
-		if isReservedName(name) {
-			return nil, fmt.Errorf("exporter name '%s' is reserved and cannot be used", name)
-		}
-
-		if !componentIDRE.MatchString(name) {
-			return nil, fmt.Errorf(
-				"invalid exporter name '%s': must match OpenTelemetry component ID format %q",
-				name, componentIDRE.String(),
-			)
+	for name, rawConfig := range configs {
+		configYAML, err := validate(name, rawConfig)
+		if err != nil {
+			return nil, err
 		}
-
-		// Obtain raw bytes from Raw or Object
-		var raw []byte
-		switch {
-		case len(rawConfig.Raw) > 0:
-			raw = rawConfig.Raw
-		case rawConfig.Object != nil:
-			b, err := yaml.Marshal(rawConfig.Object)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal exporter object for '%s': %w", name, err)
-			}
-			raw = b
-		default:
+		if configYAML == "" {
 			// nothing to process
 			continue
 		}
+		validated[name] = configYAML
+	}
 
-		// Validate individual exporter size (10KB limit)
-		if len(raw) > maxExporterSize {
-			return nil, fmt.Errorf("exporter '%s' config exceeds maximum size of %d bytes (actual: %d bytes)",
-				name, maxExporterSize, len(raw))
-		}
+	return validated, nil
+}
 
-		// Convert RawExtension to a map for validation and YAML conversion
-		var config map[string]interface{}
-		if err := yaml.Unmarshal(raw, &config); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal exporter config for '%s': %w", name, err)
-		}
-		// Treat empty/whitespace and YAML null as empty object for consistent rendering.
-		if config == nil {
-			config = map[string]interface{}{}
-		}
+// ValidateExporter validates a single custom exporter configuration and returns it as a YAML
+// string ready for template rendering. An empty string is returned if the exporter has no config.
+func ValidateExporter(name string, rawConfig runtime.RawExtension) (string, error) {
+	if isReservedName(name) {
+		return "", fmt.Errorf("exporter name '%s' is reserved and cannot be used", name)
+	}
 
-		// Enhanced security validations
-		if err := validateExporterConfigSecurity(name, config); err != nil {
-			return nil, err
-		}
+	config, err := parseComponentConfig("exporter", name, rawConfig)
+	if err != nil || config == nil {
+		return "", err
+	}
 
-		// Schema validation for known exporter types
-		if err := validateExporterSchema(name, config); err != nil {
-			return nil, err
+	// Secret references are replaced by the collector env vars they are mounted as
+	if _, err := resolveSecretRefs(name, config); err != nil {
+		return "", err
+	}
+
+	// Schema validation for known exporter types
+	if err := validateExporterSchema(name, config); err != nil {
+		return "", err
+	}
+
+	return marshalComponentConfig("exporter", name, config)
+}
+
+// resolveSecretRefs replaces the secret references in an exporter config with the collector env vars
+// holding their values, and returns those env vars. A secret reference is an object of the form
+//
+//	valueFrom:
+//	  secretKeyRef:
+//	    name: <secret in the monitoring namespace>
+//	    key: <key in the secret>
+func resolveSecretRefs(exporterName string, config map[string]interface{}) ([]collectorSecretEnv, error) {
+	var refs []collectorSecretEnv
+
+	var resolve func(value interface{}) (interface{}, error)
+	resolve = func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if _, ok := v["valueFrom"]; ok {
+				ref, err := parseSecretRef(exporterName, v)
+				if err != nil {
+					return nil, err
+				}
+				refs = append(refs, ref)
+				return "${env:" + ref.Name + "}", nil
+			}
+			for key, item := range v {
+				resolved, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = resolved
+			}
+		case []interface{}:
+			for i, item := range v {
+				resolved, err := resolve(item)
+				if err != nil {
+					return nil, err
+				}
+				v[i] = resolved
+			}
 		}
+		return value, nil
+	}
 
-		// Convert config back to YAML string for template rendering
-		configYAML, err := yaml.Marshal(config)
+	if _, err := resolve(config); err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+// parseSecretRef parses a valueFrom secret reference of an exporter config.
+func parseSecretRef(exporterName string, ref map[string]interface{}) (collectorSecretEnv, error) {
+	invalid := fmt.Errorf("exporter '%s' has an invalid secret reference: expected only valueFrom.secretKeyRef with name and key", exporterName)
+
+	valueFrom, ok := ref["valueFrom"].(map[string]interface{})
+	if !ok || len(ref) != 1 || len(valueFrom) != 1 {
+		return collectorSecretEnv{}, invalid
+	}
+	secretKeyRef, ok := valueFrom["secretKeyRef"].(map[string]interface{})
+	if !ok || len(secretKeyRef) != 2 {
+		return collectorSecretEnv{}, invalid
+	}
+	name, _ := secretKeyRef["name"].(string)
+	key, _ := secretKeyRef["key"].(string)
+	if name == "" || key == "" {
+		return collectorSecretEnv{}, invalid
+	}
+
+	return collectorSecretEnv{Name: secretRefEnvName(name, key), Secret: name, Key: key}, nil
+}
+
+// secretRefEnvName returns the collector env var name a secret key is exposed as. A hash of the
+// secret and key is appended as different names can map to the same sanitized env var name.
+func secretRefEnvName(secret, key string) string {
+	sanitize := func(s string) string {
+		return strings.ToUpper(nonEnvNameCharRE.ReplaceAllString(s, "_"))
+	}
+	hash := sha256.Sum256([]byte(secret + "/" + key))
+
+	return fmt.Sprintf("EXPORTER_SECRET_%s_%s_%s", sanitize(secret), sanitize(key), hex.EncodeToString(hash[:4]))
+}
+
+// ValidateProcessor validates a single custom processor configuration and returns it as a YAML
+// string ready for template rendering. An empty string is returned if the processor has no config.
+func ValidateProcessor(name string, rawConfig runtime.RawExtension) (string, error) {
+	if isReservedProcessorName(name) {
+		return "", fmt.Errorf("processor name '%s' is reserved and cannot be used", name)
+	}
+
+	config, err := parseComponentConfig("processor", name, rawConfig)
+	if err != nil || config == nil {
+		return "", err
+	}
+
+	return marshalComponentConfig("processor", name, config)
+}
+
+// isReservedProcessorName returns true for the processors the collector pipelines are built with.
+func isReservedProcessorName(n string) bool {
+	return slices.Contains(builtinProcessors, n)
+}
+
+// parseComponentConfig checks the name and size of a collector component config and decodes it.
+// A nil map is returned if no config is set.
+func parseComponentConfig(kind, name string, rawConfig runtime.RawExtension) (map[string]interface{}, error) {
+	if !componentIDRE.MatchString(name) {
+		return nil, fmt.Errorf(
+			"invalid %s name '%s': must match OpenTelemetry component ID format %q",
+			kind, name, componentIDRE.String(),
+		)
+	}
+
+	// Obtain raw bytes from Raw or Object
+	var raw []byte
+	switch {
+	case len(rawConfig.Raw) > 0:
+		raw = rawConfig.Raw
+	case rawConfig.Object != nil:
+		b, err := yaml.Marshal(rawConfig.Object)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal exporter config for '%s': %w", name, err)
+			return nil, fmt.Errorf("failed to marshal %s object for '%s': %w", kind, name, err)
 		}
-		// Store the YAML string for template rendering with the indent template function
-		validatedExporters[name] = strings.TrimSpace(string(configYAML))
+		raw = b
+	default:
+		return nil, nil
+	}
+
+	// Validate individual config size (10KB limit)
+	if len(raw) > maxExporterSize {
+		return nil, fmt.Errorf("%s '%s' config exceeds maximum size of %d bytes (actual: %d bytes)",
+			kind, name, maxExporterSize, len(raw))
+	}
+
+	// Convert RawExtension to a map for validation and YAML conversion
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s config for '%s': %w", kind, name, err)
+	}
+	// Treat empty/whitespace and YAML null as empty object for consistent rendering.
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	// Enhanced security validations
+	if err := validateConfigSecurity(kind, name, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// marshalComponentConfig converts a validated config back to a YAML string for template rendering.
+func marshalComponentConfig(kind, name string, config map[string]interface{}) (string, error) {
+	configYAML, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s config for '%s': %w", kind, name, err)
 	}
 
-	return validatedExporters, nil
+	return strings.TrimSpace(string(configYAML)), nil
 }
 
 func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Traces, namespace string) error {
@@ -225,6 +406,12 @@ func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Trace
 		if err != nil {
 			return err
 		}
+		// The exporter of the selected backend is built-in, custom exporters cannot override it
+		if backendExporter, ok := templateData["TracesBackendExporter"].(string); ok {
+			if _, exists := validatedExporters[backendExporter]; exists {
+				return fmt.Errorf("exporter name '%s' is reserved and cannot be used", backendExporter)
+			}
+		}
 		for n := range validatedExporters {
 			exporterNames = append(exporterNames, n)
 		}
@@ -250,14 +437,37 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 	}
 
 	templateData := map[string]any{
-		"Namespace":            monitoring.Spec.Namespace,
-		"Traces":               monitoring.Spec.Traces != nil,
-		"Metrics":              monitoring.Spec.Metrics != nil,
-		"AcceleratorMetrics":   monitoring.Spec.Metrics != nil,
-		"ApplicationNamespace": appNamespace,
-		"MetricsExporters":     make(map[string]string),
-		"MetricsExporterNames": []string{},
-		"PersesImage":          getPersesImage(),
+		"Namespace":                monitoring.Spec.Namespace,
+		"Traces":                   monitoring.Spec.Traces != nil,
+		"Metrics":                  monitoring.Spec.Metrics != nil,
+		"AcceleratorMetrics":       monitoring.Spec.Metrics != nil,
+		"ApplicationNamespace":     appNamespace,
+		"MetricsExporters":         make(map[string]string),
+		"MetricsExporterNames":     []string{},
+		"PersesImage":              getPersesImage(),
+		"TracesBackendType":        getTracesBackendType(monitoring.Spec.Traces),
+		"ScrapeConfigs":            []componentScrapeConfig{},
+		"Logs":                     monitoring.Spec.Logs != nil,
+		"LogsExporters":            make(map[string]string),
+		"LogsExporterNames":        []string{},
+		"CollectorResources":       "",
+		"TempoResources":           "",
+		"RemoteWrites":             []remoteWriteExporter{},
+		"MetricsPipelineExporters": []string{},
+		"DefaultMetricsStack":      deploysDefaultMetricsStack(monitoring.Spec.Metrics),
+		"CommonLabels":             monitoring.Spec.CommonLabels,
+	}
+
+	if err := addCommonLabelsData(monitoring.Spec.CommonLabels, templateData); err != nil {
+		return nil, err
+	}
+
+	if err := addProcessorsData(monitoring, templateData); err != nil {
+		return nil, err
+	}
+
+	if err := addAlertingData(ctx, rr, monitoring.Spec.Alerting, monitoring.Spec.Namespace, templateData); err != nil {
+		return nil, err
 	}
 
 	// Add metrics-related data if metrics are configured
@@ -275,8 +485,29 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		}
 	}
 
+	// Add logs-related data if logs are configured
+	if logs := monitoring.Spec.Logs; logs != nil {
+		if err := addLogsData(logs, templateData); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := addCollectorCredentialsData(ctx, rr, monitoring, templateData); err != nil {
+		return nil, err
+	}
+
+	if err := addDashboardsData(ctx, rr, monitoring.Spec.Dashboards, templateData); err != nil {
+		return nil, err
+	}
+
 	templateData["CollectorReplicas"] = monitoring.Spec.CollectorReplicas
 
+	addOperatorMetricsData(rr, templateData)
+
+	if err := addSizeData(ctx, rr, monitoring, templateData); err != nil {
+		return nil, err
+	}
+
 	return templateData, nil
 }
 
@@ -312,7 +543,7 @@ func checkMonitoringPreconditions(ctx context.Context, rr *odhtypes.Reconciliati
 
 	// Check for opentelemetry-product operator if either metrics or traces are enabled
 	if monitoring.Spec.Metrics != nil || monitoring.Spec.Traces != nil {
-		if found, err := cluster.OperatorExists(ctx, rr.Client, opentelemetryOperator); err != nil || !found {
+		if found, err := dependency.Installed(ctx, rr.Client, dependency.OpenTelemetry); err != nil || !found {
 			if err != nil {
 				return odherrors.NewStopErrorW(err)
 			}
@@ -320,78 +551,747 @@ func checkMonitoringPreconditions(ctx context.Context, rr *odhtypes.Reconciliati
 		}
 	}
 
-	// Check for cluster-observability-operator if metrics are enabled
-	if monitoring.Spec.Metrics != nil {
-		if found, err := cluster.OperatorExists(ctx, rr.Client, clusterObservabilityOperator); err != nil || !found {
-			if err != nil {
-				return odherrors.NewStopErrorW(err)
-			}
-			allErrors = multierror.Append(allErrors, odherrors.NewStopError(status.COOMissingMessage))
-		}
+	// Check for cluster-observability-operator if metrics are enabled
+	if monitoring.Spec.Metrics != nil {
+		if found, err := dependency.Installed(ctx, rr.Client, dependency.ClusterObservability); err != nil || !found {
+			if err != nil {
+				return odherrors.NewStopErrorW(err)
+			}
+			allErrors = multierror.Append(allErrors, odherrors.NewStopError(status.COOMissingMessage))
+		}
+	}
+
+	// Check for tempo-product operator if traces are enabled and exported to Tempo
+	if monitoring.Spec.Traces != nil && isTempoTracesBackend(monitoring.Spec.Traces) {
+		if found, err := dependency.Installed(ctx, rr.Client, dependency.Tempo); err != nil || !found {
+			if err != nil {
+				return odherrors.NewStopErrorW(err)
+			}
+			allErrors = multierror.Append(allErrors, odherrors.NewStopError(status.TempoOperatorMissingMessage))
+		}
+	}
+
+	return allErrors.ErrorOrNil()
+}
+
+func addPrometheusRules(componentName string, rr *odhtypes.ReconciliationRequest) error {
+	componentRules := fmt.Sprintf("%s/monitoring/%s-prometheusrules.tmpl.yaml", componentName, componentName)
+
+	if !common.FileExists(componentMonitoring.ComponentRulesFS, componentRules) {
+		return fmt.Errorf("prometheus rules file for component %s not found", componentName)
+	}
+
+	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+		FS:   componentMonitoring.ComponentRulesFS,
+		Path: componentRules,
+	})
+
+	return nil
+}
+
+// if a component is disabled, we need to delete the prometheus rules. If the DSCI is deleted
+// the rules will be gc'd automatically.
+func cleanupPrometheusRules(ctx context.Context, componentName string, rr *odhtypes.ReconciliationRequest) error {
+	// Fetch monitoring namespace from DSCI
+	monitoringNamespace, err := cluster.MonitoringNamespace(ctx, rr.Client)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			// No DSCI means no monitoring namespace configured, nothing to clean up
+			return nil
+		}
+		return err
+	}
+
+	pr := &unstructured.Unstructured{}
+	pr.SetGroupVersionKind(gvk.PrometheusRule)
+	pr.SetName(fmt.Sprintf("%s-prometheusrules", componentName))
+	pr.SetNamespace(monitoringNamespace)
+
+	if err := rr.Client.Delete(ctx, pr); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete prometheus rule for component %s: %w", componentName, err)
+	}
+
+	return nil
+}
+
+// addMetricsData adds metrics configuration data to the template data map.
+func addMetricsData(ctx context.Context, rr *odhtypes.ReconciliationRequest, metrics *serviceApi.Metrics, templateData map[string]any) error {
+	addResourceData(metrics, templateData)
+	if err := addStorageData(metrics, templateData); err != nil {
+		return err
+	}
+	addReplicasData(ctx, rr, metrics, templateData)
+	if err := addScrapeConfigsData(rr, metrics, templateData); err != nil {
+		return err
+	}
+	if err := addExportersData(metrics, templateData); err != nil {
+		return err
+	}
+	return addRemoteWriteData(metrics, templateData)
+}
+
+// isRemoteWriteOnly returns true if metrics are only remote written, without in-cluster storage.
+func isRemoteWriteOnly(metrics *serviceApi.Metrics) bool {
+	return metrics != nil && metrics.Storage == nil && metrics.Resources == nil && len(metrics.RemoteWrite) > 0
+}
+
+// isDefaultStackDisabled returns true if the in-cluster metrics stack is explicitly disabled.
+func isDefaultStackDisabled(metrics *serviceApi.Metrics) bool {
+	return metrics != nil && !ptr.Deref(metrics.DeployDefaultStack, true)
+}
+
+// deploysDefaultMetricsStack returns true if metrics are stored in the in-cluster metrics stack.
+func deploysDefaultMetricsStack(metrics *serviceApi.Metrics) bool {
+	return metrics != nil && !isDefaultStackDisabled(metrics) && !isRemoteWriteOnly(metrics)
+}
+
+// remoteWriteExporter holds the data needed to render the prometheusremotewrite exporter of a target.
+// Targets with write relabel configs get a dedicated pipeline with a filter processor, as the
+// exporter itself does not support relabeling.
+type remoteWriteExporter struct {
+	Exporter           string
+	URL                string
+	InsecureSkipVerify bool
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	BearerTokenEnv     string
+	BasicAuthExtension string
+	Pipeline           string
+	Filter             string
+	FilterConfig       string
+}
+
+// addRemoteWriteData adds the remote write targets to the template data map.
+func addRemoteWriteData(metrics *serviceApi.Metrics, templateData map[string]any) error {
+	remoteWrites := make([]remoteWriteExporter, 0, len(metrics.RemoteWrite))
+
+	for _, rw := range metrics.RemoteWrite {
+		exporter := remoteWriteExporterName(rw.Name)
+		if _, ok := metrics.Exporters[exporter]; ok {
+			return fmt.Errorf("remote write '%s' conflicts with custom metrics exporter '%s'", rw.Name, exporter)
+		}
+		if strings.HasPrefix(rw.URL, "http://") && !isLocalServiceEndpoint(rw.URL) {
+			return fmt.Errorf("remote write '%s': insecure HTTP endpoints not allowed for external services", rw.Name)
+		}
+
+		data := remoteWriteExporter{
+			Exporter: exporter,
+			URL:      rw.URL,
+		}
+
+		if tls := rw.TLS; tls != nil {
+			data.InsecureSkipVerify = tls.InsecureSkipVerify
+			if tls.CAConfigMap != "" {
+				data.CAFile = remoteWriteMountPath(rw.Name, "ca") + "/ca.crt"
+			}
+			if tls.CertificateSecret != "" {
+				data.CertFile = remoteWriteMountPath(rw.Name, "tls") + "/tls.crt"
+				data.KeyFile = remoteWriteMountPath(rw.Name, "tls") + "/tls.key"
+			}
+		}
+
+		if auth := rw.Auth; auth != nil {
+			if auth.BearerTokenSecret != "" {
+				data.BearerTokenEnv = remoteWriteEnvPrefix(rw.Name) + "_TOKEN"
+			}
+			if auth.BasicAuthSecret != "" {
+				data.BasicAuthExtension = "basicauth/remote-write-" + rw.Name
+			}
+		}
+
+		if len(rw.WriteRelabelConfigs) > 0 {
+			conditions := make([]string, 0, len(rw.WriteRelabelConfigs))
+			for _, rc := range rw.WriteRelabelConfigs {
+				condition, err := relabelFilterCondition(rc)
+				if err != nil {
+					return fmt.Errorf("remote write '%s': %w", rw.Name, err)
+				}
+				conditions = append(conditions, condition)
+			}
+
+			b, err := sigsyaml.Marshal(map[string]any{
+				"error_mode": "ignore",
+				"metrics":    map[string]any{"datapoint": conditions},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to marshal write relabel configs for remote write '%s': %w", rw.Name, err)
+			}
+			data.Pipeline = "metrics/remote-write-" + rw.Name
+			data.Filter = "filter/remote-write-" + rw.Name
+			data.FilterConfig = strings.TrimSpace(string(b))
+		}
+
+		remoteWrites = append(remoteWrites, data)
+	}
+
+	templateData["RemoteWrites"] = remoteWrites
+
+	// Targets with their own pipeline are not part of the main metrics pipeline
+	pipelineExporters := make([]string, 0)
+	if deploysDefaultMetricsStack(metrics) {
+		pipelineExporters = append(pipelineExporters, "prometheus")
+	}
+	for _, rw := range remoteWrites {
+		if rw.Pipeline == "" {
+			pipelineExporters = append(pipelineExporters, rw.Exporter)
+		}
+	}
+	if exporterNames, ok := templateData["MetricsExporterNames"].([]string); ok {
+		pipelineExporters = append(pipelineExporters, exporterNames...)
+	}
+	templateData["MetricsPipelineExporters"] = pipelineExporters
+
+	return nil
+}
+
+func remoteWriteExporterName(name string) string {
+	return "prometheusremotewrite/" + name
+}
+
+func remoteWriteMountPath(name, kind string) string {
+	return "/etc/otelcol/remote-write/" + name + "/" + kind
+}
+
+func remoteWriteEnvPrefix(name string) string {
+	return "REMOTE_WRITE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// relabelFilterCondition converts a keep or drop relabel config into an OTTL condition of the filter
+// processor. The filter processor drops the data points matching any condition.
+func relabelFilterCondition(rc serviceApi.MetricsRelabelConfig) (string, error) {
+	if len(rc.SourceLabels) == 0 {
+		return "", errors.New("write relabel configs require sourceLabels")
+	}
+
+	values := make([]string, 0, len(rc.SourceLabels))
+	for _, label := range rc.SourceLabels {
+		if label == "__name__" {
+			values = append(values, "metric.name")
+		} else {
+			values = append(values, fmt.Sprintf("datapoint.attributes[%s]", strconv.Quote(label)))
+		}
+	}
+
+	value := values[0]
+	if len(values) > 1 {
+		value = fmt.Sprintf("Concat([%s], %s)", strings.Join(values, ", "), strconv.Quote(getStringValueOrDefault(rc.Separator, ";")))
+	}
+
+	// Prometheus regexes are fully anchored
+	match := fmt.Sprintf("IsMatch(%s, %s)", value, strconv.Quote("^(?:"+getStringValueOrDefault(rc.Regex, "(.*)")+")$"))
+
+	switch rc.Action {
+	case "drop":
+		return match, nil
+	case "keep":
+		return "not " + match, nil
+	default:
+		return "", fmt.Errorf("unsupported write relabel action '%s', only keep and drop are supported", rc.Action)
+	}
+}
+
+// collectorSecretEnv is a collector environment variable populated from a secret key.
+type collectorSecretEnv struct {
+	Name   string
+	Secret string
+	Key    string
+}
+
+// collectorVolume is a ConfigMap or secret mounted read-only into the collector.
+type collectorVolume struct {
+	Name      string
+	ConfigMap string
+	Secret    string
+	MountPath string
+}
+
+// collectorBasicAuth is a basicauth extension reading its credentials from environment variables.
+type collectorBasicAuth struct {
+	Name        string
+	UsernameEnv string
+	PasswordEnv string
+}
+
+// collectorCredentials collects the secrets and ConfigMaps the collector needs to reach external backends.
+type collectorCredentials struct {
+	Env       []collectorSecretEnv
+	Volumes   []collectorVolume
+	BasicAuth []collectorBasicAuth
+}
+
+func (c *collectorCredentials) addAuth(bearerTokenSecret, basicAuthSecret, envPrefix, basicAuthExtension string) {
+	if bearerTokenSecret != "" {
+		c.Env = append(c.Env, collectorSecretEnv{Name: envPrefix + "_TOKEN", Secret: bearerTokenSecret, Key: "token"})
+	}
+	if basicAuthSecret != "" {
+		c.Env = append(c.Env,
+			collectorSecretEnv{Name: envPrefix + "_USERNAME", Secret: basicAuthSecret, Key: "username"},
+			collectorSecretEnv{Name: envPrefix + "_PASSWORD", Secret: basicAuthSecret, Key: "password"},
+		)
+		c.BasicAuth = append(c.BasicAuth, collectorBasicAuth{
+			Name:        basicAuthExtension,
+			UsernameEnv: envPrefix + "_USERNAME",
+			PasswordEnv: envPrefix + "_PASSWORD",
+		})
+	}
+}
+
+func (c *collectorCredentials) addTLS(caConfigMap, certificateSecret, volumePrefix, mountPrefix string) {
+	if caConfigMap != "" {
+		c.Volumes = append(c.Volumes, collectorVolume{Name: volumePrefix + "-ca", ConfigMap: caConfigMap, MountPath: mountPrefix + "/ca"})
+	}
+	if certificateSecret != "" {
+		c.Volumes = append(c.Volumes, collectorVolume{Name: volumePrefix + "-tls", Secret: certificateSecret, MountPath: mountPrefix + "/tls"})
+	}
+}
+
+// addExporterSecretRefs adds the env vars of the secret references in the given exporter configs.
+// Invalid configs are skipped, they are reported when the exporters are validated.
+func (c *collectorCredentials) addExporterSecretRefs(exporters map[string]runtime.RawExtension) {
+	names := make([]string, 0, len(exporters))
+	for name := range exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		config, err := parseComponentConfig("exporter", name, exporters[name])
+		if err != nil || config == nil {
+			continue
+		}
+		refs, err := resolveSecretRefs(name, config)
+		if err != nil {
+			continue
+		}
+		for _, ref := range refs {
+			if !slices.Contains(c.Env, ref) {
+				c.Env = append(c.Env, ref)
+			}
+		}
+	}
+}
+
+// secretNames returns the sorted, unique names of the secrets referenced by the credentials.
+func (c *collectorCredentials) secretNames() []string {
+	names := make([]string, 0, len(c.Env)+len(c.Volumes))
+	for _, e := range c.Env {
+		names = append(names, e.Secret)
+	}
+	for _, v := range c.Volumes {
+		if v.Secret != "" {
+			names = append(names, v.Secret)
+		}
+	}
+	sort.Strings(names)
+	return slices.Compact(names)
+}
+
+// getCollectorCredentials returns the credentials of the external trace backend, the remote write
+// targets and the secrets referenced by custom exporters.
+func getCollectorCredentials(monitoring *serviceApi.Monitoring) collectorCredentials {
+	var creds collectorCredentials
+
+	if metrics := monitoring.Spec.Metrics; metrics != nil {
+		creds.addExporterSecretRefs(metrics.Exporters)
+	}
+	if traces := monitoring.Spec.Traces; traces != nil {
+		creds.addExporterSecretRefs(traces.Exporters)
+	}
+	if logs := monitoring.Spec.Logs; logs != nil {
+		creds.addExporterSecretRefs(logs.Exporters)
+	}
+
+	if traces := monitoring.Spec.Traces; traces != nil && !isTempoTracesBackend(traces) {
+		if auth := traces.Backend.Auth; auth != nil {
+			creds.addAuth(auth.BearerTokenSecret, auth.BasicAuthSecret, "TRACES_BACKEND", "basicauth/traces-backend")
+		}
+		if tls := traces.Backend.TLS; tls != nil {
+			creds.addTLS(tls.CAConfigMap, tls.CertificateSecret, "traces-backend", "/etc/otelcol/traces-backend")
+		}
+	}
+
+	if metrics := monitoring.Spec.Metrics; metrics != nil {
+		for _, rw := range metrics.RemoteWrite {
+			if auth := rw.Auth; auth != nil {
+				creds.addAuth(auth.BearerTokenSecret, auth.BasicAuthSecret, remoteWriteEnvPrefix(rw.Name), "basicauth/remote-write-"+rw.Name)
+			}
+			if tls := rw.TLS; tls != nil {
+				creds.addTLS(tls.CAConfigMap, tls.CertificateSecret, "remote-write-"+rw.Name, "/etc/otelcol/remote-write/"+rw.Name)
+			}
+		}
+	}
+
+	return creds
+}
+
+// addCollectorCredentialsData adds the collector env vars, volumes and auth extensions to the template data map.
+// The referenced secrets must exist in the monitoring namespace; a hash of their content is added so the
+// collector is rolled out again when credentials change.
+func addCollectorCredentialsData(ctx context.Context, rr *odhtypes.ReconciliationRequest, monitoring *serviceApi.Monitoring, templateData map[string]any) error {
+	creds := getCollectorCredentials(monitoring)
+
+	templateData["CollectorEnv"] = creds.Env
+	templateData["CollectorVolumes"] = creds.Volumes
+	templateData["CollectorBasicAuth"] = creds.BasicAuth
+	templateData["CollectorSecretsHash"] = ""
+
+	names := creds.secretNames()
+	if len(names) == 0 {
+		return nil
+	}
+
+	hash := sha256.New()
+	for _, name := range names {
+		secret, err := cluster.GetSecret(ctx, rr.Client, monitoring.Spec.Namespace, name)
+		if err != nil {
+			return fmt.Errorf("failed to get secret %s/%s referenced by the collector: %w", monitoring.Spec.Namespace, name, err)
+		}
+
+		keys := make([]string, 0, len(secret.Data))
+		for k := range secret.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		hash.Write([]byte(name))
+		for _, k := range keys {
+			hash.Write([]byte(k))
+			hash.Write(secret.Data[k])
+		}
+	}
+	templateData["CollectorSecretsHash"] = hex.EncodeToString(hash.Sum(nil))
+
+	return nil
+}
+
+// isMonitoringSecret returns true if the secret is referenced by the collector configuration or
+// by an alerting receiver of the Monitoring instance, so that credential changes trigger a
+// reconciliation.
+func isMonitoringSecret(ctx context.Context, cli client.Client, obj client.Object) bool {
+	monitoring := &serviceApi.Monitoring{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.MonitoringInstanceName}, monitoring); err != nil {
+		return false
+	}
+
+	if obj.GetNamespace() != monitoring.Spec.Namespace {
+		return false
+	}
+
+	creds := getCollectorCredentials(monitoring)
+	if slices.Contains(creds.secretNames(), obj.GetName()) {
+		return true
+	}
+
+	return slices.Contains(alertingSecretNames(monitoring.Spec.Alerting), obj.GetName())
+}
+
+// renderManifests returns the given resources as a multi-document YAML, sorted by kind, namespace
+// and name. The values of secrets are redacted.
+func renderManifests(resources []unstructured.Unstructured) (string, error) {
+	sorted := make([]unstructured.Unstructured, 0, len(resources))
+	for i := range resources {
+		sorted = append(sorted, *resources[i].DeepCopy())
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	var manifests strings.Builder
+	for i := range sorted {
+		u := &sorted[i]
+		if u.GetKind() == "Secret" {
+			for _, field := range []string{"data", "stringData"} {
+				values, found, err := unstructured.NestedMap(u.Object, field)
+				if err != nil || !found {
+					continue
+				}
+				for k := range values {
+					values[k] = redactedValue
+				}
+				if err := unstructured.SetNestedMap(u.Object, values, field); err != nil {
+					return "", fmt.Errorf("failed to redact secret %s: %w", u.GetName(), err)
+				}
+			}
+		}
+
+		b, err := sigsyaml.Marshal(u.Object)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+		manifests.WriteString("---\n")
+		manifests.Write(b)
+	}
+
+	if manifests.Len() > maxRenderedManifestsSize {
+		return "", fmt.Errorf("rendered monitoring manifests exceed the maximum ConfigMap size of %d bytes (actual: %d bytes)",
+			maxRenderedManifestsSize, manifests.Len())
+	}
+
+	return manifests.String(), nil
+}
+
+// grafanaDashboard holds the data needed to render a curated Grafana dashboard.
+type grafanaDashboard struct {
+	Name string
+	File string
+	JSON string
+}
+
+// addDashboardsData adds the curated Grafana dashboards to the template data map.
+func addDashboardsData(ctx context.Context, rr *odhtypes.ReconciliationRequest, dashboards *serviceApi.Dashboards, templateData map[string]any) error {
+	templateData["GrafanaDashboards"] = []grafanaDashboard{}
+	templateData["GrafanaOperator"] = false
+	templateData["GrafanaInstanceSelector"] = map[string]string{}
+
+	if dashboards == nil || !dashboards.Enabled {
+		return nil
+	}
+
+	grafanaExists, err := cluster.HasCRD(ctx, rr.Client, gvk.GrafanaDashboard)
+	if err != nil {
+		return fmt.Errorf("failed to check if CRD GrafanaDashboard exists: %w", err)
+	}
+
+	result := make([]grafanaDashboard, 0, len(grafanaDashboards))
+	for _, name := range grafanaDashboards {
+		content, err := resourcesFS.ReadFile(GrafanaDashboardsDir + "/" + name + ".json")
+		if err != nil {
+			return fmt.Errorf("failed to read Grafana dashboard %s: %w", name, err)
+		}
+		result = append(result, grafanaDashboard{
+			Name: "data-science-" + name,
+			File: name + ".json",
+			JSON: strings.TrimSpace(string(content)),
+		})
+	}
+
+	templateData["GrafanaDashboards"] = result
+	templateData["GrafanaOperator"] = grafanaExists
+	if dashboards.InstanceSelector != nil {
+		templateData["GrafanaInstanceSelector"] = dashboards.InstanceSelector
+	}
+
+	return nil
+}
+
+// addCommonLabelsData adds the relabelings that set the common labels on the series scraped by the
+// generated monitors to the template data map.
+func addCommonLabelsData(commonLabels map[string]string, templateData map[string]any) error {
+	templateData["CommonLabelsRelabelings"] = ""
+	if len(commonLabels) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(commonLabels))
+	for k := range commonLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	relabelings := make([]serviceApi.MetricsRelabelConfig, 0, len(keys))
+	for _, k := range keys {
+		relabelings = append(relabelings, serviceApi.MetricsRelabelConfig{
+			Action:      "replace",
+			TargetLabel: k,
+			Replacement: commonLabels[k],
+		})
+	}
+
+	b, err := sigsyaml.Marshal(relabelings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal common labels relabelings: %w", err)
+	}
+	templateData["CommonLabelsRelabelings"] = strings.TrimSpace(string(b))
+
+	return nil
+}
+
+// workloadNotReadyMessage returns why a Deployment or StatefulSet is not ready, or an empty string
+// if all its desired replicas are ready.
+func workloadNotReadyMessage(ctx context.Context, cli client.Client, obj client.Object) (string, error) {
+	kind := "Deployment"
+	if _, ok := obj.(*appsv1.StatefulSet); ok {
+		kind = "StatefulSet"
+	}
+
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if k8serr.IsNotFound(err) {
+			return fmt.Sprintf("%s %s not found", kind, obj.GetName()), nil
+		}
+		return "", fmt.Errorf("failed to get %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	var desired *int32
+	var ready int32
+	switch w := obj.(type) {
+	case *appsv1.Deployment:
+		desired, ready = w.Spec.Replicas, w.Status.ReadyReplicas
+	case *appsv1.StatefulSet:
+		desired, ready = w.Spec.Replicas, w.Status.ReadyReplicas
+	default:
+		return "", fmt.Errorf("unsupported workload type %T", obj)
+	}
+
+	replicas := ptr.Deref(desired, 1)
+	if replicas == 0 || ready < replicas {
+		return fmt.Sprintf("%s %s has %d/%d ready replicas", kind, obj.GetName(), ready, replicas), nil
+	}
+
+	return "", nil
+}
+
+// resourceNotReadyMessage returns why a custom resource does not report a Ready condition, or an
+// empty string if it is ready.
+func resourceNotReadyMessage(ctx context.Context, cli client.Client, obj *unstructured.Unstructured) (string, error) {
+	kind := obj.GetKind()
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return fmt.Sprintf("%s %s not found", kind, obj.GetName()), nil
+		}
+		return "", fmt.Errorf("failed to get %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return "", fmt.Errorf("failed to read conditions of %s %s: %w", kind, obj.GetName(), err)
 	}
 
-	// Check for tempo-product operator if traces are enabled
-	if monitoring.Spec.Traces != nil {
-		if found, err := cluster.OperatorExists(ctx, rr.Client, tempoOperator); err != nil || !found {
-			if err != nil {
-				return odherrors.NewStopErrorW(err)
-			}
-			allErrors = multierror.Append(allErrors, odherrors.NewStopError(status.TempoOperatorMissingMessage))
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if condition["status"] == string(metav1.ConditionTrue) {
+			return "", nil
 		}
+		if msg, ok := condition["message"].(string); ok && msg != "" {
+			return fmt.Sprintf("%s %s is not ready: %s", kind, obj.GetName(), msg), nil
+		}
+		break
 	}
 
-	return allErrors.ErrorOrNil()
+	return fmt.Sprintf("%s %s is not ready", kind, obj.GetName()), nil
 }
 
-func addPrometheusRules(componentName string, rr *odhtypes.ReconciliationRequest) error {
-	componentRules := fmt.Sprintf("%s/monitoring/%s-prometheusrules.tmpl.yaml", componentName, componentName)
-
-	if !common.FileExists(componentMonitoring.ComponentRulesFS, componentRules) {
-		return fmt.Errorf("prometheus rules file for component %s not found", componentName)
+// isPipelineWorkload returns true for the workloads whose readiness is reported by the pipeline conditions.
+func isPipelineWorkload(obj client.Object) bool {
+	switch obj.GetName() {
+	case CollectorDeploymentName, PrometheusStatefulSetName, AlertmanagerStatefulSetName:
+		return true
+	default:
+		return strings.HasPrefix(obj.GetName(), TempoWorkloadPrefix)
 	}
+}
 
-	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
-		FS:   componentMonitoring.ComponentRulesFS,
-		Path: componentRules,
-	})
+// pipelineWorkloadReadinessChanged triggers on changes of the ready replicas of the pipeline workloads.
+var pipelineWorkloadReadinessChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return isPipelineWorkload(e.Object)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return isPipelineWorkload(e.Object)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if !isPipelineWorkload(e.ObjectNew) {
+			return false
+		}
+		switch n := e.ObjectNew.(type) {
+		case *appsv1.Deployment:
+			o, ok := e.ObjectOld.(*appsv1.Deployment)
+			return !ok || o.Status.ReadyReplicas != n.Status.ReadyReplicas || !reflect.DeepEqual(o.Spec.Replicas, n.Spec.Replicas)
+		case *appsv1.StatefulSet:
+			o, ok := e.ObjectOld.(*appsv1.StatefulSet)
+			return !ok || o.Status.ReadyReplicas != n.Status.ReadyReplicas || !reflect.DeepEqual(o.Spec.Replicas, n.Spec.Replicas)
+		default:
+			return false
+		}
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
 
-	return nil
+// componentScrapeConfig holds the data needed to render the monitor of a single component.
+type componentScrapeConfig struct {
+	Name          string
+	MonitorType   string
+	SelectorLabel string
+	Port          string
+	Path          string
+	Interval      string
+	Relabelings   string
 }
 
-// if a component is disabled, we need to delete the prometheus rules. If the DSCI is deleted
-// the rules will be gc'd automatically.
-func cleanupPrometheusRules(ctx context.Context, componentName string, rr *odhtypes.ReconciliationRequest) error {
-	// Fetch monitoring namespace from DSCI
-	monitoringNamespace, err := cluster.MonitoringNamespace(ctx, rr.Client)
-	if err != nil {
-		if k8serr.IsNotFound(err) {
-			// No DSCI means no monitoring namespace configured, nothing to clean up
-			return nil
+// addScrapeConfigsData adds the per-component scrape configuration to the template data map.
+// Disabled components are skipped so their monitors are garbage collected.
+func addScrapeConfigsData(rr *odhtypes.ReconciliationRequest, metrics *serviceApi.Metrics, templateData map[string]any) error {
+	scrapeConfigs := make([]componentScrapeConfig, 0, len(metrics.ScrapeConfigs))
+
+	for _, sc := range metrics.ScrapeConfigs {
+		if !sc.Enabled {
+			continue
 		}
-		return err
-	}
 
-	pr := &unstructured.Unstructured{}
-	pr.SetGroupVersionKind(gvk.PrometheusRule)
-	pr.SetName(fmt.Sprintf("%s-prometheusrules", componentName))
-	pr.SetNamespace(monitoringNamespace)
+		label, ok := componentScrapeLabels[sc.Component]
+		if !ok {
+			return fmt.Errorf("unsupported component '%s' in metrics scrape configs", sc.Component)
+		}
+		// The dashboard workloads are labeled with the downstream name on RHOAI
+		if sc.Component == componentApi.DashboardComponentName &&
+			(rr.Release.Name == cluster.SelfManagedRhoai || rr.Release.Name == cluster.ManagedRhoai) {
+			label = "rhods-dashboard"
+		}
 
-	if err := rr.Client.Delete(ctx, pr); err != nil {
-		if k8serr.IsNotFound(err) {
-			return nil
+		relabelings := ""
+		if len(sc.Relabelings) > 0 {
+			b, err := sigsyaml.Marshal(sc.Relabelings)
+			if err != nil {
+				return fmt.Errorf("failed to marshal relabelings for component '%s': %w", sc.Component, err)
+			}
+			relabelings = strings.TrimSpace(string(b))
 		}
-		return fmt.Errorf("failed to delete prometheus rule for component %s: %w", componentName, err)
+
+		scrapeConfigs = append(scrapeConfigs, componentScrapeConfig{
+			Name:          sc.Component + "-metrics",
+			MonitorType:   getStringValueOrDefault(sc.MonitorType, "ServiceMonitor"),
+			SelectorLabel: labels.ODH.Component(label),
+			Port:          getStringValueOrDefault(sc.Port, "metrics"),
+			Path:          getStringValueOrDefault(sc.Path, "/metrics"),
+			Interval:      getStringValueOrDefault(sc.Interval, "30s"),
+			Relabelings:   relabelings,
+		})
 	}
 
+	templateData["ScrapeConfigs"] = scrapeConfigs
+
 	return nil
 }
 
-// addMetricsData adds metrics configuration data to the template data map.
-func addMetricsData(ctx context.Context, rr *odhtypes.ReconciliationRequest, metrics *serviceApi.Metrics, templateData map[string]any) error {
-	addResourceData(metrics, templateData)
-	addStorageData(metrics, templateData)
-	addReplicasData(ctx, rr, metrics, templateData)
-	return addExportersData(metrics, templateData)
+// addOperatorMetricsData adds the data needed to scrape the metrics of the operator itself to the
+// template data map. The metrics Service is labeled differently on each platform.
+func addOperatorMetricsData(rr *odhtypes.ReconciliationRequest, templateData map[string]any) {
+	// the operator monitor is only deployed when the operator namespace is known
+	operatorNamespace, _ := cluster.GetOperatorNamespace()
+
+	templateData["OperatorNamespace"] = operatorNamespace
+	templateData["OperatorMetricsSelector"] = map[string]string{"control-plane": "controller-manager"}
+	templateData["OperatorMetricsPort"] = "https"
+
+	if rr.Release.Name == cluster.SelfManagedRhoai || rr.Release.Name == cluster.ManagedRhoai {
+		templateData["OperatorMetricsSelector"] = map[string]string{"name": "rhods-operator"}
+		templateData["OperatorMetricsPort"] = "http"
+	}
 }
 
 // addResourceData adds resource configuration data to the template data map.
@@ -411,15 +1311,23 @@ func addResourceData(metrics *serviceApi.Metrics, templateData map[string]any) {
 }
 
 // addStorageData adds storage configuration data to the template data map.
-func addStorageData(metrics *serviceApi.Metrics, templateData map[string]any) {
+func addStorageData(metrics *serviceApi.Metrics, templateData map[string]any) error {
+	size, retention, storageClassName := defaultStorageSize, defaultRetention, ""
 	if metrics.Storage != nil {
-		templateData["StorageSize"] = getResourceValueOrDefault(metrics.Storage.Size.String(), defaultStorageSize)
-		templateData["StorageRetention"] = getStringValueOrDefault(metrics.Storage.Retention, defaultRetention)
-	} else {
-		// Use defaults when Storage is nil
-		templateData["StorageSize"] = defaultStorageSize
-		templateData["StorageRetention"] = defaultRetention
+		size = getResourceValueOrDefault(metrics.Storage.Size.String(), defaultStorageSize)
+		retention = getStringValueOrDefault(metrics.Storage.Retention, defaultRetention)
+		storageClassName = metrics.Storage.StorageClassName
+	}
+
+	if !prometheusDurationRE.MatchString(retention) {
+		return fmt.Errorf("invalid metrics retention %q: must be a Prometheus duration (e.g. \"15d\", \"2w\")", retention)
 	}
+
+	templateData["StorageSize"] = size
+	templateData["StorageRetention"] = retention
+	templateData["StorageClassName"] = storageClassName
+
+	return nil
 }
 
 // addReplicasData adds replica configuration data to the template data map.
@@ -477,6 +1385,231 @@ func addExportersData(metrics *serviceApi.Metrics, templateData map[string]any)
 	return nil
 }
 
+// monitoringSizePreset holds the resources and replicas of a monitoring stack sizing preset.
+type monitoringSizePreset struct {
+	Collector          corev1.ResourceRequirements
+	CollectorReplicas  int32
+	Prometheus         corev1.ResourceRequirements
+	PrometheusReplicas int32
+	Tempo              corev1.ResourceRequirements
+}
+
+var monitoringSizePresets = map[string]monitoringSizePreset{
+	serviceApi.MonitoringSizeSmall: {
+		Collector:          resourceRequirements("100m", "256Mi", "500m", "512Mi"),
+		CollectorReplicas:  1,
+		Prometheus:         resourceRequirements(defaultCPURequest, defaultMemoryRequest, defaultCPULimit, defaultMemoryLimit),
+		PrometheusReplicas: 1,
+		Tempo:              resourceRequirements("100m", "256Mi", "1", "1Gi"),
+	},
+	serviceApi.MonitoringSizeMedium: {
+		Collector:          resourceRequirements("250m", "512Mi", "1", "1Gi"),
+		CollectorReplicas:  2,
+		Prometheus:         resourceRequirements("250m", "1Gi", "1", "2Gi"),
+		PrometheusReplicas: 2,
+		Tempo:              resourceRequirements("250m", "1Gi", "1", "2Gi"),
+	},
+	serviceApi.MonitoringSizeLarge: {
+		Collector:          resourceRequirements("500m", "1Gi", "2", "2Gi"),
+		CollectorReplicas:  3,
+		Prometheus:         resourceRequirements("1", "4Gi", "2", "8Gi"),
+		PrometheusReplicas: 2,
+		Tempo:              resourceRequirements("500m", "2Gi", "2", "4Gi"),
+	},
+}
+
+func resourceRequirements(cpuRequest, memoryRequest, cpuLimit, memoryLimit string) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+			corev1.ResourceMemory: resource.MustParse(memoryRequest),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+			corev1.ResourceMemory: resource.MustParse(memoryLimit),
+		},
+	}
+}
+
+// addSizeData resolves the sizing preset, or the custom resources, into the template data map.
+// Without a size the per-component settings from the metrics configuration are used unchanged.
+func addSizeData(ctx context.Context, rr *odhtypes.ReconciliationRequest, monitoring *serviceApi.Monitoring, templateData map[string]any) error {
+	switch size := monitoring.Spec.Size; size {
+	case "":
+		return nil
+	case serviceApi.MonitoringSizeCustom:
+		custom := monitoring.Spec.CustomResources
+		if custom == nil {
+			return errors.New("customResources must be specified when size is custom")
+		}
+		return setComponentResourcesData(custom.Collector, custom.Prometheus, custom.Tempo, templateData)
+	default:
+		preset, ok := monitoringSizePresets[size]
+		if !ok {
+			return fmt.Errorf("unsupported monitoring size '%s'", size)
+		}
+		if err := setComponentResourcesData(&preset.Collector, &preset.Prometheus, &preset.Tempo, templateData); err != nil {
+			return err
+		}
+
+		// Explicit replica counts take precedence, and single-node clusters never run more than one replica
+		isSNO := cluster.IsSingleNodeCluster(ctx, rr.Client)
+		if monitoring.Spec.CollectorReplicas == 0 {
+			templateData["CollectorReplicas"] = presetReplicas(preset.CollectorReplicas, isSNO)
+		}
+		if monitoring.Spec.Metrics != nil && monitoring.Spec.Metrics.Replicas == 0 {
+			templateData["Replicas"] = strconv.Itoa(int(presetReplicas(preset.PrometheusReplicas, isSNO)))
+		}
+
+		return nil
+	}
+}
+
+func presetReplicas(replicas int32, isSNO bool) int32 {
+	if isSNO {
+		return 1
+	}
+	return replicas
+}
+
+// setComponentResourcesData sets the collector, Prometheus and Tempo resources in the template data map.
+// A nil requirement leaves the corresponding component settings unchanged.
+func setComponentResourcesData(collector, prometheus, tempo *corev1.ResourceRequirements, templateData map[string]any) error {
+	if collector != nil {
+		b, err := sigsyaml.Marshal(collector)
+		if err != nil {
+			return fmt.Errorf("failed to marshal collector resources: %w", err)
+		}
+		templateData["CollectorResources"] = strings.TrimSpace(string(b))
+	}
+
+	if tempo != nil {
+		b, err := sigsyaml.Marshal(tempo)
+		if err != nil {
+			return fmt.Errorf("failed to marshal tempo resources: %w", err)
+		}
+		templateData["TempoResources"] = strings.TrimSpace(string(b))
+	}
+
+	if prometheus != nil {
+		templateData["CPULimit"] = getResourceValueOrDefault(prometheus.Limits.Cpu().String(), defaultCPULimit)
+		templateData["MemoryLimit"] = getResourceValueOrDefault(prometheus.Limits.Memory().String(), defaultMemoryLimit)
+		templateData["CPURequest"] = getResourceValueOrDefault(prometheus.Requests.Cpu().String(), defaultCPURequest)
+		templateData["MemoryRequest"] = getResourceValueOrDefault(prometheus.Requests.Memory().String(), defaultMemoryRequest)
+	}
+
+	return nil
+}
+
+// addProcessorsData adds the custom metrics and traces processors to the template data map.
+// The processors of each pipeline are listed in the order they run.
+func addProcessorsData(monitoring *serviceApi.Monitoring, templateData map[string]any) error {
+	processors := make(map[string]string)
+	metricsProcessorNames := make([]string, 0)
+	tracesProcessorNames := make([]string, 0)
+
+	var err error
+	if metrics := monitoring.Spec.Metrics; metrics != nil {
+		metricsProcessorNames, err = mergeProcessors(processors, metrics.Processors, metrics.ProcessorOrder)
+		if err != nil {
+			return err
+		}
+	}
+	if traces := monitoring.Spec.Traces; traces != nil {
+		tracesProcessorNames, err = mergeProcessors(processors, traces.Processors, traces.ProcessorOrder)
+		if err != nil {
+			return err
+		}
+	}
+
+	processorNames := make([]string, 0, len(processors))
+	for name := range processors {
+		processorNames = append(processorNames, name)
+	}
+	sort.Strings(processorNames)
+
+	templateData["Processors"] = processors
+	templateData["ProcessorNames"] = processorNames
+	templateData["MetricsProcessorNames"] = metricsProcessorNames
+	templateData["TracesProcessorNames"] = tracesProcessorNames
+
+	return nil
+}
+
+// mergeProcessors validates the given processors and adds them to the shared collector processors.
+// Pipelines share the collector processors section, so a name used by several pipelines must
+// have the same config everywhere. The names of the given processors are returned in the order
+// they run in the pipeline.
+func mergeProcessors(processors map[string]string, configs map[string]runtime.RawExtension, order []string) ([]string, error) {
+	validated, err := validateComponentConfigs("processor", configs, ValidateProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := OrderProcessors(order, configs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		config := validated[name]
+		if existing, ok := processors[name]; ok && existing != config {
+			return nil, fmt.Errorf("processor '%s' is configured differently for metrics and traces", name)
+		}
+		processors[name] = config
+	}
+
+	return names, nil
+}
+
+// OrderProcessors returns the names of the given processors in the order they run in the
+// pipeline: the ones listed by order first, then the others ordered by name.
+func OrderProcessors(order []string, processors map[string]runtime.RawExtension) ([]string, error) {
+	names := make([]string, 0, len(processors))
+	for _, name := range order {
+		if _, ok := processors[name]; !ok {
+			return nil, fmt.Errorf("processor order references unknown processor '%s'", name)
+		}
+		if slices.Contains(names, name) {
+			return nil, fmt.Errorf("processor order lists processor '%s' more than once", name)
+		}
+		names = append(names, name)
+	}
+
+	rest := make([]string, 0, len(processors)-len(names))
+	for name := range processors {
+		if !slices.Contains(names, name) {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(names, rest...), nil
+}
+
+// addLogsData adds custom logs exporters data to the template data map.
+func addLogsData(logs *serviceApi.Logs, templateData map[string]any) error {
+	if len(logs.Exporters) == 0 {
+		return nil
+	}
+
+	validatedExporters, err := validateExporters(logs.Exporters)
+	if err != nil {
+		return err
+	}
+
+	exporterNames := make([]string, 0, len(validatedExporters))
+	for name := range validatedExporters {
+		exporterNames = append(exporterNames, name)
+	}
+	sort.Strings(exporterNames)
+
+	templateData["LogsExporters"] = validatedExporters
+	templateData["LogsExporterNames"] = exporterNames
+
+	return nil
+}
+
 // addTracesData adds traces configuration data to the template data map.
 func addTracesData(traces *serviceApi.Traces, namespace string, templateData map[string]any) {
 	templateData["OtlpEndpoint"] = fmt.Sprintf("http://data-science-collector.%s.svc.cluster.local:4317", namespace)
@@ -498,6 +1631,60 @@ func addTracesData(traces *serviceApi.Traces, namespace string, templateData map
 	templateData["TracesRetention"] = traces.Storage.Retention.Duration.String()
 
 	setTempoEndpointAndStorageData(traces, namespace, templateData)
+	setTracesBackendData(traces, templateData)
+}
+
+// getTracesBackendType returns the configured trace backend type, defaulting to tempo.
+func getTracesBackendType(traces *serviceApi.Traces) string {
+	if traces == nil || traces.Backend == nil || traces.Backend.Type == "" {
+		return serviceApi.TracesBackendTempo
+	}
+	return traces.Backend.Type
+}
+
+// isTempoTracesBackend returns true if traces are exported to the operator managed Tempo instance.
+func isTempoTracesBackend(traces *serviceApi.Traces) bool {
+	return getTracesBackendType(traces) == serviceApi.TracesBackendTempo
+}
+
+// setTracesBackendData sets the exporter, endpoint, TLS and auth data for the selected trace backend.
+func setTracesBackendData(traces *serviceApi.Traces, templateData map[string]any) {
+	backendType := getTracesBackendType(traces)
+	templateData["TracesBackendType"] = backendType
+
+	// Set empty values to avoid template missing key errors
+	templateData["TracesBackendEndpoint"] = ""
+	templateData["TracesBackendInsecure"] = false
+	templateData["TracesBackendInsecureSkipVerify"] = false
+	templateData["TracesBackendCAConfigMap"] = ""
+	templateData["TracesBackendCertificateSecret"] = ""
+	templateData["TracesBackendBearerTokenSecret"] = ""
+	templateData["TracesBackendBasicAuthSecret"] = ""
+
+	switch backendType {
+	case serviceApi.TracesBackendJaeger:
+		templateData["TracesBackendExporter"] = jaegerTracesExporter
+	case serviceApi.TracesBackendOTLP:
+		templateData["TracesBackendExporter"] = backendTracesExporter
+	default:
+		templateData["TracesBackendExporter"] = tempoTracesExporter
+		return
+	}
+
+	backend := traces.Backend
+	templateData["TracesBackendEndpoint"] = backend.Endpoint
+
+	if backend.TLS != nil {
+		templateData["TracesBackendInsecure"] = backend.TLS.Insecure
+		templateData["TracesBackendInsecureSkipVerify"] = backend.TLS.InsecureSkipVerify
+		templateData["TracesBackendCAConfigMap"] = backend.TLS.CAConfigMap
+		templateData["TracesBackendCertificateSecret"] = backend.TLS.CertificateSecret
+	}
+
+	if backend.Auth != nil {
+		templateData["TracesBackendBearerTokenSecret"] = backend.Auth.BearerTokenSecret
+		templateData["TracesBackendBasicAuthSecret"] = backend.Auth.BasicAuthSecret
+	}
 }
 
 // determineTLSEnabled determines if TLS should be enabled for traces.
@@ -545,6 +1732,9 @@ const (
 	maxArrayLength       = 100   // Maximum length for array values.
 	maxExporterSize      = 10240 // Maximum size per exporter config (10KB).
 	maxTotalExporterSize = 51200 // Maximum total size for all exporters combined (50KB).
+
+	maxRenderedManifestsSize = 1048576 // Maximum size of the rendered manifests ConfigMap data (1MB).
+	redactedValue            = "<redacted>"
 )
 
 // ExporterSchema defines the validation schema for an exporter type.
@@ -717,15 +1907,15 @@ var metricsExporterSchemas = map[string]ExporterSchema{
 	},
 }
 
-// validateExporterConfigSecurity performs additional security validations on exporter configurations.
-func validateExporterConfigSecurity(name string, config map[string]interface{}) error {
+// validateConfigSecurity performs additional security validations on collector component configurations.
+func validateConfigSecurity(kind, name string, config map[string]interface{}) error {
 	// Check maximum number of fields
 	if len(config) > maxConfigFields {
-		return fmt.Errorf("exporter '%s' has too many fields (%d), maximum allowed is %d", name, len(config), maxConfigFields)
+		return fmt.Errorf("%s '%s' has too many fields (%d), maximum allowed is %d", kind, name, len(config), maxConfigFields)
 	}
 
 	// Check nesting depth and validate types recursively
-	if err := validateConfigDepthAndTypes(config, 1, name); err != nil {
+	if err := validateConfigDepthAndTypes(config, 1, kind, name); err != nil {
 		return err
 	}
 
@@ -733,45 +1923,45 @@ func validateExporterConfigSecurity(name string, config map[string]interface{})
 }
 
 // validateConfigDepthAndTypes recursively validates the depth and types of configuration values.
-func validateConfigDepthAndTypes(obj interface{}, depth int, exporterName string) error {
+func validateConfigDepthAndTypes(obj interface{}, depth int, kind, name string) error {
 	if depth > maxNestingDepth {
-		return fmt.Errorf("exporter '%s' config nesting too deep (max %d levels)", exporterName, maxNestingDepth)
+		return fmt.Errorf("%s '%s' config nesting too deep (max %d levels)", kind, name, maxNestingDepth)
 	}
 
 	switch v := obj.(type) {
 	case map[string]interface{}:
 		if len(v) > maxConfigFields {
-			return fmt.Errorf("exporter '%s' config object has too many fields at depth %d", exporterName, depth)
+			return fmt.Errorf("%s '%s' config object has too many fields at depth %d", kind, name, depth)
 		}
 		for key, value := range v {
 			// Validate key length
 			if len(key) > maxStringLength {
-				return fmt.Errorf("exporter '%s' config key too long at depth %d", exporterName, depth)
+				return fmt.Errorf("%s '%s' config key too long at depth %d", kind, name, depth)
 			}
 			// Recursively validate nested values
-			if err := validateConfigDepthAndTypes(value, depth+1, exporterName); err != nil {
+			if err := validateConfigDepthAndTypes(value, depth+1, kind, name); err != nil {
 				return err
 			}
 		}
 	case []interface{}:
 		if len(v) > maxArrayLength {
-			return fmt.Errorf("exporter '%s' config array too long (%d items) at depth %d", exporterName, len(v), depth)
+			return fmt.Errorf("%s '%s' config array too long (%d items) at depth %d", kind, name, len(v), depth)
 		}
 		for _, item := range v {
-			if err := validateConfigDepthAndTypes(item, depth+1, exporterName); err != nil {
+			if err := validateConfigDepthAndTypes(item, depth+1, kind, name); err != nil {
 				return err
 			}
 		}
 	case string:
 		if len(v) > maxStringLength {
-			return fmt.Errorf("exporter '%s' config string value too long at depth %d", exporterName, depth)
+			return fmt.Errorf("%s '%s' config string value too long at depth %d", kind, name, depth)
 		}
 	case int, int32, int64, float32, float64, bool:
 		// These types are safe
 	case nil:
 		// Nil values are safe
 	default:
-		return fmt.Errorf("exporter '%s' config contains unsupported type %T at depth %d", exporterName, v, depth)
+		return fmt.Errorf("%s '%s' config contains unsupported type %T at depth %d", kind, name, v, depth)
 	}
 
 	return nil
@@ -910,6 +2100,182 @@ func validateFieldTypeStrict(_ string, value interface{}, expectedType string) e
 	return nil
 }
 
+// alertmanagerConfig mirrors the subset of the Alertmanager configuration file rendered from the Alerting spec.
+type alertmanagerConfig struct {
+	Route     alertmanagerRoute      `json:"route"`
+	Receivers []alertmanagerReceiver `json:"receivers"`
+}
+
+type alertmanagerRoute struct {
+	Receiver       string              `json:"receiver"`
+	GroupBy        []string            `json:"group_by,omitempty"`
+	GroupWait      string              `json:"group_wait,omitempty"`
+	GroupInterval  string              `json:"group_interval,omitempty"`
+	RepeatInterval string              `json:"repeat_interval,omitempty"`
+	Matchers       []string            `json:"matchers,omitempty"`
+	Continue       bool                `json:"continue,omitempty"`
+	Routes         []alertmanagerRoute `json:"routes,omitempty"`
+}
+
+type alertmanagerReceiver struct {
+	Name             string           `json:"name"`
+	SlackConfigs     []map[string]any `json:"slack_configs,omitempty"`
+	PagerdutyConfigs []map[string]any `json:"pagerduty_configs,omitempty"`
+	EmailConfigs     []map[string]any `json:"email_configs,omitempty"`
+	WebhookConfigs   []map[string]any `json:"webhook_configs,omitempty"`
+}
+
+// addAlertingData renders the Alertmanager configuration for the configured receivers.
+// Credentials are read from secrets in the monitoring namespace and inlined into the config.
+func addAlertingData(ctx context.Context, rr *odhtypes.ReconciliationRequest, alerting *serviceApi.Alerting, namespace string, templateData map[string]any) error {
+	templateData["AlertmanagerConfig"] = ""
+
+	if alerting == nil || len(alerting.Receivers) == 0 {
+		return nil
+	}
+
+	receiverNames := make([]string, 0, len(alerting.Receivers))
+	receivers := make([]alertmanagerReceiver, 0, len(alerting.Receivers))
+	for _, r := range alerting.Receivers {
+		receiver, err := buildAlertmanagerReceiver(ctx, rr, r, namespace)
+		if err != nil {
+			return fmt.Errorf("invalid alerting receiver '%s': %w", r.Name, err)
+		}
+		receivers = append(receivers, receiver)
+		receiverNames = append(receiverNames, r.Name)
+	}
+
+	// Without an explicit route all alerts go to the first receiver
+	route := alertmanagerRoute{Receiver: alerting.Receivers[0].Name}
+	if r := alerting.Route; r != nil {
+		route = alertmanagerRoute{
+			Receiver:       r.Receiver,
+			GroupBy:        r.GroupBy,
+			GroupWait:      r.GroupWait,
+			GroupInterval:  r.GroupInterval,
+			RepeatInterval: r.RepeatInterval,
+		}
+		for _, child := range r.Routes {
+			route.Routes = append(route.Routes, alertmanagerRoute{
+				Receiver: child.Receiver,
+				Matchers: child.Matchers,
+				Continue: child.Continue,
+			})
+		}
+	}
+
+	for _, name := range append([]string{route.Receiver}, routeReceivers(route.Routes)...) {
+		if !contains(receiverNames, name) {
+			return fmt.Errorf("alerting route references unknown receiver '%s'", name)
+		}
+	}
+
+	b, err := sigsyaml.Marshal(alertmanagerConfig{Route: route, Receivers: receivers})
+	if err != nil {
+		return fmt.Errorf("failed to marshal alertmanager configuration: %w", err)
+	}
+	templateData["AlertmanagerConfig"] = string(b)
+
+	return nil
+}
+
+func routeReceivers(routes []alertmanagerRoute) []string {
+	names := make([]string, 0, len(routes))
+	for _, r := range routes {
+		names = append(names, r.Receiver)
+	}
+	return names
+}
+
+func buildAlertmanagerReceiver(ctx context.Context, rr *odhtypes.ReconciliationRequest, r serviceApi.AlertingReceiver, namespace string) (alertmanagerReceiver, error) {
+	receiver := alertmanagerReceiver{Name: r.Name}
+
+	switch {
+	case r.Slack != nil:
+		apiURL, err := getSecretValue(ctx, rr, namespace, r.Slack.APIURLSecret, "url")
+		if err != nil {
+			return receiver, err
+		}
+		cfg := map[string]any{"api_url": apiURL, "send_resolved": r.Slack.SendResolved}
+		if r.Slack.Channel != "" {
+			cfg["channel"] = r.Slack.Channel
+		}
+		receiver.SlackConfigs = []map[string]any{cfg}
+	case r.PagerDuty != nil:
+		routingKey, err := getSecretValue(ctx, rr, namespace, r.PagerDuty.RoutingKeySecret, "routingKey")
+		if err != nil {
+			return receiver, err
+		}
+		cfg := map[string]any{"routing_key": routingKey, "send_resolved": r.PagerDuty.SendResolved}
+		if r.PagerDuty.Severity != "" {
+			cfg["severity"] = r.PagerDuty.Severity
+		}
+		receiver.PagerdutyConfigs = []map[string]any{cfg}
+	case r.Email != nil:
+		cfg := map[string]any{
+			"to":            r.Email.To,
+			"from":          r.Email.From,
+			"smarthost":     r.Email.Smarthost,
+			"require_tls":   r.Email.RequireTLS,
+			"send_resolved": r.Email.SendResolved,
+		}
+		if r.Email.AuthUsername != "" {
+			cfg["auth_username"] = r.Email.AuthUsername
+		}
+		if r.Email.AuthPasswordSecret != "" {
+			password, err := getSecretValue(ctx, rr, namespace, r.Email.AuthPasswordSecret, "password")
+			if err != nil {
+				return receiver, err
+			}
+			cfg["auth_password"] = password
+		}
+		receiver.EmailConfigs = []map[string]any{cfg}
+	case r.Webhook != nil:
+		receiver.WebhookConfigs = []map[string]any{{"url": r.Webhook.URL, "send_resolved": r.Webhook.SendResolved}}
+	default:
+		return receiver, errors.New("exactly one of slack, pagerDuty, email or webhook must be specified")
+	}
+
+	return receiver, nil
+}
+
+// alertingSecretNames returns the names of the secrets holding the credentials of the alerting
+// receivers, whose values are inlined in the rendered Alertmanager configuration.
+func alertingSecretNames(alerting *serviceApi.Alerting) []string {
+	if alerting == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(alerting.Receivers))
+	for _, r := range alerting.Receivers {
+		switch {
+		case r.Slack != nil:
+			names = append(names, r.Slack.APIURLSecret)
+		case r.PagerDuty != nil:
+			names = append(names, r.PagerDuty.RoutingKeySecret)
+		case r.Email != nil && r.Email.AuthPasswordSecret != "":
+			names = append(names, r.Email.AuthPasswordSecret)
+		}
+	}
+
+	return names
+}
+
+// getSecretValue returns the value stored under key in the given secret.
+func getSecretValue(ctx context.Context, rr *odhtypes.ReconciliationRequest, namespace, name, key string) (string, error) {
+	secret, err := cluster.GetSecret(ctx, rr.Client, namespace, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)
+	}
+
+	value, ok := secret.Data[key]
+	if !ok || len(value) == 0 {
+		return "", fmt.Errorf("secret %s/%s does not contain key '%s'", namespace, name, key)
+	}
+
+	return string(value), nil
+}
+
 // Helper functions.
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
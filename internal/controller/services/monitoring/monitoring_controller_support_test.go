@@ -2,16 +2,28 @@
 package monitoring
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 
+	gtypes "github.com/onsi/gomega/types"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -19,9 +31,12 @@ import (
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	templateutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/template"
 	testScheme "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
@@ -71,6 +86,7 @@ func setupTestClient(g Gomega, objects ...client.Object) client.Client {
 	scheme := runtime.NewScheme()
 	g.Expect(dsciv2.AddToScheme(scheme)).Should(Succeed())
 	g.Expect(serviceApi.AddToScheme(scheme)).Should(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).Should(Succeed())
 
 	return fake.NewClientBuilder().
 		WithScheme(scheme).
@@ -306,14 +322,14 @@ func TestCustomMetricsExporters(t *testing.T) {
 			name: "valid custom exporters",
 			exporters: map[string]runtime.RawExtension{
 				"debug":       stringToRawExtension("verbosity: detailed"),
-				"otlp/jaeger": stringToRawExtension("endpoint: https://jaeger:4317\ntls:\n  insecure: false"),
+				"otlp/custom": stringToRawExtension("endpoint: https://jaeger:4317\ntls:\n  insecure: false"),
 			},
 			expectError: false,
 			expectedParsedConfig: map[string]string{
 				"debug":       "verbosity: detailed",
-				"otlp/jaeger": "endpoint: https://jaeger:4317\ntls:\n    insecure: false",
+				"otlp/custom": "endpoint: https://jaeger:4317\ntls:\n    insecure: false",
 			},
-			expectedNames: []string{"debug", "otlp/jaeger"}, // Note: sorted order
+			expectedNames: []string{"debug", "otlp/custom"}, // Note: sorted order
 		},
 		{
 			name:        "empty exporters map",
@@ -347,6 +363,22 @@ func TestCustomMetricsExporters(t *testing.T) {
 			expectError: true,
 			errorMsg:    "reserved",
 		},
+		{
+			name: "reserved name otlp/jaeger",
+			exporters: map[string]runtime.RawExtension{
+				"otlp/jaeger": stringToRawExtension("endpoint: https://jaeger:4317"),
+			},
+			expectError: true,
+			errorMsg:    "reserved",
+		},
+		{
+			name: "reserved name otlp/backend",
+			exporters: map[string]runtime.RawExtension{
+				"otlp/backend": stringToRawExtension("endpoint: https://otlp.example.com:4317"),
+			},
+			expectError: true,
+			errorMsg:    "reserved",
+		},
 		{
 			name: "invalid YAML",
 			exporters: map[string]runtime.RawExtension{
@@ -972,3 +1004,1738 @@ func TestIsLocalServiceEndpoint(t *testing.T) {
 		})
 	}
 }
+
+// renderTemplate renders a monitoring template with the given data and decodes the non-empty documents.
+func renderTemplate(g Gomega, path string, templateData map[string]any) []map[string]any {
+	tmpl, err := templateutils.New("").ParseFS(resourcesFS, path)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	var buf bytes.Buffer
+	g.Expect(tmpl.ExecuteTemplate(&buf, filepath.Base(path), templateData)).Should(Succeed())
+
+	docs := make([]map[string]any, 0)
+	decoder := yaml.NewDecoder(&buf)
+	for {
+		out := map[string]any{}
+		err := decoder.Decode(&out)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		g.Expect(err).ShouldNot(HaveOccurred())
+		if len(out) > 0 {
+			docs = append(docs, out)
+		}
+	}
+
+	return docs
+}
+
+func TestTracesBackend(t *testing.T) {
+	tests := []struct {
+		name             string
+		backend          *serviceApi.TracesBackend
+		expectedExporter string
+		expectedEndpoint string
+		expectedEnv      []string
+		expectedVolumes  []string
+	}{
+		{
+			name:             "defaults to tempo",
+			backend:          nil,
+			expectedExporter: "otlp/tempo",
+			expectedEndpoint: "tempo-data-science-tempomonolithic.test-namespace.svc.cluster.local:4317",
+		},
+		{
+			name:             "explicit tempo",
+			backend:          &serviceApi.TracesBackend{Type: serviceApi.TracesBackendTempo},
+			expectedExporter: "otlp/tempo",
+			expectedEndpoint: "tempo-data-science-tempomonolithic.test-namespace.svc.cluster.local:4317",
+		},
+		{
+			name: "jaeger with bearer token",
+			backend: &serviceApi.TracesBackend{
+				Type:     serviceApi.TracesBackendJaeger,
+				Endpoint: "jaeger-collector.tracing.svc:4317",
+				TLS:      &serviceApi.TracesBackendTLS{CAConfigMap: "jaeger-ca"},
+				Auth:     &serviceApi.TracesBackendAuth{BearerTokenSecret: "jaeger-token"},
+			},
+			expectedExporter: "otlp/jaeger",
+			expectedEndpoint: "jaeger-collector.tracing.svc:4317",
+			expectedEnv:      []string{"TRACES_BACKEND_TOKEN"},
+			expectedVolumes:  []string{"traces-backend-ca"},
+		},
+		{
+			name: "otlp with basic auth and mTLS",
+			backend: &serviceApi.TracesBackend{
+				Type:     serviceApi.TracesBackendOTLP,
+				Endpoint: "otlp.example.com:4317",
+				TLS:      &serviceApi.TracesBackendTLS{CAConfigMap: "otlp-ca", CertificateSecret: "otlp-client-cert"},
+				Auth:     &serviceApi.TracesBackendAuth{BasicAuthSecret: "otlp-basic"},
+			},
+			expectedExporter: "otlp/backend",
+			expectedEndpoint: "otlp.example.com:4317",
+			expectedEnv:      []string{"TRACES_BACKEND_USERNAME", "TRACES_BACKEND_PASSWORD"},
+			expectedVolumes:  []string{"traces-backend-ca", "traces-backend-tls"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dsci := &dsciv2.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+				Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+			}
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Traces: &serviceApi.Traces{
+							Storage: serviceApi.TracesStorage{Backend: "pv"},
+							Backend: tt.backend,
+						},
+					},
+				},
+			}
+
+			objects := []client.Object{dsci, monitoring}
+			for _, name := range []string{"jaeger-token", "otlp-basic", "otlp-client-cert"} {
+				objects = append(objects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-namespace"},
+					Data:       map[string][]byte{"token": []byte(name)},
+				})
+			}
+
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, objects...),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(templateData).Should(HaveKeyWithValue("TracesBackendExporter", tt.expectedExporter))
+
+			docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+			collector := docs[0]
+
+			spec, ok := collector["spec"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			config, ok := spec["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+
+			exporters, ok := config["exporters"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(exporters).Should(HaveKey(tt.expectedExporter))
+			g.Expect(exporters[tt.expectedExporter]).Should(HaveKeyWithValue("endpoint", tt.expectedEndpoint))
+
+			pipelines, ok := config["service"].(map[string]any)["pipelines"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(pipelines["traces"]).Should(HaveKeyWithValue("exporters", ContainElement(tt.expectedExporter)))
+
+			envNames := make([]string, 0)
+			if env, ok := spec["env"].([]any); ok {
+				for _, e := range env {
+					envNames = append(envNames, e.(map[string]any)["name"].(string))
+				}
+			}
+			g.Expect(envNames).Should(ConsistOf(tt.expectedEnv))
+
+			volumeNames := make([]string, 0)
+			if volumes, ok := spec["volumes"].([]any); ok {
+				for _, v := range volumes {
+					volumeNames = append(volumeNames, v.(map[string]any)["name"].(string))
+				}
+			}
+			g.Expect(volumeNames).Should(ConsistOf(tt.expectedVolumes))
+		})
+	}
+}
+
+func TestMetricsScrapeConfigs(t *testing.T) {
+	g := NewWithT(t)
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+	monitoring := &serviceApi.Monitoring{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "test-namespace",
+				Metrics: &serviceApi.Metrics{
+					ScrapeConfigs: []serviceApi.MetricsScrapeConfig{
+						{
+							Component: "dashboard",
+							Enabled:   true,
+						},
+						{
+							Component:   "kserve",
+							Enabled:     true,
+							MonitorType: "PodMonitor",
+							Port:        "http-metrics",
+							Interval:    "1m",
+							Relabelings: []serviceApi.MetricsRelabelConfig{
+								{SourceLabels: []string{"__meta_kubernetes_pod_name"}, TargetLabel: "pod", Action: "replace"},
+							},
+						},
+						{
+							Component: "workbenches",
+							Enabled:   false,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client:   setupTestClient(g, dsci, monitoring),
+		Instance: monitoring,
+	}
+
+	templateData, err := getTemplateData(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	docs := renderTemplate(g, ComponentMonitorsTemplate, templateData)
+	g.Expect(docs).Should(HaveLen(2), "disabled components should not be rendered")
+
+	g.Expect(docs[0]).Should(HaveKeyWithValue("kind", "ServiceMonitor"))
+	g.Expect(docs[0]["metadata"]).Should(HaveKeyWithValue("name", "dashboard-metrics"))
+	g.Expect(docs[0]["spec"]).Should(HaveKeyWithValue("selector",
+		HaveKeyWithValue("matchLabels", HaveKeyWithValue("app.opendatahub.io/dashboard", "true"))))
+	g.Expect(docs[0]["spec"]).Should(HaveKeyWithValue("namespaceSelector",
+		HaveKeyWithValue("matchNames", ConsistOf("test-app-namespace"))))
+	g.Expect(docs[0]["spec"]).Should(HaveKeyWithValue("endpoints", ConsistOf(
+		And(HaveKeyWithValue("port", "metrics"), HaveKeyWithValue("path", "/metrics"), HaveKeyWithValue("interval", "30s")),
+	)))
+
+	g.Expect(docs[1]).Should(HaveKeyWithValue("kind", "PodMonitor"))
+	g.Expect(docs[1]["metadata"]).Should(HaveKeyWithValue("name", "kserve-metrics"))
+	g.Expect(docs[1]["spec"]).Should(HaveKeyWithValue("podMetricsEndpoints", ConsistOf(
+		And(
+			HaveKeyWithValue("port", "http-metrics"),
+			HaveKeyWithValue("interval", "1m"),
+			HaveKeyWithValue("relabelings", ConsistOf(HaveKeyWithValue("targetLabel", "pod"))),
+		),
+	)))
+}
+
+func TestOperatorMonitor(t *testing.T) {
+	tests := []struct {
+		name     string
+		release  common.Platform
+		selector map[string]any
+		port     string
+	}{
+		{name: "open data hub", release: cluster.OpenDataHub, selector: map[string]any{"control-plane": "controller-manager"}, port: "https"},
+		{name: "self managed rhoai", release: cluster.SelfManagedRhoai, selector: map[string]any{"name": "rhods-operator"}, port: "http"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dsci := &dsciv2.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+				Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+			}
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-monitoring"},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics:   &serviceApi.Metrics{},
+					},
+				},
+			}
+
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, dsci, monitoring),
+				Instance: monitoring,
+				Release:  common.Release{Name: tt.release},
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			templateData["OperatorNamespace"] = "test-operator-namespace"
+
+			docs := renderTemplate(g, OperatorMonitorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(3))
+
+			g.Expect(docs[0]).Should(HaveKeyWithValue("kind", "Role"))
+			g.Expect(docs[0]["metadata"]).Should(HaveKeyWithValue("namespace", "test-operator-namespace"))
+
+			g.Expect(docs[1]).Should(HaveKeyWithValue("kind", "RoleBinding"))
+			g.Expect(docs[1]["subjects"]).Should(ConsistOf(And(
+				HaveKeyWithValue("name", "data-science-monitoringstack-prometheus"),
+				HaveKeyWithValue("namespace", "test-namespace"),
+			)))
+
+			g.Expect(docs[2]).Should(HaveKeyWithValue("kind", "ServiceMonitor"))
+			g.Expect(docs[2]["metadata"]).Should(HaveKeyWithValue("namespace", "test-namespace"))
+			g.Expect(docs[2]["spec"]).Should(HaveKeyWithValue("selector", HaveKeyWithValue("matchLabels", Equal(tt.selector))))
+			g.Expect(docs[2]["spec"]).Should(HaveKeyWithValue("namespaceSelector",
+				HaveKeyWithValue("matchNames", ConsistOf("test-operator-namespace"))))
+			g.Expect(docs[2]["spec"]).Should(HaveKeyWithValue("endpoints", ConsistOf(HaveKeyWithValue("port", tt.port))))
+		})
+	}
+}
+
+func TestAlertingReceivers(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+	slackSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-webhook", Namespace: "test-namespace"},
+		Data:       map[string][]byte{"url": []byte("https://hooks.slack.com/services/T000/B000/XXX")},
+	}
+	pagerDutySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pagerduty", Namespace: "test-namespace"},
+		Data:       map[string][]byte{"routingKey": []byte("0123456789abcdef")},
+	}
+
+	newMonitoring := func(alerting *serviceApi.Alerting) *serviceApi.Monitoring {
+		return &serviceApi.Monitoring{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+			Spec: serviceApi.MonitoringSpec{
+				MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+					Namespace: "test-namespace",
+					Metrics:   &serviceApi.Metrics{},
+					Alerting:  alerting,
+				},
+			},
+		}
+	}
+
+	t.Run("renders receivers and routes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		monitoring := newMonitoring(&serviceApi.Alerting{
+			Receivers: []serviceApi.AlertingReceiver{
+				{Name: "team-slack", Slack: &serviceApi.SlackReceiver{APIURLSecret: "slack-webhook", Channel: "#alerts", SendResolved: true}},
+				{Name: "oncall", PagerDuty: &serviceApi.PagerDutyReceiver{RoutingKeySecret: "pagerduty", Severity: "critical"}},
+				{Name: "hook", Webhook: &serviceApi.WebhookReceiver{URL: "https://example.com/alerts"}},
+			},
+			Route: &serviceApi.AlertingRoute{
+				Receiver:       "team-slack",
+				GroupBy:        []string{"alertname"},
+				RepeatInterval: "4h",
+				Routes: []serviceApi.AlertingSubRoute{
+					{Receiver: "oncall", Matchers: []string{`severity="critical"`}, Continue: true},
+					{Receiver: "hook"},
+				},
+			},
+		})
+
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   setupTestClient(g, dsci, monitoring, slackSecret, pagerDutySecret),
+			Instance: monitoring,
+		}
+
+		templateData, err := getTemplateData(t.Context(), rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		docs := renderTemplate(g, AlertmanagerConfigTemplate, templateData)
+		g.Expect(docs).Should(HaveLen(1))
+		g.Expect(docs[0]["metadata"]).Should(HaveKeyWithValue("name", "alertmanager-data-science-monitoringstack"))
+		g.Expect(docs[0]["metadata"]).Should(HaveKeyWithValue("namespace", "test-namespace"))
+
+		stringData, ok := docs[0]["stringData"].(map[string]any)
+		g.Expect(ok).Should(BeTrue())
+		config := map[string]any{}
+		g.Expect(yaml.Unmarshal([]byte(stringData["alertmanager.yaml"].(string)), &config)).Should(Succeed())
+
+		g.Expect(config["route"]).Should(And(
+			HaveKeyWithValue("receiver", "team-slack"),
+			HaveKeyWithValue("group_by", ConsistOf("alertname")),
+			HaveKeyWithValue("repeat_interval", "4h"),
+			HaveKeyWithValue("routes", HaveExactElements(
+				And(HaveKeyWithValue("receiver", "oncall"), HaveKeyWithValue("matchers", ConsistOf(`severity="critical"`)), HaveKeyWithValue("continue", true)),
+				HaveKeyWithValue("receiver", "hook"),
+			)),
+		))
+		g.Expect(config["receivers"]).Should(HaveExactElements(
+			And(HaveKeyWithValue("name", "team-slack"), HaveKeyWithValue("slack_configs", ConsistOf(And(
+				HaveKeyWithValue("api_url", "https://hooks.slack.com/services/T000/B000/XXX"),
+				HaveKeyWithValue("channel", "#alerts"),
+				HaveKeyWithValue("send_resolved", true),
+			)))),
+			And(HaveKeyWithValue("name", "oncall"), HaveKeyWithValue("pagerduty_configs", ConsistOf(And(
+				HaveKeyWithValue("routing_key", "0123456789abcdef"),
+				HaveKeyWithValue("severity", "critical"),
+			)))),
+			And(HaveKeyWithValue("name", "hook"), HaveKeyWithValue("webhook_configs", ConsistOf(
+				HaveKeyWithValue("url", "https://example.com/alerts"),
+			))),
+		))
+	})
+
+	t.Run("defaults route to the first receiver", func(t *testing.T) {
+		g := NewWithT(t)
+
+		monitoring := newMonitoring(&serviceApi.Alerting{
+			Receivers: []serviceApi.AlertingReceiver{
+				{Name: "hook", Webhook: &serviceApi.WebhookReceiver{URL: "https://example.com/alerts"}},
+			},
+		})
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   setupTestClient(g, dsci, monitoring),
+			Instance: monitoring,
+		}
+
+		templateData, err := getTemplateData(t.Context(), rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(templateData["AlertmanagerConfig"]).Should(ContainSubstring("receiver: hook"))
+	})
+
+	t.Run("fails when a referenced secret is missing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		monitoring := newMonitoring(&serviceApi.Alerting{
+			Receivers: []serviceApi.AlertingReceiver{
+				{Name: "team-slack", Slack: &serviceApi.SlackReceiver{APIURLSecret: "missing"}},
+			},
+		})
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   setupTestClient(g, dsci, monitoring),
+			Instance: monitoring,
+		}
+
+		_, err := getTemplateData(t.Context(), rr)
+		g.Expect(err).Should(MatchError(ContainSubstring("test-namespace/missing")))
+	})
+
+	t.Run("receiver secrets trigger a reconciliation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		monitoring := newMonitoring(&serviceApi.Alerting{
+			Receivers: []serviceApi.AlertingReceiver{
+				{Name: "team-slack", Slack: &serviceApi.SlackReceiver{APIURLSecret: "slack-webhook"}},
+				{Name: "oncall", PagerDuty: &serviceApi.PagerDutyReceiver{RoutingKeySecret: "pagerduty"}},
+				{Name: "mail", Email: &serviceApi.EmailReceiver{To: "ops@example.com", AuthPasswordSecret: "smtp"}},
+			},
+		})
+		cli := setupTestClient(g, dsci, monitoring, slackSecret, pagerDutySecret)
+
+		for _, name := range []string{"slack-webhook", "pagerduty", "smtp"} {
+			rotated := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-namespace"}}
+			g.Expect(isMonitoringSecret(t.Context(), cli, rotated)).Should(BeTrue(), name)
+		}
+
+		g.Expect(isMonitoringSecret(t.Context(), cli, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "test-namespace"},
+		})).Should(BeFalse())
+		g.Expect(isMonitoringSecret(t.Context(), cli, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack-webhook", Namespace: "other-namespace"},
+		})).Should(BeFalse())
+	})
+
+	t.Run("fails when a route references an unknown receiver", func(t *testing.T) {
+		g := NewWithT(t)
+
+		monitoring := newMonitoring(&serviceApi.Alerting{
+			Receivers: []serviceApi.AlertingReceiver{
+				{Name: "hook", Webhook: &serviceApi.WebhookReceiver{URL: "https://example.com/alerts"}},
+			},
+			Route: &serviceApi.AlertingRoute{
+				Receiver: "hook",
+				Routes:   []serviceApi.AlertingSubRoute{{Receiver: "unknown"}},
+			},
+		})
+		rr := &odhtypes.ReconciliationRequest{
+			Client:   setupTestClient(g, dsci, monitoring),
+			Instance: monitoring,
+		}
+
+		_, err := getTemplateData(t.Context(), rr)
+		g.Expect(err).Should(MatchError(ContainSubstring("unknown receiver 'unknown'")))
+	})
+}
+
+func TestCustomLogsExporters(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+
+	tests := []struct {
+		name          string
+		logs          *serviceApi.Logs
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			name:          "logs without exporters",
+			logs:          &serviceApi.Logs{},
+			expectedNames: []string{},
+		},
+		{
+			name: "valid logs exporters are sorted",
+			logs: &serviceApi.Logs{Exporters: map[string]runtime.RawExtension{
+				"otlphttp/loki": stringToRawExtension(`{"endpoint": "https://loki.example.com/otlp"}`),
+				"debug":         stringToRawExtension(`{"verbosity": "basic"}`),
+			}},
+			expectedNames: []string{"debug", "otlphttp/loki"},
+		},
+		{
+			name: "reserved logs exporter name",
+			logs: &serviceApi.Logs{Exporters: map[string]runtime.RawExtension{
+				"otlp/tempo": stringToRawExtension(`{"endpoint": "tempo:4317"}`),
+			}},
+			expectedError: "reserved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Logs:      tt.logs,
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, dsci, monitoring),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.expectedError)))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(templateData["LogsExporterNames"]).Should(Equal(tt.expectedNames))
+
+			docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+
+			config, ok := docs[0]["spec"].(map[string]any)["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			if len(tt.expectedNames) == 0 {
+				g.Expect(config["service"]).ShouldNot(HaveKey("pipelines"))
+				return
+			}
+			g.Expect(config["exporters"]).Should(HaveKey("otlphttp/loki"))
+			g.Expect(config["service"]).Should(HaveKeyWithValue("pipelines", HaveKeyWithValue("logs",
+				HaveKeyWithValue("exporters", HaveExactElements("debug", "otlphttp/loki")))))
+		})
+	}
+}
+
+func TestMonitoringSizePresets(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+
+	tests := []struct {
+		name                      string
+		size                      string
+		customResources           *serviceApi.MonitoringCustomResources
+		collectorReplicas         int32
+		metricsReplicas           int32
+		expectedCPULimit          string
+		expectedReplicas          string
+		expectedCollectorReplicas int32
+		expectedCollectorCPU      any
+		expectedTempoMemory       any
+	}{
+		{
+			name:                      "no size keeps existing defaults",
+			collectorReplicas:         2,
+			expectedCPULimit:          defaultCPULimit,
+			expectedReplicas:          "2",
+			expectedCollectorReplicas: 2,
+		},
+		{
+			name:                      "medium preset",
+			size:                      serviceApi.MonitoringSizeMedium,
+			expectedCPULimit:          "1",
+			expectedReplicas:          "2",
+			expectedCollectorReplicas: 2,
+			expectedCollectorCPU:      "1",
+			expectedTempoMemory:       "2Gi",
+		},
+		{
+			name:                      "large preset honours explicit replicas",
+			size:                      serviceApi.MonitoringSizeLarge,
+			collectorReplicas:         5,
+			metricsReplicas:           4,
+			expectedCPULimit:          "2",
+			expectedReplicas:          "4",
+			expectedCollectorReplicas: 5,
+			expectedCollectorCPU:      "2",
+			expectedTempoMemory:       "4Gi",
+		},
+		{
+			name: "custom resources",
+			size: serviceApi.MonitoringSizeCustom,
+			customResources: &serviceApi.MonitoringCustomResources{
+				Collector: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+				},
+				Prometheus: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m")},
+				},
+			},
+			collectorReplicas:         1,
+			expectedCPULimit:          "1500m",
+			expectedReplicas:          "2",
+			expectedCollectorReplicas: 1,
+			expectedCollectorCPU:      "3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics: &serviceApi.Metrics{
+							Storage:  &serviceApi.MetricsStorage{},
+							Replicas: tt.metricsReplicas,
+						},
+						Traces: &serviceApi.Traces{
+							Storage: serviceApi.TracesStorage{Backend: "pv"},
+						},
+						CollectorReplicas: tt.collectorReplicas,
+						Size:              tt.size,
+						CustomResources:   tt.customResources,
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, dsci, monitoring),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(templateData).Should(HaveKeyWithValue("CPULimit", tt.expectedCPULimit))
+			g.Expect(templateData).Should(HaveKeyWithValue("Replicas", tt.expectedReplicas))
+			g.Expect(templateData).Should(HaveKeyWithValue("CollectorReplicas", tt.expectedCollectorReplicas))
+
+			collector := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)[0]
+			if tt.expectedCollectorCPU == nil {
+				g.Expect(collector["spec"]).ShouldNot(HaveKey("resources"))
+			} else {
+				g.Expect(collector["spec"]).Should(HaveKeyWithValue("resources",
+					HaveKeyWithValue("limits", HaveKeyWithValue("cpu", tt.expectedCollectorCPU))))
+			}
+
+			tempo := renderTemplate(g, TempoMonolithicTemplate, templateData)[0]
+			if tt.expectedTempoMemory == nil {
+				g.Expect(tempo["spec"]).ShouldNot(HaveKey("resources"))
+			} else {
+				g.Expect(tempo["spec"]).Should(HaveKeyWithValue("resources", HaveKeyWithValue("total",
+					HaveKeyWithValue("limits", HaveKeyWithValue("memory", tt.expectedTempoMemory)))))
+			}
+		})
+	}
+}
+
+func TestCustomProcessors(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+
+	tests := []struct {
+		name                     string
+		metricsProcessors        map[string]runtime.RawExtension
+		metricsProcessorOrder    []string
+		tracesProcessors         map[string]runtime.RawExtension
+		tracesProcessorOrder     []string
+		expectedMetricsProcessor []any
+		expectedTracesProcessor  []any
+		expectedError            string
+	}{
+		{
+			name:                     "no custom processors",
+			expectedMetricsProcessor: []any{"memory_limiter", "k8sattributes", "resourcedetection", "batch"},
+			expectedTracesProcessor:  []any{"memory_limiter", "k8sattributes", "resourcedetection", "batch"},
+		},
+		{
+			name: "custom processors are ordered by name before batch",
+			metricsProcessors: map[string]runtime.RawExtension{
+				"filter/drop-debug": stringToRawExtension(`{"error_mode": "ignore"}`),
+				"attributes/env":    stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+			},
+			tracesProcessors: map[string]runtime.RawExtension{
+				"attributes/env": stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+				"batch/traces":   {},
+			},
+			expectedMetricsProcessor: []any{"memory_limiter", "k8sattributes", "resourcedetection", "attributes/env", "filter/drop-debug", "batch"},
+			expectedTracesProcessor:  []any{"memory_limiter", "k8sattributes", "resourcedetection", "attributes/env", "batch/traces", "batch"},
+		},
+		{
+			name: "processor order is kept",
+			metricsProcessors: map[string]runtime.RawExtension{
+				"filter/drop-debug": stringToRawExtension(`{"error_mode": "ignore"}`),
+				"attributes/env":    stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+				"transform/units":   stringToRawExtension(`{"error_mode": "ignore"}`),
+			},
+			metricsProcessorOrder: []string{"filter/drop-debug", "transform/units"},
+			tracesProcessors: map[string]runtime.RawExtension{
+				"attributes/env": stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+				"batch/traces":   {},
+			},
+			tracesProcessorOrder:     []string{"batch/traces", "attributes/env"},
+			expectedMetricsProcessor: []any{"memory_limiter", "k8sattributes", "resourcedetection", "filter/drop-debug", "transform/units", "attributes/env", "batch"},
+			expectedTracesProcessor:  []any{"memory_limiter", "k8sattributes", "resourcedetection", "batch/traces", "attributes/env", "batch"},
+		},
+		{
+			name: "processor order references an unknown processor",
+			metricsProcessors: map[string]runtime.RawExtension{
+				"attributes/env": stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+			},
+			metricsProcessorOrder: []string{"filter/drop-debug"},
+			expectedError:         "processor order references unknown processor 'filter/drop-debug'",
+		},
+		{
+			name: "reserved processor name",
+			metricsProcessors: map[string]runtime.RawExtension{
+				"memory_limiter": stringToRawExtension(`{"limit_mib": 100}`),
+			},
+			expectedError: "processor name 'memory_limiter' is reserved",
+		},
+		{
+			name: "conflicting processor config",
+			metricsProcessors: map[string]runtime.RawExtension{
+				"attributes/env": stringToRawExtension(`{"actions": [{"key": "env", "value": "prod", "action": "upsert"}]}`),
+			},
+			tracesProcessors: map[string]runtime.RawExtension{
+				"attributes/env": stringToRawExtension(`{"actions": [{"key": "env", "value": "dev", "action": "upsert"}]}`),
+			},
+			expectedError: "processor 'attributes/env' is configured differently",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics: &serviceApi.Metrics{
+							Storage:        &serviceApi.MetricsStorage{},
+							Processors:     tt.metricsProcessors,
+							ProcessorOrder: tt.metricsProcessorOrder,
+						},
+						Traces: &serviceApi.Traces{
+							Storage:        serviceApi.TracesStorage{Backend: "pv"},
+							Processors:     tt.tracesProcessors,
+							ProcessorOrder: tt.tracesProcessorOrder,
+						},
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, dsci, monitoring),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.expectedError)))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			collector := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)[0]
+			config, ok := collector["spec"].(map[string]any)["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+
+			for name := range tt.metricsProcessors {
+				g.Expect(config["processors"]).Should(HaveKey(name))
+			}
+			for name := range tt.tracesProcessors {
+				g.Expect(config["processors"]).Should(HaveKey(name))
+			}
+			g.Expect(config["service"]).Should(HaveKeyWithValue("pipelines", And(
+				HaveKeyWithValue("metrics", HaveKeyWithValue("processors", Equal(tt.expectedMetricsProcessor))),
+				HaveKeyWithValue("traces", HaveKeyWithValue("processors", Equal(tt.expectedTracesProcessor))),
+			)))
+		})
+	}
+}
+
+func TestMetricsRemoteWrite(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mimir-token", Namespace: "test-namespace"},
+		Data:       map[string][]byte{"token": []byte("secret-token")},
+	}
+
+	tests := []struct {
+		name              string
+		metrics           *serviceApi.Metrics
+		objects           []client.Object
+		expectedPipelines map[string][]string
+		expectedEnv       []string
+		expectedVolumes   []string
+		expectedError     string
+	}{
+		{
+			name: "remote write alongside in-cluster storage",
+			metrics: &serviceApi.Metrics{
+				Storage: &serviceApi.MetricsStorage{Size: resource.MustParse("5Gi"), Retention: "90d"},
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "central",
+					URL:  "https://prometheus.example.com/api/v1/write",
+				}},
+			},
+			expectedPipelines: map[string][]string{"metrics": {"prometheus", "prometheusremotewrite/central"}},
+			expectedEnv:       []string{},
+			expectedVolumes:   []string{},
+		},
+		{
+			name: "remote write only with auth, TLS and relabeling",
+			metrics: &serviceApi.Metrics{
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "mimir",
+					URL:  "https://mimir.example.com/api/v1/push",
+					Auth: &serviceApi.MetricsRemoteWriteAuth{BearerTokenSecret: "mimir-token"},
+					TLS:  &serviceApi.MetricsRemoteWriteTLS{CAConfigMap: "mimir-ca"},
+					WriteRelabelConfigs: []serviceApi.MetricsRelabelConfig{{
+						SourceLabels: []string{"__name__"},
+						Regex:        "go_.*",
+						Action:       "drop",
+					}},
+				}},
+			},
+			objects:           []client.Object{tokenSecret},
+			expectedPipelines: map[string][]string{"metrics/remote-write-mimir": {"prometheusremotewrite/mimir"}},
+			expectedEnv:       []string{"REMOTE_WRITE_MIMIR_TOKEN"},
+			expectedVolumes:   []string{"remote-write-mimir-ca"},
+		},
+		{
+			name: "missing auth secret",
+			metrics: &serviceApi.Metrics{
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "mimir",
+					URL:  "https://mimir.example.com/api/v1/push",
+					Auth: &serviceApi.MetricsRemoteWriteAuth{BearerTokenSecret: "mimir-token"},
+				}},
+			},
+			expectedError: "failed to get secret test-namespace/mimir-token",
+		},
+		{
+			name: "insecure external endpoint",
+			metrics: &serviceApi.Metrics{
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "central",
+					URL:  "http://prometheus.example.com/api/v1/write",
+				}},
+			},
+			expectedError: "insecure HTTP endpoints not allowed",
+		},
+		{
+			name: "conflicting custom exporter",
+			metrics: &serviceApi.Metrics{
+				Exporters: map[string]runtime.RawExtension{
+					"prometheusremotewrite/central": stringToRawExtension(`{"endpoint": "https://other.example.com"}`),
+				},
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "central",
+					URL:  "https://prometheus.example.com/api/v1/write",
+				}},
+			},
+			expectedError: "conflicts with custom metrics exporter",
+		},
+		{
+			name: "unsupported write relabel action",
+			metrics: &serviceApi.Metrics{
+				RemoteWrite: []serviceApi.MetricsRemoteWrite{{
+					Name: "central",
+					URL:  "https://prometheus.example.com/api/v1/write",
+					WriteRelabelConfigs: []serviceApi.MetricsRelabelConfig{{
+						SourceLabels: []string{"namespace"},
+						TargetLabel:  "ns",
+						Action:       "replace",
+					}},
+				}},
+			},
+			expectedError: "unsupported write relabel action 'replace'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics:   tt.metrics,
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, append([]client.Object{dsci, monitoring}, tt.objects...)...),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.expectedError)))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+
+			spec, ok := docs[0]["spec"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			config, ok := spec["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			pipelines, ok := config["service"].(map[string]any)["pipelines"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(pipelines).Should(HaveLen(len(tt.expectedPipelines)))
+			for name, exporters := range tt.expectedPipelines {
+				g.Expect(pipelines).Should(HaveKeyWithValue(name, HaveKeyWithValue("exporters", HaveExactElements(exporters))))
+			}
+
+			envNames := make([]string, 0)
+			if env, ok := spec["env"].([]any); ok {
+				for _, e := range env {
+					envNames = append(envNames, e.(map[string]any)["name"].(string))
+				}
+			}
+			g.Expect(envNames).Should(ConsistOf(tt.expectedEnv))
+
+			volumeNames := make([]string, 0)
+			if volumes, ok := spec["volumes"].([]any); ok {
+				for _, v := range volumes {
+					volumeNames = append(volumeNames, v.(map[string]any)["name"].(string))
+				}
+			}
+			g.Expect(volumeNames).Should(ConsistOf(tt.expectedVolumes))
+
+			if len(tt.objects) == 0 {
+				g.Expect(spec).ShouldNot(HaveKey("podAnnotations"))
+				return
+			}
+			g.Expect(spec).Should(HaveKeyWithValue("podAnnotations",
+				HaveKeyWithValue("opendatahub.io/collector-secrets-hash", templateData["CollectorSecretsHash"])))
+
+			exporter, ok := config["exporters"].(map[string]any)["prometheusremotewrite/mimir"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(exporter).Should(HaveKeyWithValue("endpoint", "https://mimir.example.com/api/v1/push"))
+			g.Expect(exporter).Should(HaveKeyWithValue("tls", HaveKeyWithValue("ca_file", "/etc/otelcol/remote-write/mimir/ca/ca.crt")))
+			g.Expect(exporter).ShouldNot(HaveKey("write_relabel_configs"))
+
+			g.Expect(pipelines["metrics/remote-write-mimir"]).Should(HaveKeyWithValue("processors", ContainElement("filter/remote-write-mimir")))
+			g.Expect(config["processors"]).Should(HaveKeyWithValue("filter/remote-write-mimir", HaveKeyWithValue("metrics",
+				HaveKeyWithValue("datapoint", HaveExactElements(`IsMatch(metric.name, "^(?:go_.*)$")`)))))
+		})
+	}
+}
+
+func TestRelabelFilterCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   serviceApi.MetricsRelabelConfig
+		expected string
+	}{
+		{
+			name:     "drop by metric name",
+			config:   serviceApi.MetricsRelabelConfig{SourceLabels: []string{"__name__"}, Regex: "go_.*", Action: "drop"},
+			expected: `IsMatch(metric.name, "^(?:go_.*)$")`,
+		},
+		{
+			name:     "keep by attribute",
+			config:   serviceApi.MetricsRelabelConfig{SourceLabels: []string{"namespace"}, Regex: "opendatahub", Action: "keep"},
+			expected: `not IsMatch(datapoint.attributes["namespace"], "^(?:opendatahub)$")`,
+		},
+		{
+			name: "keep with multiple source labels",
+			config: serviceApi.MetricsRelabelConfig{
+				SourceLabels: []string{"__name__", "job"}, Separator: "/", Regex: `up/.+\.svc`, Action: "keep",
+			},
+			expected: `not IsMatch(Concat([metric.name, datapoint.attributes["job"]], "/"), "^(?:up/.+\\.svc)$")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			condition, err := relabelFilterCondition(tt.config)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(condition).Should(Equal(tt.expected))
+		})
+	}
+}
+
+func TestCommonLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+	monitoring := &serviceApi.Monitoring{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "test-namespace",
+				Metrics: &serviceApi.Metrics{
+					Storage: &serviceApi.MetricsStorage{Size: resource.MustParse("5Gi"), Retention: "90d"},
+					ScrapeConfigs: []serviceApi.MetricsScrapeConfig{{
+						Component: "kserve",
+						Enabled:   true,
+						Relabelings: []serviceApi.MetricsRelabelConfig{
+							{SourceLabels: []string{"__meta_kubernetes_pod_name"}, TargetLabel: "pod", Action: "replace"},
+						},
+					}},
+				},
+				Traces: &serviceApi.Traces{Storage: serviceApi.TracesStorage{Backend: "pv"}},
+				Logs: &serviceApi.Logs{Exporters: map[string]runtime.RawExtension{
+					"debug": stringToRawExtension(`{"verbosity": "basic"}`),
+				}},
+				CommonLabels: map[string]string{"tenant": "team-a", "cluster": "prod-east"},
+			},
+		},
+	}
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client:   setupTestClient(g, dsci, monitoring),
+		Instance: monitoring,
+	}
+
+	templateData, err := getTemplateData(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+	g.Expect(docs).Should(HaveLen(1))
+	config, ok := docs[0]["spec"].(map[string]any)["config"].(map[string]any)
+	g.Expect(ok).Should(BeTrue())
+
+	g.Expect(config["processors"]).Should(HaveKeyWithValue(commonLabelsProcessor, HaveKeyWithValue("attributes", HaveExactElements(
+		And(HaveKeyWithValue("key", "cluster"), HaveKeyWithValue("value", "prod-east"), HaveKeyWithValue("action", "upsert")),
+		And(HaveKeyWithValue("key", "tenant"), HaveKeyWithValue("value", "team-a"), HaveKeyWithValue("action", "upsert")),
+	))))
+
+	pipelines, ok := config["service"].(map[string]any)["pipelines"].(map[string]any)
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(pipelines).Should(HaveLen(3))
+	for name, pipeline := range pipelines {
+		g.Expect(pipeline).Should(HaveKeyWithValue("processors", HaveExactElements(
+			"memory_limiter", "k8sattributes", "resourcedetection", commonLabelsProcessor, "batch")), name)
+	}
+
+	monitors := renderTemplate(g, ComponentMonitorsTemplate, templateData)
+	monitors = append(monitors, renderTemplate(g, CollectorServiceMonitorsTemplate, templateData)...)
+	g.Expect(monitors).Should(HaveLen(3))
+	for _, monitor := range monitors {
+		g.Expect(monitor["metadata"]).Should(HaveKeyWithValue("labels", And(
+			HaveKeyWithValue("tenant", "team-a"),
+			HaveKeyWithValue("cluster", "prod-east"),
+		)))
+		g.Expect(monitor["spec"]).Should(HaveKeyWithValue("endpoints", ConsistOf(HaveKeyWithValue("relabelings", ContainElements(
+			And(HaveKeyWithValue("targetLabel", "cluster"), HaveKeyWithValue("replacement", "prod-east"), HaveKeyWithValue("action", "replace")),
+			And(HaveKeyWithValue("targetLabel", "tenant"), HaveKeyWithValue("replacement", "team-a"), HaveKeyWithValue("action", "replace")),
+		)))))
+	}
+	g.Expect(monitors[0]["spec"]).Should(HaveKeyWithValue("endpoints", ConsistOf(
+		HaveKeyWithValue("relabelings", HaveLen(3)),
+	)), "component relabelings should be kept")
+}
+
+func createGrafanaDashboardCRD() *extv1.CustomResourceDefinition {
+	return &extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "grafanadashboards.grafana.integreatly.org",
+		},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: "grafana.integreatly.org",
+			Versions: []extv1.CustomResourceDefinitionVersion{
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+			Scope: extv1.NamespaceScoped,
+			Names: extv1.CustomResourceDefinitionNames{
+				Plural: "grafanadashboards",
+				Kind:   "GrafanaDashboard",
+			},
+		},
+		Status: extv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1beta1"},
+		},
+	}
+}
+
+func TestGrafanaDashboards(t *testing.T) {
+	tests := []struct {
+		name              string
+		dashboards        *serviceApi.Dashboards
+		hasGrafanaCRD     bool
+		expectedKind      string
+		expectedCondition metav1.ConditionStatus
+		expectedReason    string
+	}{
+		{
+			name:              "dashboards not configured",
+			expectedCondition: metav1.ConditionFalse,
+			expectedReason:    status.DashboardsNotConfiguredReason,
+		},
+		{
+			name:              "dashboards disabled",
+			dashboards:        &serviceApi.Dashboards{Enabled: false},
+			hasGrafanaCRD:     true,
+			expectedCondition: metav1.ConditionFalse,
+			expectedReason:    status.DashboardsNotConfiguredReason,
+		},
+		{
+			name:              "ConfigMaps without the Grafana operator",
+			dashboards:        &serviceApi.Dashboards{Enabled: true},
+			expectedKind:      "ConfigMap",
+			expectedCondition: metav1.ConditionTrue,
+			expectedReason:    status.DashboardConfigMapsReason,
+		},
+		{
+			name:              "GrafanaDashboards with the Grafana operator",
+			dashboards:        &serviceApi.Dashboards{Enabled: true, InstanceSelector: map[string]string{"dashboards": "data-science"}},
+			hasGrafanaCRD:     true,
+			expectedKind:      "GrafanaDashboard",
+			expectedCondition: metav1.ConditionTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := testScheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			fakeMapper := meta.NewDefaultRESTMapper(scheme.PreferredVersionAllGroups())
+			for kt := range scheme.AllKnownTypes() {
+				fakeMapper.Add(kt, meta.RESTScopeNamespace)
+			}
+
+			dsci := &dsciv2.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+				Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+			}
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace:  "test-namespace",
+						Dashboards: tt.dashboards,
+					},
+				},
+			}
+			objects := []client.Object{dsci, monitoring}
+			if tt.hasGrafanaCRD {
+				fakeMapper.Add(gvk.GrafanaDashboard, meta.RESTScopeNamespace)
+				objects = append(objects, createGrafanaDashboardCRD())
+			}
+
+			rr := &odhtypes.ReconciliationRequest{
+				Client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithRESTMapper(fakeMapper).
+					WithObjects(objects...).
+					Build(),
+				Instance:   monitoring,
+				Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+			}
+
+			g.Expect(deployGrafanaDashboards(t.Context(), rr)).Should(Succeed())
+
+			condition := rr.Conditions.GetCondition(status.ConditionGrafanaDashboardsAvailable)
+			g.Expect(condition).ShouldNot(BeNil())
+			g.Expect(condition.Status).Should(Equal(tt.expectedCondition))
+			if tt.expectedReason != "" {
+				g.Expect(condition.Reason).Should(Equal(tt.expectedReason))
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			docs := renderTemplate(g, GrafanaDashboardsTemplate, templateData)
+			if tt.expectedKind == "" {
+				g.Expect(rr.Templates).Should(BeEmpty())
+				g.Expect(docs).Should(BeEmpty())
+				return
+			}
+			g.Expect(rr.Templates).Should(ConsistOf(HaveField("Path", GrafanaDashboardsTemplate)))
+			g.Expect(docs).Should(HaveLen(len(grafanaDashboards)))
+
+			for i, doc := range docs {
+				name := "data-science-" + grafanaDashboards[i]
+				g.Expect(doc).Should(HaveKeyWithValue("kind", tt.expectedKind))
+				g.Expect(doc["metadata"]).Should(HaveKeyWithValue("name", name))
+
+				var dashboard string
+				if tt.expectedKind == "ConfigMap" {
+					g.Expect(doc["metadata"]).Should(HaveKeyWithValue("labels", HaveKeyWithValue("grafana_dashboard", "1")))
+					data, ok := doc["data"].(map[string]any)
+					g.Expect(ok).Should(BeTrue())
+					dashboard, ok = data[grafanaDashboards[i]+".json"].(string)
+					g.Expect(ok).Should(BeTrue())
+				} else {
+					spec, ok := doc["spec"].(map[string]any)
+					g.Expect(ok).Should(BeTrue())
+					g.Expect(spec).Should(HaveKeyWithValue("instanceSelector",
+						HaveKeyWithValue("matchLabels", HaveKeyWithValue("dashboards", "data-science"))))
+					dashboard, ok = spec["json"].(string)
+					g.Expect(ok).Should(BeTrue())
+				}
+
+				parsed := map[string]any{}
+				g.Expect(json.Unmarshal([]byte(dashboard), &parsed)).Should(Succeed(), name)
+				g.Expect(parsed).Should(HaveKey("panels"))
+			}
+		})
+	}
+}
+
+func TestPipelineConditions(t *testing.T) {
+	const ns = "test-namespace"
+
+	deployment := func(name string, ready int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: ready},
+		}
+	}
+	statefulSet := func(name string, ready int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](2)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: ready},
+		}
+	}
+	tempo := func(ready string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk.TempoMonolithic)
+		u.SetName(TempoMonolithicName)
+		u.SetNamespace(ns)
+		u.Object["status"] = map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": ready, "message": "ingester pending"}},
+		}
+		return u
+	}
+
+	fullSpec := serviceApi.MonitoringCommonSpec{
+		Namespace: ns,
+		Metrics:   &serviceApi.Metrics{},
+		Traces: &serviceApi.Traces{
+			Storage: serviceApi.TracesStorage{Backend: "pv"},
+		},
+		Alerting: &serviceApi.Alerting{},
+	}
+
+	tests := []struct {
+		name     string
+		spec     serviceApi.MonitoringCommonSpec
+		objects  []client.Object
+		expected map[string]gtypes.GomegaMatcher
+	}{
+		{
+			name: "nothing configured",
+			spec: serviceApi.MonitoringCommonSpec{Namespace: ns},
+			expected: map[string]gtypes.GomegaMatcher{
+				status.ConditionMetricsPipelineReady: HaveField("Reason", status.MetricsNotConfiguredReason),
+				status.ConditionTracesPipelineReady:  HaveField("Reason", status.TracesNotConfiguredReason),
+				status.ConditionAlertingReady:        HaveField("Reason", status.AlertingNotConfiguredReason),
+			},
+		},
+		{
+			name: "all workloads ready",
+			spec: fullSpec,
+			objects: []client.Object{
+				deployment(CollectorDeploymentName, 1),
+				statefulSet(PrometheusStatefulSetName, 2),
+				statefulSet(AlertmanagerStatefulSetName, 2),
+				tempo("True"),
+			},
+			expected: map[string]gtypes.GomegaMatcher{
+				status.ConditionMetricsPipelineReady: HaveField("Status", metav1.ConditionTrue),
+				status.ConditionTracesPipelineReady:  HaveField("Status", metav1.ConditionTrue),
+				status.ConditionAlertingReady:        HaveField("Status", metav1.ConditionTrue),
+			},
+		},
+		{
+			name: "workloads not ready",
+			spec: fullSpec,
+			objects: []client.Object{
+				deployment(CollectorDeploymentName, 1),
+				statefulSet(PrometheusStatefulSetName, 1),
+				tempo("False"),
+			},
+			expected: map[string]gtypes.GomegaMatcher{
+				status.ConditionMetricsPipelineReady: And(
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Reason", status.PipelineWorkloadNotReadyReason),
+					HaveField("Message", "StatefulSet "+PrometheusStatefulSetName+" has 1/2 ready replicas"),
+				),
+				status.ConditionTracesPipelineReady: And(
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Message", "TempoMonolithic "+TempoMonolithicName+" is not ready: ingester pending"),
+				),
+				status.ConditionAlertingReady: And(
+					HaveField("Status", metav1.ConditionFalse),
+					HaveField("Message", ContainSubstring("StatefulSet "+AlertmanagerStatefulSetName+" not found")),
+				),
+			},
+		},
+		{
+			name: "remote write only does not require prometheus",
+			spec: serviceApi.MonitoringCommonSpec{
+				Namespace: ns,
+				Metrics: &serviceApi.Metrics{
+					RemoteWrite: []serviceApi.MetricsRemoteWrite{{Name: "external", URL: "https://prometheus.example.com/api/v1/write"}},
+				},
+			},
+			objects: []client.Object{deployment(CollectorDeploymentName, 1)},
+			expected: map[string]gtypes.GomegaMatcher{
+				status.ConditionMetricsPipelineReady: HaveField("Status", metav1.ConditionTrue),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := testScheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			fakeMapper := meta.NewDefaultRESTMapper(scheme.PreferredVersionAllGroups())
+			for kt := range scheme.AllKnownTypes() {
+				fakeMapper.Add(kt, meta.RESTScopeNamespace)
+			}
+			fakeMapper.Add(gvk.TempoMonolithic, meta.RESTScopeNamespace)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec:       serviceApi.MonitoringSpec{MonitoringCommonSpec: tt.spec},
+			}
+
+			rr := &odhtypes.ReconciliationRequest{
+				Client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithRESTMapper(fakeMapper).
+					WithObjects(tt.objects...).
+					Build(),
+				Instance:   monitoring,
+				Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+			}
+
+			g.Expect(updatePipelineConditions(t.Context(), rr)).Should(Succeed())
+
+			for conditionType, matcher := range tt.expected {
+				condition := rr.Conditions.GetCondition(conditionType)
+				g.Expect(condition).ShouldNot(BeNil(), conditionType)
+				g.Expect(*condition).Should(matcher, conditionType)
+			}
+		})
+	}
+}
+
+func TestExporterSecretRefs(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+	apiKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "datadog", Namespace: "test-namespace"},
+		Data:       map[string][]byte{"api-key": []byte("dd-api-key")},
+	}
+	apiKeyEnv := secretRefEnvName("datadog", "api-key")
+	datadogExporter := `{
+		"endpoint": "https://otlp.datadoghq.com",
+		"headers": {"DD-API-KEY": {"valueFrom": {"secretKeyRef": {"name": "datadog", "key": "api-key"}}}}
+	}`
+
+	tests := []struct {
+		name          string
+		metrics       *serviceApi.Metrics
+		traces        *serviceApi.Traces
+		objects       []client.Object
+		expectedError string
+	}{
+		{
+			name: "metrics and traces exporters referencing the same key",
+			metrics: &serviceApi.Metrics{
+				Exporters: map[string]runtime.RawExtension{"otlphttp/datadog": stringToRawExtension(datadogExporter)},
+			},
+			traces: &serviceApi.Traces{
+				Storage:   serviceApi.TracesStorage{Backend: "pv"},
+				Exporters: map[string]runtime.RawExtension{"otlphttp/datadog-traces": stringToRawExtension(datadogExporter)},
+			},
+			objects: []client.Object{apiKeySecret},
+		},
+		{
+			name: "missing secret",
+			metrics: &serviceApi.Metrics{
+				Exporters: map[string]runtime.RawExtension{"otlphttp/datadog": stringToRawExtension(datadogExporter)},
+			},
+			expectedError: "failed to get secret test-namespace/datadog",
+		},
+		{
+			name: "secret reference without key",
+			metrics: &serviceApi.Metrics{
+				Exporters: map[string]runtime.RawExtension{
+					"otlphttp/datadog": stringToRawExtension(`{"headers": {"DD-API-KEY": {"valueFrom": {"secretKeyRef": {"name": "datadog"}}}}}`),
+				},
+			},
+			objects:       []client.Object{apiKeySecret},
+			expectedError: "exporter 'otlphttp/datadog' has an invalid secret reference",
+		},
+		{
+			name: "secret reference mixed with other fields",
+			metrics: &serviceApi.Metrics{
+				Exporters: map[string]runtime.RawExtension{
+					"otlphttp/datadog": stringToRawExtension(`{"headers": {"DD-API-KEY": {"value": "plain", "valueFrom": {"secretKeyRef": {"name": "datadog", "key": "api-key"}}}}}`),
+				},
+			},
+			objects:       []client.Object{apiKeySecret},
+			expectedError: "exporter 'otlphttp/datadog' has an invalid secret reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics:   tt.metrics,
+						Traces:    tt.traces,
+					},
+				},
+			}
+			cli := setupTestClient(g, append([]client.Object{dsci, monitoring}, tt.objects...)...)
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   cli,
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.expectedError)))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(isMonitoringSecret(t.Context(), cli, apiKeySecret)).Should(BeTrue())
+
+			docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+
+			spec, ok := docs[0]["spec"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(spec["env"]).Should(HaveExactElements(map[string]any{
+				"name": apiKeyEnv,
+				"valueFrom": map[string]any{
+					"secretKeyRef": map[string]any{"name": "datadog", "key": "api-key"},
+				},
+			}))
+
+			config, ok := spec["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(config["exporters"]).Should(HaveKeyWithValue("otlphttp/datadog",
+				HaveKeyWithValue("headers", HaveKeyWithValue("DD-API-KEY", "${env:"+apiKeyEnv+"}"))))
+			g.Expect(config["exporters"]).Should(HaveKeyWithValue("otlphttp/datadog-traces",
+				HaveKeyWithValue("headers", HaveKeyWithValue("DD-API-KEY", "${env:"+apiKeyEnv+"}"))))
+
+			rendered, err := yaml.Marshal(docs[0])
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(string(rendered)).ShouldNot(ContainSubstring("dd-api-key"))
+		})
+	}
+}
+
+func TestSecretRefEnvName(t *testing.T) {
+	g := NewWithT(t)
+
+	name := secretRefEnvName("datadog", "api-key")
+	g.Expect(name).Should(MatchRegexp(`^EXPORTER_SECRET_DATADOG_API_KEY_[0-9a-f]{8}$`))
+	g.Expect(secretRefEnvName("datadog", "api-key")).Should(Equal(name))
+	g.Expect(secretRefEnvName("datadog-api", "key")).ShouldNot(Equal(name))
+}
+
+func TestRenderOnly(t *testing.T) {
+	secret := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "alertmanager-config", "namespace": "test-namespace"},
+		"data":       map[string]any{"alertmanager.yaml": "c2xhY2stdXJs"},
+	}}
+	collector := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "opentelemetry.io/v1beta1",
+		"kind":       "OpenTelemetryCollector",
+		"metadata": map[string]any{
+			"name":        "data-science-collector",
+			"namespace":   "test-namespace",
+			"annotations": map[string]any{annotations.InstanceGeneration: "1"},
+		},
+	}}
+
+	for _, renderOnlyMode := range []bool{false, true} {
+		t.Run(fmt.Sprintf("renderOnly=%t", renderOnlyMode), func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := testScheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace:  "test-namespace",
+						Metrics:    &serviceApi.Metrics{},
+						RenderOnly: renderOnlyMode,
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:     fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Instance:   monitoring,
+				Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+				Resources:  []unstructured.Unstructured{*secret.DeepCopy(), *collector.DeepCopy()},
+			}
+
+			g.Expect(renderOnly(t.Context(), rr)).Should(Succeed())
+			g.Expect(updatePipelineConditions(t.Context(), rr)).Should(Succeed())
+
+			removable, err := gcObjectPredicate(rr, collector)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			condition := rr.Conditions.GetCondition(status.ConditionMetricsPipelineReady)
+			g.Expect(condition).ShouldNot(BeNil())
+
+			if !renderOnlyMode {
+				g.Expect(rr.Resources).Should(HaveLen(2))
+				g.Expect(removable).Should(BeTrue())
+				g.Expect(condition.Reason).Should(Equal(status.PipelineWorkloadNotReadyReason))
+				return
+			}
+
+			g.Expect(removable).Should(BeFalse())
+			g.Expect(condition.Reason).Should(Equal(status.RenderOnlyReason))
+
+			g.Expect(rr.Resources).Should(HaveLen(1))
+			cm := rr.Resources[0]
+			g.Expect(cm.GetKind()).Should(Equal("ConfigMap"))
+			g.Expect(cm.GetName()).Should(Equal(RenderedManifestsName))
+			g.Expect(cm.GetNamespace()).Should(Equal("test-namespace"))
+
+			manifests, found, err := unstructured.NestedString(cm.Object, "data", RenderedManifestsKey)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(found).Should(BeTrue())
+			g.Expect(manifests).ShouldNot(ContainSubstring("c2xhY2stdXJs"))
+
+			docs := make([]map[string]any, 0)
+			decoder := yaml.NewDecoder(strings.NewReader(manifests))
+			for {
+				out := map[string]any{}
+				err := decoder.Decode(&out)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				g.Expect(err).ShouldNot(HaveOccurred())
+				docs = append(docs, out)
+			}
+			g.Expect(docs).Should(HaveExactElements(
+				HaveKeyWithValue("kind", "OpenTelemetryCollector"),
+				And(
+					HaveKeyWithValue("kind", "Secret"),
+					HaveKeyWithValue("data", HaveKeyWithValue("alertmanager.yaml", redactedValue)),
+				),
+			))
+		})
+	}
+}
+
+func TestMetricsStorage(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+
+	tests := []struct {
+		name                 string
+		storage              *serviceApi.MetricsStorage
+		expectedRetention    string
+		expectedStorageClass any
+		expectedError        string
+	}{
+		{
+			name:              "default retention without storage class",
+			storage:           &serviceApi.MetricsStorage{Size: resource.MustParse("5Gi")},
+			expectedRetention: defaultRetention,
+		},
+		{
+			name: "retention and storage class",
+			storage: &serviceApi.MetricsStorage{
+				Size:             resource.MustParse("50Gi"),
+				Retention:        "2w3d",
+				StorageClassName: "gp3-csi",
+			},
+			expectedRetention:    "2w3d",
+			expectedStorageClass: "gp3-csi",
+		},
+		{
+			name: "invalid retention",
+			storage: &serviceApi.MetricsStorage{
+				Size:      resource.MustParse("50Gi"),
+				Retention: "90 days",
+			},
+			expectedError: `invalid metrics retention "90 days"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics:   &serviceApi.Metrics{Storage: tt.storage},
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:   setupTestClient(g, dsci, monitoring),
+				Instance: monitoring,
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			if tt.expectedError != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.expectedError)))
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			docs := renderTemplate(g, MonitoringStackTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+			g.Expect(docs[0]["spec"]).Should(HaveKeyWithValue("retention", tt.expectedRetention))
+
+			prometheusConfig, ok := docs[0]["spec"].(map[string]any)["prometheusConfig"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			pvc, ok := prometheusConfig["persistentVolumeClaim"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			if tt.expectedStorageClass == nil {
+				g.Expect(pvc).ShouldNot(HaveKey("storageClassName"))
+			} else {
+				g.Expect(pvc).Should(HaveKeyWithValue("storageClassName", tt.expectedStorageClass))
+			}
+		})
+	}
+}
+
+func TestDeployDefaultStack(t *testing.T) {
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "test-app-namespace"},
+	}
+
+	tests := []struct {
+		name               string
+		deployDefaultStack *bool
+		expectedReason     string
+		expectedExporters  []string
+	}{
+		{
+			name:              "default stack deployed when unset",
+			expectedExporters: []string{"prometheus", "otlphttp/external"},
+		},
+		{
+			name:               "default stack disabled",
+			deployDefaultStack: ptr.To(false),
+			expectedReason:     status.MetricsDefaultStackDisabledReason,
+			expectedExporters:  []string{"otlphttp/external"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			monitoring := &serviceApi.Monitoring{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics: &serviceApi.Metrics{
+							Storage:            &serviceApi.MetricsStorage{Size: resource.MustParse("5Gi"), Retention: "90d"},
+							DeployDefaultStack: tt.deployDefaultStack,
+							Exporters: map[string]runtime.RawExtension{
+								"otlphttp/external": stringToRawExtension(`{"endpoint": "https://otlp.example.com"}`),
+							},
+						},
+					},
+				},
+			}
+			rr := &odhtypes.ReconciliationRequest{
+				Client:     setupTestClient(g, dsci, monitoring),
+				Instance:   monitoring,
+				Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+			}
+
+			g.Expect(deployMonitoringStackWithQuerier(t.Context(), rr)).Should(Succeed())
+			if tt.expectedReason != "" {
+				g.Expect(rr.Templates).Should(BeEmpty())
+				for _, c := range []string{status.ConditionMonitoringStackAvailable, status.ConditionThanosQuerierAvailable} {
+					condition := rr.Conditions.GetCondition(c)
+					g.Expect(condition).ShouldNot(BeNil())
+					g.Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+					g.Expect(condition.Reason).Should(Equal(tt.expectedReason))
+				}
+			}
+
+			templateData, err := getTemplateData(t.Context(), rr)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			docs := renderTemplate(g, OpenTelemetryCollectorTemplate, templateData)
+			g.Expect(docs).Should(HaveLen(1))
+
+			config, ok := docs[0]["spec"].(map[string]any)["config"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			exporters, ok := config["exporters"].(map[string]any)
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(exporters).Should(HaveLen(len(tt.expectedExporters)))
+			for _, exporter := range tt.expectedExporters {
+				g.Expect(exporters).Should(HaveKey(exporter))
+			}
+			g.Expect(config["service"]).Should(HaveKeyWithValue("pipelines",
+				HaveKeyWithValue("metrics", HaveKeyWithValue("exporters", HaveExactElements(tt.expectedExporters)))))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+//nolint:testpackage
+package operatorconfig
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScopedClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	// created by the Kueue operator, so not labeled as part of the platform
+	crb := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "kueue-batch-user-rolebinding"}}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "user-service", Namespace: "user"}}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "user"}}
+
+	// the cache of the scoped kinds only holds the resources of the platform
+	cached, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	live, err := fakeclient.New(fakeclient.WithObjects(crb, svc, cm))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cli := &scopedClient{Client: cached, live: live}
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(crb), &rbacv1.ClusterRoleBinding{})).Should(Succeed())
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(svc), &corev1.Service{})).Should(Succeed())
+
+	// the deploy action looks up the existing resources as unstructured
+	u := resources.GvkToUnstructured(gvk.ClusterRoleBinding)
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(crb), u)).Should(Succeed())
+	g.Expect(u.GetName()).Should(Equal(crb.Name))
+
+	// the kinds cached as a whole are only read from the cache
+	err = cli.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})
+	g.Expect(k8serr.IsNotFound(err)).Should(BeTrue())
+
+	// missing resources are still reported as such
+	err = cli.Get(ctx, client.ObjectKey{Name: "missing"}, &rbacv1.ClusterRoleBinding{})
+	g.Expect(k8serr.IsNotFound(err)).Should(BeTrue())
+}
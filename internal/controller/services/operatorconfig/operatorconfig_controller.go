@@ -0,0 +1,102 @@
+package operatorconfig
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+)
+
+// OperatorConfigReconciler applies the logging configuration of the OperatorConfig at runtime and
+// restarts the operator when its manager configuration changes.
+type OperatorConfigReconciler struct {
+	client.Client
+}
+
+func (r *OperatorConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("OperatorConfig")
+
+	oc := serviceApi.OperatorConfig{}
+
+	err := r.Client.Get(ctx, req.NamespacedName, &oc)
+	switch {
+	case k8serr.IsNotFound(err):
+		// the flags of the operator apply again
+		if err := ApplyLogging(serviceApi.OperatorLoggingSpec{}); err != nil {
+			return ctrl.Result{}, err
+		}
+		if requiresRestart(serviceApi.OperatorManagerSpec{}) {
+			log.Info("OperatorConfig deleted, restarting the operator to apply the manager configuration")
+			requestRestart()
+		}
+		return ctrl.Result{}, nil
+	case err != nil:
+		return ctrl.Result{}, err
+	}
+
+	ready := common.Condition{
+		Type:   status.ConditionTypeReady,
+		Status: metav1.ConditionTrue,
+		Reason: status.ReadyReason,
+	}
+
+	if err := ApplyLogging(oc.Spec.Logging); err != nil {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.InvalidConfigReason
+		ready.Message = err.Error()
+	}
+
+	_, err = groupKindConcurrency(oc.Spec.Manager.ControllerConcurrency, r.Client.Scheme())
+	if err != nil {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.InvalidConfigReason
+		ready.Message = err.Error()
+	}
+
+	restart := err == nil && requiresRestart(oc.Spec.Manager)
+	if restart && ready.Status == metav1.ConditionTrue {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.RestartRequiredReason
+		ready.Message = "The operator is restarting to apply the manager configuration"
+	}
+
+	oc.Status.ObservedGeneration = oc.Generation
+	oc.Status.Phase = status.PhaseReady
+	if ready.Status != metav1.ConditionTrue {
+		oc.Status.Phase = status.PhaseNotReady
+	}
+
+	conditions.SetStatusCondition(&oc, ready)
+
+	if err := r.Client.Status().Update(ctx, &oc); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update OperatorConfig status: %w", err)
+	}
+
+	if restart {
+		log.Info("manager configuration changed, restarting the operator")
+		requestRestart()
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *OperatorConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		For(&serviceApi.OperatorConfig{}, builder.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(serviceApi.OperatorConfigInstanceName))).
+		Complete(r)
+}
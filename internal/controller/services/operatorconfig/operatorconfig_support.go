@@ -0,0 +1,335 @@
+package operatorconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// platformScoped are the cluster-wide kinds deployed by the operator, cached with the Platform
+// cache scope only when labeled as part of the platform. The ClusterRoles are cached as a whole,
+// the Kueue component watching the ClusterRole created by the Kueue operator.
+var platformScoped = []client.Object{
+	&rbacv1.ClusterRoleBinding{},
+}
+
+// namespaceScoped are the namespaced kinds deployed by the operator, cached with the Platform
+// cache scope only in the namespaces of the platform.
+var namespaceScoped = []client.Object{
+	&corev1.ServiceAccount{},
+	&corev1.Service{},
+}
+
+var (
+	mu sync.Mutex
+	// startupSpec is the manager configuration the operator has been started with.
+	startupSpec serviceApi.OperatorManagerSpec
+	restart     func()
+	restarted   bool
+)
+
+// Load reads the OperatorConfig the operator is started with, applies its logging configuration
+// and records its manager configuration, so a change of it restarts the operator. A missing
+// OperatorConfig, or OperatorConfig CRD, results in an empty configuration.
+func Load(ctx context.Context, cli client.Reader) (*serviceApi.OperatorConfig, error) {
+	oc := serviceApi.OperatorConfig{}
+
+	err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.OperatorConfigInstanceName}, &oc)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		oc = serviceApi.OperatorConfig{}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get OperatorConfig %s: %w", serviceApi.OperatorConfigInstanceName, err)
+	}
+
+	if err := ApplyLogging(oc.Spec.Logging); err != nil {
+		return nil, err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	startupSpec = *oc.Spec.Manager.DeepCopy()
+
+	return &oc, nil
+}
+
+// OnRestart registers the function called to restart the operator when the manager configuration
+// differs from the one the operator has been started with, i.e. the cancellation of the manager
+// context.
+func OnRestart(fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	restart = fn
+}
+
+// RestartRequested reports whether the operator has been stopped to apply a new manager configuration.
+func RestartRequested() bool {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return restarted
+}
+
+// requiresRestart reports whether the given manager configuration differs from the one the
+// operator has been started with.
+func requiresRestart(spec serviceApi.OperatorManagerSpec) bool {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return !equality.Semantic.DeepEqual(startupSpec, spec)
+}
+
+func requestRestart() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if restart == nil || restarted {
+		return
+	}
+
+	restarted = true
+	restart()
+}
+
+// ApplyLogging applies the logging configuration to the operator logger, an empty level or
+// encoding restores the one set by the flags of the operator.
+func ApplyLogging(spec serviceApi.OperatorLoggingSpec) error {
+	if err := logger.SetLevelOverride(spec.Level); err != nil {
+		return fmt.Errorf("failed to set log level: %w", err)
+	}
+
+	if err := logger.SetEncoding(string(spec.Encoding)); err != nil {
+		return fmt.Errorf("failed to set log encoding: %w", err)
+	}
+
+	if err := logger.SetControllerLevels(spec.Controllers); err != nil {
+		return fmt.Errorf("failed to set controllers log level: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyManagerOptions sets the manager configuration into the options of the controller manager,
+// the fields of the configuration which are not set keep the value of the options. The given
+// namespaces are the ones of the platform, the namespaced kinds are cached in with the Platform
+// cache scope.
+func ApplyManagerOptions(spec serviceApi.OperatorManagerSpec, s *runtime.Scheme, namespaces map[string]cache.Config, opts *ctrl.Options) error {
+	if spec.LeaderElection != nil {
+		opts.LeaderElection = *spec.LeaderElection
+	}
+
+	if spec.SyncPeriod != nil {
+		opts.Cache.SyncPeriod = &spec.SyncPeriod.Duration
+	}
+
+	if spec.MaxConcurrentReconciles != nil {
+		opts.Controller.MaxConcurrentReconciles = *spec.MaxConcurrentReconciles
+	}
+
+	if spec.CacheScope == serviceApi.CacheScopePlatform {
+		if err := scopeCache(&opts.Cache, namespaces); err != nil {
+			return err
+		}
+
+		opts.NewClient = newScopedClient(opts.NewClient)
+	}
+
+	concurrency, err := groupKindConcurrency(spec.ControllerConcurrency, s)
+	if len(concurrency) != 0 {
+		opts.Controller.GroupKindConcurrency = concurrency
+	}
+
+	return err
+}
+
+// ApplyBackoff sets the retry policy of the controllers, the fields of the configuration which
+// are not set keep their default value.
+func ApplyBackoff(spec *serviceApi.OperatorBackoffSpec) {
+	b := reconciler.Backoff{}
+
+	if spec != nil {
+		if spec.BaseDelay != nil {
+			b.BaseDelay = spec.BaseDelay.Duration
+		}
+		if spec.MaxDelay != nil {
+			b.MaxDelay = spec.MaxDelay.Duration
+		}
+		b.MaxRetries = ptr.Deref(spec.MaxRetries, 0)
+		b.QPS = ptr.Deref(spec.QPS, 0)
+		b.Burst = ptr.Deref(spec.Burst, 0)
+	}
+
+	reconciler.SetBackoff(b)
+}
+
+// scopeCache restricts the cache of the platformScoped kinds to the resources labeled as part of
+// the platform, and the cache of the namespaceScoped kinds to the given namespaces, keeping the
+// other settings of their cache.
+func scopeCache(opts *cache.Options, namespaces map[string]cache.Config) error {
+	partOf, err := k8slabels.NewRequirement(labels.PlatformPartOf, selection.Exists, nil)
+	if err != nil {
+		return err
+	}
+
+	selector := k8slabels.NewSelector().Add(*partOf)
+
+	if opts.ByObject == nil {
+		opts.ByObject = make(map[client.Object]cache.ByObject, len(platformScoped)+len(namespaceScoped))
+	}
+
+	for _, obj := range platformScoped {
+		key := byObjectKey(opts, obj)
+
+		byObject := opts.ByObject[key]
+		byObject.Label = selector
+		opts.ByObject[key] = byObject
+	}
+
+	for _, obj := range namespaceScoped {
+		key := byObjectKey(opts, obj)
+
+		byObject := opts.ByObject[key]
+		byObject.Namespaces = maps.Clone(namespaces)
+		opts.ByObject[key] = byObject
+	}
+
+	return nil
+}
+
+// byObjectKey returns the key of the cache settings of the kind of the given object, the settings
+// already set for the kind being keyed by an object of their own.
+func byObjectKey(opts *cache.Options, obj client.Object) client.Object {
+	for k := range opts.ByObject {
+		if reflect.TypeOf(k) == reflect.TypeOf(obj) {
+			return k
+		}
+	}
+
+	return obj
+}
+
+// newScopedClient returns a function creating the clients of the manager with the given function,
+// client.New by default, whose reads of the resources of the scoped kinds missing from the cache,
+// e.g. the ones created by other operators, are made against the API server.
+func newScopedClient(newClient client.NewClientFunc) client.NewClientFunc {
+	if newClient == nil {
+		newClient = client.New
+	}
+
+	return func(config *rest.Config, options client.Options) (client.Client, error) {
+		cached, err := newClient(config, options)
+		if err != nil {
+			return nil, err
+		}
+
+		options.Cache = nil
+
+		live, err := client.New(config, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &scopedClient{Client: cached, live: live}, nil
+	}
+}
+
+// scopedClient reads the resources of the scoped kinds which are not in the cache from the API
+// server, the cache only holding the ones of the platform.
+type scopedClient struct {
+	client.Client
+
+	live client.Reader
+}
+
+func (c *scopedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	err := c.Client.Get(ctx, key, obj, opts...)
+	if !k8serr.IsNotFound(err) || !c.scoped(obj) {
+		return err
+	}
+
+	return c.live.Get(ctx, key, obj, opts...)
+}
+
+// scoped returns true if the cache of the kind of the given object is scoped.
+func (c *scopedClient) scoped(obj client.Object) bool {
+	k, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return false
+	}
+
+	for _, o := range slices.Concat(platformScoped, namespaceScoped) {
+		if sk, err := c.GroupVersionKindFor(o); err == nil && sk.GroupKind() == k.GroupKind() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// groupKindConcurrency maps the concurrency keyed by lower case kind to the concurrency keyed by
+// group kind expected by the controller manager. The kinds are looked up in the opendatahub.io
+// groups, unknown kinds are reported in the returned error.
+func groupKindConcurrency(concurrency map[string]int, s *runtime.Scheme) (map[string]int, error) {
+	if len(concurrency) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]int, len(concurrency))
+	found := make(map[string]bool, len(concurrency))
+
+	for k := range s.AllKnownTypes() {
+		if !strings.HasSuffix(k.Group, "opendatahub.io") {
+			continue
+		}
+
+		key := strings.ToLower(k.Kind)
+
+		value, ok := concurrency[key]
+		if !ok {
+			continue
+		}
+
+		result[k.GroupKind().String()] = value
+		found[key] = true
+	}
+
+	var errs []error
+
+	for key := range concurrency {
+		if !found[key] {
+			errs = append(errs, fmt.Errorf("unknown controller %q", key))
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return result, errors.Join(errs...)
+}
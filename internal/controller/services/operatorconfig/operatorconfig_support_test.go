@@ -0,0 +1,219 @@
+package operatorconfig_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/operatorconfig"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApplyManagerOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	spec := serviceApi.OperatorManagerSpec{
+		LeaderElection:          ptr.To(true),
+		SyncPeriod:              &metav1.Duration{Duration: 5 * time.Minute},
+		MaxConcurrentReconciles: ptr.To(2),
+		ControllerConcurrency: map[string]int{
+			"dashboard":          3,
+			"datasciencecluster": 4,
+			"unknown":            1,
+		},
+	}
+
+	opts := ctrl.Options{}
+
+	err = operatorconfig.ApplyManagerOptions(spec, cli.Scheme(), nil, &opts)
+	g.Expect(err).Should(MatchError(ContainSubstring(`unknown controller "unknown"`)))
+
+	g.Expect(opts.LeaderElection).Should(BeTrue())
+	g.Expect(opts.Cache.SyncPeriod).Should(HaveValue(Equal(5 * time.Minute)))
+	g.Expect(opts.Controller.MaxConcurrentReconciles).Should(Equal(2))
+	g.Expect(opts.Controller.GroupKindConcurrency).Should(Equal(map[string]int{
+		"Dashboard.components.platform.opendatahub.io":         3,
+		"DataScienceCluster.datasciencecluster.opendatahub.io": 4,
+	}))
+}
+
+func TestApplyManagerOptionsUnset(t *testing.T) {
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	opts := ctrl.Options{LeaderElection: true}
+
+	g.Expect(operatorconfig.ApplyManagerOptions(serviceApi.OperatorManagerSpec{}, cli.Scheme(), nil, &opts)).Should(Succeed())
+	g.Expect(opts.LeaderElection).Should(BeTrue())
+	g.Expect(opts.Cache.SyncPeriod).Should(BeNil())
+	g.Expect(opts.Controller.GroupKindConcurrency).Should(BeNil())
+}
+
+func TestApplyManagerOptionsCacheScope(t *testing.T) {
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	namespaces := map[string]cache.Config{"opendatahub": {}, "opendatahub-operator-system": {}}
+	service := &corev1.Service{}
+
+	opts := ctrl.Options{
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				service:          {Transform: func(in any) (any, error) { return in, nil }},
+				&corev1.Secret{}: {Namespaces: map[string]cache.Config{"opendatahub": {}}},
+			},
+		},
+	}
+
+	spec := serviceApi.OperatorManagerSpec{CacheScope: serviceApi.CacheScopePlatform}
+	g.Expect(operatorconfig.ApplyManagerOptions(spec, cli.Scheme(), namespaces, &opts)).Should(Succeed())
+
+	g.Expect(opts.Cache.ByObject).Should(HaveLen(4))
+	g.Expect(opts.NewClient).ShouldNot(BeNil())
+
+	partOf := k8slabels.Set{labels.PlatformPartOf: "dashboard"}
+
+	for obj, byObject := range opts.Cache.ByObject {
+		switch obj.(type) {
+		case *corev1.Secret:
+			g.Expect(byObject.Label).Should(BeNil())
+			g.Expect(byObject.Namespaces).Should(HaveLen(1))
+		case *corev1.Service, *corev1.ServiceAccount:
+			// the namespaced kinds are cached in the namespaces of the platform
+			g.Expect(byObject.Label).Should(BeNil(), "%T", obj)
+			g.Expect(byObject.Namespaces).Should(Equal(namespaces), "%T", obj)
+		case *rbacv1.ClusterRoleBinding:
+			g.Expect(byObject.Label).ShouldNot(BeNil())
+			g.Expect(byObject.Label.Matches(partOf)).Should(BeTrue())
+			g.Expect(byObject.Label.Matches(k8slabels.Set{})).Should(BeFalse())
+		default:
+			// the ClusterRoles are cached as a whole, the one created by the Kueue operator is watched
+			t.Errorf("unexpected cache settings for %T", obj)
+		}
+	}
+
+	// the settings already set for a kind are kept
+	g.Expect(opts.Cache.ByObject[service].Transform).ShouldNot(BeNil())
+
+	// the Cluster scope caches all the resources
+	opts = ctrl.Options{}
+
+	spec.CacheScope = serviceApi.CacheScopeCluster
+	g.Expect(operatorconfig.ApplyManagerOptions(spec, cli.Scheme(), namespaces, &opts)).Should(Succeed())
+	g.Expect(opts.Cache.ByObject).Should(BeEmpty())
+	g.Expect(opts.NewClient).Should(BeNil())
+}
+
+func TestApplyBackoff(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Cleanup(func() {
+		operatorconfig.ApplyBackoff(nil)
+	})
+
+	operatorconfig.ApplyBackoff(&serviceApi.OperatorBackoffSpec{
+		BaseDelay:  &metav1.Duration{Duration: time.Second},
+		MaxDelay:   &metav1.Duration{Duration: 5 * time.Minute},
+		MaxRetries: ptr.To(10),
+	})
+
+	g.Expect(reconciler.GetBackoff()).Should(Equal(reconciler.Backoff{
+		BaseDelay:  time.Second,
+		MaxDelay:   5 * time.Minute,
+		MaxRetries: 10,
+		QPS:        reconciler.DefaultBackoff.QPS,
+		Burst:      reconciler.DefaultBackoff.Burst,
+	}))
+
+	// an unset configuration restores the default retry policy
+	operatorconfig.ApplyBackoff(nil)
+	g.Expect(reconciler.GetBackoff()).Should(Equal(reconciler.DefaultBackoff))
+}
+
+func TestReconcileRestart(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	oc := &serviceApi.OperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.OperatorConfigInstanceName,
+		},
+		Spec: serviceApi.OperatorConfigSpec{
+			Manager: serviceApi.OperatorManagerSpec{
+				MaxConcurrentReconciles: ptr.To(1),
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(oc),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not know the status subresource of the OperatorConfig
+			SubResourceUpdate: func(ctx context.Context, cli client.Client, _ string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				return cli.Update(ctx, obj)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	_, err = operatorconfig.Load(ctx, cli)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	restarts := 0
+	operatorconfig.OnRestart(func() { restarts++ })
+
+	rec := operatorconfig.OperatorConfigReconciler{Client: cli}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(oc)}
+
+	_, err = rec.Reconcile(ctx, req)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(restarts).Should(Equal(0))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(oc), oc)).Should(Succeed())
+	g.Expect(oc.Status.Phase).Should(Equal(status.PhaseReady))
+	g.Expect(oc.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionTypeReady),
+		HaveField("Status", metav1.ConditionTrue),
+	)))
+
+	oc.Spec.Manager.MaxConcurrentReconciles = ptr.To(2)
+	g.Expect(cli.Update(ctx, oc)).Should(Succeed())
+
+	for range 2 {
+		_, err = rec.Reconcile(ctx, req)
+		g.Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	g.Expect(restarts).Should(Equal(1))
+	g.Expect(operatorconfig.RestartRequested()).Should(BeTrue())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(oc), oc)).Should(Succeed())
+	g.Expect(oc.Status.Phase).Should(Equal(status.PhaseNotReady))
+	g.Expect(oc.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionTypeReady),
+		HaveField("Reason", status.RestartRequiredReason),
+	)))
+}
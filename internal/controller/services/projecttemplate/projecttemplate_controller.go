@@ -0,0 +1,218 @@
+// Package projecttemplate adds the resources of the DSCInitialization project template to the data science projects
+package projecttemplate
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// ProjectTemplateReconciler holds the controller configuration.
+type ProjectTemplateReconciler struct {
+	client client.Client
+	reader client.Reader
+}
+
+// NewWithManager sets up the controller with the Manager.
+func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
+	r := ProjectTemplateReconciler{
+		client: mgr.GetClient(),
+		// The resources of the projects live in user namespaces, which are not
+		// cached by the manager's shared cache.
+		reader: mgr.GetAPIReader(),
+	}
+
+	// Only the resources added to the projects, discriminated by label, are cached
+	// to revert their external modifications.
+	selector := labels.Set{PartOfLabel: ServiceName}.AsSelector()
+
+	targetCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:                  mgr.GetHTTPClient(),
+		Scheme:                      mgr.GetScheme(),
+		Mapper:                      mgr.GetRESTMapper(),
+		ReaderFailOnMissingInformer: true,
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ResourceQuota{}:       {Label: selector},
+			&networkingv1.NetworkPolicy{}: {Label: selector},
+			&rbacv1.RoleBinding{}:         {Label: selector},
+		},
+		DefaultTransform: func(in any) (any, error) {
+			if obj, err := meta.Accessor(in); err == nil && obj.GetManagedFields() != nil {
+				obj.SetManagedFields(nil)
+			}
+
+			return in, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create cache: %w", err)
+	}
+
+	if err := mgr.Add(targetCache); err != nil {
+		return fmt.Errorf("unable to register target cache to manager: %w", err)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		Named("project-template-controller")
+
+	//
+	// Namespace
+	//
+	b = b.WatchesRawSource(
+		// Labeling or unlabeling a namespace as a data science project adds or removes
+		// the resources of the template.
+		source.TypedKind[client.Object, ctrl.Request](
+			mgr.GetCache(),
+			&corev1.Namespace{},
+			handlers.RequestFromObject(),
+			predicate.LabelChangedPredicate{},
+		),
+	)
+
+	//
+	// Project resources
+	//
+	for _, k := range []schema.GroupVersionKind{gvk.ResourceQuota, gvk.NetworkPolicy, gvk.RoleBinding} {
+		b = b.WatchesRawSource(
+			// Leveraging PartialObjectMetadata minimizes API server load, as only
+			// the namespace of the resource is needed.
+			source.TypedKind[client.Object, ctrl.Request](
+				targetCache,
+				resources.GvkToPartial(k),
+				handlers.Fn(func(_ context.Context, obj client.Object) []reconcile.Request {
+					return []reconcile.Request{{
+						NamespacedName: types.NamespacedName{
+							Name: obj.GetNamespace(),
+						},
+					}}
+				}),
+			),
+		)
+	}
+
+	//
+	// DSCInitialization
+	//
+	b = b.WatchesRawSource(
+		source.TypedKind[client.Object, ctrl.Request](
+			mgr.GetCache(),
+			&dsciv2.DSCInitialization{},
+			dsciEventHandler(r.reader),
+			dsciPredicates(),
+		),
+	)
+
+	return b.Complete(
+		reconcile.AsReconciler[*corev1.Namespace](r.client, &r),
+	)
+}
+
+// Reconcile adds the resources of the project template to the data science projects, keeps them in
+// sync with the template and removes them from the namespaces no longer labeled as projects.
+func (r *ProjectTemplateReconciler) Reconcile(ctx context.Context, ns *corev1.Namespace) (ctrl.Result, error) {
+	l := logf.FromContext(ctx)
+
+	if !cluster.IsActiveNamespace(ns) || cluster.IsReservedNamespace(ns) {
+		l.V(3).Info("Namespace not active or reserved, skip")
+		return ctrl.Result{}, nil
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, r.client)
+	switch {
+	case k8serr.IsNotFound(err):
+		return ctrl.Result{}, nil
+	case err != nil:
+		return ctrl.Result{}, fmt.Errorf("failed to retrieve DSCInitialization: %w", err)
+	}
+
+	selected := IsDataScienceProject(ns) && dsci.Spec.ProjectTemplate != nil
+	if !selected && !isTemplated(ns) {
+		return ctrl.Result{}, nil
+	}
+
+	// the LocalQueue is only added when Kueue is installed
+	hasLocalQueue, err := cluster.HasCRD(ctx, r.client, gvk.LocalQueue)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check the %s CRD: %w", gvk.LocalQueue.Kind, err)
+	}
+
+	desired := make([]client.Object, 0)
+	if selected {
+		desired = projectResources(ns.Name, dsci.Spec.ProjectTemplate, hasLocalQueue)
+	}
+
+	if err := r.prune(ctx, ns.Name, desired, hasLocalQueue); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	for _, obj := range desired {
+		if err := resources.Apply(ctx, r.client, obj, client.FieldOwner(FieldOwner), client.ForceOwnership); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The labels are applied last, so a namespace is marked as templated only once
+	// its resources are added. Applying the namespace without labels removes the
+	// ones previously set by the template.
+	err = resources.Apply(ctx, r.client, namespaceLabels(ns.Name, dsci.Spec.ProjectTemplate, selected),
+		client.FieldOwner(FieldOwner),
+		client.ForceOwnership,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// prune deletes the resources previously added to the namespace and no longer part of the template.
+func (r *ProjectTemplateReconciler) prune(ctx context.Context, namespace string, desired []client.Object, hasLocalQueue bool) error {
+	kinds := []schema.GroupVersionKind{gvk.ResourceQuota, gvk.NetworkPolicy, gvk.RoleBinding}
+	if hasLocalQueue {
+		kinds = append(kinds, gvk.LocalQueue)
+	}
+
+	for _, k := range kinds {
+		items, err := listTemplated(ctx, r.reader, k, namespace)
+		if err != nil {
+			return err
+		}
+
+		for i := range items {
+			if isDesired(desired, k, items[i].GetName()) {
+				continue
+			}
+
+			if err := r.client.Delete(ctx, &items[i]); err != nil && !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s/%s: %w", k.Kind, namespace, items[i].GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
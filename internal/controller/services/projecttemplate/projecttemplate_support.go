@@ -0,0 +1,203 @@
+package projecttemplate
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// PartOfLabel marks the namespaces templated by the controller and the resources it added to them.
+	PartOfLabel = labels.PlatformPartOf
+	FieldOwner  = resources.PlatformFieldOwner + "/" + ServiceName
+	QuotaName   = "data-science-project-quota"
+)
+
+// IsDataScienceProject returns true if the namespace is labeled as a data science project.
+func IsDataScienceProject(ns client.Object) bool {
+	return ns.GetLabels()[labels.DataScienceProject] == labels.True
+}
+
+// isTemplated returns true if the resources of the template were added to the namespace.
+func isTemplated(ns client.Object) bool {
+	return ns.GetLabels()[PartOfLabel] == ServiceName
+}
+
+// projectResources returns the resources of the template added to the given namespace.
+func projectResources(namespace string, tpl *common.DataScienceProjectTemplate, withLocalQueue bool) []client.Object {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{PartOfLabel: ServiceName},
+		}
+	}
+
+	result := make([]client.Object, 0)
+
+	if len(tpl.Quota) != 0 {
+		result = append(result, &corev1.ResourceQuota{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gvk.ResourceQuota.GroupVersion().String(), Kind: gvk.ResourceQuota.Kind},
+			ObjectMeta: meta(QuotaName),
+			Spec:       corev1.ResourceQuotaSpec{Hard: tpl.Quota.DeepCopy()},
+		})
+	}
+
+	for _, np := range tpl.NetworkPolicies {
+		result = append(result, &networkingv1.NetworkPolicy{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gvk.NetworkPolicy.GroupVersion().String(), Kind: gvk.NetworkPolicy.Kind},
+			ObjectMeta: meta(np.Name),
+			Spec:       *np.Spec.DeepCopy(),
+		})
+	}
+
+	for _, rb := range tpl.RoleBindings {
+		result = append(result, &rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gvk.RoleBinding.GroupVersion().String(), Kind: gvk.RoleBinding.Kind},
+			ObjectMeta: meta(rb.Name),
+			RoleRef:    rb.RoleRef,
+			Subjects:   append([]rbacv1.Subject(nil), rb.Subjects...),
+		})
+	}
+
+	if tpl.LocalQueue != nil && withLocalQueue {
+		lq := resources.GvkToUnstructured(gvk.LocalQueue)
+		lq.SetName(tpl.LocalQueue.Name)
+		lq.SetNamespace(namespace)
+		lq.SetLabels(map[string]string{PartOfLabel: ServiceName})
+		lq.Object["spec"] = map[string]any{
+			"clusterQueue": tpl.LocalQueue.ClusterQueue,
+		}
+
+		result = append(result, lq)
+	}
+
+	return result
+}
+
+// namespaceLabels returns the namespace to apply for the labels of the template, holding no label
+// when the namespace is not selected, so the ones previously applied are removed.
+func namespaceLabels(name string, tpl *common.DataScienceProjectTemplate, selected bool) *corev1.Namespace {
+	ns := corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gvk.Namespace.GroupVersion().String(), Kind: gvk.Namespace.Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+
+	if selected {
+		ns.Labels = maps.Clone(tpl.Labels)
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+
+		ns.Labels[PartOfLabel] = ServiceName
+	}
+
+	return &ns
+}
+
+// listTemplated lists the resources of the given kind added by the controller to the namespace.
+func listTemplated(ctx context.Context, cli client.Reader, k schema.GroupVersionKind, namespace string) ([]metav1.PartialObjectMetadata, error) {
+	items := metav1.PartialObjectMetadataList{}
+	items.SetGroupVersionKind(k.GroupVersion().WithKind(k.Kind + "List"))
+
+	err := cli.List(ctx, &items,
+		client.InNamespace(namespace),
+		client.MatchingLabels{PartOfLabel: ServiceName},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s in namespace %s: %w", k.Kind, namespace, err)
+	}
+
+	for i := range items.Items {
+		items.Items[i].SetGroupVersionKind(k)
+	}
+
+	return items.Items, nil
+}
+
+func isDesired(desired []client.Object, k schema.GroupVersionKind, name string) bool {
+	for _, obj := range desired {
+		if obj.GetObjectKind().GroupVersionKind() == k && obj.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dsciEventHandler enqueues the data science projects and the namespaces already templated when the
+// DSCInitialization changes, so the resources of the template are updated or removed.
+func dsciEventHandler(cli client.Reader) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+		seen := make(map[string]struct{})
+
+		for _, selector := range []client.MatchingLabels{
+			{labels.DataScienceProject: labels.True},
+			{PartOfLabel: ServiceName},
+		} {
+			namespaces := metav1.PartialObjectMetadataList{}
+			namespaces.SetGroupVersionKind(gvk.Namespace.GroupVersion().WithKind(gvk.Namespace.Kind + "List"))
+
+			if err := cli.List(ctx, &namespaces, selector); err != nil {
+				return []reconcile.Request{}
+			}
+
+			for _, ns := range namespaces.Items {
+				if _, ok := seen[ns.Name]; ok {
+					continue
+				}
+
+				seen[ns.Name] = struct{}{}
+				requests = append(requests, reconcile.Request{
+					NamespacedName: resources.NamespacedNameFromObject(&ns),
+				})
+			}
+		}
+
+		return requests
+	})
+}
+
+// dsciPredicates reconciles the projects when a DSCInitialization is created or its project
+// template changes.
+func dsciPredicates() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			dsciOld, ok := e.ObjectOld.(*dsciv2.DSCInitialization)
+			if !ok {
+				return false
+			}
+			dsciNew, ok := e.ObjectNew.(*dsciv2.DSCInitialization)
+			if !ok {
+				return false
+			}
+
+			return !reflect.DeepEqual(dsciOld.Spec.ProjectTemplate, dsciNew.Spec.ProjectTemplate)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	}
+}
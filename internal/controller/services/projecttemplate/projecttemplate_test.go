@@ -0,0 +1,112 @@
+//nolint:testpackage
+package projecttemplate
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestProjectResources(t *testing.T) {
+	g := NewWithT(t)
+
+	tpl := &common.DataScienceProjectTemplate{
+		Quota: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("8")},
+		NetworkPolicies: []common.ProjectNetworkPolicy{{
+			Name: "allow-same-namespace",
+			Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		}},
+		LocalQueue: &common.ProjectLocalQueue{Name: "default", ClusterQueue: "team-queue"},
+		RoleBindings: []common.ProjectRoleBinding{{
+			Name:     "data-scientists",
+			RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+			Subjects: []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: "Group", Name: "data-scientists"}},
+		}},
+	}
+
+	t.Run("renders the resources of the template in the project", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := projectResources("project", tpl, true)
+		g.Expect(objs).Should(HaveLen(4))
+
+		for _, obj := range objs {
+			g.Expect(obj.GetNamespace()).Should(Equal("project"))
+			g.Expect(obj.GetLabels()).Should(HaveKeyWithValue(PartOfLabel, ServiceName))
+		}
+
+		g.Expect(isDesired(objs, gvk.ResourceQuota, QuotaName)).Should(BeTrue())
+		g.Expect(isDesired(objs, gvk.NetworkPolicy, "allow-same-namespace")).Should(BeTrue())
+		g.Expect(isDesired(objs, gvk.RoleBinding, "data-scientists")).Should(BeTrue())
+		g.Expect(isDesired(objs, gvk.LocalQueue, "default")).Should(BeTrue())
+	})
+
+	t.Run("skips the LocalQueue without Kueue", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := projectResources("project", tpl, false)
+		g.Expect(objs).Should(HaveLen(3))
+		g.Expect(isDesired(objs, gvk.LocalQueue, "default")).Should(BeFalse())
+	})
+
+	g.Expect(projectResources("project", &common.DataScienceProjectTemplate{}, true)).Should(BeEmpty())
+}
+
+func TestNamespaceLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	tpl := &common.DataScienceProjectTemplate{Labels: map[string]string{"team": "ml"}}
+
+	g.Expect(namespaceLabels("project", tpl, true).Labels).Should(And(
+		HaveKeyWithValue("team", "ml"),
+		HaveKeyWithValue(PartOfLabel, ServiceName),
+	))
+	g.Expect(tpl.Labels).ShouldNot(HaveKey(PartOfLabel))
+	g.Expect(namespaceLabels("project", tpl, false).Labels).Should(BeEmpty())
+	g.Expect(namespaceLabels("project", nil, false).Labels).Should(BeEmpty())
+}
+
+func TestPrune(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	templated := map[string]string{PartOfLabel: ServiceName}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "kept", Namespace: "project", Labels: templated}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "removed", Namespace: "project", Labels: templated}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "project"}},
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: QuotaName, Namespace: "project", Labels: templated}},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	r := ProjectTemplateReconciler{client: cli, reader: cli}
+
+	desired := projectResources("project", &common.DataScienceProjectTemplate{
+		NetworkPolicies: []common.ProjectNetworkPolicy{{Name: "kept"}},
+	}, false)
+
+	g.Expect(r.prune(ctx, "project", desired, false)).Should(Succeed())
+
+	policies := networkingv1.NetworkPolicyList{}
+	g.Expect(cli.List(ctx, &policies, client.InNamespace("project"))).Should(Succeed())
+	g.Expect(policies.Items).Should(ConsistOf(
+		HaveField("Name", "kept"),
+		HaveField("Name", "user"),
+	))
+
+	quotas := corev1.ResourceQuotaList{}
+	g.Expect(cli.List(ctx, &quotas, client.InNamespace("project"))).Should(Succeed())
+	g.Expect(quotas.Items).Should(BeEmpty())
+}
@@ -38,7 +38,8 @@ func (h *serviceHandler) GetManagementState(_ common.Platform, _ *dsciv2.DSCInit
 
 func (h *serviceHandler) NewReconciler(_ context.Context, mgr ctrl.Manager) error {
 	rec := &SetupControllerReconciler{
-		Client: mgr.GetClient(),
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
 	}
 
 	if err := rec.SetupWithManager(mgr); err != nil {
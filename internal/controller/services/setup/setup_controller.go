@@ -5,19 +5,28 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
 
 type SetupControllerReconciler struct {
 	client.Client
+
+	// APIReader reads the restored resources without caching them.
+	APIReader client.Reader
 }
 
 func (r *SetupControllerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -28,7 +37,18 @@ func (r *SetupControllerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
-	if err := upgrade.OperatorUninstall(ctx, r.Client, cluster.GetRelease().Name); err != nil {
+	// The delete configMap requests the removal of the whole platform, which is run by the
+	// Uninstall controller so its progress is reported
+	uninstall := &serviceApi.Uninstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.UninstallInstanceName,
+		},
+		Spec: serviceApi.UninstallSpec{
+			Scope: serviceApi.UninstallScopeAll,
+		},
+	}
+
+	if err := r.Client.Create(ctx, uninstall); err != nil && !k8serr.IsAlreadyExists(err) {
 		return ctrl.Result{}, fmt.Errorf("operator uninstall failed : %w", err)
 	}
 
@@ -41,7 +61,23 @@ func (r *SetupControllerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err != nil {
 		return fmt.Errorf("failed to get operator namespace: %w", err)
 	}
+
+	// Restore precheck: the resources restored while the operator was not running are re-adopted
+	// on start, a failure is only logged so it does not prevent the operator from starting
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if err := ReadoptRestoredResources(ctx, r.APIReader, r.Client); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to re-adopt restored resources")
+		}
+
+		return nil
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to add the restore precheck: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
 		For(&corev1.ConfigMap{}, builder.WithPredicates(r.filterDeleteConfigMap(operatorNs))).
 		Complete(r)
 }
@@ -0,0 +1,123 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// VeleroRestoreNameLabel is set by Velero on the resources it restores.
+	VeleroRestoreNameLabel = "velero.io/restore-name"
+
+	platformGroupSuffix = "opendatahub.io"
+)
+
+// restorableTypes are the kinds of the resources deployed by the operator that are
+// checked for re-adoption once restored.
+var restorableTypes = []schema.GroupVersionKind{
+	gvk.Deployment,
+	gvk.StatefulSet,
+	gvk.PersistentVolumeClaim,
+	gvk.ConfigMap,
+	gvk.Secret,
+	gvk.Service,
+	gvk.ServiceAccount,
+	gvk.Role,
+	gvk.RoleBinding,
+	gvk.ClusterRole,
+	gvk.ClusterRoleBinding,
+	gvk.NetworkPolicy,
+}
+
+// ReadoptRestoredResources prepares the platform resources restored by Velero to be adopted
+// again by the operator, instead of being recreated or conflicting with the resources the
+// operator deploys. The platform resources owning them are recreated by the restore with a
+// new UID, so their owner references are updated with the UID of the current owner, or
+// removed when the owner does not exist anymore, in which case the next reconciliation of
+// the owner sets them again.
+//
+// Once processed, the Velero restore label is removed so the resources are not checked again.
+func ReadoptRestoredResources(ctx context.Context, reader client.Reader, cli client.Client) error {
+	l := logf.FromContext(ctx)
+
+	for _, t := range restorableTypes {
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(t.GroupVersion().WithKind(t.Kind + "List"))
+
+		err := reader.List(ctx, &items, client.HasLabels{VeleroRestoreNameLabel, labels.PlatformPartOf})
+		switch {
+		case meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to list restored resources of type %s: %w", t, err)
+		}
+
+		for i := range items.Items {
+			if err := readopt(ctx, reader, cli, &items.Items[i]); err != nil {
+				return err
+			}
+
+			l.Info("restored resource re-adopted",
+				"gvk", t,
+				"name", items.Items[i].GetName(),
+				"namespace", items.Items[i].GetNamespace(),
+			)
+		}
+	}
+
+	return nil
+}
+
+func readopt(ctx context.Context, reader client.Reader, cli client.Client, obj *unstructured.Unstructured) error {
+	refs := make([]metav1.OwnerReference, 0, len(obj.GetOwnerReferences()))
+
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return err
+		}
+
+		// only the platform resources, which are cluster scoped, are expected
+		// to be recreated with a new UID by the restore
+		if !strings.HasSuffix(gv.Group, platformGroupSuffix) {
+			refs = append(refs, ref)
+			continue
+		}
+
+		owner := resources.GvkToPartial(gv.WithKind(ref.Kind))
+
+		err = reader.Get(ctx, client.ObjectKey{Name: ref.Name}, owner)
+		switch {
+		case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to get owner %s %s of restored resource %s: %w",
+				ref.Kind, ref.Name, resources.FormatObjectReference(obj), err)
+		}
+
+		ref.UID = owner.GetUID()
+		refs = append(refs, ref)
+	}
+
+	obj.SetOwnerReferences(refs)
+	resources.RemoveLabel(obj, VeleroRestoreNameLabel)
+
+	if err := cli.Update(ctx, obj); err != nil {
+		return fmt.Errorf("failed to re-adopt restored resource %s: %w", resources.FormatObjectReference(obj), err)
+	}
+
+	return nil
+}
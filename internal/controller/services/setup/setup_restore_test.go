@@ -0,0 +1,95 @@
+package setup_test
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestReadoptRestoredResources(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	dashboard := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: componentApi.DashboardInstanceName,
+			UID:  types.UID(xid.New().String()),
+		},
+	}
+
+	ownerRef := func(kind string, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: gvk.Dashboard.GroupVersion().String(),
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(xid.New().String()),
+			Controller: ptr.To(true),
+		}
+	}
+
+	restoredLabels := map[string]string{
+		setup.VeleroRestoreNameLabel: "restore",
+		labels.PlatformPartOf:        "dashboard",
+	}
+
+	recreatedOwner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "recreated-owner",
+			Namespace:       ns,
+			Labels:          restoredLabels,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(componentApi.DashboardKind, componentApi.DashboardInstanceName)},
+		},
+	}
+
+	missingOwner := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "missing-owner",
+			Namespace:       ns,
+			Labels:          restoredLabels,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(componentApi.DashboardKind, "missing")},
+		},
+	}
+
+	notRestored := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "not-restored",
+			Namespace:       ns,
+			Labels:          map[string]string{labels.PlatformPartOf: "dashboard"},
+			OwnerReferences: []metav1.OwnerReference{ownerRef(componentApi.DashboardKind, componentApi.DashboardInstanceName)},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(dashboard, recreatedOwner, missingOwner, notRestored))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(setup.ReadoptRestoredResources(ctx, cli, cli)).Should(Succeed())
+
+	cm := corev1.ConfigMap{}
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(recreatedOwner), &cm)).Should(Succeed())
+	g.Expect(cm.Labels).ShouldNot(HaveKey(setup.VeleroRestoreNameLabel))
+	g.Expect(cm.OwnerReferences).Should(HaveExactElements(
+		HaveField("UID", dashboard.UID),
+	))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(missingOwner), &cm)).Should(Succeed())
+	g.Expect(cm.Labels).ShouldNot(HaveKey(setup.VeleroRestoreNameLabel))
+	g.Expect(cm.OwnerReferences).Should(BeEmpty())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(notRestored), &cm)).Should(Succeed())
+	g.Expect(cm.OwnerReferences).Should(Equal(notRestored.OwnerReferences))
+}
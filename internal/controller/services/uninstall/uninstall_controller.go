@@ -0,0 +1,172 @@
+package uninstall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
+)
+
+const (
+	// finalizerName keeps the Uninstall, hence the progress of the uninstall, until it completes.
+	finalizerName = "services.platform.opendatahub.io/uninstall"
+
+	// requeueAfter is the interval the completion of the running step is checked at.
+	requeueAfter = 10 * time.Second
+)
+
+// UninstallReconciler removes the part of the platform defined by the scope of the Uninstall. The
+// steps run in order, each waiting for the resources removed by the previous one to be finalized,
+// and the completed steps are recorded in the status, so an interrupted uninstall resumes where it
+// stopped.
+type UninstallReconciler struct {
+	client.Client
+}
+
+func (r *UninstallReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("Uninstall")
+
+	u := serviceApi.Uninstall{}
+	if err := r.Client.Get(ctx, req.NamespacedName, &u); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	for _, step := range u.Spec.Scope.Steps() {
+		if slices.Contains(u.Status.CompletedSteps, step) {
+			continue
+		}
+
+		if step == serviceApi.UninstallStepOperator {
+			return ctrl.Result{}, r.removeOperator(ctx, &u)
+		}
+
+		if u.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(&u, finalizerName) {
+			if err := r.Client.Update(ctx, &u); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to add finalizer to Uninstall: %w", err)
+			}
+		}
+
+		log.Info("Running uninstall step", "step", step)
+
+		done, err := r.run(ctx, step)
+		switch {
+		case err != nil:
+			return ctrl.Result{}, r.updateStatus(ctx, &u, step, err)
+		case !done:
+			return ctrl.Result{RequeueAfter: requeueAfter}, r.updateStatus(ctx, &u, step, nil)
+		}
+
+		log.Info("Uninstall step completed", "step", step)
+		u.Status.CompletedSteps = append(u.Status.CompletedSteps, step)
+	}
+
+	if err := r.updateStatus(ctx, &u, "", nil); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.removeFinalizer(ctx, &u)
+}
+
+func (r *UninstallReconciler) run(ctx context.Context, step serviceApi.UninstallStep) (bool, error) {
+	switch step {
+	case serviceApi.UninstallStepDataScienceCluster:
+		return upgrade.RemoveDSC(ctx, r.Client)
+	case serviceApi.UninstallStepDSCInitialization:
+		return upgrade.RemoveDSCI(ctx, r.Client)
+	case serviceApi.UninstallStepNamespaces:
+		return upgrade.RemoveGeneratedNamespaces(ctx, r.Client)
+	default:
+		return false, fmt.Errorf("unknown uninstall step %s", step)
+	}
+}
+
+// removeOperator runs the last step, which removes the operator itself. The finalizer is removed
+// beforehand, as the Uninstall could not be deleted without the operator.
+func (r *UninstallReconciler) removeOperator(ctx context.Context, u *serviceApi.Uninstall) error {
+	if err := r.removeFinalizer(ctx, u); err != nil {
+		return err
+	}
+
+	if err := upgrade.RemoveOperator(ctx, r.Client, cluster.GetRelease().Name); err != nil {
+		return r.updateStatus(ctx, u, serviceApi.UninstallStepOperator, err)
+	}
+
+	u.Status.CompletedSteps = append(u.Status.CompletedSteps, serviceApi.UninstallStepOperator)
+
+	return r.updateStatus(ctx, u, "", nil)
+}
+
+// updateStatus reports the step being run, or the completion of the uninstall when step is empty,
+// and the error of the step if any, which is returned so the step is retried.
+func (r *UninstallReconciler) updateStatus(ctx context.Context, u *serviceApi.Uninstall, step serviceApi.UninstallStep, stepErr error) error {
+	ready := common.Condition{
+		Type:    status.ConditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  status.UninstalledReason,
+		Message: fmt.Sprintf("Uninstall of scope %s completed", u.Spec.Scope),
+	}
+	phase := status.PhaseReady
+
+	switch {
+	case stepErr != nil:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.UninstallFailedReason
+		ready.Message = fmt.Sprintf("Uninstall step %s failed: %v", step, stepErr)
+		phase = status.PhaseError
+	case step != "":
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = status.UninstallingReason
+		ready.Message = fmt.Sprintf("Waiting for the uninstall step %s to complete", step)
+		phase = status.PhaseProgressing
+	}
+
+	u.Status.CurrentStep = step
+	u.Status.ObservedGeneration = u.Generation
+	u.Status.Phase = phase
+	conditions.SetStatusCondition(u, ready)
+
+	// the Uninstall is gone once deleted and no longer finalized
+	if err := r.Client.Status().Update(ctx, u); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to update Uninstall status: %w", err)
+	}
+
+	return stepErr
+}
+
+func (r *UninstallReconciler) removeFinalizer(ctx context.Context, u *serviceApi.Uninstall) error {
+	if !controllerutil.RemoveFinalizer(u, finalizerName) {
+		return nil
+	}
+
+	if err := r.Client.Update(ctx, u); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to remove finalizer from Uninstall: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UninstallReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// the failed reconciliations are retried within the budget of the controller
+		WithOptions(controller.Options{RateLimiter: reconciler.NewRateLimiter(reconciler.GetBackoff())}).
+		For(&serviceApi.Uninstall{}, builder.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(serviceApi.UninstallInstanceName))).
+		Complete(r)
+}
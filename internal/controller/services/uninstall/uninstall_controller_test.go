@@ -0,0 +1,146 @@
+package uninstall_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/uninstall"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(objs...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not know the status subresource of the Uninstall
+			SubResourceUpdate: func(ctx context.Context, cli client.Client, _ string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				return cli.Update(ctx, obj)
+			},
+		}),
+	)
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return cli
+}
+
+func TestReconcileComponents(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	u := &serviceApi.Uninstall{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.UninstallInstanceName},
+		Spec:       serviceApi.UninstallSpec{Scope: serviceApi.UninstallScopeComponents},
+	}
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "default-dsc",
+			Finalizers: []string{"datasciencecluster.opendatahub.io/finalizer"},
+		},
+	}
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+	}
+
+	cli := newClient(t, u, dsc, dsci)
+	rec := uninstall.UninstallReconciler{Client: cli}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(u)}
+
+	// the step waits for the DataScienceCluster to be finalized
+	res, err := rec.Reconcile(ctx, req)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).ShouldNot(BeZero())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(u), u)).Should(Succeed())
+	g.Expect(u.Finalizers).ShouldNot(BeEmpty())
+	g.Expect(u.Status.CurrentStep).Should(Equal(serviceApi.UninstallStepDataScienceCluster))
+	g.Expect(u.Status.CompletedSteps).Should(BeEmpty())
+	g.Expect(u.Status.Phase).Should(Equal(status.PhaseProgressing))
+	g.Expect(u.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionTypeReady),
+		HaveField("Reason", status.UninstallingReason),
+	)))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dsc), dsc)).Should(Succeed())
+	dsc.Finalizers = nil
+	g.Expect(cli.Update(ctx, dsc)).Should(Succeed())
+
+	res, err = rec.Reconcile(ctx, req)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).Should(BeZero())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(u), u)).Should(Succeed())
+	g.Expect(u.Finalizers).Should(BeEmpty())
+	g.Expect(u.Status.CurrentStep).Should(BeEmpty())
+	g.Expect(u.Status.CompletedSteps).Should(Equal([]serviceApi.UninstallStep{serviceApi.UninstallStepDataScienceCluster}))
+	g.Expect(u.Status.Phase).Should(Equal(status.PhaseReady))
+
+	// the DSCInitialization is out of the scope
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dsci), dsci)).Should(Succeed())
+}
+
+func TestReconcileAll(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	u := &serviceApi.Uninstall{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.UninstallInstanceName},
+		Spec:       serviceApi.UninstallSpec{Scope: serviceApi.UninstallScopeAll},
+	}
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "opendatahub",
+			Labels: map[string]string{labels.ODH.OwnedNamespace: "true"},
+		},
+	}
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+	}
+
+	cli := newClient(t, u, ns, dsci)
+	rec := uninstall.UninstallReconciler{Client: cli}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(u)}
+
+	// the step waits for the namespaces to be removed
+	res, err := rec.Reconcile(ctx, req)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).ShouldNot(BeZero())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(u), u)).Should(Succeed())
+	g.Expect(u.Status.CurrentStep).Should(Equal(serviceApi.UninstallStepNamespaces))
+
+	// the operator namespace is unknown, so the removal of the operator fails
+	_, err = rec.Reconcile(ctx, req)
+	g.Expect(err).Should(HaveOccurred())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dsci), dsci)).ShouldNot(Succeed())
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(ns), ns)).ShouldNot(Succeed())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(u), u)).Should(Succeed())
+	g.Expect(u.Finalizers).Should(BeEmpty())
+	g.Expect(u.Status.CurrentStep).Should(Equal(serviceApi.UninstallStepOperator))
+	g.Expect(u.Status.CompletedSteps).Should(Equal([]serviceApi.UninstallStep{
+		serviceApi.UninstallStepDataScienceCluster,
+		serviceApi.UninstallStepDSCInitialization,
+		serviceApi.UninstallStepNamespaces,
+	}))
+	g.Expect(u.Status.Phase).Should(Equal(status.PhaseError))
+	g.Expect(u.Status.Conditions).Should(ContainElement(And(
+		HaveField("Type", status.ConditionTypeReady),
+		HaveField("Reason", status.UninstallFailedReason),
+	)))
+}
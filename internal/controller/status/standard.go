@@ -0,0 +1,84 @@
+package status
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	cond "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+)
+
+const (
+	// ConditionTypeReconciled indicates whether the last reconciliation of the resource succeeded.
+	ConditionTypeReconciled = "Reconciled"
+)
+
+// Reasons of the standard conditions.
+const (
+	AsExpectedReason        = "AsExpected"
+	RolloutInProgressReason = "RolloutInProgress"
+)
+
+// SetStandardConditions sets the standard conditions from the happiness computed by the given manager
+// and its ProvisioningSucceeded condition:
+//   - Reconciled reports whether the actions of the last reconciliation succeeded.
+//   - Available mirrors the happy condition, i.e. the resource and all its dependents are ready.
+//   - Progressing is True while the reconciled resources are rolling out.
+//   - Degraded is True when the last reconciliation failed.
+//
+// The conditions have Info severity, so they don't affect the happiness of the resource.
+func SetStandardConditions(m *cond.Manager, generation int64) {
+	opts := func(reason string, message string) []cond.Option {
+		return []cond.Option{
+			cond.WithReason(reason),
+			cond.WithMessage("%s", message),
+			cond.WithObservedGeneration(generation),
+			cond.WithSeverity(common.ConditionSeverityInfo),
+		}
+	}
+
+	// the conditions are copied as the pointers are invalidated when setting the conditions
+	happy := m.GetTopLevelCondition()
+	if happy == nil {
+		return
+	}
+
+	ready := *happy
+
+	available := ready.Status == metav1.ConditionTrue
+
+	if available {
+		m.MarkTrue(ConditionTypeAvailable, opts(AvailableReason, "")...)
+	} else {
+		reason := ready.Reason
+		if reason == "" {
+			reason = NotReadyReason
+		}
+
+		m.MarkFalse(ConditionTypeAvailable, opts(reason, ready.Message)...)
+	}
+
+	if p := m.GetCondition(ConditionTypeProvisioningSucceeded); p != nil && p.Status == metav1.ConditionFalse {
+		message := p.Message
+
+		// an instance past its retry budget is reported as such
+		reason := ReconcileFailed
+		if p.Reason == RetriesExhaustedReason {
+			reason = RetriesExhaustedReason
+		}
+
+		m.MarkFalse(ConditionTypeReconciled, opts(reason, message)...)
+		m.MarkTrue(ConditionTypeDegraded, opts(reason, message)...)
+		m.MarkFalse(ConditionTypeProgressing, opts(reason, message)...)
+
+		return
+	}
+
+	m.MarkTrue(ConditionTypeReconciled, opts(ReconcileCompleted, ReconcileCompletedMessage)...)
+	m.MarkFalse(ConditionTypeDegraded, opts(AsExpectedReason, "")...)
+
+	if available {
+		m.MarkFalse(ConditionTypeProgressing, opts(ReconcileCompleted, ReconcileCompletedMessage)...)
+	} else {
+		m.MarkTrue(ConditionTypeProgressing, opts(RolloutInProgressReason, ready.Message)...)
+	}
+}
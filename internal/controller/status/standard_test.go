@@ -0,0 +1,108 @@
+package status_test
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSetStandardConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		mark       func(m *conditions.Manager)
+		conditions map[string]metav1.ConditionStatus
+		reasons    map[string]string
+	}{
+		{
+			name: "should report a reconciled and available instance",
+			mark: func(m *conditions.Manager) {
+				m.MarkTrue(status.ConditionTypeProvisioningSucceeded)
+				m.MarkTrue(status.ConditionDeploymentsAvailable)
+			},
+			conditions: map[string]metav1.ConditionStatus{
+				status.ConditionTypeAvailable:   metav1.ConditionTrue,
+				status.ConditionTypeProgressing: metav1.ConditionFalse,
+				status.ConditionTypeDegraded:    metav1.ConditionFalse,
+				status.ConditionTypeReconciled:  metav1.ConditionTrue,
+			},
+			reasons: map[string]string{
+				status.ConditionTypeAvailable:   status.AvailableReason,
+				status.ConditionTypeProgressing: status.ReconcileCompleted,
+				status.ConditionTypeDegraded:    status.AsExpectedReason,
+				status.ConditionTypeReconciled:  status.ReconcileCompleted,
+			},
+		},
+		{
+			name: "should report a progressing instance while the deployments roll out",
+			mark: func(m *conditions.Manager) {
+				m.MarkTrue(status.ConditionTypeProvisioningSucceeded)
+				m.MarkFalse(status.ConditionDeploymentsAvailable, conditions.WithReason(status.ConditionDeploymentsNotAvailableReason))
+			},
+			conditions: map[string]metav1.ConditionStatus{
+				status.ConditionTypeAvailable:   metav1.ConditionFalse,
+				status.ConditionTypeProgressing: metav1.ConditionTrue,
+				status.ConditionTypeDegraded:    metav1.ConditionFalse,
+				status.ConditionTypeReconciled:  metav1.ConditionTrue,
+			},
+			reasons: map[string]string{
+				status.ConditionTypeAvailable:   status.ConditionDeploymentsNotAvailableReason,
+				status.ConditionTypeProgressing: status.RolloutInProgressReason,
+			},
+		},
+		{
+			name: "should report a degraded instance when the reconciliation fails",
+			mark: func(m *conditions.Manager) {
+				m.MarkFalse(status.ConditionTypeProvisioningSucceeded, conditions.WithError(errors.New("failure")))
+				m.MarkTrue(status.ConditionDeploymentsAvailable)
+			},
+			conditions: map[string]metav1.ConditionStatus{
+				status.ConditionTypeAvailable:   metav1.ConditionFalse,
+				status.ConditionTypeProgressing: metav1.ConditionFalse,
+				status.ConditionTypeDegraded:    metav1.ConditionTrue,
+				status.ConditionTypeReconciled:  metav1.ConditionFalse,
+			},
+			reasons: map[string]string{
+				status.ConditionTypeDegraded:   status.ReconcileFailed,
+				status.ConditionTypeReconciled: status.ReconcileFailed,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			instance := &componentApi.Dashboard{}
+			m := conditions.NewManager(instance, status.ConditionTypeReady,
+				status.ConditionTypeProvisioningSucceeded,
+				status.ConditionDeploymentsAvailable,
+			)
+
+			tt.mark(m)
+
+			status.SetStandardConditions(m, 3)
+
+			for ct, cs := range tt.conditions {
+				g.Expect(m.GetCondition(ct)).Should(And(
+					Not(BeNil()),
+					HaveField("Status", cs),
+					HaveField("ObservedGeneration", int64(3)),
+				))
+			}
+
+			for ct, reason := range tt.reasons {
+				g.Expect(m.GetCondition(ct)).Should(HaveField("Reason", reason))
+			}
+
+			// the standard conditions don't change the happiness of the instance
+			g.Expect(m.IsHappy()).Should(Equal(tt.conditions[status.ConditionTypeAvailable] == metav1.ConditionTrue))
+		})
+	}
+}
@@ -77,6 +77,19 @@ const (
 
 	// Component-specific condition types.
 	ConditionTypeProvisioningSucceeded       = "ProvisioningSucceeded"
+	ConditionTypeUpgradePending              = "UpgradePending"
+	ConditionTypeRemovalBlocked              = "RemovalBlocked"
+	ConditionTypeMaintenanceMode             = "MaintenanceMode"
+	ConditionTypeDriftDetected               = "DriftDetected"
+	ConditionTypePodSecurityNonCompliant     = "PodSecurityNonCompliant"
+	ConditionTypeFIPSCompliant               = "FIPSCompliant"
+	ConditionTypeArchitecturePinned          = "ArchitecturePinned"
+	ConditionTypeImagesMirrored              = "ImagesMirrored"
+	ConditionTypeGroupsSynced                = "GroupsSynced"
+	ConditionTypePreflightChecksPassed       = "PreflightChecksPassed"
+	ConditionTypeManifestsPublished          = "ManifestsPublished"
+	ConditionTypeReadinessChecksPassed       = "ReadinessChecksPassed"
+	ConditionGatewayAPIAvailable             = "GatewayAPIAvailable"
 	ConditionDeploymentsNotAvailableReason   = "DeploymentsNotReady"
 	ConditionDeploymentsAvailable            = "DeploymentsAvailable"
 	ConditionArgoWorkflowAvailable           = "ArgoWorkflowAvailable"
@@ -90,6 +103,10 @@ const (
 	ConditionThanosQuerierAvailable          = "ThanosQuerierAvailable"
 	ConditionPersesAvailable                 = "PersesAvailable"
 	ConditionPersesTempoDataSourceAvailable  = "PersesTempoDataSourceAvailable"
+	ConditionGrafanaDashboardsAvailable      = "GrafanaDashboardsAvailable"
+	ConditionMetricsPipelineReady            = "MetricsPipelineReady"
+	ConditionTracesPipelineReady             = "TracesPipelineReady"
+	ConditionAlertingReady                   = "AlertingReady"
 )
 
 const (
@@ -100,6 +117,37 @@ const (
 	CapabilityFailed          string = "CapabilityFailed"
 	ArgoWorkflowExist         string = "ArgoWorkflowExist"
 	NoManagedComponentsReason        = "NoManagedComponents"
+	BlockedOnDependencyReason        = "BlockedOnDependency"
+	ReconcilePausedReason            = "ReconcilePaused"
+	ReconcilePausedMessage           = "Reconciliation is paused by the opendatahub.io/reconcile-paused annotation"
+	RetriesExhaustedReason           = "RetriesExhausted"
+	UpgradePendingReason             = "AwaitingUpgradeApproval"
+	DependentWorkloadsReason         = "DependentWorkloadsExist"
+	MaintenanceModeReason            = "MaintenanceModeEnabled"
+	ResourcesDriftedReason           = "ResourcesDrifted"
+	PodSecurityViolatedReason        = "PodSecurityViolated"
+	FIPSNonCompliantReason           = "FIPSNonCompliant"
+	UnsupportedArchReason            = "ArchitectureUnsupported"
+	UnmirroredImagesReason           = "UnmirroredImages"
+	GroupSyncFailedReason            = "GroupSyncFailed"
+	GatewayAPIMissingReason          = "GatewayAPIMissing"
+	RestartRequiredReason            = "RestartRequired"
+	InvalidConfigReason              = "InvalidConfig"
+	PreflightPassedReason            = "PreflightChecksPassed"
+	PreflightFailedReason            = "PreflightChecksFailed"
+	UpgradeBlockedReason             = "UpgradeBlocked"
+	UninstallingReason               = "Uninstalling"
+	UninstalledReason                = "Uninstalled"
+	UninstallFailedReason            = "UninstallFailed"
+	BundleCollectedReason            = "BundleCollected"
+	BundleFailedReason               = "BundleCollectionFailed"
+	ManifestsExportedReason          = "ManifestsExported"
+	ExportFailedReason               = "ExportFailed"
+	ManifestsPublishedReason         = "ManifestsPublished"
+	PublishFailedReason              = "PublishFailed"
+	ReadinessPassedReason            = "ReadinessChecksPassed"
+	ReadinessFailedReason            = "ReadinessChecksFailed"
+	MaintenanceModeMessage           = "Maintenance mode is enabled, components reconciliation is paused and platform validating webhooks fail open"
 
 	AvailableReason = "Available"
 	NotReadyReason  = "NotReady"
@@ -110,6 +158,21 @@ const (
 	ReadySuffix = "Ready"
 )
 
+// Reasons of the events recorded on the reconciled resources.
+const (
+	ManifestsFetchFailedReason    = "ManifestsFetchFailed"
+	RenderFailedReason            = "RenderFailed"
+	DeployConflictReason          = "DeployConflict"
+	ResourceDeletedReason         = "ResourceDeleted"
+	GarbageCollectDryRunReason    = "GarbageCollectDryRun"
+	VersionUpgradedReason         = "VersionUpgraded"
+	SpecRolledBackReason          = "SpecRolledBack"
+	ImageDriftedReason            = "ImageDrifted"
+	SchemaValidationFailedReason  = "SchemaValidationFailed"
+	ResourceAdoptedReason         = "ResourceAdopted"
+	ResourceAdoptionSkippedReason = "ResourceAdoptionSkipped"
+)
+
 const (
 	DataSciencePipelinesDoesntOwnArgoCRDReason        = "DataSciencePipelinesDoesntOwnArgoCRD"
 	DataSciencePipelinesArgoWorkflowsNotManagedReason = "DataSciencePipelinesArgoWorkflowsNotManaged"
@@ -138,16 +201,206 @@ const (
 	ISVCMissingCRDMessage = "InferenceServices CRD does not exist, please enable serving component first"
 )
 
+// For KServe require Knative Serving when the Serverless deployment mode is selected.
+const (
+	KserveServerlessMissingMessage = "Serverless deployment mode is selected but Knative Serving is not installed, " +
+		"install the OpenShift Serverless operator or use the RawDeployment mode"
+)
+
+// For the KServe NVIDIA NIM capability.
+const (
+	ConditionNIMAvailable = "NIMAvailable"
+
+	NIMRemovedReason           = "Removed"
+	NIMAPIKeyInvalidReason     = "APIKeyInvalid"
+	NIMPullSecretMissingReason = "PullSecretMissing"
+
+	NIMRemovedMessage           = "NVIDIA NIM integration is removed"
+	NIMAPIKeyInvalidMessage     = "NGC API key Secret %s is missing or has no api_key"
+	NIMPullSecretMissingMessage = "NIM image pull secret %s is not yet created in namespace %s"
+)
+
+// For the migration of the ModelMesh InferenceServices to KServe.
+const (
+	ConditionModelMeshMigrated = "ModelMeshMigrated"
+
+	ModelMeshMigrationPendingReason = "MigrationPending"
+
+	ModelMeshMigratedMessage         = "The %d ModelMesh InferenceServices found are migrated to KServe or deleted"
+	ModelMeshMigrationPendingMessage = "%d of %d ModelMesh InferenceServices are pending migration to KServe, the drafts of their KServe InferenceServices are in the ConfigMap %s/%s"
+)
+
+// For the feature gates set in the DSCInitialization.
+const (
+	ConditionFeatureGatesValid = "FeatureGatesValid"
+
+	FeatureGatesValidReason   = "FeatureGatesValid"
+	FeatureGateEnabledReason  = "FeatureGateEnabled"
+	FeatureGateDisabledReason = "FeatureGateDisabled"
+
+	FeatureGatesValidMessage   = "All the feature gates are valid"
+	FeatureGateEnabledMessage  = "%s feature %s is enabled"
+	FeatureGateDisabledMessage = "%s feature %s is disabled, enable it with the %s feature gate of the DSCInitialization"
+)
+
+// For the verification of the manifests bundle, reported in the DSCInitialization.
+const (
+	ConditionManifestsVerified = "ManifestsVerified"
+
+	ManifestsVerifiedReason           = "Verified"
+	ManifestsVerificationFailedReason = "VerificationFailed"
+
+	ManifestsVerifiedMessage           = "The manifests bundle matches its checksum and signature"
+	ManifestsVerificationFailedMessage = "The manifests bundle is rejected, the manifests of the operator image are deployed instead: %v"
+)
+
+// For the HardwareProfiles.
+const (
+	ConditionHardwareProfileValid  = "Valid"
+	ConditionAcceleratorsAvailable = "AcceleratorsAvailable"
+
+	HardwareProfileValidReason = "Valid"
+	NoAcceleratorsReason       = "NoAccelerators"
+	NoMatchingNodesReason      = "NoMatchingNodes"
+
+	HardwareProfileValidMessage    = "The hardware profile is valid"
+	NoAcceleratorsMessage          = "The hardware profile requests no accelerators"
+	AcceleratorsAvailableMessage   = "%d nodes provide the accelerators of the hardware profile"
+	AcceleratorsUnavailableMessage = "No node matching the node scheduling of the hardware profile provides %s"
+)
+
+// For the accelerators recommended by the KServe serving runtimes.
+const (
+	ConditionServingRuntimeAcceleratorsAvailable = "ServingRuntimeAcceleratorsAvailable"
+
+	ServingRuntimeAcceleratorsMissingReason = "AcceleratorsMissing"
+
+	ServingRuntimeAcceleratorsAvailableMessage = "The accelerators recommended by the serving runtimes are detected on the cluster"
+	ServingRuntimeAcceleratorsMissingMessage   = "The accelerators recommended by the serving runtimes %s are not detected on the cluster"
+)
+
+// For the ModelRegistry external database.
+const (
+	ConditionDatabaseAvailable = "DatabaseAvailable"
+
+	DatabaseBundledReason            = "Bundled"
+	DatabaseReachableReason          = "Reachable"
+	DatabaseCredentialsInvalidReason = "CredentialsInvalid"
+	DatabaseCABundleMissingReason    = "CABundleMissing"
+	DatabaseUnreachableReason        = "Unreachable"
+
+	DatabaseBundledMessage            = "Model registries use the bundled database"
+	DatabaseReachableMessage          = "External %s database at %s is reachable"
+	DatabaseCredentialsInvalidMessage = "Database credentials Secret %s/%s is missing or has no %s and %s keys"
+	DatabaseCABundleMissingMessage    = "Database CA bundle ConfigMap %s/%s is missing or has no ca.crt key"
+	DatabaseUnreachableMessage        = "External %s database at %s is unreachable: %v"
+)
+
+// For the DataSciencePipelines object storage.
+const (
+	ConditionObjectStorageAvailable = "ObjectStorageAvailable"
+
+	ObjectStorageNotConfiguredReason      = "NotConfigured"
+	ObjectStorageBundledReason            = "Bundled"
+	ObjectStorageReachableReason          = "Reachable"
+	ObjectStorageCredentialsInvalidReason = "CredentialsInvalid"
+	ObjectStorageUnreachableReason        = "Unreachable"
+
+	ObjectStorageNotConfiguredMessage      = "Pipeline servers configure their own object storage"
+	ObjectStorageBundledMessage            = "Pipeline servers use the in-cluster MinIO, not supported in production"
+	ObjectStorageReachableMessage          = "Object storage endpoint %s is reachable"
+	ObjectStorageCredentialsInvalidMessage = "Object storage credentials Secret %s/%s is missing or has no %s and %s keys"
+	ObjectStorageUnreachableMessage        = "Object storage endpoint %s is unreachable: %v"
+)
+
+// For the Dashboard catalog sources.
+const (
+	ConditionCatalogAvailable = "CatalogAvailable"
+
+	CatalogNotConfiguredReason     = "NotConfigured"
+	CatalogSourceUnavailableReason = "SourceUnavailable"
+
+	CatalogNotConfiguredMessage     = "No catalog source is configured"
+	CatalogAvailableMessage         = "%d resources registered from the catalog sources"
+	CatalogSourceUnavailableMessage = "Catalog sources unavailable, the resources last registered from them are kept: %s"
+)
+
+// For the LlamaStackOperator model provider.
+const (
+	ConditionModelProviderAvailable = "ModelProviderAvailable"
+
+	ModelProviderNotConfiguredReason      = "NotConfigured"
+	ModelProviderReachableReason          = "Reachable"
+	ModelProviderCredentialsInvalidReason = "CredentialsInvalid"
+	ModelProviderUnreachableReason        = "Unreachable"
+
+	ModelProviderNotConfiguredMessage      = "No Llama Stack distribution is deployed by the component"
+	ModelProviderReachableMessage          = "Model provider of the %s distribution at %s is reachable"
+	ModelProviderCredentialsInvalidMessage = "Model provider API key Secret %s/%s is missing or has no %s key"
+	ModelProviderUnreachableMessage        = "Model provider of the %s distribution at %s is unreachable: %v"
+)
+
+// For the ModelRegistry instances.
+const (
+	ConditionRegistriesAvailable = "RegistriesAvailable"
+
+	RegistriesNotReadyReason = "RegistriesNotReady"
+
+	RegistriesNotReadyMessage = "Model registries not ready: %s"
+
+	RegistryNamespaceNotFoundMessage  = "Namespace %s not found"
+	RegistryCredentialsInvalidMessage = "Database credentials Secret %s is missing or has no %s and %s keys"
+	RegistryPendingMessage            = "Waiting for the model registry to be created"
+	RegistryCRDMissingMessage         = "Waiting for the ModelRegistry CRD to be installed"
+)
+
+// For the FeastOperator feature store.
+const (
+	ConditionFeatureStoreAvailable = "FeatureStoreAvailable"
+
+	FeatureStoreNotConfiguredReason     = "NotConfigured"
+	FeatureStoreCRDMissingReason        = "CRDMissing"
+	FeatureStoreNamespaceNotFoundReason = "NamespaceNotFound"
+	FeatureStoreSecretInvalidReason     = "SecretInvalid"
+	FeatureStorePendingReason           = "Pending"
+	FeatureStoreNotReadyReason          = "NotReady"
+
+	FeatureStoreNotConfiguredMessage     = "No feature store is provisioned by the component"
+	FeatureStoreCRDMissingMessage        = "Waiting for the FeatureStore CRD to be installed"
+	FeatureStoreNamespaceNotFoundMessage = "Namespace %s of the feature store not found"
+	FeatureStoreSecretInvalidMessage     = "%s store Secret %s/%s is missing or has no %s key"
+	FeatureStorePendingMessage           = "Waiting for the feature store %s/%s to be created"
+	FeatureStoreReadyMessage             = "Feature store %s/%s is ready"
+	FeatureStoreNotReadyMessage          = "Feature store %s/%s is in phase %s"
+)
+
 // For Monitoring service checks.
 const (
-	MetricsNotConfiguredReason  = "MetricsNotConfigured"
-	MetricsNotConfiguredMessage = "Metrics not configured in DSCI CR"
-	TracesNotConfiguredReason   = "TracesNotConfigured"
-	TracesNotConfiguredMessage  = "Traces not configured in DSCI CR"
+	MetricsNotConfiguredReason    = "MetricsNotConfigured"
+	MetricsNotConfiguredMessage   = "Metrics not configured in DSCI CR"
+	TracesNotConfiguredReason     = "TracesNotConfigured"
+	TracesNotConfiguredMessage    = "Traces not configured in DSCI CR"
+	TempoNotSelectedReason        = "TempoNotSelected"
+	TempoNotSelectedMessage       = "Traces are exported to an external backend, Tempo is not deployed"
+	MetricsRemoteWriteOnlyReason  = "MetricsRemoteWriteOnly"
+	MetricsRemoteWriteOnlyMessage = "Metrics are only remote written to external endpoints, the MonitoringStack is not deployed"
+
+	MetricsDefaultStackDisabledReason  = "MetricsDefaultStackDisabled"
+	MetricsDefaultStackDisabledMessage = "The default metrics stack is disabled, the MonitoringStack is not deployed"
 
 	AlertingNotConfiguredReason  = "AlertingNotConfigured"
 	AlertingNotConfiguredMessage = "Alerting not configured in DSCI CR"
 
+	PipelineWorkloadNotReadyReason = "WorkloadNotReady"
+
+	RenderOnlyReason  = "RenderOnly"
+	RenderOnlyMessage = "Monitoring manifests are rendered into a ConfigMap without being applied"
+
+	DashboardsNotConfiguredReason  = "DashboardsNotConfigured"
+	DashboardsNotConfiguredMessage = "Dashboards not enabled in DSCI CR"
+	DashboardConfigMapsReason      = "DashboardConfigMaps"
+	DashboardConfigMapsMessage     = "Grafana operator is not installed, dashboards are deployed as ConfigMaps for the Grafana sidecar"
+
 	TempoOperatorMissingMessage                  = "Tempo operator must be installed for traces configuration"
 	COOMissingMessage                            = "ClusterObservability operator must be installed for metrics configuration"
 	OpenTelemetryCollectorOperatorMissingMessage = "OpenTelemetryCollector operator must be installed for OpenTelemetry configuration"
@@ -156,6 +409,11 @@ const (
 	GatewayNotReadyMessage = "Gateway is not ready"
 	GatewayReadyMessage    = "Gateway is ready"
 
+	// Gateway messages when the services fall back to OpenShift Routes.
+	GatewayServiceMeshRemovedMessage = "Service mesh is Removed, services are exposed with OpenShift Routes"
+	GatewayAPIAvailableMessage       = "Gateway API CRDs are installed"
+	GatewayAPIMissingMessage         = "Gateway API CRDs are not installed, the gatewayapi ingress type cannot be used"
+
 	// Gateway Authentication messages.
 	AuthProxyDeployedMessage                 = "Auth proxy deployed successfully"
 	AuthProxyFailedDeployMessage             = "Failed to deploy auth proxy"
@@ -169,6 +427,19 @@ const (
 	AuthProxyExternalAuthNoDeploymentMessage = "Cluster uses external authentication, no gateway auth proxy deployed"
 )
 
+// For the third-party operators the components depend on, reported in the DSCInitialization with
+// the condition type of each dependency, e.g. CertManagerReady.
+const (
+	DependencyReadyReason      = "DependencyReady"
+	DependencyInstallingReason = "DependencyInstalling"
+	DependencyFailedReason     = "DependencyFailed"
+
+	DependencyReadyMessage      = "%s is installed, ClusterServiceVersion %s succeeded"
+	DependencyInstallingMessage = "%s is being installed: %s"
+	DependencyFailedMessage     = "%s failed to install: %s"
+	DependencyMissingMessage    = "%s is not installed, install it or set the managementState of the %s dependency of the DSCInitialization to Managed"
+)
+
 // For v3 upgrade sanity checks.
 const (
 	CodeFlarePresentMessage = `Failed upgrade: CodeFlare component is present in the cluster. It must be uninstalled to proceed with Ray component upgrade.
@@ -306,3 +577,9 @@ func SetCondition(conditions *[]common.Condition, conditionType string, reason s
 		Message: message,
 	})
 }
+
+// RemoveCondition removes the condition of the given type, if any.
+func RemoveCondition(conditions *[]common.Condition, conditionType string) {
+	wrapper := &conditionsWrapper{conditions: conditions}
+	cond.RemoveStatusCondition(wrapper, conditionType)
+}
@@ -0,0 +1,122 @@
+// Package constraints holds the constraints of the DataScienceCluster spanning several components,
+// or a component and the cluster, that can not be expressed in the CRD schema. They are enforced by
+// the DataScienceCluster validating webhooks and reported by the upgrade preflight checks.
+package constraints
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
+)
+
+const (
+	certManagerCRD      = "certificates.cert-manager.io"
+	inferenceServiceCRD = "inferenceservices.serving.kserve.io"
+)
+
+// Check validates a constraint of the DataScienceCluster components, returning
+// a message describing how to fix the spec when the constraint is not met.
+type Check func(context.Context, client.Reader, *dscv2.DataScienceCluster) (string, error)
+
+var checks = []Check{
+	checkKueueDependencies,
+	checkTrustyAIDependencies,
+	checkModelRegistryNamespace,
+}
+
+// Violations returns the messages describing how to fix the spec of the given DataScienceCluster,
+// one per constraint it does not meet.
+func Violations(ctx context.Context, cli client.Reader, dsc *dscv2.DataScienceCluster) ([]string, error) {
+	violations := make([]string, 0)
+
+	for _, check := range checks {
+		msg, err := check(ctx, cli, dsc)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg != "" {
+			violations = append(violations, msg)
+		}
+	}
+
+	return violations, nil
+}
+
+// checkKueueDependencies ensures that the operators an Unmanaged Kueue relies on are installed.
+func checkKueueDependencies(ctx context.Context, cli client.Reader, dsc *dscv2.DataScienceCluster) (string, error) {
+	if dsc.Spec.Components.Kueue.ManagementState != operatorv1.Unmanaged {
+		return "", nil
+	}
+
+	found, err := dependency.Installed(ctx, cli, dependency.Kueue)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for the Kueue operator: %w", err)
+	}
+	if !found {
+		return "kueue managementState Unmanaged requires the Red Hat build of Kueue operator, " +
+			"install it or set kueue managementState to Removed", nil
+	}
+
+	found, err = crdExists(ctx, cli, certManagerCRD)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "kueue managementState Unmanaged requires cert-manager, " +
+			"install the cert-manager Operator for Red Hat OpenShift or set kueue managementState to Removed", nil
+	}
+
+	return "", nil
+}
+
+// checkTrustyAIDependencies ensures that the InferenceService API TrustyAI relies on is
+// either provided by KServe or already available in the cluster.
+func checkTrustyAIDependencies(ctx context.Context, cli client.Reader, dsc *dscv2.DataScienceCluster) (string, error) {
+	if dsc.Spec.Components.TrustyAI.ManagementState != operatorv1.Managed {
+		return "", nil
+	}
+	if dsc.Spec.Components.Kserve.ManagementState == operatorv1.Managed {
+		return "", nil
+	}
+
+	found, err := crdExists(ctx, cli, inferenceServiceCRD)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "trustyai managementState Managed requires the KServe InferenceService API, " +
+			"set kserve managementState to Managed or set trustyai managementState to Removed", nil
+	}
+
+	return "", nil
+}
+
+// checkModelRegistryNamespace ensures that a Managed ModelRegistry has a namespace to install the registries to.
+func checkModelRegistryNamespace(_ context.Context, _ client.Reader, dsc *dscv2.DataScienceCluster) (string, error) {
+	mr := dsc.Spec.Components.ModelRegistry
+	if mr.ManagementState != operatorv1.Managed || mr.RegistriesNamespace != "" {
+		return "", nil
+	}
+
+	return "modelregistry managementState Managed requires registriesNamespace to be set", nil
+}
+
+func crdExists(ctx context.Context, cli client.Reader, name string) (bool, error) {
+	_, err := cluster.GetCRD(ctx, cli, name)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to check for the %s CRD: %w", name, err)
+	default:
+		return true, nil
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,6 +18,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	v2webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
@@ -73,9 +77,9 @@ func (v *Validator) Handle(ctx context.Context, req admission.Request) admission
 
 	switch req.Operation {
 	case admissionv1.Create:
-		return validate([]validationCheck{v.denyManagementstateManaged, denyMultipleDsc}, allowMessage, ctx, v.Client, &req)
+		return validate([]validationCheck{v.denyManagementstateManaged, v.validateApplicationsNamespace, v.validateComponents}, allowMessage, ctx, v.Client, &req)
 	case admissionv1.Update:
-		return validate([]validationCheck{v.denyManagementstateManaged}, allowMessage, ctx, v.Client, &req)
+		return validate([]validationCheck{v.denyManagementstateManaged, v.validateApplicationsNamespace, v.validateComponents}, allowMessage, ctx, v.Client, &req)
 	default:
 		return admission.Allowed(allowMessage) // initialize Allowed to be true in case Operation falls into "default" case
 	}
@@ -94,8 +98,25 @@ func validate(checks []validationCheck, allowedMessage string, ctx context.Conte
 	return admission.Allowed(allowedMessage)
 }
 
-func denyMultipleDsc(ctx context.Context, client client.Reader, req *admission.Request) admission.Response {
-	return webhookutils.ValidateSingletonCreation(ctx, client, req, gvk.DataScienceCluster)
+// validateApplicationsNamespace converts the DataScienceCluster to the hub version and checks that its
+// applications namespace does not overlap another one, as the v2 webhook does.
+func (v *Validator) validateApplicationsNamespace(ctx context.Context, client client.Reader, req *admission.Request) admission.Response {
+	dsc, err := v.decodeHub(req.Object)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Error converting request object to "+gvk.DataScienceCluster.String())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var old *dscv2.DataScienceCluster
+	if req.Operation == admissionv1.Update {
+		old, err = v.decodeHub(req.OldObject)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Error converting request old object to "+gvk.DataScienceCluster.String())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	return v2webhook.ValidateApplicationsNamespace(ctx, client, dsc, old)
 }
 
 func (v *Validator) denyManagementstateManaged(ctx context.Context, client client.Reader, req *admission.Request) admission.Response {
@@ -110,3 +131,41 @@ func (v *Validator) denyManagementstateManaged(ctx context.Context, client clien
 
 	return admission.Allowed("")
 }
+
+// validateComponents converts the DataScienceCluster to the hub version and runs the cross-component
+// checks shared with the v2 webhook. Updates that do not change the spec are always allowed.
+func (v *Validator) validateComponents(ctx context.Context, client client.Reader, req *admission.Request) admission.Response {
+	dsc, err := v.decodeHub(req.Object)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Error converting request object to "+gvk.DataScienceCluster.String())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1.Update {
+		old, err := v.decodeHub(req.OldObject)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Error converting request old object to "+gvk.DataScienceCluster.String())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if equality.Semantic.DeepEqual(old.Spec, dsc.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	return v2webhook.ValidateComponents(ctx, client, dsc)
+}
+
+func (v *Validator) decodeHub(raw runtime.RawExtension) (*dscv2.DataScienceCluster, error) {
+	dscV1 := &dscv1.DataScienceCluster{}
+	if err := v.Decoder.DecodeRaw(raw, dscV1); err != nil {
+		return nil, err
+	}
+
+	dsc := &dscv2.DataScienceCluster{}
+	if err := dscV1.ConvertTo(dsc); err != nil {
+		return nil, err
+	}
+
+	return dsc, nil
+}
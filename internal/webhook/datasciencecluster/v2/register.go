@@ -4,14 +4,16 @@ package v2
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // RegisterWebhooks registers the webhooks for DataScienceCluster v2.
 func RegisterWebhooks(mgr ctrl.Manager) error {
 	// Register the validating webhook
 	if err := (&Validator{
-		Client: mgr.GetAPIReader(),
-		Name:   "datasciencecluster-v2-validating",
+		Client:  mgr.GetAPIReader(),
+		Name:    "datasciencecluster-v2-validating",
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
@@ -5,26 +5,34 @@ package v2
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/constraints"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
-//+kubebuilder:webhook:path=/validate-datasciencecluster-v2,matchPolicy=Exact,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=create,versions=v2,name=datasciencecluster-v2-validator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-datasciencecluster-v2,matchPolicy=Exact,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=create;update,versions=v2,name=datasciencecluster-v2-validator.opendatahub.io,admissionReviewVersions=v1
 //nolint:lll
 
 // Validator implements webhook.AdmissionHandler for DataScienceCluster v2 validation webhooks.
-// It enforces singleton creation rules for DataScienceCluster resources and always allows their deletion.
+// It enforces the applications namespaces of the DataScienceCluster resources not to overlap, and
+// cross-component constraints, and always allows their deletion.
 type Validator struct {
-	Client client.Reader
-	Name   string
+	Client  client.Reader
+	Name    string
+	Decoder admission.Decoder
 }
 
 // Assert that Validator implements admission.Handler interface.
@@ -46,8 +54,9 @@ func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
-// Handle processes admission requests for create operations on DataScienceCluster v2 resources.
-// It enforces singleton rules, allowing other operations by default.
+// Handle processes admission requests for create and update operations on DataScienceCluster v2 resources.
+// It enforces non-overlapping applications namespaces and cross-component constraints on both creation
+// and update, allowing other operations by default.
 //
 // Parameters:
 //   - ctx: Context for the admission request (logger is extracted from here).
@@ -62,8 +71,11 @@ func (v *Validator) Handle(ctx context.Context, req admission.Request) admission
 	var resp admission.Response
 
 	switch req.Operation {
-	case admissionv1.Create:
-		resp = webhookutils.ValidateSingletonCreation(ctx, v.Client, &req, gvk.DataScienceCluster)
+	case admissionv1.Create, admissionv1.Update:
+		resp = v.validateApplicationsNamespace(ctx, &req)
+		if resp.Allowed {
+			resp = v.validateComponents(ctx, &req)
+		}
 	default:
 		resp.Allowed = true // initialize Allowed to be true in case Operation falls into "default" case
 	}
@@ -74,3 +86,167 @@ func (v *Validator) Handle(ctx context.Context, req admission.Request) admission
 
 	return admission.Allowed(fmt.Sprintf("Operation %s on %s v2 allowed", req.Operation, req.Kind.Kind))
 }
+
+// validateApplicationsNamespace decodes the DataScienceCluster, and its previous version on update,
+// to check its applications namespace with ValidateApplicationsNamespace.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the object, and the old object on update.
+//
+// Returns:
+//   - admission.Response: Denied when the applications namespace overlaps another one, allowed otherwise.
+func (v *Validator) validateApplicationsNamespace(ctx context.Context, req *admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	dsc := &dscv2.DataScienceCluster{}
+	if err := v.Decoder.DecodeRaw(req.Object, dsc); err != nil {
+		log.Error(err, "Error converting request object to "+gvk.DataScienceCluster.String())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var old *dscv2.DataScienceCluster
+	if req.Operation == admissionv1.Update {
+		old = &dscv2.DataScienceCluster{}
+		if err := v.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+			log.Error(err, "Error converting request old object to "+gvk.DataScienceCluster.String())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	return ValidateApplicationsNamespace(ctx, v.Client, dsc, old)
+}
+
+// ValidateApplicationsNamespace checks that the applications namespace the DataScienceCluster is bound
+// to is not bound to another one: a single DataScienceCluster can leave it unset, to be bound to the
+// applications namespace of the DSCInitialization, and the scoped ones each set a distinct namespace.
+// The namespace can not be changed once the DataScienceCluster is created.
+// It is shared with the v1 webhook, which validates the hub version of the resource.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - cli: The client used to look up the other DataScienceClusters and the DSCInitialization.
+//   - dsc: The DataScienceCluster to validate.
+//   - old: The previous version of the DataScienceCluster on update, nil on creation.
+//
+// Returns:
+//   - admission.Response: Denied when the applications namespace overlaps another one, allowed otherwise.
+func ValidateApplicationsNamespace(
+	ctx context.Context,
+	cli client.Reader,
+	dsc *dscv2.DataScienceCluster,
+	old *dscv2.DataScienceCluster,
+) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if old != nil {
+		if old.Spec.ApplicationsNamespace != dsc.Spec.ApplicationsNamespace {
+			return admission.Denied("The applicationsNamespace of a DataScienceCluster can not be changed")
+		}
+
+		// the namespace was already checked on creation
+		return admission.Allowed("")
+	}
+
+	if dsc.IsScoped() {
+		dscis := dsciv2.DSCInitializationList{}
+		if err := cli.List(ctx, &dscis); err != nil {
+			log.Error(err, "Error listing "+gvk.DSCInitialization.String())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		for i := range dscis.Items {
+			if dscis.Items[i].Spec.ApplicationsNamespace == dsc.Spec.ApplicationsNamespace {
+				return admission.Denied(fmt.Sprintf(
+					"The applicationsNamespace %s is the one of the DSCInitialization, leave it unset instead",
+					dsc.Spec.ApplicationsNamespace))
+			}
+		}
+	}
+
+	dscs := dscv2.DataScienceClusterList{}
+	if err := cli.List(ctx, &dscs); err != nil {
+		log.Error(err, "Error listing "+gvk.DataScienceCluster.String())
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	for i := range dscs.Items {
+		other := &dscs.Items[i]
+		if other.Name == dsc.Name || other.Spec.ApplicationsNamespace != dsc.Spec.ApplicationsNamespace {
+			continue
+		}
+
+		if !dsc.IsScoped() {
+			return admission.Denied(fmt.Sprintf(
+				"Only one instance of DataScienceCluster object without applicationsNamespace is allowed, %s already exists",
+				other.Name))
+		}
+
+		return admission.Denied(fmt.Sprintf(
+			"The applicationsNamespace %s is already bound to the DataScienceCluster %s",
+			dsc.Spec.ApplicationsNamespace, other.Name))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateComponents checks the constraints spanning several components, or a component
+// and the cluster, that can not be expressed in the CRD schema.
+//
+// Updates that do not change the spec are always allowed, so the operator can still
+// manage the metadata of the resource, e.g. its finalizers, when the cluster stops
+// meeting a constraint.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the object, and the old object on update.
+//
+// Returns:
+//   - admission.Response: Denied with all the violated constraints, allowed otherwise.
+func (v *Validator) validateComponents(ctx context.Context, req *admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	dsc := &dscv2.DataScienceCluster{}
+	if err := v.Decoder.DecodeRaw(req.Object, dsc); err != nil {
+		log.Error(err, "Error converting request object to "+gvk.DataScienceCluster.String())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1.Update {
+		old := &dscv2.DataScienceCluster{}
+		if err := v.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+			log.Error(err, "Error converting request old object to "+gvk.DataScienceCluster.String())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if equality.Semantic.DeepEqual(old.Spec, dsc.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	return ValidateComponents(ctx, v.Client, dsc)
+}
+
+// ValidateComponents runs the cross-component checks against the given DataScienceCluster.
+// It is shared with the v1 webhook, which validates the hub version of the resource.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - cli: The client used to look up the cluster state.
+//   - dsc: The DataScienceCluster to validate.
+//
+// Returns:
+//   - admission.Response: Denied with all the violated constraints, allowed otherwise.
+func ValidateComponents(ctx context.Context, cli client.Reader, dsc *dscv2.DataScienceCluster) admission.Response {
+	violations, err := constraints.Violations(ctx, cli, dsc)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Error validating components")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if len(violations) != 0 {
+		return admission.Denied(strings.Join(violations, "; "))
+	}
+
+	return admission.Allowed("")
+}
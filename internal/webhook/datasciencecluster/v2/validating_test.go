@@ -1,23 +1,30 @@
 package v2_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	v2webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
 )
 
 // TestDataScienceClusterV2_ValidatingWebhook exercises the validating webhook logic for DataScienceCluster v2 resources.
-// It verifies singleton enforcement and deletion rules using table-driven tests and a fake client.
+// It verifies the single unscoped instance enforcement and deletion rules using table-driven tests and a fake client.
 func TestDataScienceClusterV2_ValidatingWebhook(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -86,11 +93,14 @@ func TestDataScienceClusterV2_ValidatingWebhook(t *testing.T) {
 			t.Parallel()
 			objs := append([]client.Object{}, tc.existingObjs...)
 			objs = append(objs, envtestutil.NewDSCI("dsci-for-dsc"))
-			cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+			sch, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			cli, err := fakeclient.New(fakeclient.WithObjects(objs...), fakeclient.WithScheme(sch))
 			g.Expect(err).ShouldNot(HaveOccurred())
 			validator := &v2webhook.Validator{
-				Client: cli,
-				Name:   "test-v2",
+				Client:  cli,
+				Name:    "test-v2",
+				Decoder: admission.NewDecoder(sch),
 			}
 			resp := validator.Handle(ctx, tc.req)
 			t.Logf("Admission response: Allowed=%v, Result=%+v", resp.Allowed, resp.Result)
@@ -101,3 +111,255 @@ func TestDataScienceClusterV2_ValidatingWebhook(t *testing.T) {
 		})
 	}
 }
+
+// TestDataScienceClusterV2_ValidatingWebhook_Components verifies the cross-component constraints
+// enforced by the validating webhook on creation and update.
+func TestDataScienceClusterV2_ValidatingWebhook_Components(t *testing.T) {
+	t.Parallel()
+
+	resource := metav1.GroupVersionResource{
+		Group:    gvk.DataScienceCluster.Group,
+		Version:  gvk.DataScienceCluster.Version,
+		Resource: "datascienceclusters",
+	}
+
+	kueueUnmanaged := func(dsc *dscv2.DataScienceCluster) {
+		dsc.Spec.Components.Kueue.ManagementState = operatorv1.Unmanaged
+	}
+	trustyAIManaged := func(dsc *dscv2.DataScienceCluster) {
+		dsc.Spec.Components.TrustyAI.ManagementState = operatorv1.Managed
+	}
+	kserveManaged := func(dsc *dscv2.DataScienceCluster) {
+		dsc.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+	}
+	modelRegistryWithoutNamespace := func(dsc *dscv2.DataScienceCluster) {
+		dsc.Spec.Components.ModelRegistry.ManagementState = operatorv1.Managed
+		dsc.Spec.Components.ModelRegistry.RegistriesNamespace = ""
+	}
+
+	kueueOperator := &ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: "kueue-operator.v1.0.0", Namespace: "openshift-kueue-operator"}}
+	certManagerCRD := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "certificates.cert-manager.io"}}
+	isvcCRD := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "inferenceservices.serving.kserve.io"}}
+
+	cases := []struct {
+		name         string
+		existingObjs []client.Object
+		op           admissionv1.Operation
+		old          *dscv2.DataScienceCluster
+		dsc          *dscv2.DataScienceCluster
+		allowed      bool
+		messages     []string
+	}{
+		{
+			name:    "Allows Unmanaged Kueue with its dependencies installed",
+			op:      admissionv1.Create,
+			dsc:     envtestutil.NewDSC("dsc", kueueUnmanaged),
+			allowed: true,
+			existingObjs: []client.Object{
+				kueueOperator,
+				certManagerCRD,
+			},
+		},
+		{
+			name:     "Denies Unmanaged Kueue without the Kueue operator",
+			op:       admissionv1.Create,
+			dsc:      envtestutil.NewDSC("dsc", kueueUnmanaged),
+			allowed:  false,
+			messages: []string{"Red Hat build of Kueue operator"},
+		},
+		{
+			name:         "Denies Unmanaged Kueue without cert-manager",
+			op:           admissionv1.Create,
+			dsc:          envtestutil.NewDSC("dsc", kueueUnmanaged),
+			existingObjs: []client.Object{kueueOperator},
+			allowed:      false,
+			messages:     []string{"requires cert-manager"},
+		},
+		{
+			name:    "Allows Managed TrustyAI with Managed KServe",
+			op:      admissionv1.Create,
+			dsc:     envtestutil.NewDSC("dsc", trustyAIManaged, kserveManaged),
+			allowed: true,
+		},
+		{
+			name:         "Allows Managed TrustyAI with the InferenceService API installed",
+			op:           admissionv1.Create,
+			dsc:          envtestutil.NewDSC("dsc", trustyAIManaged),
+			existingObjs: []client.Object{isvcCRD},
+			allowed:      true,
+		},
+		{
+			name:     "Denies Managed TrustyAI without the InferenceService API",
+			op:       admissionv1.Create,
+			dsc:      envtestutil.NewDSC("dsc", trustyAIManaged),
+			allowed:  false,
+			messages: []string{"KServe InferenceService API"},
+		},
+		{
+			name:     "Denies Managed ModelRegistry without a registries namespace",
+			op:       admissionv1.Create,
+			dsc:      envtestutil.NewDSC("dsc", modelRegistryWithoutNamespace),
+			allowed:  false,
+			messages: []string{"registriesNamespace"},
+		},
+		{
+			name:     "Reports all the violated constraints",
+			op:       admissionv1.Update,
+			old:      envtestutil.NewDSC("dsc"),
+			dsc:      envtestutil.NewDSC("dsc", trustyAIManaged, modelRegistryWithoutNamespace),
+			allowed:  false,
+			messages: []string{"KServe InferenceService API", "registriesNamespace"},
+		},
+		{
+			name:    "Allows updates not changing the spec",
+			op:      admissionv1.Update,
+			old:     envtestutil.NewDSC("dsc", trustyAIManaged),
+			dsc:     envtestutil.NewDSC("dsc", trustyAIManaged),
+			allowed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			sch, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			cli, err := fakeclient.New(fakeclient.WithObjects(tc.existingObjs...), fakeclient.WithScheme(sch))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			validator := &v2webhook.Validator{
+				Client:  cli,
+				Name:    "test-v2",
+				Decoder: admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(t, tc.op, tc.dsc, gvk.DataScienceCluster, resource)
+			if tc.old != nil {
+				raw, err := json.Marshal(tc.old)
+				g.Expect(err).ShouldNot(HaveOccurred())
+				req.OldObject.Raw = raw
+			}
+
+			resp := validator.Handle(ctx, req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed), "unexpected response: %+v", resp.Result)
+			for _, m := range tc.messages {
+				g.Expect(resp.Result.Message).To(ContainSubstring(m))
+			}
+		})
+	}
+}
+
+// TestDataScienceClusterV2_ValidatingWebhook_ApplicationsNamespace verifies that the validating webhook
+// only allows several DataScienceClusters bound to distinct applications namespaces.
+func TestDataScienceClusterV2_ValidatingWebhook_ApplicationsNamespace(t *testing.T) {
+	t.Parallel()
+
+	resource := metav1.GroupVersionResource{
+		Group:    gvk.DataScienceCluster.Group,
+		Version:  gvk.DataScienceCluster.Version,
+		Resource: "datascienceclusters",
+	}
+
+	scopedTo := func(ns string) func(*dscv2.DataScienceCluster) {
+		return func(dsc *dscv2.DataScienceCluster) {
+			dsc.Spec.ApplicationsNamespace = ns
+		}
+	}
+
+	dsci := envtestutil.NewDSCI("dsci", func(dsci *dsciv2.DSCInitialization) {
+		dsci.Spec.ApplicationsNamespace = "opendatahub"
+	})
+
+	cases := []struct {
+		name         string
+		existingObjs []client.Object
+		op           admissionv1.Operation
+		old          *dscv2.DataScienceCluster
+		dsc          *dscv2.DataScienceCluster
+		allowed      bool
+		messages     []string
+	}{
+		{
+			name:         "Allows a scoped DataScienceCluster next to the unscoped one",
+			op:           admissionv1.Create,
+			existingObjs: []client.Object{dsci, envtestutil.NewDSC("default-dsc")},
+			dsc:          envtestutil.NewDSC("team-a", scopedTo("team-a")),
+			allowed:      true,
+		},
+		{
+			name:         "Allows scoped DataScienceClusters bound to distinct namespaces",
+			op:           admissionv1.Create,
+			existingObjs: []client.Object{dsci, envtestutil.NewDSC("team-a", scopedTo("team-a"))},
+			dsc:          envtestutil.NewDSC("team-b", scopedTo("team-b")),
+			allowed:      true,
+		},
+		{
+			name:         "Denies a second unscoped DataScienceCluster",
+			op:           admissionv1.Create,
+			existingObjs: []client.Object{dsci, envtestutil.NewDSC("default-dsc")},
+			dsc:          envtestutil.NewDSC("other"),
+			allowed:      false,
+			messages:     []string{"without applicationsNamespace"},
+		},
+		{
+			name:         "Denies a namespace bound to another DataScienceCluster",
+			op:           admissionv1.Create,
+			existingObjs: []client.Object{dsci, envtestutil.NewDSC("team-a", scopedTo("team-a"))},
+			dsc:          envtestutil.NewDSC("other", scopedTo("team-a")),
+			allowed:      false,
+			messages:     []string{"already bound to the DataScienceCluster team-a"},
+		},
+		{
+			name:         "Denies the applications namespace of the DSCInitialization",
+			op:           admissionv1.Create,
+			existingObjs: []client.Object{dsci},
+			dsc:          envtestutil.NewDSC("team-a", scopedTo("opendatahub")),
+			allowed:      false,
+			messages:     []string{"the one of the DSCInitialization"},
+		},
+		{
+			name:         "Denies changing the namespace",
+			op:           admissionv1.Update,
+			existingObjs: []client.Object{dsci},
+			old:          envtestutil.NewDSC("team-a", scopedTo("team-a")),
+			dsc:          envtestutil.NewDSC("team-a", scopedTo("team-b")),
+			allowed:      false,
+			messages:     []string{"can not be changed"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			sch, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			cli, err := fakeclient.New(fakeclient.WithObjects(tc.existingObjs...), fakeclient.WithScheme(sch))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			validator := &v2webhook.Validator{
+				Client:  cli,
+				Name:    "test-v2",
+				Decoder: admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(t, tc.op, tc.dsc, gvk.DataScienceCluster, resource)
+			if tc.old != nil {
+				raw, err := json.Marshal(tc.old)
+				g.Expect(err).ShouldNot(HaveOccurred())
+				req.OldObject.Raw = raw
+			}
+
+			resp := validator.Handle(ctx, req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed), "unexpected response: %+v", resp.Result)
+			for _, m := range tc.messages {
+				g.Expect(resp.Result.Message).To(ContainSubstring(m))
+			}
+		})
+	}
+}
@@ -24,8 +24,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
@@ -243,6 +245,14 @@ func (i *Injector) performHardwareProfileInjection(ctx context.Context, req *adm
 		}
 	}
 
+	// Reject the hardware profiles the hardware profile controller reported as invalid, unless
+	// they changed since
+	if hwp.Status.ObservedGeneration == hwp.Generation && conditions.IsStatusConditionFalse(hwp, status.ConditionHardwareProfileValid) {
+		c := conditions.FindStatusCondition(hwp, status.ConditionHardwareProfileValid)
+		userErr := fmt.Errorf("hardware profile '%s' in namespace '%s' is invalid: %s", profileName, profileNamespace, c.Message)
+		return admission.Errored(http.StatusBadRequest, userErr)
+	}
+
 	// Early exit if hardware profile has no meaningful configuration
 	if len(hwp.Spec.Identifiers) == 0 && hwp.Spec.SchedulingSpec == nil {
 		return admission.Allowed("HardwareProfile has no configuration to apply")
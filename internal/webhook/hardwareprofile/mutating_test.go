@@ -17,7 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -201,6 +203,42 @@ func TestHardwareProfile_DeniesWhenProfileNotFound(t *testing.T) {
 	g.Expect(resp.Result.Message).Should(ContainSubstring("hardware profile 'nonexistent' not found"))
 }
 
+// TestHardwareProfile_DeniesWhenProfileInvalid tests that the hardware profiles reported as invalid are not applied.
+func TestHardwareProfile_DeniesWhenProfileInvalid(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	sch, ctx := setupTestEnvironment(t)
+
+	hwp := envtestutil.NewHardwareProfile(testHardwareProfile, testNamespace,
+		envtestutil.WithCPUIdentifier("4", "2"),
+	)
+	hwp.Status.Conditions = []common.Condition{{
+		Type:    status.ConditionHardwareProfileValid,
+		Status:  metav1.ConditionFalse,
+		Reason:  status.InvalidConfigReason,
+		Message: "identifier cpu: default count 2 is lower than the minimum count 4",
+	}}
+
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(hwp).Build()
+	injector := createWebhookInjector(cli, sch)
+
+	req := envtestutil.NewAdmissionRequest(
+		t,
+		admissionv1.Create,
+		envtestutil.NewNotebook(testNotebook, testNamespace, envtestutil.WithHardwareProfile(testHardwareProfile)),
+		gvk.Notebook,
+		metav1.GroupVersionResource{
+			Group:    gvk.Notebook.Group,
+			Version:  gvk.Notebook.Version,
+			Resource: "notebooks",
+		},
+	)
+
+	resp := injector.Handle(ctx, req)
+	g.Expect(resp.Allowed).Should(BeFalse())
+	g.Expect(resp.Result.Message).Should(ContainSubstring("default count 2 is lower than the minimum count 4"))
+}
+
 // TestHardwareProfile_AppliesKueueConfiguration tests that hardware profiles with Kueue configuration are applied correctly.
 func TestHardwareProfile_AppliesKueueConfiguration(t *testing.T) {
 	t.Parallel()
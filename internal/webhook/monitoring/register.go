@@ -0,0 +1,20 @@
+//go:build !nowebhook
+
+package monitoring
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// RegisterWebhooks registers the webhooks for Monitoring.
+func RegisterWebhooks(mgr ctrl.Manager) error {
+	if err := (&Validator{
+		Name:    "monitoring-validating",
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+//go:build !nowebhook
+
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	monitoringctrl "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+//+kubebuilder:webhook:path=/validate-monitoring,matchPolicy=Exact,mutating=false,failurePolicy=fail,sideEffects=None,groups=services.platform.opendatahub.io,resources=monitorings,verbs=create;update,versions=v1alpha1,name=monitoring-validator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// Validator implements webhook.AdmissionHandler for Monitoring validation webhooks.
+// It validates custom exporter configurations so errors surface at apply time instead of reconcile time.
+type Validator struct {
+	Name    string
+	Decoder admission.Decoder
+}
+
+// Assert that Validator implements admission.Handler interface.
+var _ admission.Handler = &Validator{}
+
+// SetupWithManager registers the validating webhook with the provided controller-runtime manager.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager to register the webhook with.
+//
+// Returns:
+//   - error: Always nil (for future extensibility).
+func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/validate-monitoring", &webhook.Admission{
+		Handler:        v,
+		LogConstructor: webhookutils.NewWebhookLogConstructor(v.Name),
+	})
+	return nil
+}
+
+// Handle processes admission requests for create and update operations on Monitoring resources.
+// It rejects reserved or malformed custom exporters, allowing other operations by default.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the operation and object details.
+//
+// Returns:
+//   - admission.Response: The result of the admission check, indicating whether the operation is allowed or denied.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if v.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+	}
+
+	monitoring := &serviceApi.Monitoring{}
+	if err := v.Decoder.DecodeRaw(req.Object, monitoring); err != nil {
+		log.Error(err, "Error converting request object to "+gvk.Monitoring.String())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := ValidateMonitoring(monitoring); len(errs) > 0 {
+		return deniedWithFieldErrors(monitoring, errs)
+	}
+
+	return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+}
+
+// ValidateMonitoring validates the custom exporters and processors of a Monitoring resource.
+//
+// Parameters:
+//   - monitoring: The Monitoring resource to validate.
+//
+// Returns:
+//   - field.ErrorList: The validation errors, empty if the resource is valid.
+func ValidateMonitoring(monitoring *serviceApi.Monitoring) field.ErrorList {
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if metrics := monitoring.Spec.Metrics; metrics != nil {
+		errs = append(errs, validateExporters(specPath.Child("metrics", "exporters"), metrics.Exporters)...)
+		errs = append(errs, validateProcessors(specPath.Child("metrics", "processors"), metrics.Processors)...)
+		errs = append(errs, validateProcessorOrder(specPath.Child("metrics", "processorOrder"), metrics.ProcessorOrder, metrics.Processors)...)
+	}
+
+	if traces := monitoring.Spec.Traces; traces != nil {
+		errs = append(errs, validateExporters(specPath.Child("traces", "exporters"), traces.Exporters)...)
+		errs = append(errs, validateProcessors(specPath.Child("traces", "processors"), traces.Processors)...)
+		errs = append(errs, validateProcessorOrder(specPath.Child("traces", "processorOrder"), traces.ProcessorOrder, traces.Processors)...)
+	}
+
+	if logs := monitoring.Spec.Logs; logs != nil {
+		errs = append(errs, validateExporters(specPath.Child("logs", "exporters"), logs.Exporters)...)
+	}
+
+	return errs
+}
+
+// validateExporters validates each exporter in sorted order so that errors are reported deterministically.
+func validateExporters(fldPath *field.Path, exporters map[string]runtime.RawExtension) field.ErrorList {
+	return validateComponents(fldPath, exporters, monitoringctrl.ValidateExporter)
+}
+
+// validateProcessors validates each processor in sorted order so that errors are reported deterministically.
+func validateProcessors(fldPath *field.Path, processors map[string]runtime.RawExtension) field.ErrorList {
+	return validateComponents(fldPath, processors, monitoringctrl.ValidateProcessor)
+}
+
+// validateProcessorOrder validates that the processor order only lists configured processors.
+func validateProcessorOrder(fldPath *field.Path, order []string, processors map[string]runtime.RawExtension) field.ErrorList {
+	if _, err := monitoringctrl.OrderProcessors(order, processors); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, order, err.Error())}
+	}
+
+	return nil
+}
+
+func validateComponents(
+	fldPath *field.Path,
+	configs map[string]runtime.RawExtension,
+	validate func(name string, rawConfig runtime.RawExtension) (string, error),
+) field.ErrorList {
+	var errs field.ErrorList
+
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err := validate(name, configs[name]); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Key(name), name, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+// deniedWithFieldErrors builds a denied admission response carrying the structured field errors.
+func deniedWithFieldErrors(monitoring *serviceApi.Monitoring, errs field.ErrorList) admission.Response {
+	statusErr := k8serr.NewInvalid(gvk.Monitoring.GroupKind(), monitoring.GetName(), errs)
+
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &statusErr.ErrStatus,
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	monitoringwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newMonitoring(metricsExporters, tracesExporters map[string]runtime.RawExtension) *serviceApi.Monitoring {
+	m := &serviceApi.Monitoring{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: serviceApi.GroupVersion.String(),
+			Kind:       serviceApi.MonitoringKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.MonitoringInstanceName,
+		},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "opendatahub",
+			},
+		},
+	}
+
+	if metricsExporters != nil {
+		m.Spec.Metrics = &serviceApi.Metrics{Exporters: metricsExporters}
+	}
+	if tracesExporters != nil {
+		m.Spec.Traces = &serviceApi.Traces{Exporters: tracesExporters}
+	}
+
+	return m
+}
+
+// TestMonitoring_ValidatingWebhook exercises the validating webhook logic for Monitoring custom exporters.
+func TestMonitoring_ValidatingWebhook(t *testing.T) {
+	t.Parallel()
+
+	gvr := metav1.GroupVersionResource{
+		Group:    gvk.Monitoring.Group,
+		Version:  gvk.Monitoring.Version,
+		Resource: "monitorings",
+	}
+
+	cases := []struct {
+		name          string
+		operation     admissionv1.Operation
+		monitoring    *serviceApi.Monitoring
+		allowed       bool
+		expectedField string
+	}{
+		{
+			name:       "Allows monitoring without exporters",
+			operation:  admissionv1.Create,
+			monitoring: newMonitoring(nil, nil),
+			allowed:    true,
+		},
+		{
+			name:      "Allows valid custom exporters",
+			operation: admissionv1.Create,
+			monitoring: newMonitoring(
+				map[string]runtime.RawExtension{"otlphttp/custom": {Raw: []byte(`{"endpoint":"https://metrics.example.com"}`)}},
+				map[string]runtime.RawExtension{"debug": {Raw: []byte(`{"verbosity":"detailed"}`)}},
+			),
+			allowed: true,
+		},
+		{
+			name:          "Denies reserved metrics exporter name",
+			operation:     admissionv1.Create,
+			monitoring:    newMonitoring(map[string]runtime.RawExtension{"prometheus": {Raw: []byte(`{}`)}}, nil),
+			allowed:       false,
+			expectedField: "spec.metrics.exporters[prometheus]",
+		},
+		{
+			name:          "Denies reserved traces exporter name on update",
+			operation:     admissionv1.Update,
+			monitoring:    newMonitoring(nil, map[string]runtime.RawExtension{"otlp/tempo": {Raw: []byte(`{}`)}}),
+			allowed:       false,
+			expectedField: "spec.traces.exporters[otlp/tempo]",
+		},
+		{
+			name:          "Denies traces exporter name of a built-in traces backend",
+			operation:     admissionv1.Create,
+			monitoring:    newMonitoring(nil, map[string]runtime.RawExtension{"otlp/jaeger": {Raw: []byte(`{}`)}}),
+			allowed:       false,
+			expectedField: "spec.traces.exporters[otlp/jaeger]",
+		},
+		{
+			name:          "Denies malformed exporter config",
+			operation:     admissionv1.Create,
+			monitoring:    newMonitoring(map[string]runtime.RawExtension{"otlp/custom": {Raw: []byte(`"endpoint: [unterminated"`)}}, nil),
+			allowed:       false,
+			expectedField: "spec.metrics.exporters[otlp/custom]",
+		},
+		{
+			name:          "Denies exporter failing schema validation",
+			operation:     admissionv1.Create,
+			monitoring:    newMonitoring(map[string]runtime.RawExtension{"otlp/custom": {Raw: []byte(`{"compression":"gzip"}`)}}, nil),
+			allowed:       false,
+			expectedField: "spec.metrics.exporters[otlp/custom]",
+		},
+		{
+			name:      "Denies reserved logs exporter name",
+			operation: admissionv1.Create,
+			monitoring: func() *serviceApi.Monitoring {
+				m := newMonitoring(nil, nil)
+				m.Spec.Logs = &serviceApi.Logs{Exporters: map[string]runtime.RawExtension{"prometheus": {Raw: []byte(`{}`)}}}
+				return m
+			}(),
+			allowed:       false,
+			expectedField: "spec.logs.exporters[prometheus]",
+		},
+		{
+			name:      "Denies reserved processor name",
+			operation: admissionv1.Create,
+			monitoring: func() *serviceApi.Monitoring {
+				m := newMonitoring(map[string]runtime.RawExtension{}, nil)
+				m.Spec.Metrics.Processors = map[string]runtime.RawExtension{"batch": {Raw: []byte(`{}`)}}
+				return m
+			}(),
+			allowed:       false,
+			expectedField: "spec.metrics.processors[batch]",
+		},
+		{
+			name:      "Denies processor order listing an unknown processor",
+			operation: admissionv1.Create,
+			monitoring: func() *serviceApi.Monitoring {
+				m := newMonitoring(map[string]runtime.RawExtension{}, nil)
+				m.Spec.Metrics.Processors = map[string]runtime.RawExtension{"attributes/env": {Raw: []byte(`{}`)}}
+				m.Spec.Metrics.ProcessorOrder = []string{"filter/drop-debug"}
+				return m
+			}(),
+			allowed:       false,
+			expectedField: "spec.metrics.processorOrder",
+		},
+		{
+			name:       "Allows deletion always",
+			operation:  admissionv1.Delete,
+			monitoring: newMonitoring(map[string]runtime.RawExtension{"prometheus": {Raw: []byte(`{}`)}}, nil),
+			allowed:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			sch, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			validator := &monitoringwebhook.Validator{
+				Name:    "test-monitoring",
+				Decoder: admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(t, tc.operation, tc.monitoring, gvk.Monitoring, gvr)
+			resp := validator.Handle(t.Context(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+
+			if !tc.allowed {
+				g.Expect(resp.Result).ToNot(BeNil())
+				g.Expect(resp.Result.Details).ToNot(BeNil())
+				g.Expect(resp.Result.Details.Causes).ToNot(BeEmpty())
+				g.Expect(resp.Result.Details.Causes[0].Field).To(Equal(tc.expectedField))
+			}
+		})
+	}
+}
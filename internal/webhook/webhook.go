@@ -12,6 +12,7 @@ import (
 	dsciv2webhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/dscinitialization/v2"
 	hardwareprofilewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
 	kueuewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/kueue"
+	monitoringwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/monitoring"
 	notebookwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/notebook"
 	serving "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/serving"
 )
@@ -29,6 +30,7 @@ func RegisterAllWebhooks(mgr ctrl.Manager) error {
 		serving.RegisterWebhooks,
 		notebookwebhook.RegisterWebhooks,
 		dashboard.RegisterWebhooks,
+		monitoringwebhook.RegisterWebhooks,
 	}
 	for _, reg := range webhookRegistrations {
 		if err := reg(mgr); err != nil {
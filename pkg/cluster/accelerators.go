@@ -0,0 +1,123 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// acceleratorVendor describes how the accelerators of a vendor are detected: the extended resources
+// advertised by its device plugin and the kind of the resources managed by its operator.
+type acceleratorVendor struct {
+	vendor        common.AcceleratorVendor
+	resourceNames []string
+	operator      schema.GroupVersionKind
+}
+
+var acceleratorVendors = []acceleratorVendor{
+	{
+		vendor:        common.AcceleratorVendorNVIDIA,
+		resourceNames: []string{"nvidia.com/gpu"},
+		operator:      gvk.NvidiaClusterPolicy,
+	},
+	{
+		vendor:        common.AcceleratorVendorAMD,
+		resourceNames: []string{"amd.com/gpu"},
+		operator:      gvk.AMDDeviceConfig,
+	},
+	{
+		vendor:        common.AcceleratorVendorHabana,
+		resourceNames: []string{"habana.ai/gaudi"},
+		operator:      gvk.HabanaClusterPolicy,
+	},
+	{
+		vendor:        common.AcceleratorVendorIntel,
+		resourceNames: []string{"gpu.intel.com/i915", "gpu.intel.com/xe"},
+		operator:      gvk.IntelGpuDevicePlugin,
+	},
+}
+
+// DetectAccelerators detects the accelerators of the known vendors on the cluster, from the extended
+// resources allocatable on the nodes and the CRDs of the operators of the vendors. The vendors of
+// which neither accelerators nor operator are found are not reported.
+func DetectAccelerators(ctx context.Context, cli client.Client) ([]common.Accelerator, error) {
+	nodes := corev1.NodeList{}
+	if err := cli.List(ctx, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	accelerators := make([]common.Accelerator, 0, len(acceleratorVendors))
+
+	for _, v := range acceleratorVendors {
+		installed, err := HasCRD(ctx, cli, v.operator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if %s CRD exists: %w", v.operator, err)
+		}
+
+		a := common.Accelerator{
+			Vendor:            v.vendor,
+			OperatorInstalled: installed,
+		}
+
+		for i := range nodes.Items {
+			advertised := false
+
+			for _, name := range v.resourceNames {
+				allocatable, ok := nodes.Items[i].Status.Allocatable[corev1.ResourceName(name)]
+				if !ok || allocatable.IsZero() {
+					continue
+				}
+
+				advertised = true
+				a.Count += allocatable.Value()
+
+				if !slices.Contains(a.ResourceNames, name) {
+					a.ResourceNames = append(a.ResourceNames, name)
+				}
+			}
+
+			if advertised {
+				a.Nodes++
+			}
+		}
+
+		if a.Nodes == 0 && !a.OperatorInstalled {
+			continue
+		}
+
+		slices.Sort(a.ResourceNames)
+		accelerators = append(accelerators, a)
+	}
+
+	return accelerators, nil
+}
+
+// Accelerators returns the accelerators detected on the cluster, as published in the DSCInitialization
+// status, none when the DSCInitialization is not found.
+func Accelerators(ctx context.Context, cli client.Client) ([]common.Accelerator, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	return dsci.Status.Accelerators, nil
+}
+
+// HasAcceleratorResource returns whether one of the accelerators advertises the given extended
+// resource on the nodes.
+func HasAcceleratorResource(accelerators []common.Accelerator, resourceName string) bool {
+	return slices.ContainsFunc(accelerators, func(a common.Accelerator) bool {
+		return slices.Contains(a.ResourceNames, resourceName)
+	})
+}
@@ -0,0 +1,102 @@
+package cluster_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newAcceleratorNode(name string, allocatable corev1.ResourceList) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{Allocatable: allocatable},
+	}
+}
+
+func TestDetectAccelerators(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("should report the accelerators allocatable on the nodes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(
+			newAcceleratorNode("gpu-1", corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}),
+			newAcceleratorNode("gpu-2", corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")}),
+			newAcceleratorNode("intel-1", corev1.ResourceList{
+				"gpu.intel.com/i915": resource.MustParse("1"),
+				"gpu.intel.com/xe":   resource.MustParse("1"),
+			}),
+			newAcceleratorNode("amd-1", corev1.ResourceList{"amd.com/gpu": resource.MustParse("0")}),
+			newAcceleratorNode("cpu-1", corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}),
+		))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		accelerators, err := cluster.DetectAccelerators(ctx, cli)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(accelerators).Should(Equal([]common.Accelerator{
+			{
+				Vendor:        common.AcceleratorVendorNVIDIA,
+				ResourceNames: []string{"nvidia.com/gpu"},
+				Nodes:         2,
+				Count:         6,
+			},
+			{
+				Vendor:        common.AcceleratorVendorIntel,
+				ResourceNames: []string{"gpu.intel.com/i915", "gpu.intel.com/xe"},
+				Nodes:         1,
+				Count:         2,
+			},
+		}))
+	})
+
+	t.Run("should report the operators installed without accelerators", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s, err := scheme.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		s.AddKnownTypeWithName(gvk.AMDDeviceConfig, &unstructured.Unstructured{})
+
+		cli, err := fakeclient.New(fakeclient.WithScheme(s))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		m, err := cli.RESTMapper().RESTMapping(gvk.AMDDeviceConfig.GroupKind(), gvk.AMDDeviceConfig.Version)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		crd := mocks.NewMockCRD(gvk.AMDDeviceConfig.Group, gvk.AMDDeviceConfig.Version, gvk.AMDDeviceConfig.Kind, "amd")
+		crd.Name = m.Resource.GroupResource().String()
+		crd.Status.StoredVersions = []string{gvk.AMDDeviceConfig.Version}
+		g.Expect(cli.Create(ctx, crd)).Should(Succeed())
+
+		accelerators, err := cluster.DetectAccelerators(ctx, cli)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(accelerators).Should(Equal([]common.Accelerator{{
+			Vendor:            common.AcceleratorVendorAMD,
+			OperatorInstalled: true,
+		}}))
+	})
+}
+
+func TestHasAcceleratorResource(t *testing.T) {
+	g := NewWithT(t)
+
+	accelerators := []common.Accelerator{{
+		Vendor:        common.AcceleratorVendorNVIDIA,
+		ResourceNames: []string{"nvidia.com/gpu"},
+	}}
+
+	g.Expect(cluster.HasAcceleratorResource(accelerators, "nvidia.com/gpu")).Should(BeTrue())
+	g.Expect(cluster.HasAcceleratorResource(accelerators, "amd.com/gpu")).Should(BeFalse())
+	g.Expect(cluster.HasAcceleratorResource(nil, "nvidia.com/gpu")).Should(BeFalse())
+}
@@ -0,0 +1,50 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ArchitectureAMD64 is the architecture the images of the components are built for by default.
+const ArchitectureAMD64 = "amd64"
+
+// DetectArchitectures returns the sorted CPU architectures of the nodes of the cluster, as set in
+// their kubernetes.io/arch label.
+func DetectArchitectures(ctx context.Context, cli client.Client) ([]string, error) {
+	nodes := corev1.NodeList{}
+	if err := cli.List(ctx, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	architectures := make([]string, 0, 1)
+
+	for i := range nodes.Items {
+		arch := nodes.Items[i].Labels[corev1.LabelArchStable]
+		if arch != "" && !slices.Contains(architectures, arch) {
+			architectures = append(architectures, arch)
+		}
+	}
+
+	slices.Sort(architectures)
+
+	return architectures, nil
+}
+
+// Architectures returns the CPU architectures of the nodes of the cluster, as published in the
+// DSCInitialization status, none when the DSCInitialization is not found.
+func Architectures(ctx context.Context, cli client.Client) ([]string, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	return dsci.Status.Architectures, nil
+}
@@ -27,9 +27,10 @@ import (
 )
 
 type ClusterInfo struct {
-	Type        string                  `json:"type,omitempty"` // openshift , TODO: can be other value if we later support other type
-	Version     version.OperatorVersion `json:"version,omitempty"`
-	FipsEnabled bool                    `json:"fips_enabled,omitempty"`
+	Type               string                  `json:"type,omitempty"` // ClusterTypeOpenShift or ClusterTypeKubernetes
+	Version            version.OperatorVersion `json:"version,omitempty"`
+	FipsEnabled        bool                    `json:"fips_enabled,omitempty"`
+	HostedControlPlane bool                    `json:"hosted_control_plane,omitempty"`
 }
 
 var clusterConfig struct {
@@ -102,7 +103,26 @@ func GetClusterInfo() ClusterInfo {
 	return clusterConfig.ClusterInfo
 }
 
+// IsOpenShift returns false when the operator runs on a Kubernetes cluster other than OpenShift, in
+// which case the OpenShift specific resources are replaced with their Kubernetes equivalent.
+func IsOpenShift() bool {
+	return clusterConfig.ClusterInfo.Type != ClusterTypeKubernetes
+}
+
+// IsHostedControlPlane returns true when the control plane of the OpenShift cluster is hosted
+// outside of it, e.g. by HyperShift, in which case the cluster-scoped resources managed by the
+// hosting cluster, such as the MachineConfigs, are not available.
+func IsHostedControlPlane() bool {
+	return clusterConfig.ClusterInfo.HostedControlPlane
+}
+
+// GetDomain returns the domain of the OpenShift cluster ingress. Kubernetes clusters have no such
+// domain, so the domain of the data science Gateway must be set in the GatewayConfig.
 func GetDomain(ctx context.Context, c client.Client) (string, error) {
+	if !IsOpenShift() {
+		return "", errors.New("the cluster domain can't be detected on Kubernetes, it must be set in the GatewayConfig")
+	}
+
 	ingress := &unstructured.Unstructured{}
 	ingress.SetGroupVersionKind(gvk.OpenshiftIngress)
 
@@ -296,9 +316,20 @@ func getClusterInfo(ctx context.Context, cli client.Client) (ClusterInfo, error)
 		Version: version.OperatorVersion{
 			Version: semver.Version{},
 		},
-		Type:        "OpenShift",
+		Type:        ClusterTypeOpenShift,
 		FipsEnabled: false,
 	}
+
+	// The ClusterVersion API is served by all the OpenShift clusters only
+	isOpenShift, err := HasCRD(ctx, cli, gvk.ClusterVersion)
+	if err != nil {
+		return c, fmt.Errorf("failed to detect the cluster type: %w", err)
+	}
+	if !isOpenShift {
+		c.Type = ClusterTypeKubernetes
+		return c, nil
+	}
+
 	// Set OCP
 	ocpVersion, err := getOCPVersion(ctx, cli)
 	if err != nil {
@@ -313,9 +344,27 @@ func getClusterInfo(ctx context.Context, cli client.Client) (ClusterInfo, error)
 		logf.FromContext(ctx).Info("could not determine FIPS status, defaulting to false", "error", err)
 	}
 
+	// Check for a hosted control plane
+	if hosted, err := isHostedControlPlane(ctx, cli); err == nil {
+		c.HostedControlPlane = hosted
+	} else {
+		logf.FromContext(ctx).Info("could not determine the control plane topology, defaulting to self-managed", "error", err)
+	}
+
 	return c, nil
 }
 
+// isHostedControlPlane checks the control plane topology of the Infrastructure, External when
+// the control plane is hosted outside of the cluster.
+func isHostedControlPlane(ctx context.Context, cli client.Client) (bool, error) {
+	infra := &configv1.Infrastructure{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: "cluster"}, infra); err != nil {
+		return false, err
+	}
+
+	return infra.Status.ControlPlaneTopology == configv1.ExternalTopologyMode, nil
+}
+
 func IsFipsEnabled(ctx context.Context, cli client.Client) (bool, error) {
 	// Check the install-config for the fips flag and it's value
 	// https://access.redhat.com/solutions/6525331
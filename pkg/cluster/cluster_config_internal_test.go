@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	configv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -11,6 +12,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 )
 
 func TestGetApplicationNamespace(t *testing.T) {
@@ -213,3 +219,135 @@ func TestSetApplicationNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClusterInfo(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("Detects Kubernetes without the ClusterVersion API", func(t *testing.T) {
+		cli, err := fakeclient.New()
+		if err != nil {
+			t.Fatalf("Failed to create fake client: %v", err)
+		}
+
+		info, err := getClusterInfo(ctx, cli)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if info.Type != ClusterTypeKubernetes {
+			t.Errorf("Cluster type = %q, want %q", info.Type, ClusterTypeKubernetes)
+		}
+	})
+
+	// openShiftClient returns a client of an OpenShift cluster, serving the ClusterVersion API
+	openShiftClient := func(t *testing.T, objs ...client.Object) client.Client {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(append(objs, &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: OpenShiftVersionObj},
+			Status: configv1.ClusterVersionStatus{
+				History: []configv1.UpdateHistory{{Version: "4.19.1"}},
+			},
+		})...))
+		if err != nil {
+			t.Fatalf("Failed to create fake client: %v", err)
+		}
+
+		m, err := cli.RESTMapper().RESTMapping(gvk.ClusterVersion.GroupKind(), gvk.ClusterVersion.Version)
+		if err != nil {
+			t.Fatalf("Failed to get the REST mapping of %s: %v", gvk.ClusterVersion.Kind, err)
+		}
+
+		crd := mocks.NewMockCRD(gvk.ClusterVersion.Group, gvk.ClusterVersion.Version, gvk.ClusterVersion.Kind, "openshift")
+		crd.Name = m.Resource.GroupResource().String()
+		crd.Status.StoredVersions = []string{gvk.ClusterVersion.Version}
+		if err := cli.Create(ctx, crd); err != nil {
+			t.Fatalf("Failed to create CRD %s: %v", crd.Name, err)
+		}
+
+		return cli
+	}
+
+	t.Run("Detects OpenShift with the ClusterVersion API", func(t *testing.T) {
+		cli := openShiftClient(t)
+
+		info, err := getClusterInfo(ctx, cli)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if info.Type != ClusterTypeOpenShift {
+			t.Errorf("Cluster type = %q, want %q", info.Type, ClusterTypeOpenShift)
+		}
+		if info.Version.String() != "4.19.1" {
+			t.Errorf("Cluster version = %q, want %q", info.Version.String(), "4.19.1")
+		}
+		if info.HostedControlPlane {
+			t.Errorf("Hosted control plane detected without the Infrastructure")
+		}
+	})
+
+	t.Run("Detects a hosted control plane with the External topology", func(t *testing.T) {
+		for topology, want := range map[configv1.TopologyMode]bool{
+			configv1.ExternalTopologyMode:        true,
+			configv1.HighlyAvailableTopologyMode: false,
+			configv1.SingleReplicaTopologyMode:   false,
+		} {
+			cli := openShiftClient(t, &configv1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Status:     configv1.InfrastructureStatus{ControlPlaneTopology: topology},
+			})
+
+			info, err := getClusterInfo(ctx, cli)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if info.HostedControlPlane != want {
+				t.Errorf("Hosted control plane = %v for the %s topology, want %v", info.HostedControlPlane, topology, want)
+			}
+		}
+	})
+}
+
+func TestKubernetesDefaults(t *testing.T) {
+	ctx := t.Context()
+
+	clusterConfig.ClusterInfo.Type = ClusterTypeKubernetes
+	defer func() {
+		clusterConfig.ClusterInfo.Type = ""
+	}()
+
+	cli, err := fakeclient.New()
+	if err != nil {
+		t.Fatalf("Failed to create fake client: %v", err)
+	}
+
+	if IsOpenShift() {
+		t.Errorf("IsOpenShift() = true, want false")
+	}
+
+	ingress, err := IngressType(ctx, cli)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ingress != infrav1.IngressTypeIngress {
+		t.Errorf("Ingress type = %q, want %q", ingress, infrav1.IngressTypeIngress)
+	}
+
+	profile, err := PodSecurityProfile(ctx, cli)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if profile != infrav1.PodSecurityProfileRestricted {
+		t.Errorf("Pod Security profile = %q, want %q", profile, infrav1.PodSecurityProfileRestricted)
+	}
+
+	if err := cli.Create(ctx, &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{IngressType: infrav1.IngressTypeRoute},
+	}); err != nil {
+		t.Fatalf("Failed to create DSCInitialization: %v", err)
+	}
+
+	if _, err := IngressType(ctx, cli); err == nil {
+		t.Errorf("Expected an error for the route ingress type on Kubernetes")
+	}
+}
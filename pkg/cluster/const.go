@@ -10,6 +10,11 @@ const (
 	// OpenDataHub defines display name in csv.
 	OpenDataHub common.Platform = "Open Data Hub"
 
+	// ClusterTypeOpenShift is the type of the OpenShift clusters.
+	ClusterTypeOpenShift = "OpenShift"
+	// ClusterTypeKubernetes is the type of the Kubernetes clusters other than OpenShift, e.g. EKS, GKE or AKS.
+	ClusterTypeKubernetes = "Kubernetes"
+
 	// DefaultNotebooksNamespaceODH defines default namespace for notebooks.
 	DefaultNotebooksNamespaceODH = "opendatahub"
 	// DefaultNotebooksNamespaceRHOAI defines default namespace for notebooks.
@@ -23,6 +28,9 @@ const (
 	// Default cluster-scope Authentication CR name.
 	ClusterAuthenticationObj = "cluster"
 
+	// Default cluster-scope Proxy CR name.
+	ClusterProxyObj = "cluster"
+
 	// Default OpenShift version CR name.
 	OpenShiftVersionObj = "version"
 
@@ -2,13 +2,18 @@ package gvk
 
 import (
 	configv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
+	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	templatev1 "github.com/openshift/api/template/v1"
 	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -175,12 +180,54 @@ var (
 		Kind:    "ConfigMap",
 	}
 
+	PersistentVolumeClaim = schema.GroupVersionKind{
+		Group:   corev1.SchemeGroupVersion.Group,
+		Version: corev1.SchemeGroupVersion.Version,
+		Kind:    "PersistentVolumeClaim",
+	}
+
 	Service = schema.GroupVersionKind{
 		Group:   corev1.SchemeGroupVersion.Group,
 		Version: corev1.SchemeGroupVersion.Version,
 		Kind:    "Service",
 	}
 
+	ImageStream = schema.GroupVersionKind{
+		Group:   imagev1.GroupVersion.Group,
+		Version: imagev1.GroupVersion.Version,
+		Kind:    "ImageStream",
+	}
+
+	ClusterProxy = schema.GroupVersionKind{
+		Group:   configv1.SchemeGroupVersion.Group,
+		Version: configv1.SchemeGroupVersion.Version,
+		Kind:    "Proxy",
+	}
+
+	ImageDigestMirrorSet = schema.GroupVersionKind{
+		Group:   configv1.SchemeGroupVersion.Group,
+		Version: configv1.SchemeGroupVersion.Version,
+		Kind:    "ImageDigestMirrorSet",
+	}
+
+	ImageTagMirrorSet = schema.GroupVersionKind{
+		Group:   configv1.SchemeGroupVersion.Group,
+		Version: configv1.SchemeGroupVersion.Version,
+		Kind:    "ImageTagMirrorSet",
+	}
+
+	ImageContentSourcePolicy = schema.GroupVersionKind{
+		Group:   "operator.openshift.io",
+		Version: "v1alpha1",
+		Kind:    "ImageContentSourcePolicy",
+	}
+
+	Template = schema.GroupVersionKind{
+		Group:   templatev1.GroupVersion.Group,
+		Version: templatev1.GroupVersion.Version,
+		Kind:    "Template",
+	}
+
 	Route = schema.GroupVersionKind{
 		Group:   routev1.SchemeGroupVersion.Group,
 		Version: routev1.SchemeGroupVersion.Version,
@@ -265,6 +312,12 @@ var (
 		Kind:    componentApi.KserveKind,
 	}
 
+	LlamaStackDistribution = schema.GroupVersionKind{
+		Group:   "llamastack.io",
+		Version: "v1alpha1",
+		Kind:    "LlamaStackDistribution",
+	}
+
 	LlamaStackOperator = schema.GroupVersionKind{
 		Group:   componentApi.GroupVersion.Group,
 		Version: componentApi.GroupVersion.Version,
@@ -367,6 +420,30 @@ var (
 		Kind:    serviceApi.AuthKind,
 	}
 
+	OperatorConfig = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.OperatorConfigKind,
+	}
+
+	Uninstall = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.UninstallKind,
+	}
+
+	DiagnosticBundle = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.DiagnosticBundleKind,
+	}
+
+	ManifestExport = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.ManifestExportKind,
+	}
+
 	HTTPRoute = schema.GroupVersionKind{
 		Group:   gwapiv1.GroupVersion.Group,
 		Version: gwapiv1.GroupVersion.Version,
@@ -415,6 +492,30 @@ var (
 		Kind:    "ResourceFlavor",
 	}
 
+	ModelRegistryInstance = schema.GroupVersionKind{
+		Group:   "modelregistry.opendatahub.io",
+		Version: "v1alpha1",
+		Kind:    "ModelRegistry",
+	}
+
+	FeatureStore = schema.GroupVersionKind{
+		Group:   "feast.dev",
+		Version: "v1alpha1",
+		Kind:    "FeatureStore",
+	}
+
+	KnativeService = schema.GroupVersionKind{
+		Group:   "serving.knative.dev",
+		Version: "v1",
+		Kind:    "Service",
+	}
+
+	KnativeServing = schema.GroupVersionKind{
+		Group:   "operator.knative.dev",
+		Version: "v1beta1",
+		Kind:    "KnativeServing",
+	}
+
 	InferenceServices = schema.GroupVersionKind{
 		Group:   "serving.kserve.io",
 		Version: "v1beta1",
@@ -427,6 +528,12 @@ var (
 		Kind:    "ServingRuntime",
 	}
 
+	ClusterServingRuntime = schema.GroupVersionKind{
+		Group:   "serving.kserve.io",
+		Version: "v1alpha1",
+		Kind:    "ClusterServingRuntime",
+	}
+
 	Notebook = schema.GroupVersionKind{
 		Group:   "kubeflow.org",
 		Version: "v1",
@@ -469,6 +576,30 @@ var (
 		Kind:    "NetworkPolicy",
 	}
 
+	Ingress = schema.GroupVersionKind{
+		Group:   networkingv1.SchemeGroupVersion.Group,
+		Version: networkingv1.SchemeGroupVersion.Version,
+		Kind:    "Ingress",
+	}
+
+	PodDisruptionBudget = schema.GroupVersionKind{
+		Group:   policyv1.SchemeGroupVersion.Group,
+		Version: policyv1.SchemeGroupVersion.Version,
+		Kind:    "PodDisruptionBudget",
+	}
+
+	ConsoleLink = schema.GroupVersionKind{
+		Group:   consolev1.GroupVersion.Group,
+		Version: consolev1.GroupVersion.Version,
+		Kind:    "ConsoleLink",
+	}
+
+	SecurityContextConstraints = schema.GroupVersionKind{
+		Group:   securityv1.GroupVersion.Group,
+		Version: securityv1.GroupVersion.Version,
+		Kind:    "SecurityContextConstraints",
+	}
+
 	MonitoringStack = schema.GroupVersionKind{
 		Group:   "monitoring.rhobs",
 		Version: "v1alpha1",
@@ -535,6 +666,12 @@ var (
 		Kind:    "ServiceMonitor",
 	}
 
+	PodMonitor = schema.GroupVersionKind{
+		Group:   "monitoring.rhobs",
+		Version: "v1",
+		Kind:    "PodMonitor",
+	}
+
 	PrometheusRule = schema.GroupVersionKind{
 		Group:   "monitoring.rhobs",
 		Version: "v1",
@@ -571,6 +708,12 @@ var (
 		Kind:    "PersesDashboard",
 	}
 
+	GrafanaDashboard = schema.GroupVersionKind{
+		Group:   "grafana.integreatly.org",
+		Version: "v1beta1",
+		Kind:    "GrafanaDashboard",
+	}
+
 	ValidatingAdmissionPolicy = schema.GroupVersionKind{
 		Group:   "admissionregistration.k8s.io",
 		Version: "v1",
@@ -582,4 +725,34 @@ var (
 		Version: "v1",
 		Kind:    "ValidatingAdmissionPolicyBinding",
 	}
+
+	NvidiaClusterPolicy = schema.GroupVersionKind{
+		Group:   "nvidia.com",
+		Version: "v1",
+		Kind:    "ClusterPolicy",
+	}
+
+	AMDDeviceConfig = schema.GroupVersionKind{
+		Group:   "amd.com",
+		Version: "v1alpha1",
+		Kind:    "DeviceConfig",
+	}
+
+	HabanaClusterPolicy = schema.GroupVersionKind{
+		Group:   "habanalabs.habana.ai",
+		Version: "v1",
+		Kind:    "ClusterPolicy",
+	}
+
+	IntelGpuDevicePlugin = schema.GroupVersionKind{
+		Group:   "deviceplugin.intel.com",
+		Version: "v1",
+		Kind:    "GpuDevicePlugin",
+	}
+
+	KfDef = schema.GroupVersionKind{
+		Group:   "kfdef.apps.kubeflow.org",
+		Version: "v1",
+		Kind:    "KfDef",
+	}
 )
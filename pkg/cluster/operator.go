@@ -58,7 +58,7 @@ func DeleteExistingSubscription(ctx context.Context, cli client.Client, operator
 // OperatorExists checks if an Operator with 'operatorPrefix' is installed.
 // Return true if found it, false if not.
 // if we need to check exact version of the operator installed, can append vX.Y.Z later.
-func OperatorExists(ctx context.Context, cli client.Client, operatorPrefix string) (bool, error) {
+func OperatorExists(ctx context.Context, cli client.Reader, operatorPrefix string) (bool, error) {
 	opConditionList := &ofapiv2.OperatorConditionList{}
 	err := cli.List(ctx, opConditionList)
 	if err != nil {
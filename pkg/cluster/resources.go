@@ -8,6 +8,7 @@ import (
 	"slices"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apihelpers"
@@ -21,10 +22,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
@@ -88,16 +91,22 @@ func GetSingleton[T client.Object](ctx context.Context, cli client.Client, obj T
 	}
 }
 
-// GetDSC retrieves the DataScienceCluster (DSC) instance from the Kubernetes cluster.
+// GetDSC retrieves the DataScienceCluster (DSC) instance from the Kubernetes cluster, i.e. the one
+// bound to the applications namespace of the DSCInitialization. The scoped DataScienceClusters,
+// bound to their own applications namespace, are ignored.
 func GetDSC(ctx context.Context, cli client.Reader) (*dscv2.DataScienceCluster, error) {
-	instances := dscv2.DataScienceClusterList{}
-	if err := cli.List(ctx, &instances); err != nil {
+	list := dscv2.DataScienceClusterList{}
+	if err := cli.List(ctx, &list); err != nil {
 		return nil, fmt.Errorf("failed to list resources of type %s: %w", gvk.DataScienceCluster, err)
 	}
 
-	switch len(instances.Items) {
+	instances := slices.DeleteFunc(list.Items, func(dsc dscv2.DataScienceCluster) bool {
+		return dsc.IsScoped()
+	})
+
+	switch len(instances) {
 	case 1:
-		return &instances.Items[0], nil
+		return &instances[0], nil
 	case 0:
 		return nil, k8serr.NewNotFound(
 			schema.GroupResource{
@@ -107,10 +116,30 @@ func GetDSC(ctx context.Context, cli client.Reader) (*dscv2.DataScienceCluster,
 			"",
 		)
 	default:
-		return nil, fmt.Errorf("failed to get a valid %s instance, expected to find 1 instance, found %d", gvk.DataScienceCluster, len(instances.Items))
+		return nil, fmt.Errorf("failed to get a valid %s instance, expected to find 1 instance, found %d", gvk.DataScienceCluster, len(instances))
 	}
 }
 
+// GetInstanceDSC retrieves the DataScienceCluster the given instance belongs to: the instance itself
+// when it is a DataScienceCluster, the one recorded in its labels.DataScienceCluster label, e.g. for the
+// components of a scoped DataScienceCluster, or the one returned by GetDSC.
+func GetInstanceDSC(ctx context.Context, cli client.Reader, obj client.Object) (*dscv2.DataScienceCluster, error) {
+	if dsc, ok := obj.(*dscv2.DataScienceCluster); ok && dsc != nil {
+		return dsc, nil
+	}
+
+	if obj == nil || obj.GetLabels()[labels.DataScienceCluster] == "" {
+		return GetDSC(ctx, cli)
+	}
+
+	dsc := dscv2.DataScienceCluster{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: obj.GetLabels()[labels.DataScienceCluster]}, &dsc); err != nil {
+		return nil, fmt.Errorf("failed to get DataScienceCluster %s: %w", obj.GetLabels()[labels.DataScienceCluster], err)
+	}
+
+	return &dsc, nil
+}
+
 // GetDSCI retrieves the DSCInitialization (DSCI) instance from the Kubernetes cluster.
 func GetDSCI(ctx context.Context, cli client.Client) (*dsciv2.DSCInitialization, error) {
 	instances := dsciv2.DSCInitializationList{}
@@ -147,6 +176,19 @@ func ApplicationNamespace(ctx context.Context, cli client.Client) (string, error
 	return dsci.Spec.ApplicationsNamespace, nil
 }
 
+// InstanceApplicationNamespace returns the applications namespace the resources of the given component
+// instance are deployed to: the one of the scoped DataScienceCluster the instance belongs to, recorded
+// in its labels.ApplicationsNamespace label, or the one of the DSCInitialization.
+func InstanceApplicationNamespace(ctx context.Context, cli client.Client, obj client.Object) (string, error) {
+	if obj != nil {
+		if ns := obj.GetLabels()[labels.ApplicationsNamespace]; ns != "" {
+			return ns, nil
+		}
+	}
+
+	return ApplicationNamespace(ctx, cli)
+}
+
 // MonitoringNamespace returns the monitoring namespace from DSCInitialization.
 // Returns an error if DSCI is not found or cannot be retrieved.
 func MonitoringNamespace(ctx context.Context, cli client.Client) (string, error) {
@@ -160,6 +202,242 @@ func MonitoringNamespace(ctx context.Context, cli client.Client) (string, error)
 	return dsci.Spec.Monitoring.Namespace, nil
 }
 
+// IngressType returns the ingress layer selected in DSCInitialization, empty when it is not set or
+// when DSCI is not found, except on Kubernetes where the components are then exposed with Ingresses.
+// Returns an error if Gateway API is selected but its CRDs are not installed, or if Routes are
+// selected on Kubernetes.
+func IngressType(ctx context.Context, cli client.Client) (infrav1.IngressType, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return unsetIngressType(), nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	switch dsci.Spec.IngressType {
+	case "":
+		return unsetIngressType(), nil
+	case infrav1.IngressTypeRoute:
+		if !IsOpenShift() {
+			return "", errors.New("ingress type route is selected but Routes are only available on OpenShift")
+		}
+	case infrav1.IngressTypeGatewayAPI:
+		found, err := HasGatewayAPI(ctx, cli)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return "", errors.New("ingress type gatewayapi is selected but the Gateway API CRDs are not installed")
+		}
+	}
+
+	return dsci.Spec.IngressType, nil
+}
+
+// DefaultIngressType returns the ingress layer exposing the components without the data science
+// Gateway: the OpenShift router on OpenShift, Ingresses otherwise.
+func DefaultIngressType() infrav1.IngressType {
+	if IsOpenShift() {
+		return infrav1.IngressTypeRoute
+	}
+
+	return infrav1.IngressTypeIngress
+}
+
+// unsetIngressType returns the ingress layer used when none is selected in the DSCInitialization.
+func unsetIngressType() infrav1.IngressType {
+	if IsOpenShift() {
+		return ""
+	}
+
+	return infrav1.IngressTypeIngress
+}
+
+// NetworkPolicyProfile returns the NetworkPolicy profile selected in the DSCInitialization, baseline
+// when none is selected or the DSCInitialization does not exist yet.
+func NetworkPolicyProfile(ctx context.Context, cli client.Client) (infrav1.NetworkPolicyProfile, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return infrav1.NetworkPolicyProfileBaseline, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	if dsci.Spec.NetworkPolicyProfile == "" {
+		return infrav1.NetworkPolicyProfileBaseline, nil
+	}
+
+	return dsci.Spec.NetworkPolicyProfile, nil
+}
+
+// PodSecurityProfile returns the Pod Security profile selected in the DSCInitialization, baseline
+// when none is selected or the DSCInitialization does not exist yet. On Kubernetes, where no
+// SecurityContextConstraints set the securityContext of the workloads, restricted is the default.
+func PodSecurityProfile(ctx context.Context, cli client.Client) (infrav1.PodSecurityProfile, error) {
+	defaultProfile := infrav1.PodSecurityProfileBaseline
+	if !IsOpenShift() {
+		defaultProfile = infrav1.PodSecurityProfileRestricted
+	}
+
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return defaultProfile, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	if dsci.Spec.PodSecurityProfile == "" {
+		return defaultProfile, nil
+	}
+
+	return dsci.Spec.PodSecurityProfile, nil
+}
+
+// DeploymentProfile returns the deployment profile selected in the DSCInitialization, default when
+// none is selected or the DSCInitialization does not exist yet.
+func DeploymentProfile(ctx context.Context, cli client.Client) (infrav1.DeploymentProfile, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return infrav1.DeploymentProfileDefault, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	if dsci.Spec.DeploymentProfile == "" {
+		return infrav1.DeploymentProfileDefault, nil
+	}
+
+	return dsci.Spec.DeploymentProfile, nil
+}
+
+// PriorityClasses returns the PriorityClasses assigned to the workloads deployed by the operator, as
+// set in the DSCInitialization, nil when none is set or the DSCInitialization does not exist yet.
+func PriorityClasses(ctx context.Context, cli client.Client) (*infrav1.PriorityClassesSpec, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	return dsci.Spec.PriorityClasses, nil
+}
+
+// DeployMode returns the deploy mode selected in the DataScienceCluster the given instance belongs to,
+// Apply when none is selected or the DataScienceCluster does not exist yet.
+func DeployMode(ctx context.Context, cli client.Reader, obj client.Object) (common.DeployMode, error) {
+	dsc, err := GetInstanceDSC(ctx, cli, obj)
+	switch {
+	case k8serr.IsNotFound(err):
+		return common.DeployModeApply, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get DataScienceCluster: %w", err)
+	}
+
+	if dsc.Spec.DeployMode == "" {
+		return common.DeployModeApply, nil
+	}
+
+	return dsc.Spec.DeployMode, nil
+}
+
+// ImageDigests returns how the digests of the images referenced by tag are resolved, as set in the
+// DataScienceCluster the given instance belongs to, nil when they are not resolved or the
+// DataScienceCluster does not exist yet.
+func ImageDigests(ctx context.Context, cli client.Reader, obj client.Object) (*common.ImageDigestsSpec, error) {
+	dsc, err := GetInstanceDSC(ctx, cli, obj)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DataScienceCluster: %w", err)
+	}
+
+	return dsc.Spec.ImageDigests, nil
+}
+
+// FIPSRequired returns true if the FIPS compliance selected in the DSCInitialization requires the
+// workloads to use FIPS-compliant images, which Auto does when the cluster is installed in FIPS mode.
+func FIPSRequired(ctx context.Context, cli client.Client) (bool, error) {
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	switch dsci.Spec.FIPSCompliance {
+	case infrav1.FIPSComplianceRequired:
+		return true, nil
+	case infrav1.FIPSComplianceAuto:
+		return GetClusterInfo().FipsEnabled, nil
+	default:
+		return false, nil
+	}
+}
+
+// GetProxy returns the proxy settings to inject in the workloads: the ones of the OpenShift cluster-wide
+// Proxy when configured, otherwise the ones set in the DSCInitialization. It returns nil when no proxy
+// is configured.
+func GetProxy(ctx context.Context, cli client.Client) (*infrav1.ProxySpec, error) {
+	found, err := HasCRD(ctx, cli, gvk.ClusterProxy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the %s CRD: %w", gvk.ClusterProxy.Kind, err)
+	}
+
+	if found {
+		proxy := configv1.Proxy{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: ClusterProxyObj}, &proxy); err != nil && !k8serr.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get the cluster Proxy: %w", err)
+		}
+
+		// the status holds the settings in effect, the NO_PROXY list
+		// including the cluster networks
+		if proxy.Status.HTTPProxy != "" || proxy.Status.HTTPSProxy != "" {
+			return &infrav1.ProxySpec{
+				HTTPProxy:  proxy.Status.HTTPProxy,
+				HTTPSProxy: proxy.Status.HTTPSProxy,
+				NoProxy:    proxy.Status.NoProxy,
+			}, nil
+		}
+	}
+
+	dsci, err := GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	if dsci.Spec.Proxy == nil || (dsci.Spec.Proxy.HTTPProxy == "" && dsci.Spec.Proxy.HTTPSProxy == "") {
+		return nil, nil
+	}
+
+	return dsci.Spec.Proxy.DeepCopy(), nil
+}
+
+// HasGatewayAPI checks if the Gateway API CRDs used to expose the components, Gateway and HTTPRoute, are installed.
+func HasGatewayAPI(ctx context.Context, cli client.Client) (bool, error) {
+	for _, g := range []schema.GroupVersionKind{gvk.KubernetesGateway, gvk.HTTPRoute} {
+		found, err := HasCRD(ctx, cli, g)
+		if err != nil {
+			return false, fmt.Errorf("failed to check if %s CRD exists: %w", g.Kind, err)
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 // GetHardwareProfile retrieves a specific HardwareProfile instance by name and namespace.
 func GetHardwareProfile(ctx context.Context, cli client.Client, name, namespace string) (*infrav1.HardwareProfile, error) {
 	hwProfile := &infrav1.HardwareProfile{}
@@ -334,7 +612,7 @@ func CreateWithRetry(ctx context.Context, cli client.Client, obj client.Object)
 	})
 }
 
-func GetCRD(ctx context.Context, cli client.Client, name string) (apiextensionsv1.CustomResourceDefinition, error) {
+func GetCRD(ctx context.Context, cli client.Reader, name string) (apiextensionsv1.CustomResourceDefinition, error) {
 	obj := apiextensionsv1.CustomResourceDefinition{}
 	err := cli.Get(ctx, client.ObjectKey{Name: name}, &obj)
 	if err != nil {
@@ -12,10 +12,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 
 	. "github.com/onsi/gomega"
@@ -144,6 +147,18 @@ func TestGetClusterSingletons(t *testing.T) {
 			err: errors.New("failed to get a valid datasciencecluster.opendatahub.io/v2, Kind=DataScienceCluster instance, expected to find 1 instance, found 2"),
 			fn:  dscFn,
 		},
+		{
+			name: "Scoped DataScienceCluster instances ignored",
+			objs: []client.Object{
+				&dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "dsc-1"}},
+				&dscv2.DataScienceCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+					Spec:       dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team-a"},
+				},
+			},
+			err: nil,
+			fn:  dscFn,
+		},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +182,69 @@ func TestGetClusterSingletons(t *testing.T) {
 	}
 }
 
+func TestGetInstanceDSC(t *testing.T) {
+	g := NewWithT(t)
+
+	unscoped := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+		Spec:       dscv2.DataScienceClusterSpec{GCPolicy: common.GCPolicyEnabled},
+	}
+	scoped := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-dsc"},
+		Spec: dscv2.DataScienceClusterSpec{
+			ApplicationsNamespace: "team",
+			GCPolicy:              common.GCPolicyDryRun,
+			DeployMode:            common.DeployModeGitOps,
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(unscoped, scoped))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	component := func(dsc string) client.Object {
+		obj := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+		if dsc != "" {
+			obj.SetLabels(map[string]string{labels.DataScienceCluster: dsc})
+		}
+
+		return obj
+	}
+
+	tests := []struct {
+		name     string
+		instance client.Object
+		expected string
+		mode     common.DeployMode
+		err      bool
+	}{
+		{name: "DataScienceCluster itself", instance: scoped, expected: scoped.Name, mode: common.DeployModeGitOps},
+		{name: "component of the scoped DataScienceCluster", instance: component(scoped.Name), expected: scoped.Name, mode: common.DeployModeGitOps},
+		{name: "component of the unscoped DataScienceCluster", instance: component(unscoped.Name), expected: unscoped.Name, mode: common.DeployModeApply},
+		{name: "unlabeled component", instance: component(""), expected: unscoped.Name, mode: common.DeployModeApply},
+		{name: "no instance", instance: nil, expected: unscoped.Name, mode: common.DeployModeApply},
+		{name: "missing DataScienceCluster", instance: component("deleted"), err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			dsc, err := cluster.GetInstanceDSC(ctx, cli, tt.instance)
+			if tt.err {
+				g.Expect(err).Should(MatchError(k8serr.IsNotFound, "IsNotFound"))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(dsc.Name).Should(Equal(tt.expected))
+
+			mode, err := cluster.DeployMode(ctx, cli, tt.instance)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(mode).Should(Equal(tt.mode))
+		})
+	}
+}
+
 func TestHasCRDWithVersion(t *testing.T) {
 	ctx := t.Context()
 
@@ -11,7 +11,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
-type CachingKeyFn func(rr *types.ReconciliationRequest) ([]byte, error)
+type CachingKeyFn func(ctx context.Context, rr *types.ReconciliationRequest) ([]byte, error)
 
 type Cacher[T any] struct {
 	cachingKeyFn    CachingKeyFn
@@ -24,7 +24,7 @@ func Zero[T any]() T {
 }
 
 // SetKeyFn installs the function which calculates hash of resource sources,
-// taking ReconciliationRequest as the argument and returning []byte
+// taking the context and the ReconciliationRequest as arguments and returning []byte
 // The returned hash MUST NOT be empty by the contract.
 func (s *Cacher[T]) SetKeyFn(key CachingKeyFn) {
 	s.cachingKeyFn = key
@@ -67,7 +67,7 @@ func (s *Cacher[T]) Render(ctx context.Context, rr *types.ReconciliationRequest,
 		return s.reRender(ctx, nil, rr, r)
 	}
 
-	cachingKey, err = s.cachingKeyFn(rr)
+	cachingKey, err = s.cachingKeyFn(ctx, rr)
 	if err != nil {
 		return Zero[T](), false, fmt.Errorf("unable to calculate caching key: %w", err)
 	}
@@ -51,8 +51,8 @@ func (r *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error
 		}
 
 		if namespaced {
-			// Fetch application namespace from DSCI.
-			appNamespace, nsErr := cluster.ApplicationNamespace(ctx, rr.Client)
+			// Fetch the application namespace of the instance, defaulting to the DSCI one.
+			appNamespace, nsErr := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 			if nsErr != nil {
 				return nsErr
 			}
@@ -7,16 +7,23 @@ import (
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/export"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -37,6 +44,8 @@ type Action struct {
 	labels      map[string]string
 	annotations map[string]string
 	cache       *Cache
+
+	resolveImage ImageResolver
 }
 
 type ActionOpts func(*Action)
@@ -117,8 +126,106 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 	controllerName := strings.ToLower(kind)
 	igvk := rr.Instance.GetObjectKind().GroupVersionKind()
 
+	partOf := a.fieldOwner
+	if partOf == "" {
+		partOf = controllerName
+	}
+
+	mode, err := cluster.DeployMode(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	policy := common.DriftPolicyOverwrite
+	if dp := rr.WorkloadSpec().GetDriftPolicy(); dp != "" {
+		policy = dp
+	}
+
+	adoption := common.AdoptionPolicyAdopt
+	if ap := rr.WorkloadSpec().GetAdoptionPolicy(); ap != "" {
+		adoption = ap
+	}
+
+	// the hashes the resources were rendered with when last deployed, keyed by the
+	// resource identity
+	deployed := make(map[common.ManagedResource]string, len(rr.Instance.GetStatus().Resources))
+	for _, mr := range rr.Instance.GetStatus().Resources {
+		deployed[managedResourceKey(mr)] = mr.Hash
+	}
+
+	images, err := NewImageOverrides(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get image overrides: %w", err)
+	}
+
+	digestsSpec, err := cluster.ImageDigests(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	resolveImage := a.resolveImage
+	if resolveImage == nil && digestsSpec != nil {
+		resolveImage = cachedImageResolver(digestsSpec.CredentialsSecret)
+	}
+
+	imageDigests := NewImageDigests(digestsSpec, resolveImage, rr.Instance.GetStatus().Images)
+
+	podSecurity, err := cluster.PodSecurityProfile(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	proxy, err := NewProxySettings(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get proxy settings: %w", err)
+	}
+
+	inventory := make([]common.ManagedResource, 0, len(rr.Resources))
+	containerImages := make([]common.ContainerImage, 0)
+	drifted := make([]string, 0)
+	exported := make([]unstructured.Unstructured, 0, len(rr.Resources))
+
 	for i := range rr.Resources {
 		res := rr.Resources[i]
+
+		if !ScopeResource(rr.Instance, &res) {
+			continue
+		}
+
+		if err := images.Apply(&res); err != nil {
+			return fmt.Errorf("failed to apply image overrides to %s %s: %w", res.GetKind(), res.GetName(), err)
+		}
+
+		if err := proxy.Apply(&res); err != nil {
+			return fmt.Errorf("failed to apply proxy settings to %s %s: %w", res.GetKind(), res.GetName(), err)
+		}
+
+		resImages, driftedImages, err := imageDigests.Apply(ctx, &res)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the image digests of %s %s: %w", res.GetKind(), res.GetName(), err)
+		}
+
+		containerImages = append(containerImages, resImages...)
+
+		for _, msg := range driftedImages {
+			rr.Eventf(corev1.EventTypeWarning, status.ImageDriftedReason, "Image tag drifted: %s", msg)
+		}
+
+		switch res.GroupVersionKind() {
+		case gvk.Deployment, gvk.StatefulSet:
+			if podSecurity == infrav1.PodSecurityProfileRestricted {
+				if err := ApplyRestrictedPodSecurity(&res); err != nil {
+					return fmt.Errorf("failed to apply the restricted pod security settings to %s %s: %w", res.GetKind(), res.GetName(), err)
+				}
+			}
+		}
+
+		// the resources applied by a GitOps tool are only exported
+		if !IsApplied(mode, res.GroupVersionKind()) {
+			exported = append(exported, a.rendered(&res, partOf))
+			continue
+		}
+
 		current := resources.GvkToUnstructured(res.GroupVersionKind())
 
 		lookupErr := rr.Client.Get(ctx, client.ObjectKeyFromObject(&res), current)
@@ -130,6 +237,8 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 		case lookupErr != nil:
 			return fmt.Errorf("failed to lookup object %s/%s: %w", res.GetNamespace(), res.GetName(), lookupErr)
 		default:
+			managed := isManaged(current)
+
 			// Remove the previous owner reference if set, This is required during the
 			// transition from the old to the new operator.
 			if err := resources.RemoveOwnerReferences(ctx, rr.Client, current, ownedTypeIsNot(&igvk)); err != nil {
@@ -146,30 +255,129 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 				//  skip any further processing
 				continue
 			}
+
+			// the object was not created by the operator, e.g. it was installed by the user,
+			// resources only created if missing are left as they are anyway
+			if !managed && resources.GetAnnotation(&res, annotations.ManagedByODHOperator) != "false" {
+				switch adoption {
+				case common.AdoptionPolicySkip:
+					rr.Eventf(corev1.EventTypeNormal, status.ResourceAdoptionSkippedReason,
+						"Resource not managed by the platform left as is: %s", resources.FormatObjectReference(current))
+
+					continue
+				case common.AdoptionPolicyFail:
+					return fmt.Errorf("resource %s already exists and is not managed by the platform, "+
+						"remove it or annotate it with %s=true to adopt it", resources.FormatObjectReference(current), annotations.ManagedByODHOperator)
+				default:
+					rr.Eventf(corev1.EventTypeNormal, status.ResourceAdoptedReason,
+						"Resource not managed by the platform adopted: %s", resources.FormatObjectReference(current))
+				}
+			}
+		}
+
+		// recorded as rendered, before the instance specific metadata is set while deploying it
+		exported = append(exported, a.rendered(&res, partOf))
+
+		// drift is only checked when the resource is rendered as it was when last deployed,
+		// otherwise the differences are due to the operator updating it
+		resPolicy := common.DriftPolicyOverwrite
+
+		// resources only created if missing are not owned by the operator
+		if resources.GetAnnotation(&res, annotations.ManagedByODHOperator) != "false" {
+			mr, err := newManagedResource(&res)
+			if err != nil {
+				return err
+			}
+
+			if h, found := deployed[managedResourceKey(mr)]; found && h == mr.Hash {
+				resPolicy = policy
+			}
+
+			inventory = append(inventory, mr)
 		}
 
 		var ok bool
-		var err error
+		var drift []string
 
 		switch rr.Resources[i].GroupVersionKind() {
 		case gvk.CustomResourceDefinition:
 			ok, err = a.deployCRD(ctx, rr, res, current)
 		default:
-			ok, err = a.deploy(ctx, rr, res, current)
+			ok, drift, err = a.deploy(ctx, rr, res, current, resPolicy)
 		}
 
 		if err != nil {
+			resGVK := res.GroupVersionKind()
+			DeployErrorsTotal.WithLabelValues(controllerName, resGVK.Group, resGVK.Version, resGVK.Kind).Inc()
+
+			if k8serr.IsConflict(err) {
+				rr.Eventf(corev1.EventTypeWarning, status.DeployConflictReason,
+					"Conflict deploying %s: %v", resources.FormatObjectReference(&res), err)
+			}
+
 			return fmt.Errorf("failure deploying resource %s: %w", res, err)
 		}
 
+		if len(drift) > 0 {
+			msg := FormatDrift(&res, drift)
+			drifted = append(drifted, msg)
+
+			rr.Eventf(corev1.EventTypeWarning, "DriftDetected",
+				"Resource changed on the cluster, %s policy applied: %s", resPolicy, msg)
+		}
+
 		if ok {
 			DeployedResourcesTotal.WithLabelValues(controllerName).Inc()
 		}
 	}
 
+	rr.Instance.GetStatus().Resources = inventory
+	rr.Instance.GetStatus().Images = containerImages
+
+	// the digests queued for resolution are recorded once resolved in the background
+	if imageDigests.Pending() {
+		rr.Requeue(pendingDigestsRequeue)
+	}
+	export.DefaultStore().Record(controllerName, exported)
+
+	if len(drifted) > 0 {
+		rr.Conditions.SetCondition(common.Condition{
+			Type:     status.ConditionTypeDriftDetected,
+			Status:   metav1.ConditionTrue,
+			Severity: common.ConditionSeverityInfo,
+			Reason:   status.ResourcesDriftedReason,
+			Message:  fmt.Sprintf("Resources changed on the cluster, %s policy applied: %s", policy, strings.Join(drifted, "; ")),
+		})
+	}
+
 	return nil
 }
 
+// newManagedResource returns the inventory entry of the given rendered resource.
+func newManagedResource(obj *unstructured.Unstructured) (common.ManagedResource, error) {
+	h, err := resources.Hash(obj)
+	if err != nil {
+		return common.ManagedResource{}, fmt.Errorf("failed to hash resource %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	objGVK := obj.GroupVersionKind()
+
+	return common.ManagedResource{
+		Group:     objGVK.Group,
+		Version:   objGVK.Version,
+		Kind:      objGVK.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Hash:      resources.EncodeToString(h),
+	}, nil
+}
+
+// managedResourceKey returns the identity of the given inventory entry, regardless of its hash.
+func managedResourceKey(mr common.ManagedResource) common.ManagedResource {
+	mr.Hash = ""
+	return mr
+}
+
 // ShouldSkip determines whether resource deployment should be skipped based on cache state.
 // Returns true if the resource is cached and deployment should be skipped, false if deployment should proceed.
 // Delegates to cache for deletion timestamp handling and cache cleanup.
@@ -232,9 +440,9 @@ func (a *Action) deployCRD(
 
 	switch a.deployMode {
 	case ModePatch:
-		deployedObj, err = a.patch(ctx, rr.Client, &obj, current, ops...)
+		deployedObj, err = a.patch(ctx, rr.Client, &obj, current, nil, nil, ops...)
 	case ModeSSA:
-		deployedObj, err = a.apply(ctx, rr.Client, &obj, current, ops...)
+		deployedObj, err = a.apply(ctx, rr.Client, &obj, current, nil, nil, ops...)
 	default:
 		err = fmt.Errorf("unsupported deploy mode %s", a.deployMode)
 	}
@@ -258,12 +466,13 @@ func (a *Action) deploy(
 	rr *odhTypes.ReconciliationRequest,
 	obj unstructured.Unstructured,
 	current *unstructured.Unstructured,
-) (bool, error) {
+	policy common.DriftPolicy,
+) (bool, []string, error) {
 	fo := a.fieldOwner
 	if fo == "" {
 		kind, err := resources.KindForObject(rr.Client.Scheme(), rr.Instance)
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 
 		fo = strings.ToLower(kind)
@@ -281,12 +490,44 @@ func (a *Action) deploy(
 		resources.SetLabel(&obj, labels.PlatformPartOf, fo)
 	}
 
+	if resources.GetLabel(&obj, labels.BackupTier) == "" {
+		resources.SetLabel(&obj, labels.BackupTier, backupTier(obj.GroupVersionKind()))
+	}
+
+	// Scheduling constraints are part of the desired state, so they have to be
+	// set before checking the cache to roll out any change to them
+	switch obj.GroupVersionKind() {
+	case gvk.Deployment, gvk.StatefulSet:
+		if err := ApplyScheduling(&obj, rr.WorkloadSpec().GetScheduling()); err != nil {
+			return false, nil, fmt.Errorf("failed to apply scheduling constraints to %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
 	shouldSkip, err := a.ShouldSkip(current, &obj)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	if shouldSkip {
-		return false, nil
+		return false, nil, nil
+	}
+
+	// The drift is detected on the resource as it is about to be written, after the
+	// changes specific to its kind, so the fields users are allowed to change are not
+	// reported
+	var drift []string
+	var preserve func(*unstructured.Unstructured) bool
+	if current != nil && policy != common.DriftPolicyOverwrite {
+		preserve = func(desired *unstructured.Unstructured) bool {
+			// a new generation of the instance or a new release may change the resource as well
+			for _, k := range []string{annotations.InstanceGeneration, annotations.PlatformVersion} {
+				if resources.GetAnnotation(current, k) != resources.GetAnnotation(desired, k) {
+					return false
+				}
+			}
+
+			drift = DetectDrift(current, desired)
+			return len(drift) > 0 && policy == common.DriftPolicyPreserve
+		}
 	}
 
 	// backup copy for caching
@@ -304,14 +545,14 @@ func (a *Action) deploy(
 
 		deployedObj, err = a.create(ctx, rr.Client, &obj)
 		if err != nil && !k8serr.IsAlreadyExists(err) {
-			return false, err
+			return false, nil, err
 		}
 
 	default:
 		owned := rr.Controller.Owns(obj.GroupVersionKind())
 		if owned {
 			if err := ctrl.SetControllerReference(rr.Instance, &obj, rr.Client.Scheme()); err != nil {
-				return false, err
+				return false, nil, err
 			}
 		}
 
@@ -320,28 +561,35 @@ func (a *Action) deploy(
 			client.FieldOwner(fo),
 		}
 
+		overrides := rr.WorkloadSpec().GetResourcesOverrides()
+
 		switch a.deployMode {
 		case ModePatch:
-			deployedObj, err = a.patch(ctx, rr.Client, &obj, current, ops...)
+			deployedObj, err = a.patch(ctx, rr.Client, &obj, current, overrides, preserve, ops...)
 		case ModeSSA:
-			deployedObj, err = a.apply(ctx, rr.Client, &obj, current, ops...)
+			deployedObj, err = a.apply(ctx, rr.Client, &obj, current, overrides, preserve, ops...)
 		default:
 			err = fmt.Errorf("unsupported deploy mode %s", a.deployMode)
 		}
 
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
+	// The preserved resource is not cached, so the drift keeps being reported
+	if len(drift) > 0 && policy == common.DriftPolicyPreserve {
+		return false, drift, nil
+	}
+
 	if a.cache != nil {
 		err := a.cache.Add(deployedObj, origObj)
 		if err != nil {
-			return false, fmt.Errorf("failed to cache object: %w", err)
+			return false, nil, fmt.Errorf("failed to cache object: %w", err)
 		}
 	}
 
-	return true, nil
+	return true, drift, nil
 }
 
 func (a *Action) create(
@@ -367,6 +615,8 @@ func (a *Action) patch(
 	cli client.Client,
 	obj *unstructured.Unstructured,
 	old *unstructured.Unstructured,
+	overrides []common.ResourcesOverride,
+	preserve func(*unstructured.Unstructured) bool,
 	opts ...client.PatchOption,
 ) (*unstructured.Unstructured, error) {
 	logf.FromContext(ctx).V(3).Info("patch",
@@ -381,23 +631,35 @@ func (a *Action) patch(
 		// - If the resource does not exist (the resource must be created)
 		// - If the resource is forcefully marked as managed by the operator via
 		//   annotations (i.e. to bring it back to the default values)
-		if old == nil || resources.GetAnnotation(old, annotations.ManagedByODHOperator) == "true" {
-			break
+		if old != nil && resources.GetAnnotation(old, annotations.ManagedByODHOperator) != "true" {
+			// To preserve backward compatibility with the current model, fields are being
+			// removed, hence not included in the final PATCH. Ideally with should leverage
+			// Server-Side Apply.
+			//
+			// Ideally deployed resources should be configured only via the platform API
+			if err := RemoveDeploymentsResources(obj); err != nil {
+				return nil, fmt.Errorf("failed to apply allow list to Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
 		}
 
-		// To preserve backward compatibility with the current model, fields are being
-		// removed, hence not included in the final PATCH. Ideally with should leverage
-		// Server-Side Apply.
-		//
-		// Ideally deployed resources should be configured only via the platform API
-		if err := RemoveDeploymentsResources(obj); err != nil {
-			return nil, fmt.Errorf("failed to apply allow list to Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		// Resources overrides set via the platform API take precedence over both the
+		// manifests and the values set on the cluster
+		if err := ApplyResourcesOverrides(obj, overrides); err != nil {
+			return nil, fmt.Errorf("failed to apply resources overrides to Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
 		}
 	default:
 		// do nothing
 		break
 	}
 
+	if err := RemoveExcludedFields(obj, old); err != nil {
+		return nil, err
+	}
+
+	if preserve != nil && preserve(obj) {
+		return old, nil
+	}
+
 	if old == nil {
 		err := cli.Create(ctx, obj)
 		if err != nil {
@@ -429,6 +691,8 @@ func (a *Action) apply(
 	cli client.Client,
 	obj *unstructured.Unstructured,
 	old *unstructured.Unstructured,
+	overrides []common.ResourcesOverride,
+	preserve func(*unstructured.Unstructured) bool,
 	opts ...client.PatchOption,
 ) (*unstructured.Unstructured, error) {
 	logf.FromContext(ctx).V(3).Info("apply",
@@ -443,19 +707,23 @@ func (a *Action) apply(
 		// - If the resource does not exist (the resource must be created)
 		// - If the resource is forcefully marked as managed by the operator via
 		//   annotations (i.e. to bring it back to the default values)
-		if old == nil || resources.GetAnnotation(old, annotations.ManagedByODHOperator) == "true" {
-			break
+		if old != nil && resources.GetAnnotation(old, annotations.ManagedByODHOperator) != "true" {
+			// To preserve backward compatibility with the current model, fields are being
+			// merged from an existing Deployment (if it exists) to the rendered manifest,
+			// hence the current value is preserved [1].
+			//
+			// Ideally deployed resources should be configured only via the platform API
+			//
+			// [1] https://kubernetes.io/docs/reference/using-api/server-side-apply/#conflicts
+			if err := MergeDeployments(old, obj); err != nil {
+				return nil, fmt.Errorf("failed to merge Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
 		}
 
-		// To preserve backward compatibility with the current model, fields are being
-		// merged from an existing Deployment (if it exists) to the rendered manifest,
-		// hence the current value is preserved [1].
-		//
-		// Ideally deployed resources should be configured only via the platform API
-		//
-		// [1] https://kubernetes.io/docs/reference/using-api/server-side-apply/#conflicts
-		if err := MergeDeployments(old, obj); err != nil {
-			return nil, fmt.Errorf("failed to merge Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		// Resources overrides set via the platform API take precedence over both the
+		// manifests and the values set on the cluster
+		if err := ApplyResourcesOverrides(obj, overrides); err != nil {
+			return nil, fmt.Errorf("failed to apply resources overrides to Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
 		}
 	case gvk.ClusterRole:
 		// For ClusterRole, if AggregationRule is set, then the Rules are controller managed
@@ -474,6 +742,14 @@ func (a *Action) apply(
 		break
 	}
 
+	if err := RemoveExcludedFields(obj, old); err != nil {
+		return nil, err
+	}
+
+	if preserve != nil && preserve(obj) {
+		return old, nil
+	}
+
 	err := resources.Apply(ctx, cli, obj, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("apply failed %s: %w", obj.GroupVersionKind(), err)
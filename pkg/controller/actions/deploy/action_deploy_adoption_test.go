@@ -0,0 +1,147 @@
+package deploy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDeployAdoption(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  common.AdoptionPolicy
+		labels  map[string]string
+		patched bool
+		event   string
+		err     string
+	}{
+		{
+			name:    "adopt by default",
+			patched: true,
+			event:   status.ResourceAdoptedReason,
+		},
+		{
+			name:   "skip",
+			policy: common.AdoptionPolicySkip,
+			event:  status.ResourceAdoptionSkippedReason,
+		},
+		{
+			name:   "fail",
+			policy: common.AdoptionPolicyFail,
+			err:    "is not managed by the platform",
+		},
+		{
+			name:    "managed resources are not adopted",
+			policy:  common.AdoptionPolicyFail,
+			labels:  map[string]string{labels.PlatformPartOf: "dashboard"},
+			patched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			existing := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      xid.New().String(),
+					Namespace: xid.New().String(),
+					Labels:    tt.labels,
+				},
+				Data: map[string]string{
+					"key": "installed",
+				},
+			}
+
+			// fake client does not yet support SSA, so patches are only recorded
+			patched := false
+			cl, err := fakeclient.New(
+				fakeclient.WithObjects(existing),
+				fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+					Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						patched = true
+						return nil
+					},
+				}),
+			)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			rendered, err := resources.ToUnstructured(&corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: corev1.SchemeGroupVersion.String(),
+					Kind:       "ConfigMap",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      existing.Name,
+					Namespace: existing.Namespace,
+				},
+				Data: map[string]string{
+					"key": "rendered",
+				},
+			})
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			recorder := record.NewFakeRecorder(10)
+			instance := &componentApi.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+			}
+			instance.Spec.AdoptionPolicy = tt.policy
+
+			rr := types.ReconciliationRequest{
+				Client:    cl,
+				Instance:  instance,
+				Release:   common.Release{Name: cluster.OpenDataHub},
+				Resources: []unstructured.Unstructured{*rendered},
+				Controller: mocks.NewMockController(func(m *mocks.MockController) {
+					m.On("Owns", mock.Anything).Return(false)
+					m.On("GetEventRecorder").Return(recorder)
+				}),
+			}
+
+			err = deploy.NewAction(deploy.WithMode(deploy.ModePatch))(ctx, &rr)
+			if tt.err != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(patched).Should(Equal(tt.patched))
+
+			if tt.patched {
+				g.Expect(instance.Status.Resources).Should(HaveLen(1))
+			} else {
+				g.Expect(instance.Status.Resources).Should(BeEmpty())
+			}
+
+			if tt.event == "" {
+				g.Expect(recorder.Events).Should(BeEmpty())
+			} else {
+				g.Expect(recorder.Events).Should(Receive(ContainSubstring(tt.event)))
+			}
+		})
+	}
+}
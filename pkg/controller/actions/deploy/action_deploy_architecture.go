@@ -0,0 +1,99 @@
+package deploy
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var nodeSelectorTermsPath = []string{
+	"spec", "template", "spec", "affinity", "nodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms",
+}
+
+// RequireArchitectures restricts the pods of the given workload to the nodes of the given CPU
+// architectures, requiring the kubernetes.io/arch label in each of the node selector terms of its
+// node affinity, in place of the requirement on the label already set.
+func RequireArchitectures(obj *unstructured.Unstructured, architectures []string) error {
+	terms, _, err := unstructured.NestedSlice(obj.Object, nodeSelectorTermsPath...)
+	if err != nil {
+		return err
+	}
+
+	if len(terms) == 0 {
+		terms = []any{map[string]any{}}
+	}
+
+	values := make([]any, 0, len(architectures))
+	for _, arch := range architectures {
+		values = append(values, arch)
+	}
+
+	requirement := map[string]any{
+		"key":      corev1.LabelArchStable,
+		"operator": string(corev1.NodeSelectorOpIn),
+		"values":   values,
+	}
+
+	for i := range terms {
+		term, ok := terms[i].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		expressions, _, err := unstructured.NestedSlice(term, "matchExpressions")
+		if err != nil {
+			return err
+		}
+
+		expressions = slices.DeleteFunc(expressions, isArchitectureRequirement)
+		expressions = append(expressions, requirement)
+
+		if err := unstructured.SetNestedSlice(term, expressions, "matchExpressions"); err != nil {
+			return err
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, terms, nodeSelectorTermsPath...)
+}
+
+// requiredArchitectures returns the CPU architectures the pods of the given workload are restricted
+// to by the first node selector term of its node affinity, none when not restricted.
+func requiredArchitectures(obj *unstructured.Unstructured) ([]string, error) {
+	terms, _, err := unstructured.NestedSlice(obj.Object, nodeSelectorTermsPath...)
+	if err != nil || len(terms) == 0 {
+		return nil, err
+	}
+
+	term, ok := terms[0].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	expressions, _, err := unstructured.NestedSlice(term, "matchExpressions")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range expressions {
+		m, ok := e.(map[string]any)
+		if !ok || !isArchitectureRequirement(m) {
+			continue
+		}
+
+		values, _, err := unstructured.NestedStringSlice(m, "values")
+		if err != nil {
+			return nil, err
+		}
+
+		return values, nil
+	}
+
+	return nil, nil
+}
+
+func isArchitectureRequirement(e any) bool {
+	m, ok := e.(map[string]any)
+
+	return ok && m["key"] == corev1.LabelArchStable && m["operator"] == string(corev1.NodeSelectorOpIn)
+}
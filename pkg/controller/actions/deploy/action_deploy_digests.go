@@ -0,0 +1,196 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/digests"
+)
+
+// pendingDigestsRequeue is the delay after which the instances deploying images whose digest was
+// not resolved yet are reconciled again.
+const pendingDigestsRequeue = 10 * time.Second
+
+// ImageResolver returns the digest the given image, referenced by tag, resolves to.
+type ImageResolver func(ctx context.Context, image string) (string, error)
+
+// WithImageResolver sets how the digests of the images referenced by tag are resolved, from the
+// digests resolved in the background by digests.Default by default.
+func WithImageResolver(value ImageResolver) ActionOpts {
+	return func(action *Action) {
+		action.resolveImage = value
+	}
+}
+
+// cachedImageResolver returns a resolver reading the digests digests.Default resolved with the
+// credentials the given kubernetes.io/dockerconfigjson Secret of the operator namespace holds.
+func cachedImageResolver(credentialsSecret string) ImageResolver {
+	return func(_ context.Context, image string) (string, error) {
+		return digests.Default.Lookup(credentialsSecret, image)
+	}
+}
+
+// ImageDigests records the images of the containers of the rendered workloads, along with their
+// digests, resolving the digests of the images referenced by tag when requested in the
+// DataScienceCluster, and pinning the images by digest with the Pin policy.
+type ImageDigests struct {
+	spec    *common.ImageDigestsSpec
+	resolve ImageResolver
+	// the digests recorded when the workloads were last deployed, by container and image
+	recorded map[common.ContainerImage]string
+	// whether the digest of an image was not resolved yet
+	pending bool
+}
+
+// NewImageDigests returns the ImageDigests of the given configuration, the digests being pinned to
+// the ones recorded when the workloads were last deployed.
+func NewImageDigests(spec *common.ImageDigestsSpec, resolve ImageResolver, recorded []common.ContainerImage) *ImageDigests {
+	d := ImageDigests{
+		spec:     spec,
+		resolve:  resolve,
+		recorded: make(map[common.ContainerImage]string, len(recorded)),
+	}
+
+	for _, ci := range recorded {
+		d.recorded[containerImageKey(ci)] = ci.Digest
+	}
+
+	return &d
+}
+
+// Apply returns the images of the containers, init containers included, of the given workload, and
+// the images whose tag no longer resolves to the digest recorded for them. With the Pin policy, the
+// images are pinned by digest, failing when their digest can't be resolved.
+func (d *ImageDigests) Apply(ctx context.Context, obj *unstructured.Unstructured) ([]common.ContainerImage, []string, error) {
+	podSpecPath := podSpecPathOf(obj)
+	if podSpecPath == nil {
+		return nil, nil, nil
+	}
+
+	images := make([]common.ContainerImage, 0)
+	drifted := make([]string, 0)
+
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)
+
+		value, found, err := unstructured.NestedFieldNoCopy(obj.Object, fieldPath...)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !found || value == nil {
+			continue
+		}
+
+		containers, ok := value.([]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s is of the type %T, expected []interface{}", strings.Join(fieldPath, "."), value)
+		}
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			image, ok := c["image"].(string)
+			if !ok || image == "" {
+				continue
+			}
+
+			name, _ := c["name"].(string)
+
+			ci := common.ContainerImage{
+				Kind:      obj.GetKind(),
+				Namespace: obj.GetNamespace(),
+				Name:      obj.GetName(),
+				Container: name,
+				Image:     image,
+			}
+
+			msg, err := d.digest(ctx, &ci)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if msg != "" {
+				drifted = append(drifted, msg)
+			}
+
+			if d.pin() && !strings.Contains(image, "@") {
+				c["image"] = image + "@" + ci.Digest
+			}
+
+			images = append(images, ci)
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fieldPath...); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return images, drifted, nil
+}
+
+// digest sets the digest of the given container image and returns a description of its drift, if
+// its tag no longer resolves to the digest recorded for it.
+func (d *ImageDigests) digest(ctx context.Context, ci *common.ContainerImage) (string, error) {
+	if _, dgst, ok := strings.Cut(ci.Image, "@"); ok {
+		ci.Digest = dgst
+		return "", nil
+	}
+
+	if d.spec == nil || d.resolve == nil {
+		return "", nil
+	}
+
+	recorded := d.recorded[containerImageKey(*ci)]
+
+	resolved, err := d.resolve(ctx, ci.Image)
+	if errors.Is(err, digests.ErrPending) {
+		d.pending = true
+	}
+
+	switch {
+	case err != nil && d.pin() && recorded == "":
+		return "", fmt.Errorf("failed to resolve the digest of image %s to pin it: %w", ci.Image, err)
+	case err != nil:
+		logf.FromContext(ctx).V(1).Info("unable to resolve image digest", "image", ci.Image, "error", err.Error())
+	}
+
+	// the images stay pinned to the digest their tag first resolved to, the digest recorded is kept
+	// while the registry can't be queried
+	ci.Digest = resolved
+	if recorded != "" && (d.pin() || resolved == "") {
+		ci.Digest = recorded
+	}
+
+	if recorded == "" || resolved == "" || resolved == recorded {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%s of container %s of %s %s/%s now resolves to %s instead of %s",
+		ci.Image, ci.Container, ci.Kind, ci.Namespace, ci.Name, resolved, recorded), nil
+}
+
+// Pending returns true if the digest of an image of the applied workloads was not resolved yet, the
+// workloads being applied again once resolved.
+func (d *ImageDigests) Pending() bool {
+	return d.pending
+}
+
+func (d *ImageDigests) pin() bool {
+	return d.spec != nil && d.spec.Policy == common.ImageDigestPolicyPin
+}
+
+// containerImageKey returns the identity of the given container image, regardless of its digest.
+func containerImageKey(ci common.ContainerImage) common.ContainerImage {
+	ci.Digest = ""
+	return ci
+}
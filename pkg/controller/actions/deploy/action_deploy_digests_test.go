@@ -0,0 +1,177 @@
+package deploy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/digests"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	movedImageDigest   = "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	pinnedManagerImage = "quay.io/opendatahub/manager:v1@" + testImageDigest
+)
+
+func newDigestsTestDeployment(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+
+	u, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "manager", Namespace: "opendatahub"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "quay.io/opendatahub/init@" + testImageDigest}},
+					Containers:     []corev1.Container{{Name: "manager", Image: "quay.io/opendatahub/manager:v1"}},
+				},
+			},
+		},
+	})
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return u
+}
+
+func TestImageDigestsApply(t *testing.T) {
+	resolveTo := func(d string, err error) deploy.ImageResolver {
+		return func(_ context.Context, _ string) (string, error) {
+			return d, err
+		}
+	}
+
+	recorded := []common.ContainerImage{{
+		Kind: "Deployment", Namespace: "opendatahub", Name: "manager", Container: "manager",
+		Image: "quay.io/opendatahub/manager:v1", Digest: testImageDigest,
+	}}
+
+	tests := []struct {
+		name     string
+		spec     *common.ImageDigestsSpec
+		resolve  deploy.ImageResolver
+		recorded []common.ContainerImage
+		image    string
+		digest   string
+		drifted  int
+		pending  bool
+		err      string
+	}{
+		{
+			name:  "digests of the pinned images only",
+			image: "quay.io/opendatahub/manager:v1",
+		},
+		{
+			name:    "record",
+			spec:    &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyRecord},
+			resolve: resolveTo(testImageDigest, nil),
+			image:   "quay.io/opendatahub/manager:v1",
+			digest:  testImageDigest,
+		},
+		{
+			name:     "record a moved tag",
+			spec:     &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyRecord},
+			resolve:  resolveTo(movedImageDigest, nil),
+			recorded: recorded,
+			image:    "quay.io/opendatahub/manager:v1",
+			digest:   movedImageDigest,
+			drifted:  1,
+		},
+		{
+			name:     "record keeps the digest while the registry is unreachable",
+			spec:     &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyRecord},
+			resolve:  resolveTo("", errors.New("unreachable")),
+			recorded: recorded,
+			image:    "quay.io/opendatahub/manager:v1",
+			digest:   testImageDigest,
+		},
+		{
+			name:    "record queues the digests not resolved yet",
+			spec:    &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyRecord},
+			resolve: resolveTo("", digests.ErrPending),
+			image:   "quay.io/opendatahub/manager:v1",
+			pending: true,
+		},
+		{
+			name:    "pin",
+			spec:    &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyPin},
+			resolve: resolveTo(testImageDigest, nil),
+			image:   pinnedManagerImage,
+			digest:  testImageDigest,
+		},
+		{
+			name:     "pin keeps the recorded digest of a moved tag",
+			spec:     &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyPin},
+			resolve:  resolveTo(movedImageDigest, nil),
+			recorded: recorded,
+			image:    pinnedManagerImage,
+			digest:   testImageDigest,
+			drifted:  1,
+		},
+		{
+			name:     "pin keeps the recorded digest while not resolved yet",
+			spec:     &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyPin},
+			resolve:  resolveTo("", digests.ErrPending),
+			recorded: recorded,
+			image:    pinnedManagerImage,
+			digest:   testImageDigest,
+			pending:  true,
+		},
+		{
+			name:    "pin fails while the digest is not resolved yet",
+			spec:    &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyPin},
+			resolve: resolveTo("", digests.ErrPending),
+			err:     digests.ErrPending.Error(),
+		},
+		{
+			name:    "pin fails when the digest can't be resolved",
+			spec:    &common.ImageDigestsSpec{Policy: common.ImageDigestPolicyPin},
+			resolve: resolveTo("", errors.New("unreachable")),
+			err:     "unreachable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			deployment := newDigestsTestDeployment(t)
+
+			imageDigests := deploy.NewImageDigests(tt.spec, tt.resolve, tt.recorded)
+
+			images, drifted, err := imageDigests.Apply(t.Context(), deployment)
+			if tt.err != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(drifted).Should(HaveLen(tt.drifted))
+			g.Expect(imageDigests.Pending()).Should(Equal(tt.pending))
+			g.Expect(deployment).Should(And(
+				jq.Match(`.spec.template.spec.initContainers[0].image == "%s"`, "quay.io/opendatahub/init@"+testImageDigest),
+				jq.Match(`.spec.template.spec.containers[0].image == "%s"`, tt.image),
+			))
+			g.Expect(images).Should(Equal([]common.ContainerImage{
+				{
+					Kind: "Deployment", Namespace: "opendatahub", Name: "manager", Container: "init",
+					Image: "quay.io/opendatahub/init@" + testImageDigest, Digest: testImageDigest,
+				},
+				{
+					Kind: "Deployment", Namespace: "opendatahub", Name: "manager", Container: "manager",
+					Image: "quay.io/opendatahub/manager:v1", Digest: tt.digest,
+				},
+			}))
+		})
+	}
+}
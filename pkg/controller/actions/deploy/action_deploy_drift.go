@@ -0,0 +1,151 @@
+package deploy
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// platformMetadataPrefix is the prefix of the labels and annotations the operator
+	// sets to track a deployment, which change along with the instance and the release.
+	platformMetadataPrefix = "platform.opendatahub.io/"
+
+	// maxReportedDriftFields is the maximum number of drifted fields reported per resource.
+	maxReportedDriftFields = 5
+)
+
+// DetectDrift returns the paths of the fields set in the desired resource whose value on the
+// cluster differs, sorted. Fields not set in the desired resource are not compared, so values
+// defaulted by the API server are not reported. Among the metadata, only the labels and the
+// annotations are compared, leaving out the ones the operator sets to track the deployment.
+func DetectDrift(current *unstructured.Unstructured, desired *unstructured.Unstructured) []string {
+	paths := make([]string, 0)
+
+	for k, dv := range desired.Object {
+		switch k {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			for _, field := range []string{"labels", "annotations"} {
+				dm := nestedStringMap(desired, "metadata", field)
+				cm := nestedStringMap(current, "metadata", field)
+
+				for name, value := range dm {
+					if strings.HasPrefix(name, platformMetadataPrefix) {
+						continue
+					}
+					if cv, ok := cm[name]; !ok || cv != value {
+						paths = append(paths, fmt.Sprintf(".metadata.%s.%q", field, name))
+					}
+				}
+			}
+		default:
+			paths = diffValue(paths, "."+k, dv, current.Object[k])
+		}
+	}
+
+	slices.Sort(paths)
+
+	return paths
+}
+
+// FormatDrift describes the drifted fields of a resource, listing at most maxReportedDriftFields of them.
+func FormatDrift(obj *unstructured.Unstructured, paths []string) string {
+	fields := strings.Join(paths, ", ")
+	if len(paths) > maxReportedDriftFields {
+		fields = fmt.Sprintf("%s and %d more", strings.Join(paths[:maxReportedDriftFields], ", "), len(paths)-maxReportedDriftFields)
+	}
+
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+
+	return fmt.Sprintf("%s %s (%s)", obj.GetKind(), name, fields)
+}
+
+func nestedStringMap(obj *unstructured.Unstructured, fields ...string) map[string]string {
+	m, _, err := unstructured.NestedStringMap(obj.Object, fields...)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
+
+func diffValue(paths []string, path string, desired any, current any) []string {
+	switch d := desired.(type) {
+	case map[string]any:
+		c, ok := current.(map[string]any)
+		if !ok {
+			return append(paths, path)
+		}
+		for k, v := range d {
+			paths = diffValue(paths, path+"."+k, v, c[k])
+		}
+	case []any:
+		c, ok := current.([]any)
+		if !ok || len(c) != len(d) {
+			return append(paths, path)
+		}
+		for i := range d {
+			paths = diffValue(paths, fmt.Sprintf("%s[%d]", path, i), d[i], c[i])
+		}
+	default:
+		if !scalarEqual(desired, current) {
+			return append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// scalarEqual compares two scalar values, treating numbers of different types and
+// quantities with different representations, as normalized by the API server, as equal.
+func scalarEqual(desired any, current any) bool {
+	if reflect.DeepEqual(desired, current) {
+		return true
+	}
+
+	if df, ok := toFloat(desired); ok {
+		cf, ok := toFloat(current)
+		return ok && df == cf
+	}
+
+	ds, dok := desired.(string)
+	cs, cok := current.(string)
+	if !dok || !cok {
+		return false
+	}
+
+	dq, err := resource.ParseQuantity(ds)
+	if err != nil {
+		return false
+	}
+	cq, err := resource.ParseQuantity(cs)
+	if err != nil {
+		return false
+	}
+
+	return dq.Cmp(cq) == 0
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
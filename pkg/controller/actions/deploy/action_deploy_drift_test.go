@@ -0,0 +1,192 @@
+package deploy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDetectDrift(t *testing.T) {
+	g := NewWithT(t)
+
+	desired := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name": "test",
+			"labels": map[string]any{
+				"app": "test",
+			},
+			"annotations": map[string]any{
+				annotations.InstanceGeneration: "2",
+			},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":  "manager",
+							"image": "manager:v1",
+							"resources": map[string]any{
+								"limits": map[string]any{"cpu": "1000m"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	current := desired.DeepCopy()
+	current.SetAnnotations(map[string]string{annotations.InstanceGeneration: "1"})
+	g.Expect(unstructured.SetNestedField(current.Object, float64(1), "spec", "replicas")).Should(Succeed())
+	g.Expect(unstructured.SetNestedField(current.Object, int64(600), "spec", "progressDeadlineSeconds")).Should(Succeed())
+
+	containers, _, err := unstructured.NestedSlice(current.Object, "spec", "template", "spec", "containers")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(unstructured.SetNestedField(containers[0].(map[string]any), "1", "resources", "limits", "cpu")).Should(Succeed())
+	g.Expect(unstructured.SetNestedSlice(current.Object, containers, "spec", "template", "spec", "containers")).Should(Succeed())
+
+	g.Expect(deploy.DetectDrift(current, desired)).Should(BeEmpty())
+
+	current.SetLabels(nil)
+	containers[0].(map[string]any)["image"] = "manager:patched"
+	g.Expect(unstructured.SetNestedSlice(current.Object, containers, "spec", "template", "spec", "containers")).Should(Succeed())
+
+	g.Expect(deploy.DetectDrift(current, desired)).Should(Equal([]string{
+		`.metadata.labels."app"`,
+		`.spec.template.spec.containers[0].image`,
+	}))
+}
+
+func TestDeployDrift(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  common.DriftPolicy
+		patched bool
+		drift   bool
+	}{
+		{
+			name:    "overwrite",
+			policy:  common.DriftPolicyOverwrite,
+			patched: true,
+		},
+		{
+			name:    "report",
+			policy:  common.DriftPolicyReport,
+			patched: true,
+			drift:   true,
+		},
+		{
+			name:   "preserve",
+			policy: common.DriftPolicyPreserve,
+			drift:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			// fake client does not yet support SSA, so patches are only recorded
+			patched := false
+			cl, err := fakeclient.New(fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patched = true
+					return nil
+				},
+			}))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			action := deploy.NewAction(
+				deploy.WithMode(deploy.ModePatch),
+			)
+
+			rendered, err := resources.ToUnstructured(&corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: corev1.SchemeGroupVersion.String(),
+					Kind:       "ConfigMap",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      xid.New().String(),
+					Namespace: xid.New().String(),
+				},
+				Data: map[string]string{
+					"key": "rendered",
+				},
+			})
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			recorder := record.NewFakeRecorder(10)
+			instance := &componentApi.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+			}
+			instance.Spec.DriftPolicy = tt.policy
+
+			newRequest := func() *types.ReconciliationRequest {
+				return &types.ReconciliationRequest{
+					Client:     cl,
+					Instance:   instance,
+					Conditions: conditions.NewManager(instance, status.ConditionTypeReady),
+					Release:    common.Release{Name: cluster.OpenDataHub},
+					Resources:  []unstructured.Unstructured{*rendered.DeepCopy()},
+					Controller: mocks.NewMockController(func(m *mocks.MockController) {
+						m.On("Owns", mock.Anything).Return(false)
+						m.On("GetEventRecorder").Return(recorder)
+					}),
+				}
+			}
+
+			g.Expect(action(ctx, newRequest())).Should(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(rendered), cm)).Should(Succeed())
+			cm.Data["key"] = "changed"
+			g.Expect(cl.Update(ctx, cm)).Should(Succeed())
+
+			rr := newRequest()
+			g.Expect(action(ctx, rr)).Should(Succeed())
+
+			g.Expect(patched).Should(Equal(tt.patched))
+
+			dc := rr.Conditions.GetCondition(status.ConditionTypeDriftDetected)
+			if !tt.drift {
+				g.Expect(dc).Should(BeNil())
+				g.Expect(recorder.Events).Should(BeEmpty())
+				return
+			}
+
+			g.Expect(dc).ShouldNot(BeNil())
+			g.Expect(dc.Status).Should(Equal(metav1.ConditionTrue))
+			g.Expect(dc.Message).Should(ContainSubstring(`ConfigMap %s/%s (.data.key)`, rendered.GetNamespace(), rendered.GetName()))
+			g.Expect(recorder.Events).Should(Receive(ContainSubstring("DriftDetected")))
+		})
+	}
+}
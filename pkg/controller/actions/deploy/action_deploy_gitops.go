@@ -0,0 +1,50 @@
+package deploy
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// platformGroups are the API groups of the resources of the platform, which the operator
+// reconciles, so they are applied in the GitOps deploy mode as well.
+var platformGroups = []string{
+	componentApi.GroupVersion.Group,
+	serviceApi.GroupVersion.Group,
+	infrav1.GroupVersion.Group,
+	dscv2.GroupVersion.Group,
+	dsciv2.GroupVersion.Group,
+}
+
+// IsApplied returns true if the operator applies the resources of the given kind in the given
+// deploy mode. In the GitOps mode, only the resources of the platform API and the Secrets, whose
+// values are not published, are applied, the other ones are published for a GitOps tool to apply
+// them.
+func IsApplied(mode common.DeployMode, resGVK schema.GroupVersionKind) bool {
+	return mode != common.DeployModeGitOps || resGVK == gvk.Secret || slices.Contains(platformGroups, resGVK.Group)
+}
+
+// rendered returns a copy of the given resource, as rendered, labeled as the deployed resources
+// the status of the instance is computed from, without the metadata specific to the instance, so
+// it can be exported or applied by a GitOps tool.
+func (a *Action) rendered(res *unstructured.Unstructured, partOf string) unstructured.Unstructured {
+	out := res.DeepCopy()
+
+	resources.SetLabels(out, a.labels)
+	if resources.GetLabel(out, labels.PlatformPartOf) == "" {
+		resources.SetLabel(out, labels.PlatformPartOf, partOf)
+	}
+
+	return *out
+}
@@ -0,0 +1,281 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// ImageOverrides redirects the container images of the rendered workloads according to the
+// overrides set in DSCInitialization.
+type ImageOverrides struct {
+	overrides map[string]string
+	// sources of the digest mirrors configured on the cluster
+	mirrored []string
+}
+
+// NewImageOverrides returns the image overrides set in DSCInitialization, aware of the digest
+// mirrors configured on the cluster through ImageDigestMirrorSets and ImageContentSourcePolicies.
+func NewImageOverrides(ctx context.Context, cli client.Client) (*ImageOverrides, error) {
+	dsci, err := cluster.GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return &ImageOverrides{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	o := ImageOverrides{
+		overrides: dsci.Spec.ImageOverrides,
+	}
+
+	if len(o.overrides) == 0 {
+		return &o, nil
+	}
+
+	mirrors, err := NewImageMirrors(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mirrored = mirrors.digest
+
+	return &o, nil
+}
+
+// ImageMirrors holds the sources of the image mirrors configured on the cluster: through
+// ImageDigestMirrorSets and ImageContentSourcePolicies for the images pinned by digest, and
+// through ImageTagMirrorSets for the images pulled by tag.
+type ImageMirrors struct {
+	digest []string
+	tag    []string
+}
+
+// NewImageMirrors returns the image mirrors configured on the cluster, none when the mirror
+// APIs are not served.
+func NewImageMirrors(ctx context.Context, cli client.Client) (*ImageMirrors, error) {
+	mirrorSets := []struct {
+		gvk    schema.GroupVersionKind
+		path   []string
+		digest bool
+	}{
+		{gvk: gvk.ImageDigestMirrorSet, path: []string{"spec", "imageDigestMirrors"}, digest: true},
+		{gvk: gvk.ImageContentSourcePolicy, path: []string{"spec", "repositoryDigestMirrors"}, digest: true},
+		{gvk: gvk.ImageTagMirrorSet, path: []string{"spec", "imageTagMirrors"}},
+	}
+
+	m := ImageMirrors{}
+
+	for _, ms := range mirrorSets {
+		sources, err := mirrorSources(ctx, cli, ms.gvk, ms.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if ms.digest {
+			m.digest = append(m.digest, sources...)
+		} else {
+			m.tag = append(m.tag, sources...)
+		}
+	}
+
+	return &m, nil
+}
+
+// Configured returns true if image mirrors are configured on the cluster, which is the case of the
+// disconnected clusters.
+func (m *ImageMirrors) Configured() bool {
+	return len(m.digest) != 0 || len(m.tag) != 0
+}
+
+// Mirrored returns true if the given image is pulled through a mirror of the cluster, the digest
+// mirrors applying to the images pinned by digest and the tag mirrors to the others.
+func (m *ImageMirrors) Mirrored(image string) bool {
+	sources := m.tag
+	if strings.Contains(image, "@") {
+		sources = m.digest
+	}
+
+	for _, source := range sources {
+		if matchImagePrefix(image, source) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mirrorSources(ctx context.Context, cli client.Client, kind schema.GroupVersionKind, path []string) ([]string, error) {
+	exists, err := cluster.HasCRD(ctx, cli, kind)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+
+	if err := cli.List(ctx, &items); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+	}
+
+	sources := make([]string, 0)
+
+	for i := range items.Items {
+		mirrors, _, err := unstructured.NestedSlice(items.Items[i].Object, path...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range mirrors {
+			if mm, ok := m.(map[string]any); ok {
+				if source, ok := mm["source"].(string); ok && source != "" {
+					sources = append(sources, source)
+				}
+			}
+		}
+	}
+
+	return sources, nil
+}
+
+// Apply overrides the images of the containers of the given workload.
+func (o *ImageOverrides) Apply(obj *unstructured.Unstructured) error {
+	if o == nil || len(o.overrides) == 0 {
+		return nil
+	}
+
+	podSpecPath := podSpecPathOf(obj)
+	if podSpecPath == nil {
+		return nil
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)
+
+		containers, found, err := unstructured.NestedSlice(obj.Object, fieldPath...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if image, ok := c["image"].(string); ok {
+				c["image"] = o.Resolve(image)
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fieldPath...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContainerImages returns the images of the containers, init containers included, of the given
+// workload, none when the object is not a workload.
+func ContainerImages(obj *unstructured.Unstructured) ([]string, error) {
+	podSpecPath := podSpecPathOf(obj)
+	if podSpecPath == nil {
+		return nil, nil
+	}
+
+	images := make([]string, 0)
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, err := unstructured.NestedSlice(obj.Object, append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range containers {
+			if c, ok := containers[i].(map[string]any); ok {
+				if image, ok := c["image"].(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+
+	return images, nil
+}
+
+// podSpecPathOf returns the path of the pod spec of the given workload, nil when the object is not
+// a workload.
+func podSpecPathOf(obj *unstructured.Unstructured) []string {
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		schema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+		schema.GroupKind{Group: "apps", Kind: "DaemonSet"},
+		schema.GroupKind{Group: "apps", Kind: "ReplicaSet"},
+		schema.GroupKind{Group: "batch", Kind: "Job"}:
+		return []string{"spec", "template", "spec"}
+	case schema.GroupKind{Group: "batch", Kind: "CronJob"}:
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	case schema.GroupKind{Group: "", Kind: "Pod"}:
+		return []string{"spec"}
+	default:
+		return nil
+	}
+}
+
+// Resolve returns the reference the given image has to be pulled from: the image with the longest
+// matching override key replaced, unless the image is pinned by digest and its repository is
+// mirrored by the cluster.
+func (o *ImageOverrides) Resolve(image string) string {
+	if strings.Contains(image, "@") {
+		for _, source := range o.mirrored {
+			if matchImagePrefix(image, source) {
+				return image
+			}
+		}
+	}
+
+	key := ""
+	for k := range o.overrides {
+		// wildcards are only supported by the cluster mirrors, as they cannot be replaced
+		if strings.HasPrefix(k, "*.") {
+			continue
+		}
+		if len(k) > len(key) && matchImagePrefix(image, k) {
+			key = k
+		}
+	}
+
+	if key == "" {
+		return image
+	}
+
+	return o.overrides[key] + image[len(key):]
+}
+
+// matchImagePrefix returns whether the given prefix is the image itself, or one of its registry,
+// repository or name prefixes. A prefix starting with *. matches the subdomains of a registry.
+func matchImagePrefix(image string, prefix string) bool {
+	if domain, ok := strings.CutPrefix(prefix, "*."); ok {
+		registry, _, _ := strings.Cut(image, "/")
+		return strings.HasSuffix(registry, "."+domain)
+	}
+
+	rest, ok := strings.CutPrefix(image, prefix)
+	if !ok {
+		return false
+	}
+
+	return rest == "" || strings.ContainsAny(rest[:1], "/:@")
+}
@@ -0,0 +1,155 @@
+package deploy_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newImageOverridesTestClient(t *testing.T, overrides map[string]string, objs ...client.Object) client.Client {
+	t.Helper()
+
+	objs = append(objs, &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ImageOverrides: overrides},
+	})
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+
+	m, err := cli.RESTMapper().RESTMapping(gvk.ImageDigestMirrorSet.GroupKind(), gvk.ImageDigestMirrorSet.Version)
+	if err != nil {
+		t.Fatalf("failed to get the REST mapping of %s: %v", gvk.ImageDigestMirrorSet.Kind, err)
+	}
+
+	crd := mocks.NewMockCRD(gvk.ImageDigestMirrorSet.Group, gvk.ImageDigestMirrorSet.Version, gvk.ImageDigestMirrorSet.Kind, "cluster")
+	crd.Name = m.Resource.GroupResource().String()
+	crd.Status.StoredVersions = []string{gvk.ImageDigestMirrorSet.Version}
+
+	if err := cli.Create(t.Context(), crd); err != nil {
+		t.Fatalf("failed to create CRD %s: %v", crd.Name, err)
+	}
+
+	return cli
+}
+
+func TestImageOverridesResolve(t *testing.T) {
+	g := NewWithT(t)
+
+	idms := &unstructured.Unstructured{}
+	idms.SetGroupVersionKind(gvk.ImageDigestMirrorSet)
+	idms.SetName("mirrors")
+	g.Expect(unstructured.SetNestedSlice(idms.Object, []any{
+		map[string]any{"source": "registry.redhat.io/rhoai", "mirrors": []any{"mirror.example.com/rhoai"}},
+	}, "spec", "imageDigestMirrors")).Should(Succeed())
+
+	cli := newImageOverridesTestClient(t, map[string]string{
+		"quay.io":                      "mirror.example.com/quay",
+		"quay.io/opendatahub":          "mirror.example.com/odh",
+		"quay.io/opendatahub/notebook": "private.example.com/notebook",
+		"registry.redhat.io":           "mirror.example.com/redhat",
+	}, idms)
+
+	images, err := deploy.NewImageOverrides(t.Context(), cli)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(images.Resolve("quay.io/opendatahub/dashboard:v2")).Should(Equal("mirror.example.com/odh/dashboard:v2"))
+	g.Expect(images.Resolve("quay.io/opendatahub/notebook:v2")).Should(Equal("private.example.com/notebook:v2"))
+	g.Expect(images.Resolve("quay.io/opendatahub-io/other:v2")).Should(Equal("mirror.example.com/quay/opendatahub-io/other:v2"))
+	g.Expect(images.Resolve("docker.io/library/nginx:1")).Should(Equal("docker.io/library/nginx:1"))
+
+	// digests of repositories mirrored by the cluster are pulled through the cluster mirrors
+	g.Expect(images.Resolve("registry.redhat.io/rhoai/dashboard@" + testImageDigest)).Should(Equal("registry.redhat.io/rhoai/dashboard@" + testImageDigest))
+	g.Expect(images.Resolve("registry.redhat.io/rhoai/dashboard:v2")).Should(Equal("mirror.example.com/redhat/rhoai/dashboard:v2"))
+	g.Expect(images.Resolve("registry.redhat.io/ubi9/ubi@" + testImageDigest)).Should(Equal("mirror.example.com/redhat/ubi9/ubi@" + testImageDigest))
+}
+
+func TestImageOverridesApply(t *testing.T) {
+	g := NewWithT(t)
+
+	cli := newImageOverridesTestClient(t, map[string]string{"quay.io/opendatahub": "mirror.example.com/odh"})
+
+	images, err := deploy.NewImageOverrides(t.Context(), cli)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	deployment, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "quay.io/opendatahub/init:v1"}},
+					Containers: []corev1.Container{
+						{Name: "manager", Image: "quay.io/opendatahub/manager:v1"},
+						{Name: "proxy", Image: "registry.example.com/proxy:v1"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cronJob, err := resources.ToUnstructured(&batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: "CronJob"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "job", Image: "quay.io/opendatahub/job:v1"}},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(deploy.ContainerImages(deployment)).Should(Equal([]string{
+		"quay.io/opendatahub/init:v1", "quay.io/opendatahub/manager:v1", "registry.example.com/proxy:v1",
+	}))
+	g.Expect(deploy.ContainerImages(cronJob)).Should(Equal([]string{"quay.io/opendatahub/job:v1"}))
+
+	g.Expect(images.Apply(deployment)).Should(Succeed())
+	g.Expect(images.Apply(cronJob)).Should(Succeed())
+
+	g.Expect(deployment).Should(And(
+		jq.Match(`.spec.template.spec.initContainers[0].image == "mirror.example.com/odh/init:v1"`),
+		jq.Match(`.spec.template.spec.containers[0].image == "mirror.example.com/odh/manager:v1"`),
+		jq.Match(`.spec.template.spec.containers[1].image == "registry.example.com/proxy:v1"`),
+	))
+	g.Expect(cronJob).Should(
+		jq.Match(`.spec.jobTemplate.spec.template.spec.containers[0].image == "mirror.example.com/odh/job:v1"`),
+	)
+}
+
+func TestImageOverridesWithoutDSCI(t *testing.T) {
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	images, err := deploy.NewImageOverrides(t.Context(), cli)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(images.Resolve("quay.io/opendatahub/dashboard:v2")).Should(Equal("quay.io/opendatahub/dashboard:v2"))
+}
@@ -0,0 +1,176 @@
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// fieldSegment is a step of a field path: either a field of an object, or a selector
+// matching the items of a list by index or by the value of one of their fields.
+type fieldSegment struct {
+	field string
+	index int
+	key   string
+	value string
+}
+
+func (s fieldSegment) matches(i int, item any) bool {
+	if s.key == "" {
+		return s.index == i
+	}
+
+	m, ok := item.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	v, ok := m[s.key].(string)
+
+	return ok && v == s.value
+}
+
+// RemoveExcludedFields removes from the desired resource the fields listed in the managed-fields-exclude
+// annotation, set either in the manifests or on the deployed resource. As the resource is applied with
+// server-side apply, the operator gives up the ownership of the removed fields, so the values set by
+// other field managers (e.g. an HPA scaling the replicas) are left untouched.
+func RemoveExcludedFields(obj *unstructured.Unstructured, current *unstructured.Unstructured) error {
+	paths := excludedFields(obj)
+	if current != nil {
+		paths = append(paths, excludedFields(current)...)
+	}
+
+	for _, p := range paths {
+		segments, err := parseFieldPath(p)
+		if err != nil {
+			return fmt.Errorf("invalid %s annotation on %s %s: %w", annotations.ManagedFieldsExclude, obj.GetKind(), obj.GetName(), err)
+		}
+
+		obj.Object, _ = removeField(obj.Object, segments).(map[string]any)
+	}
+
+	return nil
+}
+
+func excludedFields(obj *unstructured.Unstructured) []string {
+	value := resources.GetAnnotation(obj, annotations.ManagedFieldsExclude)
+	if value == "" {
+		return nil
+	}
+
+	paths := make([]string, 0)
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// parseFieldPath parses a path like .spec.template.spec.containers[name=manager].env[0]
+// into its segments.
+func parseFieldPath(path string) ([]fieldSegment, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("path %q must start with a dot", path)
+	}
+
+	segments := make([]fieldSegment, 0)
+	rest := path
+
+	for rest != "" {
+		if !strings.HasPrefix(rest, ".") {
+			return nil, fmt.Errorf("path %q: unexpected %q", path, rest)
+		}
+		rest = rest[1:]
+
+		end := strings.IndexAny(rest, ".[")
+		if end == -1 {
+			end = len(rest)
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("path %q: empty field name", path)
+		}
+
+		segments = append(segments, fieldSegment{field: rest[:end]})
+		rest = rest[end:]
+
+		for strings.HasPrefix(rest, "[") {
+			closing := strings.Index(rest, "]")
+			if closing == -1 {
+				return nil, fmt.Errorf("path %q: unterminated selector", path)
+			}
+
+			selector, err := parseSelector(rest[1:closing])
+			if err != nil {
+				return nil, fmt.Errorf("path %q: %w", path, err)
+			}
+
+			segments = append(segments, selector)
+			rest = rest[closing+1:]
+		}
+	}
+
+	return segments, nil
+}
+
+func parseSelector(selector string) (fieldSegment, error) {
+	if key, value, found := strings.Cut(selector, "="); found {
+		if key == "" {
+			return fieldSegment{}, errors.New("empty selector key")
+		}
+
+		return fieldSegment{key: key, value: value}, nil
+	}
+
+	index, err := strconv.Atoi(selector)
+	if err != nil || index < 0 {
+		return fieldSegment{}, fmt.Errorf("invalid selector %q", selector)
+	}
+
+	return fieldSegment{index: index}, nil
+}
+
+func removeField(node any, segments []fieldSegment) any {
+	s := segments[0]
+
+	switch n := node.(type) {
+	case map[string]any:
+		if s.field == "" {
+			return n
+		}
+		if len(segments) == 1 {
+			delete(n, s.field)
+			return n
+		}
+		if v, ok := n[s.field]; ok {
+			n[s.field] = removeField(v, segments[1:])
+		}
+
+		return n
+	case []any:
+		if s.field != "" {
+			return n
+		}
+
+		items := make([]any, 0, len(n))
+		for i, item := range n {
+			switch {
+			case !s.matches(i, item):
+				items = append(items, item)
+			case len(segments) > 1:
+				items = append(items, removeField(item, segments[1:]))
+			}
+		}
+
+		return items
+	default:
+		return node
+	}
+}
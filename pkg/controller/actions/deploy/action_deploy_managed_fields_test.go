@@ -0,0 +1,99 @@
+package deploy_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newManagedFieldsTestDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name": "test",
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":  "manager",
+							"image": "manager:v1",
+							"env": []any{
+								map[string]any{"name": "LOG_LEVEL", "value": "info"},
+								map[string]any{"name": "MODE", "value": "default"},
+							},
+						},
+						map[string]any{
+							"name":  "proxy",
+							"image": "proxy:v1",
+						},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestRemoveExcludedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newManagedFieldsTestDeployment()
+
+	current := newManagedFieldsTestDeployment()
+	current.SetAnnotations(map[string]string{
+		annotations.ManagedFieldsExclude: ".spec.replicas, .spec.template.spec.containers[name=manager].env[name=LOG_LEVEL],.spec.template.spec.containers[1].image",
+	})
+
+	err := deploy.RemoveExcludedFields(obj, current)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec | has("replicas") | not`),
+		jq.Match(`.spec.template.spec.containers[0].env == [{"name": "MODE", "value": "default"}]`),
+		jq.Match(`.spec.template.spec.containers[0].image == "manager:v1"`),
+		jq.Match(`.spec.template.spec.containers[1] == {"name": "proxy"}`),
+	))
+}
+
+func TestRemoveExcludedFieldsFromManifests(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newManagedFieldsTestDeployment()
+	obj.SetAnnotations(map[string]string{
+		annotations.ManagedFieldsExclude: ".spec.template.spec.containers[name=proxy]",
+	})
+
+	err := deploy.RemoveExcludedFields(obj, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.replicas == 1`),
+		jq.Match(`.spec.template.spec.containers | length == 1`),
+		jq.Match(`.spec.template.spec.containers[0].name == "manager"`),
+	))
+}
+
+func TestRemoveExcludedFieldsInvalidPath(t *testing.T) {
+	for _, path := range []string{"spec.replicas", ".spec..replicas", ".spec.containers[name=manager", ".spec.containers[-1]", ".spec.containers[=x]"} {
+		t.Run(path, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := newManagedFieldsTestDeployment()
+			obj.SetAnnotations(map[string]string{
+				annotations.ManagedFieldsExclude: path,
+			})
+
+			err := deploy.RemoveExcludedFields(obj, nil)
+			g.Expect(err).Should(MatchError(ContainSubstring(annotations.ManagedFieldsExclude)))
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 func MergeDeployments(source *unstructured.Unstructured, target *unstructured.Unstructured) error {
@@ -92,6 +94,11 @@ func MergeDeployments(source *unstructured.Unstructured, target *unstructured.Un
 	// Replicas
 	//
 
+	// the replicas set via the platform API take precedence over the ones set on the cluster
+	if target.GetAnnotations()[annotations.ManagedReplicas] == "true" {
+		return nil
+	}
+
 	sourceReplica, ok, err := unstructured.NestedFieldNoCopy(source.Object, replicasPath...)
 	if err != nil {
 		return err
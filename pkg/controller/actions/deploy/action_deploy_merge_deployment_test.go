@@ -6,11 +6,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 
 	. "github.com/onsi/gomega"
@@ -143,3 +145,58 @@ func TestMergeDeploymentsRemove(t *testing.T) {
 		jq.Match(`.spec.template.spec.containers[0] | has("resources") | not`),
 	))
 }
+
+func TestMergeDeploymentsManagedReplicas(t *testing.T) {
+	g := NewWithT(t)
+
+	source, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "test",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU: resource.MustParse("3"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	target, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{annotations.ManagedReplicas: "true"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "test",
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	src := unstructured.Unstructured{Object: source}
+	trg := unstructured.Unstructured{Object: target}
+
+	err = deploy.MergeDeployments(&src, &trg)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(trg).Should(And(
+		jq.Match(`.spec.replicas == 3`),
+		jq.Match(`.spec.template.spec.containers[0].resources.requests.cpu == "3"`),
+	))
+}
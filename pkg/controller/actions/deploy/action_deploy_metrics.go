@@ -18,6 +18,24 @@ var (
 			"controller",
 		},
 	)
+
+	// DeployErrorsTotal is a prometheus counter metrics which holds the total
+	// number of resources the action failed to deploy per controller. It has
+	// four labels.
+	// controller label refers to the controller name.
+	// group, version and kind labels refer to the GVK of the resource.
+	DeployErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "action_deploy_errors_total",
+			Help: "Number of resources failed to deploy",
+		},
+		[]string{
+			"controller",
+			"group",
+			"version",
+			"kind",
+		},
+	)
 )
 
 // init register metrics to the global registry from controller-runtime/pkg/metrics.
@@ -25,5 +43,5 @@ var (
 //
 //nolint:gochecknoinits
 func init() {
-	metrics.Registry.MustRegister(DeployedResourcesTotal)
+	metrics.Registry.MustRegister(DeployedResourcesTotal, DeployErrorsTotal)
 }
@@ -0,0 +1,75 @@
+package deploy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDeployErrorsMetrics(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	deploy.DeployErrorsTotal.Reset()
+
+	cl, err := fakeclient.New(fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			return errors.New("failure")
+		},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rendered, err := resources.ToUnstructured(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: xid.New().String(),
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	instance := &componentApi.Dashboard{}
+
+	rr := types.ReconciliationRequest{
+		Client:     cl,
+		Instance:   instance,
+		Conditions: conditions.NewManager(instance, status.ConditionTypeReady),
+		Release:    common.Release{Name: cluster.OpenDataHub},
+		Resources:  []unstructured.Unstructured{*rendered},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("Owns", mock.Anything).Return(false)
+			m.On("GetEventRecorder").Return(record.NewFakeRecorder(10))
+		}),
+	}
+
+	g.Expect(deploy.NewAction()(ctx, &rr)).Should(HaveOccurred())
+
+	g.Expect(testutil.ToFloat64(deploy.DeployErrorsTotal.WithLabelValues("dashboard", "", "v1", "ConfigMap"))).
+		Should(Equal(float64(1)))
+}
@@ -0,0 +1,77 @@
+package deploy
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const capabilityAll = "ALL"
+
+// ApplyRestrictedPodSecurity sets on the pod template of the given workload the securityContext
+// settings required by the restricted Pod Security Standard which the rendered manifest leaves unset:
+// runAsNonRoot and the RuntimeDefault seccomp profile for the pod, no privilege escalation and all
+// the capabilities dropped for its containers. The settings explicitly set by the manifest are kept,
+// the ones that can't comply being flagged by the podsecurity action.
+func ApplyRestrictedPodSecurity(obj *unstructured.Unstructured) error {
+	podSpecPath := []string{"spec", "template", "spec"}
+
+	podSpec, found, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := setDefault(podSpec, true, "securityContext", "runAsNonRoot"); err != nil {
+		return err
+	}
+	if err := setDefault(podSpec, map[string]any{"type": "RuntimeDefault"}, "securityContext", "seccompProfile"); err != nil {
+		return err
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, found, err := unstructured.NestedSlice(podSpec, field)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range containers {
+			container, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if err := setDefault(container, false, "securityContext", "allowPrivilegeEscalation"); err != nil {
+				return err
+			}
+
+			drop, _, err := unstructured.NestedStringSlice(container, "securityContext", "capabilities", "drop")
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(drop, capabilityAll) {
+				if err := unstructured.SetNestedStringSlice(container, append(drop, capabilityAll), "securityContext", "capabilities", "drop"); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(podSpec, containers, field); err != nil {
+			return err
+		}
+	}
+
+	return unstructured.SetNestedMap(obj.Object, podSpec, podSpecPath...)
+}
+
+// setDefault sets the given field to value when it is not set.
+func setDefault(obj map[string]any, value any, fields ...string) error {
+	_, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || found {
+		return err
+	}
+
+	return unstructured.SetNestedField(obj, value, fields...)
+}
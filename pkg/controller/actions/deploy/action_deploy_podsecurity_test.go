@@ -0,0 +1,56 @@
+package deploy_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApplyRestrictedPodSecurity(t *testing.T) {
+	g := NewWithT(t)
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "setup"}},
+					Containers: []corev1.Container{
+						{Name: "manager"},
+						{
+							Name: "proxy",
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(true),
+								Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"NET_RAW"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	obj := &unstructured.Unstructured{Object: u}
+
+	g.Expect(deploy.ApplyRestrictedPodSecurity(obj)).Should(Succeed())
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.template.spec.securityContext.runAsNonRoot == true`),
+		jq.Match(`.spec.template.spec.securityContext.seccompProfile.type == "RuntimeDefault"`),
+		jq.Match(`.spec.template.spec.initContainers[0].securityContext | .allowPrivilegeEscalation == false and .capabilities.drop == ["ALL"]`),
+		jq.Match(`.spec.template.spec.containers[0].securityContext | .allowPrivilegeEscalation == false and .capabilities.drop == ["ALL"]`),
+		// the settings explicitly set by the manifest are kept
+		jq.Match(`.spec.template.spec.containers[1].securityContext | .allowPrivilegeEscalation == true and .capabilities.drop == ["NET_RAW", "ALL"]`),
+	))
+}
@@ -0,0 +1,256 @@
+package deploy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const (
+	// ProxyCABundleConfigMapName is the ConfigMap holding, in the applications namespace, the cluster
+	// trusted CA bundle injected by OpenShift, mounted in the workloads with the proxy settings.
+	ProxyCABundleConfigMapName = "odh-trusted-ca-bundle"
+	// ProxyCABundleKey is the key the cluster trusted CA bundle is injected at in the ConfigMap.
+	ProxyCABundleKey = "ca-bundle.crt"
+	// ProxyCABundleMountPath is the path the cluster trusted CA bundle is mounted at, replacing the
+	// system trust store of the Red Hat based images.
+	ProxyCABundleMountPath = "/etc/pki/ca-trust/extracted/pem"
+)
+
+// ProxySettings injects the proxy settings of the cluster in the rendered workloads.
+type ProxySettings struct {
+	env []corev1.EnvVar
+	// namespace holding the cluster trusted CA bundle, empty when not injected
+	caBundleNamespace string
+}
+
+// NewProxySettings returns the proxy settings of the cluster, see cluster.GetProxy. The cluster trusted
+// CA bundle is mounted in the workloads of the applications namespace once injected in the
+// odh-trusted-ca-bundle ConfigMap.
+func NewProxySettings(ctx context.Context, cli client.Client) (*ProxySettings, error) {
+	proxy, err := cluster.GetProxy(ctx, cli)
+	if err != nil || proxy == nil {
+		return &ProxySettings{}, err
+	}
+
+	s := ProxySettings{}
+
+	for _, e := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: proxy.HTTPProxy},
+		{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy},
+		{Name: "NO_PROXY", Value: proxy.NoProxy},
+	} {
+		if e.Value != "" {
+			s.env = append(s.env, e)
+		}
+	}
+
+	appNamespace, err := cluster.ApplicationNamespace(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := corev1.ConfigMap{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: ProxyCABundleConfigMapName}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return &s, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", appNamespace, ProxyCABundleConfigMapName, err)
+	}
+
+	// mounting the ConfigMap without the bundle would hide the system trust store
+	if cm.Data[ProxyCABundleKey] != "" {
+		s.caBundleNamespace = appNamespace
+	}
+
+	return &s, nil
+}
+
+// NewProxyHTTPClient returns a client for the operator to reach external endpoints through the proxy
+// of the cluster, see cluster.GetProxy, falling back to the proxy environment variables of the
+// operator. It trusts the cluster trusted CA bundle of the odh-trusted-ca-bundle ConfigMap in addition
+// to the CA certificates of the system.
+func NewProxyHTTPClient(ctx context.Context, cli client.Client, timeout time.Duration) (*http.Client, error) {
+	proxy, err := cluster.GetProxy(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, _ := http.DefaultTransport.(*http.Transport)
+	transport = transport.Clone()
+
+	if proxy != nil {
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  proxy.HTTPProxy,
+			HTTPSProxy: proxy.HTTPSProxy,
+			NoProxy:    proxy.NoProxy,
+		}).ProxyFunc()
+
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	appNamespace, err := cluster.ApplicationNamespace(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := corev1.ConfigMap{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: appNamespace, Name: ProxyCABundleConfigMapName}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", appNamespace, ProxyCABundleConfigMapName, err)
+	case cm.Data[ProxyCABundleKey] != "":
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(cm.Data[ProxyCABundleKey])) {
+			return nil, fmt.Errorf("no CA certificate found in ConfigMap %s/%s", appNamespace, ProxyCABundleConfigMapName)
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+// Apply sets the proxy environment variables on the containers of the given Deployment, unless set
+// by the manifest, and mounts the cluster trusted CA bundle in them.
+func (s *ProxySettings) Apply(obj *unstructured.Unstructured) error {
+	if s == nil || len(s.env) == 0 || obj.GroupVersionKind() != gvk.Deployment {
+		return nil
+	}
+
+	podSpecPath := []string{"spec", "template", "spec"}
+
+	podSpec, found, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil || !found {
+		return err
+	}
+
+	mountCABundle := s.caBundleNamespace != "" && s.caBundleNamespace == obj.GetNamespace()
+
+	if mountCABundle {
+		volumes, _, err := unstructured.NestedSlice(podSpec, "volumes")
+		if err != nil {
+			return err
+		}
+
+		// the workloads mounting the ConfigMap already handle the bundle themselves
+		for _, v := range volumes {
+			if vm, ok := v.(map[string]any); ok && vm["name"] == ProxyCABundleConfigMapName {
+				mountCABundle = false
+			}
+		}
+
+		if mountCABundle {
+			volumes = append(volumes, map[string]any{
+				"name": ProxyCABundleConfigMapName,
+				"configMap": map[string]any{
+					"name": ProxyCABundleConfigMapName,
+					"items": []any{
+						map[string]any{"key": ProxyCABundleKey, "path": "tls-ca-bundle.pem"},
+					},
+				},
+			})
+
+			if err := unstructured.SetNestedSlice(podSpec, volumes, "volumes"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, found, err := unstructured.NestedSlice(podSpec, field)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if err := s.applyContainer(c, mountCABundle); err != nil {
+				return err
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(podSpec, containers, field); err != nil {
+			return err
+		}
+	}
+
+	return unstructured.SetNestedMap(obj.Object, podSpec, podSpecPath...)
+}
+
+func (s *ProxySettings) applyContainer(c map[string]any, mountCABundle bool) error {
+	env, _, err := unstructured.NestedSlice(c, "env")
+	if err != nil {
+		return err
+	}
+
+	for _, e := range s.env {
+		if !hasNamedItem(env, "name", e.Name) {
+			env = append(env, map[string]any{"name": e.Name, "value": e.Value})
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(c, env, "env"); err != nil {
+		return err
+	}
+
+	if !mountCABundle {
+		return nil
+	}
+
+	mounts, _, err := unstructured.NestedSlice(c, "volumeMounts")
+	if err != nil {
+		return err
+	}
+
+	if hasNamedItem(mounts, "mountPath", ProxyCABundleMountPath) {
+		return nil
+	}
+
+	mounts = append(mounts, map[string]any{
+		"name":      ProxyCABundleConfigMapName,
+		"mountPath": ProxyCABundleMountPath,
+		"readOnly":  true,
+	})
+
+	return unstructured.SetNestedSlice(c, mounts, "volumeMounts")
+}
+
+// hasNamedItem returns true if one of the given items has the field set to value.
+func hasNamedItem(items []any, field string, value string) bool {
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok && m[field] == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package deploy_test
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestProxySettings(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	newDeployment := func(t *testing.T, namespace string) *unstructured.Unstructured {
+		t.Helper()
+
+		u, err := resources.ToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "manager", Namespace: namespace},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "manager", Env: []corev1.EnvVar{{Name: "NO_PROXY", Value: "manifest"}}},
+				},
+			}}},
+		})
+		if err != nil {
+			t.Fatalf("failed to convert Deployment: %v", err)
+		}
+
+		return u
+	}
+
+	dsci := func(proxy *infrav1.ProxySpec) *dsciv2.DSCInitialization {
+		return &dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: appNamespace, Proxy: proxy},
+		}
+	}
+
+	t.Run("injects nothing without proxy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(dsci(nil)))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		s, err := deploy.NewProxySettings(ctx, cli)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		u := newDeployment(t, appNamespace)
+		g.Expect(s.Apply(u)).Should(Succeed())
+		g.Expect(u).Should(jq.Match(`.spec.template.spec.containers[0].env | length == 1`))
+	})
+
+	t.Run("injects the proxy settings of the DSCInitialization", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(
+			dsci(&infrav1.ProxySpec{HTTPProxy: "http://proxy:3128", HTTPSProxy: "http://proxy:3128", NoProxy: ".svc"}),
+		))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		s, err := deploy.NewProxySettings(ctx, cli)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		u := newDeployment(t, appNamespace)
+		g.Expect(s.Apply(u)).Should(Succeed())
+		g.Expect(u).Should(And(
+			jq.Match(`.spec.template.spec.containers[0].env | from_entries | .HTTP_PROXY == "http://proxy:3128" and .HTTPS_PROXY == "http://proxy:3128"`),
+			// the settings of the manifest are kept
+			jq.Match(`.spec.template.spec.containers[0].env | from_entries | .NO_PROXY == "manifest"`),
+			// the trusted CA bundle is not injected without the ConfigMap
+			jq.Match(`.spec.template.spec | has("volumes") | not`),
+		))
+	})
+
+	t.Run("injects the cluster Proxy settings and trusted CA bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(
+			dsci(&infrav1.ProxySpec{HTTPProxy: "http://ignored:3128"}),
+			&configv1.Proxy{
+				ObjectMeta: metav1.ObjectMeta{Name: cluster.ClusterProxyObj},
+				Status:     configv1.ProxyStatus{HTTPSProxy: "http://cluster-proxy:3128", NoProxy: ".cluster.local"},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: deploy.ProxyCABundleConfigMapName, Namespace: appNamespace},
+				Data:       map[string]string{deploy.ProxyCABundleKey: "bundle"},
+			},
+		))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		m, err := cli.RESTMapper().RESTMapping(gvk.ClusterProxy.GroupKind(), gvk.ClusterProxy.Version)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		crd := mocks.NewMockCRD(gvk.ClusterProxy.Group, gvk.ClusterProxy.Version, gvk.ClusterProxy.Kind, "cluster")
+		crd.Name = m.Resource.GroupResource().String()
+		crd.Status.StoredVersions = []string{gvk.ClusterProxy.Version}
+		g.Expect(cli.Create(ctx, crd)).Should(Succeed())
+
+		s, err := deploy.NewProxySettings(ctx, cli)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		u := newDeployment(t, appNamespace)
+		g.Expect(s.Apply(u)).Should(Succeed())
+		g.Expect(u).Should(And(
+			jq.Match(`.spec.template.spec.containers[0].env | from_entries | .HTTPS_PROXY == "http://cluster-proxy:3128" and (has("HTTP_PROXY") | not)`),
+			jq.Match(`.spec.template.spec.volumes[0].configMap | .name == "%s" and .items[0].key == "%s"`, deploy.ProxyCABundleConfigMapName, deploy.ProxyCABundleKey),
+			jq.Match(`.spec.template.spec.containers[0].volumeMounts[0].mountPath == "%s"`, deploy.ProxyCABundleMountPath),
+		))
+
+		// the bundle is only mounted in the applications namespace, where the ConfigMap is checked
+		other := newDeployment(t, "other")
+		g.Expect(s.Apply(other)).Should(Succeed())
+		g.Expect(other).Should(jq.Match(`.spec.template.spec | has("volumes") | not`))
+	})
+}
+
+func TestNewProxyHTTPClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv2.DSCInitializationSpec{
+				ApplicationsNamespace: appNamespace,
+				Proxy:                 &infrav1.ProxySpec{HTTPSProxy: "http://proxy:3128", NoProxy: ".svc"},
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: deploy.ProxyCABundleConfigMapName, Namespace: appNamespace},
+			Data:       map[string]string{deploy.ProxyCABundleKey: string(caBundle)},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	c, err := deploy.NewProxyHTTPClient(ctx, cli, time.Second)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	transport, ok := c.Transport.(*http.Transport)
+	g.Expect(ok).Should(BeTrue())
+
+	for target, proxy := range map[string]string{
+		"https://example.com/catalog.yaml":     "http://proxy:3128",
+		"https://dashboard.opendatahub.svc/ok": "",
+	} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		u, err := transport.Proxy(req)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		if proxy == "" {
+			g.Expect(u).Should(BeNil())
+		} else {
+			g.Expect(u.String()).Should(Equal(proxy))
+		}
+	}
+
+	// the loopback server is not proxied and its certificate is trusted through the CA bundle
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	resp, err := c.Do(req)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.Body.Close()).Should(Succeed())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+}
@@ -4,6 +4,9 @@ import (
 	"errors"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func RemoveDeploymentsResources(obj *unstructured.Unstructured) error {
@@ -40,7 +43,10 @@ func RemoveDeploymentsResources(obj *unstructured.Unstructured) error {
 	// Replicas
 	//
 
-	unstructured.RemoveNestedField(obj.Object, replicasPath...)
+	// the replicas set via the platform API are kept
+	if resources.GetAnnotation(obj, annotations.ManagedReplicas) != "true" {
+		unstructured.RemoveNestedField(obj.Object, replicasPath...)
+	}
 
 	return nil
 }
@@ -0,0 +1,107 @@
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+// ApplyResourcesOverrides merges the compute resources overrides targeting the given
+// Deployment over its containers using a strategic merge patch, so only the requests
+// and limits set in an override replace the ones of the rendered manifest. Overrides
+// targeting other Deployments are ignored.
+func ApplyResourcesOverrides(obj *unstructured.Unstructured, overrides []common.ResourcesOverride) error {
+	containersPath := []string{"spec", "template", "spec", "containers"}
+
+	names := make([]string, 0)
+	containers := make([]interface{}, 0)
+
+	for _, o := range overrides {
+		if o.Deployment != obj.GetName() {
+			continue
+		}
+
+		if len(names) == 0 {
+			sc, _, err := unstructured.NestedSlice(obj.Object, containersPath...)
+			if err != nil {
+				return err
+			}
+
+			for i := range sc {
+				m, ok := sc[i].(map[string]interface{})
+				if !ok {
+					return errors.New("field is not a map")
+				}
+
+				name, _, err := unstructured.NestedString(m, "name")
+				if err != nil {
+					return err
+				}
+
+				names = append(names, name)
+			}
+		}
+
+		name := o.Container
+		if name == "" && len(names) != 0 {
+			name = names[0]
+		}
+
+		// the container must exist as the patch would otherwise add a
+		// new container with only the resources set
+		if name == "" || !slices.Contains(names, name) {
+			return fmt.Errorf("container %q not found in Deployment %s", name, obj.GetName())
+		}
+
+		// a null value would be a delete directive, so only set what
+		// the override actually defines
+		resources := make(map[string]interface{})
+		if len(o.Requests) != 0 {
+			resources["requests"] = o.Requests
+		}
+		if len(o.Limits) != 0 {
+			resources["limits"] = o.Limits
+		}
+
+		containers = append(containers, map[string]interface{}{
+			"name":      name,
+			"resources": resources,
+		})
+	}
+
+	if len(containers) == 0 {
+		return nil
+	}
+
+	original, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": containers,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	merged, err := strategicpatch.StrategicMergePatch(original, patch, appsv1.Deployment{})
+	if err != nil {
+		return err
+	}
+
+	return obj.UnmarshalJSON(merged)
+}
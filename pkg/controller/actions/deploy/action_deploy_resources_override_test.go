@@ -0,0 +1,122 @@
+package deploy_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newOverrideTestDeployment(g *WithT) *unstructured.Unstructured {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "manager",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1"),
+									corev1.ResourceMemory: resource.MustParse("1Gi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("2"),
+									corev1.ResourceMemory: resource.MustParse("2Gi"),
+								},
+							},
+						},
+						{
+							Name: "kube-rbac-proxy",
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return &unstructured.Unstructured{Object: u}
+}
+
+func TestApplyResourcesOverrides(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newOverrideTestDeployment(g)
+
+	err := deploy.ApplyResourcesOverrides(obj, []common.ResourcesOverride{
+		{
+			Deployment: "test",
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("3"),
+			},
+		},
+		{
+			Deployment: "test",
+			Container:  "kube-rbac-proxy",
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+		},
+		{
+			Deployment: "other",
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("5"),
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// integer fields must keep the type expected by unstructured accessors
+	replicas, ok, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(replicas).Should(Equal(int64(2)))
+
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.template.spec.containers | length == 2`),
+		jq.Match(`.spec.template.spec.containers[0].name == "manager"`),
+		jq.Match(`.spec.template.spec.containers[0].resources.requests.cpu == "3"`),
+		jq.Match(`.spec.template.spec.containers[0].resources.requests.memory == "1Gi"`),
+		jq.Match(`.spec.template.spec.containers[0].resources.limits.cpu == "2"`),
+		jq.Match(`.spec.template.spec.containers[0].resources.limits.memory == "2Gi"`),
+		jq.Match(`.spec.template.spec.containers[1].name == "kube-rbac-proxy"`),
+		jq.Match(`.spec.template.spec.containers[1].resources.limits.memory == "64Mi"`),
+		jq.Match(`.spec.template.spec.containers[1].resources | has("requests") | not`),
+	))
+}
+
+func TestApplyResourcesOverridesUnknownContainer(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newOverrideTestDeployment(g)
+
+	err := deploy.ApplyResourcesOverrides(obj, []common.ResourcesOverride{{
+		Deployment: "test",
+		Container:  "unknown",
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("3"),
+		},
+	}})
+	g.Expect(err).Should(MatchError(ContainSubstring(`container "unknown" not found in Deployment test`)))
+	g.Expect(obj).Should(jq.Match(`.spec.template.spec.containers | length == 2`))
+}
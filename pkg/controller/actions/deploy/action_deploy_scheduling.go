@@ -0,0 +1,77 @@
+package deploy
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+// ApplyScheduling sets the scheduling constraints on the pod template of the given
+// workload. The node selector is merged over the one of the rendered manifest, while
+// tolerations and affinity, when set, replace the ones of the rendered manifest.
+func ApplyScheduling(obj *unstructured.Unstructured, scheduling *common.SchedulingSpec) error {
+	if scheduling == nil {
+		return nil
+	}
+
+	podSpecPath := []string{"spec", "template", "spec"}
+
+	if len(scheduling.NodeSelector) != 0 {
+		nodeSelector, _, err := unstructured.NestedStringMap(obj.Object, append(podSpecPath, "nodeSelector")...)
+		if err != nil {
+			return err
+		}
+		if nodeSelector == nil {
+			nodeSelector = make(map[string]string, len(scheduling.NodeSelector))
+		}
+		for k, v := range scheduling.NodeSelector {
+			nodeSelector[k] = v
+		}
+
+		if err := unstructured.SetNestedStringMap(obj.Object, nodeSelector, append(podSpecPath, "nodeSelector")...); err != nil {
+			return err
+		}
+	}
+
+	if scheduling.Tolerations != nil {
+		tolerations := make([]interface{}, 0, len(scheduling.Tolerations))
+		for i := range scheduling.Tolerations {
+			t, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling.Tolerations[i])
+			if err != nil {
+				return err
+			}
+
+			tolerations = append(tolerations, t)
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, tolerations, append(podSpecPath, "tolerations")...); err != nil {
+			return err
+		}
+	}
+
+	if scheduling.Affinity != nil {
+		affinity, err := runtime.DefaultUnstructuredConverter.ToUnstructured(scheduling.Affinity)
+		if err != nil {
+			return err
+		}
+
+		// the workloads stay restricted to the architectures their images support, see the multiarch action
+		architectures, err := requiredArchitectures(obj)
+		if err != nil {
+			return err
+		}
+
+		if err := unstructured.SetNestedMap(obj.Object, affinity, append(podSpecPath, "affinity")...); err != nil {
+			return err
+		}
+
+		if len(architectures) != 0 {
+			if err := RequireArchitectures(obj, architectures); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
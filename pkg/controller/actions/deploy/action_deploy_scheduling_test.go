@@ -0,0 +1,128 @@
+package deploy_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newSchedulingTestDeployment(g *WithT) *unstructured.Unstructured {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{
+						"kubernetes.io/os": "linux",
+						"node-role":        "worker",
+					},
+					Tolerations: []corev1.Toleration{
+						{Key: "manifest", Operator: corev1.TolerationOpExists},
+					},
+					Containers: []corev1.Container{
+						{Name: "manager"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return &unstructured.Unstructured{Object: u}
+}
+
+func TestApplyScheduling(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newSchedulingTestDeployment(g)
+
+	err := deploy.ApplyScheduling(obj, &common.SchedulingSpec{
+		NodeSelector: map[string]string{
+			"node-role": "ai",
+		},
+		Tolerations: []corev1.Toleration{
+			{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      "zone",
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"a"},
+						}},
+					}},
+				},
+			},
+		},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.template.spec.nodeSelector == {"kubernetes.io/os": "linux", "node-role": "ai"}`),
+		jq.Match(`.spec.template.spec.tolerations | length == 1`),
+		jq.Match(`.spec.template.spec.tolerations[0] == {"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}`),
+		jq.Match(`.spec.template.spec.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values == ["a"]`),
+	))
+}
+
+func TestApplySchedulingKeepsUnsetFields(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newSchedulingTestDeployment(g)
+
+	err := deploy.ApplyScheduling(obj, &common.SchedulingSpec{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	err = deploy.ApplyScheduling(obj, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.template.spec.nodeSelector == {"kubernetes.io/os": "linux", "node-role": "worker"}`),
+		jq.Match(`.spec.template.spec.tolerations == [{"key": "manifest", "operator": "Exists"}]`),
+		jq.Match(`.spec.template.spec | has("affinity") | not`),
+	))
+}
+
+func TestApplySchedulingKeepsRequiredArchitectures(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := newSchedulingTestDeployment(g)
+	g.Expect(deploy.RequireArchitectures(obj, []string{"amd64"})).Should(Succeed())
+
+	err := deploy.ApplyScheduling(obj, &common.SchedulingSpec{
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}}},
+						{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+					},
+				},
+			},
+		},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec.template.spec.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms | length == 2`),
+		jq.Match(`.spec.template.spec.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms | all(.matchExpressions[1] == {"key": "kubernetes.io/arch", "operator": "In", "values": ["amd64"]})`),
+	))
+}
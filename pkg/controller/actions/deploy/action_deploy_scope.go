@@ -0,0 +1,41 @@
+package deploy
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// ScopeResource adapts a resource rendered for the given instance when it was created by a scoped
+// DataScienceCluster, i.e. labeled with the applications namespace of that DataScienceCluster, and
+// returns whether the resource is deployed.
+//
+// The cluster-scoped resources the components share, e.g. CRDs, ClusterRoles or webhook configurations,
+// are only deployed by the instances of the DataScienceCluster bound to the applications namespace of the
+// DSCInitialization. The ClusterRoleBindings granting them to the ServiceAccounts of the scoped namespace
+// are the exception, they are deployed suffixed with the namespace. The deployed resources are labeled
+// with the DataScienceCluster and the applications namespace they belong to.
+func ScopeResource(instance client.Object, obj *unstructured.Unstructured) bool {
+	ns := instance.GetLabels()[labels.ApplicationsNamespace]
+	if ns == "" {
+		return true
+	}
+
+	if obj.GetNamespace() == "" {
+		if obj.GroupVersionKind() != gvk.ClusterRoleBinding {
+			return false
+		}
+
+		obj.SetName(obj.GetName() + "-" + ns)
+	}
+
+	resources.SetLabels(obj, map[string]string{
+		labels.DataScienceCluster:    instance.GetLabels()[labels.DataScienceCluster],
+		labels.ApplicationsNamespace: ns,
+	})
+
+	return true
+}
@@ -0,0 +1,76 @@
+package deploy_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScopeResource(t *testing.T) {
+	newResource := func(objGVK schema.GroupVersionKind, ns string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(objGVK)
+		u.SetName("test")
+		u.SetNamespace(ns)
+		return u
+	}
+
+	scoped := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: componentApi.DashboardInstanceName + "-team-a",
+			Labels: map[string]string{
+				labels.DataScienceCluster:    "team-a",
+				labels.ApplicationsNamespace: "team-a",
+			},
+		},
+	}
+
+	t.Run("keeps the resources of unscoped instances", func(t *testing.T) {
+		g := NewWithT(t)
+
+		res := newResource(gvk.CustomResourceDefinition, "")
+
+		g.Expect(deploy.ScopeResource(&componentApi.Dashboard{}, res)).To(BeTrue())
+		g.Expect(res.GetName()).To(Equal("test"))
+		g.Expect(res.GetLabels()).To(BeEmpty())
+	})
+
+	t.Run("labels the namespaced resources of scoped instances", func(t *testing.T) {
+		g := NewWithT(t)
+
+		res := newResource(gvk.Deployment, "team-a")
+
+		g.Expect(deploy.ScopeResource(scoped, res)).To(BeTrue())
+		g.Expect(res.GetName()).To(Equal("test"))
+		g.Expect(res.GetLabels()).To(And(
+			HaveKeyWithValue(labels.DataScienceCluster, "team-a"),
+			HaveKeyWithValue(labels.ApplicationsNamespace, "team-a"),
+		))
+	})
+
+	t.Run("skips the shared cluster-scoped resources of scoped instances", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(deploy.ScopeResource(scoped, newResource(gvk.CustomResourceDefinition, ""))).To(BeFalse())
+		g.Expect(deploy.ScopeResource(scoped, newResource(gvk.ClusterRole, ""))).To(BeFalse())
+	})
+
+	t.Run("suffixes the ClusterRoleBindings of scoped instances", func(t *testing.T) {
+		g := NewWithT(t)
+
+		res := newResource(gvk.ClusterRoleBinding, "")
+
+		g.Expect(deploy.ScopeResource(scoped, res)).To(BeTrue())
+		g.Expect(res.GetName()).To(Equal("test-team-a"))
+		g.Expect(res.GetLabels()).To(HaveKeyWithValue(labels.ApplicationsNamespace, "team-a"))
+	})
+}
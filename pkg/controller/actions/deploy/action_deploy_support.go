@@ -1,10 +1,16 @@
 package deploy
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func isLegacyOwnerRef(or metav1.OwnerReference) bool {
@@ -46,3 +52,42 @@ func ownedTypeIs(ownerType *schema.GroupVersionKind) func(or metav1.OwnerReferen
 		return ownerType.Kind == or.Kind && gv == or.APIVersion
 	}
 }
+
+// backupTier returns the backup tier of the resources of the given kind, unless set in
+// the manifests only persistent volume claims are expected to hold user data.
+func backupTier(kind schema.GroupVersionKind) string {
+	switch kind {
+	case gvk.PersistentVolumeClaim:
+		return labels.BackupTierData
+	default:
+		return labels.BackupTierConfig
+	}
+}
+
+// isManaged returns whether the given resource found on the cluster is managed by the
+// platform: deployed by this or a previous version of the operator, owned by one of the
+// platform resources, or explicitly marked as managed by the user.
+func isManaged(obj *unstructured.Unstructured) bool {
+	if resources.GetAnnotation(obj, annotations.ManagedByODHOperator) == "true" {
+		return true
+	}
+
+	if resources.GetLabel(obj, labels.PlatformPartOf) != "" || resources.GetAnnotation(obj, annotations.PlatformType) != "" {
+		return true
+	}
+
+	for k := range obj.GetLabels() {
+		if strings.HasPrefix(k, labels.ODHAppPrefix+"/") {
+			return true
+		}
+	}
+
+	for _, or := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(or.APIVersion)
+		if err == nil && strings.HasSuffix(gv.Group, "opendatahub.io") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 
 	. "github.com/onsi/gomega"
 )
@@ -79,3 +80,13 @@ func TestIsLegacyOwnerRef(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupTier(t *testing.T) {
+	t.Parallel()
+
+	g := NewWithT(t)
+
+	g.Expect(backupTier(gvk.PersistentVolumeClaim)).Should(Equal(labels.BackupTierData))
+	g.Expect(backupTier(gvk.StatefulSet)).Should(Equal(labels.BackupTierConfig))
+	g.Expect(backupTier(gvk.Secret)).Should(Equal(labels.BackupTierConfig))
+}
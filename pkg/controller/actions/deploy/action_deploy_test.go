@@ -32,6 +32,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/export"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -98,12 +99,164 @@ func TestDeployAction(t *testing.T) {
 
 	g.Expect(obj1).Should(And(
 		jq.Match(`.metadata.labels."%s" == "%s"`, labels.PlatformPartOf, strings.ToLower(componentApi.DashboardKind)),
+		jq.Match(`.metadata.labels."%s" == "%s"`, labels.BackupTier, labels.BackupTierConfig),
 		jq.Match(`.metadata.annotations."%s" == "%s"`, annotations.InstanceGeneration, strconv.FormatInt(rr.Instance.GetGeneration(), 10)),
 		jq.Match(`.metadata.annotations."%s" == "%s"`, annotations.PlatformVersion, "1.2.3"),
 		jq.Match(`.metadata.annotations."%s" == "%s"`, annotations.PlatformType, string(cluster.OpenDataHub)),
 	))
 }
 
+func TestDeployInventory(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := deploy.NewAction(
+		deploy.WithMode(deploy.ModePatch),
+	)
+
+	owned, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: ns,
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	notOwned, err := resources.ToUnstructured(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: ns,
+			Annotations: map[string]string{
+				annotations.ManagedByODHOperator: "false",
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		Instance: &componentApi.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 1,
+			},
+		},
+		Release:   common.Release{Name: cluster.OpenDataHub},
+		Resources: []unstructured.Unstructured{*owned, *notOwned},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("Owns", mock.Anything).Return(false)
+		}),
+	}
+
+	err = action(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rr.Instance.GetStatus().Resources).Should(HaveExactElements(
+		gstruct.MatchAllFields(gstruct.Fields{
+			"Group":     Equal(gvk.Deployment.Group),
+			"Version":   Equal(gvk.Deployment.Version),
+			"Kind":      Equal(gvk.Deployment.Kind),
+			"Namespace": Equal(ns),
+			"Name":      Equal(owned.GetName()),
+			"Hash":      Not(BeEmpty()),
+		}),
+	))
+}
+
+func TestDeployGitOps(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+		Spec: dscv2.DataScienceClusterSpec{
+			DeployMode: common.DeployModeGitOps,
+			GitOps:     &common.GitOpsSpec{Repository: "quay.io/example/odh-manifests"},
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsc))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := deploy.NewAction(
+		deploy.WithMode(deploy.ModePatch),
+	)
+
+	deployment, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: ns,
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	secret, err := resources.ToUnstructured(&corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: ns,
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		Instance: &componentApi.Ray{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 1,
+			},
+		},
+		Release:   common.Release{Name: cluster.OpenDataHub},
+		Resources: []unstructured.Unstructured{*deployment, *secret},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("Owns", mock.Anything).Return(false)
+		}),
+	}
+
+	err = action(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// the Deployment is left to the GitOps tool, the Secret is applied
+	err = cl.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})
+	g.Expect(err).Should(MatchError(ContainSubstring("not found")))
+
+	err = cl.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rr.Instance.GetStatus().Resources).Should(HaveExactElements(
+		HaveField("Kind", gvk.Secret.Kind),
+	))
+
+	g.Expect(export.DefaultStore().Resources()).Should(HaveKeyWithValue(
+		strings.ToLower(componentApi.RayKind),
+		ContainElement(And(
+			jq.Match(`.metadata.name == "%s"`, deployment.GetName()),
+			jq.Match(`.metadata.labels."%s" == "%s"`, labels.PlatformPartOf, strings.ToLower(componentApi.RayKind)),
+		)),
+	))
+}
+
 func TestDeployNotOwnedSkip(t *testing.T) {
 	g := NewWithT(t)
 
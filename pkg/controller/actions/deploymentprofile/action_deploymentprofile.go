@@ -0,0 +1,162 @@
+package deploymentprofile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// SNORequests caps the resource requests of the containers with the sno deployment profile.
+var SNORequests = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("100m"),
+	corev1.ResourceMemory: resource.MustParse("256Mi"),
+}
+
+// Action minimizes, when the sno deployment profile is selected in the DSCInitialization, the
+// footprint of the resources rendered by the component for single-node and edge clusters: the
+// PodDisruptionBudgets, only meaningful with several nodes, are removed and the workloads are
+// scaled to one replica, without their optional sidecars and with capped resource requests.
+// The templates can also tailor the resources to the profile with the DeploymentProfile data.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	profile, err := cluster.DeploymentProfile(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if profile != infrav1.DeploymentProfileSNO {
+		return nil
+	}
+
+	err = rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+		return u.GroupVersionKind() == gvk.PodDisruptionBudget
+	})
+	if err != nil {
+		return err
+	}
+
+	return rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if k := u.GroupVersionKind(); k != gvk.Deployment && k != gvk.StatefulSet {
+			return false, nil
+		}
+
+		if err := Minimize(u); err != nil {
+			return false, fmt.Errorf("failed to apply the sno deployment profile to %s: %w", resources.FormatObjectReference(u), err)
+		}
+
+		return false, nil
+	})
+}
+
+// Minimize scales the given workload to one replica, removes the containers listed in its
+// opendatahub.io/optional-containers annotation and caps the resource requests of the other
+// containers to SNORequests.
+func Minimize(obj *unstructured.Unstructured) error {
+	replicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if err != nil {
+		return err
+	}
+
+	if found && replicas > 1 {
+		if err := unstructured.SetNestedField(obj.Object, int64(1), "spec", "replicas"); err != nil {
+			return err
+		}
+	}
+
+	optional := make([]string, 0)
+	for _, name := range strings.Split(resources.GetAnnotation(obj, annotations.OptionalContainers), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			optional = append(optional, name)
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := []string{"spec", "template", "spec", field}
+
+		containers, found, err := unstructured.NestedSlice(obj.Object, fieldPath...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		kept := make([]any, 0, len(containers))
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				kept = append(kept, containers[i])
+				continue
+			}
+
+			if name, _ := c["name"].(string); slices.Contains(optional, name) {
+				continue
+			}
+
+			if err := capRequests(c); err != nil {
+				return err
+			}
+
+			kept = append(kept, c)
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, kept, fieldPath...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// capRequests lowers the resource requests of the given container above SNORequests to them.
+func capRequests(container map[string]any) error {
+	requests, found, err := unstructured.NestedMap(container, "resources", "requests")
+	if err != nil || !found {
+		return err
+	}
+
+	for name, limit := range SNORequests {
+		v, ok := requests[string(name)]
+		if !ok {
+			continue
+		}
+
+		q, err := resource.ParseQuantity(fmt.Sprint(v))
+		if err != nil {
+			return fmt.Errorf("invalid %s request of container %v: %w", name, container["name"], err)
+		}
+
+		if q.Cmp(limit) > 0 {
+			requests[string(name)] = limit.String()
+		}
+	}
+
+	return unstructured.SetNestedMap(container, requests, "resources", "requests")
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,144 @@
+package deploymentprofile_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploymentprofile"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) unstructured.Unstructured {
+	t.Helper()
+
+	u, err := resources.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to convert %T: %v", obj, err)
+	}
+
+	return *u
+}
+
+func rendered(t *testing.T) []unstructured.Unstructured {
+	t.Helper()
+
+	return []unstructured.Unstructured{
+		toUnstructured(t, &appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "dashboard",
+				Namespace:   "opendatahub",
+				Annotations: map[string]string{annotations.OptionalContainers: "kube-rbac-proxy, unknown"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "dashboard",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("500m"),
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+								Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+							},
+						},
+						{Name: "kube-rbac-proxy"},
+					},
+				}},
+			},
+		}),
+		toUnstructured(t, &policyv1.PodDisruptionBudget{
+			TypeMeta:   metav1.TypeMeta{APIVersion: policyv1.SchemeGroupVersion.String(), Kind: "PodDisruptionBudget"},
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "opendatahub"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+		}),
+	}
+}
+
+func TestDeploymentProfileAction(t *testing.T) {
+	ctx := t.Context()
+
+	run := func(t *testing.T, profile infrav1.DeploymentProfile) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{DeploymentProfile: profile},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := types.ReconciliationRequest{
+			Client:    cli,
+			Resources: rendered(t),
+		}
+
+		g.Expect(deploymentprofile.NewAction()(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("keeps the resources with the default profile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, profile := range []infrav1.DeploymentProfile{"", infrav1.DeploymentProfileDefault} {
+			g.Expect(run(t, profile).Resources).Should(Equal(rendered(t)))
+		}
+	})
+
+	t.Run("minimizes the resources with the sno profile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, infrav1.DeploymentProfileSNO)
+
+		g.Expect(rr.Resources).Should(HaveLen(1))
+		g.Expect(rr.Resources[0]).Should(And(
+			jq.Match(`.kind == "Deployment"`),
+			jq.Match(`.spec.replicas == 1`),
+			jq.Match(`.spec.template.spec.containers | map(.name) == ["dashboard"]`),
+			jq.Match(`.spec.template.spec.containers[0].resources.requests == {"cpu": "100m", "memory": "128Mi"}`),
+			jq.Match(`.spec.template.spec.containers[0].resources.limits == {"cpu": "1"}`),
+		))
+	})
+}
+
+func TestMinimize(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "StatefulSet",
+		"spec": map[string]any{
+			"template": map[string]any{"spec": map[string]any{
+				"containers": []any{
+					map[string]any{"name": "db", "resources": map[string]any{"requests": map[string]any{"cpu": int64(2), "memory": "1Gi"}}},
+				},
+			}},
+		},
+	}}
+
+	g.Expect(deploymentprofile.Minimize(&obj)).Should(Succeed())
+	g.Expect(obj).Should(And(
+		jq.Match(`.spec | has("replicas") | not`),
+		jq.Match(`.spec.template.spec.containers[0].resources.requests == {"cpu": "100m", "memory": "256Mi"}`),
+	))
+}
@@ -0,0 +1,94 @@
+package disconnected
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action verifies, on a disconnected cluster, recognized by the image mirrors configured through
+// ImageDigestMirrorSets, ImageTagMirrorSets or ImageContentSourcePolicies, that the images of the
+// workloads rendered by the component can be pulled: each image has to be mirrored by the cluster
+// or redirected by the image overrides of the DSCInitialization. The component is not deployed
+// while some images can't be pulled, the ImagesMirrored condition listing them instead, so a
+// disconnected install is not left half deployed with workloads failing to pull their images.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	mirrors, err := deploy.NewImageMirrors(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get the image mirrors: %w", err)
+	}
+
+	if !mirrors.Configured() {
+		return nil
+	}
+
+	overrides, err := deploy.NewImageOverrides(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to get image overrides: %w", err)
+	}
+
+	unmirrored := make([]string, 0)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		images, err := deploy.ContainerImages(u)
+		if err != nil {
+			return false, err
+		}
+
+		for _, image := range images {
+			if overrides.Resolve(image) != image || mirrors.Mirrored(image) {
+				continue
+			}
+
+			if entry := fmt.Sprintf("%s (%s)", image, resources.FormatObjectReference(u)); !slices.Contains(unmirrored, entry) {
+				unmirrored = append(unmirrored, entry)
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(unmirrored) != 0 {
+		msg := "Images not mirrored on the disconnected cluster: " + strings.Join(unmirrored, ", ")
+
+		rr.Conditions.MarkFalse(
+			status.ConditionTypeImagesMirrored,
+			conditions.WithReason(status.UnmirroredImagesReason),
+			conditions.WithMessage("%s", msg),
+		)
+
+		return odherrors.NewStopError("%s", msg)
+	}
+
+	rr.Conditions.MarkTrue(status.ConditionTypeImagesMirrored)
+
+	return nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
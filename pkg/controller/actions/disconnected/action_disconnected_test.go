@@ -0,0 +1,154 @@
+package disconnected_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/disconnected"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func mirrorSet(kind schema.GroupVersionKind, field string, sources ...string) *unstructured.Unstructured {
+	mirrors := make([]any, 0, len(sources))
+	for _, source := range sources {
+		mirrors = append(mirrors, map[string]any{"source": source, "mirrors": []any{"mirror.example.com/" + source}})
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{field: mirrors}}}
+	u.SetGroupVersionKind(kind)
+	u.SetName("mirrors")
+
+	return u
+}
+
+func deployment(t *testing.T, images ...string) unstructured.Unstructured {
+	t.Helper()
+
+	containers := make([]corev1.Container, 0, len(images))
+	for _, image := range images {
+		containers = append(containers, corev1.Container{Name: "manager", Image: image})
+	}
+
+	u, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "opendatahub"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: containers,
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("failed to convert Deployment: %v", err)
+	}
+
+	return *u
+}
+
+func TestDisconnectedAction(t *testing.T) {
+	ctx := t.Context()
+
+	newRequest := func(t *testing.T, overrides map[string]string, objs []client.Object, res ...unstructured.Unstructured) *types.ReconciliationRequest {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{ImageOverrides: overrides},
+		}))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		for _, kind := range []schema.GroupVersionKind{gvk.ImageDigestMirrorSet, gvk.ImageTagMirrorSet} {
+			m, err := cli.RESTMapper().RESTMapping(kind.GroupKind(), kind.Version)
+			if err != nil {
+				t.Fatalf("failed to get the REST mapping of %s: %v", kind.Kind, err)
+			}
+
+			crd := mocks.NewMockCRD(kind.Group, kind.Version, kind.Kind, "cluster")
+			crd.Name = m.Resource.GroupResource().String()
+			crd.Status.StoredVersions = []string{kind.Version}
+
+			if err := cli.Create(ctx, crd); err != nil {
+				t.Fatalf("failed to create CRD %s: %v", crd.Name, err)
+			}
+		}
+
+		for _, obj := range objs {
+			if err := cli.Create(ctx, obj.DeepCopyObject().(client.Object)); err != nil {
+				t.Fatalf("failed to create %s: %v", obj.GetObjectKind().GroupVersionKind().Kind, err)
+			}
+		}
+
+		d := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+
+		return &types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   d,
+			Conditions: conditions.NewManager(d, status.ConditionTypeReady),
+			Resources:  res,
+		}
+	}
+
+	mirrors := []client.Object{
+		mirrorSet(gvk.ImageDigestMirrorSet, "imageDigestMirrors", "registry.redhat.io/rhoai"),
+		mirrorSet(gvk.ImageTagMirrorSet, "imageTagMirrors", "quay.io/opendatahub"),
+	}
+
+	t.Run("skips the verification without mirrors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, nil, nil, deployment(t, "quay.io/other:v1"))
+
+		g.Expect(disconnected.NewAction()(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeImagesMirrored)).Should(BeNil())
+	})
+
+	t.Run("accepts the mirrored and overridden images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, map[string]string{"quay.io/other": "mirror.example.com/other"}, mirrors, deployment(t,
+			"registry.redhat.io/rhoai/dashboard@"+testImageDigest,
+			"quay.io/opendatahub/kube-rbac-proxy:v4",
+			"quay.io/other/sidecar:v1",
+		))
+
+		g.Expect(disconnected.NewAction()(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeImagesMirrored)).Should(HaveField("Status", metav1.ConditionTrue))
+	})
+
+	t.Run("stops on the unmirrored images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, nil, mirrors, deployment(t,
+			"registry.redhat.io/rhoai/dashboard:v2",
+			"quay.io/opendatahub/kube-rbac-proxy:v4",
+		))
+
+		err := disconnected.NewAction()(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+		g.Expect(err.Error()).Should(ContainSubstring("registry.redhat.io/rhoai/dashboard:v2"))
+		g.Expect(err.Error()).ShouldNot(ContainSubstring("kube-rbac-proxy"))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeImagesMirrored)).Should(And(
+			HaveField("Status", metav1.ConditionFalse),
+			HaveField("Reason", status.UnmirroredImagesReason),
+		))
+	})
+}
@@ -0,0 +1,177 @@
+package fips
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// VariantSuffix suffixes the environment variable holding the FIPS-compliant variant of the image
+// of a RELATED_IMAGE_ environment variable, e.g. RELATED_IMAGE_ODH_DASHBOARD_IMAGE_FIPS. An image
+// already FIPS-compliant is declared by a variant equal to the image itself.
+const VariantSuffix = "_FIPS"
+
+// Action replaces, when FIPS-compliant images are required by the DSCInitialization, the images of
+// the workloads rendered by the component with their FIPS variant. The component is not deployed
+// when one of its images has no FIPS variant, a Degraded condition listing the images instead.
+type Action struct {
+	environ func() []string
+}
+
+type ActionOpts func(*Action)
+
+// WithEnviron sets the function returning the environment the FIPS variants are read from,
+// os.Environ by default.
+func WithEnviron(fn func() []string) ActionOpts {
+	return func(action *Action) {
+		action.environ = fn
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	required, err := cluster.FIPSRequired(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if !required {
+		return nil
+	}
+
+	v := NewVariants(a.environ())
+	nonCompliant := make([]string, 0)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		images, err := v.Apply(u)
+		if err != nil {
+			return false, err
+		}
+
+		for _, image := range images {
+			nonCompliant = append(nonCompliant, fmt.Sprintf("%s (%s)", image, resources.FormatObjectReference(u)))
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(nonCompliant) != 0 {
+		msg := "Images without a FIPS-compliant variant: " + strings.Join(nonCompliant, ", ")
+
+		rr.Conditions.MarkFalse(
+			status.ConditionTypeFIPSCompliant,
+			conditions.WithReason(status.FIPSNonCompliantReason),
+			conditions.WithMessage("%s", msg),
+		)
+
+		return odherrors.NewStopError("%s", msg)
+	}
+
+	rr.Conditions.MarkTrue(status.ConditionTypeFIPSCompliant)
+
+	return nil
+}
+
+// Variants maps the images of the components to their FIPS-compliant variant.
+type Variants map[string]string
+
+// NewVariants returns the FIPS variants declared in the given environment, as returned by os.Environ.
+func NewVariants(environ []string) Variants {
+	result := make(Variants)
+	for image, variant := range deploy.RelatedImageVariants(environ, VariantSuffix) {
+		result[image] = variant
+		// a variant is compliant by definition
+		result[variant] = variant
+	}
+
+	return result
+}
+
+// Apply replaces the images of the containers of the given workload with their FIPS variant, and
+// returns the images without one.
+func (v Variants) Apply(obj *unstructured.Unstructured) ([]string, error) {
+	var podSpecPath []string
+
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		schema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+		schema.GroupKind{Group: "apps", Kind: "DaemonSet"},
+		schema.GroupKind{Group: "batch", Kind: "Job"}:
+		podSpecPath = []string{"spec", "template", "spec"}
+	case schema.GroupKind{Group: "batch", Kind: "CronJob"}:
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		return nil, nil
+	}
+
+	missing := make([]string, 0)
+
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)
+
+		containers, found, err := unstructured.NestedSlice(obj.Object, fieldPath...)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			image, ok := c["image"].(string)
+			if !ok {
+				continue
+			}
+
+			variant, ok := v[image]
+			if !ok {
+				if !slices.Contains(missing, image) {
+					missing = append(missing, image)
+				}
+
+				continue
+			}
+
+			c["image"] = variant
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fieldPath...); err != nil {
+			return nil, err
+		}
+	}
+
+	return missing, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		environ: os.Environ,
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
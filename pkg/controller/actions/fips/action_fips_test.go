@@ -0,0 +1,124 @@
+package fips_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/fips"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFIPSAction(t *testing.T) {
+	ctx := t.Context()
+
+	environ := func() []string {
+		return []string{
+			"RELATED_IMAGE_ODH_DASHBOARD_IMAGE=quay.io/opendatahub/dashboard:v1",
+			"RELATED_IMAGE_ODH_DASHBOARD_IMAGE_FIPS=quay.io/opendatahub/dashboard-fips:v1",
+			"RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE=registry.redhat.io/kube-rbac-proxy:v4",
+			"RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE_FIPS=registry.redhat.io/kube-rbac-proxy:v4",
+			"HOME=/",
+		}
+	}
+
+	deployment := func(name string, images ...string) unstructured.Unstructured {
+		containers := make([]corev1.Container, 0, len(images))
+		for _, image := range images {
+			containers = append(containers, corev1.Container{Name: name, Image: image})
+		}
+
+		u, err := resources.ToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "opendatahub"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: containers,
+			}}},
+		})
+		if err != nil {
+			t.Fatalf("failed to convert Deployment: %v", err)
+		}
+
+		return *u
+	}
+
+	newRequest := func(t *testing.T, compliance infrav1.FIPSCompliance, res ...unstructured.Unstructured) *types.ReconciliationRequest {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{FIPSCompliance: compliance},
+		}))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		d := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+
+		return &types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   d,
+			Conditions: conditions.NewManager(d, status.ConditionTypeReady),
+			Resources:  res,
+		}
+	}
+
+	t.Run("keeps the images when FIPS is not required", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, compliance := range []infrav1.FIPSCompliance{"", infrav1.FIPSComplianceIgnore, infrav1.FIPSComplianceAuto} {
+			rr := newRequest(t, compliance, deployment("dashboard", "quay.io/opendatahub/dashboard:v1", "quay.io/other:v1"))
+
+			g.Expect(fips.NewAction(fips.WithEnviron(environ))(ctx, rr)).Should(Succeed())
+			g.Expect(rr.Resources).Should(jq.Match(`.[0].spec.template.spec.containers[0].image == "quay.io/opendatahub/dashboard:v1"`))
+			g.Expect(rr.Conditions.GetCondition(status.ConditionTypeFIPSCompliant)).Should(BeNil())
+		}
+	})
+
+	t.Run("substitutes the FIPS variants", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, infrav1.FIPSComplianceRequired,
+			deployment("dashboard", "quay.io/opendatahub/dashboard:v1", "registry.redhat.io/kube-rbac-proxy:v4"),
+		)
+
+		g.Expect(fips.NewAction(fips.WithEnviron(environ))(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			jq.Match(`.[0].spec.template.spec.containers[0].image == "quay.io/opendatahub/dashboard-fips:v1"`),
+			jq.Match(`.[0].spec.template.spec.containers[1].image == "registry.redhat.io/kube-rbac-proxy:v4"`),
+		))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeFIPSCompliant)).Should(HaveField("Status", metav1.ConditionTrue))
+	})
+
+	t.Run("stops on the images without a FIPS variant", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, infrav1.FIPSComplianceRequired,
+			deployment("dashboard", "quay.io/opendatahub/dashboard:v1"),
+			deployment("other", "quay.io/other:v1"),
+		)
+
+		err := fips.NewAction(fips.WithEnviron(environ))(ctx, rr)
+		g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+		g.Expect(err.Error()).Should(ContainSubstring("quay.io/other:v1"))
+		g.Expect(err.Error()).ShouldNot(ContainSubstring("dashboard"))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeFIPSCompliant)).Should(And(
+			HaveField("Status", metav1.ConditionFalse),
+			HaveField("Reason", status.FIPSNonCompliantReason),
+		))
+	})
+}
@@ -4,19 +4,25 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	odhLabels "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
@@ -37,6 +43,12 @@ type Action struct {
 	typePredicateFn   TypePredicateFn
 	onlyOwned         bool
 	namespaceFn       actions.Getter[string]
+
+	// dryRunReported holds, by instance, the resources the dry-run events
+	// have been emitted for, so they are only emitted again when the set of
+	// resources that would be deleted changes
+	mu             sync.Mutex
+	dryRunReported map[types.UID]map[string]struct{}
 }
 
 func WithLabel(name string, value string) ActionOpts {
@@ -124,6 +136,20 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 		return nil
 	}
 
+	policy, err := a.policy(ctx, rr)
+	if err != nil {
+		return fmt.Errorf("unable to determine the garbage collection policy: %w", err)
+	}
+
+	if policy == common.GCPolicyDisabled {
+		return nil
+	}
+
+	mode, err := cluster.DeployMode(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return fmt.Errorf("unable to determine the deploy mode: %w", err)
+	}
+
 	l := logf.FromContext(ctx)
 
 	// TODO: use cacher to avoid computing deletable types
@@ -142,19 +168,27 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 
 	CyclesTotal.WithLabelValues(controllerName).Inc()
 
+	scope, err := scopeRequirement(rr.Instance)
+	if err != nil {
+		return fmt.Errorf("unable to compute the scope of the instance: %w", err)
+	}
+
 	lo := metav1.ListOptions{
-		LabelSelector: a.getOrComputeSelector(controllerName).String(),
+		LabelSelector: a.getOrComputeSelector(controllerName).Add(*scope).String(),
 	}
 
 	l.V(3).Info("run", "selector", lo.LabelSelector)
 
+	wouldDelete := make([]unstructured.Unstructured, 0)
+
 	for _, res := range items {
 		canBeDeleted, err := a.isTypeDeletable(rr, res.GroupVersionKind())
 		if err != nil {
 			return fmt.Errorf("cannot determine if resource %s can be deleted: %w", res.String(), err)
 		}
 
-		if !canBeDeleted {
+		// the resources applied by a GitOps tool are pruned by it
+		if !canBeDeleted || !deploy.IsApplied(mode, res.GroupVersionKind()) {
 			continue
 		}
 
@@ -163,7 +197,17 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 			return fmt.Errorf("cannot list child resources %s: %w", res.String(), err)
 		}
 
-		deleted, err := a.deleteResources(ctx, rr, igvk, items)
+		deletable, err := a.deletableResources(rr, igvk, items)
+		if err != nil {
+			return fmt.Errorf("error processing items to delete: %w", err)
+		}
+
+		if policy == common.GCPolicyDryRun {
+			wouldDelete = append(wouldDelete, deletable...)
+			continue
+		}
+
+		deleted, err := a.deleteResources(ctx, rr, deletable)
 		if err != nil {
 			return fmt.Errorf("error processing items to delete: %w", err)
 		}
@@ -173,9 +217,27 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 		}
 	}
 
+	a.reportDryRun(ctx, rr, wouldDelete)
+
 	return nil
 }
 
+// policy returns the garbage collection policy set on the DataScienceCluster the instance belongs
+// to, defaulting to Enabled when the DataScienceCluster does not exist or does not set it.
+func (a *Action) policy(ctx context.Context, rr *odhTypes.ReconciliationRequest) (common.GCPolicy, error) {
+	dsc, err := cluster.GetInstanceDSC(ctx, rr.Client, rr.Instance)
+	switch {
+	case k8serr.IsNotFound(err):
+		return common.GCPolicyEnabled, nil
+	case err != nil:
+		return "", err
+	case dsc.Spec.GCPolicy == "":
+		return common.GCPolicyEnabled, nil
+	default:
+		return dsc.Spec.GCPolicy, nil
+	}
+}
+
 func (a *Action) computeDeletableTypes(ctx context.Context, rr *odhTypes.ReconciliationRequest) ([]resources.Resource, error) {
 	res, err := resources.ListAvailableAPIResources(rr.Controller.GetDiscoveryClient())
 	if err != nil {
@@ -240,6 +302,9 @@ func (a *Action) isObjectDeletable(
 	if resources.HasAnnotation(&obj, annotations.ManagedByODHOperator, "false") {
 		return false, nil
 	}
+	if resources.HasAnnotation(&obj, annotations.GCProtect, "true") {
+		return false, nil
+	}
 
 	if a.onlyOwned {
 		o, err := resources.IsOwnedByType(&obj, igvk)
@@ -254,18 +319,17 @@ func (a *Action) isObjectDeletable(
 	return a.objectPredicateFn(rr, obj)
 }
 
-func (a *Action) deleteResources(
-	ctx context.Context,
+func (a *Action) deletableResources(
 	rr *odhTypes.ReconciliationRequest,
 	igvk schema.GroupVersionKind,
 	items []unstructured.Unstructured,
-) (int, error) {
-	deleted := 0
+) ([]unstructured.Unstructured, error) {
+	deletable := make([]unstructured.Unstructured, 0, len(items))
 
 	for i := range items {
 		canBeDeleted, err := a.isObjectDeletable(rr, igvk, items[i])
 		if err != nil {
-			return 0, fmt.Errorf("cannot determine if object %s in namespace %q can be deleted: %w",
+			return nil, fmt.Errorf("cannot determine if object %s in namespace %q can be deleted: %w",
 				items[i].GetName(),
 				items[i].GetNamespace(),
 				err,
@@ -280,16 +344,80 @@ func (a *Action) deleteResources(
 			continue
 		}
 
+		deletable = append(deletable, items[i])
+	}
+
+	return deletable, nil
+}
+
+func (a *Action) deleteResources(
+	ctx context.Context,
+	rr *odhTypes.ReconciliationRequest,
+	items []unstructured.Unstructured,
+) (int, error) {
+	deleted := 0
+
+	for i := range items {
 		if err := a.delete(ctx, rr.Client, items[i]); err != nil {
 			return 0, err
 		}
 
+		rr.Eventf(corev1.EventTypeNormal, status.ResourceDeletedReason,
+			"Deleted %s, no longer part of the rendered resources", resources.FormatObjectReference(&items[i]))
+
 		deleted++
 	}
 
 	return deleted, nil
 }
 
+// reportDryRun logs the resources the dry-run gc policy would delete and
+// emits an event for each of them, unless already emitted for the instance
+// since they stopped being rendered. The instances with nothing to delete,
+// e.g. because the gc policy changed, are forgotten.
+func (a *Action) reportDryRun(
+	ctx context.Context,
+	rr *odhTypes.ReconciliationRequest,
+	items []unstructured.Unstructured,
+) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	uid := rr.Instance.GetUID()
+
+	if len(items) == 0 {
+		delete(a.dryRunReported, uid)
+		return
+	}
+
+	reported := a.dryRunReported[uid]
+	current := make(map[string]struct{}, len(items))
+
+	for i := range items {
+		ref := resources.FormatObjectReference(&items[i])
+		current[ref] = struct{}{}
+
+		if _, ok := reported[ref]; ok {
+			continue
+		}
+
+		logf.FromContext(ctx).Info("dry-run: would delete resource",
+			"gvk", items[i].GroupVersionKind(),
+			"name", items[i].GetName(),
+			"namespace", items[i].GetNamespace(),
+		)
+
+		rr.Eventf(corev1.EventTypeNormal, status.GarbageCollectDryRunReason,
+			"Would delete %s, no longer part of the rendered resources", ref)
+	}
+
+	if a.dryRunReported == nil {
+		a.dryRunReported = make(map[types.UID]map[string]struct{})
+	}
+
+	a.dryRunReported[uid] = current
+}
+
 func (a *Action) delete(
 	ctx context.Context,
 	cli client.Client,
@@ -5,10 +5,15 @@ import (
 	"strconv"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhAnnotations "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	odhLabels "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
@@ -49,3 +54,20 @@ func DefaultObjectPredicate(rr *odhTypes.ReconciliationRequest, obj unstructured
 func DefaultTypePredicate(_ *odhTypes.ReconciliationRequest, _ schema.GroupVersionKind) (bool, error) {
 	return true, nil
 }
+
+// scopeRequirement returns the label requirement restricting the collected resources to the ones of
+// the applications namespace the instance is bound to, so the instances of several DataScienceClusters
+// do not collect the resources of each other. The resources of a scoped DataScienceCluster and of its
+// components are labeled with its applications namespace, the other ones are not labeled.
+func scopeRequirement(instance client.Object) (*labels.Requirement, error) {
+	ns := instance.GetLabels()[odhLabels.ApplicationsNamespace]
+	if dsc, ok := instance.(*dscv2.DataScienceCluster); ok {
+		ns = dsc.Spec.ApplicationsNamespace
+	}
+
+	if ns == "" {
+		return labels.NewRequirement(odhLabels.ApplicationsNamespace, selection.DoesNotExist, nil)
+	}
+
+	return labels.NewRequirement(odhLabels.ApplicationsNamespace, selection.Equals, []string{ns})
+}
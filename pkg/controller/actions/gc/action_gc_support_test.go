@@ -0,0 +1,128 @@
+//nolint:testpackage
+package gc
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScopeRequirement(t *testing.T) {
+	t.Parallel()
+
+	unscoped := &dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}}
+	scoped := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-dsc"},
+		Spec:       dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team"},
+	}
+
+	// the component instances are labeled by the DataScienceCluster creating them, and so are the
+	// resources deployed for the scoped ones
+	unscopedLabels := map[string]string{labels.DataScienceCluster: unscoped.Name}
+	scopedLabels := map[string]string{labels.DataScienceCluster: scoped.Name, labels.ApplicationsNamespace: "team"}
+
+	tests := []struct {
+		name     string
+		instance client.Object
+		owned    map[string]string
+		other    map[string]string
+	}{
+		{
+			name:     "unscoped DataScienceCluster",
+			instance: unscoped,
+			owned:    unscopedLabels,
+			other:    scopedLabels,
+		},
+		{
+			name:     "scoped DataScienceCluster",
+			instance: scoped,
+			owned:    scopedLabels,
+			other:    unscopedLabels,
+		},
+		{
+			name:     "component of the unscoped DataScienceCluster",
+			instance: &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Labels: unscopedLabels}},
+			owned:    map[string]string{},
+			other:    scopedLabels,
+		},
+		{
+			name:     "component of the scoped DataScienceCluster",
+			instance: &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Labels: scopedLabels}},
+			owned:    scopedLabels,
+			other:    map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewWithT(t)
+
+			r, err := scopeRequirement(tt.instance)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			selector := k8slabels.NewSelector().Add(*r)
+			g.Expect(selector.Matches(k8slabels.Set(tt.owned))).To(BeTrue())
+			g.Expect(selector.Matches(k8slabels.Set(tt.other))).To(BeFalse())
+		})
+	}
+}
+
+func TestReportDryRun(t *testing.T) {
+	t.Parallel()
+
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	recorder := record.NewFakeRecorder(10)
+
+	rr := &odhTypes.ReconciliationRequest{
+		Instance: &dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc", UID: "dsc-uid"}},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("GetEventRecorder").Return(recorder)
+		}),
+	}
+
+	configMap := func(name string) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk.ConfigMap)
+		u.SetNamespace("opendatahub")
+		u.SetName(name)
+
+		return u
+	}
+
+	a := Action{}
+
+	a.reportDryRun(ctx, rr, []unstructured.Unstructured{configMap("foo")})
+	g.Expect(recorder.Events).Should(Receive(ContainSubstring("foo")))
+
+	// the events are only emitted again for the resources not reported yet
+	a.reportDryRun(ctx, rr, []unstructured.Unstructured{configMap("foo")})
+	g.Expect(recorder.Events).ShouldNot(Receive())
+
+	a.reportDryRun(ctx, rr, []unstructured.Unstructured{configMap("foo"), configMap("bar")})
+	g.Expect(recorder.Events).Should(Receive(ContainSubstring("bar")))
+	g.Expect(recorder.Events).ShouldNot(Receive())
+
+	// the instances with nothing to delete are forgotten
+	a.reportDryRun(ctx, rr, nil)
+	g.Expect(a.dryRunReported).ShouldNot(HaveKey(rr.Instance.GetUID()))
+
+	a.reportDryRun(ctx, rr, []unstructured.Unstructured{configMap("foo")})
+	g.Expect(recorder.Events).Should(Receive(ContainSubstring("foo")))
+}
@@ -20,6 +20,7 @@ import (
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
 	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
@@ -68,6 +71,8 @@ func TestGcAction(t *testing.T) {
 		annotations    map[string]string
 		options        []gc.ActionOpts
 		uidFn          func(request *types.ReconciliationRequest) string
+		gcPolicy       common.GCPolicy
+		eventMatcher   gTypes.GomegaMatcher
 	}{
 		{
 			name:           "should delete leftovers",
@@ -94,6 +99,34 @@ func TestGcAction(t *testing.T) {
 			metricsMatcher: BeNumerically("==", 1),
 			uidFn:          func(rr *types.ReconciliationRequest) string { return string(rr.Instance.GetUID()) },
 		},
+		{
+			name:           "should not delete resources because protected",
+			version:        semver.Version{Major: 0, Minor: 0, Patch: 1},
+			generated:      true,
+			annotations:    map[string]string{annotations.GCProtect: "true"},
+			matcher:        Not(HaveOccurred()),
+			metricsMatcher: BeNumerically("==", 1),
+			uidFn:          func(rr *types.ReconciliationRequest) string { return string(rr.Instance.GetUID()) },
+		},
+		{
+			name:           "should not delete resources because of dry-run gc policy",
+			version:        semver.Version{Major: 0, Minor: 0, Patch: 1},
+			generated:      true,
+			gcPolicy:       common.GCPolicyDryRun,
+			matcher:        Not(HaveOccurred()),
+			metricsMatcher: BeNumerically("==", 1),
+			eventMatcher:   Receive(ContainSubstring(status.GarbageCollectDryRunReason)),
+			uidFn:          func(rr *types.ReconciliationRequest) string { return string(rr.Instance.GetUID()) },
+		},
+		{
+			name:           "should not delete resources because of disabled gc policy",
+			version:        semver.Version{Major: 0, Minor: 0, Patch: 1},
+			generated:      true,
+			gcPolicy:       common.GCPolicyDisabled,
+			matcher:        Not(HaveOccurred()),
+			metricsMatcher: BeNumerically("==", 0),
+			uidFn:          func(rr *types.ReconciliationRequest) string { return string(rr.Instance.GetUID()) },
+		},
 		{
 			name:           "should not delete resources because of no generated resources have been detected",
 			version:        semver.Version{Major: 0, Minor: 0, Patch: 1},
@@ -170,6 +203,29 @@ func TestGcAction(t *testing.T) {
 			g.Expect(cli.Create(ctx, &ns)).
 				NotTo(HaveOccurred())
 
+			if tt.gcPolicy != "" {
+				dsc := dscv2.DataScienceCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "default-dsc",
+					},
+					Spec: dscv2.DataScienceClusterSpec{
+						GCPolicy: tt.gcPolicy,
+					},
+				}
+
+				g.Expect(cli.Create(ctx, &dsc)).
+					NotTo(HaveOccurred())
+
+				t.Cleanup(func() {
+					g.Expect(cli.Delete(ctx, &dsc)).Should(Or(
+						Not(HaveOccurred()),
+						MatchError(k8serr.IsNotFound, "IsNotFound"),
+					))
+				})
+			}
+
+			recorder := record.NewFakeRecorder(10)
+
 			rr := types.ReconciliationRequest{
 				Client: cli,
 				Instance: &componentApi.Dashboard{
@@ -193,6 +249,7 @@ func TestGcAction(t *testing.T) {
 					m.On("GetDynamicClient").Return(envTest.DynamicClient())
 					m.On("GetDiscoveryClient").Return(envTest.DiscoveryClient())
 					m.On("Owns", mock.Anything).Return(false)
+					m.On("GetEventRecorder").Return(recorder)
 				}),
 			}
 
@@ -335,6 +392,10 @@ func TestGcAction(t *testing.T) {
 				ct := testutil.ToFloat64(gc.CyclesTotal)
 				g.Expect(ct).Should(tt.metricsMatcher)
 			}
+
+			if tt.eventMatcher != nil {
+				g.Expect(recorder.Events).Should(tt.eventMatcher)
+			}
 		})
 	}
 }
@@ -416,6 +477,7 @@ func TestGcActionOwn(t *testing.T) {
 					m.On("GetDynamicClient").Return(envTest.DynamicClient())
 					m.On("GetDiscoveryClient").Return(envTest.DiscoveryClient())
 					m.On("Owns", mock.Anything).Return(false)
+					m.On("GetEventRecorder").Return(record.NewFakeRecorder(10))
 				}),
 			}
 
@@ -524,6 +586,7 @@ func TestGcActionCluster(t *testing.T) {
 			m.On("GetDynamicClient").Return(envTest.DynamicClient())
 			m.On("GetDiscoveryClient").Return(envTest.DiscoveryClient())
 			m.On("Owns", mock.Anything).Return(false)
+			m.On("GetEventRecorder").Return(record.NewFakeRecorder(10))
 		}),
 	}
 
@@ -659,6 +722,7 @@ func TestGcActionOnce(t *testing.T) {
 			m.On("GetDynamicClient").Return(envTest.DynamicClient())
 			m.On("GetDiscoveryClient").Return(envTest.DiscoveryClient())
 			m.On("Owns", mock.Anything).Return(false)
+			m.On("GetEventRecorder").Return(record.NewFakeRecorder(10))
 		}),
 	}
 
@@ -703,3 +767,119 @@ func TestGcActionOnce(t *testing.T) {
 	g.Expect(a(ctx, &rr)).NotTo(HaveOccurred())
 	g.Expect(testutil.ToFloat64(gc.DeletedTotal)).Should(BeNumerically("==", 1))
 }
+
+func TestGcActionScoped(t *testing.T) {
+	g := NewWithT(t)
+
+	envTest, err := envt.New()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	t.Cleanup(func() {
+		_ = envTest.Stop()
+	})
+
+	ctx := context.Background()
+	cli := envTest.Client()
+	nsn := xid.New().String()
+
+	ns := corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nsn,
+		},
+	}
+
+	g.Expect(cli.Create(ctx, &ns)).
+		NotTo(HaveOccurred())
+
+	release := common.Release{
+		Name: cluster.OpenDataHub,
+		Version: version.OperatorVersion{
+			Version: semver.Version{Major: 0, Minor: 2, Patch: 0},
+		},
+	}
+
+	// an unscoped DataScienceCluster and a scoped one, each owning the ConfigMaps it renders
+	dscs := []*dscv2.DataScienceCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: xid.New().String()}},
+		{ObjectMeta: metav1.ObjectMeta{Name: xid.New().String()}, Spec: dscv2.DataScienceClusterSpec{ApplicationsNamespace: "team-" + xid.New().String()}},
+	}
+
+	current := make([]corev1.ConfigMap, 0, len(dscs))
+	stale := make([]corev1.ConfigMap, 0, len(dscs))
+
+	for _, dsc := range dscs {
+		dsc.SetGroupVersionKind(gvk.DataScienceCluster)
+
+		g.Expect(cli.Create(ctx, dsc)).
+			NotTo(HaveOccurred())
+
+		t.Cleanup(func() {
+			g.Expect(cli.Delete(ctx, dsc)).Should(Or(
+				Not(HaveOccurred()),
+				MatchError(k8serr.IsNotFound, "IsNotFound"),
+			))
+		})
+
+		om := metav1.ObjectMeta{
+			Namespace: nsn,
+			Annotations: map[string]string{
+				annotations.InstanceGeneration: strconv.FormatInt(dsc.GetGeneration(), 10),
+				annotations.InstanceUID:        string(dsc.GetUID()),
+				annotations.PlatformType:       string(release.Name),
+				annotations.PlatformVersion:    release.Version.String(),
+			},
+			Labels: map[string]string{
+				labels.PlatformPartOf: strings.ToLower(gvk.DataScienceCluster.Kind),
+			},
+		}
+
+		if dsc.IsScoped() {
+			om.Labels[labels.ApplicationsNamespace] = dsc.Spec.ApplicationsNamespace
+		}
+
+		cm := corev1.ConfigMap{ObjectMeta: *om.DeepCopy()}
+		cm.Name = xid.New().String()
+
+		old := corev1.ConfigMap{ObjectMeta: *om.DeepCopy()}
+		old.Name = xid.New().String()
+		old.Annotations[annotations.PlatformVersion] = "0.1.0"
+
+		for _, obj := range []*corev1.ConfigMap{&cm, &old} {
+			g.Expect(controllerutil.SetOwnerReference(dsc, obj, cli.Scheme())).
+				NotTo(HaveOccurred())
+			g.Expect(cli.Create(ctx, obj)).
+				NotTo(HaveOccurred())
+		}
+
+		current = append(current, cm)
+		stale = append(stale, old)
+	}
+
+	a := gc.NewAction(gc.WithDeletePropagationPolicy(metav1.DeletePropagationBackground), gc.InNamespace(nsn))
+
+	for _, dsc := range dscs {
+		rr := types.ReconciliationRequest{
+			Client:    cli,
+			Instance:  dsc,
+			Release:   release,
+			Generated: true,
+			Controller: mocks.NewMockController(func(m *mocks.MockController) {
+				m.On("GetClient").Return(envTest.Client())
+				m.On("GetDynamicClient").Return(envTest.DynamicClient())
+				m.On("GetDiscoveryClient").Return(envTest.DiscoveryClient())
+				m.On("Owns", mock.Anything).Return(false)
+				m.On("GetEventRecorder").Return(record.NewFakeRecorder(10))
+			}),
+		}
+
+		g.Expect(a(ctx, &rr)).NotTo(HaveOccurred())
+	}
+
+	// each DataScienceCluster collects its own stale ConfigMap and keeps the ones of the other
+	for i := range dscs {
+		g.Expect(cli.Get(ctx, ctrlCli.ObjectKeyFromObject(&current[i]), &corev1.ConfigMap{})).
+			NotTo(HaveOccurred())
+		g.Expect(cli.Get(ctx, ctrlCli.ObjectKeyFromObject(&stale[i]), &corev1.ConfigMap{})).
+			To(Satisfy(k8serr.IsNotFound))
+	}
+}
@@ -0,0 +1,172 @@
+package highavailability
+
+import (
+	"context"
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action runs the Deployments rendered by the component in high availability as set in the
+// highAvailability field of its spec: the Deployments are scaled to the given replicas, their
+// pods spread with the given topology spread constraints and, with a minAvailable, a
+// PodDisruptionBudget selecting their pods replaces the ones of the rendered manifests.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(_ context.Context, rr *types.ReconciliationRequest) error {
+	ha := rr.WorkloadSpec().GetHighAvailability()
+	if ha == nil {
+		return nil
+	}
+
+	deployments := make([]unstructured.Unstructured, 0)
+
+	err := rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Deployment {
+			return false, nil
+		}
+
+		if err := Apply(u, ha); err != nil {
+			return false, fmt.Errorf("failed to apply high availability to %s: %w", resources.FormatObjectReference(u), err)
+		}
+
+		deployments = append(deployments, *u)
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if ha.MinAvailable == nil {
+		return nil
+	}
+
+	for i := range deployments {
+		d := &deployments[i]
+
+		selector, found, err := unstructured.NestedMap(d.Object, "spec", "selector")
+		if err != nil || !found {
+			continue
+		}
+
+		// the PodDisruptionBudgets of the manifests protecting the same pods are replaced
+		err = rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+			if u.GroupVersionKind() != gvk.PodDisruptionBudget || u.GetNamespace() != d.GetNamespace() {
+				return false
+			}
+
+			s, _, _ := unstructured.NestedMap(u.Object, "spec", "selector")
+
+			return u.GetName() == d.GetName() || equality.Semantic.DeepEqual(s, selector)
+		})
+		if err != nil {
+			return err
+		}
+
+		pdb, err := newPodDisruptionBudget(d, selector, ha)
+		if err != nil {
+			return fmt.Errorf("failed to compute the PodDisruptionBudget of %s: %w", resources.FormatObjectReference(d), err)
+		}
+
+		if err := rr.AddResources(pdb); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply sets the replicas and the topology spread constraints of the given high availability
+// configuration on the given Deployment, the constraints without a label selector selecting the
+// pods of the Deployment. The replicas are marked as set via the platform API, so they take
+// precedence over the ones set on the cluster.
+func Apply(obj *unstructured.Unstructured, ha *common.HighAvailabilitySpec) error {
+	if ha.Replicas != nil {
+		if err := unstructured.SetNestedField(obj.Object, int64(*ha.Replicas), "spec", "replicas"); err != nil {
+			return err
+		}
+
+		resources.SetAnnotation(obj, annotations.ManagedReplicas, "true")
+	}
+
+	if len(ha.TopologySpreadConstraints) == 0 {
+		return nil
+	}
+
+	selector := metav1.LabelSelector{}
+
+	s, found, err := unstructured.NestedMap(obj.Object, "spec", "selector")
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s, &selector); err != nil {
+			return err
+		}
+	}
+
+	constraints := make([]any, 0, len(ha.TopologySpreadConstraints))
+
+	for i := range ha.TopologySpreadConstraints {
+		c := ha.TopologySpreadConstraints[i].DeepCopy()
+		if c.LabelSelector == nil && found {
+			c.LabelSelector = selector.DeepCopy()
+		}
+
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c)
+		if err != nil {
+			return err
+		}
+
+		constraints = append(constraints, u)
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, constraints, "spec", "template", "spec", "topologySpreadConstraints")
+}
+
+func newPodDisruptionBudget(
+	d *unstructured.Unstructured,
+	selector map[string]any,
+	ha *common.HighAvailabilitySpec,
+) (*policyv1.PodDisruptionBudget, error) {
+	s := metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selector, &s); err != nil {
+		return nil, err
+	}
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.GetName(),
+			Namespace: d.GetNamespace(),
+			Labels:    d.GetLabels(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: ha.MinAvailable,
+			Selector:     &s,
+		},
+	}, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
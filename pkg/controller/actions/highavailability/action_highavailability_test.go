@@ -0,0 +1,138 @@
+package highavailability_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/highavailability"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func rendered() []unstructured.Unstructured {
+	return []unstructured.Unstructured{
+		{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "odh-dashboard", "namespace": "opendatahub"},
+			"spec": map[string]any{
+				"replicas": int64(2),
+				"selector": map[string]any{"matchLabels": map[string]any{"deployment": "odh-dashboard"}},
+				"template": map[string]any{"spec": map[string]any{}},
+			},
+		}},
+		{Object: map[string]any{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata":   map[string]any{"name": "odh-dashboard-pdb", "namespace": "opendatahub"},
+			"spec": map[string]any{
+				"maxUnavailable": int64(1),
+				"selector":       map[string]any{"matchLabels": map[string]any{"deployment": "odh-dashboard"}},
+			},
+		}},
+		{Object: map[string]any{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata":   map[string]any{"name": "other", "namespace": "opendatahub"},
+			"spec": map[string]any{
+				"selector": map[string]any{"matchLabels": map[string]any{"app": "other"}},
+			},
+		}},
+	}
+}
+
+func TestHighAvailabilityAction(t *testing.T) {
+	ctx := t.Context()
+
+	run := func(t *testing.T, ha *common.HighAvailabilitySpec) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := types.ReconciliationRequest{
+			Client: cli,
+			Instance: &componentApi.Dashboard{
+				Spec: componentApi.DashboardSpec{
+					DashboardCommonSpec: componentApi.DashboardCommonSpec{ComponentWorkloadSpec: common.ComponentWorkloadSpec{HighAvailability: ha}},
+				},
+			},
+			Resources: rendered(),
+		}
+
+		g.Expect(highavailability.NewAction()(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("keeps the resources without high availability", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(run(t, nil).Resources).Should(Equal(rendered()))
+	})
+
+	t.Run("scales and spreads the Deployments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, &common.HighAvailabilitySpec{
+			Replicas: ptr.To[int32](3),
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+				MaxSkew:           1,
+				TopologyKey:       corev1.LabelTopologyZone,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+			}},
+		})
+
+		g.Expect(rr.Resources).Should(HaveLen(3))
+		g.Expect(rr.Resources[0]).Should(And(
+			jq.Match(`.metadata.annotations."opendatahub.io/managed-replicas" == "true"`),
+			jq.Match(`.spec.replicas == 3`),
+			jq.Match(`.spec.template.spec.topologySpreadConstraints | length == 1`),
+			jq.Match(`.spec.template.spec.topologySpreadConstraints[0].topologyKey == "%s"`, corev1.LabelTopologyZone),
+			jq.Match(`.spec.template.spec.topologySpreadConstraints[0].labelSelector.matchLabels.deployment == "odh-dashboard"`),
+		))
+	})
+
+	t.Run("replaces the PodDisruptionBudgets of the Deployments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, &common.HighAvailabilitySpec{
+			MinAvailable: ptr.To(intstr.FromString("50%")),
+		})
+
+		g.Expect(rr.Resources).Should(HaveLen(3))
+		g.Expect(rr.Resources[0]).Should(And(
+			jq.Match(`.spec.replicas == 2`),
+			jq.Match(`.metadata | has("annotations") | not`),
+		))
+
+		pdbs := make([]unstructured.Unstructured, 0)
+		for _, u := range rr.Resources {
+			if u.GroupVersionKind() == gvk.PodDisruptionBudget {
+				pdbs = append(pdbs, u)
+			}
+		}
+
+		g.Expect(pdbs).Should(HaveExactElements(
+			jq.Match(`.metadata.name == "other"`),
+			And(
+				jq.Match(`.metadata.name == "odh-dashboard"`),
+				jq.Match(`.metadata.namespace == "opendatahub"`),
+				jq.Match(`.spec.minAvailable == "50%%"`),
+				jq.Match(`.spec | has("maxUnavailable") | not`),
+				jq.Match(`.spec.selector.matchLabels.deployment == "odh-dashboard"`),
+			),
+		))
+	})
+}
@@ -0,0 +1,221 @@
+package multiarch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// VariantArchitectures are the CPU architectures, other than amd64, an image may have a variant for,
+// declared by the environment variable suffixed with the upper-cased architecture, e.g.
+// RELATED_IMAGE_ODH_DASHBOARD_IMAGE_ARM64. A multi-arch image is declared by a variant equal to the
+// image itself.
+var VariantArchitectures = []string{"arm64", "ppc64le", "s390x"}
+
+// Action schedules the workloads rendered by the component on the nodes of the architectures their
+// images support. On a cluster of a single architecture, the images are replaced with their variant
+// for it. On a cluster mixing architectures, the workloads with images that are not multi-arch are
+// restricted to the architectures all their images support, amd64 by default, with node affinity
+// and reported through the ArchitecturePinned condition.
+type Action struct {
+	environ func() []string
+}
+
+type ActionOpts func(*Action)
+
+// WithEnviron sets the function returning the environment the image variants are read from,
+// os.Environ by default.
+func WithEnviron(fn func() []string) ActionOpts {
+	return func(action *Action) {
+		action.environ = fn
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	architectures, err := cluster.Architectures(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	// the images are built for amd64
+	if len(architectures) == 0 || slices.Equal(architectures, []string{cluster.ArchitectureAMD64}) {
+		return nil
+	}
+
+	v := NewVariants(a.environ())
+	pinned := make([]string, 0)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if k := u.GroupVersionKind(); k != gvk.Deployment && k != gvk.StatefulSet {
+			return false, nil
+		}
+
+		required, err := v.Apply(u, architectures)
+		if err != nil {
+			return false, err
+		}
+
+		if len(required) != 0 {
+			pinned = append(pinned, fmt.Sprintf("%s (%s)", resources.FormatObjectReference(u), strings.Join(required, ", ")))
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(pinned) == 0 {
+		return nil
+	}
+
+	rr.Conditions.SetCondition(common.Condition{
+		Type:     status.ConditionTypeArchitecturePinned,
+		Status:   metav1.ConditionTrue,
+		Severity: common.ConditionSeverityInfo,
+		Reason:   status.UnsupportedArchReason,
+		Message: fmt.Sprintf("Workloads restricted to the architectures of their images, the cluster having nodes of %s: %s",
+			strings.Join(architectures, ", "), strings.Join(pinned, "; ")),
+	})
+
+	return nil
+}
+
+// Variants maps the images of the components to their variant for each architecture.
+type Variants map[string]map[string]string
+
+// NewVariants returns the architecture variants declared in the given environment, as returned by
+// os.Environ.
+func NewVariants(environ []string) Variants {
+	result := make(Variants)
+	for _, arch := range VariantArchitectures {
+		for image, variant := range odhdeploy.RelatedImageVariants(environ, "_"+strings.ToUpper(arch)) {
+			if result[image] == nil {
+				result[image] = make(map[string]string)
+			}
+
+			result[image][arch] = variant
+		}
+	}
+
+	return result
+}
+
+// Supports returns true if the given image runs on the given architecture as is.
+func (v Variants) Supports(image string, arch string) bool {
+	return arch == cluster.ArchitectureAMD64 || v[image][arch] == image
+}
+
+// Apply adapts the given workload to the given architectures of the cluster: on a cluster of a single
+// architecture, the images are replaced with their variant for it when they all have one, otherwise
+// the workload is restricted to the architectures all its images support. It returns the
+// architectures the workload is restricted to, none when it runs on all the nodes.
+func (v Variants) Apply(obj *unstructured.Unstructured, architectures []string) ([]string, error) {
+	podSpecPath := []string{"spec", "template", "spec"}
+
+	images := make([]string, 0)
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, err := unstructured.NestedSlice(obj.Object, append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range containers {
+			if c, ok := containers[i].(map[string]any); ok {
+				if image, ok := c["image"].(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+
+	if len(images) == 0 {
+		return nil, nil
+	}
+
+	supported := make([]string, 0, len(architectures))
+	for _, arch := range architectures {
+		if !slices.ContainsFunc(images, func(image string) bool { return !v.Supports(image, arch) }) {
+			supported = append(supported, arch)
+		}
+	}
+
+	if len(supported) == len(architectures) {
+		return nil, nil
+	}
+
+	if len(architectures) == 1 {
+		arch := architectures[0]
+
+		if !slices.ContainsFunc(images, func(image string) bool { return v[image][arch] == "" }) {
+			return nil, v.replaceImages(obj, podSpecPath, arch)
+		}
+	}
+
+	if len(supported) == 0 {
+		supported = []string{cluster.ArchitectureAMD64}
+	}
+
+	return supported, deploy.RequireArchitectures(obj, supported)
+}
+
+// replaceImages replaces the images of the containers of the given workload with their variant for
+// the given architecture.
+func (v Variants) replaceImages(obj *unstructured.Unstructured, podSpecPath []string, arch string) error {
+	for _, field := range []string{"initContainers", "containers"} {
+		fieldPath := append(podSpecPath[:len(podSpecPath):len(podSpecPath)], field)
+
+		containers, found, err := unstructured.NestedSlice(obj.Object, fieldPath...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		for i := range containers {
+			c, ok := containers[i].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if image, ok := c["image"].(string); ok && v[image][arch] != "" {
+				c["image"] = v[image][arch]
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fieldPath...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		environ: os.Environ,
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,183 @@
+package multiarch_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/multiarch"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const nodeSelectorTerms = `.spec.template.spec.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms`
+
+func environ() []string {
+	return []string{
+		"RELATED_IMAGE_ODH_DASHBOARD_IMAGE=quay.io/opendatahub/dashboard:v1",
+		"RELATED_IMAGE_ODH_DASHBOARD_IMAGE_ARM64=quay.io/opendatahub/dashboard:v1",
+		"RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE=registry.redhat.io/kube-rbac-proxy:v4",
+		"RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE_ARM64=registry.redhat.io/kube-rbac-proxy-arm64:v4",
+		"RELATED_IMAGE_OSE_KUBE_RBAC_PROXY_IMAGE_S390X=",
+		"HOME=/",
+	}
+}
+
+func deployment(t *testing.T, name string, images ...string) unstructured.Unstructured {
+	t.Helper()
+
+	containers := make([]corev1.Container, 0, len(images))
+	for _, image := range images {
+		containers = append(containers, corev1.Container{Name: name, Image: image})
+	}
+
+	u, err := resources.ToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "opendatahub"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: containers,
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("failed to convert Deployment: %v", err)
+	}
+
+	return *u
+}
+
+func TestNewVariants(t *testing.T) {
+	g := NewWithT(t)
+
+	v := multiarch.NewVariants(environ())
+
+	g.Expect(v).Should(Equal(multiarch.Variants{
+		"quay.io/opendatahub/dashboard:v1":      {"arm64": "quay.io/opendatahub/dashboard:v1"},
+		"registry.redhat.io/kube-rbac-proxy:v4": {"arm64": "registry.redhat.io/kube-rbac-proxy-arm64:v4"},
+	}))
+
+	g.Expect(v.Supports("quay.io/opendatahub/dashboard:v1", "arm64")).Should(BeTrue())
+	g.Expect(v.Supports("registry.redhat.io/kube-rbac-proxy:v4", "arm64")).Should(BeFalse())
+	g.Expect(v.Supports("quay.io/other:v1", "amd64")).Should(BeTrue())
+}
+
+func TestVariantsApply(t *testing.T) {
+	v := multiarch.NewVariants(environ())
+
+	t.Run("keeps the workloads with multi-arch images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := deployment(t, "dashboard", "quay.io/opendatahub/dashboard:v1")
+
+		required, err := v.Apply(&obj, []string{"amd64", "arm64"})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(required).Should(BeEmpty())
+		g.Expect(obj).Should(jq.Match(`.spec.template.spec | has("affinity") | not`))
+	})
+
+	t.Run("pins the workloads to the architectures of their images", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := deployment(t, "dashboard", "quay.io/opendatahub/dashboard:v1", "registry.redhat.io/kube-rbac-proxy:v4")
+
+		required, err := v.Apply(&obj, []string{"amd64", "arm64"})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(required).Should(Equal([]string{"amd64"}))
+		g.Expect(obj).Should(And(
+			jq.Match(`%s[0].matchExpressions == [{"key": "kubernetes.io/arch", "operator": "In", "values": ["amd64"]}]`, nodeSelectorTerms),
+			jq.Match(`.spec.template.spec.containers[1].image == "registry.redhat.io/kube-rbac-proxy:v4"`),
+		))
+	})
+
+	t.Run("substitutes the variants on a single architecture", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := deployment(t, "dashboard", "quay.io/opendatahub/dashboard:v1", "registry.redhat.io/kube-rbac-proxy:v4")
+
+		required, err := v.Apply(&obj, []string{"arm64"})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(required).Should(BeEmpty())
+		g.Expect(obj).Should(And(
+			jq.Match(`.spec.template.spec.containers[0].image == "quay.io/opendatahub/dashboard:v1"`),
+			jq.Match(`.spec.template.spec.containers[1].image == "registry.redhat.io/kube-rbac-proxy-arm64:v4"`),
+			jq.Match(`.spec.template.spec | has("affinity") | not`),
+		))
+	})
+
+	t.Run("pins to amd64 the images without a variant", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := deployment(t, "other", "quay.io/other:v1")
+
+		required, err := v.Apply(&obj, []string{"arm64"})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(required).Should(Equal([]string{"amd64"}))
+		g.Expect(obj).Should(jq.Match(`%s[0].matchExpressions[0].values == ["amd64"]`, nodeSelectorTerms))
+	})
+}
+
+func TestMultiArchAction(t *testing.T) {
+	ctx := t.Context()
+
+	newRequest := func(t *testing.T, architectures []string, res ...unstructured.Unstructured) *types.ReconciliationRequest {
+		t.Helper()
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Status:     dsciv2.DSCInitializationStatus{Architectures: architectures},
+		}))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		d := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+
+		return &types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   d,
+			Conditions: conditions.NewManager(d, status.ConditionTypeReady),
+			Resources:  res,
+		}
+	}
+
+	t.Run("keeps the workloads on an amd64 cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, []string{"amd64"}, deployment(t, "other", "quay.io/other:v1"))
+
+		g.Expect(multiarch.NewAction(multiarch.WithEnviron(environ))(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(jq.Match(`.[0].spec.template.spec | has("affinity") | not`))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeArchitecturePinned)).Should(BeNil())
+	})
+
+	t.Run("reports the pinned workloads on a mixed cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(t, []string{"amd64", "arm64"},
+			deployment(t, "dashboard", "quay.io/opendatahub/dashboard:v1"),
+			deployment(t, "other", "quay.io/other:v1"),
+		)
+
+		g.Expect(multiarch.NewAction(multiarch.WithEnviron(environ))(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			jq.Match(`.[0].spec.template.spec | has("affinity") | not`),
+			jq.Match(`.[1]%s[0].matchExpressions[0].values == ["amd64"]`, nodeSelectorTerms),
+		))
+		g.Expect(rr.Conditions.GetCondition(status.ConditionTypeArchitecturePinned)).Should(And(
+			HaveField("Status", metav1.ConditionTrue),
+			HaveField("Reason", status.UnsupportedArchReason),
+			HaveField("Message", ContainSubstring("other")),
+			HaveField("Message", Not(ContainSubstring("dashboard"))),
+		))
+	})
+}
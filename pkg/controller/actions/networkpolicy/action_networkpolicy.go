@@ -0,0 +1,137 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// NamePrefix prefixes the names of the NetworkPolicies rendered for the Services.
+	NamePrefix = "allow-"
+)
+
+// Action renders, when the strict NetworkPolicy profile is selected in the DSCInitialization, a
+// NetworkPolicy allowing the ingress traffic to the ports of each Service the component renders
+// in the applications namespace, which is otherwise denied by default.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	profile, err := cluster.NetworkPolicyProfile(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	// the NetworkPolicies rendered for the strict profile are garbage collected
+	// once no longer rendered
+	if profile != infrav1.NetworkPolicyProfileStrict {
+		return nil
+	}
+
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return err
+	}
+
+	policies := make([]*networkingv1.NetworkPolicy, 0)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Service || u.GetNamespace() != appNamespace {
+			return false, nil
+		}
+
+		svc := corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return false, err
+		}
+
+		if np := allowService(&svc); np != nil {
+			policies = append(policies, np)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, np := range policies {
+		if err := rr.AddResources(np); err != nil {
+			return fmt.Errorf("failed to add NetworkPolicy %s: %w", np.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// allowService returns the NetworkPolicy allowing the ingress traffic to the target ports of the
+// pods selected by the Service, nil for the Services without selector.
+func allowService(svc *corev1.Service) *networkingv1.NetworkPolicy {
+	if len(svc.Spec.Selector) == 0 || len(svc.Spec.Ports) == 0 {
+		return nil
+	}
+
+	ports := make([]networkingv1.NetworkPolicyPort, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		port := p.TargetPort
+		if port.Type == intstr.Int && port.IntVal == 0 {
+			port = intstr.FromInt32(p.Port)
+		}
+
+		protocol := p.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+
+		ports = append(ports, networkingv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			Port:     &port,
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.NetworkPolicy.GroupVersion().String(),
+			Kind:       gvk.NetworkPolicy.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      NamePrefix + svc.Name,
+			Namespace: svc.Namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: svc.Spec.Selector,
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				Ports: ports,
+			}},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+			},
+		},
+	}
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
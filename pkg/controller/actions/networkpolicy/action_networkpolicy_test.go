@@ -0,0 +1,93 @@
+package networkpolicy_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/networkpolicy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNetworkPolicyAction(t *testing.T) {
+	ctx := t.Context()
+
+	const appNamespace = "opendatahub"
+
+	service := func(name string, namespace string, selector map[string]string) unstructured.Unstructured {
+		u, err := resources.ToUnstructured(&corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: corev1.ServiceSpec{
+				Selector: selector,
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 8080},
+					{Name: "metrics", Port: 443, TargetPort: intstr.FromString("metrics")},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to convert Service: %v", err)
+		}
+
+		return *u
+	}
+
+	run := func(t *testing.T, profile infrav1.NetworkPolicyProfile) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv2.DSCInitializationSpec{
+				ApplicationsNamespace: appNamespace,
+				NetworkPolicyProfile:  profile,
+			},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := types.ReconciliationRequest{
+			Client: cli,
+			Resources: []unstructured.Unstructured{
+				service("dashboard", appNamespace, map[string]string{"app": "dashboard"}),
+				service("headless", appNamespace, nil),
+				service("elsewhere", "other", map[string]string{"app": "other"}),
+			},
+		}
+
+		g.Expect(networkpolicy.NewAction()(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("renders no NetworkPolicy without the strict profile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(run(t, "").Resources).Should(HaveLen(3))
+		g.Expect(run(t, infrav1.NetworkPolicyProfileBaseline).Resources).Should(HaveLen(3))
+	})
+
+	t.Run("allows the ports of the Services of the applications namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, infrav1.NetworkPolicyProfileStrict)
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(4),
+			jq.Match(`.[3] | .kind == "NetworkPolicy" and .metadata.name == "%sdashboard" and .metadata.namespace == "%s"`, networkpolicy.NamePrefix, appNamespace),
+			jq.Match(`.[3].spec.podSelector.matchLabels.app == "dashboard"`),
+			jq.Match(`.[3].spec.ingress[0].ports | map(.port) == [8080, "metrics"]`),
+			jq.Match(`.[3].spec.ingress[0].ports | all(.protocol == "TCP")`),
+			jq.Match(`.[3].spec.ingress[0] | has("from") | not`),
+		))
+	})
+}
@@ -0,0 +1,249 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// OpenShiftGroups are the API groups only served by OpenShift, whose resources are not deployed on
+// Kubernetes: the workloads run their plain images instead of ImageStreams, the restricted Pod
+// Security profile replaces the SecurityContextConstraints and the users are authenticated by
+// the OIDC provider of the data science Gateway instead of the OpenShift OAuth server.
+var OpenShiftGroups = []string{
+	"apps.openshift.io",
+	"console.openshift.io",
+	"image.openshift.io",
+	"machineconfiguration.openshift.io",
+	"oauth.openshift.io",
+	"route.openshift.io",
+	"security.openshift.io",
+	"template.openshift.io",
+}
+
+// HostedControlPlaneGroups are the API groups whose resources are managed by the hosting cluster
+// when the control plane is hosted, e.g. by HyperShift, and are not deployed in the hosted cluster.
+var HostedControlPlaneGroups = []string{
+	"machineconfiguration.openshift.io",
+}
+
+// Action adapts the resources rendered by the component to the platform the operator runs on.
+// When the ingress type is set to ingress, which is the default on Kubernetes, the rendered
+// Routes are replaced with Ingresses. On Kubernetes, the resources of the OpenShift API groups
+// are then removed, and on a cluster with a hosted control plane those of the API groups
+// managed by the hosting cluster.
+type Action struct {
+	isOpenShift          func() bool
+	isHostedControlPlane func() bool
+}
+
+type ActionOpts func(*Action)
+
+// WithIsOpenShift sets the function returning whether the operator runs on OpenShift,
+// cluster.IsOpenShift by default.
+func WithIsOpenShift(fn func() bool) ActionOpts {
+	return func(action *Action) {
+		action.isOpenShift = fn
+	}
+}
+
+// WithIsHostedControlPlane sets the function returning whether the control plane of the cluster
+// is hosted, cluster.IsHostedControlPlane by default.
+func WithIsHostedControlPlane(fn func() bool) ActionOpts {
+	return func(action *Action) {
+		action.isHostedControlPlane = fn
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	ingress, err := cluster.IngressType(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if ingress == infrav1.IngressTypeIngress {
+		if err := replaceRoutes(rr); err != nil {
+			return err
+		}
+	}
+
+	removed := make([]string, 0)
+
+	if !a.isOpenShift() {
+		removed = append(removed, OpenShiftGroups...)
+	}
+
+	if a.isHostedControlPlane() {
+		removed = append(removed, HostedControlPlaneGroups...)
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	return rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+		return slices.Contains(removed, u.GroupVersionKind().Group)
+	})
+}
+
+// replaceRoutes replaces the rendered Routes with the equivalent Ingresses.
+func replaceRoutes(rr *types.ReconciliationRequest) error {
+	services := map[string]corev1.Service{}
+	ingresses := make([]client.Object, 0)
+
+	err := rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Service {
+			return false, nil
+		}
+
+		svc := corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return false, err
+		}
+
+		services[svc.Namespace+"/"+svc.Name] = svc
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.Route {
+			return false, nil
+		}
+
+		in, err := ToIngress(u, services)
+		if err != nil {
+			return false, err
+		}
+
+		ingresses = append(ingresses, in)
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := rr.RemoveResources(func(u *unstructured.Unstructured) bool {
+		return u.GroupVersionKind() == gvk.Route
+	}); err != nil {
+		return err
+	}
+
+	return rr.AddResources(ingresses...)
+}
+
+// ToIngress returns the Ingress exposing the service targeted by the given Route with the same host
+// and path, the services being indexed by namespace/name. The TLS termination of the Route is done
+// by the ingress controller with its default certificate.
+func ToIngress(route *unstructured.Unstructured, services map[string]corev1.Service) (*networkingv1.Ingress, error) {
+	serviceName, _, err := unstructured.NestedString(route.Object, "spec", "to", "name")
+	if err != nil || serviceName == "" {
+		return nil, fmt.Errorf("route %s has no target service", resources.FormatObjectReference(route))
+	}
+
+	svc, ok := services[route.GetNamespace()+"/"+serviceName]
+	if !ok {
+		return nil, fmt.Errorf("service %s targeted by route %s not found", serviceName, resources.FormatObjectReference(route))
+	}
+
+	targetPort, found, err := unstructured.NestedFieldNoCopy(route.Object, "spec", "port", "targetPort")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read target port of route %s: %w", resources.FormatObjectReference(route), err)
+	}
+
+	var port int32
+	for _, p := range svc.Spec.Ports {
+		if !found || p.Name == fmt.Sprint(targetPort) || p.TargetPort.String() == fmt.Sprint(targetPort) {
+			port = p.Port
+			break
+		}
+	}
+
+	if port == 0 {
+		return nil, fmt.Errorf("unable to resolve port %v of service %s targeted by route %s", targetPort, serviceName, resources.FormatObjectReference(route))
+	}
+
+	host, _, err := unstructured.NestedString(route.Object, "spec", "host")
+	if err != nil {
+		return nil, err
+	}
+
+	path, _, err := unstructured.NestedString(route.Object, "spec", "path")
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	pathType := networkingv1.PathTypePrefix
+
+	in := networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       gvk.Ingress.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        route.GetName(),
+			Namespace:   route.GetNamespace(),
+			Labels:      route.GetLabels(),
+			Annotations: route.GetAnnotations(),
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     path,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: serviceName,
+									Port: networkingv1.ServiceBackendPort{Number: port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+
+	if _, tls, _ := unstructured.NestedMap(route.Object, "spec", "tls"); tls && host != "" {
+		in.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{host}}}
+	}
+
+	return &in, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		isOpenShift:          cluster.IsOpenShift,
+		isHostedControlPlane: cluster.IsHostedControlPlane,
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
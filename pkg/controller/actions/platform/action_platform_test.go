@@ -0,0 +1,170 @@
+package platform_test
+
+import (
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/platform"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const appNamespace = "opendatahub"
+
+func toUnstructured(t *testing.T, obj runtime.Object) unstructured.Unstructured {
+	t.Helper()
+
+	u, err := resources.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to convert %T: %v", obj, err)
+	}
+
+	return *u
+}
+
+func rendered(t *testing.T) []unstructured.Unstructured {
+	t.Helper()
+
+	return []unstructured.Unstructured{
+		toUnstructured(t, &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: appNamespace},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "dashboard-ui", Port: 8443, TargetPort: intstr.FromString("dashboard-ui")}},
+			},
+		}),
+		toUnstructured(t, &routev1.Route{
+			TypeMeta:   metav1.TypeMeta{APIVersion: routev1.GroupVersion.String(), Kind: "Route"},
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: appNamespace},
+			Spec: routev1.RouteSpec{
+				Host: "dashboard.example.com",
+				To:   routev1.RouteTargetReference{Kind: "Service", Name: "dashboard"},
+				Port: &routev1.RoutePort{TargetPort: intstr.FromString("dashboard-ui")},
+				TLS:  &routev1.TLSConfig{Termination: routev1.TLSTerminationReencrypt},
+			},
+		}),
+		toUnstructured(t, &securityv1.SecurityContextConstraints{
+			TypeMeta:   metav1.TypeMeta{APIVersion: securityv1.GroupVersion.String(), Kind: "SecurityContextConstraints"},
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboard"},
+		}),
+		{Object: map[string]any{
+			"apiVersion": "machineconfiguration.openshift.io/v1",
+			"kind":       "MachineConfig",
+			"metadata":   map[string]any{"name": "99-dashboard"},
+		}},
+	}
+}
+
+func kinds(rr *types.ReconciliationRequest) []string {
+	result := make([]string, 0, len(rr.Resources))
+	for _, u := range rr.Resources {
+		result = append(result, u.GetKind())
+	}
+
+	return result
+}
+
+func TestPlatformAction(t *testing.T) {
+	ctx := t.Context()
+
+	run := func(t *testing.T, ingress infrav1.IngressType, isOpenShift bool, opts ...platform.ActionOpts) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec: dsciv2.DSCInitializationSpec{
+				ApplicationsNamespace: appNamespace,
+				IngressType:           ingress,
+			},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := types.ReconciliationRequest{
+			Client:    cli,
+			Resources: rendered(t),
+		}
+
+		action := platform.NewAction(append([]platform.ActionOpts{platform.WithIsOpenShift(func() bool { return isOpenShift })}, opts...)...)
+		g.Expect(action(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("keeps the resources on OpenShift", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(kinds(run(t, "", true))).Should(Equal([]string{"Service", "Route", "SecurityContextConstraints", "MachineConfig"}))
+	})
+
+	t.Run("replaces the Routes with Ingresses", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, infrav1.IngressTypeIngress, true)
+		g.Expect(kinds(rr)).Should(Equal([]string{"Service", "SecurityContextConstraints", "MachineConfig", "Ingress"}))
+
+		in := rr.Resources[3]
+		g.Expect(in.GroupVersionKind()).Should(Equal(gvk.Ingress))
+		g.Expect(in).Should(And(
+			jq.Match(`.metadata.name == "dashboard" and .metadata.namespace == "%s"`, appNamespace),
+			jq.Match(`.spec.rules[0].host == "dashboard.example.com"`),
+			jq.Match(`.spec.rules[0].http.paths[0].path == "/"`),
+			jq.Match(`.spec.rules[0].http.paths[0].backend.service == {"name": "dashboard", "port": {"number": 8443}}`),
+			jq.Match(`.spec.tls[0].hosts == ["dashboard.example.com"]`),
+		))
+	})
+
+	t.Run("removes the resources of the OpenShift APIs on Kubernetes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(kinds(run(t, infrav1.IngressTypeIngress, false))).Should(Equal([]string{"Service", "Ingress"}))
+		g.Expect(kinds(run(t, "", false))).Should(Equal([]string{"Service"}))
+	})
+
+	t.Run("removes the resources managed by the hosting cluster with a hosted control plane", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, "", true, platform.WithIsHostedControlPlane(func() bool { return true }))
+		g.Expect(kinds(rr)).Should(Equal([]string{"Service", "Route", "SecurityContextConstraints"}))
+	})
+}
+
+func TestToIngress(t *testing.T) {
+	g := NewWithT(t)
+
+	route := toUnstructured(t, &routev1.Route{
+		TypeMeta:   metav1.TypeMeta{APIVersion: routev1.GroupVersion.String(), Kind: "Route"},
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: appNamespace},
+		Spec: routev1.RouteSpec{
+			Path: "/api",
+			To:   routev1.RouteTargetReference{Kind: "Service", Name: "api"},
+		},
+	})
+
+	_, err := platform.ToIngress(&route, map[string]corev1.Service{})
+	g.Expect(err).Should(MatchError(ContainSubstring("service api targeted by route")))
+
+	in, err := platform.ToIngress(&route, map[string]corev1.Service{
+		appNamespace + "/api": {Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(in.Spec.TLS).Should(BeEmpty())
+	g.Expect(in.Spec.Rules).Should(HaveLen(1))
+	g.Expect(in.Spec.Rules[0].Host).Should(BeEmpty())
+	g.Expect(in.Spec.Rules[0].HTTP.Paths[0].Path).Should(Equal("/api"))
+	g.Expect(in.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Number).Should(Equal(int32(8080)))
+}
@@ -0,0 +1,198 @@
+package podsecurity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Action flags, when the restricted Pod Security profile is selected in the DSCInitialization, the
+// workloads rendered by the component that can't comply with the restricted Pod Security Standard.
+// The deploy action only sets the settings the manifests leave unset, so the ones explicitly set
+// against the standard are reported through the PodSecurityNonCompliant condition.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	profile, err := cluster.PodSecurityProfile(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if profile != infrav1.PodSecurityProfileRestricted {
+		return nil
+	}
+
+	flagged := make([]string, 0)
+
+	err = rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if k := u.GroupVersionKind(); k != gvk.Deployment && k != gvk.StatefulSet {
+			return false, nil
+		}
+
+		podSpec, found, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+		if err != nil || !found {
+			return false, err
+		}
+
+		spec := corev1.PodSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpec, &spec); err != nil {
+			return false, fmt.Errorf("failed to convert the pod template of %s: %w", resources.FormatObjectReference(u), err)
+		}
+
+		if v := Violations(&spec); len(v) > 0 {
+			flagged = append(flagged, fmt.Sprintf("%s (%s)", resources.FormatObjectReference(u), strings.Join(v, ", ")))
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(flagged) == 0 {
+		return nil
+	}
+
+	msg := strings.Join(flagged, "; ")
+
+	rr.Conditions.SetCondition(common.Condition{
+		Type:     status.ConditionTypePodSecurityNonCompliant,
+		Status:   metav1.ConditionTrue,
+		Severity: common.ConditionSeverityInfo,
+		Reason:   status.PodSecurityViolatedReason,
+		Message:  "Workloads can't comply with the restricted Pod Security Standard: " + msg,
+	})
+
+	rr.Eventf(corev1.EventTypeWarning, status.PodSecurityViolatedReason,
+		"Workloads can't comply with the restricted Pod Security Standard: %s", msg)
+
+	return nil
+}
+
+// Violations returns the settings of the given pod spec that violate the restricted Pod Security
+// Standard. Unset settings are not reported, as the deploy action sets them to compliant values.
+func Violations(spec *corev1.PodSpec) []string {
+	result := make([]string, 0)
+
+	if spec.HostNetwork {
+		result = append(result, "hostNetwork")
+	}
+	if spec.HostPID {
+		result = append(result, "hostPID")
+	}
+	if spec.HostIPC {
+		result = append(result, "hostIPC")
+	}
+
+	for i := range spec.Volumes {
+		if !allowedVolume(&spec.Volumes[i]) {
+			result = append(result, fmt.Sprintf("volume %s of a restricted type", spec.Volumes[i].Name))
+		}
+	}
+
+	psc := spec.SecurityContext
+	if psc == nil {
+		psc = &corev1.PodSecurityContext{}
+	}
+
+	check := func(kind string, c *corev1.Container) {
+		sc := c.SecurityContext
+		if sc == nil {
+			sc = &corev1.SecurityContext{}
+		}
+
+		if sc.Privileged != nil && *sc.Privileged {
+			result = append(result, fmt.Sprintf("%s %s privileged", kind, c.Name))
+		}
+		if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+			result = append(result, fmt.Sprintf("%s %s allowPrivilegeEscalation", kind, c.Name))
+		}
+
+		runAsNonRoot := sc.RunAsNonRoot
+		if runAsNonRoot == nil {
+			runAsNonRoot = psc.RunAsNonRoot
+		}
+		if runAsNonRoot != nil && !*runAsNonRoot {
+			result = append(result, fmt.Sprintf("%s %s runAsNonRoot=false", kind, c.Name))
+		}
+
+		runAsUser := sc.RunAsUser
+		if runAsUser == nil {
+			runAsUser = psc.RunAsUser
+		}
+		if runAsUser != nil && *runAsUser == 0 {
+			result = append(result, fmt.Sprintf("%s %s runAsUser=0", kind, c.Name))
+		}
+
+		seccomp := sc.SeccompProfile
+		if seccomp == nil {
+			seccomp = psc.SeccompProfile
+		}
+		if seccomp != nil && seccomp.Type == corev1.SeccompProfileTypeUnconfined {
+			result = append(result, fmt.Sprintf("%s %s seccompProfile=Unconfined", kind, c.Name))
+		}
+
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if capability != "NET_BIND_SERVICE" {
+					result = append(result, fmt.Sprintf("%s %s adds capability %s", kind, c.Name, capability))
+				}
+			}
+		}
+
+		for _, p := range c.Ports {
+			if p.HostPort != 0 {
+				result = append(result, fmt.Sprintf("%s %s hostPort %d", kind, c.Name, p.HostPort))
+			}
+		}
+	}
+
+	for i := range spec.InitContainers {
+		check("init container", &spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		check("container", &spec.Containers[i])
+	}
+
+	return result
+}
+
+// allowedVolume returns true if the volume is of a type allowed by the restricted Pod Security Standard.
+func allowedVolume(v *corev1.Volume) bool {
+	vs := v.VolumeSource
+
+	return vs.ConfigMap != nil ||
+		vs.CSI != nil ||
+		vs.DownwardAPI != nil ||
+		vs.EmptyDir != nil ||
+		vs.Ephemeral != nil ||
+		vs.PersistentVolumeClaim != nil ||
+		vs.Projected != nil ||
+		vs.Secret != nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,139 @@
+package podsecurity_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/podsecurity"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPodSecurityAction(t *testing.T) {
+	ctx := t.Context()
+
+	deployment := func(name string, spec corev1.PodSpec) unstructured.Unstructured {
+		u, err := resources.ToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "opendatahub"},
+			Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: spec}},
+		})
+		if err != nil {
+			t.Fatalf("failed to convert Deployment: %v", err)
+		}
+
+		return *u
+	}
+
+	run := func(t *testing.T, profile infrav1.PodSecurityProfile) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{PodSecurityProfile: profile},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		d := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+
+		rr := types.ReconciliationRequest{
+			Client:     cli,
+			Instance:   d,
+			Conditions: conditions.NewManager(d, status.ConditionTypeReady),
+			Resources: []unstructured.Unstructured{
+				deployment("compliant", corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "manager"}},
+				}),
+				deployment("privileged", corev1.PodSpec{
+					HostNetwork: true,
+					Containers: []corev1.Container{{
+						Name:            "agent",
+						SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)},
+					}},
+				}),
+			},
+		}
+
+		g.Expect(podsecurity.NewAction()(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("flags nothing without the restricted profile", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(run(t, "").Conditions.GetCondition(status.ConditionTypePodSecurityNonCompliant)).Should(BeNil())
+		g.Expect(run(t, infrav1.PodSecurityProfileBaseline).Conditions.GetCondition(status.ConditionTypePodSecurityNonCompliant)).Should(BeNil())
+	})
+
+	t.Run("flags the workloads that can't comply", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cond := run(t, infrav1.PodSecurityProfileRestricted).Conditions.GetCondition(status.ConditionTypePodSecurityNonCompliant)
+		g.Expect(cond).ShouldNot(BeNil())
+		g.Expect(cond.Status).Should(Equal(metav1.ConditionTrue))
+		g.Expect(cond.Reason).Should(Equal(status.PodSecurityViolatedReason))
+		g.Expect(cond.Message).Should(And(
+			ContainSubstring("privileged"),
+			ContainSubstring("hostNetwork"),
+			Not(ContainSubstring("compliant")),
+		))
+	})
+}
+
+func TestViolations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(podsecurity.Violations(&corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+		},
+		Containers: []corev1.Container{{
+			Name: "manager",
+			SecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}},
+			},
+		}},
+	})).Should(BeEmpty())
+
+	g.Expect(podsecurity.Violations(&corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](0)},
+		Volumes: []corev1.Volume{
+			{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var"}}},
+		},
+		InitContainers: []corev1.Container{{
+			Name:            "setup",
+			SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(true)},
+		}},
+		Containers: []corev1.Container{{
+			Name: "manager",
+			SecurityContext: &corev1.SecurityContext{
+				RunAsUser:    ptr.To[int64](1000),
+				RunAsNonRoot: ptr.To(false),
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN"}},
+			},
+			Ports: []corev1.ContainerPort{{ContainerPort: 8080, HostPort: 8080}},
+		}},
+	})).Should(ConsistOf(
+		"volume host of a restricted type",
+		"init container setup allowPrivilegeEscalation",
+		"init container setup runAsUser=0",
+		"container manager runAsNonRoot=false",
+		"container manager adds capability SYS_ADMIN",
+		"container manager hostPort 8080",
+	))
+}
@@ -0,0 +1,83 @@
+package priorityclass
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// controllerSegments are the segments of the names of the workloads recognized as controllers.
+var controllerSegments = []string{"controller", "operator", "manager", "webhook"}
+
+// Action assigns the PriorityClasses set in the DSCInitialization to the workloads rendered by the
+// component: the controllers, recognized by their name, are assigned the controllers one and the
+// other workloads the workloads one, overriding the PriorityClass of the rendered manifests.
+type Action struct{}
+
+type ActionOpts func(*Action)
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	pcs, err := cluster.PriorityClasses(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	if pcs == nil || (pcs.Controllers == "" && pcs.Workloads == "") {
+		return nil
+	}
+
+	return rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if k := u.GroupVersionKind(); k != gvk.Deployment && k != gvk.StatefulSet {
+			return false, nil
+		}
+
+		name := pcs.Workloads
+		if IsController(u.GetName()) {
+			name = pcs.Controllers
+		}
+
+		if name == "" {
+			return false, nil
+		}
+
+		if err := unstructured.SetNestedField(u.Object, name, "spec", "template", "spec", "priorityClassName"); err != nil {
+			return false, fmt.Errorf("failed to set the PriorityClass of %s: %w", resources.FormatObjectReference(u), err)
+		}
+
+		// the priority of the rendered manifest, if any, is resolved from the PriorityClass
+		unstructured.RemoveNestedField(u.Object, "spec", "template", "spec", "priority")
+
+		return false, nil
+	})
+}
+
+// IsController returns whether the workload of the given name is a controller, its name having a
+// controller, operator, manager or webhook segment, e.g. odh-model-controller.
+func IsController(name string) bool {
+	for _, s := range strings.Split(name, "-") {
+		if slices.Contains(controllerSegments, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,112 @@
+package priorityclass_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/priorityclass"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func newWorkload(kind string, name string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": "opendatahub"},
+		"spec": map[string]any{
+			"template": map[string]any{"spec": map[string]any{
+				"priorityClassName": "rendered",
+				"priority":          int64(1000),
+			}},
+		},
+	}}
+}
+
+func rendered() []unstructured.Unstructured {
+	return []unstructured.Unstructured{
+		newWorkload("Deployment", "odh-model-controller"),
+		newWorkload("Deployment", "odh-notebook-controller-manager"),
+		newWorkload("Deployment", "odh-dashboard"),
+		newWorkload("StatefulSet", "model-registry-db"),
+		{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "kserve-webhook-server-service", "namespace": "opendatahub"},
+		}},
+	}
+}
+
+func TestPriorityClassAction(t *testing.T) {
+	ctx := t.Context()
+
+	run := func(t *testing.T, pcs *infrav1.PriorityClassesSpec) *types.ReconciliationRequest {
+		t.Helper()
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv2.DSCInitializationSpec{PriorityClasses: pcs},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr := types.ReconciliationRequest{
+			Client:    cli,
+			Resources: rendered(),
+		}
+
+		g.Expect(priorityclass.NewAction()(ctx, &rr)).Should(Succeed())
+
+		return &rr
+	}
+
+	t.Run("keeps the resources without PriorityClasses", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, pcs := range []*infrav1.PriorityClassesSpec{nil, {Create: true}} {
+			g.Expect(run(t, pcs).Resources).Should(Equal(rendered()))
+		}
+	})
+
+	t.Run("assigns the PriorityClasses of the controllers and the workloads", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, &infrav1.PriorityClassesSpec{Controllers: "odh-controllers", Workloads: "odh-workloads"})
+
+		g.Expect(rr.Resources).Should(HaveExactElements(
+			jq.Match(`.spec.template.spec == {"priorityClassName": "odh-controllers"}`),
+			jq.Match(`.spec.template.spec == {"priorityClassName": "odh-controllers"}`),
+			jq.Match(`.spec.template.spec == {"priorityClassName": "odh-workloads"}`),
+			jq.Match(`.spec.template.spec == {"priorityClassName": "odh-workloads"}`),
+			jq.Match(`has("spec") | not`),
+		))
+	})
+
+	t.Run("keeps the PriorityClass of the workloads not assigned one", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := run(t, &infrav1.PriorityClassesSpec{Controllers: "odh-controllers"})
+
+		g.Expect(rr.Resources[0]).Should(jq.Match(`.spec.template.spec.priorityClassName == "odh-controllers"`))
+		g.Expect(rr.Resources[2]).Should(jq.Match(`.spec.template.spec == {"priorityClassName": "rendered", "priority": 1000}`))
+	})
+}
+
+func TestIsController(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, name := range []string{"odh-model-controller", "kuberay-operator", "kueue-controller-manager", "kserve-webhook-server"} {
+		g.Expect(priorityclass.IsController(name)).Should(BeTrue(), name)
+	}
+
+	for _, name := range []string{"odh-dashboard", "ds-pipeline-ui", "controllers-db"} {
+		g.Expect(priorityclass.IsController(name)).Should(BeFalse(), name)
+	}
+}
@@ -2,9 +2,12 @@ package kustomize
 
 import (
 	"context"
+	"errors"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/resourcecacher"
@@ -24,6 +27,8 @@ type Action struct {
 
 	keOpts []kustomize.EngineOptsFn
 	ke     *kustomize.Engine
+
+	isHostedControlPlane func() bool
 }
 
 type ActionOpts func(*Action)
@@ -70,23 +75,64 @@ func WithCache(enabled bool) ActionOpts {
 	}
 }
 
+// WithHostedControlPlane sets the function returning whether the control plane of the cluster is
+// hosted, in which case the hosted variant of the manifests is rendered when they provide one,
+// cluster.IsHostedControlPlane by default.
+func WithHostedControlPlane(fn func() bool) ActionOpts {
+	return func(action *Action) {
+		action.isHostedControlPlane = fn
+	}
+}
+
 func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
-	return a.cacher.Render(ctx, rr, a.render)
+	err := a.cacher.Render(ctx, rr, a.render)
+
+	switch {
+	case errors.Is(err, kustomize.ErrManifestsNotFound):
+		rr.Eventf(corev1.EventTypeWarning, status.ManifestsFetchFailedReason, "Failed to fetch manifests: %v", err)
+	case err != nil:
+		rr.Eventf(corev1.EventTypeWarning, status.RenderFailedReason, "Failed to render manifests: %v", err)
+	}
+
+	return err
 }
 
 func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
 	result := make(resources.UnstructuredList, 0)
 
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range rr.Manifests {
+	manifests := rr.Manifests
+	opts := []kustomize.RenderOptsFn{
+		kustomize.WithNamespace(appNamespace),
+	}
+
+	if devFlags := devFlagsOf(rr); devFlags != nil {
+		manifests, err = a.overlayManifests(manifests, devFlags.Overlay)
+		if err != nil {
+			return nil, err
+		}
+
+		patchOpts, err := patchOptions(devFlags.Patches)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, patchOpts...)
+	}
+
+	if a.isHostedControlPlane() {
+		manifests = a.hostedManifests(manifests)
+	}
+
+	for i := range manifests {
 		renderedResources, err := a.ke.Render(
-			rr.Manifests[i].String(),
-			kustomize.WithNamespace(appNamespace),
+			manifests[i].String(),
+			opts...,
 		)
 
 		if err != nil {
@@ -101,8 +147,9 @@ func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (r
 
 func NewAction(opts ...ActionOpts) actions.Fn {
 	action := Action{
-		cacher: resourcecacher.NewResourceCacher(rendererEngine),
-		cache:  true,
+		cacher:               resourcecacher.NewResourceCacher(rendererEngine),
+		cache:                true,
+		isHostedControlPlane: cluster.IsHostedControlPlane,
 	}
 
 	for _, opt := range opts {
@@ -110,7 +157,7 @@ func NewAction(opts ...ActionOpts) actions.Fn {
 	}
 
 	if action.cache {
-		action.cacher.SetKeyFn(types.Hash)
+		action.cacher.SetKeyFn(action.cachingKey)
 	}
 
 	action.ke = kustomize.NewEngine(action.keOpts...)
@@ -0,0 +1,74 @@
+package kustomize
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// cachingKey computes the caching key of the rendered manifests from the inputs of the rendering:
+// the instance and the release, the application namespace, the dev flags of the component and the
+// manifests, including the content of their directories.
+//
+// Unlike types.Hash, the key does not depend on the generation of the instance, so updates to the
+// spec that do not affect the manifests don't trigger a new rendering, while changes made to the
+// manifests by the previous actions of the reconciliation (e.g. to params.env files) do.
+func (a *Action) cachingKey(ctx context.Context, rr *types.ReconciliationRequest) ([]byte, error) {
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+	if err != nil {
+		return nil, err
+	}
+
+	devFlags, err := json.Marshal(devFlagsOf(rr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash dev flags: %w", err)
+	}
+
+	hash := sha256.New()
+
+	inputs := [][]byte{
+		[]byte(rr.Instance.GetUID()),
+		[]byte(rr.Release.Name),
+		[]byte(rr.Release.Version.String()),
+		[]byte(appNamespace),
+		devFlags,
+	}
+
+	for _, in := range inputs {
+		if _, err := fmt.Fprintf(hash, "%s\x00", in); err != nil {
+			return nil, fmt.Errorf("failed to hash render inputs: %w", err)
+		}
+	}
+
+	fingerprinted := make(map[string]struct{}, len(rr.Manifests))
+
+	for i := range rr.Manifests {
+		if _, err := fmt.Fprintf(hash, "%s\x00", rr.Manifests[i].String()); err != nil {
+			return nil, fmt.Errorf("failed to hash manifest: %w", err)
+		}
+
+		// the whole context directory is fingerprinted, as the kustomization of the source path
+		// usually references its siblings (e.g. ../base)
+		root := rr.Manifests[i].String()
+		if rr.Manifests[i].ContextDir != "" {
+			root = path.Join(rr.Manifests[i].Path, rr.Manifests[i].ContextDir)
+		}
+
+		if _, ok := fingerprinted[root]; ok {
+			continue
+		}
+
+		fingerprinted[root] = struct{}{}
+
+		if err := a.ke.Fingerprint(root, hash); err != nil {
+			return nil, fmt.Errorf("failed to hash manifests %s: %w", root, err)
+		}
+	}
+
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,77 @@
+package kustomize
+
+import (
+	"fmt"
+	"slices"
+
+	kustomizetypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
+)
+
+func devFlagsOf(rr *types.ReconciliationRequest) *common.DevFlagsSpec {
+	return rr.WorkloadSpec().GetDevFlags()
+}
+
+// overlayManifests replaces the source path of the manifests providing the given overlay, so the
+// overlay is rendered instead of the default one. An overlay provided by none of the manifests is
+// reported as an error, as it is most likely a typo.
+func (a *Action) overlayManifests(manifests []types.ManifestInfo, overlay string) ([]types.ManifestInfo, error) {
+	if overlay == "" {
+		return manifests, nil
+	}
+
+	result := slices.Clone(manifests)
+	found := false
+
+	for i := range result {
+		mi := result[i]
+		mi.SourcePath = overlay
+
+		if !a.ke.Exists(mi.String()) {
+			continue
+		}
+
+		result[i] = mi
+		found = true
+	}
+
+	if !found {
+		return nil, fmt.Errorf("overlay %s not found in the component manifests", overlay)
+	}
+
+	return result, nil
+}
+
+func patchOptions(patches []common.ManifestPatch) ([]kustomize.RenderOptsFn, error) {
+	opts := make([]kustomize.RenderOptsFn, 0, len(patches))
+
+	for i := range patches {
+		target := patches[i].Target
+
+		plugin, err := plugins.CreatePatchPlugin(patches[i].Patch, &kustomizetypes.Selector{
+			ResId: resid.ResId{
+				Gvk: resid.Gvk{
+					Group:   target.Group,
+					Version: target.Version,
+					Kind:    target.Kind,
+				},
+				Name:      target.Name,
+				Namespace: target.Namespace,
+			},
+			LabelSelector:      target.LabelSelector,
+			AnnotationSelector: target.AnnotationSelector,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure dev flags patch %d: %w", i, err)
+		}
+
+		opts = append(opts, kustomize.WithPlugin(plugin))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,37 @@
+package kustomize
+
+import (
+	"path"
+	"slices"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// HostedSuffix suffixes the source path of the manifests variant rendered instead of it on a
+// cluster with a hosted control plane, e.g. overlays/odh-hosted for overlays/odh, usually
+// leaving out the cluster-scoped resources managed by the hosting cluster.
+const HostedSuffix = "-hosted"
+
+// hostedManifests replaces the source path of the manifests providing a hosted variant with the
+// variant. The manifests without one are rendered as is, the resources not available on the
+// cluster being removed by the platform action.
+func (a *Action) hostedManifests(manifests []types.ManifestInfo) []types.ManifestInfo {
+	result := slices.Clone(manifests)
+
+	for i := range result {
+		mi := result[i]
+
+		sourcePath := path.Clean(mi.SourcePath)
+		if sourcePath == "." || sourcePath == "/" {
+			continue
+		}
+
+		mi.SourcePath = sourcePath + HostedSuffix
+
+		if a.ke.Exists(mi.String()) {
+			result[i] = mi
+		}
+	}
+
+	return result
+}
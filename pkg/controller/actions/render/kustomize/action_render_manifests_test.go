@@ -2,16 +2,19 @@ package kustomize_test
 
 import (
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/rs/xid"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
@@ -20,6 +23,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 
 	. "github.com/onsi/gomega"
 )
@@ -196,6 +200,48 @@ spec:
               cpu: 100m
 `
 
+func TestRenderResourcesActionManifestsNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: xid.New().String(),
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := kustomize.NewAction(
+		kustomize.WithCache(false),
+		// for testing
+		kustomize.WithManifestsOptions(
+			mk.WithEngineFS(filesys.MakeFsInMemory()),
+		),
+	)
+
+	recorder := record.NewFakeRecorder(10)
+
+	rr := types.ReconciliationRequest{
+		Client:    cl,
+		Instance:  &componentApi.Dashboard{},
+		Release:   common.Release{Name: cluster.OpenDataHub},
+		Manifests: []types.ManifestInfo{{Path: xid.New().String()}},
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("GetEventRecorder").Return(recorder)
+		}),
+	}
+
+	err = action(ctx, &rr)
+	g.Expect(err).Should(MatchError(mk.ErrManifestsNotFound))
+	g.Expect(recorder.Events).Should(Receive(HavePrefix("Warning " + status.ManifestsFetchFailedReason + " ")))
+}
+
 func TestRenderResourcesWithCacheAction(t *testing.T) {
 	g := NewWithT(t)
 
@@ -233,12 +279,26 @@ func TestRenderResourcesWithCacheAction(t *testing.T) {
 	)
 
 	render.RenderedResourcesTotal.Reset()
+	render.RenderCacheRequestsTotal.Reset()
 
-	for i := range 3 {
-		d := componentApi.Dashboard{}
+	for i := range 5 {
+		d := componentApi.Dashboard{
+			TypeMeta: metav1.TypeMeta{Kind: componentApi.DashboardKind},
+		}
 
-		if i >= 1 {
+		switch i {
+		case 1:
+			// a change of the manifests, e.g. to the params.env file, is a change of the inputs
+			_ = fs.WriteFile(path.Join(id, "test-resources-deployment.yaml"), []byte(strings.Replace(testRenderResourcesWithCacheDeployment, "replicas: 3", "replicas: 2", 1)))
+		case 2:
+			// a change of the spec not affecting the manifests is not
 			d.Generation = 1
+		case 3:
+			d.Generation = 2
+			d.Spec.DevFlags = &common.DevFlagsSpec{Overlay: "."}
+		case 4:
+			d.Generation = 3
+			d.Spec.DevFlags = &common.DevFlagsSpec{Overlay: "."}
 		}
 
 		rr := types.ReconciliationRequest{
@@ -263,17 +323,247 @@ func TestRenderResourcesWithCacheAction(t *testing.T) {
 		))
 
 		rc := testutil.ToFloat64(render.RenderedResourcesTotal)
+		hits := testutil.ToFloat64(render.RenderCacheRequestsTotal.WithLabelValues("dashboard", "kustomize", render.CacheHit))
+		misses := testutil.ToFloat64(render.RenderCacheRequestsTotal.WithLabelValues("dashboard", "kustomize", render.CacheMiss))
 
 		switch i {
 		case 0:
 			g.Expect(rc).Should(BeNumerically("==", 1))
 			g.Expect(rr.Generated).Should(BeTrue())
+			g.Expect(rr.Resources[0].Object).Should(jq.Match(`.spec.replicas == 3`))
 		case 1:
 			g.Expect(rc).Should(BeNumerically("==", 2))
 			g.Expect(rr.Generated).Should(BeTrue())
+			g.Expect(rr.Resources[0].Object).Should(jq.Match(`.spec.replicas == 2`))
 		case 2:
 			g.Expect(rc).Should(BeNumerically("==", 2))
 			g.Expect(rr.Generated).Should(BeFalse())
+		case 3:
+			g.Expect(rc).Should(BeNumerically("==", 3))
+			g.Expect(rr.Generated).Should(BeTrue())
+		case 4:
+			g.Expect(rc).Should(BeNumerically("==", 3))
+			g.Expect(rr.Generated).Should(BeFalse())
+		}
+
+		g.Expect(hits + misses).Should(BeNumerically("==", i+1))
+		g.Expect(misses).Should(BeNumerically("==", rc))
+	}
+}
+
+const testRenderResourcesWithDevFlagsOverlay = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+resources:
+- ../../base
+patches:
+- patch: |-
+    apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: test-cm
+    data:
+      foo: dev
+`
+
+func TestRenderResourcesWithDevFlagsAction(t *testing.T) {
+	ctx := t.Context()
+	ns := xid.New().String()
+	id := xid.New().String()
+	fs := filesys.MakeFsInMemory()
+
+	base := path.Join(id, "base")
+	overlay := path.Join(id, "overlays", "dev")
+
+	_ = fs.MkdirAll(base)
+	_ = fs.MkdirAll(overlay)
+	_ = fs.WriteFile(path.Join(base, mk.DefaultKustomizationFileName), []byte(testRenderResourcesKustomization))
+	_ = fs.WriteFile(path.Join(base, "test-resources-cm.yaml"), []byte(testRenderResourcesConfigMap))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-managed.yaml"), []byte(testRenderResourcesManaged))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-unmanaged.yaml"), []byte(testRenderResourcesUnmanaged))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-forced.yaml"), []byte(testRenderResourcesForced))
+	_ = fs.WriteFile(path.Join(overlay, mk.DefaultKustomizationFileName), []byte(testRenderResourcesWithDevFlagsOverlay))
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: ns},
+	}))
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+
+	action := kustomize.NewAction(
+		kustomize.WithCache(false),
+		kustomize.WithManifestsOptions(
+			mk.WithEngineFS(fs),
+		),
+	)
+
+	newRequest := func(devFlags *common.DevFlagsSpec) *types.ReconciliationRequest {
+		return &types.ReconciliationRequest{
+			Client: cl,
+			Instance: &componentApi.Dashboard{
+				Spec: componentApi.DashboardSpec{
+					DashboardCommonSpec: componentApi.DashboardCommonSpec{ComponentWorkloadSpec: common.ComponentWorkloadSpec{DevFlags: devFlags}},
+				},
+			},
+			Release:   common.Release{Name: cluster.OpenDataHub},
+			Manifests: []types.ManifestInfo{{Path: id, SourcePath: "base"}},
+		}
+	}
+
+	t.Run("renders the overlay and applies the patches", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&common.DevFlagsSpec{
+			Overlay: "overlays/dev",
+			Patches: []common.ManifestPatch{
+				{
+					Target: common.ManifestPatchTarget{Kind: "Deployment", Name: "test-deployment-managed"},
+					Patch:  `[{"op": "replace", "path": "/spec/replicas", "value": 1}]`,
+				},
+				{
+					Target: common.ManifestPatchTarget{Kind: "Deployment"},
+					Patch: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: any
+spec:
+  template:
+    metadata:
+      labels:
+        dev: "true"
+`,
+				},
+			},
+		})
+
+		g.Expect(action(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(4),
+			HaveEach(jq.Match(`.metadata.namespace == "%s"`, ns)),
+			ContainElement(jq.Match(`.kind == "ConfigMap" and .data.foo == "dev"`)),
+			ContainElement(jq.Match(`.metadata.name == "test-deployment-managed" and .spec.replicas == 1`)),
+			ContainElement(jq.Match(`.metadata.name == "test-deployment-forced" and .spec.replicas == 3`)),
+			ContainElements(
+				jq.Match(`.metadata.name == "test-deployment-managed" and .spec.template.metadata.labels.dev == "true"`),
+				jq.Match(`.metadata.name == "test-deployment-unmanaged" and .spec.template.metadata.labels.dev == "true"`),
+				jq.Match(`.metadata.name == "test-deployment-forced" and .spec.template.metadata.labels.dev == "true"`),
+			),
+		))
+	})
+
+	t.Run("fails on an unknown overlay", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&common.DevFlagsSpec{Overlay: "overlays/unknown"})
+
+		g.Expect(action(ctx, rr)).Should(MatchError(ContainSubstring("overlay overlays/unknown not found")))
+	})
+
+	t.Run("fails on an invalid patch", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rr := newRequest(&common.DevFlagsSpec{
+			Patches: []common.ManifestPatch{{
+				Target: common.ManifestPatchTarget{Kind: "ConfigMap"},
+				Patch:  `not a patch`,
+			}},
+		})
+
+		g.Expect(action(ctx, rr)).Should(MatchError(ContainSubstring("dev flags patch 0")))
+	})
+}
+
+const testRenderResourcesHostedOverlay = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+resources:
+- ../base
+patches:
+- patch: |-
+    $patch: delete
+    apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: test-deployment-forced
+`
+
+func TestRenderResourcesWithHostedControlPlaneAction(t *testing.T) {
+	ctx := t.Context()
+	ns := xid.New().String()
+	id := xid.New().String()
+	fs := filesys.MakeFsInMemory()
+
+	base := path.Join(id, "base")
+	hosted := base + kustomize.HostedSuffix
+
+	_ = fs.MkdirAll(hosted)
+	_ = fs.MkdirAll(path.Join(id, "other"))
+	_ = fs.WriteFile(path.Join(base, mk.DefaultKustomizationFileName), []byte(testRenderResourcesKustomization))
+	_ = fs.WriteFile(path.Join(base, "test-resources-cm.yaml"), []byte(testRenderResourcesConfigMap))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-managed.yaml"), []byte(testRenderResourcesManaged))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-unmanaged.yaml"), []byte(testRenderResourcesUnmanaged))
+	_ = fs.WriteFile(path.Join(base, "test-resources-deployment-forced.yaml"), []byte(testRenderResourcesForced))
+	_ = fs.WriteFile(path.Join(hosted, mk.DefaultKustomizationFileName), []byte(testRenderResourcesHostedOverlay))
+	_ = fs.WriteFile(path.Join(id, "other", mk.DefaultKustomizationFileName), []byte("resources:\n- cm.yaml\n"))
+	_ = fs.WriteFile(path.Join(id, "other", "cm.yaml"), []byte(strings.ReplaceAll(testRenderResourcesConfigMap, "test-cm", "other-cm")))
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: ns},
+	}))
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+
+	newRequest := func() *types.ReconciliationRequest {
+		return &types.ReconciliationRequest{
+			Client:   cl,
+			Instance: &componentApi.Dashboard{},
+			Release:  common.Release{Name: cluster.OpenDataHub},
+			Manifests: []types.ManifestInfo{
+				{Path: id, SourcePath: "base"},
+				{Path: id, SourcePath: "other"},
+			},
 		}
 	}
+
+	render := func(t *testing.T, hosted bool) *types.ReconciliationRequest {
+		t.Helper()
+
+		action := kustomize.NewAction(
+			kustomize.WithCache(false),
+			kustomize.WithHostedControlPlane(func() bool { return hosted }),
+			kustomize.WithManifestsOptions(
+				mk.WithEngineFS(fs),
+			),
+		)
+
+		rr := newRequest()
+		if err := action(ctx, rr); err != nil {
+			t.Fatalf("failed to render manifests: %v", err)
+		}
+
+		return rr
+	}
+
+	t.Run("renders the source path without a hosted control plane", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(render(t, false).Resources).Should(And(
+			HaveLen(5),
+			ContainElement(jq.Match(`.metadata.name == "test-deployment-forced"`)),
+		))
+	})
+
+	t.Run("renders the hosted variant with a hosted control plane", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(render(t, true).Resources).Should(And(
+			HaveLen(4),
+			HaveEach(jq.Match(`.metadata.namespace == "%s"`, ns)),
+			ContainElement(jq.Match(`.metadata.name == "other-cm"`)),
+			Not(ContainElement(jq.Match(`.metadata.name == "test-deployment-forced"`))),
+		))
+	})
 }
@@ -21,6 +21,30 @@ var (
 			"engine",
 		},
 	)
+
+	// RenderCacheRequestsTotal is a prometheus counter metrics which holds the total
+	// number of lookups of the rendered resources cache per controller, rendering type
+	// and result.
+	// It has three labels.
+	// controller label refers to the controller name.
+	// engine label refers to the rendering engine.
+	// result label is either hit, when the cached resources are used, or miss.
+	RenderCacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "action_renderer_cache_requests_total",
+			Help: "Number of lookups of the rendered resources cache",
+		},
+		[]string{
+			"controller",
+			"engine",
+			"result",
+		},
+	)
+)
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
 )
 
 // init register metrics to the global registry from controller-runtime/pkg/metrics.
@@ -28,5 +52,5 @@ var (
 //
 //nolint:gochecknoinits
 func init() {
-	metrics.Registry.MustRegister(RenderedResourcesTotal)
+	metrics.Registry.MustRegister(RenderedResourcesTotal, RenderCacheRequestsTotal)
 }
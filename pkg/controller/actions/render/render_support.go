@@ -1,7 +1,9 @@
 package render
 
 import (
+	"context"
+
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
-type CachingKeyFn func(rr *types.ReconciliationRequest) ([]byte, error)
+type CachingKeyFn func(ctx context.Context, rr *types.ReconciliationRequest) ([]byte, error)
@@ -3,14 +3,18 @@ package template
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"maps"
-	gt "text/template"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/resourcecacher"
@@ -20,9 +24,11 @@ import (
 )
 
 const (
-	rendererEngine  = "template"
-	ComponentKey    = "Component"
-	AppNamespaceKey = "AppNamespace"
+	rendererEngine       = "template"
+	ComponentKey         = "Component"
+	AppNamespaceKey      = "AppNamespace"
+	AcceleratorsKey      = "Accelerators"
+	DeploymentProfileKey = "DeploymentProfile"
 )
 
 // Action takes a set of template locations and render them as Unstructured resources for
@@ -86,7 +92,12 @@ func WithAnnotations(values map[string]string) ActionOpts {
 }
 
 func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
-	return a.cacher.Render(ctx, rr, a.render)
+	err := a.cacher.Render(ctx, rr, a.render)
+	if err != nil {
+		rr.Eventf(corev1.EventTypeWarning, status.RenderFailedReason, "Failed to render templates: %v", err)
+	}
+
+	return err
 }
 
 func (a *Action) decode(decoder runtime.Decoder, data []byte, info types.TemplateInfo) ([]unstructured.Unstructured, error) {
@@ -106,14 +117,9 @@ func (a *Action) decode(decoder runtime.Decoder, data []byte, info types.Templat
 	return u, err
 }
 
-func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
-	// Early return if no templates to render
-	if len(rr.Templates) == 0 {
-		return nil, nil
-	}
-
-	decoder := serializer.NewCodecFactory(rr.Client.Scheme()).UniversalDeserializer()
-
+// templateData computes the data the templates are executed with, but the instance, which
+// is only added at rendering time.
+func (a *Action) templateData(ctx context.Context, rr *types.ReconciliationRequest) (map[string]any, error) {
 	data := maps.Clone(a.data)
 
 	for _, fn := range a.dataFn {
@@ -125,23 +131,89 @@ func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (r
 		maps.Copy(data, values)
 	}
 
-	data[ComponentKey] = rr.Instance
-
-	// Fetch application namespace from DSCI.
-	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	// Fetch the application namespace of the instance, defaulting to the DSCI one.
+	appNamespace, err := cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 	if err != nil {
 		return nil, err
 	}
 	data[AppNamespaceKey] = appNamespace
 
+	// Accelerators detected on the cluster keyed by vendor, nil for the vendors not detected.
+	accelerators, err := cluster.Accelerators(ctx, rr.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	byVendor := make(map[string]*common.Accelerator, len(accelerators))
+	for i := range accelerators {
+		byVendor[string(accelerators[i].Vendor)] = &accelerators[i]
+	}
+	data[AcceleratorsKey] = byVendor
+
+	// Deployment profile selected in DSCI, e.g. to size the workloads for single-node clusters.
+	profile, err := cluster.DeploymentProfile(ctx, rr.Client)
+	if err != nil {
+		return nil, err
+	}
+	data[DeploymentProfileKey] = string(profile)
+
+	return data, nil
+}
+
+// cachingKey computes the caching key of the rendered templates from the instance, the release
+// and the templates, as types.Hash does, and from the data the templates are executed with, which
+// may come from resources other than the instance.
+func (a *Action) cachingKey(ctx context.Context, rr *types.ReconciliationRequest) ([]byte, error) {
+	key, err := types.Hash(rr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := a.templateData(ctx, rr)
+	if err != nil {
+		return nil, err
+	}
+
+	// templates can only access exported fields, which are the ones marshalled to json
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash template data: %w", err)
+	}
+
+	hash := sha256.New()
+	if _, err := hash.Write(key); err != nil {
+		return nil, fmt.Errorf("failed to hash template data: %w", err)
+	}
+	if _, err := hash.Write(dataBytes); err != nil {
+		return nil, fmt.Errorf("failed to hash template data: %w", err)
+	}
+
+	return hash.Sum(nil), nil
+}
+
+func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
+	// Early return if no templates to render
+	if len(rr.Templates) == 0 {
+		return nil, nil
+	}
+
+	decoder := serializer.NewCodecFactory(rr.Client.Scheme()).UniversalDeserializer()
+
+	data, err := a.templateData(ctx, rr)
+	if err != nil {
+		return nil, err
+	}
+
+	data[ComponentKey] = rr.Instance
+
 	result := make(resources.UnstructuredList, 0)
 
 	var buffer bytes.Buffer
 
 	for i := range rr.Templates {
-		tmpl, err := gt.New("").Option("missingkey=error").Funcs(templateutils.TextTemplateFuncMap()).ParseFS(rr.Templates[i].FS, rr.Templates[i].Path)
+		tmpl, err := templateutils.New("").ParseFS(rr.Templates[i].FS, rr.Templates[i].Path)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse template from: %w", err)
+			return nil, fmt.Errorf("failed to parse templates %s: %w", rr.Templates[i].Path, err)
 		}
 
 		for _, t := range tmpl.Templates() {
@@ -177,7 +249,7 @@ func NewAction(opts ...ActionOpts) actions.Fn {
 	}
 
 	if action.cache {
-		action.cacher.SetKeyFn(types.Hash)
+		action.cacher.SetKeyFn(action.cachingKey)
 	}
 
 	return action.run
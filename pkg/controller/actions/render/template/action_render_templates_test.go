@@ -155,6 +155,60 @@ func TestRenderTemplateWithData(t *testing.T) {
 	))
 }
 
+func TestRenderTemplateWithAccelerators(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: ns,
+		},
+		Status: dsciv2.DSCInitializationStatus{
+			Accelerators: []common.Accelerator{{
+				Vendor:            common.AcceleratorVendorNVIDIA,
+				ResourceNames:     []string{"nvidia.com/gpu"},
+				OperatorInstalled: true,
+				Nodes:             2,
+				Count:             8,
+			}},
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := template.NewAction(
+		template.WithCache(false),
+	)
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		Instance: &componentApi.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ns,
+			},
+		},
+		Release:   common.Release{Name: cluster.OpenDataHub},
+		Templates: []types.TemplateInfo{{FS: testFS, Path: "resources/smm-accelerators.tmpl.yaml"}},
+	}
+
+	err = action(ctx, &rr)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(And(
+		HaveLen(1),
+		HaveEach(And(
+			jq.Match(`.data."nvidia-gpus" == "8"`),
+			jq.Match(`.data | has("amd-gpus") | not`),
+		)),
+	))
+}
+
 func TestRenderTemplateWithDataErr(t *testing.T) {
 	g := NewWithT(t)
 
@@ -187,6 +241,52 @@ func TestRenderTemplateWithDataErr(t *testing.T) {
 	g.Expect(err).Should(HaveOccurred())
 }
 
+func TestRenderTemplateWithMissingData(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: ns,
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := template.NewAction(
+		template.WithCache(false),
+		template.WithData(map[string]any{
+			"ID":  xid.New().String(),
+			"SMM": map[string]any{"Name": ns},
+			"UID": ns,
+		}),
+	)
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		Instance: &componentApi.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ns,
+			},
+		},
+		Release:   common.Release{Name: cluster.OpenDataHub},
+		Templates: []types.TemplateInfo{{FS: testFS, Path: "resources/smm-data.tmpl.yaml"}},
+	}
+
+	err = action(ctx, &rr)
+
+	g.Expect(err).Should(MatchError(And(
+		ContainSubstring("smm-data.tmpl.yaml:10"),
+		ContainSubstring(`map has no entry for key "Foo"`),
+	)))
+}
+
 func TestRenderTemplateWithCache(t *testing.T) {
 	g := NewWithT(t)
 
@@ -259,6 +359,72 @@ func TestRenderTemplateWithCache(t *testing.T) {
 	}
 }
 
+func TestRenderTemplateWithCacheDataChange(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: ns,
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// data computed from resources other than the instance, which do not bump its generation
+	value := "foo"
+
+	action := template.NewAction(
+		template.WithDataFn(func(context.Context, *types.ReconciliationRequest) (map[string]any, error) {
+			return map[string]any{"Value": value}, nil
+		}),
+	)
+
+	render.RenderedResourcesTotal.Reset()
+
+	d := componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       ns,
+			Generation: 1,
+		},
+	}
+
+	for i, v := range []string{"foo", "foo", "bar"} {
+		value = v
+
+		rr := types.ReconciliationRequest{
+			Client:    cl,
+			Instance:  &d,
+			Release:   common.Release{Name: cluster.OpenDataHub},
+			Templates: []types.TemplateInfo{{FS: testFS, Path: "resources/smm.tmpl.yaml"}},
+		}
+
+		err = action(ctx, &rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(rr.Resources).Should(HaveLen(1))
+
+		rc := testutil.ToFloat64(render.RenderedResourcesTotal)
+
+		switch i {
+		case 0:
+			g.Expect(rc).Should(BeNumerically("==", 1))
+			g.Expect(rr.Generated).Should(BeTrue())
+		case 1:
+			g.Expect(rc).Should(BeNumerically("==", 1))
+			g.Expect(rr.Generated).Should(BeFalse())
+		case 2:
+			g.Expect(rc).Should(BeNumerically("==", 2))
+			g.Expect(rr.Generated).Should(BeTrue())
+		}
+	}
+}
+
 func TestRenderTemplateWithGlob(t *testing.T) {
 	g := NewWithT(t)
 
@@ -19,10 +19,13 @@ type ResourceCacher struct {
 	cacher.Cacher[resources.UnstructuredList]
 
 	name string
+	// whether the rendered resources are cached, to account cache lookups
+	cached bool
 }
 
 func (s *ResourceCacher) SetKeyFn(key cacher.CachingKeyFn) {
 	s.Cacher.SetKeyFn(key)
+	s.cached = key != nil
 }
 
 func (s *ResourceCacher) Render(ctx context.Context, rr *types.ReconciliationRequest, r Renderer) error {
@@ -34,11 +37,20 @@ func (s *ResourceCacher) Render(ctx context.Context, rr *types.ReconciliationReq
 	}
 
 	resLen := len(res)
+	controllerName := strings.ToLower(rr.Instance.GetObjectKind().GroupVersionKind().Kind)
+
+	if s.cached {
+		result := render.CacheHit
+		if acted {
+			result = render.CacheMiss
+		}
+
+		render.RenderCacheRequestsTotal.WithLabelValues(controllerName, s.name, result).Inc()
+	}
 
 	if acted {
 		log.V(4).Info("accounted rendered resources", "count", resLen)
 
-		controllerName := strings.ToLower(rr.Instance.GetObjectKind().GroupVersionKind().Kind)
 		render.RenderedResourcesTotal.WithLabelValues(controllerName, s.name).Add(float64(resLen))
 
 		// flag new resources, used by GC to avoid useless run
@@ -63,7 +63,7 @@ func (s *testCacher) setResources(r []unstructured.Unstructured) {
 	s.rr.Resources = r
 }
 
-func (s *testCacher) hash(rr *types.ReconciliationRequest) ([]byte, error) {
+func (s *testCacher) hash(_ context.Context, rr *types.ReconciliationRequest) ([]byte, error) {
 	args := s.Called(rr)
 	return args.Get(0).([]byte), args.Error(1) //nolint:errcheck,forcetypeassert
 }
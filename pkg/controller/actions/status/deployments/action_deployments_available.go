@@ -120,7 +120,7 @@ func NewAction(opts ...ActionOpts) actions.Fn {
 	action := Action{
 		labels: map[string]string{},
 		namespaceFn: func(ctx context.Context, rr *types.ReconciliationRequest) (string, error) {
-			return cluster.ApplicationNamespace(ctx, rr.Client)
+			return cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
 		},
 	}
 
@@ -0,0 +1,245 @@
+package verify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// checkTimeout bounds the time an HTTP endpoint has to answer.
+	checkTimeout = 5 * time.Second
+
+	// retryInterval is the interval the checks are evaluated again at while one of them fails, the
+	// endpoints they probe not being watched.
+	retryInterval = 30 * time.Second
+)
+
+// Action evaluates the readiness checks set in the spec of the reconciled instance, and reports
+// them in the ReadinessChecksPassed condition, so the instance is only Ready once functional.
+type Action struct {
+	client      *http.Client
+	namespaceFn actions.Getter[string]
+}
+
+type ActionOpts func(*Action)
+
+// WithHTTPClient sets the client the HTTP checks are made with.
+func WithHTTPClient(c *http.Client) ActionOpts {
+	return func(action *Action) {
+		if c == nil {
+			return
+		}
+		action.client = c
+	}
+}
+
+// InNamespace sets the namespace of the Services and Jobs checked without a namespace.
+func InNamespace(ns string) ActionOpts {
+	return func(action *Action) {
+		action.namespaceFn = func(_ context.Context, _ *types.ReconciliationRequest) (string, error) {
+			return ns, nil
+		}
+	}
+}
+
+func InNamespaceFn(fn actions.Getter[string]) ActionOpts {
+	return func(action *Action) {
+		if fn == nil {
+			return
+		}
+		action.namespaceFn = fn
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	checks := rr.WorkloadSpec().GetReadinessChecks()
+	if len(checks) == 0 {
+		return rr.Conditions.ClearCondition(status.ConditionTypeReadinessChecksPassed)
+	}
+
+	obj, ok := rr.Instance.(types.ResourceObject)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a ResourceObject", rr.Instance)
+	}
+
+	ns, err := a.namespaceFn(ctx, rr)
+	if err != nil {
+		return fmt.Errorf("unable to compute namespace: %w", err)
+	}
+
+	failed := make([]string, 0, len(checks))
+
+	for _, c := range checks {
+		if err := a.check(ctx, rr.Client, ns, c); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", c.Name, err))
+		}
+	}
+
+	s := obj.GetStatus()
+
+	if len(failed) != 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionTypeReadinessChecksPassed,
+			conditions.WithObservedGeneration(s.ObservedGeneration),
+			conditions.WithReason(status.ReadinessFailedReason),
+			conditions.WithMessage("%d/%d readiness checks failed: %s", len(failed), len(checks), strings.Join(failed, "; ")),
+		)
+
+		rr.Requeue(retryInterval)
+
+		return nil
+	}
+
+	rr.Conditions.MarkTrue(
+		status.ConditionTypeReadinessChecksPassed,
+		conditions.WithObservedGeneration(s.ObservedGeneration),
+		conditions.WithReason(status.ReadinessPassedReason),
+		conditions.WithMessage("%d readiness checks passed", len(checks)),
+	)
+
+	return nil
+}
+
+// check evaluates the given check, returning the reason it does not pass.
+func (a *Action) check(ctx context.Context, cli client.Client, ns string, c common.ReadinessCheck) error {
+	switch {
+	case c.HTTP != nil:
+		return a.checkHTTP(ctx, ns, c.HTTP)
+	case c.CRD != nil:
+		return checkCRD(ctx, cli, c.CRD)
+	case c.Job != nil:
+		return checkJob(ctx, cli, ns, c.Job)
+	default:
+		return errors.New("no check set")
+	}
+}
+
+func (a *Action) checkHTTP(ctx context.Context, ns string, c *common.HTTPReadinessCheck) error {
+	if c.Namespace != "" {
+		ns = c.Namespace
+	}
+
+	scheme := "http"
+	if c.Scheme == string(corev1.URISchemeHTTPS) {
+		scheme = "https"
+	}
+
+	path := c.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	url := fmt.Sprintf("%s://%s.%s.svc:%d%s", scheme, c.Service, ns, c.Port, path)
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s failed: %w", url, err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GET %s returned %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func checkCRD(ctx context.Context, cli client.Client, c *common.CRDReadinessCheck) error {
+	crd, err := cluster.GetCRD(ctx, cli, c.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get CustomResourceDefinition %s: %w", c.Name, err)
+	}
+
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextensionsv1.Established && cond.Status == apiextensionsv1.ConditionTrue {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("CustomResourceDefinition %s is not established", c.Name)
+}
+
+func checkJob(ctx context.Context, cli client.Client, ns string, c *common.JobReadinessCheck) error {
+	if c.Namespace != "" {
+		ns = c.Namespace
+	}
+
+	job := batchv1.Job{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: c.Name}, &job); err != nil {
+		return fmt.Errorf("failed to get Job %s/%s: %w", ns, c.Name, err)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			return fmt.Errorf("Job %s/%s failed: %s", ns, c.Name, cond.Message)
+		}
+	}
+
+	return fmt.Errorf("Job %s/%s is not complete", ns, c.Name)
+}
+
+// defaultHTTPClient reaches the Services directly, without the cluster proxy, and, as the probes
+// of the kubelet, does not verify the certificates of the HTTPS endpoints.
+func defaultHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec // the endpoints are only probed, as by the kubelet
+	}
+
+	return &http.Client{
+		Transport: transport,
+		// the redirections are a success, they are not followed
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		client: defaultHTTPClient(),
+		namespaceFn: func(ctx context.Context, rr *types.ReconciliationRequest) (string, error) {
+			return cluster.InstanceApplicationNamespace(ctx, rr.Client, rr.Instance)
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
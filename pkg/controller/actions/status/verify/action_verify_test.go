@@ -0,0 +1,265 @@
+package verify_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega/gstruct"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/verify"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers"
+
+	. "github.com/onsi/gomega"
+)
+
+const ns = "opendatahub"
+
+// newHTTPClient returns a client reaching the given server whatever the Service requested, and
+// the URLs it requested.
+func newHTTPClient(srv *httptest.Server) (*http.Client, *[]string) {
+	requested := []string{}
+
+	transport := srv.Client().Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network string, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+	}
+
+	// the certificate of the test servers is issued to example.com
+	if transport.TLSClientConfig != nil {
+		transport.TLSClientConfig.ServerName = "example.com"
+	}
+
+	return &http.Client{Transport: &urlRecorder{urls: &requested, next: transport}}, &requested
+}
+
+type urlRecorder struct {
+	urls *[]string
+	next http.RoundTripper
+}
+
+func (r *urlRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	*r.urls = append(*r.urls, req.URL.String())
+	return r.next.RoundTrip(req)
+}
+
+func newCRD(name string, established bool) *apiextensionsv1.CustomResourceDefinition {
+	s := apiextensionsv1.ConditionFalse
+	if established {
+		s = apiextensionsv1.ConditionTrue
+	}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: s},
+			},
+		},
+	}
+}
+
+func newJob(name string, condition batchv1.JobConditionType) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{Type: condition, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"},
+			},
+		},
+	}
+}
+
+func TestVerifyAction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		checks  []common.ReadinessCheck
+		status  metav1.ConditionStatus
+		reason  string
+		message string
+	}{
+		{
+			name: "passing checks",
+			checks: []common.ReadinessCheck{
+				{Name: "api", HTTP: &common.HTTPReadinessCheck{Service: "odh-dashboard", Port: 8443, Path: "/api/health"}},
+				{Name: "crd", CRD: &common.CRDReadinessCheck{Name: "odhapplications.dashboard.opendatahub.io"}},
+				{Name: "migration", Job: &common.JobReadinessCheck{Name: "migration"}},
+			},
+			status:  metav1.ConditionTrue,
+			reason:  status.ReadinessPassedReason,
+			message: "3 readiness checks passed",
+		},
+		{
+			name: "failing endpoint",
+			checks: []common.ReadinessCheck{
+				{Name: "api", HTTP: &common.HTTPReadinessCheck{Service: "odh-dashboard", Port: 8443, Path: "/api/status"}},
+				{Name: "crd", CRD: &common.CRDReadinessCheck{Name: "odhapplications.dashboard.opendatahub.io"}},
+			},
+			status:  metav1.ConditionFalse,
+			reason:  status.ReadinessFailedReason,
+			message: "1/2 readiness checks failed: api: GET http://odh-dashboard.opendatahub.svc:8443/api/status returned 503",
+		},
+		{
+			name: "CRD not established",
+			checks: []common.ReadinessCheck{
+				{Name: "crd", CRD: &common.CRDReadinessCheck{Name: "odhdocuments.dashboard.opendatahub.io"}},
+			},
+			status:  metav1.ConditionFalse,
+			reason:  status.ReadinessFailedReason,
+			message: "1/1 readiness checks failed: crd: CustomResourceDefinition odhdocuments.dashboard.opendatahub.io is not established",
+		},
+		{
+			name: "failed Job",
+			checks: []common.ReadinessCheck{
+				{Name: "migration", Job: &common.JobReadinessCheck{Name: "failed-migration"}},
+			},
+			status:  metav1.ConditionFalse,
+			reason:  status.ReadinessFailedReason,
+			message: "1/1 readiness checks failed: migration: Job opendatahub/failed-migration failed: BackoffLimitExceeded",
+		},
+		{
+			name: "missing Job",
+			checks: []common.ReadinessCheck{
+				{Name: "migration", Job: &common.JobReadinessCheck{Name: "migration", Namespace: "other"}},
+			},
+			status:  metav1.ConditionFalse,
+			reason:  status.ReadinessFailedReason,
+			message: `1/1 readiness checks failed: migration: failed to get Job other/migration: jobs.batch "migration" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			cl, err := fakeclient.New(fakeclient.WithObjects(
+				newCRD("odhapplications.dashboard.opendatahub.io", true),
+				newCRD("odhdocuments.dashboard.opendatahub.io", false),
+				newJob("migration", batchv1.JobComplete),
+				newJob("failed-migration", batchv1.JobFailed),
+			))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			hc, _ := newHTTPClient(srv)
+
+			action := verify.NewAction(verify.InNamespace(ns), verify.WithHTTPClient(hc))
+
+			rr := types.ReconciliationRequest{
+				Client: cl,
+				Instance: &componentApi.Dashboard{
+					Spec: componentApi.DashboardSpec{
+						DashboardCommonSpec: componentApi.DashboardCommonSpec{ComponentWorkloadSpec: common.ComponentWorkloadSpec{ReadinessChecks: tt.checks}},
+					},
+				},
+			}
+
+			rr.Conditions = conditions.NewManager(rr.Instance, status.ConditionTypeReady, status.ConditionTypeReadinessChecksPassed)
+
+			g.Expect(action(ctx, &rr)).Should(Succeed())
+
+			g.Expect(rr.Instance).Should(
+				WithTransform(
+					matchers.ExtractStatusCondition(status.ConditionTypeReadinessChecksPassed),
+					gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Status":  Equal(tt.status),
+						"Reason":  Equal(tt.reason),
+						"Message": Equal(tt.message),
+					}),
+				),
+			)
+			g.Expect(rr.Instance).Should(
+				WithTransform(
+					matchers.ExtractStatusCondition(status.ConditionTypeReady),
+					gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Status": Equal(tt.status),
+					}),
+				),
+			)
+
+			// the failing checks are evaluated again, the endpoints they probe not being watched
+			if tt.status == metav1.ConditionFalse {
+				g.Expect(rr.RequeueAfter).ShouldNot(BeZero())
+			} else {
+				g.Expect(rr.RequeueAfter).Should(BeZero())
+			}
+		})
+	}
+}
+
+func TestVerifyActionHTTPS(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	hc, requested := newHTTPClient(srv)
+
+	rr := types.ReconciliationRequest{
+		Instance: &componentApi.Dashboard{
+			Spec: componentApi.DashboardSpec{
+				DashboardCommonSpec: componentApi.DashboardCommonSpec{
+					ComponentWorkloadSpec: common.ComponentWorkloadSpec{
+						ReadinessChecks: []common.ReadinessCheck{{
+							Name: "ui",
+							HTTP: &common.HTTPReadinessCheck{Service: "odh-dashboard", Namespace: "other", Port: 8443, Scheme: "HTTPS"},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	rr.Conditions = conditions.NewManager(rr.Instance, status.ConditionTypeReady, status.ConditionTypeReadinessChecksPassed)
+
+	g.Expect(verify.NewAction(verify.InNamespace(ns), verify.WithHTTPClient(hc))(ctx, &rr)).Should(Succeed())
+
+	g.Expect(*requested).Should(ConsistOf("https://odh-dashboard.other.svc:8443/"))
+	g.Expect(rr.Instance).Should(
+		WithTransform(
+			matchers.ExtractStatusCondition(status.ConditionTypeReadinessChecksPassed),
+			gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Status": Equal(metav1.ConditionTrue),
+			}),
+		),
+	)
+}
+
+func TestVerifyActionNoChecks(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	instance := &componentApi.Dashboard{}
+	instance.Status.Conditions = []common.Condition{{
+		Type:   status.ConditionTypeReadinessChecksPassed,
+		Status: metav1.ConditionFalse,
+	}}
+
+	rr := types.ReconciliationRequest{Instance: instance}
+	rr.Conditions = conditions.NewManager(rr.Instance, status.ConditionTypeReady, status.ConditionTypeReadinessChecksPassed)
+
+	g.Expect(verify.NewAction(verify.InNamespace(ns))(ctx, &rr)).Should(Succeed())
+
+	g.Expect(rr.Conditions.GetCondition(status.ConditionTypeReadinessChecksPassed)).Should(BeNil())
+	g.Expect(rr.Conditions.IsHappy()).Should(BeTrue())
+}
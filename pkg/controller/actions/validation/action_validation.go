@@ -0,0 +1,422 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/openapi"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// schemaRefPrefix is the prefix of the references to the schemas of an OpenAPI v3 document.
+	schemaRefPrefix = "#/components/schemas/"
+	// gvkExtension lists the kinds a schema of an OpenAPI document is the schema of.
+	gvkExtension = "x-kubernetes-group-version-kind"
+	// originAnnotation is set by kustomize on the rendered resources, with the file they come
+	// from, when the kustomization sets the originAnnotations build metadata.
+	originAnnotation = "config.kubernetes.io/origin"
+	// intOrStringFormat is the format of the fields holding an integer or a string.
+	intOrStringFormat = "int-or-string"
+)
+
+// Action validates the rendered resources against the OpenAPI schemas published by the cluster,
+// the ones of the built-in kinds and of the CRDs, before they are deployed: a resource the API
+// server would reject fails the reconciliation with the resource and the fields at fault instead
+// of a rejection of the apply. The resources of kinds the cluster publishes no schema for, e.g.
+// the custom resources of a CRD deployed along with them, are left to the API server, as are all
+// the resources when the schemas cannot be fetched.
+type Action struct {
+	client openapi.Client
+
+	mu    sync.Mutex
+	paths map[string]openapi.GroupVersion
+	// specs are the schemas of the group versions, keyed by the server relative URL of their
+	// OpenAPI document, which changes along with the document.
+	specs map[string]*groupVersionSchemas
+}
+
+type ActionOpts func(*Action)
+
+// WithOpenAPIClient sets the client the OpenAPI schemas are fetched with, the OpenAPI v3 client of
+// the discovery client of the controller by default.
+func WithOpenAPIClient(c openapi.Client) ActionOpts {
+	return func(action *Action) {
+		action.client = c
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	c := a.client
+	if c == nil && rr.Controller != nil {
+		c = rr.Controller.GetDiscoveryClient().OpenAPIV3()
+	}
+
+	if c == nil || len(rr.Resources) == 0 {
+		return nil
+	}
+
+	invalid, err := a.validate(c, rr.Resources, false)
+	if err == nil && len(invalid) != 0 {
+		// the schemas may be stale, e.g. after the update of a CRD
+		invalid, err = a.validate(c, rr.Resources, true)
+	}
+
+	if err != nil {
+		logf.FromContext(ctx).V(3).Info("skipping the schema validation of the rendered resources", "reason", err.Error())
+		return nil
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	err = fmt.Errorf("rendered resources failed schema validation: %s", strings.Join(invalid, "; "))
+	rr.Eventf(corev1.EventTypeWarning, status.SchemaValidationFailedReason, "%v", err)
+
+	return err
+}
+
+// validate returns the validation errors of the given resources, one per invalid resource. The
+// OpenAPI paths of the cluster are fetched again when refresh is set, or when a resource is of a
+// group version they do not list, e.g. the one of a CRD deployed since they were fetched.
+func (a *Action) validate(c openapi.Client, objs []unstructured.Unstructured, refresh bool) ([]string, error) {
+	if refresh {
+		a.reset()
+	}
+
+	invalid := make([]string, 0)
+
+	for i := range objs {
+		u := &objs[i]
+
+		s, found, err := a.schema(c, u.GroupVersionKind())
+		if err == nil && !found && !refresh {
+			refresh = true
+			a.reset()
+
+			s, _, err = a.schema(c, u.GroupVersionKind())
+		}
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			continue
+		}
+
+		msgs := validationErrors(validate.NewSchemaValidator(s, nil, "", strfmt.Default).Validate(u.Object))
+		if len(msgs) == 0 {
+			continue
+		}
+
+		ref := resources.FormatObjectReference(u)
+		if origin := originOf(u); origin != "" {
+			ref += " (" + origin + ")"
+		}
+
+		invalid = append(invalid, ref+": "+strings.Join(msgs, ", "))
+	}
+
+	return invalid, nil
+}
+
+func (a *Action) reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.paths = nil
+}
+
+// schema returns the schema of the given kind, or nil if the cluster publishes none. The returned
+// bool reports whether the group version of the kind is published.
+func (a *Action) schema(c openapi.Client, gvk schema.GroupVersionKind) (*spec.Schema, bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.paths == nil {
+		paths, err := c.Paths()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to fetch the OpenAPI paths: %w", err)
+		}
+
+		a.paths = paths
+
+		// the documents which are no longer published are dropped
+		urls := sets.New[string]()
+		for _, gv := range paths {
+			urls.Insert(gv.ServerRelativeURL())
+		}
+		for url := range a.specs {
+			if !urls.Has(url) {
+				delete(a.specs, url)
+			}
+		}
+	}
+
+	gv, ok := a.paths[groupVersionPath(gvk.GroupVersion())]
+	if !ok {
+		return nil, false, nil
+	}
+
+	url := gv.ServerRelativeURL()
+
+	gs, ok := a.specs[url]
+	if !ok {
+		data, err := gv.Schema(runtime.ContentTypeJSON)
+		if err != nil {
+			return nil, true, fmt.Errorf("failed to fetch the OpenAPI schemas of %s: %w", gvk.GroupVersion(), err)
+		}
+
+		doc := spec3.OpenAPI{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, true, fmt.Errorf("failed to decode the OpenAPI schemas of %s: %w", gvk.GroupVersion(), err)
+		}
+
+		gs = newGroupVersionSchemas(&doc)
+		a.specs[url] = gs
+	}
+
+	return gs.schema(gvk), true, nil
+}
+
+func groupVersionPath(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return "api/" + gv.Version
+	}
+
+	return "apis/" + gv.Group + "/" + gv.Version
+}
+
+// groupVersionSchemas are the schemas of the OpenAPI document of a group version.
+type groupVersionSchemas struct {
+	schemas  map[string]*spec.Schema
+	kinds    map[schema.GroupVersionKind]string
+	expanded map[schema.GroupVersionKind]*spec.Schema
+}
+
+func newGroupVersionSchemas(doc *spec3.OpenAPI) *groupVersionSchemas {
+	gs := groupVersionSchemas{
+		schemas:  make(map[string]*spec.Schema),
+		kinds:    make(map[schema.GroupVersionKind]string),
+		expanded: make(map[schema.GroupVersionKind]*spec.Schema),
+	}
+
+	if doc.Components == nil {
+		return &gs
+	}
+
+	gs.schemas = doc.Components.Schemas
+
+	for name, s := range gs.schemas {
+		ext, ok := s.Extensions[gvkExtension]
+		if !ok {
+			continue
+		}
+
+		// the extension is decoded as generic JSON
+		data, err := json.Marshal(ext)
+		if err != nil {
+			continue
+		}
+
+		gvks := make([]schema.GroupVersionKind, 0)
+		if err := json.Unmarshal(data, &gvks); err != nil {
+			continue
+		}
+
+		for _, gvk := range gvks {
+			gs.kinds[gvk] = name
+		}
+	}
+
+	return &gs
+}
+
+// schema returns the schema of the given kind with its references expanded, the validator not
+// resolving them.
+func (gs *groupVersionSchemas) schema(gvk schema.GroupVersionKind) *spec.Schema {
+	if s, ok := gs.expanded[gvk]; ok {
+		return s
+	}
+
+	name, ok := gs.kinds[gvk]
+	if !ok {
+		return nil
+	}
+
+	s := expand(gs.schemas[name], gs.schemas, sets.New(name))
+	gs.expanded[gvk] = s
+
+	return s
+}
+
+// expand returns a copy of the given schema with the references replaced by the schemas they
+// refer to. A reference to a schema being expanded, i.e. a recursive schema, is replaced by an
+// empty schema accepting any value. All the schemas accept null, which the API server treats as
+// an unset value, e.g. for the creationTimestamp of the rendered resources.
+func expand(in *spec.Schema, schemas map[string]*spec.Schema, visiting sets.Set[string]) *spec.Schema {
+	if in == nil {
+		return nil
+	}
+
+	if ref := in.Ref.String(); ref != "" {
+		name := strings.TrimPrefix(ref, schemaRefPrefix)
+
+		target, ok := schemas[name]
+		if !ok || visiting.Has(name) {
+			return &spec.Schema{}
+		}
+
+		visiting.Insert(name)
+		defer visiting.Delete(name)
+
+		return expand(target, schemas, visiting)
+	}
+
+	// a reference along with a description or a default is wrapped in an allOf, which is unwrapped
+	// so the errors are reported on the fields at fault only
+	if len(in.AllOf) == 1 && isWrapper(in) {
+		return expand(&in.AllOf[0], schemas, visiting)
+	}
+
+	out := *in
+	out.Nullable = true
+
+	// the integer or string fields are validated by their oneOf, the format not being supported
+	if out.Format == intOrStringFormat {
+		out.Format = ""
+	}
+	out.AllOf = expandAll(in.AllOf, schemas, visiting)
+	out.AnyOf = expandAll(in.AnyOf, schemas, visiting)
+	out.OneOf = expandAll(in.OneOf, schemas, visiting)
+	out.Not = expand(in.Not, schemas, visiting)
+	out.Properties = expandMap(in.Properties, schemas, visiting)
+	out.PatternProperties = expandMap(in.PatternProperties, schemas, visiting)
+
+	if in.Items != nil {
+		out.Items = &spec.SchemaOrArray{
+			Schema:  expand(in.Items.Schema, schemas, visiting),
+			Schemas: expandAll(in.Items.Schemas, schemas, visiting),
+		}
+	}
+
+	if in.AdditionalProperties != nil {
+		out.AdditionalProperties = &spec.SchemaOrBool{
+			Allows: in.AdditionalProperties.Allows,
+			Schema: expand(in.AdditionalProperties.Schema, schemas, visiting),
+		}
+	}
+
+	if in.AdditionalItems != nil {
+		out.AdditionalItems = &spec.SchemaOrBool{
+			Allows: in.AdditionalItems.Allows,
+			Schema: expand(in.AdditionalItems.Schema, schemas, visiting),
+		}
+	}
+
+	return &out
+}
+
+// isWrapper returns whether the given schema has no validation of its own but the ones of its
+// allOf.
+func isWrapper(s *spec.Schema) bool {
+	return len(s.Type) == 0 &&
+		len(s.Properties) == 0 &&
+		len(s.Required) == 0 &&
+		len(s.Enum) == 0 &&
+		len(s.AnyOf) == 0 &&
+		len(s.OneOf) == 0 &&
+		s.Not == nil &&
+		s.Items == nil &&
+		s.AdditionalProperties == nil
+}
+
+func expandAll(in []spec.Schema, schemas map[string]*spec.Schema, visiting sets.Set[string]) []spec.Schema {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]spec.Schema, len(in))
+	for i := range in {
+		out[i] = *expand(&in[i], schemas, visiting)
+	}
+
+	return out
+}
+
+func expandMap(in map[string]spec.Schema, schemas map[string]*spec.Schema, visiting sets.Set[string]) map[string]spec.Schema {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string]spec.Schema, len(in))
+	for k, v := range in {
+		out[k] = *expand(&v, schemas, visiting)
+	}
+
+	return out
+}
+
+// validationErrors returns the sorted messages of the errors of the given validation result,
+// with the path of the fields at fault.
+func validationErrors(res *validate.Result) []string {
+	msgs := sets.New[string]()
+
+	for _, err := range res.Errors {
+		msg := strings.TrimPrefix(err.Error(), ".")
+		msg = strings.Replace(msg, " in body", "", 1)
+
+		msgs.Insert(msg)
+	}
+
+	return sets.List(msgs)
+}
+
+// originOf returns the path of the file the given resource was rendered from, when kustomize
+// recorded it.
+func originOf(u *unstructured.Unstructured) string {
+	value, ok := u.GetAnnotations()[originAnnotation]
+	if !ok {
+		return ""
+	}
+
+	origin := struct {
+		Path string `json:"path"`
+	}{}
+
+	if err := yaml.Unmarshal([]byte(value), &origin); err != nil {
+		return ""
+	}
+
+	return origin.Path
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		specs: make(map[string]*groupVersionSchemas),
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,130 @@
+package validation_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/openapi/openapitest"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/validation"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+func deployment() unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":              "odh-dashboard",
+			"namespace":         "opendatahub",
+			"creationTimestamp": nil,
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"selector": map[string]any{"matchLabels": map[string]any{"deployment": "odh-dashboard"}},
+			"template": map[string]any{
+				"metadata": map[string]any{"labels": map[string]any{"deployment": "odh-dashboard"}},
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":  "odh-dashboard",
+						"image": "quay.io/opendatahub/odh-dashboard:latest",
+						"ports": []any{map[string]any{"containerPort": int64(8080)}},
+						"resources": map[string]any{
+							"limits": map[string]any{"cpu": "1", "memory": int64(1073741824)},
+						},
+						"readinessProbe": map[string]any{
+							"httpGet": map[string]any{"path": "/api/health", "port": "http"},
+						},
+					}},
+				},
+			},
+		},
+	}}
+}
+
+func service() unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "odh-dashboard", "namespace": "opendatahub"},
+		"spec": map[string]any{
+			"ports": []any{map[string]any{"port": int64(8443), "targetPort": int64(8443)}},
+		},
+	}}
+}
+
+func TestValidationAction(t *testing.T) {
+	ctx := t.Context()
+
+	run := func(t *testing.T, objs ...unstructured.Unstructured) error {
+		t.Helper()
+
+		rr := types.ReconciliationRequest{
+			Resources: objs,
+		}
+
+		action := validation.NewAction(validation.WithOpenAPIClient(openapitest.NewEmbeddedFileClient()))
+
+		return action(ctx, &rr)
+	}
+
+	t.Run("leaves the resources to the API server without schemas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		d := deployment()
+		g.Expect(unstructured.SetNestedField(d.Object, "two", "spec", "replicas")).Should(Succeed())
+
+		rr := types.ReconciliationRequest{
+			Resources: []unstructured.Unstructured{d},
+		}
+
+		action := validation.NewAction(validation.WithOpenAPIClient(openapitest.NewFileClient(t.TempDir() + "/missing")))
+
+		g.Expect(action(ctx, &rr)).Should(Succeed())
+	})
+
+	t.Run("accepts valid resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(run(t, deployment(), service())).Should(Succeed())
+	})
+
+	t.Run("ignores the kinds without a schema", func(t *testing.T) {
+		g := NewWithT(t)
+
+		u := unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "components.platform.opendatahub.io/v1alpha1",
+			"kind":       "Dashboard",
+			"metadata":   map[string]any{"name": "default-dashboard"},
+			"spec":       map[string]any{"replicas": "three"},
+		}}
+
+		g.Expect(run(t, u)).Should(Succeed())
+	})
+
+	t.Run("reports the resources and fields at fault", func(t *testing.T) {
+		g := NewWithT(t)
+
+		d := deployment()
+		g.Expect(unstructured.SetNestedField(d.Object, "two", "spec", "replicas")).Should(Succeed())
+		g.Expect(unstructured.SetNestedSlice(d.Object, []any{map[string]any{"image": "odh-dashboard"}}, "spec", "template", "spec", "containers")).
+			Should(Succeed())
+		d.SetAnnotations(map[string]string{"config.kubernetes.io/origin": "path: odh/deployment.yaml\n"})
+
+		s := service()
+		g.Expect(unstructured.SetNestedSlice(s.Object, []any{map[string]any{"port": "https"}}, "spec", "ports")).
+			Should(Succeed())
+
+		err := run(t, d, service(), s)
+
+		g.Expect(err).Should(MatchError(And(
+			ContainSubstring("rendered resources failed schema validation"),
+			ContainSubstring("apps/v1, Kind=Deployment opendatahub/odh-dashboard (odh/deployment.yaml): "),
+			ContainSubstring("spec.replicas must be of type integer"),
+			ContainSubstring("spec.template.spec.containers[0].name is required"),
+			ContainSubstring("/v1, Kind=Service opendatahub/odh-dashboard: spec.ports[0].port must be of type integer"),
+		)))
+	})
+}
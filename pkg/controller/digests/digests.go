@@ -0,0 +1,186 @@
+// Package digests resolves the digests of the images referenced by tag from their registries in the
+// background, so the reconciliations only read the digests resolved last and never wait for a
+// registry.
+package digests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+)
+
+const (
+	// RefreshInterval is how often the digests are resolved again from the registries, the
+	// failures to resolve them included.
+	RefreshInterval = 10 * time.Minute
+
+	// resolveTimeout bounds the time spent querying a registry, which may not be reachable, e.g.
+	// on a disconnected cluster.
+	resolveTimeout = 10 * time.Second
+)
+
+// ErrPending is returned for the images whose digest has not been resolved yet.
+var ErrPending = errors.New("digest not resolved yet")
+
+// Default is the Resolver the deploy action reads the digests from, run by the manager.
+var Default = NewResolver(nil)
+
+// Func resolves the digest the given image, referenced by tag, points to, with the credentials the
+// given kubernetes.io/dockerconfigjson Secret of the operator namespace holds for its registry.
+type Func func(ctx context.Context, cli client.Reader, credentialsSecret string, image string) (string, error)
+
+type key struct {
+	credentialsSecret string
+	image             string
+}
+
+type entry struct {
+	digest    string
+	err       error
+	resolved  time.Time
+	requested time.Time
+}
+
+// Resolver caches the digests of the images it is asked for, which are resolved when it runs.
+type Resolver struct {
+	resolve Func
+
+	mu      sync.Mutex
+	entries map[key]*entry
+	wakeup  chan struct{}
+}
+
+// NewResolver returns a Resolver resolving the digests with the given function, from the
+// registries of the images when nil.
+func NewResolver(resolve Func) *Resolver {
+	if resolve == nil {
+		resolve = FromRegistry
+	}
+
+	return &Resolver{
+		resolve: resolve,
+		entries: make(map[key]*entry),
+		wakeup:  make(chan struct{}, 1),
+	}
+}
+
+// Lookup returns the digest last resolved for the given image with the credentials of the given
+// Secret, or the error resolving it. The images not resolved yet are queued for resolution and
+// ErrPending is returned.
+func (r *Resolver) Lookup(credentialsSecret string, image string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := key{credentialsSecret: credentialsSecret, image: image}
+
+	e, ok := r.entries[k]
+	if !ok {
+		e = &entry{}
+		r.entries[k] = e
+
+		select {
+		case r.wakeup <- struct{}{}:
+		default:
+		}
+	}
+
+	e.requested = time.Now()
+
+	if e.resolved.IsZero() {
+		return "", fmt.Errorf("%w for image %s", ErrPending, image)
+	}
+
+	return e.digest, e.err
+}
+
+// Runnable returns the runnable resolving the queued images as soon as they are looked up, and
+// all of them every RefreshInterval, reading the credentials Secrets with the given client.
+func (r *Resolver) Runnable(cli client.Reader) manager.Runnable {
+	return manager.RunnableFunc(func(ctx context.Context) error {
+		ticker := time.NewTicker(RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-r.wakeup:
+				r.Resolve(ctx, cli, false)
+			case <-ticker.C:
+				r.Resolve(ctx, cli, true)
+			}
+		}
+	})
+}
+
+// Resolve resolves the digests of the queued images, and of all the cached ones when refresh is
+// set, dropping the images not looked up since the previous refresh.
+func (r *Resolver) Resolve(ctx context.Context, cli client.Reader, refresh bool) {
+	now := time.Now()
+	keys := make([]key, 0)
+
+	r.mu.Lock()
+	for k, e := range r.entries {
+		switch {
+		case refresh && now.Sub(e.requested) > 2*RefreshInterval:
+			delete(r.entries, k)
+		case refresh || e.resolved.IsZero():
+			keys = append(keys, k)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, k := range keys {
+		d, err := r.resolve(ctx, cli, k.credentialsSecret, k.image)
+		if err != nil {
+			logf.FromContext(ctx).V(1).Info("unable to resolve image digest", "image", k.image, "error", err.Error())
+		}
+
+		r.mu.Lock()
+		if e, ok := r.entries[k]; ok {
+			e.digest, e.err, e.resolved = d, err, time.Now()
+		}
+		r.mu.Unlock()
+	}
+}
+
+// FromRegistry resolves the digest of the given image from its registry, authenticated with the
+// credentials the given Secret holds for it, if any.
+func FromRegistry(ctx context.Context, cli client.Reader, credentialsSecret string, image string) (string, error) {
+	ref, err := oci.ParseImage(image)
+	if err != nil {
+		return "", err
+	}
+
+	c := oci.Client{HTTPClient: &http.Client{Timeout: resolveTimeout}}
+
+	if credentialsSecret != "" {
+		ns, err := cluster.GetOperatorNamespace()
+		if err != nil {
+			return "", err
+		}
+
+		secret := corev1.Secret{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: credentialsSecret}, &secret); err != nil {
+			return "", fmt.Errorf("failed to get registry credentials Secret %s: %w", credentialsSecret, err)
+		}
+
+		// the images of the registries the Secret holds no credentials for are resolved anonymously
+		if creds, err := oci.CredentialsFromDockerConfig(secret.Data[corev1.DockerConfigJsonKey], ref.Registry); err == nil {
+			c.Credentials = &creds
+		}
+	}
+
+	return c.Resolve(ctx, ref)
+}
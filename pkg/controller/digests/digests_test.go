@@ -0,0 +1,84 @@
+package digests_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/digests"
+
+	. "github.com/onsi/gomega"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestResolver(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	calls := atomic.Int32{}
+
+	r := digests.NewResolver(func(_ context.Context, _ client.Reader, _ string, image string) (string, error) {
+		calls.Add(1)
+
+		if image == "quay.io/opendatahub/missing:v1" {
+			return "", errors.New("not found")
+		}
+
+		return testImageDigest, nil
+	})
+
+	_, err := r.Lookup("", "quay.io/opendatahub/manager:v1")
+	g.Expect(err).Should(MatchError(digests.ErrPending))
+
+	_, err = r.Lookup("", "quay.io/opendatahub/missing:v1")
+	g.Expect(err).Should(MatchError(digests.ErrPending))
+
+	r.Resolve(ctx, nil, false)
+	g.Expect(calls.Load()).Should(BeEquivalentTo(2))
+
+	d, err := r.Lookup("", "quay.io/opendatahub/manager:v1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(d).Should(Equal(testImageDigest))
+
+	_, err = r.Lookup("", "quay.io/opendatahub/missing:v1")
+	g.Expect(err).Should(MatchError("not found"))
+
+	// the resolved images are only resolved again on refresh
+	r.Resolve(ctx, nil, false)
+	g.Expect(calls.Load()).Should(BeEquivalentTo(2))
+
+	r.Resolve(ctx, nil, true)
+	g.Expect(calls.Load()).Should(BeEquivalentTo(4))
+
+	// the images are cached by credentials Secret
+	_, err = r.Lookup("pull-secret", "quay.io/opendatahub/manager:v1")
+	g.Expect(err).Should(MatchError(digests.ErrPending))
+}
+
+func TestResolverRunnable(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	r := digests.NewResolver(func(_ context.Context, _ client.Reader, _ string, _ string) (string, error) {
+		return testImageDigest, nil
+	})
+
+	done := make(chan error)
+	go func() {
+		done <- r.Runnable(nil).Start(ctx)
+	}()
+
+	// the images looked up are resolved right away
+	g.Eventually(func() (string, error) {
+		return r.Lookup("", "quay.io/opendatahub/manager:v1")
+	}).Should(Equal(testImageDigest))
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+}
@@ -3,12 +3,16 @@ package handlers
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
@@ -65,6 +69,36 @@ func ToNamed(name string) handler.EventHandler {
 	})
 }
 
+// ToNamedAndScoped enqueues the named instance of the given kind, along with the instances of that
+// kind created by the scoped DataScienceClusters, labeled with labels.ApplicationsNamespace.
+func ToNamedAndScoped(cli client.Reader, objGVK schema.GroupVersionKind, name string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		requests := []reconcile.Request{{
+			NamespacedName: types.NamespacedName{
+				Name: name,
+			},
+		}}
+
+		scoped := metav1.PartialObjectMetadataList{}
+		scoped.SetGroupVersionKind(objGVK.GroupVersion().WithKind(objGVK.Kind + "List"))
+
+		if err := cli.List(ctx, &scoped, client.HasLabels{labels.ApplicationsNamespace}); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to list scoped instances", "kind", objGVK.Kind)
+			return requests
+		}
+
+		for i := range scoped.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: scoped.Items[i].Name,
+				},
+			})
+		}
+
+		return requests
+	})
+}
+
 func RequestFromObject() handler.EventHandler {
 	return Fn(func(ctx context.Context, obj client.Object) []reconcile.Request {
 		return []reconcile.Request{{
@@ -0,0 +1,157 @@
+// Package history keeps a bounded history of the specs applied to the platform resources,
+// i.e. the DataScienceCluster and the DSCInitialization, so a previous spec can be restored.
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// MaxEntries is the number of specs kept per resource.
+	MaxEntries = 10
+
+	specKey = "spec"
+)
+
+var ErrGenerationNotFound = errors.New("no spec recorded for generation")
+
+// Record stores the spec of the given resource at its current generation in a ConfigMap of the
+// given namespace, unless already stored, and removes the oldest ones of the resource beyond
+// MaxEntries. The ConfigMaps are owned by the resource, so they are deleted with it.
+func Record(ctx context.Context, cli client.Client, ns string, obj client.Object, spec any) error {
+	kind, err := kindOf(cli, obj)
+	if err != nil {
+		return err
+	}
+
+	cm := corev1.ConfigMap{}
+
+	err = cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: name(kind, obj, obj.GetGeneration())}, &cm)
+	switch {
+	case err == nil:
+		return nil
+	case !k8serr.IsNotFound(err):
+		return fmt.Errorf("failed to get spec history of %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spec of %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	cm = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name(kind, obj, obj.GetGeneration()),
+			Namespace: ns,
+			Labels: map[string]string{
+				labels.SpecHistory:         kind,
+				labels.SpecHistoryInstance: string(obj.GetUID()),
+			},
+			Annotations: map[string]string{
+				annotations.InstanceName:       obj.GetName(),
+				annotations.InstanceGeneration: strconv.FormatInt(obj.GetGeneration(), 10),
+			},
+		},
+		Data: map[string]string{
+			specKey: string(data),
+		},
+	}
+
+	if err := controllerutil.SetOwnerReference(obj, &cm, cli.Scheme()); err != nil {
+		return err
+	}
+
+	if err := cli.Create(ctx, &cm); err != nil && !k8serr.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to record spec history of %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	return prune(ctx, cli, ns, kind, obj)
+}
+
+// Get reads the spec recorded in the given namespace for the given resource at the given generation
+// into spec. If no spec has been recorded for the generation, an error wrapping ErrGenerationNotFound
+// is returned.
+func Get(ctx context.Context, cli client.Client, ns string, obj client.Object, generation int64, spec any) error {
+	kind, err := kindOf(cli, obj)
+	if err != nil {
+		return err
+	}
+
+	cm := corev1.ConfigMap{}
+
+	err = cli.Get(ctx, client.ObjectKey{Namespace: ns, Name: name(kind, obj, generation)}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return fmt.Errorf("%w %d", ErrGenerationNotFound, generation)
+	case err != nil:
+		return fmt.Errorf("failed to get spec history of %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	if err := json.Unmarshal([]byte(cm.Data[specKey]), spec); err != nil {
+		return fmt.Errorf("failed to unmarshal spec of %s %s at generation %d: %w", kind, obj.GetName(), generation, err)
+	}
+
+	return nil
+}
+
+func prune(ctx context.Context, cli client.Client, ns string, kind string, obj client.Object) error {
+	items := corev1.ConfigMapList{}
+
+	err := cli.List(ctx, &items, client.InNamespace(ns), client.MatchingLabels{
+		labels.SpecHistory:         kind,
+		labels.SpecHistoryInstance: string(obj.GetUID()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list spec history of %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	if len(items.Items) <= MaxEntries {
+		return nil
+	}
+
+	slices.SortFunc(items.Items, func(a, b corev1.ConfigMap) int {
+		return generationOf(&a) - generationOf(&b)
+	})
+
+	for i := range items.Items[:len(items.Items)-MaxEntries] {
+		if err := cli.Delete(ctx, &items.Items[i]); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to prune spec history of %s %s: %w", kind, obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func kindOf(cli client.Client, obj client.Object) (string, error) {
+	kind, err := resources.KindForObject(cli.Scheme(), obj)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(kind), nil
+}
+
+func name(kind string, obj client.Object, generation int64) string {
+	return fmt.Sprintf("%s-%s-spec-%d", kind, obj.GetName(), generation)
+}
+
+func generationOf(cm *corev1.ConfigMap) int {
+	g, _ := strconv.Atoi(resources.GetAnnotation(cm, annotations.InstanceGeneration))
+	return g
+}
@@ -0,0 +1,138 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/history"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestHistory(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default-dsc",
+			UID:  "uid",
+		},
+	}
+
+	for i := range history.MaxEntries + 2 {
+		dsc.Generation = int64(i + 1)
+		dsc.Spec.MaintenanceMode = i%2 == 0
+
+		g.Expect(history.Record(ctx, cli, ns, dsc, dsc.Spec)).Should(Succeed())
+		// recording the same generation again is a no-op
+		g.Expect(history.Record(ctx, cli, ns, dsc, dscv2.DataScienceClusterSpec{})).Should(Succeed())
+	}
+
+	items := corev1.ConfigMapList{}
+	g.Expect(cli.List(ctx, &items, client.InNamespace(ns), client.MatchingLabels{labels.SpecHistory: "datasciencecluster"})).
+		Should(Succeed())
+	g.Expect(items.Items).Should(HaveLen(history.MaxEntries))
+	g.Expect(items.Items).ShouldNot(ContainElement(HaveField("Name", "datasciencecluster-default-dsc-spec-1")))
+	g.Expect(items.Items).ShouldNot(ContainElement(HaveField("Name", "datasciencecluster-default-dsc-spec-2")))
+	g.Expect(items.Items).Should(HaveEach(HaveField("OwnerReferences", ConsistOf(HaveField("Name", dsc.Name)))))
+
+	spec := dscv2.DataScienceClusterSpec{}
+	g.Expect(history.Get(ctx, cli, ns, dsc, 3, &spec)).Should(Succeed())
+	g.Expect(spec.MaintenanceMode).Should(BeTrue())
+
+	spec = dscv2.DataScienceClusterSpec{}
+	g.Expect(history.Get(ctx, cli, ns, dsc, 4, &spec)).Should(Succeed())
+	g.Expect(spec.MaintenanceMode).Should(BeFalse())
+
+	g.Expect(history.Get(ctx, cli, ns, dsc, 1, &spec)).Should(MatchError(history.ErrGenerationNotFound))
+}
+
+func TestHistoryPerInstance(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	team := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "team-dsc",
+			UID:        "team-uid",
+			Generation: 1,
+		},
+		Spec: dscv2.DataScienceClusterSpec{
+			ApplicationsNamespace: "team",
+		},
+	}
+
+	g.Expect(history.Record(ctx, cli, ns, team, team.Spec)).Should(Succeed())
+
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default-dsc",
+			UID:  "uid",
+		},
+	}
+
+	for i := range history.MaxEntries + 2 {
+		dsc.Generation = int64(i + 1)
+		g.Expect(history.Record(ctx, cli, ns, dsc, dsc.Spec)).Should(Succeed())
+	}
+
+	// the history of the other instance is neither pruned nor shadowed
+	spec := dscv2.DataScienceClusterSpec{}
+	g.Expect(history.Get(ctx, cli, ns, team, 1, &spec)).Should(Succeed())
+	g.Expect(spec.ApplicationsNamespace).Should(Equal("team"))
+
+	spec = dscv2.DataScienceClusterSpec{}
+	g.Expect(history.Get(ctx, cli, ns, dsc, 1, &spec)).Should(MatchError(history.ErrGenerationNotFound))
+	g.Expect(history.Get(ctx, cli, ns, dsc, 3, &spec)).Should(Succeed())
+	g.Expect(spec.ApplicationsNamespace).Should(BeEmpty())
+}
+
+func TestHistoryRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "default-dsc",
+			Generation: 1,
+		},
+		Spec: dscv2.DataScienceClusterSpec{
+			GCPolicy: common.GCPolicyDryRun,
+		},
+	}
+
+	dsc.Spec.Components.Dashboard.ManagementState = "Managed"
+
+	g.Expect(history.Record(ctx, cli, ns, dsc, dsc.Spec)).Should(Succeed())
+
+	dsc.Generation = 2
+	dsc.Spec = dscv2.DataScienceClusterSpec{RollbackTo: ptr.To[int64](1)}
+
+	spec := dscv2.DataScienceClusterSpec{}
+	g.Expect(history.Get(ctx, cli, ns, dsc, 1, &spec)).Should(Succeed())
+	g.Expect(spec.GCPolicy).Should(Equal(common.GCPolicyDryRun))
+	g.Expect(spec.Components.Dashboard.ManagementState).Should(BeEquivalentTo("Managed"))
+	g.Expect(spec.RollbackTo).Should(BeNil())
+}
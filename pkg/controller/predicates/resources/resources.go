@@ -2,6 +2,7 @@ package resources
 
 import (
 	"reflect"
+	"slices"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -80,6 +81,32 @@ var SecretContentChangedPredicate = predicate.Funcs{
 	},
 }
 
+// NodeAllocatableChangedPredicate filters the updates of the Nodes which do not change their
+// allocatable resources, e.g. the heartbeats.
+var NodeAllocatableChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, _ := e.ObjectOld.(*corev1.Node)
+		newNode, _ := e.ObjectNew.(*corev1.Node)
+		return !reflect.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable)
+	},
+}
+
+// DSCIArchitecturesChangedPredicate filters the updates of the DSCInitialization which do not change
+// the architectures of the nodes published in its status.
+var DSCIArchitecturesChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldDSCI, ok := e.ObjectOld.(*dsciv2.DSCInitialization)
+		if !ok {
+			return false
+		}
+		newDSCI, ok := e.ObjectNew.(*dsciv2.DSCInitialization)
+		if !ok {
+			return false
+		}
+		return !slices.Equal(oldDSCI.Status.Architectures, newDSCI.Status.Architectures)
+	},
+}
+
 var DSCDeletionPredicate = predicate.Funcs{
 	DeleteFunc: func(e event.DeleteEvent) bool {
 		return true
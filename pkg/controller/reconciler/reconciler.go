@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
@@ -20,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -28,6 +32,7 @@ import (
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
@@ -37,6 +42,13 @@ type gvkInfo struct {
 
 type ReconcilerOpt func(*Reconciler)
 
+// WithStandardConditions makes the reconciler report the standard conditions, see status.SetStandardConditions.
+func WithStandardConditions() ReconcilerOpt {
+	return func(reconciler *Reconciler) {
+		reconciler.standardConditions = true
+	}
+}
+
 func WithConditionsManagerFactory(happy string, dependents ...string) ReconcilerOpt {
 	return func(reconciler *Reconciler) {
 		reconciler.conditionsManagerFactory = func(accessor common.ConditionsAccessor) *conditions.Manager {
@@ -64,7 +76,9 @@ type Reconciler struct {
 	name                     string
 	instanceFactory          func() (common.PlatformObject, error)
 	conditionsManagerFactory func(common.ConditionsAccessor) *conditions.Manager
+	standardConditions       bool
 	gvks                     map[schema.GroupVersionKind]gvkInfo
+	rateLimiter              *RateLimiter
 }
 
 // NewReconciler creates a new reconciler for the given type.
@@ -129,6 +143,10 @@ func (r *Reconciler) GetDynamicClient() dynamic.Interface {
 	return r.dynamicClient
 }
 
+func (r *Reconciler) GetEventRecorder() record.EventRecorder {
+	return r.Recorder
+}
+
 func (r *Reconciler) AddOwnedType(gvk schema.GroupVersionKind) {
 	r.gvks[gvk] = gvkInfo{
 		owned: true,
@@ -149,6 +167,25 @@ func (r *Reconciler) AddFinalizer(action actions.Fn) {
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+
+	result, err := r.reconcile(ctx, req)
+
+	ReconcileDurationSeconds.
+		WithLabelValues(r.name, resultOf(err)).
+		Observe(time.Since(start).Seconds())
+
+	switch {
+	case err != nil:
+		ReconcileRequeuesTotal.WithLabelValues(r.name, RequeueReasonError).Inc()
+	case result.RequeueAfter > 0:
+		ReconcileRequeuesTotal.WithLabelValues(r.name, RequeueReasonScheduled).Inc()
+	}
+
+	return result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 	l.Info("reconcile")
 
@@ -184,9 +221,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return ctrl.Result{}, err
 		}
 
-		if err := r.apply(ctx, res); err != nil {
-			return ctrl.Result{}, err
-		}
+		return r.apply(ctx, res)
 	}
 
 	return ctrl.Result{}, nil
@@ -248,7 +283,7 @@ func (r *Reconciler) delete(ctx context.Context, res common.PlatformObject) erro
 			l.WithName(actions.ActionGroup).WithName(action.String()),
 		)
 
-		if err := action(actx, &rr); err != nil {
+		if err := r.executeAction(actx, action, &rr); err != nil {
 			se := odherrors.StopError{}
 			if !errors.As(err, &se) {
 				l.Error(err, "Failed to execute finalizer", "action", action)
@@ -263,7 +298,20 @@ func (r *Reconciler) delete(ctx context.Context, res common.PlatformObject) erro
 	return nil
 }
 
-func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error {
+// executeAction executes the given action, recording its duration.
+func (r *Reconciler) executeAction(ctx context.Context, action actions.Fn, rr *types.ReconciliationRequest) error {
+	start := time.Now()
+
+	err := action(ctx, rr)
+
+	ActionDurationSeconds.
+		WithLabelValues(r.name, actionName(action), resultOf(err)).
+		Observe(time.Since(start).Seconds())
+
+	return err
+}
+
+func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 	l.Info("apply")
 
@@ -276,6 +324,18 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 		Manifests:  make([]types.ManifestInfo, 0),
 	}
 
+	if resources.GetAnnotation(res, annotations.ReconcilePaused) == "true" {
+		return ctrl.Result{}, r.paused(ctx, &rr)
+	}
+
+	if from, ok := pendingUpgrade(&rr); ok {
+		return ctrl.Result{}, r.upgradePending(ctx, &rr, from)
+	}
+
+	if from, reason, ok := upgradeBlocked(ctx, &rr); ok {
+		return ctrl.Result{}, r.upgradeBlocked(ctx, &rr, from, reason)
+	}
+
 	// reset conditions so any unknown condition eventually set on
 	// the owned resource get cleaned up. This is the case when a
 	// condition is replaced/removed.
@@ -293,7 +353,7 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 			l.WithName(actions.ActionGroup).WithName(action.String()),
 		)
 
-		provisionErr = action(actx, &rr)
+		provisionErr = r.executeAction(actx, action, &rr)
 		if provisionErr != nil {
 			break
 		}
@@ -301,11 +361,23 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 
 	// Set provisioning condition based on action execution result
 	if provisionErr != nil {
-		rr.Conditions.MarkFalse(
-			status.ConditionTypeProvisioningSucceeded,
+		opts := []conditions.Option{
 			conditions.WithError(provisionErr),
 			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
-		)
+		}
+
+		// past the retry budget of the instance, it is only retried every MaxDelay
+		if failures, exhausted := r.retriesExhausted(res); exhausted {
+			RetriesExhaustedTotal.WithLabelValues(r.name).Inc()
+
+			opts = append(opts,
+				conditions.WithReason(status.RetriesExhaustedReason),
+				conditions.WithMessage("Failed %d consecutive times, retrying every %s: %v",
+					failures, r.rateLimiter.backoff.MaxDelay, provisionErr),
+			)
+		}
+
+		rr.Conditions.MarkFalse(status.ConditionTypeProvisioningSucceeded, opts...)
 	} else {
 		rr.Conditions.MarkTrue(
 			status.ConditionTypeProvisioningSucceeded,
@@ -316,10 +388,22 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 	is := rr.Instance.GetStatus()
 	is.Phase = status.PhaseNotReady
 
+	if _, ok := rr.Instance.(common.WithComponentWorkload); ok && provisionErr == nil {
+		if from, to := is.RenderedVersion, rr.Release.Version.String(); from != "" && from != to {
+			rr.Eventf(corev1.EventTypeNormal, status.VersionUpgradedReason, "Resources upgraded from %s to %s", from, to)
+		}
+
+		is.RenderedVersion = rr.Release.Version.String()
+	}
+
 	// Update happiness to cover the case where conditions were
 	// not set using the provided helper functions
 	rr.Conditions.RecomputeHappiness("")
 
+	if r.standardConditions {
+		status.SetStandardConditions(rr.Conditions, rr.Instance.GetGeneration())
+	}
+
 	// keep conditions sorted, keeping general conditions on the
 	// top, other conditions after
 	rr.Conditions.Sort()
@@ -345,7 +429,7 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 			err.Error(),
 		)
 
-		return fmt.Errorf("reconcile failed: %w", err)
+		return ctrl.Result{}, fmt.Errorf("reconcile failed: %w", err)
 	}
 
 	if provisionErr != nil {
@@ -356,8 +440,147 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 			provisionErr.Error(),
 		)
 
-		return fmt.Errorf("provisioning failed: %w", provisionErr)
+		return ctrl.Result{}, fmt.Errorf("provisioning failed: %w", provisionErr)
+	}
+
+	return ctrl.Result{RequeueAfter: rr.RequeueAfter}, nil
+}
+
+// retriesExhausted returns the number of consecutive failed reconciliations of the given
+// instance, the current one included, and whether they reached the MaxRetries of the controller.
+func (r *Reconciler) retriesExhausted(res client.Object) (int, bool) {
+	if r.rateLimiter == nil || r.rateLimiter.backoff.MaxRetries <= 0 {
+		return 0, false
+	}
+
+	failures := r.rateLimiter.NumRequeues(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(res)}) + 1
+
+	return failures, failures >= r.rateLimiter.backoff.MaxRetries
+}
+
+// paused skips the action chain for an instance carrying the reconcile-paused
+// annotation: the deployed resources are left as they are and the previous
+// conditions are preserved, only ProvisioningSucceeded reports the pause.
+func (r *Reconciler) paused(ctx context.Context, rr *types.ReconciliationRequest) error {
+	l := log.FromContext(ctx)
+	l.Info("reconciliation paused", "annotation", annotations.ReconcilePaused)
+
+	return r.hold(ctx, rr, common.Condition{
+		Type:    status.ConditionTypeProvisioningSucceeded,
+		Status:  metav1.ConditionFalse,
+		Reason:  status.ReconcilePausedReason,
+		Message: status.ReconcilePausedMessage,
+	})
+}
+
+func (r *Reconciler) upgradePending(ctx context.Context, rr *types.ReconciliationRequest, from string) error {
+	to := rr.Release.Version.String()
+
+	l := log.FromContext(ctx)
+	l.Info("upgrade pending approval", "from", from, "to", to, "annotation", annotations.UpgradeApproved)
+
+	return r.hold(ctx, rr, common.Condition{
+		Type:   status.ConditionTypeUpgradePending,
+		Status: metav1.ConditionTrue,
+		Reason: status.UpgradePendingReason,
+		Message: fmt.Sprintf("Upgrade from %s to %s is pending approval, set the %s annotation to %s to proceed",
+			from, to, annotations.UpgradeApproved, to),
+	})
+}
+
+func (r *Reconciler) upgradeBlocked(ctx context.Context, rr *types.ReconciliationRequest, from string, reason string) error {
+	to := rr.Release.Version.String()
+
+	l := log.FromContext(ctx)
+	l.Info("upgrade blocked by the preflight checks", "from", from, "to", to)
+
+	return r.hold(ctx, rr, common.Condition{
+		Type:   status.ConditionTypeUpgradePending,
+		Status: metav1.ConditionTrue,
+		Reason: status.UpgradeBlockedReason,
+		Message: fmt.Sprintf("Upgrade from %s to %s is blocked by the DSCInitialization preflight checks: %s",
+			from, to, reason),
+	})
+}
+
+// hold records the given condition without running the actions, so the
+// resources are left as rendered by the last reconciliation.
+func (r *Reconciler) hold(ctx context.Context, rr *types.ReconciliationRequest, cond common.Condition) error {
+	cond.Severity = common.ConditionSeverityInfo
+	cond.ObservedGeneration = rr.Instance.GetGeneration()
+
+	// set the condition directly instead of going through the manager so
+	// the happiness computed by the last reconciliation is kept as is
+	conditions.SetStatusCondition(rr.Instance, cond)
+
+	rr.Conditions.Sort()
+
+	err := resources.ApplyStatus(
+		ctx,
+		r.Client,
+		rr.Instance,
+		client.FieldOwner(r.name),
+		client.ForceOwnership,
+	)
+
+	if err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("reconcile failed: %w", err)
 	}
 
 	return nil
 }
+
+// upgradeBlocked returns the operator version the instance resources were rendered with, and the
+// failures of the preflight checks, when the resources have to be upgraded to a new major version
+// of the operator but the DSCInitialization reports the upgrade as blocked by the preflight checks.
+func upgradeBlocked(ctx context.Context, rr *types.ReconciliationRequest) (string, string, bool) {
+	if _, ok := rr.Instance.(common.WithComponentWorkload); !ok {
+		return "", "", false
+	}
+
+	rendered := rr.Instance.GetStatus().RenderedVersion
+	if rendered == "" {
+		return "", "", false
+	}
+
+	from, err := semver.ParseTolerant(rendered)
+	if err != nil || from.Major == rr.Release.Version.Major {
+		return "", "", false
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, rr.Client)
+	if err != nil {
+		return "", "", false
+	}
+
+	for _, c := range dsci.Status.Conditions {
+		if c.Type == status.ConditionTypePreflightChecksPassed && c.Reason == status.UpgradeBlockedReason {
+			return rendered, c.Message, true
+		}
+	}
+
+	return "", "", false
+}
+
+// pendingUpgrade returns the operator version the instance resources were rendered
+// with, when they have to be upgraded to the current operator version but the
+// instance requires a manual approval that has not been given yet.
+func pendingUpgrade(rr *types.ReconciliationRequest) (string, bool) {
+	if rr.WorkloadSpec().GetUpgradeStrategy() != common.UpgradeStrategyManual {
+		return "", false
+	}
+
+	rendered := rr.Instance.GetStatus().RenderedVersion
+	target := rr.Release.Version.String()
+
+	// nothing has been rendered yet, or the resources are already up to date
+	if rendered == "" || rendered == target {
+		return "", false
+	}
+
+	if resources.GetAnnotation(rr.Instance, annotations.UpgradeApproved) == target {
+		return "", false
+	}
+
+	return rendered, true
+}
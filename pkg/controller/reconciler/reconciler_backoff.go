@@ -0,0 +1,106 @@
+package reconciler
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Backoff is the retry policy of the failed reconciliations of a controller.
+type Backoff struct {
+	// BaseDelay is the delay of the first retry of a failed reconciliation, doubled on each
+	// consecutive failure of the same instance, up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay between the retries of a failed reconciliation.
+	MaxDelay time.Duration
+	// MaxRetries is the number of consecutive failures of an instance after which its retries
+	// are reported as exhausted and only made every MaxDelay, 0 for no limit.
+	MaxRetries int
+	// QPS and Burst bound the rate of the retries of the controller, so a failing controller
+	// does not starve the others.
+	QPS   int
+	Burst int
+}
+
+// DefaultBackoff is the retry policy of the controller-runtime controllers.
+var DefaultBackoff = Backoff{
+	BaseDelay: 5 * time.Millisecond,
+	MaxDelay:  1000 * time.Second,
+	QPS:       10,
+	Burst:     100,
+}
+
+var backoff = struct {
+	sync.Mutex
+	value Backoff
+}{
+	value: DefaultBackoff,
+}
+
+// SetBackoff sets the retry policy of the controllers built afterwards, the fields which are not
+// set keep the value of DefaultBackoff.
+func SetBackoff(b Backoff) {
+	if b.BaseDelay <= 0 {
+		b.BaseDelay = DefaultBackoff.BaseDelay
+	}
+	if b.MaxDelay <= 0 {
+		b.MaxDelay = DefaultBackoff.MaxDelay
+	}
+	if b.QPS <= 0 {
+		b.QPS = DefaultBackoff.QPS
+	}
+	if b.Burst <= 0 {
+		b.Burst = DefaultBackoff.Burst
+	}
+
+	backoff.Lock()
+	defer backoff.Unlock()
+
+	backoff.value = b
+}
+
+// GetBackoff returns the retry policy of the controllers built afterwards.
+func GetBackoff() Backoff {
+	backoff.Lock()
+	defer backoff.Unlock()
+
+	return backoff.value
+}
+
+// RateLimiter delays the retries of the failed reconciliations of a controller exponentially,
+// within the retry budget of the controller, and once the retries of an instance are exhausted
+// only retries it every MaxDelay.
+type RateLimiter struct {
+	workqueue.TypedRateLimiter[reconcile.Request]
+
+	backoff Backoff
+}
+
+// NewRateLimiter returns a rate limiter applying the given retry policy.
+func NewRateLimiter(b Backoff) *RateLimiter {
+	return &RateLimiter{
+		TypedRateLimiter: workqueue.NewTypedMaxOfRateLimiter(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](b.BaseDelay, b.MaxDelay),
+			&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(b.QPS), b.Burst)},
+		),
+		backoff: b,
+	}
+}
+
+func (l *RateLimiter) When(item reconcile.Request) time.Duration {
+	d := l.TypedRateLimiter.When(item)
+
+	if l.Exhausted(item) {
+		return l.backoff.MaxDelay
+	}
+
+	return d
+}
+
+// Exhausted returns whether the given instance failed MaxRetries consecutive times.
+func (l *RateLimiter) Exhausted(item reconcile.Request) bool {
+	return l.backoff.MaxRetries > 0 && l.NumRequeues(item) >= l.backoff.MaxRetries
+}
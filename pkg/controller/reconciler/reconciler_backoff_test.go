@@ -0,0 +1,63 @@
+package reconciler_test
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRateLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	l := reconciler.NewRateLimiter(reconciler.Backoff{
+		BaseDelay:  time.Second,
+		MaxDelay:   time.Minute,
+		MaxRetries: 3,
+		QPS:        100,
+		Burst:      100,
+	})
+
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default-dashboard"}}
+	other := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default-kserve"}}
+
+	g.Expect(l.When(item)).Should(Equal(1 * time.Second))
+	g.Expect(l.When(item)).Should(Equal(2 * time.Second))
+	g.Expect(l.Exhausted(item)).Should(BeFalse())
+
+	// once exhausted, the retries are only made every MaxDelay
+	g.Expect(l.When(item)).Should(Equal(time.Minute))
+	g.Expect(l.Exhausted(item)).Should(BeTrue())
+	g.Expect(l.When(item)).Should(Equal(time.Minute))
+
+	// the failures of an instance do not delay the others
+	g.Expect(l.When(other)).Should(Equal(time.Second))
+	g.Expect(l.Exhausted(other)).Should(BeFalse())
+
+	l.Forget(item)
+	g.Expect(l.Exhausted(item)).Should(BeFalse())
+	g.Expect(l.When(item)).Should(Equal(time.Second))
+}
+
+func TestSetBackoff(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Cleanup(func() {
+		reconciler.SetBackoff(reconciler.DefaultBackoff)
+	})
+
+	reconciler.SetBackoff(reconciler.Backoff{MaxDelay: time.Minute, MaxRetries: 5})
+
+	g.Expect(reconciler.GetBackoff()).Should(Equal(reconciler.Backoff{
+		BaseDelay:  reconciler.DefaultBackoff.BaseDelay,
+		MaxDelay:   time.Minute,
+		MaxRetries: 5,
+		QPS:        reconciler.DefaultBackoff.QPS,
+		Burst:      reconciler.DefaultBackoff.Burst,
+	}))
+}
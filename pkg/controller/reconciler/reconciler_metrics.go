@@ -1,8 +1,20 @@
 package reconciler
 
 import (
+	"strings"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+)
+
+const (
+	ReconcileResultSuccess = "success"
+	ReconcileResultError   = "error"
+
+	RequeueReasonError     = "error"
+	RequeueReasonScheduled = "scheduled"
 )
 
 var (
@@ -19,12 +31,103 @@ var (
 			"controller",
 		},
 	)
+
+	// ReconcileDurationSeconds is a prometheus histogram metrics which holds the duration
+	// of the reconciliations of the platform resources per controller.
+	// It has two labels.
+	// controller label refers to the controller name, i.e. the component or service name.
+	// result label refers to the outcome of the reconciliation, success or error.
+	ReconcileDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "reconciler_reconcile_duration_seconds",
+			Help:    "Duration of the reconciliations",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+		[]string{
+			"controller",
+			"result",
+		},
+	)
+
+	// ActionDurationSeconds is a prometheus histogram metrics which holds the duration
+	// of the actions executed by the reconciler per controller.
+	// It has three labels.
+	// controller label refers to the controller name.
+	// action label refers to the name of the action, e.g. kustomize.(*Action).run.
+	// result label refers to the outcome of the action, success or error.
+	ActionDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "reconciler_action_duration_seconds",
+			Help:    "Duration of the actions",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{
+			"controller",
+			"action",
+			"result",
+		},
+	)
+
+	// ReconcileRequeuesTotal is a prometheus counter metrics which holds the number of
+	// reconciliations requeued per controller.
+	// It has two labels.
+	// controller label refers to the controller name.
+	// reason label refers to the cause of the requeue: error for a failed reconciliation, retried
+	// with an exponential backoff, or scheduled for a reconciliation requested again after a delay.
+	ReconcileRequeuesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "reconciler_requeues_total",
+			Help: "Number of requeued reconciliations",
+		},
+		[]string{
+			"controller",
+			"reason",
+		},
+	)
+
+	// RetriesExhaustedTotal is a prometheus counter metrics which holds the number of failed
+	// reconciliations past the retry budget of their instance per controller.
+	// It has one labels.
+	// controller label refers to the controller name.
+	RetriesExhaustedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "reconciler_retries_exhausted_total",
+			Help: "Number of failed reconciliations past the retry budget of their instance",
+		},
+		[]string{
+			"controller",
+		},
+	)
 )
 
+// actionName returns the name an action is reported with: the name of its function,
+// without the package path.
+func actionName(action actions.Fn) string {
+	name := action.String()
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	return strings.TrimSuffix(name, "-fm")
+}
+
+// resultOf returns the value of the result label for the given error.
+func resultOf(err error) string {
+	if err != nil {
+		return ReconcileResultError
+	}
+
+	return ReconcileResultSuccess
+}
+
 // init register metrics to the global registry from controller-runtime/pkg/metrics.
 // see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
 //
 //nolint:gochecknoinits
 func init() {
-	metrics.Registry.MustRegister(DynamicWatchResourcesTotal)
+	metrics.Registry.MustRegister(
+		DynamicWatchResourcesTotal,
+		ReconcileDurationSeconds,
+		ActionDurationSeconds,
+		ReconcileRequeuesTotal,
+		RetriesExhaustedTotal,
+	)
 }
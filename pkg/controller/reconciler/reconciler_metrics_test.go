@@ -0,0 +1,76 @@
+//nolint:testpackage
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+func mockFailingFinalizerAction(_ context.Context, _ *odhtypes.ReconciliationRequest) error {
+	return errors.New("failure")
+}
+
+func TestActionName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(actionName(mockFinalizerAction)).Should(Equal("reconciler.mockFinalizerAction"))
+
+	action := deploy.NewAction()
+	g.Expect(actionName(action)).Should(Equal("deploy.(*Action).run"))
+}
+
+func TestReconcileMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	ReconcileDurationSeconds.Reset()
+	ActionDurationSeconds.Reset()
+
+	mockDashboard := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       mockDashboardName,
+			Finalizers: []string{platformFinalizer},
+			DeletionTimestamp: &metav1.Time{
+				Time: time.Now(),
+			},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       componentApi.DashboardKind,
+			APIVersion: componentApi.GroupVersion.Version,
+		},
+	}
+
+	ctx, mgr, _ := setupTest(mockDashboard)
+
+	r, err := ReconcilerFor(mgr, mockDashboard).
+		WithFinalizer(mockFinalizerAction).
+		WithFinalizer(mockFailingFinalizerAction).
+		Build(ctx)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	_, err = r.Reconcile(ctx, reconcile.Request{
+		NamespacedName: client.ObjectKey{
+			Name: mockDashboardName,
+		},
+	})
+	g.Expect(err).Should(HaveOccurred())
+
+	g.Expect(testutil.CollectAndCount(ReconcileDurationSeconds)).Should(Equal(1))
+	g.Expect(ReconcileDurationSeconds.DeleteLabelValues(r.name, ReconcileResultError)).Should(BeTrue())
+
+	g.Expect(testutil.CollectAndCount(ActionDurationSeconds)).Should(Equal(2))
+	g.Expect(ActionDurationSeconds.DeleteLabelValues(r.name, "reconciler.mockFinalizerAction", ReconcileResultSuccess)).Should(BeTrue())
+	g.Expect(ActionDurationSeconds.DeleteLabelValues(r.name, "reconciler.mockFailingFinalizerAction", ReconcileResultError)).Should(BeTrue())
+}
@@ -12,17 +12,21 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
+	respredicates "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
@@ -95,6 +99,7 @@ type ReconcilerBuilder[T common.PlatformObject] struct {
 	errors              error
 	happyCondition      string
 	dependentConditions []string
+	standardConditions  bool
 }
 
 func ReconcilerFor[T common.PlatformObject](mgr ctrl.Manager, object T, opts ...builder.ForOption) *ReconcilerBuilder[T] {
@@ -130,6 +135,13 @@ func (b *ReconcilerBuilder[T]) WithConditions(dependents ...string) *ReconcilerB
 	return b
 }
 
+// WithStandardConditions makes the reconciler report the Available, Progressing, Degraded and
+// Reconciled conditions on the instance, in addition to its own conditions.
+func (b *ReconcilerBuilder[T]) WithStandardConditions() *ReconcilerBuilder[T] {
+	b.standardConditions = true
+	return b
+}
+
 func (b *ReconcilerBuilder[T]) WithInstanceName(instanceName string) *ReconcilerBuilder[T] {
 	b.instanceName = instanceName
 	return b
@@ -178,6 +190,30 @@ func (b *ReconcilerBuilder[T]) WatchesGVK(gvk schema.GroupVersionKind, opts ...W
 	return b.Watches(resources.GvkToUnstructured(gvk), opts...)
 }
 
+// WithWorkloadSettingsWatches reconciles the given instance, and the instances of the scoped
+// DataScienceClusters, when the cluster-wide settings applied to the workloads of the components
+// change: the DSCInitialization, the proxy settings of the cluster and the mirrors of disconnected
+// clusters the images are verified against.
+func (b *ReconcilerBuilder[T]) WithWorkloadSettingsWatches(instanceName string) *ReconcilerBuilder[T] {
+	toInstances := handlers.ToNamedAndScoped(b.mgr.GetAPIReader(), b.input.gvk, instanceName)
+
+	b.Watches(
+		&dsciv2.DSCInitialization{},
+		WithEventHandler(toInstances),
+		WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, respredicates.DSCIArchitecturesChangedPredicate)),
+	)
+
+	for _, k := range []schema.GroupVersionKind{gvk.ClusterProxy, gvk.ImageDigestMirrorSet, gvk.ImageTagMirrorSet} {
+		b.WatchesGVK(
+			k,
+			WithEventHandler(toInstances),
+			Dynamic(CrdExists(k)),
+		)
+	}
+
+	return b
+}
+
 func (b *ReconcilerBuilder[T]) Owns(object client.Object, opts ...WatchOpts) *ReconcilerBuilder[T] {
 	in := watchInput{}
 	in.object = object
@@ -228,12 +264,24 @@ func (b *ReconcilerBuilder[T]) Build(_ context.Context) (*Reconciler, error) {
 		return nil, errors.New("invalid type for object")
 	}
 
-	r, err := NewReconciler(b.mgr, name, obj, WithConditionsManagerFactory(b.happyCondition, b.dependentConditions...))
+	opts := []ReconcilerOpt{
+		WithConditionsManagerFactory(b.happyCondition, b.dependentConditions...),
+	}
+
+	if b.standardConditions {
+		opts = append(opts, WithStandardConditions())
+	}
+
+	r, err := NewReconciler(b.mgr, name, obj, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create reconciler for component %s: %w", name, err)
 	}
 
-	c := ctrl.NewControllerManagedBy(b.mgr)
+	// each controller retries its failed reconciliations within a budget of its own
+	r.rateLimiter = NewRateLimiter(GetBackoff())
+
+	c := ctrl.NewControllerManagedBy(b.mgr).
+		WithOptions(controller.Options{RateLimiter: r.rateLimiter})
 
 	// automatically add default predicates to the watched API if no
 	// predicates are provided
@@ -0,0 +1,39 @@
+//nolint:testpackage
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+func TestWithWorkloadSettingsWatches(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mockDashboard := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mockDashboardName,
+		},
+	}
+
+	_, mgr, _ := setupTest(mockDashboard)
+
+	b := ReconcilerFor(mgr, mockDashboard).WithWorkloadSettingsWatches(mockDashboardName)
+	g.Expect(b.watches).Should(gomega.HaveLen(4))
+
+	g.Expect(b.watches[0].object).Should(gomega.BeAssignableToTypeOf(&dsciv2.DSCInitialization{}))
+	g.Expect(b.watches[0].dynamic).Should(gomega.BeFalse())
+
+	for i, k := range []string{gvk.ClusterProxy.Kind, gvk.ImageDigestMirrorSet.Kind, gvk.ImageTagMirrorSet.Kind} {
+		w := b.watches[i+1]
+		g.Expect(w.object.GetObjectKind().GroupVersionKind().Kind).Should(gomega.Equal(k))
+		// the cluster-wide settings may not be served by the cluster
+		g.Expect(w.dynamic).Should(gomega.BeTrue())
+		g.Expect(w.owned).Should(gomega.BeFalse())
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -33,7 +34,9 @@ import (
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtype "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 	"github.com/opendatahub-io/opendatahub-operator/v2/tests/envtestutil"
 
@@ -222,3 +225,253 @@ func TestConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcilePaused(t *testing.T) {
+	tests := []struct {
+		name     string
+		paused   string
+		executed bool
+		matcher  gomegaTypes.GomegaMatcher
+	}{
+		{
+			name:     "not paused",
+			executed: true,
+			matcher: jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+				status.ConditionTypeProvisioningSucceeded, metav1.ConditionTrue),
+		},
+		{
+			name:     "paused",
+			paused:   "true",
+			executed: false,
+			matcher: And(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+					"foo", metav1.ConditionFalse),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+					status.ConditionTypeReady, metav1.ConditionFalse),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeProvisioningSucceeded, status.ReconcilePausedReason),
+			),
+		},
+		{
+			name:     "paused with unexpected value",
+			paused:   "yes",
+			executed: true,
+			matcher: jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+				status.ConditionTypeProvisioningSucceeded, metav1.ConditionTrue),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			dash := &componentApi.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       componentApi.DashboardInstanceName,
+					Generation: 1,
+				},
+				Status: componentApi.DashboardStatus{
+					Status: common.Status{
+						Conditions: []common.Condition{
+							{Type: status.ConditionTypeReady, Status: metav1.ConditionFalse, Severity: common.ConditionSeverityError},
+							{Type: "foo", Status: metav1.ConditionFalse, Severity: common.ConditionSeverityError},
+						},
+					},
+				},
+			}
+
+			if tt.paused != "" {
+				resources.SetAnnotation(dash, annotations.ReconcilePaused, tt.paused)
+			}
+
+			// the fake client does not support apply patches, capture the
+			// status being applied instead
+			var applied client.Object
+
+			cli, err := fakeclient.New(
+				fakeclient.WithObjects(dash),
+				fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(_ context.Context, _ client.Client, _ string, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						applied = obj.DeepCopyObject().(client.Object) //nolint:forcetypeassert
+						return nil
+					},
+				}),
+			)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			executed := false
+
+			cc := createReconciler(cli)
+			cc.AddAction(func(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+				executed = true
+				return nil
+			})
+
+			_, err = cc.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: dash.Name}})
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(executed).Should(Equal(tt.executed))
+			g.Expect(applied).Should(tt.matcher)
+		})
+	}
+}
+
+func TestReconcileUpgradePending(t *testing.T) {
+	current := cluster.GetRelease().Version.String()
+
+	tests := []struct {
+		name      string
+		strategy  common.UpgradeStrategy
+		rendered  string
+		approved  string
+		preflight string
+		executed  bool
+		matcher   gomegaTypes.GomegaMatcher
+	}{
+		{
+			name:     "automatic",
+			strategy: common.UpgradeStrategyAutomatic,
+			rendered: "1.0.0",
+			executed: true,
+			matcher:  jq.Match(`.status.renderedVersion == "%s"`, current),
+		},
+		{
+			name:      "automatic with failed preflight checks",
+			strategy:  common.UpgradeStrategyAutomatic,
+			rendered:  "1.0.0",
+			preflight: status.PreflightFailedReason,
+			executed:  true,
+			matcher:   jq.Match(`.status.renderedVersion == "%s"`, current),
+		},
+		{
+			name:      "automatic blocked by preflight checks",
+			strategy:  common.UpgradeStrategyAutomatic,
+			rendered:  "1.0.0",
+			preflight: status.UpgradeBlockedReason,
+			executed:  false,
+			matcher: And(
+				jq.Match(`.status.renderedVersion == "1.0.0"`),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeUpgradePending, status.UpgradeBlockedReason),
+			),
+		},
+		{
+			name:     "manual first rendering",
+			strategy: common.UpgradeStrategyManual,
+			executed: true,
+			matcher:  jq.Match(`.status.renderedVersion == "%s"`, current),
+		},
+		{
+			name:     "manual not approved",
+			strategy: common.UpgradeStrategyManual,
+			rendered: "1.0.0",
+			executed: false,
+			matcher: And(
+				jq.Match(`.status.renderedVersion == "1.0.0"`),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+					status.ConditionTypeUpgradePending, metav1.ConditionTrue),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`,
+					status.ConditionTypeUpgradePending, status.UpgradePendingReason),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+					status.ConditionTypeReady, metav1.ConditionTrue),
+			),
+		},
+		{
+			name:     "manual approved for another version",
+			strategy: common.UpgradeStrategyManual,
+			rendered: "1.0.0",
+			approved: "1.0.0",
+			executed: false,
+			matcher: jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+				status.ConditionTypeUpgradePending, metav1.ConditionTrue),
+		},
+		{
+			name:     "manual approved",
+			strategy: common.UpgradeStrategyManual,
+			rendered: "1.0.0",
+			approved: current,
+			executed: true,
+			matcher: And(
+				jq.Match(`.status.renderedVersion == "%s"`, current),
+				jq.Match(`[.status.conditions[] | select(.type == "%s")] | length == 0`,
+					status.ConditionTypeUpgradePending),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			dash := &componentApi.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       componentApi.DashboardInstanceName,
+					Generation: 1,
+				},
+				Spec: componentApi.DashboardSpec{
+					DashboardCommonSpec: componentApi.DashboardCommonSpec{
+						ComponentWorkloadSpec: common.ComponentWorkloadSpec{
+							UpgradeStrategy: tt.strategy,
+						},
+					},
+				},
+				Status: componentApi.DashboardStatus{
+					Status: common.Status{
+						RenderedVersion: tt.rendered,
+						Conditions: []common.Condition{
+							{Type: status.ConditionTypeReady, Status: metav1.ConditionTrue},
+						},
+					},
+				},
+			}
+
+			if tt.approved != "" {
+				resources.SetAnnotation(dash, annotations.UpgradeApproved, tt.approved)
+			}
+
+			objects := []client.Object{dash}
+
+			if tt.preflight != "" {
+				objects = append(objects, &dsciv2.DSCInitialization{
+					ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+					Status: dsciv2.DSCInitializationStatus{
+						Conditions: []common.Condition{{
+							Type:   status.ConditionTypePreflightChecksPassed,
+							Status: metav1.ConditionFalse,
+							Reason: tt.preflight,
+						}},
+					},
+				})
+			}
+
+			// the fake client does not support apply patches, capture the
+			// status being applied instead
+			var applied client.Object
+
+			cli, err := fakeclient.New(
+				fakeclient.WithObjects(objects...),
+				fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(_ context.Context, _ client.Client, _ string, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						applied = obj.DeepCopyObject().(client.Object) //nolint:forcetypeassert
+						return nil
+					},
+				}),
+			)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			executed := false
+
+			cc := createReconciler(cli)
+			cc.AddAction(func(ctx context.Context, rr *odhtype.ReconciliationRequest) error {
+				executed = true
+				return nil
+			})
+
+			_, err = cc.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: dash.Name}})
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(executed).Should(Equal(tt.executed))
+			g.Expect(applied).Should(tt.matcher)
+		})
+	}
+}
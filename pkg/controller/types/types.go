@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"io/fs"
 	"path"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -32,6 +36,9 @@ type Controller interface {
 
 	// GetDynamicClient returns a client-go dynamic client for working with unstructured resources.
 	GetDynamicClient() dynamic.Interface
+
+	// GetEventRecorder returns the recorder used to emit events about the reconciled resources.
+	GetEventRecorder() record.EventRecorder
 }
 
 type ResourceObject interface {
@@ -107,6 +114,73 @@ type ReconciliationRequest struct {
 	//       replaced with a better way of describing resources and
 	//       their origin
 	Generated bool
+
+	// RequeueAfter, when set by an action, schedules a new reconciliation of the
+	// instance, to refresh state that is not backed by a watched resource.
+	RequeueAfter time.Duration
+}
+
+// Requeue schedules a new reconciliation of the instance after the given duration,
+// keeping the earliest one when requested by several actions.
+func (rr *ReconciliationRequest) Requeue(after time.Duration) {
+	if after <= 0 {
+		return
+	}
+
+	if rr.RequeueAfter == 0 || after < rr.RequeueAfter {
+		rr.RequeueAfter = after
+	}
+}
+
+// WorkloadSpec returns the workload settings of the reconciled instance, nil when the instance
+// is not a component or its spec does not hold them. The getters of the returned value handle nil.
+func (rr *ReconciliationRequest) WorkloadSpec() *common.ComponentWorkloadSpec {
+	w, ok := rr.Instance.(common.WithComponentWorkload)
+	if !ok {
+		return nil
+	}
+
+	return w.GetWorkloadSpec()
+}
+
+// Eventf records an event about the reconciled instance. Warning events are recorded on the
+// resource controlling the instance as well, if any, e.g. the DataScienceCluster owning a
+// component, so the failures are shown when describing it.
+func (rr *ReconciliationRequest) Eventf(eventType string, reason string, messageFmt string, args ...any) {
+	if rr.Controller == nil || rr.Instance == nil {
+		return
+	}
+
+	recorder := rr.Controller.GetEventRecorder()
+	if recorder == nil {
+		return
+	}
+
+	recorder.Eventf(rr.Instance, eventType, reason, messageFmt, args...)
+
+	if eventType != corev1.EventTypeWarning {
+		return
+	}
+
+	owner := metav1.GetControllerOf(rr.Instance)
+	if owner == nil {
+		return
+	}
+
+	recorder.Eventf(
+		&corev1.ObjectReference{
+			APIVersion: owner.APIVersion,
+			Kind:       owner.Kind,
+			Name:       owner.Name,
+			UID:        owner.UID,
+		},
+		eventType,
+		reason,
+		"%s %s: %s",
+		rr.Instance.GetObjectKind().GroupVersionKind().Kind,
+		rr.Instance.GetName(),
+		fmt.Sprintf(messageFmt, args...),
+	)
 }
 
 // AddResources adds one or more resources to the ReconciliationRequest's Resources slice.
@@ -2,20 +2,26 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/operator-framework/api/pkg/lib/version"
 	"github.com/rs/xid"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachinery "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
 
 	. "github.com/onsi/gomega"
 )
@@ -125,6 +131,24 @@ func TestReconciliationRequest_RemoveResources(t *testing.T) {
 	))
 }
 
+func TestReconciliationRequest_Requeue(t *testing.T) {
+	g := NewWithT(t)
+
+	rr := types.ReconciliationRequest{}
+
+	rr.Requeue(0)
+	g.Expect(rr.RequeueAfter).To(BeZero())
+
+	rr.Requeue(10 * time.Minute)
+	g.Expect(rr.RequeueAfter).To(Equal(10 * time.Minute))
+
+	rr.Requeue(time.Minute)
+	g.Expect(rr.RequeueAfter).To(Equal(time.Minute))
+
+	rr.Requeue(5 * time.Minute)
+	g.Expect(rr.RequeueAfter).To(Equal(time.Minute))
+}
+
 func TestHash_WithNilDSCI(t *testing.T) {
 	g := NewWithT(t)
 
@@ -155,3 +179,39 @@ func TestHash_WithNilDSCI(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(hash1).ToNot(BeEmpty())
 }
+
+func TestReconciliationRequest_Eventf(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc := &dscv2.DataScienceCluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: dscv2.GroupVersion.String(), Kind: gvk.DataScienceCluster.Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc", UID: apimachinery.UID(xid.New().String())},
+	}
+
+	instance := &v1alpha1.Dashboard{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: v1alpha1.DashboardKind},
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.DashboardInstanceName},
+	}
+	instance.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(dsc, gvk.DataScienceCluster)})
+
+	recorder := record.NewFakeRecorder(10)
+
+	rr := types.ReconciliationRequest{
+		Instance: instance,
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("GetEventRecorder").Return(recorder)
+		}),
+	}
+
+	rr.Eventf(corev1.EventTypeNormal, "Deleted", "deleted %s", "foo")
+	g.Expect(recorder.Events).Should(Receive(Equal("Normal Deleted deleted foo")))
+	g.Expect(recorder.Events).ShouldNot(Receive())
+
+	rr.Eventf(corev1.EventTypeWarning, "Failed", "failed %s", "foo")
+	g.Expect(recorder.Events).Should(Receive(Equal("Warning Failed failed foo")))
+	g.Expect(recorder.Events).Should(Receive(Equal("Warning Failed Dashboard default-dashboard: failed foo")))
+
+	// no controller, no events
+	(&types.ReconciliationRequest{Instance: instance}).Eventf(corev1.EventTypeWarning, "Failed", "failed")
+	g.Expect(recorder.Events).ShouldNot(Receive())
+}
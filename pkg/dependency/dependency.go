@@ -0,0 +1,442 @@
+// Package dependency tracks the third-party operators the components depend on, e.g. cert-manager or
+// Serverless: it detects whether they are installed, reports the health of their ClusterServiceVersion
+// and creates their OLM Subscription when the DSCInitialization manages them.
+package dependency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// DefaultSource is the CatalogSource the Subscriptions are created from by default.
+	DefaultSource = "redhat-operators"
+	// DefaultSourceNamespace is the namespace of DefaultSource.
+	DefaultSourceNamespace = "openshift-marketplace"
+
+	// globalOperatorsNamespace already holds an OperatorGroup watching all the namespaces.
+	globalOperatorsNamespace = "openshift-operators"
+)
+
+// Dependency is a third-party operator the components depend on.
+type Dependency struct {
+	// Name identifies the dependency in the dependencies of the DSCInitialization.
+	Name string
+	// DisplayName of the operator in the reported messages.
+	DisplayName string
+	// ConditionType is the type of the DSCInitialization condition reporting the dependency.
+	ConditionType string
+	// Package is the OLM package of the operator, subscribed to when the dependency is Managed.
+	Package string
+	// OperatorPrefix is the prefix of the names of the ClusterServiceVersions of the operator, and of
+	// their OperatorConditions.
+	OperatorPrefix string
+	// Namespace the Subscription is created in.
+	Namespace string
+	// Channel of the Subscription, unless set in the DSCInitialization.
+	Channel string
+	// Required returns whether the dependency is required by the given DSCInitialization and
+	// DataScienceCluster, which may be nil. The dependencies which are never required are only
+	// tracked when listed in the DSCInitialization.
+	Required func(dsci *dsciv2.DSCInitialization, dsc *dscv2.DataScienceCluster) bool
+}
+
+var (
+	CertManager = Dependency{
+		Name:           "cert-manager",
+		DisplayName:    "cert-manager Operator for Red Hat OpenShift",
+		ConditionType:  "CertManagerReady",
+		Package:        "openshift-cert-manager-operator",
+		OperatorPrefix: "cert-manager-operator",
+		Namespace:      "cert-manager-operator",
+		Channel:        "stable-v1",
+		Required:       kueueUnmanaged,
+	}
+	Serverless = Dependency{
+		Name:           "serverless",
+		DisplayName:    "Red Hat OpenShift Serverless",
+		ConditionType:  "ServerlessReady",
+		Package:        "serverless-operator",
+		OperatorPrefix: "serverless-operator",
+		Namespace:      "openshift-serverless",
+		Channel:        "stable",
+		Required:       kserveServerless,
+	}
+	ServiceMesh = Dependency{
+		Name:           "servicemesh",
+		DisplayName:    "Red Hat OpenShift Service Mesh",
+		ConditionType:  "ServiceMeshReady",
+		Package:        "servicemeshoperator3",
+		OperatorPrefix: "servicemeshoperator3",
+		Namespace:      globalOperatorsNamespace,
+		Channel:        "stable",
+	}
+	Authorino = Dependency{
+		Name:           "authorino",
+		DisplayName:    "Red Hat - Authorino",
+		ConditionType:  "AuthorinoReady",
+		Package:        "authorino-operator",
+		OperatorPrefix: "authorino-operator",
+		Namespace:      globalOperatorsNamespace,
+		Channel:        "stable",
+	}
+	Kueue = Dependency{
+		Name:           "kueue",
+		DisplayName:    "Red Hat build of Kueue",
+		ConditionType:  "KueueOperatorReady",
+		Package:        "kueue-operator",
+		OperatorPrefix: "kueue-operator",
+		Namespace:      "openshift-kueue-operator",
+		Channel:        "stable-v1.1",
+		Required:       kueueUnmanaged,
+	}
+	OpenTelemetry = Dependency{
+		Name:           "opentelemetry",
+		DisplayName:    "Red Hat build of OpenTelemetry",
+		ConditionType:  "OpenTelemetryOperatorReady",
+		Package:        "opentelemetry-product",
+		OperatorPrefix: "opentelemetry-operator",
+		Namespace:      "openshift-opentelemetry-operator",
+		Channel:        "stable",
+		Required: func(dsci *dsciv2.DSCInitialization, _ *dscv2.DataScienceCluster) bool {
+			return monitoringManaged(dsci) && (dsci.Spec.Monitoring.Metrics != nil || dsci.Spec.Monitoring.Traces != nil)
+		},
+	}
+	ClusterObservability = Dependency{
+		Name:           "cluster-observability",
+		DisplayName:    "Cluster Observability Operator",
+		ConditionType:  "ClusterObservabilityOperatorReady",
+		Package:        "cluster-observability-operator",
+		OperatorPrefix: "cluster-observability-operator",
+		Namespace:      "openshift-cluster-observability-operator",
+		Channel:        "stable",
+		Required: func(dsci *dsciv2.DSCInitialization, _ *dscv2.DataScienceCluster) bool {
+			return monitoringManaged(dsci) && dsci.Spec.Monitoring.Metrics != nil
+		},
+	}
+	Tempo = Dependency{
+		Name:           "tempo",
+		DisplayName:    "Tempo Operator",
+		ConditionType:  "TempoOperatorReady",
+		Package:        "tempo-product",
+		OperatorPrefix: "tempo-operator",
+		Namespace:      "openshift-tempo-operator",
+		Channel:        "stable",
+		Required: func(dsci *dsciv2.DSCInitialization, _ *dscv2.DataScienceCluster) bool {
+			if !monitoringManaged(dsci) || dsci.Spec.Monitoring.Traces == nil {
+				return false
+			}
+
+			backend := dsci.Spec.Monitoring.Traces.Backend
+
+			return backend == nil || backend.Type == "" || backend.Type == serviceApi.TracesBackendTempo
+		},
+	}
+)
+
+// All are the dependencies known to the operator, the ones the DSCInitialization can list.
+var All = []Dependency{
+	CertManager,
+	Serverless,
+	ServiceMesh,
+	Authorino,
+	Kueue,
+	OpenTelemetry,
+	ClusterObservability,
+	Tempo,
+}
+
+// Get returns the known dependency with the given name.
+func Get(name string) (Dependency, bool) {
+	i := slices.IndexFunc(All, func(d Dependency) bool { return d.Name == name })
+	if i < 0 {
+		return Dependency{}, false
+	}
+
+	return All[i], true
+}
+
+// Phase is the installation phase of a dependency.
+type Phase string
+
+const (
+	// PhaseMissing means the operator is not installed, nor subscribed to.
+	PhaseMissing Phase = "Missing"
+	// PhaseInstalling means the operator is subscribed to, or its ClusterServiceVersion is not
+	// yet succeeded.
+	PhaseInstalling Phase = "Installing"
+	// PhaseReady means the ClusterServiceVersion of the operator succeeded.
+	PhaseReady Phase = "Ready"
+	// PhaseFailed means the ClusterServiceVersion of the operator failed.
+	PhaseFailed Phase = "Failed"
+)
+
+// State is the observed state of a dependency.
+type State struct {
+	Dependency Dependency
+	Phase      Phase
+	// ClusterServiceVersion is the name of the ClusterServiceVersion of the operator, when installed.
+	ClusterServiceVersion string
+	// Message details the phase, e.g. the reason of the failure of the ClusterServiceVersion.
+	Message string
+}
+
+// Installed returns whether the operator of the given dependency is installed, whatever the
+// health of its ClusterServiceVersion.
+func Installed(ctx context.Context, cli client.Reader, d Dependency) (bool, error) {
+	return cluster.OperatorExists(ctx, cli, d.OperatorPrefix)
+}
+
+// Observe returns the state of the given dependency: the phase of the ClusterServiceVersion of its
+// operator, found through the OperatorCondition OLM creates along with it, or whether the operator
+// is subscribed to when not yet installed.
+func Observe(ctx context.Context, cli client.Reader, d Dependency) (State, error) {
+	state := State{Dependency: d, Phase: PhaseMissing}
+
+	conditions := &ofapiv2.OperatorConditionList{}
+	if err := cli.List(ctx, conditions); err != nil {
+		return state, fmt.Errorf("failed to list the OperatorConditions: %w", err)
+	}
+
+	i := slices.IndexFunc(conditions.Items, func(c ofapiv2.OperatorCondition) bool {
+		return strings.HasPrefix(c.Name, d.OperatorPrefix)
+	})
+
+	if i < 0 {
+		sub, err := subscription(ctx, cli, d)
+		if err != nil {
+			return state, err
+		}
+
+		if sub != nil {
+			state.Phase = PhaseInstalling
+			state.Message = fmt.Sprintf("Subscription %s/%s is %s", sub.Namespace, sub.Name, subscriptionState(sub))
+		}
+
+		return state, nil
+	}
+
+	oc := conditions.Items[i]
+	state.ClusterServiceVersion = oc.Name
+
+	csv := &ofapiv1alpha1.ClusterServiceVersion{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: oc.Namespace, Name: oc.Name}, csv)
+	switch {
+	case k8serr.IsNotFound(err):
+		state.Phase = PhaseInstalling
+		state.Message = "ClusterServiceVersion not created yet"
+		return state, nil
+	case err != nil:
+		return state, fmt.Errorf("failed to get the ClusterServiceVersion %s/%s: %w", oc.Namespace, oc.Name, err)
+	}
+
+	switch csv.Status.Phase {
+	case ofapiv1alpha1.CSVPhaseSucceeded:
+		state.Phase = PhaseReady
+	case ofapiv1alpha1.CSVPhaseFailed:
+		state.Phase = PhaseFailed
+		state.Message = csv.Status.Message
+	default:
+		state.Phase = PhaseInstalling
+		state.Message = fmt.Sprintf("ClusterServiceVersion is %s", csv.Status.Phase)
+		if csv.Status.Message != "" {
+			state.Message += ": " + csv.Status.Message
+		}
+	}
+
+	return state, nil
+}
+
+// Tracked returns the dependencies to track for the given DSCInitialization and DataScienceCluster,
+// which may be nil: the ones required by the enabled components, unless Removed in the
+// DSCInitialization, and the ones listed in the DSCInitialization and not Removed.
+func Tracked(dsci *dsciv2.DSCInitialization, dsc *dscv2.DataScienceCluster) []Dependency {
+	tracked := make([]Dependency, 0)
+
+	for _, d := range All {
+		spec, listed := specOf(dsci, d)
+
+		switch {
+		case listed && spec.ManagementState == operatorv1.Removed:
+			continue
+		case listed, d.Required != nil && d.Required(dsci, dsc):
+			tracked = append(tracked, d)
+		}
+	}
+
+	return tracked
+}
+
+// Reconcile observes the dependencies tracked for the given DSCInitialization and DataScienceCluster,
+// creating the Subscription of the Managed ones which are missing. A dependency that can not be
+// observed does not prevent the others from being observed, the errors are returned joined.
+func Reconcile(ctx context.Context, cli client.Client, dsci *dsciv2.DSCInitialization, dsc *dscv2.DataScienceCluster) ([]State, error) {
+	states := make([]State, 0)
+	errs := make([]error, 0)
+
+	for _, d := range Tracked(dsci, dsc) {
+		state, err := Observe(ctx, cli, d)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to observe dependency %s: %w", d.Name, err))
+			continue
+		}
+
+		if spec, _ := specOf(dsci, d); state.Phase == PhaseMissing && spec.ManagementState == operatorv1.Managed {
+			if err := Subscribe(ctx, cli, d, spec); err != nil {
+				errs = append(errs, fmt.Errorf("failed to subscribe to dependency %s: %w", d.Name, err))
+			} else {
+				state.Phase = PhaseInstalling
+				state.Message = fmt.Sprintf("Subscription %s/%s created", d.Namespace, d.Package)
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	return states, errors.Join(errs...)
+}
+
+// Subscribe creates the Subscription of the operator of the given dependency, with the channel and
+// the CatalogSource set in the given spec, along with its namespace and an OperatorGroup watching
+// all the namespaces when missing.
+func Subscribe(ctx context.Context, cli client.Client, d Dependency, spec infrav1.DependencySpec) error {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   d.Namespace,
+			Labels: map[string]string{labels.PlatformDependency: d.Name},
+		},
+	}
+	if err := cli.Create(ctx, ns); err != nil && !k8serr.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", d.Namespace, err)
+	}
+
+	if d.Namespace != globalOperatorsNamespace {
+		groups := &unstructured.UnstructuredList{}
+		groups.SetGroupVersionKind(gvk.OperatorGroup)
+		if err := cli.List(ctx, groups, client.InNamespace(d.Namespace)); err != nil {
+			return fmt.Errorf("failed to list the OperatorGroups of namespace %s: %w", d.Namespace, err)
+		}
+
+		if len(groups.Items) == 0 {
+			og := &unstructured.Unstructured{}
+			og.SetGroupVersionKind(gvk.OperatorGroup)
+			og.SetName(d.Namespace)
+			og.SetNamespace(d.Namespace)
+			og.SetLabels(map[string]string{labels.PlatformDependency: d.Name})
+			og.Object["spec"] = map[string]any{}
+
+			if err := cli.Create(ctx, og); err != nil && !k8serr.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create OperatorGroup %s/%s: %w", d.Namespace, d.Namespace, err)
+			}
+		}
+	}
+
+	sub := &ofapiv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.Package,
+			Namespace: d.Namespace,
+			Labels:    map[string]string{labels.PlatformDependency: d.Name},
+		},
+		Spec: &ofapiv1alpha1.SubscriptionSpec{
+			Package:                d.Package,
+			Channel:                valueOr(spec.Channel, d.Channel),
+			CatalogSource:          valueOr(spec.Source, DefaultSource),
+			CatalogSourceNamespace: valueOr(spec.SourceNamespace, DefaultSourceNamespace),
+			InstallPlanApproval:    ofapiv1alpha1.ApprovalAutomatic,
+		},
+	}
+	if err := cli.Create(ctx, sub); err != nil && !k8serr.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create Subscription %s/%s: %w", d.Namespace, d.Package, err)
+	}
+
+	return nil
+}
+
+// subscription returns the Subscription to the package of the given dependency in its namespace,
+// nil if there is none.
+func subscription(ctx context.Context, cli client.Reader, d Dependency) (*ofapiv1alpha1.Subscription, error) {
+	subs := &ofapiv1alpha1.SubscriptionList{}
+	if err := cli.List(ctx, subs, client.InNamespace(d.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list the Subscriptions of namespace %s: %w", d.Namespace, err)
+	}
+
+	for i := range subs.Items {
+		if subs.Items[i].Spec != nil && subs.Items[i].Spec.Package == d.Package {
+			return &subs.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func subscriptionState(sub *ofapiv1alpha1.Subscription) string {
+	if sub.Status.State == "" {
+		return "pending"
+	}
+
+	return strings.ToLower(string(sub.Status.State))
+}
+
+func specOf(dsci *dsciv2.DSCInitialization, d Dependency) (infrav1.DependencySpec, bool) {
+	if dsci != nil {
+		for _, s := range dsci.Spec.Dependencies {
+			if s.Name == d.Name {
+				return s, true
+			}
+		}
+	}
+
+	return infrav1.DependencySpec{Name: d.Name, ManagementState: operatorv1.Unmanaged}, false
+}
+
+func valueOr(value string, def string) string {
+	if value == "" {
+		return def
+	}
+
+	return value
+}
+
+func kueueUnmanaged(_ *dsciv2.DSCInitialization, dsc *dscv2.DataScienceCluster) bool {
+	return dsc != nil && dsc.Spec.Components.Kueue.ManagementState == operatorv1.Unmanaged
+}
+
+func kserveServerless(_ *dsciv2.DSCInitialization, dsc *dscv2.DataScienceCluster) bool {
+	if dsc == nil || dsc.Spec.Components.Kserve.ManagementState != operatorv1.Managed {
+		return false
+	}
+
+	k := dsc.Spec.Components.Kserve
+	if k.DefaultDeploymentMode == componentApi.DeploymentModeServerless {
+		return true
+	}
+
+	return slices.ContainsFunc(k.DeploymentModeOverrides, func(o componentApi.DeploymentModeOverride) bool {
+		return o.DeploymentMode == componentApi.DeploymentModeServerless
+	})
+}
+
+func monitoringManaged(dsci *dsciv2.DSCInitialization) bool {
+	return dsci != nil && dsci.Spec.Monitoring.ManagementState == operatorv1.Managed
+}
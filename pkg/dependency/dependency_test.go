@@ -0,0 +1,176 @@
+package dependency_test
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/dependency"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func installed(name string, namespace string, phase ofapiv1alpha1.ClusterServiceVersionPhase, message string) []client.Object {
+	return []client.Object{
+		&ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}},
+		&ofapiv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     ofapiv1alpha1.ClusterServiceVersionStatus{Phase: phase, Message: message},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []client.Object
+		phase   dependency.Phase
+		message string
+	}{
+		{
+			name:  "missing",
+			phase: dependency.PhaseMissing,
+		},
+		{
+			name: "subscribed",
+			objects: []client.Object{&ofapiv1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-manager", Namespace: dependency.CertManager.Namespace},
+				Spec:       &ofapiv1alpha1.SubscriptionSpec{Package: dependency.CertManager.Package},
+			}},
+			phase:   dependency.PhaseInstalling,
+			message: "Subscription cert-manager-operator/cert-manager is pending",
+		},
+		{
+			name:    "installing",
+			objects: installed("cert-manager-operator.v1.15.1", "cert-manager-operator", ofapiv1alpha1.CSVPhaseInstalling, "waiting for install components to report healthy"),
+			phase:   dependency.PhaseInstalling,
+			message: "ClusterServiceVersion is Installing: waiting for install components to report healthy",
+		},
+		{
+			name:    "failed",
+			objects: installed("cert-manager-operator.v1.15.1", "cert-manager-operator", ofapiv1alpha1.CSVPhaseFailed, "install timeout"),
+			phase:   dependency.PhaseFailed,
+			message: "install timeout",
+		},
+		{
+			name:    "ready",
+			objects: installed("cert-manager-operator.v1.15.1", "cert-manager-operator", ofapiv1alpha1.CSVPhaseSucceeded, ""),
+			phase:   dependency.PhaseReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(tt.objects...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			state, err := dependency.Observe(t.Context(), cli, dependency.CertManager)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(state.Phase).Should(Equal(tt.phase))
+			g.Expect(state.Message).Should(Equal(tt.message))
+		})
+	}
+}
+
+func TestTracked(t *testing.T) {
+	g := NewWithT(t)
+
+	names := func(deps []dependency.Dependency) []string {
+		n := make([]string, 0, len(deps))
+		for _, d := range deps {
+			n = append(n, d.Name)
+		}
+
+		return n
+	}
+
+	dsci := &dsciv2.DSCInitialization{}
+	dsc := &dscv2.DataScienceCluster{}
+
+	g.Expect(dependency.Tracked(dsci, nil)).Should(BeEmpty())
+
+	dsc.Spec.Components.Kueue.ManagementState = operatorv1.Unmanaged
+	dsc.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+	dsc.Spec.Components.Kserve.DeploymentModeOverrides = []componentApi.DeploymentModeOverride{{
+		DeploymentMode: componentApi.DeploymentModeServerless,
+	}}
+	g.Expect(names(dependency.Tracked(dsci, dsc))).Should(Equal([]string{"cert-manager", "serverless", "kueue"}))
+
+	dsci.Spec.Dependencies = []infrav1.DependencySpec{
+		{Name: "cert-manager", ManagementState: operatorv1.Removed},
+		{Name: "authorino"},
+	}
+	g.Expect(names(dependency.Tracked(dsci, dsc))).Should(Equal([]string{"serverless", "authorino", "kueue"}))
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	s.AddKnownTypeWithName(gvk.OperatorGroup, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gvk.OperatorGroup.GroupVersion().WithKind(gvk.OperatorGroup.Kind+"List"), &unstructured.UnstructuredList{})
+
+	cli, err := fakeclient.New(
+		fakeclient.WithScheme(s),
+		fakeclient.WithObjects(installed("authorino-operator.v1.2.0", "openshift-operators", ofapiv1alpha1.CSVPhaseSucceeded, "")...),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsci := &dsciv2.DSCInitialization{}
+	dsci.Spec.Dependencies = []infrav1.DependencySpec{
+		{Name: "cert-manager", ManagementState: operatorv1.Managed, Channel: "stable-v1.15", Source: "mirror"},
+		{Name: "authorino", ManagementState: operatorv1.Managed},
+		{Name: "servicemesh"},
+	}
+
+	states, err := dependency.Reconcile(ctx, cli, dsci, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(states).Should(HaveLen(3))
+	g.Expect(states[0].Phase).Should(Equal(dependency.PhaseInstalling))
+	g.Expect(states[1].Phase).Should(Equal(dependency.PhaseMissing))
+	g.Expect(states[2].Phase).Should(Equal(dependency.PhaseReady))
+
+	// only the missing Managed dependency is subscribed to
+	subs := &ofapiv1alpha1.SubscriptionList{}
+	g.Expect(cli.List(ctx, subs)).Should(Succeed())
+	g.Expect(subs.Items).Should(HaveLen(1))
+	g.Expect(subs.Items[0].Namespace).Should(Equal("cert-manager-operator"))
+	g.Expect(subs.Items[0].Labels).Should(HaveKeyWithValue(labels.PlatformDependency, "cert-manager"))
+	g.Expect(*subs.Items[0].Spec).Should(Equal(ofapiv1alpha1.SubscriptionSpec{
+		Package:                "openshift-cert-manager-operator",
+		Channel:                "stable-v1.15",
+		CatalogSource:          "mirror",
+		CatalogSourceNamespace: dependency.DefaultSourceNamespace,
+		InstallPlanApproval:    ofapiv1alpha1.ApprovalAutomatic,
+	}))
+
+	g.Expect(cli.Get(ctx, client.ObjectKey{Name: "cert-manager-operator"}, &corev1.Namespace{})).Should(Succeed())
+
+	og := &unstructured.Unstructured{}
+	og.SetGroupVersionKind(gvk.OperatorGroup)
+	g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: "cert-manager-operator", Name: "cert-manager-operator"}, og)).Should(Succeed())
+
+	// once subscribed, the dependency is installing
+	states, err = dependency.Reconcile(ctx, cli, dsci, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(states[0].Phase).Should(Equal(dependency.PhaseInstalling))
+	g.Expect(states[0].Message).Should(Equal("Subscription cert-manager-operator/openshift-cert-manager-operator is pending"))
+}
@@ -0,0 +1,155 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/csaupgrade"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/kustomize/api/resource"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	actionsdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// FieldManager is the field manager the resources deployed from manifests are server-side applied with.
+const FieldManager = "deploy.platform.opendatahub.io"
+
+// ConflictPolicy defines how the conflicts with other field managers are handled when applying a resource.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyForce takes the ownership of the conflicting fields, the manifests being authoritative.
+	ConflictPolicyForce ConflictPolicy = "Force"
+	// ConflictPolicyKeep keeps the values set by the other field managers, leaving the resource unchanged
+	// until the conflict is solved.
+	ConflictPolicyKeep ConflictPolicy = "Keep"
+)
+
+// conflictPolicies holds the conflict policy of the kinds not using ConflictPolicyForce: their content
+// is expected to be changed in place once deployed, e.g. rotated credentials or expanded volumes.
+var conflictPolicies = map[schema.GroupKind]ConflictPolicy{
+	{Group: "", Kind: "Secret"}:                ConflictPolicyKeep,
+	{Group: "", Kind: "PersistentVolumeClaim"}: ConflictPolicyKeep,
+}
+
+// conflictPolicyFor returns the conflict policy of the given kind.
+func conflictPolicyFor(gk schema.GroupKind) ConflictPolicy {
+	if p, ok := conflictPolicies[gk]; ok {
+		return p
+	}
+
+	return ConflictPolicyForce
+}
+
+// applyResource server-side applies the given resource with FieldManager, creating it if found is nil.
+func applyResource(ctx context.Context, cli client.Client, res *resource.Resource, found *unstructured.Unstructured, owner metav1.Object) error {
+	obj, err := conversion.ResourceToUnstructured(res)
+	if err != nil {
+		return err
+	}
+
+	if found != nil {
+		// Operator reconcile allowedListfield only when resource is managed by operator(annotation is true)
+		// all other cases: no annotation at all, required annotation not present, of annotation is non-true value,
+		// the current values are kept. They are merged into the applied resource rather than removed from it, as
+		// the operator owns them and removing them would reset them.
+		if obj.GroupVersionKind() == gvk.Deployment && found.GetAnnotations()[annotations.ManagedByODHOperator] != "true" {
+			if err := actionsdeploy.MergeDeployments(found, obj); err != nil {
+				return fmt.Errorf("failed to merge Deployment %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+
+		// Retain existing labels on update
+		updateLabels(found, obj)
+
+		if err := migrateManagedFields(ctx, cli, found, owner); err != nil {
+			return fmt.Errorf("failed to migrate managed fields of %s: %w", resources.FormatObjectReference(found), err)
+		}
+	}
+
+	// the controller reference is part of the applied configuration, so it is kept once set, but
+	// it is not added to resources created by someone else
+	if found == nil || metav1.IsControlledBy(found, owner) {
+		if err := ctrl.SetControllerReference(owner, obj, cli.Scheme()); err != nil {
+			return err
+		}
+	}
+
+	policy := conflictPolicyFor(obj.GroupVersionKind().GroupKind())
+
+	opts := []client.PatchOption{client.FieldOwner(FieldManager)}
+	if policy == ConflictPolicyForce {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	err = resources.Apply(ctx, cli, obj, opts...)
+	if policy == ConflictPolicyKeep && k8serr.IsConflict(err) {
+		logf.FromContext(ctx).Info("resource changed by another field manager, changes kept",
+			"gvk", obj.GroupVersionKind(),
+			"name", client.ObjectKeyFromObject(obj),
+			"conflict", err.Error(),
+		)
+
+		return nil
+	}
+
+	return err
+}
+
+// legacyFieldManagers returns the field managers the resources were deployed with before being
+// server-side applied with FieldManager: the operator binary, for the client-side creations, and
+// the owner, for the server-side applied updates.
+func legacyFieldManagers(owner metav1.Object) sets.Set[string] {
+	clientManager, _, _ := strings.Cut(rest.DefaultKubernetesUserAgent(), "/")
+
+	return sets.New(clientManager, owner.GetName())
+}
+
+// migrateManagedFields transfers the ownership of the fields owned by the legacy field managers to
+// FieldManager, so the fields removed from the manifests are removed from the resource as well,
+// instead of being kept by the legacy owners.
+func migrateManagedFields(ctx context.Context, cli client.Client, found *unstructured.Unstructured, owner metav1.Object) error {
+	patch, err := managedFieldsMigrationPatch(found, legacyFieldManagers(owner))
+	if err != nil || patch == nil {
+		return err
+	}
+
+	logf.FromContext(ctx).V(3).Info("migrate managed fields",
+		"gvk", found.GroupVersionKind(),
+		"name", client.ObjectKeyFromObject(found),
+	)
+
+	return cli.Patch(ctx, found, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// managedFieldsMigrationPatch returns the JSON patch merging the entries of the given managers
+// into the FieldManager apply entry, or nil if there is nothing to migrate.
+func managedFieldsMigrationPatch(found *unstructured.Unstructured, managers sets.Set[string]) ([]byte, error) {
+	obj := found.DeepCopy()
+
+	entries := obj.GetManagedFields()
+	for i := range entries {
+		// the apply entries of the legacy managers are merged the same way as the client-side ones
+		if managers.Has(entries[i].Manager) && entries[i].Operation == metav1.ManagedFieldsOperationApply && entries[i].Subresource == "" {
+			entries[i].Operation = metav1.ManagedFieldsOperationUpdate
+		}
+	}
+
+	obj.SetManagedFields(entries)
+
+	return csaupgrade.UpgradeManagedFieldsPatch(obj, managers, FieldManager)
+}
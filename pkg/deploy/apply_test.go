@@ -0,0 +1,92 @@
+package deploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/onsi/gomega"
+)
+
+func managedFieldsEntry(manager string, operation metav1.ManagedFieldsOperationType, fields string) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{
+		Manager:    manager,
+		Operation:  operation,
+		APIVersion: "v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
+
+func TestManagedFieldsMigrationPatch(t *testing.T) {
+	t.Run("merges the legacy managers into the field manager", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetResourceVersion("42")
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			managedFieldsEntry("default-dsci", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+			managedFieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:bar":{}}}`),
+			managedFieldsEntry("manager", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:baz":{}}}`),
+		})
+
+		patch, err := managedFieldsMigrationPatch(&obj, sets.New("manager", "default-dsci"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(patch).ShouldNot(BeNil())
+
+		ops := []struct {
+			Op    string `json:"op"`
+			Path  string `json:"path"`
+			Value any    `json:"value"`
+		}{}
+		g.Expect(json.Unmarshal(patch, &ops)).Should(Succeed())
+		g.Expect(ops).Should(HaveLen(2))
+		g.Expect(ops[1].Path).Should(Equal("/metadata/resourceVersion"))
+		g.Expect(ops[1].Value).Should(Equal("42"))
+
+		entries := []metav1.ManagedFieldsEntry{}
+		data, err := json.Marshal(ops[0].Value)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(json.Unmarshal(data, &entries)).Should(Succeed())
+
+		g.Expect(entries).Should(HaveLen(2))
+		g.Expect(entries).Should(ContainElement(And(
+			HaveField("Manager", FieldManager),
+			HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			HaveField("FieldsV1.Raw", MatchJSON(`{"f:data":{"f:foo":{},"f:baz":{}}}`)),
+		)))
+		g.Expect(entries).Should(ContainElement(And(
+			HaveField("Manager", "kubectl-edit"),
+			HaveField("Operation", metav1.ManagedFieldsOperationUpdate),
+		)))
+	})
+
+	t.Run("does nothing without legacy managers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj := unstructured.Unstructured{}
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			managedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+			managedFieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:bar":{}}}`),
+		})
+
+		patch, err := managedFieldsMigrationPatch(&obj, sets.New("manager", "default-dsci"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(patch).Should(BeNil())
+	})
+}
+
+func TestConflictPolicyFor(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(conflictPolicyFor(schema.GroupKind{Kind: "Secret"})).Should(Equal(ConflictPolicyKeep))
+	g.Expect(conflictPolicyFor(schema.GroupKind{Kind: "PersistentVolumeClaim"})).Should(Equal(ConflictPolicyKeep))
+	g.Expect(conflictPolicyFor(schema.GroupKind{Group: "apps", Kind: "Deployment"})).Should(Equal(ConflictPolicyForce))
+	g.Expect(conflictPolicyFor(schema.GroupKind{Kind: "ConfigMap"})).Should(Equal(ConflictPolicyForce))
+}
@@ -19,7 +19,6 @@ package deploy
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
@@ -32,15 +31,12 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/kustomize/api/krusty"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/conversion"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
 )
@@ -146,7 +142,7 @@ func manageResource(ctx context.Context, cli client.Client, res *resource.Resour
 	if err == nil {
 		// when resource is found
 		if enabled {
-			return updateResource(ctx, cli, res, found, owner)
+			return applyResource(ctx, cli, res, found, owner)
 		}
 		// Delete resource if it exists or do nothing if not found
 		return handleDisabledComponent(ctx, cli, found, componentName)
@@ -158,7 +154,7 @@ func manageResource(ctx context.Context, cli client.Client, res *resource.Resour
 
 	// Create resource when component enabled
 	if enabled {
-		return createResource(ctx, cli, res, owner)
+		return applyResource(ctx, cli, res, nil, owner)
 	}
 	// Skip if resource doesn't exist and component is disabled
 	return nil
@@ -208,51 +204,6 @@ func deleteResource(ctx context.Context, cli client.Client, found *unstructured.
 	return nil
 }
 
-func createResource(ctx context.Context, cli client.Client, res *resource.Resource, owner metav1.Object) error {
-	obj, err := conversion.ResourceToUnstructured(res)
-	if err != nil {
-		return err
-	}
-
-	if err := ctrl.SetControllerReference(owner, metav1.Object(obj), cli.Scheme()); err != nil {
-		return err
-	}
-
-	return cli.Create(ctx, obj)
-}
-
-func updateResource(ctx context.Context, cli client.Client, res *resource.Resource, found *unstructured.Unstructured, owner metav1.Object) error {
-	// Operator reconcile allowedListfield only when resource is managed by operator(annotation is true)
-	// all other cases: no annotation at all, required annotation not present, of annotation is non-true value, skip reconcile
-	if managed := found.GetAnnotations()[annotations.ManagedByODHOperator]; managed != "true" {
-		if err := skipUpdateOnAllowlistedFields(res); err != nil {
-			return err
-		}
-	}
-
-	obj, err := conversion.ResourceToUnstructured(res)
-	if err != nil {
-		return err
-	}
-
-	// Retain existing labels on update
-	updateLabels(found, obj)
-
-	return performPatch(ctx, cli, obj, found, owner)
-}
-
-// skipUpdateOnAllowlistedFields applies RemoverPlugin to the component's resources
-// This ensures that we do not overwrite the fields when Patch is applied later to the resource.
-func skipUpdateOnAllowlistedFields(res *resource.Resource) error {
-	for _, rmPlugin := range plugins.AllowListedFields {
-		if err := rmPlugin.TransformResource(res); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 func updateLabels(found, obj *unstructured.Unstructured) {
 	foundLabels := make(map[string]string)
 	for k, v := range found.GetLabels() {
@@ -265,14 +216,4 @@ func updateLabels(found, obj *unstructured.Unstructured) {
 	obj.SetLabels(foundLabels)
 }
 
-// preformPatch works for update cases.
-func performPatch(ctx context.Context, cli client.Client, obj, found *unstructured.Unstructured, owner metav1.Object) error {
-	data, err := json.Marshal(obj)
-	if err != nil {
-		return err
-	}
-	// force owner to be default-dsc/default-dsci
-	return cli.Patch(ctx, found, client.RawPatch(types.ApplyPatchType, data), client.ForceOwnership, client.FieldOwner(owner.GetName()))
-}
-
 // TODO : Add function to cleanup code created as part of pre install and post install task of a component
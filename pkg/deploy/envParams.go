@@ -8,6 +8,37 @@ import (
 	"strings"
 )
 
+// RelatedImagePrefix prefixes the environment variables holding the images of the components.
+const RelatedImagePrefix = "RELATED_IMAGE_"
+
+// RelatedImageVariants returns the variants of the images of the components declared in the given
+// environment, as returned by os.Environ. The variant of the image of a RELATED_IMAGE_ environment
+// variable is declared by the same variable with the given suffix, e.g.
+// RELATED_IMAGE_ODH_DASHBOARD_IMAGE_FIPS for the suffix _FIPS. The result maps each image to its
+// variant, the empty variables are ignored.
+func RelatedImageVariants(environ []string, suffix string) map[string]string {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if k, v, ok := strings.Cut(kv, "="); ok && strings.HasPrefix(k, RelatedImagePrefix) && v != "" {
+			env[k] = v
+		}
+	}
+
+	result := make(map[string]string)
+	for k, v := range env {
+		base, ok := strings.CutSuffix(k, suffix)
+		if !ok {
+			continue
+		}
+
+		if image := env[base]; image != "" {
+			result[image] = v
+		}
+	}
+
+	return result
+}
+
 func parseParams(fileName string) (map[string]string, error) {
 	paramsEnv, err := os.Open(fileName)
 	if err != nil {
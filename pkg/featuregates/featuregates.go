@@ -0,0 +1,148 @@
+// Package featuregates provides the feature gates the components consult before rendering
+// optional capabilities, set in the featureGates field of the DSCInitialization.
+package featuregates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// Stage is the maturity of a feature, which sets whether it is enabled by default.
+type Stage string
+
+const (
+	// Alpha features are disabled unless their gate enables them.
+	Alpha Stage = "Alpha"
+	// Beta features are enabled unless their gate disables them.
+	Beta Stage = "Beta"
+	// GA features are always enabled, their gate cannot disable them.
+	GA Stage = "GA"
+)
+
+// Feature is an optional capability of a component guarded by a feature gate.
+type Feature struct {
+	Name  string
+	Stage Stage
+}
+
+// ConditionType returns the type of the condition reporting whether the feature is enabled.
+func (f Feature) ConditionType() string {
+	return f.Name + "Enabled"
+}
+
+var (
+	// ModelRegistryIstio is the Istio integration of the model registry instances.
+	ModelRegistryIstio = Feature{Name: "ModelRegistryIstio", Stage: Beta}
+	// KserveRawDeployment is the RawDeployment mode of KServe.
+	KserveRawDeployment = Feature{Name: "KserveRawDeployment", Stage: GA}
+	// HardwareProfileMigration is the migration of the dashboard hardware profiles to the
+	// infrastructure hardware profiles.
+	HardwareProfileMigration = Feature{Name: "HardwareProfileMigration", Stage: Beta}
+)
+
+// Features are the features known by the operator.
+var Features = []Feature{
+	HardwareProfileMigration,
+	KserveRawDeployment,
+	ModelRegistryIstio,
+}
+
+// Gates are the feature gates set in the DSCInitialization, keyed by feature name.
+type Gates map[string]bool
+
+// Get returns the feature gates set in the DSCInitialization, none when it is not found.
+func Get(ctx context.Context, cli client.Client) (Gates, error) {
+	dsci, err := cluster.GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return Gates{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	}
+
+	return dsci.Spec.FeatureGates, nil
+}
+
+// Enabled returns whether the feature is enabled by its gate or, when the gate is not set, by
+// default according to its stage.
+func (g Gates) Enabled(f Feature) bool {
+	if f.Stage == GA {
+		return true
+	}
+
+	if enabled, ok := g[f.Name]; ok {
+		return enabled
+	}
+
+	return f.Stage != Alpha
+}
+
+// Validate returns an error listing the gates of unknown features and the gates disabling GA
+// features, which are ignored.
+func (g Gates) Validate() error {
+	var errs []error
+
+	for name, enabled := range g {
+		i := slices.IndexFunc(Features, func(f Feature) bool { return f.Name == name })
+
+		switch {
+		case i == -1:
+			errs = append(errs, fmt.Errorf("unknown feature gate %q", name))
+		case Features[i].Stage == GA && !enabled:
+			errs = append(errs, fmt.Errorf("feature %q is GA and cannot be disabled", name))
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errors.Join(errs...)
+}
+
+// Mark reports whether the feature is enabled with the condition of the feature, a disabled
+// feature does not affect the readiness of the component.
+func Mark(rr *odhtypes.ReconciliationRequest, f Feature, enabled bool) {
+	if enabled {
+		rr.Conditions.MarkTrue(
+			f.ConditionType(),
+			conditions.WithReason(status.FeatureGateEnabledReason),
+			conditions.WithMessage(status.FeatureGateEnabledMessage, f.Stage, f.Name),
+		)
+
+		return
+	}
+
+	rr.Conditions.MarkFalse(
+		f.ConditionType(),
+		conditions.WithReason(status.FeatureGateDisabledReason),
+		conditions.WithMessage(status.FeatureGateDisabledMessage, f.Stage, f.Name, f.Name),
+		conditions.WithSeverity(common.ConditionSeverityInfo),
+	)
+}
+
+// Consult returns whether the feature is enabled by the feature gates set in the DSCInitialization
+// and reports it with the condition of the feature.
+func Consult(ctx context.Context, rr *odhtypes.ReconciliationRequest, f Feature) (bool, error) {
+	gates, err := Get(ctx, rr.Client)
+	if err != nil {
+		return false, err
+	}
+
+	enabled := gates.Enabled(f)
+	Mark(rr, f, enabled)
+
+	return enabled, nil
+}
@@ -0,0 +1,55 @@
+package featuregates_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/featuregates"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEnabled(t *testing.T) {
+	alpha := featuregates.Feature{Name: "AlphaFeature", Stage: featuregates.Alpha}
+	beta := featuregates.Feature{Name: "BetaFeature", Stage: featuregates.Beta}
+	ga := featuregates.Feature{Name: "GAFeature", Stage: featuregates.GA}
+
+	tests := []struct {
+		name    string
+		gates   featuregates.Gates
+		feature featuregates.Feature
+		enabled bool
+	}{
+		{name: "alpha disabled by default", feature: alpha, enabled: false},
+		{name: "alpha enabled by its gate", gates: featuregates.Gates{"AlphaFeature": true}, feature: alpha, enabled: true},
+		{name: "beta enabled by default", feature: beta, enabled: true},
+		{name: "beta disabled by its gate", gates: featuregates.Gates{"BetaFeature": false}, feature: beta, enabled: false},
+		{name: "GA enabled by default", feature: ga, enabled: true},
+		{name: "GA not disabled by its gate", gates: featuregates.Gates{"GAFeature": false}, feature: ga, enabled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.gates.Enabled(tt.feature)).Should(Equal(tt.enabled))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(featuregates.Gates{}.Validate()).Should(Succeed())
+	g.Expect(featuregates.Gates{
+		featuregates.ModelRegistryIstio.Name:  false,
+		featuregates.KserveRawDeployment.Name: true,
+	}.Validate()).Should(Succeed())
+
+	err := featuregates.Gates{
+		"Unknown":                             true,
+		featuregates.KserveRawDeployment.Name: false,
+	}.Validate()
+	g.Expect(err).Should(MatchError(And(
+		ContainSubstring(`unknown feature gate "Unknown"`),
+		ContainSubstring(`feature "KserveRawDeployment" is GA and cannot be disabled`),
+	)))
+}
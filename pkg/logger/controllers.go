@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// controllerKey is the key of the controller name set by controller-runtime in the loggers of
+// the reconcilers.
+const controllerKey = "controller"
+
+var (
+	controllerLevelsMu sync.RWMutex
+	// controllerLevels are the log levels of the controllers, taking precedence over the log
+	// level of the operator for the loggers of these controllers.
+	controllerLevels map[string]zapcore.Level
+)
+
+// SetControllerLevels sets the log levels of the given controllers, keyed by controller name,
+// e.g. kserve or datasciencecluster, replacing the levels previously set. The other controllers
+// log at the level of the operator.
+func SetControllerLevels(levels map[string]string) error {
+	parsed := make(map[string]zapcore.Level, len(levels))
+
+	for name, levelStr := range levels {
+		levelNum, err := stringToLevel(levelStr)
+		if err != nil {
+			return fmt.Errorf("controller %s: %w", name, err)
+		}
+
+		parsed[strings.ToLower(name)] = levelNum
+	}
+
+	controllerLevelsMu.Lock()
+	defer controllerLevelsMu.Unlock()
+
+	controllerLevels = parsed
+
+	return nil
+}
+
+func getControllerLevel(name string) (zapcore.Level, bool) {
+	if name == "" {
+		return 0, false
+	}
+
+	controllerLevelsMu.RLock()
+	defer controllerLevelsMu.RUnlock()
+
+	level, ok := controllerLevels[name]
+
+	return level, ok
+}
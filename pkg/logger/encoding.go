@@ -0,0 +1,139 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	ctrlzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	EncodingJSON    = "json"
+	EncodingConsole = "console"
+)
+
+var (
+	encodingMu sync.Mutex
+	// baseOptions are the options the logger has been created with, used to create the core of
+	// another encoding.
+	baseOptions *ctrlzap.Options
+	baseCore    zapcore.Core
+	currentCore atomic.Pointer[zapcore.Core]
+)
+
+// swappableCore delegates to the current core, so the encoding of the logs can be changed
+// without replacing the loggers already handed out.
+type swappableCore struct {
+	fields []zapcore.Field
+	// controller is the name of the controller the logger has been created for, if any.
+	controller string
+}
+
+func (c *swappableCore) core() zapcore.Core {
+	return *currentCore.Load()
+}
+
+func (c *swappableCore) Enabled(level zapcore.Level) bool {
+	if controllerLevel, ok := getControllerLevel(c.controller); ok {
+		return controllerLevel.Enabled(level)
+	}
+
+	return c.core().Enabled(level)
+}
+
+func (c *swappableCore) With(fields []zapcore.Field) zapcore.Core {
+	controller := c.controller
+	for _, f := range fields {
+		if f.Key == controllerKey && f.Type == zapcore.StringType {
+			controller = f.String
+		}
+	}
+
+	return &swappableCore{
+		fields:     append(slices.Clip(c.fields), fields...),
+		controller: controller,
+	}
+}
+
+func (c *swappableCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *swappableCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.core().With(c.fields).Write(entry, fields)
+}
+
+func (c *swappableCore) Sync() error {
+	return c.core().Sync()
+}
+
+// wrapCore returns the zap option installing the swappable core, the options are stored
+// so the core can be recreated with another encoder by SetEncoding.
+func wrapCore(opts *ctrlzap.Options) zap.Option {
+	stored := *opts
+	stored.ZapOpts = slices.Clone(opts.ZapOpts)
+
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		encodingMu.Lock()
+		defer encodingMu.Unlock()
+
+		baseOptions = &stored
+		baseCore = core
+		currentCore.Store(&core)
+
+		return &swappableCore{}
+	})
+}
+
+// SetEncoding changes the encoding of the logs, json or console. An empty encoding restores
+// the encoding the logger has been created with.
+func SetEncoding(encoding string) error {
+	encodingMu.Lock()
+	defer encodingMu.Unlock()
+
+	if encoding == "" {
+		if baseOptions != nil {
+			currentCore.Store(&baseCore)
+		}
+		return nil
+	}
+
+	if baseOptions == nil {
+		return errors.New("logger has not been created with NewLogger")
+	}
+
+	var encoder ctrlzap.Opts
+
+	switch encoding {
+	case EncodingJSON:
+		encoder = ctrlzap.JSONEncoder(baseOptions.EncoderConfigOptions...)
+	case EncodingConsole:
+		encoder = ctrlzap.ConsoleEncoder(baseOptions.EncoderConfigOptions...)
+	default:
+		return fmt.Errorf("invalid log encoding \"%s\"", encoding)
+	}
+
+	opts := *baseOptions
+
+	var core zapcore.Core
+
+	opts.ZapOpts = append(slices.Clone(baseOptions.ZapOpts), zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		core = c
+		return c
+	}))
+
+	_ = ctrlzap.NewRaw(ctrlzap.UseFlagOptions(&opts), encoder)
+
+	currentCore.Store(&core)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
@@ -17,6 +18,14 @@ import (
 
 var currentLogLevel atomic.Value
 
+var (
+	levelMu sync.Mutex
+	// levelOverride is set by SetLevelOverride, it takes precedence over the level set by SetLevel.
+	levelOverride bool
+	// requestedLevel is the last level set by SetLevel, restored when the override is removed.
+	requestedLevel zapcore.Level
+)
+
 // copy from controller-runtime/pkg/log/zap/flag.go.
 var levelStrings = map[string]zapcore.Level{
 	"debug": zap.DebugLevel,
@@ -51,6 +60,41 @@ func SetLevel(levelStr string) error {
 		return err
 	}
 
+	levelMu.Lock()
+	defer levelMu.Unlock()
+
+	requestedLevel = levelNum
+	if levelOverride {
+		return nil
+	}
+
+	return setLevel(levelNum)
+}
+
+// SetLevelOverride sets a log level taking precedence over the one set by SetLevel, e.g. from the
+// DSCInitialization devFlags. An empty level removes the override and restores the level set by SetLevel.
+func SetLevelOverride(levelStr string) error {
+	levelMu.Lock()
+	defer levelMu.Unlock()
+
+	if levelStr == "" {
+		if !levelOverride {
+			return nil
+		}
+		levelOverride = false
+		return setLevel(requestedLevel)
+	}
+
+	levelNum, err := stringToLevel(levelStr)
+	if err != nil {
+		return err
+	}
+
+	levelOverride = true
+	return setLevel(levelNum)
+}
+
+func setLevel(levelNum zapcore.Level) error {
 	// We must be sure that ctrlzap.Options.Level is not nil when addDefaults() is called
 	// because otherwise ctrlzap.addDefaults() will set the log level to an AtomicLevel we don't have a reference to
 	level, ok := currentLogLevel.Load().(zap.AtomicLevel)
@@ -66,6 +110,12 @@ func NewLogger(mode string, override *ctrlzap.Options) logr.Logger {
 	opts := newBaseOptionsFromMode(mode)
 	overrideOptions(opts, override)
 	currentLogLevel.Store(opts.Level)
+	if level, ok := opts.Level.(zap.AtomicLevel); ok {
+		levelMu.Lock()
+		requestedLevel = level.Level()
+		levelMu.Unlock()
+	}
+	opts.ZapOpts = append(opts.ZapOpts, wrapCore(opts))
 	return ctrlzap.New(ctrlzap.UseFlagOptions(opts))
 }
 
@@ -0,0 +1,94 @@
+package logger_test
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/zap"
+	ctrlzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSetLevelOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+	logger.NewLogger("", &ctrlzap.Options{Level: level, DestWriter: &bytes.Buffer{}})
+
+	g.Expect(logger.SetLevelOverride("debug")).Should(Succeed())
+	g.Expect(level.Level()).Should(Equal(zap.DebugLevel))
+
+	// the override takes precedence
+	g.Expect(logger.SetLevel("error")).Should(Succeed())
+	g.Expect(level.Level()).Should(Equal(zap.DebugLevel))
+
+	// removing the override restores the last requested level
+	g.Expect(logger.SetLevelOverride("")).Should(Succeed())
+	g.Expect(level.Level()).Should(Equal(zap.ErrorLevel))
+
+	g.Expect(logger.SetLevelOverride("invalid")).ShouldNot(Succeed())
+}
+
+func TestSetEncoding(t *testing.T) {
+	g := NewWithT(t)
+
+	out := bytes.Buffer{}
+	log := logger.NewLogger("", &ctrlzap.Options{Level: zap.NewAtomicLevelAt(zap.InfoLevel), DestWriter: &out})
+	log = log.WithValues("key", "value")
+
+	log.Info("json")
+	g.Expect(out.String()).Should(HavePrefix("{"))
+	g.Expect(out.String()).Should(ContainSubstring(`"key":"value"`))
+
+	out.Reset()
+	g.Expect(logger.SetEncoding(logger.EncodingConsole)).Should(Succeed())
+	log.Info("console")
+	g.Expect(out.String()).ShouldNot(HavePrefix("{"))
+	g.Expect(out.String()).Should(ContainSubstring(`"key": "value"`))
+
+	out.Reset()
+	g.Expect(logger.SetEncoding("")).Should(Succeed())
+	log.Info("json")
+	g.Expect(out.String()).Should(HavePrefix("{"))
+
+	g.Expect(logger.SetEncoding("xml")).ShouldNot(Succeed())
+}
+
+func TestSetControllerLevels(t *testing.T) {
+	g := NewWithT(t)
+
+	out := bytes.Buffer{}
+	log := logger.NewLogger("", &ctrlzap.Options{Level: zap.NewAtomicLevelAt(zap.InfoLevel), DestWriter: &out})
+
+	kserve := log.WithValues("controller", "kserve")
+	dashboard := log.WithValues("controller", "dashboard")
+
+	g.Expect(logger.SetControllerLevels(map[string]string{"kserve": "debug"})).Should(Succeed())
+	t.Cleanup(func() {
+		_ = logger.SetControllerLevels(nil)
+	})
+
+	kserve.V(1).Info("kserve debug")
+	dashboard.V(1).Info("dashboard debug")
+	log.V(1).Info("operator debug")
+
+	g.Expect(out.String()).Should(ContainSubstring("kserve debug"))
+	g.Expect(out.String()).ShouldNot(ContainSubstring("dashboard debug"))
+	g.Expect(out.String()).ShouldNot(ContainSubstring("operator debug"))
+
+	// the loggers derived from the controller logger keep its level
+	out.Reset()
+	kserve.WithValues("reconcileID", "id").V(1).Info("derived debug")
+	g.Expect(out.String()).Should(ContainSubstring("derived debug"))
+
+	// removing the level restores the level of the operator
+	out.Reset()
+	g.Expect(logger.SetControllerLevels(nil)).Should(Succeed())
+	kserve.V(1).Info("kserve debug")
+	g.Expect(out.String()).Should(BeEmpty())
+
+	g.Expect(logger.SetControllerLevels(map[string]string{"kserve": "invalid"})).ShouldNot(Succeed())
+}
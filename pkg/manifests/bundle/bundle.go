@@ -0,0 +1,354 @@
+// Package bundle loads the component manifests from a bundle provided to the operator, instead
+// of the ones baked in the operator image, to support disconnected installations.
+//
+// A bundle is a gzip compressed tar archive of the manifests directory, provided either as a file
+// on a volume mounted in the operator pod (e.g. a PVC or a ConfigMap) or as the single layer of
+// an OCI artifact mirrored into a registry reachable from the cluster. The bundle is verified
+// against the expected checksum, and against its cosign signature when a public key is configured,
+// before being extracted to the manifests directory. The transient
+// failures of the OCI pulls are retried, and the pulled bundles can be kept in a cache directory
+// to start the operator while the registry is unreachable.
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type SourceType string
+
+const (
+	// SourceBuiltin uses the manifests baked in the operator image.
+	SourceBuiltin SourceType = ""
+	// SourceVolume loads the bundle from a file of a volume mounted in the operator pod.
+	SourceVolume SourceType = "volume"
+	// SourceOCI loads the bundle from an OCI artifact.
+	SourceOCI SourceType = "oci"
+
+	checksumPrefix = "sha256:"
+
+	// maxBundleSize bounds the size of the bundle archive, and of its extracted content.
+	maxBundleSize = 512 << 20
+)
+
+type Config struct {
+	Source SourceType
+	// Path of the bundle archive, for the volume source.
+	Path string
+	// Reference of the OCI artifact, for the oci source, e.g. registry.local/odh/manifests:v2.30.
+	Image string
+	// Path of a docker config json file holding the credentials of the registry, for the oci source.
+	PullSecretPath string
+	// Expected checksum of the bundle archive, as sha256:<hex>.
+	Checksum string
+	// Path of a PEM file holding the CA certificates the registry is trusted with, in addition to
+	// the ones of the system, for the oci source.
+	CAFile string
+	// Directory the pulled bundles are kept in, for the oci source. A bundle kept there is loaded
+	// instead of being pulled again.
+	CacheDir string
+	// Path of a PEM file holding the public key the bundle archive is signed with, e.g. cosign.pub.
+	// When set, the bundle is only loaded along with a valid signature. Only the signatures made
+	// with a key pair are supported: keyless signatures, certificates and transparency log entries
+	// are not verified.
+	PublicKeyPath string
+	// Path of the signature of the bundle archive, as produced by cosign sign-blob --key.
+	SignaturePath string
+}
+
+// VerificationError reports a bundle whose checksum or signature does not match, i.e. a bundle that
+// has been tampered with or was not published by the owner of the signing key.
+type VerificationError struct {
+	err error
+}
+
+func (e *VerificationError) Error() string {
+	return e.err.Error()
+}
+
+func (e *VerificationError) Unwrap() error {
+	return e.err
+}
+
+type Loader struct {
+	cfg     Config
+	client  *http.Client
+	backoff wait.Backoff
+}
+
+type LoaderOpts func(*Loader)
+
+func WithHTTPClient(value *http.Client) LoaderOpts {
+	return func(l *Loader) {
+		l.client = value
+	}
+}
+
+// WithBackoff sets the backoff the transient failures of the pulls are retried with.
+func WithBackoff(value wait.Backoff) LoaderOpts {
+	return func(l *Loader) {
+		l.backoff = value
+	}
+}
+
+func NewLoader(cfg Config, opts ...LoaderOpts) *Loader {
+	l := Loader{
+		cfg:    cfg,
+		client: http.DefaultClient,
+		backoff: wait.Backoff{
+			Duration: 2 * time.Second,
+			Factor:   2.0,
+			Jitter:   0.1,
+			Steps:    5,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&l)
+	}
+
+	return &l
+}
+
+// Load fetches the bundle, verifies its checksum and signature and replaces the content of the
+// destination directory with the extracted manifests, which is left untouched when the bundle is
+// rejected with a VerificationError. Nothing is done for the builtin source.
+func (l *Loader) Load(ctx context.Context, dest string) error {
+	if l.cfg.Source == SourceBuiltin {
+		return nil
+	}
+
+	if !strings.HasPrefix(l.cfg.Checksum, checksumPrefix) {
+		return fmt.Errorf("invalid manifests bundle checksum %q, expected %s<hex>", l.cfg.Checksum, checksumPrefix)
+	}
+
+	var data []byte
+	var err error
+
+	switch l.cfg.Source {
+	case SourceVolume:
+		data, err = l.readVolume()
+	case SourceOCI:
+		data, err = l.pullOCICached(ctx)
+	default:
+		return fmt.Errorf("unsupported manifests source %q", l.cfg.Source)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to fetch manifests bundle from %s source: %w", l.cfg.Source, err)
+	}
+
+	if err := verify(data, l.cfg.Checksum); err != nil {
+		return err
+	}
+
+	if err := l.verifySignature(data); err != nil {
+		return err
+	}
+
+	return extract(data, dest)
+}
+
+// verifySignature verifies the signature of the bundle archive produced by cosign sign-blob --key,
+// i.e. the base64 encoded signature of the archive with the private key matching the configured
+// public key. Nothing is verified without a public key. This is not a sigstore verifier: the
+// bundles signed without a key, with a certificate or recorded in a transparency log, are only
+// verified as such keyed blob signatures.
+func (l *Loader) verifySignature(data []byte) error {
+	if l.cfg.PublicKeyPath == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(l.cfg.PublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read manifests bundle public key: %w", err)
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return fmt.Errorf("no PEM public key found in %s", l.cfg.PublicKeyPath)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("invalid manifests bundle public key: %w", err)
+	}
+
+	if l.cfg.SignaturePath == "" {
+		return &VerificationError{errors.New("manifests bundle signature missing")}
+	}
+
+	encoded, err := os.ReadFile(l.cfg.SignaturePath)
+	if err != nil {
+		return &VerificationError{fmt.Errorf("failed to read manifests bundle signature: %w", err)}
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return &VerificationError{fmt.Errorf("invalid manifests bundle signature: %w", err)}
+	}
+
+	sum := sha256.Sum256(data)
+	valid := false
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, sum[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, signature)
+	default:
+		return fmt.Errorf("unsupported manifests bundle public key type %T", key)
+	}
+
+	if !valid {
+		return &VerificationError{errors.New("manifests bundle signature mismatch")}
+	}
+
+	return nil
+}
+
+func (l *Loader) readVolume() ([]byte, error) {
+	if l.cfg.Path == "" {
+		return nil, errors.New("no bundle path configured")
+	}
+
+	f, err := os.Open(l.cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return readBounded(f)
+}
+
+func readBounded(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxBundleSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxBundleSize {
+		return nil, fmt.Errorf("bundle larger than %d bytes", maxBundleSize)
+	}
+
+	return data, nil
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
+
+func verify(data []byte, checksum string) error {
+	if actual := digest(data); !strings.EqualFold(actual, checksum) {
+		return &VerificationError{fmt.Errorf("manifests bundle checksum mismatch: expected %s, got %s", checksum, actual)}
+	}
+
+	return nil
+}
+
+// extract replaces the content of dest with the content of the archive. Only directories and
+// regular files are allowed, to prevent the archive from writing outside of dest.
+func extract(data []byte, dest string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid manifests bundle: %w", err)
+	}
+
+	defer gz.Close()
+
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dest)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err := os.RemoveAll(filepath.Join(dest, e.Name())); err != nil {
+			return fmt.Errorf("failed to clean manifests directory: %w", err)
+		}
+	}
+
+	tr := tar.NewReader(gz)
+	size := int64(0)
+
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid manifests bundle: %w", err)
+		}
+
+		name := filepath.Clean(h.Name)
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid manifests bundle: entry %s is outside of the bundle", h.Name)
+		}
+
+		target := filepath.Join(dest, name)
+
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			size += h.Size
+			if size > maxBundleSize {
+				return fmt.Errorf("invalid manifests bundle: content larger than %d bytes", maxBundleSize)
+			}
+
+			if err := writeFile(target, tr); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid manifests bundle: entry %s is not a file nor a directory", h.Name)
+		}
+	}
+}
+
+func writeFile(target string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,194 @@
+package bundle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/util/retry"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+)
+
+// retriable returns true if the given error of a pull is transient: the registry could not be
+// reached, is rate limiting the pulls or failed to serve them.
+func retriable(err error) bool {
+	if se := (*oci.StatusError)(nil); errors.As(err, &se) {
+		return se.Code == http.StatusTooManyRequests || se.Code >= http.StatusInternalServerError
+	}
+
+	if ue := (*url.Error)(nil); errors.As(err, &ue) {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	return false
+}
+
+// pullOCICached returns the bundle kept in the cache directory when it matches the checksum, and
+// otherwise pulls it, retrying the transient failures, then keeps it in the cache directory. As
+// the checksum pins the content of the bundle, the cached bundle is loaded without contacting the
+// registry, e.g. when the operator restarts while the registry is unreachable.
+func (l *Loader) pullOCICached(ctx context.Context) ([]byte, error) {
+	log := logf.FromContext(ctx)
+
+	cached := ""
+	if l.cfg.CacheDir != "" {
+		cached = filepath.Join(l.cfg.CacheDir, strings.TrimPrefix(strings.ToLower(l.cfg.Checksum), checksumPrefix)+".tar.gz")
+
+		if data, err := os.ReadFile(cached); err == nil && verify(data, l.cfg.Checksum) == nil {
+			log.Info("loading cached manifests bundle", "path", cached)
+			return data, nil
+		}
+	}
+
+	client, err := l.httpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+
+	err = retry.OnError(l.backoff, retriable, func() error {
+		var err error
+
+		data, err = l.pullOCI(ctx, client)
+		if err != nil && retriable(err) {
+			log.Info("failed to pull manifests bundle, retrying", "image", l.cfg.Image, "error", err.Error())
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != "" && verify(data, l.cfg.Checksum) == nil {
+		if err := writeCache(cached, data); err != nil {
+			log.Error(err, "unable to cache manifests bundle", "path", cached)
+		}
+	}
+
+	return data, nil
+}
+
+// httpClient returns the client of the registry, trusting the CA certificates of the configured
+// CA file in addition to the ones of the system. The proxy is set by the environment.
+func (l *Loader) httpClient() (*http.Client, error) {
+	if l.cfg.CAFile == "" {
+		return l.client, nil
+	}
+
+	pem, err := os.ReadFile(l.cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificate found in %s", l.cfg.CAFile)
+	}
+
+	transport, ok := l.client.Transport.(*http.Transport)
+	if !ok || transport == nil {
+		transport, _ = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport, Timeout: l.client.Timeout}, nil
+}
+
+// writeCache atomically writes the given bundle to the given cache path.
+func writeCache(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".bundle-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// pullOCI fetches the single layer of the artifact, verifying it against the digest recorded in
+// the artifact manifest.
+func (l *Loader) pullOCI(ctx context.Context, client *http.Client) ([]byte, error) {
+	ref, err := oci.ParseArtifact(l.cfg.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := l.credentials(ref.Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	c := oci.Client{HTTPClient: client, Credentials: creds}
+
+	manifest, err := c.FetchManifest(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest of %s: %w", l.cfg.Image, err)
+	}
+
+	if len(manifest.Layers) != 1 {
+		return nil, fmt.Errorf("artifact %s must have exactly one layer, found %d", l.cfg.Image, len(manifest.Layers))
+	}
+
+	layer := manifest.Layers[0]
+
+	blob, err := c.FetchBlob(ctx, ref, layer, maxBundleSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch layer %s of %s: %w", layer.Digest, l.cfg.Image, err)
+	}
+
+	return blob, nil
+}
+
+// credentials returns the credentials of the given registry in the configured docker config json
+// file, if any.
+func (l *Loader) credentials(registry string) (*oci.Credentials, error) {
+	if l.cfg.PullSecretPath == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(l.cfg.PullSecretPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry pull secret: %w", err)
+	}
+
+	creds, err := oci.CredentialsFromDockerConfig(data, registry)
+	switch {
+	case errors.Is(err, oci.ErrNoCredentials):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("invalid registry pull secret: %w", err)
+	}
+
+	return &creds, nil
+}
@@ -0,0 +1,387 @@
+package bundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/bundle"
+
+	. "github.com/onsi/gomega"
+)
+
+func newBundle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := bytes.Buffer{}
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("failed to write bundle header: %v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write bundle content: %v", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close bundle: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close bundle: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestLoadFromVolume(t *testing.T) {
+	ctx := t.Context()
+
+	data := newBundle(t, map[string]string{
+		"dashboard/odh/kustomization.yaml": "resources: []",
+		"workbenches/params.env":           "image=quay.io/odh/notebook",
+	})
+
+	src := filepath.Join(t.TempDir(), "manifests.tar.gz")
+	if err := os.WriteFile(src, data, 0o600); err != nil {
+		t.Fatalf("failed to write bundle: %v", err)
+	}
+
+	t.Run("replaces the manifests with the bundle content", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dest := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dest, "stale.yaml"), []byte("stale"), 0o600)).Should(Succeed())
+
+		loader := bundle.NewLoader(bundle.Config{Source: bundle.SourceVolume, Path: src, Checksum: checksum(data)})
+
+		g.Expect(loader.Load(ctx, dest)).Should(Succeed())
+		g.Expect(filepath.Join(dest, "stale.yaml")).ShouldNot(BeAnExistingFile())
+		g.Expect(os.ReadFile(filepath.Join(dest, "workbenches", "params.env"))).Should(BeEquivalentTo("image=quay.io/odh/notebook"))
+		g.Expect(filepath.Join(dest, "dashboard", "odh", "kustomization.yaml")).Should(BeAnExistingFile())
+	})
+
+	t.Run("rejects a bundle not matching the checksum", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dest := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dest, "kept.yaml"), []byte("kept"), 0o600)).Should(Succeed())
+
+		loader := bundle.NewLoader(bundle.Config{Source: bundle.SourceVolume, Path: src, Checksum: checksum([]byte("other"))})
+
+		err := loader.Load(ctx, dest)
+		g.Expect(err).Should(MatchError(ContainSubstring("checksum mismatch")))
+		g.Expect(err).Should(BeAssignableToTypeOf(&bundle.VerificationError{}))
+		g.Expect(filepath.Join(dest, "kept.yaml")).Should(BeAnExistingFile())
+	})
+
+	t.Run("rejects entries outside of the bundle", func(t *testing.T) {
+		g := NewWithT(t)
+
+		evil := newBundle(t, map[string]string{"../evil.yaml": "evil"})
+		evilSrc := filepath.Join(t.TempDir(), "evil.tar.gz")
+		g.Expect(os.WriteFile(evilSrc, evil, 0o600)).Should(Succeed())
+
+		dest := filepath.Join(t.TempDir(), "manifests")
+		loader := bundle.NewLoader(bundle.Config{Source: bundle.SourceVolume, Path: evilSrc, Checksum: checksum(evil)})
+
+		g.Expect(loader.Load(ctx, dest)).Should(MatchError(ContainSubstring("outside of the bundle")))
+		g.Expect(filepath.Join(filepath.Dir(dest), "evil.yaml")).ShouldNot(BeAnExistingFile())
+	})
+
+	t.Run("requires a checksum", func(t *testing.T) {
+		g := NewWithT(t)
+
+		loader := bundle.NewLoader(bundle.Config{Source: bundle.SourceVolume, Path: src})
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(MatchError(ContainSubstring("invalid manifests bundle checksum")))
+	})
+}
+
+func TestLoadFromOCI(t *testing.T) {
+	ctx := t.Context()
+
+	data := newBundle(t, map[string]string{"ray/kustomization.yaml": "resources: []"})
+	layerDigest := checksum(data)
+
+	const token = "test-token"
+
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers": []map[string]any{{
+			"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip",
+			"digest":    layerDigest,
+			"size":      len(data),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal manifest: %v", err)
+	}
+
+	// number of requests failing with a transient error
+	failures := atomic.Int32{}
+
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/token":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "mirror" || password != "secret" || r.URL.Query().Get("scope") != "repository:odh/manifests:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+		case r.Header.Get("Authorization") != "Bearer "+token:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/odh/manifests/manifests/v1":
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = w.Write(manifest)
+		case r.URL.Path == "/v2/odh/manifests/blobs/"+layerDigest:
+			_, _ = w.Write(data)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(srv.Close)
+
+	registry := strings.TrimPrefix(srv.URL, "https://")
+
+	pullSecret := filepath.Join(t.TempDir(), "config.json")
+	auth := base64.StdEncoding.EncodeToString([]byte("mirror:secret"))
+	if err := os.WriteFile(pullSecret, []byte(`{"auths":{"`+registry+`":{"auth":"`+auth+`"}}}`), 0o600); err != nil {
+		t.Fatalf("failed to write pull secret: %v", err)
+	}
+
+	t.Run("pulls and extracts the artifact layer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dest := t.TempDir()
+		loader := bundle.NewLoader(
+			bundle.Config{
+				Source:         bundle.SourceOCI,
+				Image:          registry + "/odh/manifests:v1",
+				PullSecretPath: pullSecret,
+				Checksum:       layerDigest,
+			},
+			bundle.WithHTTPClient(srv.Client()),
+		)
+
+		g.Expect(loader.Load(ctx, dest)).Should(Succeed())
+		g.Expect(filepath.Join(dest, "ray", "kustomization.yaml")).Should(BeAnExistingFile())
+	})
+
+	t.Run("retries the transient failures", func(t *testing.T) {
+		g := NewWithT(t)
+
+		failures.Store(2)
+
+		loader := bundle.NewLoader(
+			bundle.Config{
+				Source:         bundle.SourceOCI,
+				Image:          registry + "/odh/manifests:v1",
+				PullSecretPath: pullSecret,
+				Checksum:       layerDigest,
+			},
+			bundle.WithHTTPClient(srv.Client()),
+			bundle.WithBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 3}),
+		)
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(Succeed())
+
+		failures.Store(3)
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(MatchError(ContainSubstring("503")))
+		failures.Store(0)
+	})
+
+	t.Run("loads the cached bundle while the registry is unreachable", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cacheDir := t.TempDir()
+
+		loader := bundle.NewLoader(
+			bundle.Config{
+				Source:         bundle.SourceOCI,
+				Image:          registry + "/odh/manifests:v1",
+				PullSecretPath: pullSecret,
+				Checksum:       layerDigest,
+				CacheDir:       cacheDir,
+			},
+			bundle.WithHTTPClient(srv.Client()),
+		)
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(Succeed())
+
+		unreachable := bundle.NewLoader(
+			bundle.Config{
+				Source:   bundle.SourceOCI,
+				Image:    "127.0.0.1:1/odh/manifests:v1",
+				Checksum: layerDigest,
+				CacheDir: cacheDir,
+			},
+			bundle.WithBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 1}),
+		)
+
+		dest := t.TempDir()
+		g.Expect(unreachable.Load(ctx, dest)).Should(Succeed())
+		g.Expect(filepath.Join(dest, "ray", "kustomization.yaml")).Should(BeAnExistingFile())
+	})
+
+	t.Run("trusts the configured CA", func(t *testing.T) {
+		g := NewWithT(t)
+
+		caFile := filepath.Join(t.TempDir(), "ca.crt")
+		g.Expect(os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0o600)).Should(Succeed())
+
+		loader := bundle.NewLoader(
+			bundle.Config{
+				Source:         bundle.SourceOCI,
+				Image:          registry + "/odh/manifests:v1",
+				PullSecretPath: pullSecret,
+				Checksum:       layerDigest,
+				CAFile:         caFile,
+			},
+			bundle.WithBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 1}),
+		)
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(Succeed())
+	})
+
+	t.Run("fails without registry credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		loader := bundle.NewLoader(
+			bundle.Config{
+				Source:   bundle.SourceOCI,
+				Image:    registry + "/odh/manifests:v1",
+				Checksum: layerDigest,
+			},
+			bundle.WithHTTPClient(srv.Client()),
+		)
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(MatchError(ContainSubstring("failed to get registry token")))
+	})
+
+	t.Run("rejects short references", func(t *testing.T) {
+		g := NewWithT(t)
+
+		loader := bundle.NewLoader(bundle.Config{Source: bundle.SourceOCI, Image: "odh/manifests:v1", Checksum: layerDigest})
+
+		g.Expect(loader.Load(ctx, t.TempDir())).Should(MatchError(ContainSubstring("invalid OCI reference")))
+	})
+}
+
+func TestLoadSigned(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+
+	data := newBundle(t, map[string]string{"ray/kustomization.yaml": "resources: []"})
+
+	src := filepath.Join(dir, "manifests.tar.gz")
+	if err := os.WriteFile(src, data, 0o600); err != nil {
+		t.Fatalf("failed to write bundle: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	publicKey := filepath.Join(dir, "cosign.pub")
+	if err := os.WriteFile(publicKey, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write public key: %v", err)
+	}
+
+	sign := func(content []byte) string {
+		sum := sha256.Sum256(content)
+
+		sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+		if err != nil {
+			t.Fatalf("failed to sign bundle: %v", err)
+		}
+
+		path := filepath.Join(t.TempDir(), "manifests.tar.gz.sig")
+		if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(sig)+"\n"), 0o600); err != nil {
+			t.Fatalf("failed to write signature: %v", err)
+		}
+
+		return path
+	}
+
+	newLoader := func(signature string) *bundle.Loader {
+		return bundle.NewLoader(bundle.Config{
+			Source:        bundle.SourceVolume,
+			Path:          src,
+			Checksum:      checksum(data),
+			PublicKeyPath: publicKey,
+			SignaturePath: signature,
+		})
+	}
+
+	t.Run("loads a bundle with a valid signature", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dest := t.TempDir()
+
+		g.Expect(newLoader(sign(data)).Load(ctx, dest)).Should(Succeed())
+		g.Expect(filepath.Join(dest, "ray", "kustomization.yaml")).Should(BeAnExistingFile())
+	})
+
+	t.Run("rejects a bundle signed by another key or without signature", func(t *testing.T) {
+		for name, signature := range map[string]string{
+			"other content": sign([]byte("other")),
+			"no signature":  "",
+		} {
+			t.Run(name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				dest := t.TempDir()
+				g.Expect(os.WriteFile(filepath.Join(dest, "kept.yaml"), []byte("kept"), 0o600)).Should(Succeed())
+
+				err := newLoader(signature).Load(ctx, dest)
+				g.Expect(err).Should(BeAssignableToTypeOf(&bundle.VerificationError{}))
+				g.Expect(filepath.Join(dest, "kept.yaml")).Should(BeAnExistingFile())
+			})
+		}
+	})
+}
@@ -0,0 +1,250 @@
+// Package export keeps the resources last deployed by the controllers of the operator, as
+// rendered, and lays them out as a kustomize bundle or a Helm chart, so they can be reviewed and
+// committed to a GitOps repository.
+package export
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const redactedValue = "<redacted>"
+
+// helmDelimiters escapes the template delimiters found in the manifests, e.g. in alerting rules,
+// so Helm renders them literally.
+var helmDelimiters = strings.NewReplacer("{{", "{{`{{`}}", "}}", "{{`}}`}}")
+
+// platformGroupVersions are the API versions of the resources whose controllers deploy the
+// exported resources.
+var platformGroupVersions = []schema.GroupVersion{
+	dscv2.GroupVersion,
+	dsciv2.GroupVersion,
+	componentApi.GroupVersion,
+	serviceApi.GroupVersion,
+}
+
+// Store holds the resources last deployed by each controller, by controller name.
+type Store struct {
+	mu        sync.RWMutex
+	resources map[string][]unstructured.Unstructured
+}
+
+func NewStore() *Store {
+	return &Store{
+		resources: make(map[string][]unstructured.Unstructured),
+	}
+}
+
+var defaultStore = NewStore()
+
+// DefaultStore returns the Store the deploy action records the deployed resources in.
+func DefaultStore() *Store {
+	return defaultStore
+}
+
+// Record replaces the resources of the given controller, which must not be modified afterwards.
+func (s *Store) Record(controller string, resources []unstructured.Unstructured) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources[controller] = resources
+}
+
+// Resources returns the resources of the controllers, by controller name.
+func (s *Store) Resources() map[string][]unstructured.Unstructured {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return maps.Clone(s.resources)
+}
+
+// Deployed returns the resources of the controllers whose platform resource exists, leaving out
+// those of the components and services removed since they were deployed.
+func (s *Store) Deployed(ctx context.Context, cli client.Reader, scheme *runtime.Scheme) (map[string][]unstructured.Unstructured, error) {
+	resources := s.Resources()
+
+	for controller := range resources {
+		kind, found := kindOf(scheme, controller)
+		if !found {
+			continue
+		}
+
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+
+		err := cli.List(ctx, &items, client.Limit(1))
+		switch {
+		case meta.IsNoMatchError(err):
+			delete(resources, controller)
+		case err != nil:
+			return nil, fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+		case len(items.Items) == 0:
+			delete(resources, controller)
+		}
+	}
+
+	return resources, nil
+}
+
+// kindOf returns the kind of the platform resources reconciled by the given controller, named
+// after the lower case kind.
+func kindOf(scheme *runtime.Scheme, controller string) (schema.GroupVersionKind, bool) {
+	for _, gv := range platformGroupVersions {
+		known := scheme.KnownTypes(gv)
+
+		for kind := range known {
+			if strings.ToLower(kind) != controller {
+				continue
+			}
+
+			if _, ok := known[kind+"List"]; ok {
+				return gv.WithKind(kind), true
+			}
+		}
+	}
+
+	return schema.GroupVersionKind{}, false
+}
+
+// ChartMetadata is the metadata of an exported Helm chart.
+type ChartMetadata struct {
+	Name       string
+	Version    string
+	AppVersion string
+}
+
+// Kustomize returns the files, by path, of a kustomize bundle holding the given resources, one
+// file per resource in a directory per controller.
+func Kustomize(resources map[string][]unstructured.Unstructured) (map[string]string, error) {
+	files, err := manifests(resources, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	names := slices.Sorted(maps.Keys(files))
+
+	kustomization := map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  names,
+	}
+
+	content, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+
+	files["kustomization.yaml"] = string(content)
+
+	return files, nil
+}
+
+// HelmChart returns the files, by path, of a Helm chart holding the given resources as templates,
+// one template per resource in a directory per controller. The chart has no values, its
+// templates render the resources as deployed.
+func HelmChart(resources map[string][]unstructured.Unstructured, meta ChartMetadata) (map[string]string, error) {
+	files, err := manifests(resources, path.Join(meta.Name, "templates"), true)
+	if err != nil {
+		return nil, err
+	}
+
+	chart := map[string]any{
+		"apiVersion":  "v2",
+		"name":        meta.Name,
+		"description": "Resources rendered by the Open Data Hub operator",
+		"type":        "application",
+		"version":     meta.Version,
+		"appVersion":  meta.AppVersion,
+	}
+
+	content, err := yaml.Marshal(chart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal chart metadata: %w", err)
+	}
+
+	files[path.Join(meta.Name, "Chart.yaml")] = string(content)
+
+	return files, nil
+}
+
+// manifests returns the YAML files of the given resources, by path under the given directory,
+// the values of the secrets redacted.
+func manifests(resources map[string][]unstructured.Unstructured, dir string, helm bool) (map[string]string, error) {
+	files := make(map[string]string)
+
+	for controller, items := range resources {
+		for i := range items {
+			u := items[i].DeepCopy()
+
+			if u.GroupVersionKind() == gvk.Secret {
+				if err := redact(u); err != nil {
+					return nil, err
+				}
+			}
+
+			content, err := yaml.Marshal(u.Object)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s %s: %w", u.GetKind(), u.GetName(), err)
+			}
+
+			manifest := string(content)
+			if helm {
+				manifest = helmDelimiters.Replace(manifest)
+			}
+
+			files[path.Join(dir, controller, fileName(u))] = manifest
+		}
+	}
+
+	return files, nil
+}
+
+// fileName returns the name of the file of the given resource, unique within a controller.
+func fileName(u *unstructured.Unstructured) string {
+	parts := []string{strings.ToLower(u.GetKind())}
+	if u.GetNamespace() != "" {
+		parts = append(parts, u.GetNamespace())
+	}
+
+	parts = append(parts, u.GetName())
+
+	return strings.Join(parts, "_") + ".yaml"
+}
+
+// redact replaces the values of the given Secret.
+func redact(u *unstructured.Unstructured) error {
+	for _, field := range []string{"data", "stringData"} {
+		values, found, err := unstructured.NestedMap(u.Object, field)
+		if err != nil || !found {
+			continue
+		}
+
+		for k := range values {
+			values[k] = redactedValue
+		}
+
+		if err := unstructured.SetNestedMap(u.Object, values, field); err != nil {
+			return fmt.Errorf("failed to redact secret %s: %w", u.GetName(), err)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package kustomize
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"maps"
 	"path/filepath"
 	"slices"
@@ -14,6 +17,9 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
+// ErrManifestsNotFound is returned when rendering manifests from a path that does not exist.
+var ErrManifestsNotFound = errors.New("manifests not found")
+
 type Engine struct {
 	k          *krusty.Kustomizer
 	fs         filesys.FileSystem
@@ -31,6 +37,10 @@ func (e *Engine) Render(path string, opts ...RenderOptsFn) ([]unstructured.Unstr
 		fn(&ro)
 	}
 
+	if !e.fs.Exists(path) {
+		return nil, fmt.Errorf("%w: %s", ErrManifestsNotFound, path)
+	}
+
 	if !e.fs.Exists(filepath.Join(path, ro.kustomizationFileName)) {
 		path = filepath.Join(path, ro.kustomizationFileOverlay)
 	}
@@ -86,3 +96,44 @@ func (e *Engine) Render(path string, opts ...RenderOptsFn) ([]unstructured.Unstr
 
 	return resp, nil
 }
+
+// Exists returns whether the given path holds a kustomization the engine can render.
+func (e *Engine) Exists(path string) bool {
+	if e.fs.Exists(filepath.Join(path, e.renderOpts.kustomizationFileName)) {
+		return true
+	}
+
+	return e.fs.Exists(filepath.Join(path, e.renderOpts.kustomizationFileOverlay, e.renderOpts.kustomizationFileName))
+}
+
+// Fingerprint writes the path and the content of the files found under the given path to w, in
+// lexical order, so that any change to the manifests results in a different fingerprint. Nothing
+// is written when the path does not exist.
+func (e *Engine) Fingerprint(path string, w io.Writer) error {
+	if !e.fs.Exists(path) {
+		return nil
+	}
+
+	return e.fs.Walk(path, func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		data, err := e.fs.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("failed to read manifest %s: %w", p, err)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s\x00%d\x00", p, len(data)); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
@@ -202,3 +202,14 @@ func TestEngineOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestEngineManifestsNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	e := kustomize.NewEngine(
+		kustomize.WithEngineFS(filesys.MakeFsInMemory()),
+	)
+
+	_, err := e.Render(xid.New().String())
+	g.Expect(err).Should(MatchError(kustomize.ErrManifestsNotFound))
+}
@@ -0,0 +1,607 @@
+// Package oci pushes artifacts to and pulls them from OCI registries, and resolves the digests of
+// their tags, using the distribution API of the registries and the token authentication they
+// delegate to an authorization server.
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// MediaTypeManifest is the media type of the manifests of the pushed artifacts.
+	MediaTypeManifest = "application/vnd.oci.image.manifest.v1+json"
+	// MediaTypeEmpty is the media type of the empty configuration of the pushed artifacts.
+	MediaTypeEmpty = "application/vnd.oci.empty.v1+json"
+	// MediaTypeLayerTarGz is the media type of a gzipped tarball layer.
+	MediaTypeLayerTarGz = "application/vnd.oci.image.layer.v1.tar+gzip"
+
+	// MediaTypeIndex is the media type of the multi-architecture images.
+	MediaTypeIndex = "application/vnd.oci.image.index.v1+json"
+	// MediaTypeDockerManifest is the media type of the manifests of the Docker images.
+	MediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	// MediaTypeDockerManifestList is the media type of the multi-architecture Docker images.
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// AnnotationTitle is the annotation naming the file of a layer.
+	AnnotationTitle = "org.opencontainers.image.title"
+
+	dockerHub         = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+
+	// maxErrorBody bounds the part of the body of the failed responses reported in errors.
+	maxErrorBody = 1024
+	// maxManifestSize bounds the size of the fetched manifests and tokens.
+	maxManifestSize = 4 << 20
+)
+
+var (
+	// emptyConfig is the content of the configuration of the pushed artifacts.
+	emptyConfig = []byte("{}")
+
+	// ErrNoCredentials is returned when a docker config holds no credentials for a registry.
+	ErrNoCredentials = errors.New("no credentials found")
+)
+
+// Reference is an artifact of a repository of a registry, referenced by tag or by digest.
+type Reference struct {
+	Registry   string
+	Repository string
+	// Tag is the tag, or the digest, of the artifact.
+	Tag string
+}
+
+// StatusError reports an unexpected status returned by a registry.
+type StatusError struct {
+	Code    int
+	Status  string
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return "unexpected status " + e.Status + " " + e.Message
+}
+
+// ParseReference returns the reference of the given tag of the given repository, prefixed with its
+// registry, e.g. quay.io/example/manifests.
+func ParseReference(repository string, tag string) (Reference, error) {
+	registry, path, found := strings.Cut(repository, "/")
+	if !found || registry == "" || path == "" {
+		return Reference{}, fmt.Errorf("invalid repository %q, expected <registry>/<repository>", repository)
+	}
+
+	if tag == "" {
+		tag = "latest"
+	}
+
+	return Reference{Registry: registry, Repository: path, Tag: tag}, nil
+}
+
+// ParseArtifact parses the fully qualified reference of an artifact,
+// <registry>/<repository>[:<tag>|@<digest>], the tag defaulting to latest. Short names are rejected
+// as they would be resolved against a public registry.
+func ParseArtifact(value string) (Reference, error) {
+	registry, rest, found := strings.Cut(value, "/")
+	if !found || rest == "" || !strings.ContainsAny(registry, ".:") && registry != "localhost" {
+		return Reference{}, fmt.Errorf("invalid OCI reference %q, expected <registry>/<repository>[:<tag>|@<digest>]", value)
+	}
+
+	ref := Reference{Registry: registry, Repository: rest, Tag: "latest"}
+
+	if repository, d, ok := strings.Cut(rest, "@"); ok {
+		ref.Repository, ref.Tag = repository, d
+	} else if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		ref.Repository, ref.Tag = rest[:i], rest[i+1:]
+	}
+
+	if ref.Repository == "" || ref.Tag == "" {
+		return Reference{}, fmt.Errorf("invalid OCI reference %q", value)
+	}
+
+	return ref, nil
+}
+
+// ParseImage returns the reference of the given image, referenced by tag, e.g. quay.io/example/app:v1,
+// the registry defaulting to docker.io and the tag to latest.
+func ParseImage(image string) (Reference, error) {
+	if strings.Contains(image, "@") {
+		return Reference{}, fmt.Errorf("image %q is referenced by digest", image)
+	}
+
+	name, tag := image, "latest"
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		name, tag = image[:i], image[i+1:]
+	}
+
+	registry, path, found := strings.Cut(name, "/")
+	if !found || !strings.ContainsAny(registry, ".:") && registry != "localhost" {
+		registry, path = dockerHub, name
+	}
+
+	if registry == dockerHub && !strings.Contains(path, "/") {
+		path = "library/" + path
+	}
+
+	if path == "" || tag == "" {
+		return Reference{}, fmt.Errorf("invalid image %q", image)
+	}
+
+	return Reference{Registry: registry, Repository: path, Tag: tag}, nil
+}
+
+func (r Reference) String() string {
+	if r.byDigest() {
+		return r.Registry + "/" + r.Repository + "@" + r.Tag
+	}
+
+	return r.Registry + "/" + r.Repository + ":" + r.Tag
+}
+
+// byDigest returns true if the reference is a digest, the tags not holding colons.
+func (r Reference) byDigest() bool {
+	return strings.Contains(r.Tag, ":")
+}
+
+// Credentials authenticate the requests to a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// CredentialsFromDockerConfig returns the credentials of the given registry in the given
+// .dockerconfigjson content, as found in the kubernetes.io/dockerconfigjson Secrets.
+func CredentialsFromDockerConfig(content []byte, registry string) (Credentials, error) {
+	config := struct {
+		Auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auths"`
+	}{}
+
+	if err := json.Unmarshal(content, &config); err != nil {
+		return Credentials{}, fmt.Errorf("invalid docker config: %w", err)
+	}
+
+	for name, auth := range config.Auths {
+		// the entries may be keyed by the URL of the registry
+		name = strings.TrimPrefix(strings.TrimPrefix(name, "https://"), "http://")
+		name, _, _ = strings.Cut(name, "/")
+
+		if name != registry {
+			continue
+		}
+
+		if auth.Auth == "" {
+			return Credentials{Username: auth.Username, Password: auth.Password}, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("invalid auth of registry %s: %w", registry, err)
+		}
+
+		username, password, found := strings.Cut(string(decoded), ":")
+		if !found {
+			return Credentials{}, fmt.Errorf("invalid auth of registry %s, expected <username>:<password>", registry)
+		}
+
+		return Credentials{Username: username, Password: password}, nil
+	}
+
+	return Credentials{}, fmt.Errorf("%w for registry %s", ErrNoCredentials, registry)
+}
+
+// Layer is the content of a layer of a pushed artifact.
+type Layer struct {
+	MediaType   string
+	Content     []byte
+	Annotations map[string]string
+}
+
+// Client pushes artifacts to, pulls them from, and resolves the tags of, the registry of a reference.
+type Client struct {
+	// HTTPClient sends the requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+	// Credentials authenticate the requests, which are anonymous when nil.
+	Credentials *Credentials
+	// Insecure sends the requests over plain HTTP.
+	Insecure bool
+
+	token string
+}
+
+// Descriptor describes the content of a blob of an artifact.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int               `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is the manifest of an artifact.
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Push pushes an artifact of the given type made of the given layers, tagged with the given
+// reference, and returns the digest of its manifest.
+func (c *Client) Push(ctx context.Context, ref Reference, artifactType string, layers []Layer, annotations map[string]string) (string, error) {
+	m := Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeManifest,
+		ArtifactType:  artifactType,
+		Config:        Descriptor{MediaType: MediaTypeEmpty, Digest: digest(emptyConfig), Size: len(emptyConfig)},
+		Layers:        make([]Descriptor, 0, len(layers)),
+		Annotations:   annotations,
+	}
+
+	if err := c.pushBlob(ctx, ref, emptyConfig); err != nil {
+		return "", err
+	}
+
+	for _, l := range layers {
+		if err := c.pushBlob(ctx, ref, l.Content); err != nil {
+			return "", err
+		}
+
+		m.Layers = append(m.Layers, Descriptor{
+			MediaType:   l.MediaType,
+			Digest:      digest(l.Content),
+			Size:        len(l.Content),
+			Annotations: l.Annotations,
+		})
+	}
+
+	content, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	resp, err := c.do(ctx, ref, http.MethodPut, c.url(ref, "manifests/"+ref.Tag), http.Header{"Content-Type": {MediaTypeManifest}}, content)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", unexpectedStatus(resp, "pushing manifest of "+ref.String())
+	}
+
+	return digest(content), nil
+}
+
+// Resolve returns the digest of the manifest the tag of the given reference points to, the index of
+// the multi-architecture images.
+func (c *Client) Resolve(ctx context.Context, ref Reference) (string, error) {
+	accept := http.Header{"Accept": {MediaTypeIndex, MediaTypeDockerManifestList, MediaTypeManifest, MediaTypeDockerManifest}}
+
+	resp, err := c.do(ctx, ref, http.MethodHead, c.url(ref, "manifests/"+ref.Tag), accept, nil)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", unexpectedStatus(resp, "resolving "+ref.String())
+	}
+
+	d := resp.Header.Get("Docker-Content-Digest")
+	if d == "" {
+		return "", fmt.Errorf("registry %s returned no digest for %s", ref.Registry, ref)
+	}
+
+	return d, nil
+}
+
+// FetchManifest returns the manifest of the artifact of the given reference, verifying it against
+// the digest of the reference when referenced by digest.
+func (c *Client) FetchManifest(ctx context.Context, ref Reference) (Manifest, error) {
+	accept := http.Header{"Accept": {MediaTypeManifest, MediaTypeDockerManifest}}
+
+	content, err := c.get(ctx, ref, "manifests/"+ref.Tag, accept, maxManifestSize)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if ref.byDigest() && digest(content) != ref.Tag {
+		return Manifest{}, fmt.Errorf("manifest of %s does not match its digest", ref)
+	}
+
+	m := Manifest{}
+	if err := json.Unmarshal(content, &m); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest of %s: %w", ref, err)
+	}
+
+	return m, nil
+}
+
+// FetchBlob returns the content of the given blob of the repository of the given reference, up to
+// the given size, verifying it against the digest of the blob.
+func (c *Client) FetchBlob(ctx context.Context, ref Reference, blob Descriptor, limit int64) ([]byte, error) {
+	content, err := c.get(ctx, ref, "blobs/"+blob.Digest, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest(content) != blob.Digest {
+		return nil, fmt.Errorf("blob of %s does not match its digest %s", ref, blob.Digest)
+	}
+
+	return content, nil
+}
+
+// get returns the content of the given path of the repository of the given reference, failing
+// when larger than the given size.
+func (c *Client) get(ctx context.Context, ref Reference, path string, header http.Header, limit int64) ([]byte, error) {
+	resp, err := c.do(ctx, ref, http.MethodGet, c.url(ref, path), header, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(resp, "fetching "+path+" of "+ref.String())
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s of %s: %w", path, ref, err)
+	}
+
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("%s of %s larger than %d bytes", path, ref, limit)
+	}
+
+	return content, nil
+}
+
+// pushBlob uploads the given content to the repository of the given reference, unless it holds it
+// already.
+func (c *Client) pushBlob(ctx context.Context, ref Reference, content []byte) error {
+	d := digest(content)
+
+	resp, err := c.do(ctx, ref, http.MethodHead, c.url(ref, "blobs/"+d), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	resp, err = c.do(ctx, ref, http.MethodPost, c.url(ref, "blobs/uploads/"), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return unexpectedStatus(resp, "starting upload of blob "+d)
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return fmt.Errorf("invalid location of the upload of blob %s: %w", d, err)
+	}
+
+	q := location.Query()
+	q.Set("digest", d)
+	location.RawQuery = q.Encode()
+
+	resp, err = c.do(ctx, ref, http.MethodPut, location.String(), http.Header{"Content-Type": {"application/octet-stream"}}, content)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return unexpectedStatus(resp, "uploading blob "+d)
+	}
+
+	return nil
+}
+
+// url returns the URL of the given path of the distribution API of the repository of the given
+// reference.
+func (c *Client) url(ref Reference, path string) string {
+	scheme := "https"
+	if c.Insecure {
+		scheme = "http"
+	}
+
+	host := ref.Registry
+	if host == dockerHub {
+		host = dockerHubRegistry
+	}
+
+	return fmt.Sprintf("%s://%s/v2/%s/%s", scheme, host, ref.Repository, path)
+}
+
+// do sends the given request, authenticating as challenged by the registry when it is refused.
+func (c *Client) do(ctx context.Context, ref Reference, method string, u string, header http.Header, body []byte) (*http.Response, error) {
+	resp, err := c.send(ctx, method, u, header, body)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+
+	// the pulls only ask for the pull action, so read-only credentials are enough
+	actions := "pull,push"
+	if method == http.MethodGet || method == http.MethodHead {
+		actions = "pull"
+	}
+
+	if err := c.authenticate(ctx, ref, challenge, actions); err != nil {
+		return nil, err
+	}
+
+	return c.send(ctx, method, u, header, body)
+}
+
+func (c *Client) send(ctx context.Context, method string, u string, header http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.Credentials != nil:
+		req.SetBasicAuth(c.Credentials.Username, c.Credentials.Password)
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s %s: %w", method, u, err)
+	}
+
+	return resp, nil
+}
+
+// authenticate gets a token from the authorization server the registry delegates to in the given
+// WWW-Authenticate challenge, for the given actions unless the challenge sets its scope. The
+// credentials are sent to the registry when it asks for them.
+func (c *Client) authenticate(ctx context.Context, ref Reference, challenge string, actions string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch {
+	case strings.EqualFold(scheme, "basic") && c.Credentials != nil && c.token == "":
+		// the credentials were sent already
+		return errors.New("registry " + ref.Registry + " refused the credentials")
+	case strings.EqualFold(scheme, "basic"):
+		return errors.New("registry " + ref.Registry + " requires credentials")
+	case !strings.EqualFold(scheme, "bearer") || params["realm"] == "":
+		return fmt.Errorf("unsupported authentication challenge of registry %s: %q", ref.Registry, challenge)
+	}
+
+	u, err := url.Parse(params["realm"])
+	if err != nil {
+		return fmt.Errorf("invalid authentication realm of registry %s: %w", ref.Registry, err)
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + ref.Repository + ":" + actions
+	}
+
+	q := u.Query()
+	q.Set("scope", scope)
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	u.RawQuery = q.Encode()
+
+	// the token is requested with the credentials only
+	c.token = ""
+
+	resp, err := c.send(ctx, http.MethodGet, u.String(), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get registry token of %s: %w", ref.Registry, unexpectedStatus(resp, "authenticating"))
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&token); err != nil {
+		return fmt.Errorf("invalid token of registry %s: %w", ref.Registry, err)
+	}
+
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+
+	if c.token == "" {
+		return fmt.Errorf("no token returned by registry %s", ref.Registry)
+	}
+
+	return nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	return http.DefaultClient
+}
+
+// parseChallenge returns the scheme and the parameters of the given WWW-Authenticate challenge,
+// e.g. Bearer realm="https://auth.example.com/token",service="registry.example.com".
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest != "" {
+		var key string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if key == "" {
+			break
+		}
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			// quoted values may hold commas, e.g. the actions of a scope
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+
+	return scheme, params
+}
+
+func digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func unexpectedStatus(resp *http.Response, action string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+	return &StatusError{
+		Code:    resp.StatusCode,
+		Status:  resp.Status,
+		Message: action + ": " + strings.TrimSpace(string(body)),
+	}
+}
@@ -0,0 +1,289 @@
+package oci_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+
+	. "github.com/onsi/gomega"
+)
+
+const token = "t0k3n"
+
+// registry is a minimal registry delegating its authentication to a token server.
+type registry struct {
+	mu        sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	uploads   int
+}
+
+func (r *registry) handler(t *testing.T, srv **httptest.Server) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if req.URL.Path == "/token" {
+			if user, password, ok := req.BasicAuth(); !ok || user != "user" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+			return
+		}
+
+		if req.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate",
+				`Bearer realm="`+(*srv).URL+`/token",service="registry.test",scope="repository:org/manifests:pull,push"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		path := strings.TrimPrefix(req.URL.Path, "/v2/org/manifests/")
+		body, _ := io.ReadAll(req.Body)
+
+		switch {
+		case req.Method == http.MethodHead && strings.HasPrefix(path, "blobs/"):
+			if _, ok := r.blobs[strings.TrimPrefix(path, "blobs/")]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case req.Method == http.MethodPost && path == "blobs/uploads/":
+			r.uploads++
+			w.Header().Set("Location", "/v2/org/manifests/blobs/uploads/session?state=1")
+			w.WriteHeader(http.StatusAccepted)
+		case req.Method == http.MethodPut && path == "blobs/uploads/session":
+			sum := sha256.Sum256(body)
+			if d := req.URL.Query().Get("digest"); d != "sha256:"+hex.EncodeToString(sum[:]) || req.URL.Query().Get("state") != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			r.blobs[req.URL.Query().Get("digest")] = body
+			w.WriteHeader(http.StatusCreated)
+		case req.Method == http.MethodHead && strings.HasPrefix(path, "manifests/"):
+			m, ok := r.manifests[strings.TrimPrefix(path, "manifests/")]
+			if !ok || !strings.Contains(strings.Join(req.Header.Values("Accept"), ","), oci.MediaTypeIndex) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			sum := sha256.Sum256(m)
+			w.Header().Set("Docker-Content-Digest", "sha256:"+hex.EncodeToString(sum[:]))
+		case req.Method == http.MethodGet && strings.HasPrefix(path, "manifests/"):
+			m, ok := r.manifests[strings.TrimPrefix(path, "manifests/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write(m)
+		case req.Method == http.MethodGet && strings.HasPrefix(path, "blobs/"):
+			b, ok := r.blobs[strings.TrimPrefix(path, "blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write(b)
+		case req.Method == http.MethodPut && strings.HasPrefix(path, "manifests/"):
+			if req.Header.Get("Content-Type") != oci.MediaTypeManifest {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+
+			r.manifests[strings.TrimPrefix(path, "manifests/")] = body
+			// the manifests are also referenced by digest
+			sum := sha256.Sum256(body)
+			r.manifests["sha256:"+hex.EncodeToString(sum[:])] = body
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestPush(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	reg := &registry{
+		blobs:     make(map[string][]byte),
+		manifests: make(map[string][]byte),
+	}
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(reg.handler(t, &srv))
+	defer srv.Close()
+
+	ref, err := oci.ParseReference(strings.TrimPrefix(srv.URL, "http://")+"/org/manifests", "")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref.Tag).Should(Equal("latest"))
+
+	layer := oci.Layer{
+		MediaType:   oci.MediaTypeLayerTarGz,
+		Content:     []byte("manifests"),
+		Annotations: map[string]string{oci.AnnotationTitle: "manifests.tar.gz"},
+	}
+
+	anonymous := oci.Client{Insecure: true}
+	_, err = anonymous.Push(ctx, ref, "application/vnd.example.v1", []oci.Layer{layer}, nil)
+	g.Expect(err).Should(MatchError(ContainSubstring("401")))
+
+	c := oci.Client{
+		Insecure:    true,
+		Credentials: &oci.Credentials{Username: "user", Password: "secret"},
+	}
+
+	d, err := c.Push(ctx, ref, "application/vnd.example.v1", []oci.Layer{layer}, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(reg.blobs).Should(HaveLen(2))
+	g.Expect(reg.blobs).Should(ContainElement([]byte("manifests")))
+	g.Expect(reg.manifests).Should(HaveKey("latest"))
+
+	sum := sha256.Sum256(reg.manifests["latest"])
+	g.Expect(d).Should(Equal("sha256:" + hex.EncodeToString(sum[:])))
+
+	m := map[string]any{}
+	g.Expect(json.Unmarshal(reg.manifests["latest"], &m)).Should(Succeed())
+	g.Expect(m).Should(And(
+		HaveKeyWithValue("artifactType", "application/vnd.example.v1"),
+		HaveKeyWithValue("config", HaveKeyWithValue("mediaType", oci.MediaTypeEmpty)),
+		HaveKeyWithValue("layers", ConsistOf(And(
+			HaveKeyWithValue("mediaType", oci.MediaTypeLayerTarGz),
+			HaveKeyWithValue("size", BeNumerically("==", len("manifests"))),
+		))),
+	))
+
+	// the blobs held by the registry are not uploaded again
+	uploads := reg.uploads
+
+	_, err = c.Push(ctx, ref, "application/vnd.example.v1", []oci.Layer{layer}, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reg.uploads).Should(Equal(uploads))
+
+	// the tag resolves to the pushed manifest
+	resolved, err := c.Resolve(ctx, ref)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resolved).Should(Equal(d))
+
+	// the pushed artifact is pulled back, by tag and by digest
+	for _, tag := range []string{"latest", d} {
+		pulled := ref
+		pulled.Tag = tag
+
+		m, err := c.FetchManifest(ctx, pulled)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(m.Layers).Should(HaveLen(1))
+
+		content, err := c.FetchBlob(ctx, pulled, m.Layers[0], 1024)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(content).Should(Equal([]byte("manifests")))
+
+		_, err = c.FetchBlob(ctx, pulled, m.Layers[0], 4)
+		g.Expect(err).Should(MatchError(ContainSubstring("larger than 4 bytes")))
+	}
+
+	ref.Tag = "missing"
+	_, err = c.Resolve(ctx, ref)
+	g.Expect(err).Should(MatchError(ContainSubstring("404")))
+
+	se := &oci.StatusError{}
+	_, err = c.FetchManifest(ctx, ref)
+	g.Expect(errors.As(err, &se)).Should(BeTrue())
+	g.Expect(se.Code).Should(Equal(http.StatusNotFound))
+}
+
+func TestParseReference(t *testing.T) {
+	g := NewWithT(t)
+
+	ref, err := oci.ParseReference("quay.io/example/odh-manifests", "v1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: "quay.io", Repository: "example/odh-manifests", Tag: "v1"}))
+	g.Expect(ref.String()).Should(Equal("quay.io/example/odh-manifests:v1"))
+
+	_, err = oci.ParseReference("odh-manifests", "")
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestParseArtifact(t *testing.T) {
+	tests := []struct {
+		value string
+		ref   oci.Reference
+	}{
+		{value: "quay.io/example/manifests:v1", ref: oci.Reference{Registry: "quay.io", Repository: "example/manifests", Tag: "v1"}},
+		{value: "localhost:5000/manifests", ref: oci.Reference{Registry: "localhost:5000", Repository: "manifests", Tag: "latest"}},
+		{value: "quay.io/example/manifests@sha256:0123", ref: oci.Reference{Registry: "quay.io", Repository: "example/manifests", Tag: "sha256:0123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref, err := oci.ParseArtifact(tt.value)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(ref).Should(Equal(tt.ref))
+			g.Expect(ref.String()).Should(Equal(strings.Replace(tt.value, "localhost:5000/manifests", "localhost:5000/manifests:latest", 1)))
+		})
+	}
+
+	_, err := oci.ParseArtifact("example/manifests:v1")
+	NewWithT(t).Expect(err).Should(MatchError(ContainSubstring("invalid OCI reference")))
+}
+
+func TestParseImage(t *testing.T) {
+	tests := []struct {
+		image string
+		ref   oci.Reference
+	}{
+		{image: "quay.io/example/app:v1", ref: oci.Reference{Registry: "quay.io", Repository: "example/app", Tag: "v1"}},
+		{image: "registry.example.com:5000/app", ref: oci.Reference{Registry: "registry.example.com:5000", Repository: "app", Tag: "latest"}},
+		{image: "example/app:v1", ref: oci.Reference{Registry: "docker.io", Repository: "example/app", Tag: "v1"}},
+		{image: "busybox", ref: oci.Reference{Registry: "docker.io", Repository: "library/busybox", Tag: "latest"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref, err := oci.ParseImage(tt.image)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(ref).Should(Equal(tt.ref))
+		})
+	}
+
+	_, err := oci.ParseImage("quay.io/example/app@sha256:0123")
+	NewWithT(t).Expect(err).Should(MatchError(ContainSubstring("referenced by digest")))
+}
+
+func TestCredentialsFromDockerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	config := []byte(`{"auths": {
+		"https://quay.io/v1/": {"auth": "dXNlcjpzZWNyZXQ="},
+		"registry.example.com:5000": {"username": "admin", "password": "pa:ss"}
+	}}`)
+
+	creds, err := oci.CredentialsFromDockerConfig(config, "quay.io")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(creds).Should(Equal(oci.Credentials{Username: "user", Password: "secret"}))
+
+	creds, err = oci.CredentialsFromDockerConfig(config, "registry.example.com:5000")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(creds).Should(Equal(oci.Credentials{Username: "admin", Password: "pa:ss"}))
+
+	_, err = oci.CredentialsFromDockerConfig(config, "docker.io")
+	g.Expect(err).Should(MatchError(ContainSubstring("no credentials found")))
+}
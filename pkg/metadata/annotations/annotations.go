@@ -3,6 +3,18 @@ package annotations
 // ManagedByODHOperator is used to denote if a resource/component should be reconciled - when true, reconcile.
 const ManagedByODHOperator = "opendatahub.io/managed"
 
+// ManagedFieldsExclude set on an operator-managed resource to a comma separated list of field paths the operator
+// does not reconcile, e.g. ".spec.replicas, .spec.template.spec.containers[name=manager].env[name=LOG_LEVEL]".
+const ManagedFieldsExclude = "opendatahub.io/managed-fields-exclude"
+
+// OptionalContainers set on a Deployment or StatefulSet manifest to a comma separated list of the names of the
+// sidecar containers removed from its pods with the sno deployment profile, e.g. "kube-rbac-proxy".
+const OptionalContainers = "opendatahub.io/optional-containers"
+
+// ManagedReplicas set to "true" on a rendered Deployment whose replicas are set via the platform API, so they
+// take precedence over the replicas set on the cluster.
+const ManagedReplicas = "opendatahub.io/managed-replicas"
+
 // trust CA bundler.
 const InjectionOfCABundleAnnotatoion = "security.opendatahub.io/inject-trusted-ca-bundle"
 
@@ -17,6 +29,27 @@ const (
 // ManagementStateAnnotation set on Component CR only, to show which ManagementState value if defined in DSC for the component.
 const ManagementStateAnnotation = "component.opendatahub.io/management-state"
 
+// ReconcilePaused set to "true" on a Component CR or DSC to stop the operator from rendering and deploying its resources.
+const ReconcilePaused = "opendatahub.io/reconcile-paused"
+
+// UpgradeApproved set on a Component CR with a Manual upgrade strategy to the operator version the component can be upgraded to.
+const UpgradeApproved = "opendatahub.io/upgrade-approved"
+
+// ForceRemoval set to "true" on a Component CR to remove the component even if user workloads relying on it still exist.
+const ForceRemoval = "opendatahub.io/force-removal"
+
+// GCProtect set to "true" on a resource deployed by the operator to exempt it from garbage collection, so it is
+// kept when no longer rendered.
+const GCProtect = "opendatahub.io/gc-protect"
+
+// MaintenanceFailOpen set by the operator on a webhook configuration whose webhooks have been set to fail open
+// while the DataScienceCluster is in maintenance mode, so their failure policy is restored afterwards.
+const MaintenanceFailOpen = "opendatahub.io/maintenance-fail-open"
+
+// AdoptKfDef set to "true" on the KfDef migration proposal ConfigMap to let the operator create the proposed
+// DataScienceCluster, when no DataScienceCluster exists.
+const AdoptKfDef = "opendatahub.io/adopt-kfdef"
+
 const (
 	PlatformVersion    = "platform.opendatahub.io/version"
 	PlatformType       = "platform.opendatahub.io/type"
@@ -38,3 +71,7 @@ const ConnectionTypeProtocol = "opendatahub.io/connection-type-protocol"
 // ConnectionPath annotation for specifying the path under bucket(s3) to use for the connection.
 // TODO: extend to oci.
 const ConnectionPath = "opendatahub.io/connection-path"
+
+// RecommendedAccelerators annotation of the serving runtimes listing, as a JSON array, the extended
+// resources of the accelerators they are built for.
+const RecommendedAccelerators = "opendatahub.io/recommended-accelerators"
@@ -8,9 +8,24 @@ const (
 	ClusterMonitoring      = "openshift.io/cluster-monitoring"
 	PlatformPartOf         = ODHPlatformPrefix + "/part-of"
 	PlatformDependency     = ODHPlatformPrefix + "/dependency"
+	SpecHistory            = ODHPlatformPrefix + "/spec-history"
+	SpecHistoryInstance    = ODHPlatformPrefix + "/spec-history-instance"
+	DataScienceCluster     = ODHPlatformPrefix + "/datasciencecluster"
+	ApplicationsNamespace  = ODHPlatformPrefix + "/applications-namespace"
 	Platform               = "platform"
 	True                   = "true"
 	CustomizedAppNamespace = "opendatahub.io/application-namespace"
+	DataScienceProject     = "opendatahub.io/dashboard"
+	DashboardCatalog       = "opendatahub.io/dashboard-catalog"
+)
+
+// BackupTier classifies the resources deployed by the operator for backup tools such as Velero:
+// resources holding user data are labeled with BackupTierData, the ones the operator recreates
+// from the platform resources with BackupTierConfig.
+const (
+	BackupTier       = "opendatahub.io/backup-tier"
+	BackupTierData   = "data"
+	BackupTierConfig = "config"
 )
 
 // K8SCommon keeps common kubernetes labels [1]
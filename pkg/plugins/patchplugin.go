@@ -0,0 +1,35 @@
+package plugins
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/api/builtins" //nolint:staticcheck // Remove after package update
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/yaml"
+)
+
+// CreatePatchPlugin creates a plugin applying an inline patch, either a JSON6902 or a strategic merge
+// one, to the resources matching the target, the same way a kustomization patches entry does.
+func CreatePatchPlugin(patch string, target *types.Selector) (*builtins.PatchTransformerPlugin, error) {
+	plugin := &builtins.PatchTransformerPlugin{
+		Patch:  patch,
+		Target: target,
+	}
+
+	config, err := yaml.Marshal(plugin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch plugin config: %w", err)
+	}
+
+	// inline patches do not load anything, so no loader nor validator is required
+	rf := provider.NewDefaultDepProvider().GetResourceFactory()
+	helpers := resmap.NewPluginHelpers(nil, nil, resmap.NewFactory(rf), types.DisabledPluginConfig())
+
+	if err := plugin.Config(helpers, config); err != nil {
+		return nil, fmt.Errorf("invalid patch: %w", err)
+	}
+
+	return plugin, nil
+}
@@ -14,6 +14,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	"gopkg.in/yaml.v3"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -151,6 +152,25 @@ func IngressHost(r routev1.Route) string {
 	return ""
 }
 
+// KubernetesIngressHost returns the host the given Ingress is reachable at: the host of its rule or,
+// when the rule matches all the hosts, the address of its load balancer once assigned.
+func KubernetesIngressHost(in networkingv1.Ingress) string {
+	if len(in.Spec.Rules) == 1 && in.Spec.Rules[0].Host != "" {
+		return in.Spec.Rules[0].Host
+	}
+
+	if len(in.Status.LoadBalancer.Ingress) == 0 {
+		return ""
+	}
+
+	lb := in.Status.LoadBalancer.Ingress[0]
+	if lb.Hostname != "" {
+		return lb.Hostname
+	}
+
+	return lb.IP
+}
+
 func HasLabel(obj client.Object, k string, values ...string) bool {
 	if obj == nil {
 		return false
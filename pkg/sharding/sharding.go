@@ -0,0 +1,73 @@
+// Package sharding splits the component controllers across several operator replicas, each
+// replica running the controllers of one shard under the leader election lease of that shard.
+package sharding
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Config is the shard an operator replica runs.
+type Config struct {
+	// Count is the number of shards the component controllers are split across, 1 to run
+	// them all in every replica.
+	Count int
+	// Index is the shard run by the replica, from 0 to Count-1. The shard 0 also runs the
+	// controllers of the platform, the webhooks and the setup tasks.
+	Index int
+}
+
+// Validate returns an error if the index of the shard is out of the number of shards.
+func (c Config) Validate() error {
+	if c.Count < 1 {
+		return fmt.Errorf("invalid shard count %d, must be at least 1", c.Count)
+	}
+
+	if c.Index < 0 || c.Index >= c.Count {
+		return fmt.Errorf("invalid shard index %d, must be between 0 and %d", c.Index, c.Count-1)
+	}
+
+	return nil
+}
+
+// IsSharded returns whether the component controllers are split across several shards.
+func (c Config) IsSharded() bool {
+	return c.Count > 1
+}
+
+// IsCore returns whether the shard runs the controllers of the platform, the webhooks and the
+// setup tasks, along with its component controllers.
+func (c Config) IsCore() bool {
+	return c.Index == 0
+}
+
+// Components returns, out of the given component names, the ones whose controllers the shard
+// runs. The names are sorted and dealt to the shards in turn, so every replica computes the same
+// split and the shards run a balanced number of controllers.
+func (c Config) Components(names []string) []string {
+	if !c.IsSharded() {
+		return names
+	}
+
+	sorted := slices.Sorted(slices.Values(names))
+	selected := make([]string, 0, len(sorted)/c.Count+1)
+
+	for i, name := range sorted {
+		if i%c.Count == c.Index {
+			selected = append(selected, name)
+		}
+	}
+
+	return selected
+}
+
+// LeaderElectionID returns the ID of the leader election lease of the shard, derived from the
+// given one. The shard 0 keeps the given ID, so a sharded operator takes over from an operator
+// that is not.
+func (c Config) LeaderElectionID(id string) string {
+	if !c.IsSharded() || c.IsCore() {
+		return id
+	}
+
+	return fmt.Sprintf("shard-%d.%s", c.Index, id)
+}
@@ -0,0 +1,56 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/sharding"
+
+	. "github.com/onsi/gomega"
+)
+
+const leaderElectionID = "07ed84f7.opendatahub.io"
+
+var components = []string{"workbenches", "dashboard", "kserve", "ray", "kueue"}
+
+func TestValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(sharding.Config{Count: 1}.Validate()).Should(Succeed())
+	g.Expect(sharding.Config{Count: 3, Index: 2}.Validate()).Should(Succeed())
+
+	g.Expect(sharding.Config{Count: 0}.Validate()).Should(MatchError(ContainSubstring("invalid shard count 0")))
+	g.Expect(sharding.Config{Count: 3, Index: 3}.Validate()).Should(MatchError(ContainSubstring("between 0 and 2")))
+	g.Expect(sharding.Config{Count: 3, Index: -1}.Validate()).Should(HaveOccurred())
+}
+
+func TestUnsharded(t *testing.T) {
+	g := NewWithT(t)
+
+	c := sharding.Config{Count: 1}
+
+	g.Expect(c.IsSharded()).Should(BeFalse())
+	g.Expect(c.IsCore()).Should(BeTrue())
+	g.Expect(c.Components(components)).Should(Equal(components))
+	g.Expect(c.LeaderElectionID(leaderElectionID)).Should(Equal(leaderElectionID))
+}
+
+func TestComponents(t *testing.T) {
+	g := NewWithT(t)
+
+	shards := []sharding.Config{{Count: 2, Index: 0}, {Count: 2, Index: 1}}
+
+	g.Expect(shards[0].Components(components)).Should(Equal([]string{"dashboard", "kueue", "workbenches"}))
+	g.Expect(shards[1].Components(components)).Should(Equal([]string{"kserve", "ray"}))
+
+	// the split does not depend on the order of the components
+	g.Expect(shards[1].Components([]string{"ray", "kueue", "kserve", "dashboard", "workbenches"})).
+		Should(Equal([]string{"kserve", "ray"}))
+}
+
+func TestLeaderElectionID(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(sharding.Config{Count: 3, Index: 0}.LeaderElectionID(leaderElectionID)).Should(Equal(leaderElectionID))
+	g.Expect(sharding.Config{Count: 3, Index: 1}.LeaderElectionID(leaderElectionID)).Should(Equal("shard-1." + leaderElectionID))
+	g.Expect(sharding.Config{Count: 3, Index: 2}.IsCore()).Should(BeFalse())
+}
@@ -0,0 +1,293 @@
+// Package kfdef helps the migration of the installs predating the current DataScienceCluster API,
+// deployed with KfDef resources or configured with the v1 DataScienceCluster components: the
+// DataScienceCluster equivalent to the applications of the KfDef resources is proposed in a
+// ConfigMap, which may also hold a v1 configuration, and created once the proposal is approved.
+package kfdef
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	// ProposalName is the name of the ConfigMap, in the operator namespace, holding the proposed
+	// DataScienceCluster.
+	ProposalName = "kfdef-migration-proposal"
+
+	// ProposalKey is the key of the proposal ConfigMap holding the proposed DataScienceCluster.
+	ProposalKey = "datasciencecluster.yaml"
+
+	// UnmappedKey is the key of the proposal ConfigMap listing the KfDef applications without an
+	// equivalent component.
+	UnmappedKey = "unmapped-applications"
+)
+
+// Applications maps the names of the KfDef applications to the component of the DataScienceCluster
+// deploying them, none for the applications now deployed by the platform itself.
+var Applications = map[string]string{
+	"odh-common":                      "",
+	"odh-dashboard":                   componentApi.DashboardComponentName,
+	"odh-notebook-controller":         componentApi.WorkbenchesComponentName,
+	"notebook-images":                 componentApi.WorkbenchesComponentName,
+	"notebooks":                       componentApi.WorkbenchesComponentName,
+	"data-science-pipelines-operator": componentApi.DataSciencePipelinesComponentName,
+	"kserve":                          componentApi.KserveComponentName,
+	"model-mesh":                      componentApi.KserveComponentName,
+	"odh-model-controller":            componentApi.KserveComponentName,
+	"codeflare-stack":                 componentApi.RayComponentName,
+	"kuberay-operator":                componentApi.RayComponentName,
+	"ray-operator":                    componentApi.RayComponentName,
+	"kueue":                           componentApi.KueueComponentName,
+	"trustyai":                        componentApi.TrustyAIComponentName,
+	"trustyai-service-operator":       componentApi.TrustyAIComponentName,
+	"model-registry-operator":         componentApi.ModelRegistryComponentName,
+	"training-operator":               componentApi.TrainingOperatorComponentName,
+}
+
+// Adopt proposes, when KfDef resources exist, the equivalent DataScienceCluster in the proposal
+// ConfigMap of the given namespace, unless already proposed so the proposal can be reviewed and
+// edited. Once the proposal ConfigMap is annotated with opendatahub.io/adopt-kfdef set to "true",
+// the proposed DataScienceCluster is created, if none exists yet. The proposal may be written with
+// the v1 API, e.g. copied from the configuration of an older install, and is then converted. The
+// KfDef resources are left untouched.
+func Adopt(ctx context.Context, cli client.Client, namespace string) error {
+	l := logf.FromContext(ctx).WithValues("configmap", client.ObjectKey{Namespace: namespace, Name: ProposalName})
+
+	proposal := corev1.ConfigMap{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ProposalName}, &proposal)
+	switch {
+	case k8serr.IsNotFound(err):
+		return propose(ctx, cli, namespace)
+	case err != nil:
+		return fmt.Errorf("failed to get the KfDef migration proposal: %w", err)
+	}
+
+	if resources.GetAnnotation(&proposal, annotations.AdoptKfDef) != "true" {
+		return nil
+	}
+
+	_, err = cluster.GetDSC(ctx, cli)
+	switch {
+	case err == nil:
+		l.Info("KfDef migration proposal not adopted, a DataScienceCluster already exists")
+		return nil
+	case !k8serr.IsNotFound(err):
+		return err
+	}
+
+	dsc, dropped, err := decodeProposal([]byte(proposal.Data[ProposalKey]))
+	if err != nil {
+		return fmt.Errorf("invalid KfDef migration proposal: %w", err)
+	}
+
+	if len(dropped) != 0 {
+		l.Info("the components without an equivalent in the DataScienceCluster are not adopted", "components", dropped)
+	}
+
+	if err := cli.Create(ctx, dsc); err != nil {
+		return fmt.Errorf("failed to create the DataScienceCluster proposed for the KfDef resources: %w", err)
+	}
+
+	l.Info("adopted the KfDef migration proposal", "dsc", dsc.Name)
+
+	return nil
+}
+
+// propose creates the proposal ConfigMap in the given namespace when KfDef resources exist.
+func propose(ctx context.Context, cli client.Client, namespace string) error {
+	found, err := cluster.HasCRD(ctx, cli, gvk.KfDef)
+	if err != nil {
+		return fmt.Errorf("failed to check the %s CRD: %w", gvk.KfDef.Kind, err)
+	}
+	if !found {
+		return nil
+	}
+
+	kfdefs := unstructured.UnstructuredList{}
+	kfdefs.SetGroupVersionKind(gvk.KfDef.GroupVersion().WithKind(gvk.KfDef.Kind + "List"))
+
+	if err := cli.List(ctx, &kfdefs); err != nil {
+		return fmt.Errorf("failed to list %s resources: %w", gvk.KfDef.Kind, err)
+	}
+	if len(kfdefs.Items) == 0 {
+		return nil
+	}
+
+	if err := createProposal(ctx, cli, namespace, kfdefs.Items); err != nil {
+		return err
+	}
+
+	logf.FromContext(ctx).Info("proposed the DataScienceCluster equivalent to the KfDef resources, annotate the ConfigMap to adopt it",
+		"configmap", client.ObjectKey{Namespace: namespace, Name: ProposalName}, "annotation", annotations.AdoptKfDef)
+
+	return nil
+}
+
+// decodeProposal returns the proposed DataScienceCluster, converting the v1 ones, along with the
+// Managed v1 components without an equivalent, which are dropped by the conversion.
+func decodeProposal(content []byte) (*dscv2.DataScienceCluster, []string, error) {
+	tm := metav1.TypeMeta{}
+	if err := yaml.Unmarshal(content, &tm); err != nil {
+		return nil, nil, err
+	}
+
+	dsc := dscv2.DataScienceCluster{}
+
+	if tm.APIVersion != dscv1.GroupVersion.String() {
+		if err := yaml.Unmarshal(content, &dsc); err != nil {
+			return nil, nil, err
+		}
+
+		return &dsc, nil, nil
+	}
+
+	legacy := dscv1.DataScienceCluster{}
+	if err := yaml.Unmarshal(content, &legacy); err != nil {
+		return nil, nil, err
+	}
+
+	if err := legacy.ConvertTo(&dsc); err != nil {
+		return nil, nil, fmt.Errorf("failed to convert the v1 DataScienceCluster: %w", err)
+	}
+
+	dsc.TypeMeta = metav1.TypeMeta{
+		Kind:       gvk.DataScienceCluster.Kind,
+		APIVersion: gvk.DataScienceCluster.GroupVersion().String(),
+	}
+	dsc.Status = dscv2.DataScienceClusterStatus{}
+
+	dropped := make([]string, 0)
+	if legacy.Spec.Components.ModelMeshServing.ManagementState == operatorv1.Managed {
+		dropped = append(dropped, componentApi.ModelMeshServingKind)
+	}
+	if legacy.Spec.Components.CodeFlare.ManagementState == operatorv1.Managed {
+		dropped = append(dropped, componentApi.CodeFlareKind)
+	}
+
+	return &dsc, dropped, nil
+}
+
+func createProposal(ctx context.Context, cli client.Client, namespace string, kfdefs []unstructured.Unstructured) error {
+	dsc, unmapped, err := Propose(kfdefs)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(dsc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the KfDef migration proposal: %w", err)
+	}
+
+	proposal := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ProposalName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			ProposalKey: string(content),
+		},
+	}
+
+	if len(unmapped) != 0 {
+		proposal.Data[UnmappedKey] = strings.Join(unmapped, ",")
+	}
+
+	if err := cli.Create(ctx, &proposal); err != nil && !k8serr.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the KfDef migration proposal: %w", err)
+	}
+
+	return nil
+}
+
+// Propose returns the DataScienceCluster managing the components equivalent to the applications of
+// the given KfDef resources, the other components being removed, and the sorted names of the
+// applications without an equivalent component.
+func Propose(kfdefs []unstructured.Unstructured) (*dscv2.DataScienceCluster, []string, error) {
+	managed := make([]string, 0)
+	unmapped := make([]string, 0)
+
+	for i := range kfdefs {
+		applications, _, err := unstructured.NestedSlice(kfdefs[i].Object, "spec", "applications")
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid applications of %s: %w", resources.FormatObjectReference(&kfdefs[i]), err)
+		}
+
+		for _, app := range applications {
+			m, ok := app.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := m["name"].(string)
+
+			component, ok := Applications[name]
+			switch {
+			case !ok:
+				if name != "" && !slices.Contains(unmapped, name) {
+					unmapped = append(unmapped, name)
+				}
+			case component != "":
+				managed = append(managed, component)
+			}
+		}
+	}
+
+	slices.Sort(unmapped)
+
+	state := func(component string) operatorv1.ManagementState {
+		if slices.Contains(managed, component) {
+			return operatorv1.Managed
+		}
+
+		return operatorv1.Removed
+	}
+
+	kueue := operatorv1.Removed
+	if slices.Contains(managed, componentApi.KueueComponentName) {
+		kueue = operatorv1.Unmanaged
+	}
+
+	dsc := dscv2.DataScienceCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.DataScienceCluster.Kind,
+			APIVersion: gvk.DataScienceCluster.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default-dsc",
+		},
+	}
+
+	c := &dsc.Spec.Components
+	c.Dashboard.ManagementState = state(componentApi.DashboardComponentName)
+	c.Workbenches.ManagementState = state(componentApi.WorkbenchesComponentName)
+	c.AIPipelines.ManagementState = state(componentApi.DataSciencePipelinesComponentName)
+	c.Kserve.ManagementState = state(componentApi.KserveComponentName)
+	c.Kueue.ManagementState = kueue
+	c.Ray.ManagementState = state(componentApi.RayComponentName)
+	c.TrustyAI.ManagementState = state(componentApi.TrustyAIComponentName)
+	c.ModelRegistry.ManagementState = state(componentApi.ModelRegistryComponentName)
+	c.TrainingOperator.ManagementState = state(componentApi.TrainingOperatorComponentName)
+	c.FeastOperator.ManagementState = operatorv1.Removed
+	c.LlamaStackOperator.ManagementState = operatorv1.Removed
+
+	return &dsc, unmapped, nil
+}
@@ -0,0 +1,198 @@
+package kfdef_test
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/kfdef"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+func newKfDef(name string, applications ...string) *unstructured.Unstructured {
+	apps := make([]any, 0, len(applications))
+	for _, app := range applications {
+		apps = append(apps, map[string]any{"name": app})
+	}
+
+	u := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"applications": apps}}}
+	u.SetGroupVersionKind(gvk.KfDef)
+	u.SetName(name)
+	u.SetNamespace("opendatahub")
+
+	return u
+}
+
+func newClient(t *testing.T, withCRD bool, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s, err := scheme.New()
+	if err != nil {
+		t.Fatalf("failed to create scheme: %v", err)
+	}
+
+	s.AddKnownTypeWithName(gvk.KfDef, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gvk.KfDef.GroupVersion().WithKind(gvk.KfDef.Kind+"List"), &unstructured.UnstructuredList{})
+
+	if withCRD {
+		crd := mocks.NewMockCRD(gvk.KfDef.Group, gvk.KfDef.Version, gvk.KfDef.Kind, "kfdef")
+		crd.Status.StoredVersions = []string{gvk.KfDef.Version}
+		objs = append(objs, crd)
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return cli
+}
+
+func TestPropose(t *testing.T) {
+	g := NewWithT(t)
+
+	dsc, unmapped, err := kfdef.Propose([]unstructured.Unstructured{
+		*newKfDef("opendatahub", "odh-common", "odh-dashboard", "odh-notebook-controller", "notebooks", "model-mesh"),
+		*newKfDef("opendatahub-apps", "codeflare-stack", "kueue", "prometheus-cluster", "odh-dashboard"),
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(unmapped).Should(Equal([]string{"prometheus-cluster"}))
+
+	c := dsc.Spec.Components
+	g.Expect(c.Dashboard.ManagementState).Should(Equal(operatorv1.Managed))
+	g.Expect(c.Workbenches.ManagementState).Should(Equal(operatorv1.Managed))
+	g.Expect(c.Kserve.ManagementState).Should(Equal(operatorv1.Managed))
+	g.Expect(c.Ray.ManagementState).Should(Equal(operatorv1.Managed))
+	g.Expect(c.Kueue.ManagementState).Should(Equal(operatorv1.Unmanaged))
+	g.Expect(c.AIPipelines.ManagementState).Should(Equal(operatorv1.Removed))
+	g.Expect(c.TrustyAI.ManagementState).Should(Equal(operatorv1.Removed))
+	g.Expect(c.ModelRegistry.ManagementState).Should(Equal(operatorv1.Removed))
+	g.Expect(c.TrainingOperator.ManagementState).Should(Equal(operatorv1.Removed))
+	g.Expect(c.FeastOperator.ManagementState).Should(Equal(operatorv1.Removed))
+	g.Expect(c.LlamaStackOperator.ManagementState).Should(Equal(operatorv1.Removed))
+}
+
+func TestAdopt(t *testing.T) {
+	ctx := t.Context()
+
+	proposalKey := client.ObjectKey{Namespace: operatorNamespace, Name: kfdef.ProposalName}
+
+	t.Run("Does nothing without the KfDef CRD", func(t *testing.T) {
+		g := NewWithT(t)
+		cli := newClient(t, false)
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+		g.Expect(cli.Get(ctx, proposalKey, &corev1.ConfigMap{})).ShouldNot(Succeed())
+	})
+
+	t.Run("Does nothing without KfDef resources", func(t *testing.T) {
+		g := NewWithT(t)
+		cli := newClient(t, true)
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+		g.Expect(cli.Get(ctx, proposalKey, &corev1.ConfigMap{})).ShouldNot(Succeed())
+	})
+
+	t.Run("Proposes then creates the DataScienceCluster once approved", func(t *testing.T) {
+		g := NewWithT(t)
+		cli := newClient(t, true, newKfDef("opendatahub", "odh-dashboard", "data-science-pipelines-operator"))
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+
+		proposal := corev1.ConfigMap{}
+		g.Expect(cli.Get(ctx, proposalKey, &proposal)).Should(Succeed())
+		g.Expect(proposal.Data).Should(HaveKey(kfdef.ProposalKey))
+
+		// not approved yet
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+
+		dscs := dscv2.DataScienceClusterList{}
+		g.Expect(cli.List(ctx, &dscs)).Should(Succeed())
+		g.Expect(dscs.Items).Should(BeEmpty())
+
+		proposal.SetAnnotations(map[string]string{annotations.AdoptKfDef: "true"})
+		g.Expect(cli.Update(ctx, &proposal)).Should(Succeed())
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+
+		g.Expect(cli.List(ctx, &dscs)).Should(Succeed())
+		g.Expect(dscs.Items).Should(HaveLen(1))
+		g.Expect(dscs.Items[0].Spec.Components.Dashboard.ManagementState).Should(Equal(operatorv1.Managed))
+		g.Expect(dscs.Items[0].Spec.Components.AIPipelines.ManagementState).Should(Equal(operatorv1.Managed))
+		g.Expect(dscs.Items[0].Spec.Components.Workbenches.ManagementState).Should(Equal(operatorv1.Removed))
+	})
+
+	t.Run("Keeps the existing DataScienceCluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		proposal := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kfdef.ProposalName,
+				Namespace:   operatorNamespace,
+				Annotations: map[string]string{annotations.AdoptKfDef: "true"},
+			},
+			Data: map[string]string{kfdef.ProposalKey: "metadata:\n  name: proposed-dsc\n"},
+		}
+
+		existing := &dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}}
+
+		cli := newClient(t, true, newKfDef("opendatahub", "odh-dashboard"), proposal, existing)
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+
+		dscs := dscv2.DataScienceClusterList{}
+		g.Expect(cli.List(ctx, &dscs)).Should(Succeed())
+		g.Expect(dscs.Items).Should(HaveLen(1))
+		g.Expect(dscs.Items[0].Name).Should(Equal(existing.Name))
+	})
+
+	t.Run("Converts a v1 proposal without KfDef resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		proposal := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kfdef.ProposalName,
+				Namespace:   operatorNamespace,
+				Annotations: map[string]string{annotations.AdoptKfDef: "true"},
+			},
+			Data: map[string]string{kfdef.ProposalKey: `
+apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: legacy-dsc
+spec:
+  components:
+    datasciencepipelines:
+      managementState: Managed
+    modelmeshserving:
+      managementState: Managed
+    workbenches:
+      managementState: Removed
+`},
+		}
+
+		cli := newClient(t, false, proposal)
+
+		g.Expect(kfdef.Adopt(ctx, cli, operatorNamespace)).Should(Succeed())
+
+		dscs := dscv2.DataScienceClusterList{}
+		g.Expect(cli.List(ctx, &dscs)).Should(Succeed())
+		g.Expect(dscs.Items).Should(HaveLen(1))
+		g.Expect(dscs.Items[0].Name).Should(Equal("legacy-dsc"))
+		g.Expect(dscs.Items[0].Spec.Components.AIPipelines.ManagementState).Should(Equal(operatorv1.Managed))
+		g.Expect(dscs.Items[0].Spec.Components.Workbenches.ManagementState).Should(Equal(operatorv1.Removed))
+	})
+}
@@ -0,0 +1,120 @@
+// Package migration migrates the platform resources stored in an older version of their CRD to the
+// storage version of the CRD, so the older versions can be removed from the CRD status.storedVersions
+// and eventually from the CRD itself, without a manual intervention across several upgrades.
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// pageSize is the number of resources listed at once while migrating a CRD.
+const pageSize = 500
+
+// Migrate rewrites the resources of the opendatahub.io CRDs which store resources in versions other
+// than their storage version, then records the storage version as the only stored version. A CRD
+// that fails to migrate keeps its stored versions, so it is migrated again on the next start.
+func Migrate(ctx context.Context, cli client.Client) error {
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := cli.List(ctx, &crds); err != nil {
+		return fmt.Errorf("failed to list CRDs: %w", err)
+	}
+
+	var errs []error
+
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if !strings.HasSuffix(crd.Spec.Group, "opendatahub.io") {
+			continue
+		}
+
+		if err := migrate(ctx, cli, crd); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func migrate(ctx context.Context, cli client.Client, crd *apiextensionsv1.CustomResourceDefinition) error {
+	storage := storageVersion(crd)
+	if storage == "" || slices.Equal(crd.Status.StoredVersions, []string{storage}) {
+		return nil
+	}
+
+	l := logf.FromContext(ctx).WithValues("crd", crd.Name, "storedVersions", crd.Status.StoredVersions, "storageVersion", storage)
+	l.Info("migrating resources to the storage version")
+
+	migrated, err := rewrite(ctx, cli, schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storage,
+		Kind:    crd.Spec.Names.ListKind,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate the resources of %s to version %s: %w", crd.Name, storage, err)
+	}
+
+	crd.Status.StoredVersions = []string{storage}
+
+	if err := cli.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("failed to update the stored versions of %s: %w", crd.Name, err)
+	}
+
+	l.Info("migrated resources to the storage version", "resources", migrated)
+
+	return nil
+}
+
+// rewrite updates, without changes, all the resources of the given list kind, which is enough for
+// the API server to store them again in the storage version. It returns the number of resources
+// updated.
+func rewrite(ctx context.Context, cli client.Client, listKind schema.GroupVersionKind) (int, error) {
+	migrated := 0
+	next := ""
+
+	for {
+		items := unstructured.UnstructuredList{}
+		items.SetGroupVersionKind(listKind)
+
+		if err := cli.List(ctx, &items, client.Limit(pageSize), client.Continue(next)); err != nil {
+			return migrated, fmt.Errorf("failed to list resources: %w", err)
+		}
+
+		for i := range items.Items {
+			err := cli.Update(ctx, &items.Items[i])
+			switch {
+			case k8serr.IsNotFound(err), k8serr.IsConflict(err):
+				// deleted, or updated hence already stored in the storage version, meanwhile
+			case err != nil:
+				return migrated, fmt.Errorf("failed to update %s: %w", client.ObjectKeyFromObject(&items.Items[i]), err)
+			default:
+				migrated++
+			}
+		}
+
+		next = items.GetContinue()
+		if next == "" {
+			return migrated, nil
+		}
+	}
+}
+
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,77 @@
+package migration_test
+
+import (
+	"context"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/migration"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newCRD(name string, group string, kind string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:     kind,
+				ListKind: kind + "List",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true},
+				{Name: "v2", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: storedVersions,
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dscCRD := newCRD("datascienceclusters.datasciencecluster.opendatahub.io", "datasciencecluster.opendatahub.io", "DataScienceCluster", "v1", "v2")
+	otherCRD := newCRD("widgets.example.com", "example.com", "Widget", "v1", "v2")
+
+	dsc := &dscv2.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+	}
+
+	updated := make([]string, 0)
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(dscCRD, otherCRD, dsc),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				updated = append(updated, obj.GetName())
+				return cli.Update(ctx, obj, opts...)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(migration.Migrate(ctx, cli)).Should(Succeed())
+	g.Expect(updated).Should(ConsistOf(dsc.Name))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dscCRD), dscCRD)).Should(Succeed())
+	g.Expect(dscCRD.Status.StoredVersions).Should(Equal([]string{"v2"}))
+
+	// only the platform CRDs are migrated
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(otherCRD), otherCRD)).Should(Succeed())
+	g.Expect(otherCRD.Status.StoredVersions).Should(Equal([]string{"v1", "v2"}))
+
+	// migrated CRDs are not migrated again
+	updated = updated[:0]
+	g.Expect(migration.Migrate(ctx, cli)).Should(Succeed())
+	g.Expect(updated).Should(BeEmpty())
+}
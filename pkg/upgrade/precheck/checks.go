@@ -0,0 +1,111 @@
+package precheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster/constraints"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// DeprecatedAPIVersions reports the platform CRDs still storing resources in a version which is
+// deprecated or no longer served, as a later release may remove it.
+var DeprecatedAPIVersions = Check{
+	Name:     "DeprecatedAPIVersions",
+	Blocking: true,
+	Remediation: "The operator migrates the resources to the storage version of the CRD on start, " +
+		"check the operator logs for the migration failures and restart the operator once fixed",
+	Run: deprecatedAPIVersions,
+}
+
+// OrphanedResources reports the workloads of the platform left in the applications namespace
+// without owner, usually by an older release, which are no longer updated nor removed.
+var OrphanedResources = Check{
+	Name:        "OrphanedResources",
+	Remediation: "Delete the resources no longer deployed by the operator, or enable the component deploying them",
+	Run:         orphanedResources,
+}
+
+// IncompatibleComponents reports the DataScienceCluster components combinations the platform does
+// not support, e.g. created before the corresponding validation was introduced.
+var IncompatibleComponents = Check{
+	Name:        "IncompatibleComponents",
+	Blocking:    true,
+	Remediation: "Update the components of the DataScienceCluster as described",
+	Run:         incompatibleComponents,
+}
+
+func deprecatedAPIVersions(ctx context.Context, cli client.Client, _ *dsciv2.DSCInitialization) ([]string, error) {
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := cli.List(ctx, &crds); err != nil {
+		return nil, fmt.Errorf("failed to list CRDs: %w", err)
+	}
+
+	issues := make([]string, 0)
+
+	for _, crd := range crds.Items {
+		if !strings.HasSuffix(crd.Spec.Group, "opendatahub.io") {
+			continue
+		}
+
+		for _, stored := range crd.Status.StoredVersions {
+			idx := slices.IndexFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+				return v.Name == stored
+			})
+
+			if idx == -1 || !crd.Spec.Versions[idx].Served || crd.Spec.Versions[idx].Deprecated {
+				issues = append(issues, fmt.Sprintf("%s stored in deprecated version %s", crd.Name, stored))
+			}
+		}
+	}
+
+	slices.Sort(issues)
+
+	return issues, nil
+}
+
+func orphanedResources(ctx context.Context, cli client.Client, dsci *dsciv2.DSCInitialization) ([]string, error) {
+	if dsci.Spec.ApplicationsNamespace == "" {
+		return nil, nil
+	}
+
+	deployments := appsv1.DeploymentList{}
+
+	err := cli.List(ctx, &deployments, client.InNamespace(dsci.Spec.ApplicationsNamespace), client.HasLabels{labels.PlatformPartOf})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Deployments: %w", err)
+	}
+
+	issues := make([]string, 0)
+
+	for _, d := range deployments.Items {
+		if len(d.OwnerReferences) == 0 {
+			issues = append(issues, fmt.Sprintf("Deployment %s/%s", d.Namespace, d.Name))
+		}
+	}
+
+	slices.Sort(issues)
+
+	return issues, nil
+}
+
+func incompatibleComponents(ctx context.Context, cli client.Client, _ *dsciv2.DSCInitialization) ([]string, error) {
+	dsc, err := cluster.GetDSC(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return constraints.Violations(ctx, cli, dsc)
+}
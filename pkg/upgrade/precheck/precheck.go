@@ -0,0 +1,85 @@
+// Package precheck runs the upgrade preflight checks, detecting the cluster state an upgrade of the
+// platform may break, e.g. resources stored in a deprecated API version, so it can be fixed beforehand.
+package precheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+)
+
+// Check is an upgrade preflight check.
+type Check struct {
+	// Name identifies the check in the reported failures.
+	Name string
+	// Blocking checks hold the major version upgrades of the components while failing, when the
+	// DSCInitialization preflightPolicy is Block.
+	Blocking bool
+	// Remediation describes how to fix the issues found by the check.
+	Remediation string
+	// Run returns the issues found, the check passes when there are none.
+	Run func(ctx context.Context, cli client.Client, dsci *dsciv2.DSCInitialization) ([]string, error)
+}
+
+// Failure is the result of a failed Check.
+type Failure struct {
+	Name        string
+	Blocking    bool
+	Issues      []string
+	Remediation string
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("%s: %s. %s", f.Name, strings.Join(f.Issues, ", "), f.Remediation)
+}
+
+// DefaultChecks are the checks run by the DSCInitialization controller.
+var DefaultChecks = []Check{
+	DeprecatedAPIVersions,
+	OrphanedResources,
+	IncompatibleComponents,
+}
+
+// Run runs the given checks and returns the failed ones. A check that can not be run does not
+// prevent the others from running, the errors are returned joined.
+func Run(ctx context.Context, cli client.Client, dsci *dsciv2.DSCInitialization, checks ...Check) ([]Failure, error) {
+	failures := make([]Failure, 0)
+	errs := make([]error, 0)
+
+	for _, check := range checks {
+		issues, err := check.Run(ctx, cli, dsci)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("preflight check %s failed to run: %w", check.Name, err))
+			continue
+		}
+
+		if len(issues) == 0 {
+			continue
+		}
+
+		failures = append(failures, Failure{
+			Name:        check.Name,
+			Blocking:    check.Blocking,
+			Issues:      issues,
+			Remediation: check.Remediation,
+		})
+	}
+
+	return failures, errors.Join(errs...)
+}
+
+// Blocking reports whether one of the given failures is blocking.
+func Blocking(failures []Failure) bool {
+	for _, f := range failures {
+		if f.Blocking {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+package precheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/precheck"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDefaultChecks(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "datascienceclusters.datasciencecluster.opendatahub.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "datasciencecluster.opendatahub.io",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true, Deprecated: true},
+				{Name: "v2", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1", "v2"},
+		},
+	}
+
+	orphan := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "orphan",
+			Namespace: "opendatahub",
+			Labels:    map[string]string{labels.PlatformPartOf: "dashboard"},
+		},
+	}
+
+	owned := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owned",
+			Namespace: "opendatahub",
+			Labels:    map[string]string{labels.PlatformPartOf: "dashboard"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Dashboard", Name: "default-dashboard", UID: "uid"},
+			},
+		},
+	}
+
+	dsc := &dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}}
+	dsc.Spec.Components.ModelRegistry.ManagementState = operatorv1.Managed
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(crd, orphan, owned, dsc))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	failures, err := precheck.Run(ctx, cli, dsci, precheck.DefaultChecks...)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(failures).Should(HaveExactElements(
+		And(
+			HaveField("Name", precheck.DeprecatedAPIVersions.Name),
+			HaveField("Issues", ConsistOf(ContainSubstring("datascienceclusters.datasciencecluster.opendatahub.io stored in deprecated version v1"))),
+		),
+		And(
+			HaveField("Name", precheck.OrphanedResources.Name),
+			HaveField("Issues", ConsistOf("Deployment opendatahub/orphan")),
+		),
+		And(
+			HaveField("Name", precheck.IncompatibleComponents.Name),
+			HaveField("Issues", ConsistOf(ContainSubstring("modelregistry"))),
+		),
+	))
+	g.Expect(precheck.Blocking(failures)).Should(BeTrue())
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	passing := precheck.Check{
+		Name: "Passing",
+		Run: func(context.Context, client.Client, *dsciv2.DSCInitialization) ([]string, error) {
+			return nil, nil
+		},
+	}
+	warning := precheck.Check{
+		Name:        "Warning",
+		Remediation: "Fix it",
+		Run: func(context.Context, client.Client, *dsciv2.DSCInitialization) ([]string, error) {
+			return []string{"issue"}, nil
+		},
+	}
+	broken := precheck.Check{
+		Name:     "Broken",
+		Blocking: true,
+		Run: func(context.Context, client.Client, *dsciv2.DSCInitialization) ([]string, error) {
+			return nil, errors.New("boom")
+		},
+	}
+
+	failures, err := precheck.Run(ctx, cli, &dsciv2.DSCInitialization{}, passing, broken, warning)
+	g.Expect(err).Should(MatchError(ContainSubstring("preflight check Broken failed to run: boom")))
+	g.Expect(failures).Should(HaveLen(1))
+	g.Expect(failures[0].String()).Should(Equal("Warning: issue. Fix it"))
+	g.Expect(precheck.Blocking(failures)).Should(BeFalse())
+}
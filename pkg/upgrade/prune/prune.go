@@ -0,0 +1,176 @@
+// Package prune deletes, on upgrade, the resources deployed by previous releases of the operator and
+// obsoleted by newer ones, as listed in a declarative deprecation manifest.
+package prune
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/blang/semver/v4"
+	"github.com/hashicorp/go-multierror"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed deprecations.yaml
+var deprecations []byte
+
+// Manifest lists the resources obsoleted by the releases of the operator.
+type Manifest struct {
+	Deprecations []Deprecation `json:"deprecations"`
+}
+
+// Deprecation lists the resources the given release stopped deploying.
+type Deprecation struct {
+	Release   string     `json:"release"`
+	Resources []Resource `json:"resources"`
+
+	version semver.Version
+}
+
+// Resource selects, in all namespaces, the resources of the given kind matching the label selector
+// and, when set, one of the names.
+type Resource struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Selector   string   `json:"selector"`
+	Names      []string `json:"names,omitempty"`
+	Reason     string   `json:"reason,omitempty"`
+
+	selector labels.Selector
+}
+
+// GroupVersionKind returns the GroupVersionKind of the selected resources.
+func (r *Resource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(r.APIVersion, r.Kind)
+}
+
+// Load parses and validates the given deprecation manifest. The label selector of the resources is
+// required so that the resources created by the users are never selected by kind alone.
+func Load(content []byte) ([]Deprecation, error) {
+	m := Manifest{}
+	if err := yaml.UnmarshalStrict(content, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse the deprecation manifest: %w", err)
+	}
+
+	for i := range m.Deprecations {
+		d := &m.Deprecations[i]
+
+		v, err := semver.ParseTolerant(d.Release)
+		if err != nil {
+			return nil, fmt.Errorf("invalid release %q: %w", d.Release, err)
+		}
+
+		d.version = v
+
+		for j := range d.Resources {
+			r := &d.Resources[j]
+
+			if r.APIVersion == "" || r.Kind == "" {
+				return nil, fmt.Errorf("release %s: apiVersion and kind are required", d.Release)
+			}
+
+			if r.Selector == "" {
+				return nil, fmt.Errorf("release %s: %s has no label selector", d.Release, r.Kind)
+			}
+
+			s, err := labels.Parse(r.Selector)
+			if err != nil {
+				return nil, fmt.Errorf("release %s: invalid label selector %q of %s: %w", d.Release, r.Selector, r.Kind, err)
+			}
+
+			r.selector = s
+		}
+	}
+
+	return m.Deprecations, nil
+}
+
+// Default returns the deprecations of the manifest embedded in the operator.
+func Default() ([]Deprecation, error) {
+	return Load(deprecations)
+}
+
+// Prune deletes the resources obsoleted by the releases newer than from, up to and including to, and
+// returns the number of resources deleted. When dryRun is set, the resources are only logged. The
+// kinds the cluster does not serve are skipped.
+func Prune(ctx context.Context, cli client.Client, from semver.Version, to semver.Version, dryRun bool, deprecations []Deprecation) (int, error) {
+	var multiErr *multierror.Error
+
+	count := 0
+
+	for _, d := range deprecations {
+		if d.version.LTE(from) || d.version.GT(to) {
+			continue
+		}
+
+		for _, r := range d.Resources {
+			n, err := prune(ctx, cli, d.Release, r, dryRun)
+			count += n
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
+	return count, multiErr.ErrorOrNil()
+}
+
+func prune(ctx context.Context, cli client.Client, release string, r Resource, dryRun bool) (int, error) {
+	log := logf.FromContext(ctx).WithValues("release", release, "kind", r.Kind, "reason", r.Reason)
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(r.GroupVersionKind())
+
+	err := cli.List(ctx, &items, client.MatchingLabelsSelector{Selector: r.selector})
+	switch {
+	case meta.IsNoMatchError(err):
+		log.V(1).Info("kind not served, nothing to prune")
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("failed to list %s: %w", r.Kind, err)
+	}
+
+	var multiErr *multierror.Error
+
+	count := 0
+
+	for i := range items.Items {
+		item := &items.Items[i]
+
+		if len(r.Names) != 0 && !slices.Contains(r.Names, item.GetName()) {
+			continue
+		}
+
+		if dryRun {
+			log.Info("dry-run: would prune obsolete resource", "namespace", item.GetNamespace(), "name", item.GetName())
+			PrunedTotal.WithLabelValues(r.Kind, strconv.FormatBool(dryRun)).Inc()
+			count++
+
+			continue
+		}
+
+		err := cli.Delete(ctx, item, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		switch {
+		case k8serr.IsNotFound(err):
+			continue
+		case err != nil:
+			multiErr = multierror.Append(multiErr, fmt.Errorf("failed to delete %s %s/%s: %w", r.Kind, item.GetNamespace(), item.GetName(), err))
+			continue
+		}
+
+		log.Info("pruned obsolete resource", "namespace", item.GetNamespace(), "name", item.GetName())
+		PrunedTotal.WithLabelValues(r.Kind, strconv.FormatBool(dryRun)).Inc()
+		count++
+	}
+
+	return count, multiErr.ErrorOrNil()
+}
@@ -0,0 +1,31 @@
+package prune
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// PrunedTotal is a prometheus counter metrics which holds the total number
+	// of obsolete resources pruned on upgrade. It has two labels.
+	// kind label refers to the kind of the resource.
+	// dry_run label tells whether the resource was only logged.
+	PrunedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "upgrade_pruned_resources_total",
+			Help: "Number of obsolete resources pruned on upgrade",
+		},
+		[]string{
+			"kind",
+			"dry_run",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(PrunedTotal)
+}
@@ -0,0 +1,145 @@
+package prune_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/prune"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+const manifest = `
+deprecations:
+  - release: 2.5.0
+    resources:
+      - apiVersion: apps/v1
+        kind: Deployment
+        selector: platform.opendatahub.io/part-of=legacy
+        reason: removed component
+  - release: 3.0.0
+    resources:
+      - apiVersion: v1
+        kind: ConfigMap
+        selector: platform.opendatahub.io/part-of=dashboard
+        names: [dashboard-config]
+        reason: renamed to odh-dashboard-config
+  - release: 3.2.0
+    resources:
+      - apiVersion: v1
+        kind: Service
+        selector: platform.opendatahub.io/part-of=dashboard
+  - release: 3.0.0
+    resources:
+      - apiVersion: example.opendatahub.io/v1
+        kind: Unknown
+        selector: platform.opendatahub.io/part-of=dashboard
+`
+
+func newObjects() []client.Object {
+	meta := func(name string, partOf string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "opendatahub",
+			Labels:    map[string]string{"platform.opendatahub.io/part-of": partOf},
+		}
+	}
+
+	return []client.Object{
+		&appsv1.Deployment{ObjectMeta: meta("legacy-controller", "legacy")},
+		&corev1.ConfigMap{ObjectMeta: meta("dashboard-config", "dashboard")},
+		&corev1.ConfigMap{ObjectMeta: meta("odh-dashboard-config", "dashboard")},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "dashboard-config", Namespace: "user"}},
+		&corev1.Service{ObjectMeta: meta("odh-dashboard", "dashboard")},
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		dryRun  bool
+		count   int
+		deleted []string
+	}{
+		{
+			name:    "upgrade across releases",
+			from:    "2.4.0",
+			count:   2,
+			deleted: []string{"legacy-controller", "dashboard-config"},
+		},
+		{
+			name:    "upgrade from the release of a deprecation",
+			from:    "2.5.0",
+			count:   1,
+			deleted: []string{"dashboard-config"},
+		},
+		{
+			name:   "dry run",
+			from:   "2.4.0",
+			dryRun: true,
+			count:  2,
+		},
+		{
+			name: "same release",
+			from: "3.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			deprecations, err := prune.Load([]byte(manifest))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			cli, err := fakeclient.New(fakeclient.WithObjects(newObjects()...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			count, err := prune.Prune(ctx, cli, semver.MustParse(tt.from), semver.MustParse("3.0.0"), tt.dryRun, deprecations)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(count).Should(Equal(tt.count))
+
+			for _, obj := range newObjects() {
+				err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+				if obj.GetNamespace() == "opendatahub" && slices.Contains(tt.deleted, obj.GetName()) {
+					g.Expect(k8serr.IsNotFound(err)).Should(BeTrue(), "%s should have been pruned", obj.GetName())
+				} else {
+					g.Expect(err).ShouldNot(HaveOccurred(), "%s should have been kept", obj.GetName())
+				}
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := prune.Default()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	_, err = prune.Load([]byte(`
+deprecations:
+  - release: 3.0.0
+    resources:
+      - apiVersion: v1
+        kind: ConfigMap
+`))
+	g.Expect(err).Should(MatchError(ContainSubstring("no label selector")))
+
+	_, err = prune.Load([]byte(`
+deprecations:
+  - release: next
+    resources: []
+`))
+	g.Expect(err).Should(MatchError(ContainSubstring("invalid release")))
+}
@@ -3,7 +3,6 @@ package upgrade
 import (
 	"context"
 	"fmt"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
@@ -15,6 +14,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
@@ -25,46 +25,68 @@ const (
 	DeleteConfigMapLabel = "api.openshift.com/addon-managed-odh-delete"
 )
 
-// OperatorUninstall deletes all the externally generated resources.
-// This includes DSCI, namespace created by operator (but not workbench or MR's), subscription and CSV.
-func OperatorUninstall(ctx context.Context, cli client.Client, platform common.Platform) error {
-	log := logf.FromContext(ctx)
+// RemoveDSC deletes the DataScienceClusters, it returns true once they are removed, i.e. once
+// the components they enabled have been removed.
+func RemoveDSC(ctx context.Context, cli client.Client) (bool, error) {
+	if err := cli.DeleteAllOf(ctx, &dscv2.DataScienceCluster{}, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+		return false, fmt.Errorf("failure deleting DSC: %w", err)
+	}
 
-	if err := removeDSC(ctx, cli); err != nil {
-		return err
+	instances := &dscv2.DataScienceClusterList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return false, fmt.Errorf("error getting DSC: %w", err)
 	}
 
-	if err := removeDSCI(ctx, cli); err != nil {
-		return err
+	return len(instances.Items) == 0, nil
+}
+
+// RemoveDSCI deletes the DSCInitializations, it returns true once they are removed, i.e. once
+// the platform services they enabled have been removed.
+func RemoveDSCI(ctx context.Context, cli client.Client) (bool, error) {
+	if err := cli.DeleteAllOf(ctx, &dsciv2.DSCInitialization{}, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+		return false, fmt.Errorf("failure deleting DSCI: %w", err)
 	}
 
-	// Delete generated namespaces by the operator
+	instances := &dsciv2.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return false, fmt.Errorf("error getting DSCI: %w", err)
+	}
+
+	return len(instances.Items) == 0, nil
+}
+
+// RemoveGeneratedNamespaces deletes the namespaces created by the operator (but not workbench or
+// MR's), it returns true once they are removed.
+func RemoveGeneratedNamespaces(ctx context.Context, cli client.Client) (bool, error) {
+	log := logf.FromContext(ctx)
+
 	generatedNamespaces := &corev1.NamespaceList{}
 	nsOptions := []client.ListOption{
 		client.MatchingLabels{labels.ODH.OwnedNamespace: "true"},
 	}
 	if err := cli.List(ctx, generatedNamespaces, nsOptions...); err != nil {
-		return fmt.Errorf("error getting generated namespaces : %w", err)
+		return false, fmt.Errorf("error getting generated namespaces : %w", err)
 	}
 
-	// Return if any one of the namespaces is Terminating due to resources that are in process of deletion. (e.g. CRDs)
+	// Namespaces already Terminating are waited for, e.g. while their resources are deleted
 	for _, namespace := range generatedNamespaces.Items {
 		if namespace.Status.Phase == corev1.NamespaceTerminating {
-			return fmt.Errorf("waiting for namespace %v to be deleted", namespace.Name)
+			continue
 		}
-	}
 
-	for _, namespace := range generatedNamespaces.Items {
-		if namespace.Status.Phase == corev1.NamespaceActive {
-			if err := cli.Delete(ctx, &namespace); err != nil {
-				return fmt.Errorf("error deleting namespace %v: %w", namespace.Name, err)
-			}
-			log.Info("Namespace deleted as a part of uninstallation", "namespace", namespace.Name)
+		if err := cli.Delete(ctx, &namespace); err != nil && !k8serr.IsNotFound(err) {
+			return false, fmt.Errorf("error deleting namespace %v: %w", namespace.Name, err)
 		}
+		log.Info("Namespace deleted as a part of uninstallation", "namespace", namespace.Name)
 	}
 
-	// give enough time for namespace deletion before proceed
-	time.Sleep(10 * time.Second)
+	return len(generatedNamespaces.Items) == 0, nil
+}
+
+// RemoveOperator deletes the operator subscription and CSV, which in turn removes the operator
+// deployment.
+func RemoveOperator(ctx context.Context, cli client.Client, platform common.Platform) error {
+	log := logf.FromContext(ctx)
 
 	// We can only assume the subscription is using standard names
 	// if user install by creating different named subs, then we will not know the name
@@ -86,30 +108,18 @@ func OperatorUninstall(ctx context.Context, cli client.Client, platform common.P
 	}
 
 	log.Info("Removing the operator CSV in turn remove operator deployment")
-	err = removeCSV(ctx, cli)
-
-	log.Info("All resources deleted as part of uninstall.")
-	return err
+	return removeCSV(ctx, cli)
 }
 
-func removeDSCI(ctx context.Context, cli client.Client) error {
-	instance := &dsciv2.DSCInitialization{}
-
-	if err := cli.DeleteAllOf(ctx, instance, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
-		return fmt.Errorf("failure deleting DSCI: %w", err)
+// IsUninstalling returns true if the uninstallation of the platform has been requested, either by
+// an Uninstall or by the delete configMap.
+func IsUninstalling(ctx context.Context, cli client.Client) bool {
+	instances := &serviceApi.UninstallList{}
+	if err := cli.List(ctx, instances); err == nil && len(instances.Items) != 0 {
+		return true
 	}
 
-	return nil
-}
-
-func removeDSC(ctx context.Context, cli client.Client) error {
-	instance := &dscv2.DataScienceCluster{}
-
-	if err := cli.DeleteAllOf(ctx, instance, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
-		return fmt.Errorf("failure deleting DSC: %w", err)
-	}
-
-	return nil
+	return HasDeleteConfigMap(ctx, cli)
 }
 
 // HasDeleteConfigMap returns true if delete configMap is added to the operator namespace by managed-tenants repo.
@@ -38,6 +38,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade/prune"
 )
 
 type ResourceSpec struct {
@@ -277,6 +278,8 @@ func CleanupExistingResource(ctx context.Context,
 	multiErr = multierror.Append(multiErr, cleanupModelControllerLegacyDeployment(ctx, cli, d.Spec.ApplicationsNamespace))
 	// cleanup deprecated kueue ValidatingAdmissionPolicyBinding
 	multiErr = multierror.Append(multiErr, cleanupDeprecatedKueueVAPB(ctx, cli))
+	// prune the resources obsoleted by the releases upgraded across
+	multiErr = multierror.Append(multiErr, pruneObsoleteResources(ctx, cli, oldReleaseVersion))
 
 	// HardwareProfile migration as described in RHOAIENG-33158 and RHOAIENG-33159
 	// This includes creating HardwareProfile resources and updating annotations on Notebooks and InferenceServices
@@ -637,6 +640,45 @@ func cleanupModelControllerLegacyDeployment(ctx context.Context, cli client.Clie
 	return nil
 }
 
+// pruneObsoleteResources deletes the resources listed by the deprecation manifest for the releases
+// newer than the given one, following the garbage collection policy of the DataScienceCluster:
+// DryRun only logs them, Disabled keeps them.
+func pruneObsoleteResources(ctx context.Context, cli client.Client, oldReleaseVersion common.Release) error {
+	policy := common.GCPolicyEnabled
+
+	dsc, err := cluster.GetDSC(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+		break
+	case err != nil:
+		return err
+	case dsc.Spec.GCPolicy != "":
+		policy = dsc.Spec.GCPolicy
+	}
+
+	if policy == common.GCPolicyDisabled {
+		return nil
+	}
+
+	deprecations, err := prune.Default()
+	if err != nil {
+		return err
+	}
+
+	count, err := prune.Prune(ctx, cli,
+		oldReleaseVersion.Version.Version,
+		cluster.GetRelease().Version.Version,
+		policy == common.GCPolicyDryRun,
+		deprecations,
+	)
+
+	if count != 0 {
+		logf.FromContext(ctx).Info("pruned obsolete resources", "count", count, "policy", policy)
+	}
+
+	return err
+}
+
 // cleanupDeprecatedKueueVAPB removes the deprecated ValidatingAdmissionPolicyBinding
 // that was used in previous versions of Kueue but is no longer needed.
 // TODO: Remove this cleanup function in a future release when upgrading from versions
@@ -31,6 +31,60 @@ func AddOperatorFlagsAndEnvvars(envvarPrefix string) error {
 		return err
 	}
 
+	// manifests bundle flags, to load the component manifests in disconnected installations
+	pflag.String("manifests-source", "", "Source of the component manifests ('', volume, oci), default to '' for the manifests of the operator image")
+	if err := viper.BindEnv("manifests-source", envvarPrefix+"_MANIFESTS_SOURCE"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-path", "", "Path of the manifests bundle archive, for the volume source")
+	if err := viper.BindEnv("manifests-bundle-path", envvarPrefix+"_MANIFESTS_BUNDLE_PATH"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-image", "", "Reference of the OCI artifact holding the manifests bundle, for the oci source")
+	if err := viper.BindEnv("manifests-bundle-image", envvarPrefix+"_MANIFESTS_BUNDLE_IMAGE"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-pull-secret", "", "Path of a docker config json file with the credentials of the registry, for the oci source")
+	if err := viper.BindEnv("manifests-bundle-pull-secret", envvarPrefix+"_MANIFESTS_BUNDLE_PULL_SECRET"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-checksum", "", "Expected checksum of the manifests bundle archive, as sha256:<hex>")
+	if err := viper.BindEnv("manifests-bundle-checksum", envvarPrefix+"_MANIFESTS_BUNDLE_CHECKSUM"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-ca-file", "", "Path of a PEM file with the CA certificates the registry is trusted with, for the oci source")
+	if err := viper.BindEnv("manifests-bundle-ca-file", envvarPrefix+"_MANIFESTS_BUNDLE_CA_FILE"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-cache-dir", "", "Directory the pulled manifests bundles are kept in, and loaded from when available, for the oci source")
+	if err := viper.BindEnv("manifests-bundle-cache-dir", envvarPrefix+"_MANIFESTS_BUNDLE_CACHE_DIR"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-public-key", "", "Path of a PEM file with the public key the manifests bundle archive is signed with, requiring a valid signature")
+	if err := viper.BindEnv("manifests-bundle-public-key", envvarPrefix+"_MANIFESTS_BUNDLE_PUBLIC_KEY"); err != nil {
+		return err
+	}
+	pflag.String("manifests-bundle-signature", "", "Path of the signature of the manifests bundle archive, as produced by cosign sign-blob --key")
+	if err := viper.BindEnv("manifests-bundle-signature", envvarPrefix+"_MANIFESTS_BUNDLE_SIGNATURE"); err != nil {
+		return err
+	}
+
+	// webhook certificates flag, for the installs whose webhook certificates are not provided by OLM or the service CA
+	pflag.Bool("manage-webhook-certs", false, "Generate and rotate the webhook serving certificates and inject their CA into the webhooks")
+	if err := viper.BindEnv("manage-webhook-certs", envvarPrefix+"_MANAGE_WEBHOOK_CERTS"); err != nil {
+		return err
+	}
+
+	// sharding flags, to split the component controllers across several operator replicas on large clusters
+	pflag.Int("shard-count", 1, "Number of shards the component controllers are split across, each run by the replicas of one shard")
+	if err := viper.BindEnv("shard-count", envvarPrefix+"_SHARD_COUNT"); err != nil {
+		return err
+	}
+	pflag.Int("shard-index", 0, "Shard run by the replica, from 0 to shard-count - 1, the shard 0 also running the platform controllers and webhooks")
+	if err := viper.BindEnv("shard-index", envvarPrefix+"_SHARD_INDEX"); err != nil {
+		return err
+	}
+
 	// zap logging flags
 	// these are taken from https://github.com/kubernetes-sigs/controller-runtime/blob/4161b012d114e6c1ea861fd8afcebf7ba2417b49/pkg/log/zap/zap.go#L255
 	// and need to be kept in sync.
@@ -0,0 +1,512 @@
+package template
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	gt "text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// registry holds the functions available to the text templates, keyed by name.
+var registry = struct {
+	sync.RWMutex
+	funcs gt.FuncMap
+}{
+	funcs: gt.FuncMap{},
+}
+
+//nolint:gochecknoinits
+func init() {
+	MustRegister(builtinFuncs())
+	MustRegister(sprigFuncs())
+}
+
+// Register adds the given functions to the ones available to the text templates. It fails if a
+// function is already registered with one of the given names, so a helper never silently
+// replaces another one the templates rely on.
+func Register(funcs gt.FuncMap) error {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for name, fn := range funcs {
+		if _, ok := registry.funcs[name]; ok {
+			return fmt.Errorf("template function %q already registered", name)
+		}
+
+		if fn == nil || reflect.TypeOf(fn).Kind() != reflect.Func {
+			return fmt.Errorf("template function %q is not a function", name)
+		}
+	}
+
+	maps.Copy(registry.funcs, funcs)
+
+	return nil
+}
+
+// MustRegister is like Register but panics if a function cannot be registered.
+func MustRegister(funcs gt.FuncMap) {
+	if err := Register(funcs); err != nil {
+		panic(err)
+	}
+}
+
+// Funcs returns the names of the functions available to the text templates, sorted.
+func Funcs() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return slices.Sorted(maps.Keys(registry.funcs))
+}
+
+// New returns a text template with the registered functions, whose execution fails on a missing
+// map key instead of rendering "<no value>", so a template bug fails the rendering with the
+// name and line of the faulty action rather than producing an invalid resource.
+func New(name string) *gt.Template {
+	return gt.New(name).Option("missingkey=error").Funcs(TextTemplateFuncMap())
+}
+
+func builtinFuncs() gt.FuncMap {
+	return gt.FuncMap{
+		"indent": Indent,
+		"nindent": func(spaces int, s string) string {
+			if s == "" {
+				return ""
+			}
+			return "\n" + Indent(spaces, s)
+		},
+		"toYaml": func(v any) (string, error) {
+			b, err := yaml.Marshal(v)
+			return string(b), err
+		},
+	}
+}
+
+// sprigFuncs returns the subset of the sprig functions (https://masterminds.github.io/sprig/)
+// the templates use, with the same names and argument order, so the templates read as the Helm
+// charts they are often ported from. Unlike sprig, the functions fail on invalid arguments
+// instead of rendering a zero value.
+func sprigFuncs() gt.FuncMap {
+	return gt.FuncMap{
+		// defaults and flow control
+		"default":  defaultValue,
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary":  ternary,
+		"required": required,
+		"fail":     func(msg string) (string, error) { return "", errors.New(msg) },
+
+		// strings
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix string, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix string, s string) string { return strings.TrimSuffix(s, suffix) },
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"replace":    func(old string, replacement string, s string) string { return strings.ReplaceAll(s, old, replacement) },
+		"contains":   func(substr string, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix string, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix string, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"trunc":      trunc,
+		"quote":      func(v ...any) string { return quote(strconv.Quote, v) },
+		"squote":     func(v ...any) string { return quote(func(s string) string { return "'" + s + "'" }, v) },
+		"join":       join,
+		"splitList":  func(sep string, s string) []string { return strings.Split(s, sep) },
+
+		// lists
+		"list":      func(v ...any) []any { return v },
+		"first":     first,
+		"last":      last,
+		"has":       has,
+		"uniq":      uniq,
+		"sortAlpha": sortAlpha,
+		"append":    appendList,
+
+		// dictionaries
+		"dict":   dict,
+		"get":    func(d map[string]any, key string) any { return d[key] },
+		"set":    set,
+		"hasKey": hasKey,
+		"keys":   keys,
+		"merge":  merge,
+
+		// encoding
+		"toJson":       toJSON,
+		"toPrettyJson": toPrettyJSON,
+		"b64enc":       func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":       b64dec,
+		"sha256sum":    sha256sum,
+
+		// numbers and conversions
+		"add":      add,
+		"sub":      func(a any, b any) (int64, error) { return arith(a, b, func(x, y int64) int64 { return x - y }) },
+		"mul":      mul,
+		"div":      div,
+		"max":      func(a any, v ...any) (int64, error) { return fold(a, v, func(x, y int64) int64 { return max(x, y) }) },
+		"min":      func(a any, v ...any) (int64, error) { return fold(a, v, func(x, y int64) int64 { return min(x, y) }) },
+		"int":      toInt,
+		"int64":    toInt64,
+		"atoi":     strconv.Atoi,
+		"toString": toString,
+	}
+}
+
+// empty returns whether the given value is the zero value of its type, or an empty collection.
+func empty(v any) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return rv.IsZero()
+	}
+}
+
+func defaultValue(d any, given ...any) any {
+	if len(given) == 0 || empty(given[0]) {
+		return d
+	}
+
+	return given[0]
+}
+
+func coalesce(v ...any) any {
+	for _, val := range v {
+		if !empty(val) {
+			return val
+		}
+	}
+
+	return nil
+}
+
+func ternary(vt any, vf any, cond bool) any {
+	if cond {
+		return vt
+	}
+
+	return vf
+}
+
+func required(msg string, v any) (any, error) {
+	if empty(v) {
+		return nil, errors.New(msg)
+	}
+
+	return v, nil
+}
+
+func trunc(c int, s string) string {
+	switch {
+	case c < 0 && len(s)+c > 0:
+		return s[len(s)+c:]
+	case c >= 0 && len(s) > c:
+		return s[:c]
+	default:
+		return s
+	}
+}
+
+func quote(fn func(string) string, v []any) string {
+	out := make([]string, 0, len(v))
+
+	for _, val := range v {
+		if val != nil {
+			out = append(out, fn(toString(val)))
+		}
+	}
+
+	return strings.Join(out, " ")
+}
+
+func toString(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	case error:
+		return s.Error()
+	case fmt.Stringer:
+		return s.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// toList returns the elements of the given slice or array.
+func toList(v any) ([]any, error) {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		l := make([]any, rv.Len())
+		for i := range l {
+			l[i] = rv.Index(i).Interface()
+		}
+
+		return l, nil
+	default:
+		return nil, fmt.Errorf("cannot iterate over type %T", v)
+	}
+}
+
+func join(sep string, v any) (string, error) {
+	l, err := toList(v)
+	if err != nil {
+		return "", err
+	}
+
+	out := make([]string, 0, len(l))
+	for _, val := range l {
+		if val != nil {
+			out = append(out, toString(val))
+		}
+	}
+
+	return strings.Join(out, sep), nil
+}
+
+func first(v any) (any, error) {
+	l, err := toList(v)
+	if err != nil || len(l) == 0 {
+		return nil, err
+	}
+
+	return l[0], nil
+}
+
+func last(v any) (any, error) {
+	l, err := toList(v)
+	if err != nil || len(l) == 0 {
+		return nil, err
+	}
+
+	return l[len(l)-1], nil
+}
+
+func has(needle any, haystack any) (bool, error) {
+	if haystack == nil {
+		return false, nil
+	}
+
+	l, err := toList(haystack)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(l, func(v any) bool { return reflect.DeepEqual(v, needle) }), nil
+}
+
+func uniq(v any) ([]any, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]any, 0, len(l))
+	for _, val := range l {
+		if !slices.ContainsFunc(out, func(o any) bool { return reflect.DeepEqual(o, val) }) {
+			out = append(out, val)
+		}
+	}
+
+	return out, nil
+}
+
+func sortAlpha(v any) ([]string, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(l))
+	for i, val := range l {
+		out[i] = toString(val)
+	}
+
+	slices.Sort(out)
+
+	return out, nil
+}
+
+func appendList(v any, elem any) ([]any, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(l, elem), nil
+}
+
+func dict(v ...any) (map[string]any, error) {
+	if len(v)%2 != 0 {
+		return nil, fmt.Errorf("dict requires an even number of arguments, got %d", len(v))
+	}
+
+	d := make(map[string]any, len(v)/2)
+	for i := 0; i < len(v); i += 2 {
+		d[toString(v[i])] = v[i+1]
+	}
+
+	return d, nil
+}
+
+// keys returns the keys of the given dictionaries, sorted so the rendering is deterministic.
+func keys(dicts ...map[string]any) []string {
+	out := make([]string, 0)
+	for _, d := range dicts {
+		out = append(out, slices.Collect(maps.Keys(d))...)
+	}
+
+	slices.Sort(out)
+
+	return out
+}
+
+// merge merges the given source dictionaries into the destination one, recursively, the values
+// of the destination taking precedence over the ones of the sources.
+func merge(dst map[string]any, srcs ...map[string]any) map[string]any {
+	for _, src := range srcs {
+		for k, sv := range src {
+			dv, ok := dst[k]
+			if !ok {
+				dst[k] = sv
+				continue
+			}
+
+			dm, dok := dv.(map[string]any)
+			sm, sok := sv.(map[string]any)
+			if dok && sok {
+				dst[k] = merge(dm, sm)
+			}
+		}
+	}
+
+	return dst
+}
+
+func set(d map[string]any, key string, v any) map[string]any {
+	d[key] = v
+
+	return d
+}
+
+func hasKey(d map[string]any, key string) bool {
+	_, ok := d[key]
+
+	return ok
+}
+
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func toPrettyJSON(v any) (string, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	return string(b), err
+}
+
+func b64dec(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	return string(b), err
+}
+
+func sha256sum(s string) string {
+	h := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(h[:])
+}
+
+func toInt(v any) (int, error) {
+	i, err := toInt64(v)
+
+	return int(i), err
+}
+
+func toInt64(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil //nolint:gosec
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), nil
+	case reflect.String:
+		return strconv.ParseInt(rv.String(), 10, 64)
+	default:
+		return 0, fmt.Errorf("cannot convert type %T to a number", v)
+	}
+}
+
+func arith(a any, b any, fn func(int64, int64) int64) (int64, error) {
+	x, err := toInt64(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := toInt64(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return fn(x, y), nil
+}
+
+func fold(a any, v []any, fn func(int64, int64) int64) (int64, error) {
+	acc, err := toInt64(a)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range v {
+		acc, err = arith(acc, val, fn)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return acc, nil
+}
+
+func add(v ...any) (int64, error) {
+	return fold(int64(0), v, func(x, y int64) int64 { return x + y })
+}
+
+func mul(a any, v ...any) (int64, error) {
+	return fold(a, v, func(x, y int64) int64 { return x * y })
+}
+
+func div(a any, b any) (int64, error) {
+	y, err := toInt64(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if y == 0 {
+		return 0, errors.New("division by zero")
+	}
+
+	return arith(a, y, func(x, y int64) int64 { return x / y })
+}
@@ -2,10 +2,9 @@ package template
 
 import (
 	"html/template"
+	"maps"
 	"strings"
 	gt "text/template"
-
-	"sigs.k8s.io/yaml"
 )
 
 // Indent adds the specified number of spaces to each line of the input string.
@@ -30,19 +29,11 @@ func HTMLTemplateFuncMap() template.FuncMap {
 	}
 }
 
-// TextTemplateFuncMap returns a map of custom template functions for text/template.
+// TextTemplateFuncMap returns the functions registered for text/template, i.e. indent, nindent,
+// toYaml, the supported sprig functions and the ones added with Register.
 func TextTemplateFuncMap() gt.FuncMap {
-	return gt.FuncMap{
-		"indent": Indent,
-		"nindent": func(spaces int, s string) string {
-			if s == "" {
-				return ""
-			}
-			return "\n" + Indent(spaces, s)
-		},
-		"toYaml": func(v any) (string, error) {
-			b, err := yaml.Marshal(v)
-			return string(b), err
-		},
-	}
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return maps.Clone(registry.funcs)
 }
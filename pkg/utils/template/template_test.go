@@ -0,0 +1,112 @@
+package template_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	gt "text/template"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/template"
+
+	. "github.com/onsi/gomega"
+)
+
+func render(text string, data any) (string, error) {
+	tmpl, err := template.New("test").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+
+	return buf.String(), err
+}
+
+func TestIndent(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(template.Indent(2, "a\n\nb")).Should(Equal("  a\n\n  b"))
+	g.Expect(template.Indent(2, "")).Should(BeEmpty())
+}
+
+func TestFuncs(t *testing.T) {
+	data := map[string]any{
+		"Name":      "otel-collector",
+		"Empty":     "",
+		"Exporters": []string{"otlp/tempo", "prometheus", "otlp/tempo"},
+		"Resources": map[string]any{"limits": map[string]any{"cpu": "500m"}},
+		"Replicas":  int32(3),
+	}
+
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{`{{ .Empty | default "none" }}`, `none`},
+		{`{{ .Name | default "none" }}`, `otel-collector`},
+		{`{{ coalesce .Empty .Name }}`, `otel-collector`},
+		{`{{ ternary "on" "off" (empty .Empty) }}`, `on`},
+		{`{{ .Name | upper | trimSuffix "-COLLECTOR" }}`, `OTEL`},
+		{`{{ .Name | replace "-" "_" | quote }}`, `"otel_collector"`},
+		{`{{ .Name | trunc 4 }}/{{ .Name | trunc -9 }}`, `otel/collector`},
+		{`{{ .Exporters | uniq | join "," }}`, `otlp/tempo,prometheus`},
+		{`{{ has "prometheus" .Exporters }} {{ first .Exporters }} {{ last .Exporters }}`, `true otlp/tempo otlp/tempo`},
+		{`{{ splitList "/" "otlp/tempo" | sortAlpha | join " " }}`, `otlp tempo`},
+		{`{{ $d := dict "cpu" "1" "memory" "1Gi" }}{{ keys $d | join "," }} {{ get $d "memory" }} {{ hasKey $d "gpu" }}`, `cpu,memory 1Gi false`},
+		{`{{ merge (dict "limits" (dict "memory" "1Gi")) .Resources | toJson }}`, `{"limits":{"cpu":"500m","memory":"1Gi"}}`},
+		{`{{ add .Replicas 1 }} {{ sub .Replicas 1 }} {{ mul .Replicas 2 }} {{ div .Replicas 2 }} {{ max 1 .Replicas }}`, `4 2 6 1 3`},
+		{`{{ "secret" | b64enc }} {{ "c2VjcmV0" | b64dec }}`, `c2VjcmV0 secret`},
+		{`{{ .Resources | toYaml | nindent 2 }}`, "\n  limits:\n    cpu: 500m\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			g := NewWithT(t)
+
+			out, err := render(tt.text, data)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(out).Should(Equal(tt.expected))
+		})
+	}
+}
+
+func TestStrict(t *testing.T) {
+	g := NewWithT(t)
+
+	data := map[string]any{"Name": "otel-collector"}
+
+	_, err := render(`name: {{ .Namespace }}`, data)
+	g.Expect(err).Should(MatchError(And(
+		ContainSubstring("test:1:9"),
+		ContainSubstring(`map has no entry for key "Namespace"`),
+	)))
+
+	_, err = render(`{{ required "the namespace is required" .Name }}{{ required "the namespace is required" "" }}`, data)
+	g.Expect(err).Should(MatchError(ContainSubstring("the namespace is required")))
+
+	_, err = render(`{{ div 1 0 }}`, data)
+	g.Expect(err).Should(MatchError(ContainSubstring("division by zero")))
+
+	_, err = render(`{{ join "," .Name }}`, data)
+	g.Expect(err).Should(MatchError(ContainSubstring("cannot iterate over type string")))
+}
+
+func TestRegister(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(template.Funcs()).Should(ContainElements("indent", "toYaml", "default", "required", "toJson"))
+
+	g.Expect(template.Register(gt.FuncMap{"indent": strings.TrimSpace})).
+		Should(MatchError(ContainSubstring(`template function "indent" already registered`)))
+	g.Expect(template.Register(gt.FuncMap{"notAFunction": "value"})).
+		Should(MatchError(ContainSubstring(`template function "notAFunction" is not a function`)))
+
+	g.Expect(template.Register(gt.FuncMap{"shout": func(s string) string { return strings.ToUpper(s) + "!" }})).
+		Should(Succeed())
+	g.Expect(template.Funcs()).Should(ContainElement("shout"))
+
+	out, err := render(`{{ "hello" | shout }}`, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(out).Should(Equal("HELLO!"))
+}
@@ -80,6 +80,14 @@ func New(opts ...ClientOpts) (client.Client, error) {
 			fakeMapper.Add(kt, meta.RESTScopeRoot)
 		case gvk.Auth:
 			fakeMapper.Add(kt, meta.RESTScopeRoot)
+		case gvk.OperatorConfig:
+			fakeMapper.Add(kt, meta.RESTScopeRoot)
+		case gvk.Uninstall:
+			fakeMapper.Add(kt, meta.RESTScopeRoot)
+		case gvk.DiagnosticBundle:
+			fakeMapper.Add(kt, meta.RESTScopeRoot)
+		case gvk.ManifestExport:
+			fakeMapper.Add(kt, meta.RESTScopeRoot)
 		default:
 			fakeMapper.Add(kt, meta.RESTScopeNamespace)
 		}
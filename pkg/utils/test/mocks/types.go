@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -69,6 +70,10 @@ func (m *MockController) GetDynamicClient() dynamic.Interface {
 	return m.Called().Get(0).(dynamic.Interface)
 }
 
+func (m *MockController) GetEventRecorder() record.EventRecorder {
+	return m.Called().Get(0).(record.EventRecorder)
+}
+
 func NewMockController(f func(m *MockController)) *MockController {
 	m := new(MockController)
 	f(m)
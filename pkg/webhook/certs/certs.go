@@ -0,0 +1,484 @@
+// Package certs manages the serving certificate of the webhooks of the operator when neither OLM
+// nor the OpenShift service CA provide it: a self-signed CA and the serving certificate it signs
+// are kept in a Secret of the operator namespace, rotated before they expire, written to the
+// certificate directory of the webhook server and their CA injected into the webhook
+// configurations and the conversion webhooks of the CRDs served from the operator namespace.
+package certs
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// SecretName is the name of the Secret of the operator namespace holding the CA and the
+	// serving certificate.
+	SecretName = "opendatahub-operator-webhook-managed-cert"
+
+	// CACertKey and CAKeyKey are the keys of the Secret holding the CA, the serving certificate
+	// being held in the tls.crt and tls.key keys.
+	CACertKey = "ca.crt"
+	CAKeyKey  = "ca.key"
+
+	DefaultCertValidity = 365 * 24 * time.Hour
+	DefaultRenewBefore  = 30 * 24 * time.Hour
+	DefaultInterval     = 10 * time.Minute
+
+	// caValidityFactor is the number of serving certificates a CA outlives, so the CA, whose
+	// rotation breaks the calls of the API server until the CA bundles are injected, rotates
+	// seldom.
+	caValidityFactor = 10
+)
+
+// DefaultCertDir is the certificate directory of the webhook server when the certificates are
+// managed by the operator, distinct from the one the certificates provided by the cluster are
+// mounted in.
+var DefaultCertDir = filepath.Join(os.TempDir(), "k8s-webhook-server", "managed-serving-certs")
+
+// Rotator keeps the serving certificate of the webhooks valid. It runs on every replica of the
+// operator, each writing the certificate of the shared Secret to its own certificate directory.
+type Rotator struct {
+	// Client reads and writes the Secret, webhook configurations and CRDs, uncached so the CRDs
+	// of the cluster are not cached by the operator.
+	Client client.Client
+
+	// Namespace is the operator namespace, holding the Secret and the services of the webhooks.
+	Namespace string
+
+	// CertDir is the certificate directory of the webhook server.
+	CertDir string
+
+	// CertValidity is the validity of the serving certificates, defaulting to DefaultCertValidity.
+	CertValidity time.Duration
+
+	// RenewBefore is the remaining validity the certificates are renewed at, defaulting to
+	// DefaultRenewBefore.
+	RenewBefore time.Duration
+
+	// Interval is the interval the certificates are checked at, defaulting to DefaultInterval.
+	Interval time.Duration
+}
+
+// NeedLeaderElection makes the Rotator run on every replica, since each serves the webhooks.
+func (r *Rotator) NeedLeaderElection() bool {
+	return false
+}
+
+// Start checks the certificates periodically until the given context is done, the CA bundles
+// reset by a new apply of the webhook configurations or CRDs being injected again meanwhile.
+func (r *Rotator) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("webhook-certs")
+
+	ticker := time.NewTicker(cmp.Or(r.Interval, DefaultInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Sync(ctx); err != nil {
+				log.Error(err, "failed to sync the webhook certificates")
+			}
+		}
+	}
+}
+
+// Sync renews the certificates if needed, writes the serving certificate to the certificate
+// directory and injects the CA into the webhooks served from the operator namespace.
+func (r *Rotator) Sync(ctx context.Context) error {
+	vwcs := admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, &vwcs); err != nil {
+		return fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+	}
+
+	mwcs := admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, &mwcs); err != nil {
+		return fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)
+	}
+
+	crds := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.Client.List(ctx, &crds); err != nil {
+		return fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)
+	}
+
+	secret, err := r.ensureSecret(ctx, r.dnsNames(&vwcs, &mwcs, &crds))
+	if err != nil {
+		return err
+	}
+
+	if err := r.writeCerts(secret); err != nil {
+		return err
+	}
+
+	return r.injectCABundle(ctx, secret.Data[CACertKey], &vwcs, &mwcs, &crds)
+}
+
+// ownService returns whether the given service of a webhook is served from the operator namespace.
+func (r *Rotator) ownService(ref *admissionregistrationv1.ServiceReference) bool {
+	return ref != nil && ref.Namespace == r.Namespace
+}
+
+// ownConversion returns whether the conversion webhook of the given CRD is served from the operator
+// namespace.
+func (r *Rotator) ownConversion(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	c := crd.Spec.Conversion
+	return c != nil && c.Strategy == apiextensionsv1.WebhookConverter && c.Webhook != nil && c.Webhook.ClientConfig != nil &&
+		c.Webhook.ClientConfig.Service != nil && c.Webhook.ClientConfig.Service.Namespace == r.Namespace
+}
+
+// dnsNames returns the sorted DNS names of the services of the webhooks served from the operator
+// namespace, the serving certificate is valid for.
+func (r *Rotator) dnsNames(
+	vwcs *admissionregistrationv1.ValidatingWebhookConfigurationList,
+	mwcs *admissionregistrationv1.MutatingWebhookConfigurationList,
+	crds *apiextensionsv1.CustomResourceDefinitionList,
+) []string {
+	names := make([]string, 0)
+
+	add := func(service string) {
+		svc := fmt.Sprintf("%s.%s.svc", service, r.Namespace)
+		if !slices.Contains(names, svc) {
+			names = append(names, svc, svc+".cluster.local")
+		}
+	}
+
+	for i := range vwcs.Items {
+		for _, w := range vwcs.Items[i].Webhooks {
+			if r.ownService(w.ClientConfig.Service) {
+				add(w.ClientConfig.Service.Name)
+			}
+		}
+	}
+
+	for i := range mwcs.Items {
+		for _, w := range mwcs.Items[i].Webhooks {
+			if r.ownService(w.ClientConfig.Service) {
+				add(w.ClientConfig.Service.Name)
+			}
+		}
+	}
+
+	for i := range crds.Items {
+		if r.ownConversion(&crds.Items[i]) {
+			add(crds.Items[i].Spec.Conversion.Webhook.ClientConfig.Service.Name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// ensureSecret returns the Secret holding certificates valid for the given DNS names, creating or
+// renewing them as needed. The Secret being shared by the replicas, the one losing the race to
+// create or renew it uses the certificates of the winner.
+func (r *Rotator) ensureSecret(ctx context.Context, dnsNames []string) (*corev1.Secret, error) {
+	secret := corev1.Secret{}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.Client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: SecretName}, &secret)
+		switch {
+		case k8serr.IsNotFound(err):
+			secret = corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: r.Namespace},
+				Type:       corev1.SecretTypeTLS,
+			}
+		case err != nil:
+			return err
+		}
+
+		data, renewed, err := r.renew(secret.Data, dnsNames, time.Now())
+		if err != nil || !renewed {
+			return err
+		}
+
+		logf.FromContext(ctx).Info("Renewing the webhook serving certificate", "secret", SecretName, "dnsNames", dnsNames)
+
+		secret.Data = data
+
+		if secret.ResourceVersion == "" {
+			err = r.Client.Create(ctx, &secret)
+			if k8serr.IsAlreadyExists(err) {
+				// created by another replica meanwhile, retried to use its certificates
+				return k8serr.NewConflict(corev1.Resource("secrets"), SecretName, err)
+			}
+
+			return err
+		}
+
+		return r.Client.Update(ctx, &secret)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure the webhook certificates Secret %s: %w", SecretName, err)
+	}
+
+	return &secret, nil
+}
+
+// renew returns the given certificates, renewed when they are invalid, expire within RenewBefore
+// of the given time or do not match the given DNS names, and whether they were renewed.
+func (r *Rotator) renew(data map[string][]byte, dnsNames []string, now time.Time) (map[string][]byte, bool, error) {
+	validity := cmp.Or(r.CertValidity, DefaultCertValidity)
+	renewBefore := cmp.Or(r.RenewBefore, DefaultRenewBefore)
+
+	caCert, caKey, err := parseKeyPair(data[CACertKey], data[CAKeyKey])
+	if err != nil || now.Add(renewBefore).After(caCert.NotAfter) {
+		caCert, caKey, err = newCA(now, caValidityFactor*validity)
+		if err != nil {
+			return nil, false, err
+		}
+
+		data = nil
+	}
+
+	cert, _, err := parseKeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey])
+	if err == nil && !now.Add(renewBefore).After(cert.NotAfter) && slices.Equal(sortedNames(cert), dnsNames) && cert.CheckSignatureFrom(caCert) == nil {
+		return data, false, nil
+	}
+
+	certPEM, keyPEM, err := newServingCert(caCert, caKey, dnsNames, now, validity)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return map[string][]byte{
+		CACertKey:               encodeCert(caCert),
+		CAKeyKey:                encodeKey(caKey),
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	}, true, nil
+}
+
+// writeCerts writes the serving certificate of the given Secret to the certificate directory,
+// the webhook server reloading it when it changes.
+func (r *Rotator) writeCerts(secret *corev1.Secret) error {
+	if err := os.MkdirAll(r.CertDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create the webhook certificate directory %s: %w", r.CertDir, err)
+	}
+
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		path := filepath.Join(r.CertDir, key)
+
+		current, err := os.ReadFile(path)
+		if err == nil && bytes.Equal(current, secret.Data[key]) {
+			continue
+		}
+
+		// written then renamed, so the webhook server never reads a partial file
+		tmp := path + ".tmp"
+		if err := os.WriteFile(tmp, secret.Data[key], 0o600); err != nil {
+			return fmt.Errorf("failed to write the webhook certificate %s: %w", path, err)
+		}
+
+		if err := os.Rename(tmp, path); err != nil {
+			return fmt.Errorf("failed to write the webhook certificate %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// injectCABundle sets the given CA bundle in the webhooks and conversion webhooks served from the
+// operator namespace, patching only the resources whose bundle differs.
+func (r *Rotator) injectCABundle(
+	ctx context.Context,
+	ca []byte,
+	vwcs *admissionregistrationv1.ValidatingWebhookConfigurationList,
+	mwcs *admissionregistrationv1.MutatingWebhookConfigurationList,
+	crds *apiextensionsv1.CustomResourceDefinitionList,
+) error {
+	var errs []error
+
+	for i := range vwcs.Items {
+		vwc := &vwcs.Items[i]
+		patch := client.MergeFromWithOptions(vwc.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		changed := false
+		for j := range vwc.Webhooks {
+			cc := &vwc.Webhooks[j].ClientConfig
+			if r.ownService(cc.Service) && !bytes.Equal(cc.CABundle, ca) {
+				cc.CABundle = ca
+				changed = true
+			}
+		}
+
+		if changed {
+			if err := r.Client.Patch(ctx, vwc, patch); err != nil {
+				errs = append(errs, fmt.Errorf("failed to inject the CA bundle into ValidatingWebhookConfiguration %s: %w", vwc.Name, err))
+			}
+		}
+	}
+
+	for i := range mwcs.Items {
+		mwc := &mwcs.Items[i]
+		patch := client.MergeFromWithOptions(mwc.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		changed := false
+		for j := range mwc.Webhooks {
+			cc := &mwc.Webhooks[j].ClientConfig
+			if r.ownService(cc.Service) && !bytes.Equal(cc.CABundle, ca) {
+				cc.CABundle = ca
+				changed = true
+			}
+		}
+
+		if changed {
+			if err := r.Client.Patch(ctx, mwc, patch); err != nil {
+				errs = append(errs, fmt.Errorf("failed to inject the CA bundle into MutatingWebhookConfiguration %s: %w", mwc.Name, err))
+			}
+		}
+	}
+
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if !r.ownConversion(crd) || bytes.Equal(crd.Spec.Conversion.Webhook.ClientConfig.CABundle, ca) {
+			continue
+		}
+
+		patch := client.MergeFromWithOptions(crd.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		crd.Spec.Conversion.Webhook.ClientConfig.CABundle = ca
+
+		if err := r.Client.Patch(ctx, crd, patch); err != nil {
+			errs = append(errs, fmt.Errorf("failed to inject the CA bundle into the conversion webhook of CRD %s: %w", crd.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newCA(now time.Time, validity time.Duration) (*x509.Certificate, *rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating CA key: %w", err)
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "opendatahub-operator-webhook-ca",
+			Organization: []string{"opendatahub-self-signed"},
+		},
+		NotBefore:             now.Add(-time.Hour).UTC(),
+		NotAfter:              now.Add(validity).UTC(),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, key.Public(), key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating CA certificate: %w", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing CA certificate: %w", err)
+	}
+
+	return cert, key, nil
+}
+
+func newServingCert(ca *x509.Certificate, caKey *rsa.PrivateKey, dnsNames []string, now time.Time, validity time.Duration) ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating key: %w", err)
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "opendatahub-operator-webhook",
+			Organization: []string{"opendatahub-self-signed"},
+		},
+		DNSNames:    dnsNames,
+		NotBefore:   now.Add(-time.Hour).UTC(),
+		NotAfter:    now.Add(validity).UTC(),
+		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, ca, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating certificate: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), encodeKey(key), nil
+}
+
+func newSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("error generating serial number: %w", err)
+	}
+
+	return serial, nil
+}
+
+// parseKeyPair returns the certificate and RSA key of the given PEM blocks.
+func parseKeyPair(certPEM []byte, keyPEM []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	keyBlock, _ := pem.Decode(keyPEM)
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, errors.New("missing certificate or key")
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+func encodeCert(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func encodeKey(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func sortedNames(cert *x509.Certificate) []string {
+	names := slices.Clone(cert.DNSNames)
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,138 @@
+package certs_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook/certs"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorNamespace = "opendatahub-operator-system"
+
+func parseCert(t *testing.T, data []byte) *x509.Certificate {
+	t.Helper()
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("invalid certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("invalid certificate: %v", err)
+	}
+
+	return cert
+}
+
+func TestRotatorSync(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-operator-validating-webhook-configuration"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: "datasciencecluster-validator.opendatahub.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: operatorNamespace, Name: "opendatahub-operator-webhook-service"},
+				},
+			},
+			{
+				Name: "other.example.com",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: "other", Name: "other-webhook"},
+				},
+			},
+		},
+	}
+
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "opendatahub-operator-mutating-webhook-configuration"},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: "hardwareprofile-injector.opendatahub.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: operatorNamespace, Name: "opendatahub-operator-webhook-service"},
+			},
+		}},
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "datascienceclusters.datasciencecluster.opendatahub.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig: &apiextensionsv1.WebhookClientConfig{
+						Service: &apiextensionsv1.ServiceReference{Namespace: operatorNamespace, Name: "opendatahub-operator-webhook-service"},
+					},
+				},
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(vwc, mwc, crd))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rotator := certs.Rotator{
+		Client:    cli,
+		Namespace: operatorNamespace,
+		CertDir:   t.TempDir(),
+	}
+
+	g.Expect(rotator.Sync(ctx)).Should(Succeed())
+
+	secret := corev1.Secret{}
+	g.Expect(cli.Get(ctx, client.ObjectKey{Namespace: operatorNamespace, Name: certs.SecretName}, &secret)).Should(Succeed())
+
+	ca := parseCert(t, secret.Data[certs.CACertKey])
+	cert := parseCert(t, secret.Data[corev1.TLSCertKey])
+	g.Expect(cert.CheckSignatureFrom(ca)).Should(Succeed())
+	g.Expect(cert.DNSNames).Should(ConsistOf(
+		"opendatahub-operator-webhook-service.opendatahub-operator-system.svc",
+		"opendatahub-operator-webhook-service.opendatahub-operator-system.svc.cluster.local",
+	))
+
+	g.Expect(os.ReadFile(filepath.Join(rotator.CertDir, corev1.TLSCertKey))).Should(Equal(secret.Data[corev1.TLSCertKey]))
+	g.Expect(os.ReadFile(filepath.Join(rotator.CertDir, corev1.TLSPrivateKeyKey))).Should(Equal(secret.Data[corev1.TLSPrivateKeyKey]))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(vwc), vwc)).Should(Succeed())
+	g.Expect(vwc.Webhooks[0].ClientConfig.CABundle).Should(Equal(secret.Data[certs.CACertKey]))
+	g.Expect(vwc.Webhooks[1].ClientConfig.CABundle).Should(BeEmpty())
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(mwc), mwc)).Should(Succeed())
+	g.Expect(mwc.Webhooks[0].ClientConfig.CABundle).Should(Equal(secret.Data[certs.CACertKey]))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(crd), crd)).Should(Succeed())
+	g.Expect(crd.Spec.Conversion.Webhook.ClientConfig.CABundle).Should(Equal(secret.Data[certs.CACertKey]))
+
+	// valid certificates are kept
+	g.Expect(rotator.Sync(ctx)).Should(Succeed())
+
+	kept := corev1.Secret{}
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(&secret), &kept)).Should(Succeed())
+	g.Expect(kept.Data).Should(Equal(secret.Data))
+
+	// the serving certificate is renewed before it expires, signed by the same CA
+	rotator.RenewBefore = 2 * certs.DefaultCertValidity
+
+	g.Expect(rotator.Sync(ctx)).Should(Succeed())
+
+	renewed := corev1.Secret{}
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(&secret), &renewed)).Should(Succeed())
+	g.Expect(renewed.Data[corev1.TLSCertKey]).ShouldNot(Equal(secret.Data[corev1.TLSCertKey]))
+	g.Expect(renewed.Data[certs.CACertKey]).Should(Equal(secret.Data[certs.CACertKey]))
+	g.Expect(os.ReadFile(filepath.Join(rotator.CertDir, corev1.TLSCertKey))).Should(Equal(renewed.Data[corev1.TLSCertKey]))
+}